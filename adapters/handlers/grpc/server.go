@@ -15,6 +15,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"net"
 	"time"
 
@@ -24,14 +25,20 @@ import (
 	"github.com/weaviate/weaviate/adapters/handlers/rest/state"
 	pbv0 "github.com/weaviate/weaviate/grpc/generated/protocol/v0"
 	pbv1 "github.com/weaviate/weaviate/grpc/generated/protocol/v1"
+	"github.com/weaviate/weaviate/grpc/health"
+	"github.com/weaviate/weaviate/grpc/interceptors"
 	"github.com/weaviate/weaviate/usecases/auth/authentication/composer"
 	authErrs "github.com/weaviate/weaviate/usecases/auth/authorization/errors"
+	"github.com/weaviate/weaviate/usecases/build"
 	"github.com/weaviate/weaviate/usecases/monitoring"
+	schemaUC "github.com/weaviate/weaviate/usecases/schema"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials"
 	_ "google.golang.org/grpc/encoding/gzip" // Install the gzip compressor
 	"google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/reflection"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/proto"
 
@@ -56,46 +63,98 @@ func CreateGRPCServer(state *state.State) *GRPCServer {
 		o = append(o, grpc.Creds(c))
 	}
 
-	var interceptors []grpc.UnaryServerInterceptor
+	// Tracing goes first, so the spans cover all other interceptors
+	o = append(o, interceptors.WeaviateOTelInterceptors(state.ServerConfig.Config.GRPC.OTelInterceptors)...)
 
-	interceptors = append(interceptors, makeAuthInterceptor())
+	// The service is created before the interceptors, as the rate limiter
+	// authenticates requests with it
+	weaviateV1 := v1.NewService(
+		state.Traverser,
+		composer.New(
+			state.ServerConfig.Config.Authentication,
+			state.APIKey, state.OIDC),
+		state.ServerConfig.Config.Authentication.AnonymousAccess.Enabled,
+		state.SchemaManager,
+		state.BatchManager,
+		&state.ServerConfig.Config,
+		state.DataAuthorizer,
+		state.Logger,
+	)
+
+	// The versions go first, so that they are part of all responses
+	// including the ones rejected by other interceptors
+	var unaryInterceptors []grpc.UnaryServerInterceptor
+	unaryInterceptors = append(unaryInterceptors, interceptors.VersionUnaryServerInterceptor(buildVersion{}))
+
+	// Schema validation goes before auth, so authorization failures during
+	// validation are mapped by the auth interceptor
+	unaryInterceptors = append(unaryInterceptors, makeSchemaValidationInterceptor())
+	unaryInterceptors = append(unaryInterceptors, makeAuthInterceptor())
+	// Signatures are verified before the tenant interceptor modifies the request
+	if secret := state.ServerConfig.Config.Authentication.BatchDeleteSigningSecret; secret != "" {
+		unaryInterceptors = append(unaryInterceptors,
+			interceptors.BatchDeleteSignatureUnaryServerInterceptor([]byte(secret)))
+	}
+	unaryInterceptors = append(unaryInterceptors, interceptors.TenantUnaryServerInterceptor())
+	if audit := state.ServerConfig.Config.GRPC.AuditLog; audit.Enabled {
+		var w io.Writer = interceptors.NewLogrusAuditWriter(state.Logger)
+		if audit.Path != "" {
+			w = interceptors.NewDailyFileWriter(audit.Path)
+		}
+		unaryInterceptors = append(unaryInterceptors, interceptors.NewAuditInterceptor(w, audit.RedactFilters).Unary())
+	}
+	if cfg := state.ServerConfig.Config.GRPC; len(cfg.ClassRateLimits) > 0 || len(cfg.PrincipalRateLimits) > 0 {
+		unaryInterceptors = append(unaryInterceptors, interceptors.RateLimitUnaryServerInterceptor(
+			cfg.ClassRateLimits, cfg.PrincipalRateLimits, weaviateV1.PrincipalFromContext))
+	}
 
 	// If sentry is enabled add automatic spans on gRPC requests
 	if state.ServerConfig.Config.Sentry.Enabled {
-		interceptors = append(interceptors, grpc_middleware.ChainUnaryServer(
+		unaryInterceptors = append(unaryInterceptors, grpc_middleware.ChainUnaryServer(
 			grpc_sentry.UnaryServerInterceptor(),
 		))
 	}
 
 	if state.Metrics != nil {
-		interceptors = append(interceptors, makeMetricsInterceptor(state.Logger, state.Metrics))
+		unaryInterceptors = append(unaryInterceptors, makeMetricsInterceptor(state.Logger, state.Metrics))
 	}
 
-	if len(interceptors) > 0 {
-		o = append(o, grpc.ChainUnaryInterceptor(interceptors...))
+	if len(unaryInterceptors) > 0 {
+		o = append(o, grpc.ChainUnaryInterceptor(unaryInterceptors...))
 	}
+	unary := grpc_middleware.ChainUnaryServer(unaryInterceptors...)
+	o = append(o, grpc.ChainStreamInterceptor(interceptors.VersionStreamServerInterceptor(buildVersion{}),
+		makeAuthStreamInterceptor()))
 
 	s := grpc.NewServer(o...)
 	weaviateV0 := v0.NewService()
-	weaviateV1 := v1.NewService(
-		state.Traverser,
-		composer.New(
-			state.ServerConfig.Config.Authentication,
-			state.APIKey, state.OIDC),
-		state.ServerConfig.Config.Authentication.AnonymousAccess.Enabled,
-		state.SchemaManager,
-		state.BatchManager,
-		&state.ServerConfig.Config,
-		state.Authorizer,
-		state.Logger,
-	)
+	// the versions are already set by the interceptors of MultiBatchDelete
+	// itself
+	weaviateV1.SetBatchDeleteInterceptor(grpc_middleware.ChainUnaryServer(unaryInterceptors[1:]...))
 	pbv0.RegisterWeaviateServer(s, weaviateV0)
 	pbv1.RegisterWeaviateServer(s, weaviateV1)
-	grpc_health_v1.RegisterHealthServer(s, weaviateV1)
+	grpc_health_v1.RegisterHealthServer(s,
+		health.NewHealthServer(state.ClusterService.Raft, health.DefaultWatchInterval))
+	if state.ServerConfig.Config.GRPC.EnableReflection {
+		// serves the descriptors of all registered services including their
+		// dependencies, e.g. weaviate.v1 messages defined in base.proto
+		reflection.Register(s)
+	}
 
-	return &GRPCServer{s}
+	return &GRPCServer{Server: s, unary: unary, weaviateV1: weaviateV1}
 }
 
+// APIVersion is the version of the gRPC API reported to clients, i.e. the
+// package of the latest protocol
+const APIVersion = "v1"
+
+// buildVersion reports the version the server was built with
+type buildVersion struct{}
+
+func (buildVersion) ServerVersion() string { return build.Version }
+
+func (buildVersion) APIVersion() string { return APIVersion }
+
 func makeMetricsInterceptor(logger logrus.FieldLogger, metrics *monitoring.PrometheusMetrics) grpc.UnaryServerInterceptor {
 	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
 		if info.FullMethod != "/weaviate.v1.Weaviate/BatchObjects" {
@@ -128,6 +187,69 @@ func makeMetricsInterceptor(logger logrus.FieldLogger, metrics *monitoring.Prome
 	}
 }
 
+// makeAuthStreamInterceptor is the streaming counterpart of makeAuthInterceptor
+func makeAuthStreamInterceptor() grpc.StreamServerInterceptor {
+	return func(
+		srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler,
+	) error {
+		err := handler(srv, ss)
+
+		if errors.As(err, &authErrs.Unauthenticated{}) {
+			return status.Error(codes.Unauthenticated, err.Error())
+		}
+
+		if errors.As(err, &authErrs.Forbidden{}) {
+			return status.Error(codes.PermissionDenied, err.Error())
+		}
+
+		return err
+	}
+}
+
+// schemaWritingMethods are the RPCs which validate the classes they write,
+// their schema validation failures are mapped by
+// makeSchemaValidationInterceptor
+var schemaWritingMethods = map[string]bool{
+	"/weaviate.v1.Weaviate/UpdateClass": true,
+}
+
+// makeSchemaValidationInterceptor returns the schema validation failures of
+// schemaWritingMethods as InvalidArgument with a BadRequest detail listing
+// every invalid field
+func makeSchemaValidationInterceptor() grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler,
+	) (any, error) {
+		if !schemaWritingMethods[info.FullMethod] {
+			return handler(ctx, req)
+		}
+		resp, err := handler(ctx, req)
+		if st, ok := schemaValidationStatus(err); ok {
+			return nil, st.Err()
+		}
+		return resp, err
+	}
+}
+
+func schemaValidationStatus(err error) (*status.Status, bool) {
+	var verr *schemaUC.ValidationError
+	if !errors.As(err, &verr) {
+		return nil, false
+	}
+	badRequest := &errdetails.BadRequest{}
+	for _, v := range verr.Violations {
+		badRequest.FieldViolations = append(badRequest.FieldViolations, &errdetails.BadRequest_FieldViolation{
+			Field:       v.Field,
+			Description: v.Err.Error(),
+		})
+	}
+	st, detailErr := status.New(codes.InvalidArgument, err.Error()).WithDetails(badRequest)
+	if detailErr != nil {
+		return status.New(codes.InvalidArgument, err.Error()), true
+	}
+	return st, true
+}
+
 func makeAuthInterceptor() grpc.UnaryServerInterceptor {
 	return func(
 		ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler,
@@ -163,4 +285,29 @@ func StartAndListen(s *GRPCServer, state *state.State) error {
 
 type GRPCServer struct {
 	*grpc.Server
+
+	unary      grpc.UnaryServerInterceptor
+	weaviateV1 *v1.Service
+}
+
+// GracefulStop stops the server like grpc.Server.GracefulStop and then waits
+// for the batch delete jobs still running in the background
+func (s *GRPCServer) GracefulStop() {
+	s.Server.GracefulStop()
+	s.weaviateV1.StopBatchDeleteJobs()
+}
+
+// BatchDelete calls BatchDelete of the weaviate.v1 service in process, through
+// the same unary interceptors as calls served over the network. It backs
+// transports other than gRPC, e.g. server-sent events.
+func (s *GRPCServer) BatchDelete(ctx context.Context, req *pbv1.BatchDeleteRequest) (*pbv1.BatchDeleteReply, error) {
+	info := &grpc.UnaryServerInfo{Server: s.weaviateV1, FullMethod: "/weaviate.v1.Weaviate/BatchDelete"}
+	resp, err := s.unary(ctx, req, info, func(ctx context.Context, req any) (any, error) {
+		return s.weaviateV1.BatchDelete(ctx, req.(*pbv1.BatchDeleteRequest))
+	})
+	if err != nil {
+		return nil, err
+	}
+	reply, _ := resp.(*pbv1.BatchDeleteReply)
+	return reply, nil
 }
@@ -0,0 +1,198 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package grpc
+
+import (
+	"context"
+	"errors"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/grpc/client"
+	pbv1 "github.com/weaviate/weaviate/grpc/generated/protocol/v1"
+	"github.com/weaviate/weaviate/grpc/interceptors"
+	"github.com/weaviate/weaviate/usecases/build"
+	schemaUC "github.com/weaviate/weaviate/usecases/schema"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/reflection"
+	reflectionpb "google.golang.org/grpc/reflection/grpc_reflection_v1"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func TestGRPCServerReflection(t *testing.T) {
+	lis := bufconn.Listen(1024 * 1024)
+	s := grpc.NewServer()
+	pbv1.RegisterWeaviateServer(s, &pbv1.UnimplementedWeaviateServer{})
+	reflection.Register(s)
+	go s.Serve(lis)
+	defer s.Stop()
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return lis.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.Nil(t, err)
+	defer conn.Close()
+
+	stream, err := reflectionpb.NewServerReflectionClient(conn).ServerReflectionInfo(context.Background())
+	require.Nil(t, err)
+	request := func(req *reflectionpb.ServerReflectionRequest) *reflectionpb.ServerReflectionResponse {
+		require.Nil(t, stream.Send(req))
+		resp, err := stream.Recv()
+		require.Nil(t, err)
+		return resp
+	}
+
+	t.Run("list services", func(t *testing.T) {
+		resp := request(&reflectionpb.ServerReflectionRequest{
+			MessageRequest: &reflectionpb.ServerReflectionRequest_ListServices{},
+		})
+		var services []string
+		for _, service := range resp.GetListServicesResponse().GetService() {
+			services = append(services, service.Name)
+		}
+		assert.Contains(t, services, "weaviate.v1.Weaviate")
+	})
+
+	t.Run("all v1 files are resolvable", func(t *testing.T) {
+		resp := request(&reflectionpb.ServerReflectionRequest{
+			MessageRequest: &reflectionpb.ServerReflectionRequest_FileContainingSymbol{
+				FileContainingSymbol: "weaviate.v1.Weaviate",
+			},
+		})
+
+		// follow the dependencies like a client resolving the full API would
+		resolved := map[string]bool{}
+		pending := resp.GetFileDescriptorResponse().GetFileDescriptorProto()
+		for len(pending) > 0 {
+			fd := &descriptorpb.FileDescriptorProto{}
+			require.Nil(t, proto.Unmarshal(pending[0], fd))
+			pending = pending[1:]
+			resolved[fd.GetName()] = true
+			for _, dep := range fd.GetDependency() {
+				if resolved[dep] {
+					continue
+				}
+				resp := request(&reflectionpb.ServerReflectionRequest{
+					MessageRequest: &reflectionpb.ServerReflectionRequest_FileByFilename{FileByFilename: dep},
+				})
+				require.Nil(t, resp.GetErrorResponse(), dep)
+				pending = append(pending, resp.GetFileDescriptorResponse().GetFileDescriptorProto()...)
+			}
+		}
+
+		for _, file := range []string{"v1/weaviate.proto", "v1/base.proto", "v1/batch.proto", "v1/batch_delete.proto", "v1/search_get.proto", "v1/tenants.proto"} {
+			assert.True(t, resolved[file], file)
+		}
+	})
+}
+
+func TestSchemaValidationInterceptor(t *testing.T) {
+	interceptor := makeSchemaValidationInterceptor()
+	call := func(method string, err error) error {
+		_, err = interceptor(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: method},
+			func(ctx context.Context, req any) (any, error) { return nil, err })
+		return err
+	}
+	verr := &schemaUC.ValidationError{Violations: []schemaUC.FieldViolation{
+		{Field: "vectorConfig", Err: errors.New("invalid vector config")},
+		{Field: "maxObjects", Err: errors.New("invalid maxObjects")},
+	}}
+
+	t.Run("validation error", func(t *testing.T) {
+		err := call("/weaviate.v1.Weaviate/UpdateClass", verr)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		assert.Equal(t, "invalid vector config", status.Convert(err).Message())
+
+		violations, ok := client.ParseSchemaValidationError(err)
+		require.True(t, ok)
+		assert.Equal(t, []client.FieldViolation{
+			{Field: "vectorConfig", Description: "invalid vector config"},
+			{Field: "maxObjects", Description: "invalid maxObjects"},
+		}, violations)
+	})
+
+	t.Run("other error", func(t *testing.T) {
+		err := call("/weaviate.v1.Weaviate/UpdateClass", errors.New("other"))
+		assert.EqualError(t, err, "other")
+
+		_, ok := client.ParseSchemaValidationError(err)
+		assert.False(t, ok)
+	})
+
+	t.Run("method not writing the schema", func(t *testing.T) {
+		err := call("/weaviate.v1.Weaviate/Search", verr)
+		assert.Equal(t, verr, err)
+	})
+}
+
+func TestVersionInterceptors(t *testing.T) {
+	lis := bufconn.Listen(1024 * 1024)
+	s := grpc.NewServer(
+		grpc.ChainUnaryInterceptor(interceptors.VersionUnaryServerInterceptor(buildVersion{})),
+		grpc.ChainStreamInterceptor(interceptors.VersionStreamServerInterceptor(buildVersion{})))
+	pbv1.RegisterWeaviateServer(s, &pbv1.UnimplementedWeaviateServer{})
+	go s.Serve(lis)
+	defer s.Stop()
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return lis.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.Nil(t, err)
+	defer conn.Close()
+
+	assertVersions := func(t *testing.T, md metadata.MD) {
+		serverVersion, apiVersion, ok := client.ExtractVersionFromMetadata(md)
+		require.True(t, ok)
+		assert.Equal(t, build.Version, serverVersion)
+		assert.Equal(t, APIVersion, apiVersion)
+	}
+	method := func(name string) string {
+		return "/" + pbv1.Weaviate_ServiceDesc.ServiceName + "/" + name
+	}
+
+	for _, desc := range pbv1.Weaviate_ServiceDesc.Methods {
+		t.Run(desc.MethodName, func(t *testing.T) {
+			var header, trailer metadata.MD
+			err := conn.Invoke(context.Background(), method(desc.MethodName), &emptypb.Empty{}, &emptypb.Empty{},
+				grpc.Header(&header), grpc.Trailer(&trailer))
+			require.Equal(t, codes.Unimplemented, status.Code(err))
+			assertVersions(t, metadata.Join(header, trailer))
+		})
+	}
+
+	for _, desc := range pbv1.Weaviate_ServiceDesc.Streams {
+		t.Run(desc.StreamName, func(t *testing.T) {
+			stream, err := conn.NewStream(context.Background(), &desc, method(desc.StreamName))
+			require.Nil(t, err)
+			require.Nil(t, stream.SendMsg(&emptypb.Empty{}))
+			require.Nil(t, stream.CloseSend())
+			err = stream.RecvMsg(&emptypb.Empty{})
+			require.Equal(t, codes.Unimplemented, status.Code(err))
+			header, err := stream.Header()
+			require.Nil(t, err)
+			assertVersions(t, metadata.Join(header, stream.Trailer()))
+		})
+	}
+}
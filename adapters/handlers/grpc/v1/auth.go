@@ -49,6 +49,12 @@ func (s *Service) principalFromContext(ctx context.Context) (*models.Principal,
 	return s.authComposer(token, nil)
 }
 
+// PrincipalFromContext returns the principal authenticated by the
+// credentials of the incoming request, for interceptors which depend on it
+func (s *Service) PrincipalFromContext(ctx context.Context) (*models.Principal, error) {
+	return s.principalFromContext(ctx)
+}
+
 func (s *Service) tryAnonymous() (*models.Principal, error) {
 	if s.allowAnonymousAccess {
 		return nil, nil
@@ -12,17 +12,109 @@
 package v1
 
 import (
+	"context"
+	"errors"
 	"fmt"
-	"math/big"
 	"strings"
 
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
 	"github.com/weaviate/weaviate/entities/filters"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/storagestate"
 	pb "github.com/weaviate/weaviate/grpc/generated/protocol/v1"
 	"github.com/weaviate/weaviate/usecases/objects"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
+const (
+	// DefaultBatchDeleteChunkSize is used for batch deletes without chunk_size
+	DefaultBatchDeleteChunkSize = 100
+	// MaxBatchDeleteChunkSize is the largest chunk_size of a batch delete
+	MaxBatchDeleteChunkSize = 10_000
+	// MaxBatchDeleteExcludeUUIDs is the largest number of exclude_uuids of a
+	// batch delete
+	MaxBatchDeleteExcludeUUIDs = 10_000
+)
+
+// validateBatchDeleteRequest rejects requests which must not reach the filter
+// translator, which recurses once per level of the filter tree, requests
+// with a chunk size above MaxBatchDeleteChunkSize and requests with more than
+// MaxBatchDeleteExcludeUUIDs or malformed exclude_uuids. Callback URLs are
+// checked by validateCallbackURL.
+func validateBatchDeleteRequest(req *pb.BatchDeleteRequest, maxFilterDepth int) error {
+	if depth := FilterDepth(req.Filters); maxFilterDepth > 0 && depth > maxFilterDepth {
+		return status.Errorf(codes.InvalidArgument,
+			"batch delete filters are nested %d levels deep, at most %d levels are allowed", depth, maxFilterDepth)
+	}
+	if req.ChunkSize > MaxBatchDeleteChunkSize {
+		return status.Errorf(codes.InvalidArgument,
+			"batch delete chunk_size is %d, at most %d is allowed", req.ChunkSize, MaxBatchDeleteChunkSize)
+	}
+	if n := len(req.ExcludeUuids); n > MaxBatchDeleteExcludeUUIDs {
+		return status.Errorf(codes.InvalidArgument,
+			"batch delete has %d exclude_uuids, at most %d are allowed", n, MaxBatchDeleteExcludeUUIDs)
+	}
+	for i, id := range req.ExcludeUuids {
+		if len(id) != 16 {
+			return status.Errorf(codes.InvalidArgument,
+				"batch delete exclude_uuids[%d] is %d bytes long, uuids are 16 bytes", i, len(id))
+		}
+	}
+	return nil
+}
+
+// filterValidationStatus returns err as InvalidArgument status with a
+// pb.FilterValidationError detail if err is a filterValidationError, see
+// pb.ParseFilterValidationError
+func filterValidationStatus(err error) error {
+	var fve *filterValidationError
+	if !errors.As(err, &fve) {
+		return err
+	}
+	st, detailErr := status.New(codes.InvalidArgument, err.Error()).WithDetails(fve.detail)
+	if detailErr != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	return st.Err()
+}
+
+// batchDeleteTenants returns the tenants to delete from. A request without
+// tenant selection deletes from the non-tenant shards, which is represented
+// by a single empty tenant. With all_tenants the tenants are the ones
+// returned by activeTenants for the collection.
+func batchDeleteTenants(req *pb.BatchDeleteRequest, activeTenants func(class string) ([]string, error)) ([]string, error) {
+	if req.AllTenants {
+		if req.TenantSelection != nil {
+			return nil, status.Error(codes.InvalidArgument, "all_tenants must not be combined with tenant or tenant_list")
+		}
+		return activeTenants(req.Collection)
+	}
+
+	list, ok := req.TenantSelection.(*pb.BatchDeleteRequest_TenantList)
+	if !ok {
+		return []string{req.GetTenant()}, nil
+	}
+	tenants := list.TenantList.GetTenants()
+	if len(tenants) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "tenant_list must contain at least one tenant")
+	}
+	seen := make(map[string]struct{}, len(tenants))
+	for _, tenant := range tenants {
+		if tenant == "" {
+			return nil, status.Error(codes.InvalidArgument, "tenant_list must not contain empty tenant names")
+		}
+		if _, ok := seen[tenant]; ok {
+			return nil, status.Errorf(codes.InvalidArgument, "tenant_list contains tenant %q more than once", tenant)
+		}
+		seen[tenant] = struct{}{}
+	}
+	return tenants, nil
+}
+
 func batchDeleteParamsFromProto(req *pb.BatchDeleteRequest, authorizedGetClass func(string) (*models.Class, error)) (objects.BatchDeleteParams, error) {
 	params := objects.BatchDeleteParams{}
 
@@ -44,6 +136,10 @@ func batchDeleteParamsFromProto(req *pb.BatchDeleteRequest, authorizedGetClass f
 	}
 
 	params.DryRun = req.DryRun
+	params.ChunkSize = DefaultBatchDeleteChunkSize
+	if req.ChunkSize > 0 {
+		params.ChunkSize = int(req.ChunkSize)
+	}
 
 	if req.Filters == nil {
 		return objects.BatchDeleteParams{}, fmt.Errorf("no filters in batch delete request")
@@ -51,14 +147,40 @@ func batchDeleteParamsFromProto(req *pb.BatchDeleteRequest, authorizedGetClass f
 
 	clause, err := ExtractFilters(req.Filters, authorizedGetClass, req.Collection)
 	if err != nil {
-		return objects.BatchDeleteParams{}, err
+		return objects.BatchDeleteParams{}, filterValidationStatus(err)
+	}
+	if err := validateFilterValueTypes(req.Filters, &clause); err != nil {
+		return objects.BatchDeleteParams{}, filterValidationStatus(err)
 	}
 	filter := &filters.LocalFilter{Root: &clause}
 	if err := filters.ValidateFilters(authorizedGetClass, filter); err != nil {
-		return objects.BatchDeleteParams{}, err
+		err = describeInvalidClause(err, authorizedGetClass, req.Filters, &clause)
+		return objects.BatchDeleteParams{}, filterValidationStatus(err)
 	}
 	params.Filters = filter
 
+	if req.ModifiedBefore != nil {
+		if err := req.ModifiedBefore.CheckValid(); err != nil {
+			return objects.BatchDeleteParams{}, fmt.Errorf("invalid modified_before: %w", err)
+		}
+		if class.InvertedIndexConfig == nil || !class.InvertedIndexConfig.IndexTimestamps {
+			return objects.BatchDeleteParams{}, fmt.Errorf(
+				"modified_before requires indexTimestamps to be enabled on collection %s", req.Collection)
+		}
+		params.ModifiedBefore = req.ModifiedBefore.AsTime()
+	}
+
+	if len(req.ExcludeUuids) > 0 {
+		params.ExcludeUUIDs = make([]strfmt.UUID, len(req.ExcludeUuids))
+		for i, id := range req.ExcludeUuids {
+			parsed, err := uuid.FromBytes(id)
+			if err != nil {
+				return objects.BatchDeleteParams{}, fmt.Errorf("invalid exclude_uuids[%d]: %w", i, err)
+			}
+			params.ExcludeUUIDs[i] = strfmt.UUID(parsed.String())
+		}
+	}
+
 	return params, nil
 }
 
@@ -69,24 +191,22 @@ func batchDeleteReplyFromObjects(response objects.BatchDeleteResult, verbose boo
 	if verbose {
 		objs = make([]*pb.BatchDeleteObject, 0, len(response.Objects))
 	}
+	var breakdown errorBreakdown
 	for _, obj := range response.Objects {
 		if obj.Err == nil {
 			successful += 1
 		} else {
 			failed += 1
+			breakdown.add(obj.Err, obj.UUID)
 		}
 		if verbose {
-			hexInteger, success := new(big.Int).SetString(strings.Replace(obj.UUID.String(), "-", "", -1), 16)
-			if !success {
-				return nil, fmt.Errorf("failed to parse hex string to integer")
-			}
 			errorString := ""
 			if obj.Err != nil {
 				errorString = obj.Err.Error()
 			}
 
 			resultObj := &pb.BatchDeleteObject{
-				Uuid:       hexInteger.Bytes(),
+				UuidFormat: &pb.BatchDeleteObject_UuidStr{UuidStr: obj.UUID.String()},
 				Successful: obj.Err == nil,
 				Error:      &errorString,
 			}
@@ -98,7 +218,123 @@ func batchDeleteReplyFromObjects(response objects.BatchDeleteResult, verbose boo
 		Failed:     failed,
 		Matches:    response.Matches,
 		Objects:    objs,
+		Skipped:    response.Skipped,
+		Excluded:   response.Excluded,
+
+		ErrorBreakdown: breakdown.buckets,
 	}
 
 	return reply, nil
 }
+
+// echoBatchDeleteRequest sets the fields of reply which let callers
+// correlate it to req
+func echoBatchDeleteRequest(reply *pb.BatchDeleteReply, req *pb.BatchDeleteRequest) {
+	reply.Collection = req.Collection
+	reply.Tenant = req.GetTenant()
+	reply.RequestId = req.RequestId
+}
+
+// mergeBatchDeleteReplies sums up the replies of a delete fanned out to
+// tenants, replies[i] being the reply of tenants[i]
+func mergeBatchDeleteReplies(tenants []string, replies []*pb.BatchDeleteReply) *pb.BatchDeleteReply {
+	merged := &pb.BatchDeleteReply{
+		TenantResults: make([]*pb.TenantDeleteSummary, 0, len(tenants)),
+	}
+	var breakdown errorBreakdown
+	for i, reply := range replies {
+		breakdown.merge(reply.ErrorBreakdown)
+		merged.Failed += reply.Failed
+		merged.Matches += reply.Matches
+		merged.Successful += reply.Successful
+		merged.Skipped += reply.Skipped
+		merged.Excluded += reply.Excluded
+		merged.Objects = append(merged.Objects, reply.Objects...)
+		merged.TenantResults = append(merged.TenantResults, &pb.TenantDeleteSummary{
+			Tenant:     tenants[i],
+			Failed:     reply.Failed,
+			Matches:    reply.Matches,
+			Successful: reply.Successful,
+			Skipped:    reply.Skipped,
+			Excluded:   reply.Excluded,
+		})
+	}
+	merged.ErrorBreakdown = breakdown.buckets
+	return merged
+}
+
+// error_code values of pb.ErrorBucket
+const (
+	batchDeleteErrTimeout          = "timeout"
+	batchDeleteErrCanceled         = "canceled"
+	batchDeleteErrReadOnly         = "shard read-only"
+	batchDeleteErrShardUnavailable = "shard unavailable"
+	batchDeleteErrTenantNotActive  = "tenant not active"
+	batchDeleteErrTenantNotFound   = "tenant not found"
+	batchDeleteErrReplication      = "replication failed"
+	batchDeleteErrUnknown          = "unknown"
+)
+
+// batchDeleteErrorCode normalizes err into one of the error codes above.
+// Errors of remote shards only arrive as messages, so the message is matched
+// as well.
+func batchDeleteErrorCode(err error) string {
+	matches := func(target error) bool {
+		return errors.Is(err, target) || strings.Contains(err.Error(), target.Error())
+	}
+	switch {
+	case matches(context.DeadlineExceeded) || strings.Contains(err.Error(), "timeout"):
+		return batchDeleteErrTimeout
+	case matches(context.Canceled):
+		return batchDeleteErrCanceled
+	case matches(storagestate.ErrStatusReadOnly):
+		return batchDeleteErrReadOnly
+	case matches(enterrors.ErrTenantNotActive):
+		return batchDeleteErrTenantNotActive
+	case matches(enterrors.ErrTenantNotFound):
+		return batchDeleteErrTenantNotFound
+	case strings.Contains(err.Error(), "shard not found"):
+		return batchDeleteErrShardUnavailable
+	case strings.Contains(err.Error(), "replica"):
+		return batchDeleteErrReplication
+	default:
+		return batchDeleteErrUnknown
+	}
+}
+
+// errorBreakdown groups failed objects by error code in the order the codes
+// are first seen
+type errorBreakdown struct {
+	buckets []*pb.ErrorBucket
+}
+
+func (b *errorBreakdown) bucket(code string) *pb.ErrorBucket {
+	for _, bucket := range b.buckets {
+		if bucket.ErrorCode == code {
+			return bucket
+		}
+	}
+	bucket := &pb.ErrorBucket{ErrorCode: code}
+	b.buckets = append(b.buckets, bucket)
+	return bucket
+}
+
+func (b *errorBreakdown) add(err error, id strfmt.UUID) {
+	bucket := b.bucket(batchDeleteErrorCode(err))
+	bucket.Count++
+	if bucket.SampleUuid == nil {
+		if parsed, err := uuid.Parse(id.String()); err == nil {
+			bucket.SampleUuid = parsed[:]
+		}
+	}
+}
+
+func (b *errorBreakdown) merge(buckets []*pb.ErrorBucket) {
+	for _, other := range buckets {
+		bucket := b.bucket(other.ErrorCode)
+		bucket.Count += other.Count
+		if bucket.SampleUuid == nil {
+			bucket.SampleUuid = other.SampleUuid
+		}
+	}
+}
@@ -0,0 +1,335 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package v1
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/google/uuid"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/entities/jobs"
+	pb "github.com/weaviate/weaviate/grpc/generated/protocol/v1"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// DefaultBatchDeleteCallbackTimeout is used if the config doesn't set a
+	// callback timeout
+	DefaultBatchDeleteCallbackTimeout = 10 * time.Second
+	// JobIDHeader is the header of the callback of a batch delete job with its
+	// job id
+	JobIDHeader = "x-weaviate-job-id"
+	// batchDeleteJobsShutdownTimeout is how long StopBatchDeleteJobs waits for
+	// running jobs before it cancels them
+	batchDeleteJobsShutdownTimeout = 30 * time.Second
+)
+
+// batchDeleteCallback is the JSON body posted to the callback URL of a batch
+// delete job. Error is set instead of the counts if the delete failed.
+type batchDeleteCallback struct {
+	JobID      string `json:"job_id"`
+	Collection string `json:"collection"`
+	Tenant     string `json:"tenant,omitempty"`
+	RequestID  string `json:"request_id,omitempty"`
+	// Took is in seconds
+	Took       float64 `json:"took"`
+	Matches    int64   `json:"matches"`
+	Successful int64   `json:"successful"`
+	Failed     int64   `json:"failed"`
+	Skipped    int64   `json:"skipped"`
+	Excluded   int64   `json:"excluded"`
+	Error      string  `json:"error,omitempty"`
+}
+
+// batchDeleteJob is a batch delete running in the background, see
+// startBatchDeleteJob
+type batchDeleteJob struct {
+	collection string
+	tenants    []string
+	status     pb.BatchDeleteJobStatus
+	reply      *pb.BatchDeleteReply
+	err        string
+	startedAt  time.Time
+	finishedAt time.Time
+}
+
+// batchDeleteJobs tracks the batch delete jobs of a Service. Jobs which are
+// still running when the service stops are waited for, and canceled if they
+// take too long, so that their callbacks are still posted.
+type batchDeleteJobs struct {
+	tracker *jobs.Tracker[batchDeleteJob]
+	running sync.WaitGroup
+	ctx     context.Context
+	cancel  context.CancelFunc
+}
+
+func newBatchDeleteJobs() *batchDeleteJobs {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &batchDeleteJobs{tracker: jobs.NewTracker[batchDeleteJob](), ctx: ctx, cancel: cancel}
+}
+
+// StopBatchDeleteJobs waits for the running batch delete jobs, jobs still
+// running after batchDeleteJobsShutdownTimeout are canceled. It must be called
+// after the server stopped accepting requests.
+func (s *Service) StopBatchDeleteJobs() {
+	done := make(chan struct{})
+	enterrors.GoWrapper(func() {
+		s.batchDeleteJobs.running.Wait()
+		close(done)
+	}, s.logger)
+
+	select {
+	case <-done:
+	case <-time.After(batchDeleteJobsShutdownTimeout):
+		s.logger.WithField("action", "stop_batch_delete_jobs").
+			Warn("canceling batch delete jobs which are still running")
+		s.batchDeleteJobs.cancel()
+		<-done
+	}
+}
+
+// newBatchDeleteCallbackClient returns the client posting the callbacks of
+// batch delete jobs. It doesn't follow redirects and, unless the host is one
+// of allowedHosts, refuses to connect to loopback, private and link-local
+// addresses, which are checked again on every dial as the host may resolve
+// to another address than when the URL was validated.
+func newBatchDeleteCallbackClient(timeout time.Duration, allowedHosts []string) *http.Client {
+	if timeout <= 0 {
+		timeout = DefaultBatchDeleteCallbackTimeout
+	}
+	dialer := &net.Dialer{Timeout: timeout}
+	restricted := &net.Dialer{Timeout: timeout, Control: func(network, address string, _ syscall.RawConn) error {
+		host, _, err := net.SplitHostPort(address)
+		if err != nil {
+			return err
+		}
+		if ip := net.ParseIP(host); ip == nil || !publicCallbackIP(ip) {
+			return fmt.Errorf("callback address %s is not public", host)
+		}
+		return nil
+	}}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	// a proxy would be dialed instead of the callback host
+	transport.Proxy = nil
+	transport.DialContext = func(ctx context.Context, network, address string) (net.Conn, error) {
+		host, _, err := net.SplitHostPort(address)
+		if err != nil {
+			return nil, err
+		}
+		if callbackHostAllowed(host, allowedHosts) {
+			return dialer.DialContext(ctx, network, address)
+		}
+		return restricted.DialContext(ctx, network, address)
+	}
+	return &http.Client{
+		Timeout:   timeout,
+		Transport: transport,
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+}
+
+// publicCallbackIP returns whether callbacks may be posted to ip
+func publicCallbackIP(ip net.IP) bool {
+	return !ip.IsLoopback() && !ip.IsPrivate() && !ip.IsLinkLocalUnicast() &&
+		!ip.IsLinkLocalMulticast() && !ip.IsInterfaceLocalMulticast() &&
+		!ip.IsMulticast() && !ip.IsUnspecified()
+}
+
+func callbackHostAllowed(host string, allowedHosts []string) bool {
+	return slices.ContainsFunc(allowedHosts, func(allowed string) bool {
+		return strings.EqualFold(strings.TrimSpace(allowed), host)
+	})
+}
+
+// validateCallbackURL checks that raw is an absolute HTTPS URL. With
+// allowedHosts its host must be one of them, otherwise it must only resolve
+// to public addresses.
+func validateCallbackURL(ctx context.Context, raw string, allowedHosts []string) error {
+	u, err := url.Parse(raw)
+	if err != nil {
+		return err
+	}
+	if u.Scheme != "https" {
+		return fmt.Errorf("%q is not an https URL", raw)
+	}
+	host := u.Hostname()
+	if host == "" {
+		return fmt.Errorf("%q has no host", raw)
+	}
+	if len(allowedHosts) > 0 {
+		if !callbackHostAllowed(host, allowedHosts) {
+			return fmt.Errorf("host %q is not an allowed callback host", host)
+		}
+		return nil
+	}
+
+	addrs, err := net.DefaultResolver.LookupIPAddr(ctx, host)
+	if err != nil {
+		return fmt.Errorf("resolve host %q: %w", host, err)
+	}
+	for _, addr := range addrs {
+		if !publicCallbackIP(addr.IP) {
+			return fmt.Errorf("host %q resolves to %s, which is not a public address", host, addr.IP)
+		}
+	}
+	return nil
+}
+
+// startBatchDeleteJob runs the batch delete of req in the background and
+// posts its result to the callback URL of req once it is done. The returned
+// reply only has the job id and the echoed request fields, the job can be
+// polled with GetBatchDeleteJob. It is stored for the claimed idempotency key,
+// if any, so that a retry returns the same job instead of starting another
+// one. The job outlives the call, it is neither canceled with it nor retried
+// if its callback fails.
+func (s *Service) startBatchDeleteJob(ctx context.Context, req *pb.BatchDeleteRequest, tenants []string,
+	claim *idempotencyClaim, run func(ctx context.Context) (*pb.BatchDeleteReply, error),
+) (*pb.BatchDeleteReply, error) {
+	jobID := uuid.New().String()
+	result := &pb.BatchDeleteReply{JobId: jobID}
+	echoBatchDeleteRequest(result, req)
+
+	s.batchDeleteJobs.tracker.Add(jobID, batchDeleteJob{
+		collection: req.Collection,
+		tenants:    tenants,
+		status:     pb.BatchDeleteJobStatus_BATCH_DELETE_JOB_STATUS_RUNNING,
+		startedAt:  time.Now(),
+	})
+	if claim != nil {
+		s.batchDeleteReplies.Put(claim.key, idempotencyRecord{
+			fingerprint: claim.fingerprint,
+			reply:       proto.Clone(result).(*pb.BatchDeleteReply),
+		})
+	}
+
+	jobCtx, cancel := context.WithCancel(context.WithoutCancel(ctx))
+	stop := context.AfterFunc(s.batchDeleteJobs.ctx, cancel)
+	s.batchDeleteJobs.running.Add(1)
+	enterrors.GoWrapper(func() {
+		defer s.batchDeleteJobs.running.Done()
+		defer cancel()
+		defer stop()
+
+		reply, err := run(jobCtx)
+		s.batchDeleteJobs.tracker.Finish(jobID, finishBatchDeleteJob(reply, err))
+		logger := s.logger.WithField("job_id", jobID).WithField("collection", req.Collection)
+		if err != nil {
+			logger.WithError(err).Warn("batch delete job failed")
+		}
+		if err := s.postBatchDeleteCallback(req.CallbackUrl, batchDeleteCallbackBody(jobID, req, reply, err)); err != nil {
+			logger.WithError(err).Warn("batch delete job callback")
+		}
+	}, s.logger)
+
+	return result, nil
+}
+
+func finishBatchDeleteJob(reply *pb.BatchDeleteReply, err error) func(job *batchDeleteJob) {
+	return func(job *batchDeleteJob) {
+		job.finishedAt = time.Now()
+		if err != nil {
+			job.status = pb.BatchDeleteJobStatus_BATCH_DELETE_JOB_STATUS_FAILED
+			job.err = err.Error()
+			return
+		}
+		job.status = pb.BatchDeleteJobStatus_BATCH_DELETE_JOB_STATUS_FINISHED
+		job.reply = proto.Clone(reply).(*pb.BatchDeleteReply)
+		job.reply.Objects = nil
+	}
+}
+
+// GetBatchDeleteJob returns the status of a batch delete started with a
+// callback URL. Jobs are only known to the node they were started on, and
+// only until they are evicted some time after they finished.
+func (s *Service) GetBatchDeleteJob(ctx context.Context, req *pb.GetBatchDeleteJobRequest) (*pb.GetBatchDeleteJobReply, error) {
+	principal, err := s.principalFromContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("extract auth: %w", err)
+	}
+
+	job, ok := s.batchDeleteJobs.tracker.Get(req.JobId)
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "batch delete job %q not found", req.JobId)
+	}
+	if err := s.authorizer.Authorize(principal, authorization.READ, authorization.ShardsData(job.collection, job.tenants...)...); err != nil {
+		return nil, err
+	}
+
+	reply := &pb.GetBatchDeleteJobReply{
+		Status:    job.status,
+		Reply:     job.reply,
+		Error:     job.err,
+		StartedAt: timestamppb.New(job.startedAt),
+	}
+	if !job.finishedAt.IsZero() {
+		reply.FinishedAt = timestamppb.New(job.finishedAt)
+	}
+	return reply, nil
+}
+
+func batchDeleteCallbackBody(jobID string, req *pb.BatchDeleteRequest, reply *pb.BatchDeleteReply, err error) batchDeleteCallback {
+	body := batchDeleteCallback{JobID: jobID, Collection: req.Collection, Tenant: req.GetTenant(), RequestID: req.RequestId}
+	if err != nil {
+		body.Error = err.Error()
+		return body
+	}
+	body.Took = reply.TookDuration.AsDuration().Seconds()
+	body.Matches = reply.Matches
+	body.Successful = reply.Successful
+	body.Failed = reply.Failed
+	body.Skipped = reply.Skipped
+	body.Excluded = reply.Excluded
+	return body
+}
+
+// postBatchDeleteCallback posts body to callbackURL. Responses other than
+// 2xx are errors.
+func (s *Service) postBatchDeleteCallback(callbackURL string, body batchDeleteCallback) error {
+	payload, err := json.Marshal(body)
+	if err != nil {
+		return fmt.Errorf("marshal callback: %w", err)
+	}
+	req, err := http.NewRequest(http.MethodPost, callbackURL, bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(JobIDHeader, body.JobID)
+
+	res, err := s.batchDeleteCallbacks.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	if res.StatusCode < 200 || res.StatusCode > 299 {
+		return fmt.Errorf("callback responded with %s", res.Status)
+	}
+	return nil
+}
@@ -0,0 +1,251 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package v1
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	pb "github.com/weaviate/weaviate/grpc/generated/protocol/v1"
+	"github.com/weaviate/weaviate/usecases/auth/authorization/mocks"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+func TestValidateCallbackURL(t *testing.T) {
+	ctx := context.Background()
+	assert.Nil(t, validateCallbackURL(ctx, "https://93.184.215.14/hooks/delete?token=abc", nil))
+	for _, raw := range []string{
+		"http://example.com/hook", "https:///hook", "example.com/hook", "https://ex ample.com",
+		"https://127.0.0.1/hook", "https://localhost:8443/hook", "https://10.0.0.1/hook",
+		"https://169.254.169.254/latest/meta-data", "https://[::1]/hook", "https://[fe80::1]/hook",
+		"https://0.0.0.0/hook",
+	} {
+		assert.NotNil(t, validateCallbackURL(ctx, raw, nil), raw)
+	}
+
+	t.Run("allowed hosts", func(t *testing.T) {
+		allowed := []string{"hooks.internal", " 10.0.0.1"}
+		assert.Nil(t, validateCallbackURL(ctx, "https://hooks.internal/delete", allowed))
+		assert.Nil(t, validateCallbackURL(ctx, "https://HOOKS.internal:8443/delete", allowed))
+		assert.Nil(t, validateCallbackURL(ctx, "https://10.0.0.1/delete", allowed))
+		assert.ErrorContains(t, validateCallbackURL(ctx, "https://93.184.215.14/delete", allowed),
+			"not an allowed callback host")
+	})
+}
+
+func TestBatchDeleteCallbackClient(t *testing.T) {
+	redirected := false
+	target := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		redirected = true
+	}))
+	defer target.Close()
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, target.URL, http.StatusTemporaryRedirect)
+	}))
+	defer srv.Close()
+
+	post := func(client *http.Client) (*http.Response, error) {
+		client.Transport.(*http.Transport).TLSClientConfig = srv.Client().Transport.(*http.Transport).TLSClientConfig
+		return client.Post(srv.URL, "application/json", nil)
+	}
+
+	t.Run("private addresses are refused on dial", func(t *testing.T) {
+		_, err := post(newBatchDeleteCallbackClient(time.Second, nil))
+		assert.ErrorContains(t, err, "is not public")
+	})
+
+	t.Run("redirects are not followed", func(t *testing.T) {
+		res, err := post(newBatchDeleteCallbackClient(time.Second, []string{"127.0.0.1"}))
+		require.Nil(t, err)
+		defer res.Body.Close()
+		assert.Equal(t, http.StatusTemporaryRedirect, res.StatusCode)
+		assert.False(t, redirected)
+	})
+
+	t.Run("default timeout", func(t *testing.T) {
+		assert.Equal(t, DefaultBatchDeleteCallbackTimeout, newBatchDeleteCallbackClient(0, nil).Timeout)
+	})
+}
+
+func TestBatchDeleteJob(t *testing.T) {
+	type callback struct {
+		jobID string
+		body  batchDeleteCallback
+	}
+	callbacks := make(chan callback, 1)
+	var statusCode int
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body batchDeleteCallback
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Nil(t, json.NewDecoder(r.Body).Decode(&body))
+		w.WriteHeader(statusCode)
+		callbacks <- callback{jobID: r.Header.Get(JobIDHeader), body: body}
+	}))
+	defer srv.Close()
+
+	logger, hook := test.NewNullLogger()
+	s := &Service{
+		logger: logger, allowAnonymousAccess: true, authorizer: mocks.NewMockAuthorizer(),
+		batchDeleteCallbacks: srv.Client(),
+		batchDeleteJobs:      newBatchDeleteJobs(), batchDeleteReplies: newLRUIdempotencyStore(time.Hour, 10),
+	}
+	req := &pb.BatchDeleteRequest{Collection: "C", RequestId: "r1", CallbackUrl: srv.URL}
+	start := func(ctx context.Context, req *pb.BatchDeleteRequest,
+		run func(ctx context.Context) (*pb.BatchDeleteReply, error),
+	) (*pb.BatchDeleteReply, error) {
+		var claim *idempotencyClaim
+		if req.IdempotencyKey != "" {
+			var err error
+			claim, _, err = claimIdempotencyKey(ctx, s.batchDeleteReplies, nil, req, []byte("fingerprint"))
+			require.Nil(t, err)
+		}
+		return s.startBatchDeleteJob(ctx, req, []string{""}, claim, run)
+	}
+
+	t.Run("result is posted", func(t *testing.T) {
+		statusCode = http.StatusNoContent
+		started := make(chan struct{})
+		reply, err := start(context.Background(), req, func(ctx context.Context) (*pb.BatchDeleteReply, error) {
+			<-started
+			return &pb.BatchDeleteReply{
+				Matches: 3, Successful: 2, Failed: 1,
+				TookDuration: durationpb.New(1500 * time.Millisecond),
+			}, nil
+		})
+		require.Nil(t, err)
+		require.NotEmpty(t, reply.JobId)
+		assert.Equal(t, "C", reply.Collection)
+		assert.Equal(t, "r1", reply.RequestId)
+		assert.Zero(t, reply.Matches, "the reply is returned before the delete is done")
+		close(started)
+
+		cb := <-callbacks
+		assert.Equal(t, reply.JobId, cb.jobID)
+		assert.Equal(t, batchDeleteCallback{
+			JobID: reply.JobId, Collection: "C", RequestID: "r1",
+			Took: 1.5, Matches: 3, Successful: 2, Failed: 1,
+		}, cb.body)
+
+		job, err := s.GetBatchDeleteJob(context.Background(), &pb.GetBatchDeleteJobRequest{JobId: reply.JobId})
+		require.Nil(t, err)
+		assert.Equal(t, pb.BatchDeleteJobStatus_BATCH_DELETE_JOB_STATUS_FINISHED, job.Status)
+		assert.Equal(t, int64(3), job.Reply.Matches)
+		assert.NotNil(t, job.FinishedAt)
+	})
+
+	t.Run("status of a running job", func(t *testing.T) {
+		statusCode = http.StatusOK
+		done := make(chan struct{})
+		reply, err := start(context.Background(), req, func(ctx context.Context) (*pb.BatchDeleteReply, error) {
+			<-done
+			return nil, errors.New("shard is read-only")
+		})
+		require.Nil(t, err)
+
+		job, err := s.GetBatchDeleteJob(context.Background(), &pb.GetBatchDeleteJobRequest{JobId: reply.JobId})
+		require.Nil(t, err)
+		assert.Equal(t, pb.BatchDeleteJobStatus_BATCH_DELETE_JOB_STATUS_RUNNING, job.Status)
+		assert.Nil(t, job.FinishedAt)
+
+		close(done)
+		<-callbacks
+		job, err = s.GetBatchDeleteJob(context.Background(), &pb.GetBatchDeleteJobRequest{JobId: reply.JobId})
+		require.Nil(t, err)
+		assert.Equal(t, pb.BatchDeleteJobStatus_BATCH_DELETE_JOB_STATUS_FAILED, job.Status)
+		assert.Equal(t, "shard is read-only", job.Error)
+	})
+
+	t.Run("unknown job", func(t *testing.T) {
+		_, err := s.GetBatchDeleteJob(context.Background(), &pb.GetBatchDeleteJobRequest{JobId: "unknown"})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+
+	t.Run("retry with idempotency key returns the job", func(t *testing.T) {
+		statusCode = http.StatusOK
+		req := &pb.BatchDeleteRequest{Collection: "C", CallbackUrl: srv.URL, IdempotencyKey: "k1"}
+		reply, err := start(context.Background(), req, func(ctx context.Context) (*pb.BatchDeleteReply, error) {
+			return &pb.BatchDeleteReply{}, nil
+		})
+		require.Nil(t, err)
+		<-callbacks
+
+		_, cached, err := claimIdempotencyKey(context.Background(), s.batchDeleteReplies, nil, req, []byte("fingerprint"))
+		require.Nil(t, err)
+		require.NotNil(t, cached)
+		assert.Equal(t, reply.JobId, cached.JobId)
+	})
+
+	t.Run("failed delete", func(t *testing.T) {
+		statusCode = http.StatusOK
+		reply, err := start(context.Background(), req, func(ctx context.Context) (*pb.BatchDeleteReply, error) {
+			return nil, errors.New("shard is read-only")
+		})
+		require.Nil(t, err)
+
+		cb := <-callbacks
+		assert.Equal(t, reply.JobId, cb.body.JobID)
+		assert.Equal(t, "shard is read-only", cb.body.Error)
+		assert.Zero(t, cb.body.Matches)
+	})
+
+	t.Run("failed callback is logged", func(t *testing.T) {
+		statusCode = http.StatusInternalServerError
+		hook.Reset()
+		_, err := start(context.Background(), req, func(ctx context.Context) (*pb.BatchDeleteReply, error) {
+			return &pb.BatchDeleteReply{}, nil
+		})
+		require.Nil(t, err)
+
+		<-callbacks
+		require.Eventually(t, func() bool { return hook.LastEntry() != nil }, 5*time.Second, time.Millisecond)
+		assert.Equal(t, "batch delete job callback", hook.LastEntry().Message)
+		assert.ErrorContains(t, hook.LastEntry().Data["error"].(error), "500")
+	})
+
+	t.Run("job is not canceled with the call", func(t *testing.T) {
+		statusCode = http.StatusOK
+		ctx, cancel := context.WithCancel(context.Background())
+		done := make(chan error, 1)
+		_, err := start(ctx, req, func(ctx context.Context) (*pb.BatchDeleteReply, error) {
+			<-done
+			return &pb.BatchDeleteReply{}, ctx.Err()
+		})
+		require.Nil(t, err)
+		cancel()
+		close(done)
+
+		assert.Empty(t, (<-callbacks).body.Error)
+	})
+
+	t.Run("running jobs are canceled on shutdown", func(t *testing.T) {
+		statusCode = http.StatusOK
+		_, err := start(context.Background(), req, func(ctx context.Context) (*pb.BatchDeleteReply, error) {
+			<-ctx.Done()
+			return nil, ctx.Err()
+		})
+		require.Nil(t, err)
+		s.batchDeleteJobs.cancel()
+
+		assert.Equal(t, context.Canceled.Error(), (<-callbacks).body.Error)
+		s.StopBatchDeleteJobs()
+	})
+}
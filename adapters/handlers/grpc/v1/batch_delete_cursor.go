@@ -0,0 +1,163 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package v1
+
+import (
+	"context"
+	"net"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/weaviate/weaviate/entities/models"
+	pb "github.com/weaviate/weaviate/grpc/generated/protocol/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	// DefaultBatchDeleteCursorTTL is used if the config doesn't set a TTL
+	DefaultBatchDeleteCursorTTL = 60 * time.Second
+	// maxBatchDeleteCursorBytes bounds the memory used for the remaining
+	// objects, the least recently used cursors are evicted first
+	maxBatchDeleteCursorBytes = 256 * 1024 * 1024
+	// batchDeletePageSize is the number of objects in a page of a verbose
+	// batch delete reply
+	batchDeletePageSize = 10_000
+)
+
+// batchDeleteCursor holds the objects of a verbose batch delete reply which
+// did not fit into a single page
+type batchDeleteCursor struct {
+	// owner is the user of the batch delete, only they may fetch its pages,
+	// see requestOwner
+	owner string
+	// tenants are the tenants of the batch delete. The user must still be
+	// allowed to delete in them and in the collection of summary to fetch a
+	// page.
+	tenants []string
+	// summary is the reply without its objects, it is repeated on every page
+	summary *pb.BatchDeleteReply
+	objects []*pb.BatchDeleteObject
+	// size is the encoded size of objects in bytes
+	size int
+}
+
+// batchDeleteCursors splits verbose batch delete replies into pages. The
+// remaining objects of a reply are kept for ttl after a page was returned.
+// Cursors are kept in memory of the node which ran the batch delete, so the
+// pages must be fetched from that node.
+type batchDeleteCursors struct {
+	store    *lruStore[*batchDeleteCursor]
+	pageSize int
+}
+
+// newBatchDeleteCursors returns cursors holding remaining objects of at most
+// maxBytes bytes in total
+func newBatchDeleteCursors(ttl time.Duration, maxBytes, pageSize int) *batchDeleteCursors {
+	if ttl <= 0 {
+		ttl = DefaultBatchDeleteCursorTTL
+	}
+	store := newWeightedLRUStore(ttl, maxBytes, func(c *batchDeleteCursor) int { return c.size })
+	return &batchDeleteCursors{store: store, pageSize: pageSize}
+}
+
+// firstPage cuts the objects of reply down to the first page. If there are
+// more, they are kept in a new cursor and reply.PageToken is set.
+func (c *batchDeleteCursors) firstPage(ctx context.Context, principal *models.Principal,
+	tenants []string, reply *pb.BatchDeleteReply,
+) *pb.BatchDeleteReply {
+	if len(reply.Objects) <= c.pageSize {
+		return reply
+	}
+
+	summary := proto.Clone(reply).(*pb.BatchDeleteReply)
+	summary.Objects = nil
+	size := 0
+	for _, obj := range reply.Objects {
+		size += proto.Size(obj)
+	}
+	id := uuid.New().String()
+	c.store.Put(id, &batchDeleteCursor{
+		owner: requestOwner(ctx, principal), tenants: tenants,
+		summary: summary, objects: reply.Objects, size: size,
+	})
+
+	reply.Objects = reply.Objects[:c.pageSize]
+	reply.PageToken = pageToken(id, c.pageSize)
+	return reply
+}
+
+// nextPage returns the page of token. Tokens of expired or evicted cursors,
+// of cursors of other nodes and of cursors of other users are reported as not
+// found. Authorize is called with the collection and the tenants of the
+// cursor before a page is returned. The cursor is dropped once its last page
+// was returned.
+func (c *batchDeleteCursors) nextPage(ctx context.Context, principal *models.Principal, req *pb.BatchDeleteRequest,
+	authorize func(collection string, tenants []string) error,
+) (*pb.BatchDeleteReply, error) {
+	id, offset, err := parsePageToken(req.PageToken)
+	if err != nil {
+		return nil, err
+	}
+	cursor, ok := c.store.Get(id)
+	if !ok || cursor.owner != requestOwner(ctx, principal) || offset > len(cursor.objects) {
+		return nil, status.Errorf(codes.NotFound, "page token %q is unknown or expired on this node", req.PageToken)
+	}
+	if err := authorize(cursor.summary.Collection, cursor.tenants); err != nil {
+		return nil, err
+	}
+
+	reply := proto.Clone(cursor.summary).(*pb.BatchDeleteReply)
+	reply.RequestId = req.RequestId
+	end := min(offset+c.pageSize, len(cursor.objects))
+	reply.Objects = cursor.objects[offset:end]
+	if end < len(cursor.objects) {
+		reply.PageToken = pageToken(id, end)
+		// renews the TTL
+		c.store.Put(id, cursor)
+	} else {
+		c.store.Delete(id)
+	}
+	return reply, nil
+}
+
+// requestOwner identifies the user of a request. Anonymous users have no
+// identity of their own, so their cursors are bound to the host they connect
+// from.
+func requestOwner(ctx context.Context, principal *models.Principal) string {
+	if principal != nil {
+		return "user:" + principal.Username
+	}
+	host := peerAddress(ctx)
+	if h, _, err := net.SplitHostPort(host); err == nil {
+		host = h
+	}
+	return "anonymous:" + host
+}
+
+// pageToken identifies the page of a cursor starting at offset, so that
+// retries of a page return the same objects
+func pageToken(id string, offset int) string {
+	return id + ":" + strconv.Itoa(offset)
+}
+
+func parsePageToken(token string) (string, int, error) {
+	id, offsetStr, ok := strings.Cut(token, ":")
+	offset, err := strconv.Atoi(offsetStr)
+	if !ok || err != nil || offset < 0 {
+		return "", 0, status.Errorf(codes.InvalidArgument, "invalid page token %q", token)
+	}
+	return id, offset, nil
+}
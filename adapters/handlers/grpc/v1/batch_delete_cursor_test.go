@@ -0,0 +1,164 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package v1
+
+import (
+	"context"
+	"errors"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+	pb "github.com/weaviate/weaviate/grpc/generated/protocol/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestBatchDeleteCursors(t *testing.T) {
+	ctx := context.Background()
+	alice := &models.Principal{Username: "alice"}
+	allow := func(string, []string) error { return nil }
+	verboseReply := func(n int) *pb.BatchDeleteReply {
+		reply := &pb.BatchDeleteReply{Matches: int64(n), Successful: int64(n), Collection: "C", RequestId: "first"}
+		for i := 0; i < n; i++ {
+			reply.Objects = append(reply.Objects, &pb.BatchDeleteObject{UuidFormat: &pb.BatchDeleteObject_Uuid{Uuid: []byte{byte(i)}}, Successful: true})
+		}
+		return reply
+	}
+	uuids := func(reply *pb.BatchDeleteReply) []byte {
+		var res []byte
+		for _, obj := range reply.Objects {
+			res = append(res, obj.GetUuid()...)
+		}
+		return res
+	}
+
+	t.Run("small replies are not paginated", func(t *testing.T) {
+		cursors := newBatchDeleteCursors(time.Minute, 1<<20, 2)
+		reply := cursors.firstPage(ctx, alice, nil, verboseReply(2))
+		assert.Empty(t, reply.PageToken)
+		assert.Len(t, reply.Objects, 2)
+		assert.Empty(t, cursors.store.entries)
+	})
+
+	t.Run("all pages", func(t *testing.T) {
+		cursors := newBatchDeleteCursors(time.Minute, 1<<20, 2)
+		reply := cursors.firstPage(ctx, alice, nil, verboseReply(5))
+		assert.Equal(t, []byte{0, 1}, uuids(reply))
+		require.NotEmpty(t, reply.PageToken)
+
+		token := reply.PageToken
+		reply, err := cursors.nextPage(ctx, alice, &pb.BatchDeleteRequest{PageToken: token, RequestId: "second"}, allow)
+		require.Nil(t, err)
+		assert.Equal(t, []byte{2, 3}, uuids(reply))
+		assert.Equal(t, int64(5), reply.Matches)
+		assert.Equal(t, "C", reply.Collection)
+		assert.Equal(t, "second", reply.RequestId)
+
+		// a retry returns the same page
+		retry, err := cursors.nextPage(ctx, alice, &pb.BatchDeleteRequest{PageToken: token}, allow)
+		require.Nil(t, err)
+		assert.Equal(t, uuids(reply), uuids(retry))
+		assert.Equal(t, reply.PageToken, retry.PageToken)
+
+		reply, err = cursors.nextPage(ctx, alice, &pb.BatchDeleteRequest{PageToken: reply.PageToken}, allow)
+		require.Nil(t, err)
+		assert.Equal(t, []byte{4}, uuids(reply))
+		assert.Empty(t, reply.PageToken)
+		assert.Empty(t, cursors.store.entries, "cursor is dropped after the last page")
+	})
+
+	t.Run("expired cursor", func(t *testing.T) {
+		now := time.Now()
+		cursors := newBatchDeleteCursors(time.Minute, 1<<20, 2)
+		cursors.store.now = func() time.Time { return now }
+		reply := cursors.firstPage(ctx, alice, nil, verboseReply(5))
+
+		now = now.Add(time.Minute)
+		_, err := cursors.nextPage(ctx, alice, &pb.BatchDeleteRequest{PageToken: reply.PageToken}, allow)
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+
+	t.Run("cursor of another user", func(t *testing.T) {
+		cursors := newBatchDeleteCursors(time.Minute, 1<<20, 2)
+		reply := cursors.firstPage(ctx, alice, nil, verboseReply(5))
+
+		for _, principal := range []*models.Principal{nil, {Username: "bob"}} {
+			_, err := cursors.nextPage(ctx, principal, &pb.BatchDeleteRequest{PageToken: reply.PageToken}, allow)
+			assert.Equal(t, codes.NotFound, status.Code(err))
+		}
+	})
+
+	t.Run("anonymous users on other hosts", func(t *testing.T) {
+		fromHost := func(addr string) context.Context {
+			return peer.NewContext(ctx, &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP(addr), Port: 4000}})
+		}
+		cursors := newBatchDeleteCursors(time.Minute, 1<<20, 2)
+		reply := cursors.firstPage(fromHost("10.0.0.1"), nil, nil, verboseReply(5))
+
+		_, err := cursors.nextPage(fromHost("10.0.0.2"), nil, &pb.BatchDeleteRequest{PageToken: reply.PageToken}, allow)
+		assert.Equal(t, codes.NotFound, status.Code(err))
+		_, err = cursors.nextPage(fromHost("10.0.0.1"), nil, &pb.BatchDeleteRequest{PageToken: reply.PageToken}, allow)
+		assert.Nil(t, err)
+	})
+
+	t.Run("pages are authorized against the collection of the cursor", func(t *testing.T) {
+		cursors := newBatchDeleteCursors(time.Minute, 1<<20, 2)
+		reply := cursors.firstPage(ctx, alice, []string{"t1"}, verboseReply(5))
+
+		forbidden := errors.New("forbidden")
+		var collection string
+		var tenants []string
+		_, err := cursors.nextPage(ctx, alice, &pb.BatchDeleteRequest{PageToken: reply.PageToken},
+			func(c string, t []string) error {
+				collection, tenants = c, t
+				return forbidden
+			})
+		assert.ErrorIs(t, err, forbidden)
+		assert.Equal(t, "C", collection)
+		assert.Equal(t, []string{"t1"}, tenants)
+	})
+
+	t.Run("memory is bounded by bytes", func(t *testing.T) {
+		first := verboseReply(5)
+		size := 0
+		for _, obj := range first.Objects {
+			size += proto.Size(obj)
+		}
+		cursors := newBatchDeleteCursors(time.Minute, size, 2)
+		first = cursors.firstPage(ctx, alice, nil, first)
+		second := cursors.firstPage(ctx, alice, nil, verboseReply(5))
+
+		_, err := cursors.nextPage(ctx, alice, &pb.BatchDeleteRequest{PageToken: first.PageToken}, allow)
+		assert.Equal(t, codes.NotFound, status.Code(err), "least recently used cursor is evicted")
+		_, err = cursors.nextPage(ctx, alice, &pb.BatchDeleteRequest{PageToken: second.PageToken}, allow)
+		assert.Nil(t, err)
+	})
+
+	t.Run("invalid token", func(t *testing.T) {
+		cursors := newBatchDeleteCursors(time.Minute, 1<<20, 2)
+		for _, token := range []string{"abc", "abc:x", "abc:-1"} {
+			_, err := cursors.nextPage(ctx, alice, &pb.BatchDeleteRequest{PageToken: token}, allow)
+			assert.Equal(t, codes.InvalidArgument, status.Code(err), token)
+		}
+	})
+
+	t.Run("default ttl", func(t *testing.T) {
+		cursors := newBatchDeleteCursors(0, maxBatchDeleteCursorBytes, batchDeletePageSize)
+		assert.Equal(t, DefaultBatchDeleteCursorTTL, cursors.store.ttl)
+	})
+}
@@ -0,0 +1,192 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package v1
+
+import (
+	"container/heap"
+	"context"
+	"sync"
+
+	pb "github.com/weaviate/weaviate/grpc/generated/protocol/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// PriorityDeleteQueue limits the number of batch deletes running at a time.
+// Deletes which can't run yet wait in a queue per priority, higher priorities
+// are run first and each priority in the order it was queued. High priority
+// deletes bypass the queue and the limit. Low priority deletes only use a
+// share of the limit, so that they leave storage I/O to queries and other
+// deletes. Without a limit all deletes run right away.
+type PriorityDeleteQueue struct {
+	sync.Mutex
+	maxConcurrent int
+	maxLow        int
+	maxDepth      int
+
+	running    int
+	runningLow int
+	queued     map[pb.BatchDeletePriority]int
+	waiting    deleteWaiters
+	seq        uint64
+}
+
+// NewPriorityDeleteQueue runs at most maxConcurrent deletes at a time, of
+// which lowPercent may be low priority ones, but at least one. Each priority
+// queues at most maxDepth deletes. A maxConcurrent <= 0 doesn't limit the
+// deletes, a maxDepth <= 0 doesn't limit the queues.
+func NewPriorityDeleteQueue(maxConcurrent, maxDepth, lowPercent int) *PriorityDeleteQueue {
+	if maxConcurrent <= 0 {
+		return &PriorityDeleteQueue{queued: map[pb.BatchDeletePriority]int{}}
+	}
+	return &PriorityDeleteQueue{
+		maxConcurrent: maxConcurrent,
+		maxLow:        min(max(maxConcurrent*lowPercent/100, 1), maxConcurrent),
+		maxDepth:      maxDepth,
+		queued:        map[pb.BatchDeletePriority]int{},
+	}
+}
+
+// Acquire blocks until a delete of priority may run and returns the func to
+// call once it is done. It fails with ResourceExhausted if the queue of
+// priority is full, or with the error of ctx if it is done before.
+func (q *PriorityDeleteQueue) Acquire(ctx context.Context, priority pb.BatchDeletePriority) (func(), error) {
+	priority = normalizedPriority(priority)
+	if q.maxConcurrent <= 0 {
+		return func() {}, nil
+	}
+
+	q.Lock()
+	// deletes of the same or a higher priority which wait already go first
+	if priority == pb.BatchDeletePriority_BATCH_DELETE_PRIORITY_HIGH ||
+		(q.canRun(priority) && (len(q.waiting) == 0 || q.waiting[0].priority < priority)) {
+		q.start(priority)
+		q.Unlock()
+		return q.releaseFunc(priority), nil
+	}
+	if q.maxDepth > 0 && q.queued[priority] >= q.maxDepth {
+		q.Unlock()
+		return nil, status.Errorf(codes.ResourceExhausted,
+			"batch delete queue for priority %s is full, %d deletes are waiting", priority, q.maxDepth)
+	}
+	w := &deleteWaiter{priority: priority, seq: q.seq, ready: make(chan struct{})}
+	q.seq++
+	q.queued[priority]++
+	heap.Push(&q.waiting, w)
+	q.Unlock()
+
+	select {
+	case <-w.ready:
+		return q.releaseFunc(priority), nil
+	case <-ctx.Done():
+		q.Lock()
+		defer q.Unlock()
+		if w.index >= 0 {
+			heap.Remove(&q.waiting, w.index)
+			q.queued[priority]--
+		} else {
+			// it was started concurrently
+			q.finish(priority)
+		}
+		return nil, ctx.Err()
+	}
+}
+
+func (q *PriorityDeleteQueue) releaseFunc(priority pb.BatchDeletePriority) func() {
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			q.Lock()
+			defer q.Unlock()
+			q.finish(priority)
+		})
+	}
+}
+
+func (q *PriorityDeleteQueue) canRun(priority pb.BatchDeletePriority) bool {
+	if q.running >= q.maxConcurrent {
+		return false
+	}
+	return priority != pb.BatchDeletePriority_BATCH_DELETE_PRIORITY_LOW || q.runningLow < q.maxLow
+}
+
+func (q *PriorityDeleteQueue) start(priority pb.BatchDeletePriority) {
+	q.running++
+	if priority == pb.BatchDeletePriority_BATCH_DELETE_PRIORITY_LOW {
+		q.runningLow++
+	}
+}
+
+// finish marks a delete of priority as done and starts the waiting deletes
+// which may run now. Must be called with the lock held.
+func (q *PriorityDeleteQueue) finish(priority pb.BatchDeletePriority) {
+	q.running--
+	if priority == pb.BatchDeletePriority_BATCH_DELETE_PRIORITY_LOW {
+		q.runningLow--
+	}
+	for len(q.waiting) > 0 && q.canRun(q.waiting[0].priority) {
+		w := heap.Pop(&q.waiting).(*deleteWaiter)
+		q.queued[w.priority]--
+		q.start(w.priority)
+		close(w.ready)
+	}
+}
+
+// normalizedPriority treats an unspecified priority as normal
+func normalizedPriority(priority pb.BatchDeletePriority) pb.BatchDeletePriority {
+	switch priority {
+	case pb.BatchDeletePriority_BATCH_DELETE_PRIORITY_LOW, pb.BatchDeletePriority_BATCH_DELETE_PRIORITY_HIGH:
+		return priority
+	default:
+		return pb.BatchDeletePriority_BATCH_DELETE_PRIORITY_NORMAL
+	}
+}
+
+type deleteWaiter struct {
+	priority pb.BatchDeletePriority
+	seq      uint64
+	ready    chan struct{}
+	index    int // in deleteWaiters, -1 once popped
+}
+
+// deleteWaiters is a heap.Interface ordered by descending priority and then
+// by the order the waiters were queued in
+type deleteWaiters []*deleteWaiter
+
+func (w deleteWaiters) Len() int { return len(w) }
+
+func (w deleteWaiters) Less(i, j int) bool {
+	if w[i].priority != w[j].priority {
+		return w[i].priority > w[j].priority
+	}
+	return w[i].seq < w[j].seq
+}
+
+func (w deleteWaiters) Swap(i, j int) {
+	w[i], w[j] = w[j], w[i]
+	w[i].index, w[j].index = i, j
+}
+
+func (w *deleteWaiters) Push(x any) {
+	waiter := x.(*deleteWaiter)
+	waiter.index = len(*w)
+	*w = append(*w, waiter)
+}
+
+func (w *deleteWaiters) Pop() any {
+	old := *w
+	waiter := old[len(old)-1]
+	old[len(old)-1] = nil
+	waiter.index = -1
+	*w = old[:len(old)-1]
+	return waiter
+}
@@ -0,0 +1,183 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package v1
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	pb "github.com/weaviate/weaviate/grpc/generated/protocol/v1"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
+	"github.com/weaviate/weaviate/usecases/auth/authorization/mocks"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestPriorityDeleteQueue(t *testing.T) {
+	const (
+		low    = pb.BatchDeletePriority_BATCH_DELETE_PRIORITY_LOW
+		normal = pb.BatchDeletePriority_BATCH_DELETE_PRIORITY_NORMAL
+		high   = pb.BatchDeletePriority_BATCH_DELETE_PRIORITY_HIGH
+	)
+	ctx := context.Background()
+
+	// acquire queues a delete and returns a channel receiving its release
+	// func once it runs
+	acquire := func(t *testing.T, q *PriorityDeleteQueue, priority pb.BatchDeletePriority) <-chan func() {
+		started := make(chan func(), 1)
+		go func() {
+			release, err := q.Acquire(ctx, priority)
+			if err == nil {
+				started <- release
+			}
+		}()
+		require.Eventually(t, func() bool {
+			q.Lock()
+			defer q.Unlock()
+			return q.queued[normalizedPriority(priority)] > 0 || len(started) > 0
+		}, time.Second, time.Millisecond)
+		return started
+	}
+	running := func(started <-chan func()) func() {
+		select {
+		case release := <-started:
+			return release
+		case <-time.After(time.Second):
+			return nil
+		}
+	}
+
+	t.Run("higher priorities run first", func(t *testing.T) {
+		q := NewPriorityDeleteQueue(1, 10, 100)
+		release, err := q.Acquire(ctx, normal)
+		require.Nil(t, err)
+
+		lowStarted := acquire(t, q, low)
+		normalStarted := acquire(t, q, pb.BatchDeletePriority_BATCH_DELETE_PRIORITY_UNSPECIFIED)
+
+		// high priority deletes don't wait
+		releaseHigh, err := q.Acquire(ctx, high)
+		require.Nil(t, err)
+		releaseHigh()
+		assert.Empty(t, normalStarted)
+
+		release()
+		releaseNormal := running(normalStarted)
+		require.NotNil(t, releaseNormal)
+		assert.Empty(t, lowStarted)
+
+		releaseNormal()
+		releaseLow := running(lowStarted)
+		require.NotNil(t, releaseLow)
+		releaseLow()
+	})
+
+	t.Run("low priority share", func(t *testing.T) {
+		q := NewPriorityDeleteQueue(10, 10, 10)
+		releaseLow, err := q.Acquire(ctx, low)
+		require.Nil(t, err)
+
+		lowStarted := acquire(t, q, low)
+		// normal priority deletes may use the remaining slots
+		releaseNormal, err := q.Acquire(ctx, normal)
+		require.Nil(t, err)
+		releaseNormal()
+		assert.Empty(t, lowStarted)
+
+		releaseLow()
+		release := running(lowStarted)
+		require.NotNil(t, release)
+		release()
+	})
+
+	t.Run("full queue", func(t *testing.T) {
+		q := NewPriorityDeleteQueue(1, 1, 100)
+		release, err := q.Acquire(ctx, normal)
+		require.Nil(t, err)
+		normalStarted := acquire(t, q, normal)
+
+		_, err = q.Acquire(ctx, normal)
+		assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+		// the queues are per priority
+		lowStarted := acquire(t, q, low)
+
+		release()
+		release = running(normalStarted)
+		require.NotNil(t, release)
+		release()
+		release = running(lowStarted)
+		require.NotNil(t, release)
+		release()
+	})
+
+	t.Run("unlimited", func(t *testing.T) {
+		q := NewPriorityDeleteQueue(0, 0, 10)
+		for i := 0; i < 10; i++ {
+			_, err := q.Acquire(ctx, low)
+			require.Nil(t, err)
+		}
+	})
+
+	t.Run("unlimited queue", func(t *testing.T) {
+		q := NewPriorityDeleteQueue(1, 0, 100)
+		release, err := q.Acquire(ctx, normal)
+		require.Nil(t, err)
+		var queued []<-chan func()
+		for i := 0; i < 3; i++ {
+			queued = append(queued, acquire(t, q, normal))
+		}
+		for _, started := range queued {
+			release()
+			release = running(started)
+			require.NotNil(t, release)
+		}
+		release()
+	})
+
+	t.Run("canceled while queued", func(t *testing.T) {
+		q := NewPriorityDeleteQueue(1, 1, 100)
+		release, err := q.Acquire(ctx, normal)
+		require.Nil(t, err)
+
+		canceled, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+		defer cancel()
+		_, err = q.Acquire(canceled, normal)
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+		release()
+
+		// the canceled delete neither occupies the queue nor a slot
+		release, err = q.Acquire(ctx, normal)
+		require.Nil(t, err)
+		release()
+		assert.Equal(t, 0, q.running)
+	})
+}
+
+func TestBatchDeleteHighPriorityRequiresAdmin(t *testing.T) {
+	forbidden := errors.New("forbidden")
+	authorizer := mocks.NewAuthorizer(t)
+	authorizer.On("Authorize", mock.Anything, authorization.DELETE, mock.Anything).Return(nil)
+	authorizer.On("Authorize", mock.Anything, authorization.UPDATE, authorization.Cluster()).Return(forbidden)
+	s := &Service{allowAnonymousAccess: true, authorizer: authorizer}
+
+	_, err := s.batchDelete(context.Background(), &pb.BatchDeleteRequest{
+		Collection:       "C",
+		ConsistencyLevel: pb.ConsistencyLevel_CONSISTENCY_LEVEL_ONE.Enum(),
+		Priority:         pb.BatchDeletePriority_BATCH_DELETE_PRIORITY_HIGH,
+	})
+	assert.ErrorIs(t, err, forbidden)
+}
@@ -0,0 +1,299 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package v1
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	clusterSchema "github.com/weaviate/weaviate/cluster/schema"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/entities/schema"
+	pb "github.com/weaviate/weaviate/grpc/generated/protocol/v1"
+	authErrs "github.com/weaviate/weaviate/usecases/auth/authorization/errors"
+	schemaManager "github.com/weaviate/weaviate/usecases/schema"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// DefaultBatchDeleteHistoryPageSize is used for history requests without
+	// page_size
+	DefaultBatchDeleteHistoryPageSize = 100
+	// MaxBatchDeleteHistoryPageSize is the largest page_size of a history
+	// request
+	MaxBatchDeleteHistoryPageSize = 1000
+
+	// the history is replicated to every node and part of the schema
+	// snapshots, so only a bounded summary of each batch delete is recorded
+	// maxRecordedFilterText is the length above which text filter values are
+	// recorded as their hash
+	maxRecordedFilterText = 64
+	// maxRecordedFilterValues is the number of values of array filter values
+	// which are recorded
+	maxRecordedFilterValues = 10
+	// maxRecordedFiltersSize is the encoded size above which the filters are
+	// not recorded at all
+	maxRecordedFiltersSize = 8 << 10
+	// maxRecordedTenantResults is the number of tenant results of the reply
+	// which are recorded
+	maxRecordedTenantResults = 100
+	// batchDeleteHistoryQueueSize is the number of batch deletes waiting to
+	// be recorded, further ones are dropped
+	batchDeleteHistoryQueueSize = 1000
+)
+
+// ListBatchDeleteHistory returns the batch deletes run by any node of the
+// cluster, oldest first
+func (s *Service) ListBatchDeleteHistory(ctx context.Context, req *pb.ListBatchDeleteHistoryRequest) (*pb.ListBatchDeleteHistoryReply, error) {
+	principal, err := s.principalFromContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("extract auth: %w", err)
+	}
+
+	q, err := batchDeleteHistoryQuery(req)
+	if err != nil {
+		return nil, err
+	}
+	entries, more, err := s.schemaManager.ListBatchDeleteHistory(ctx, principal, q)
+	if err != nil {
+		switch {
+		case errors.Is(err, clusterSchema.ErrBadRequest):
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		case errors.As(err, &authErrs.Forbidden{}):
+			return nil, status.Error(codes.PermissionDenied, err.Error())
+		default:
+			return nil, err
+		}
+	}
+
+	reply := &pb.ListBatchDeleteHistoryReply{Entries: make([]*pb.BatchDeleteHistoryEntry, 0, len(entries))}
+	for _, e := range entries {
+		entry, err := batchDeleteHistoryEntryToProto(e)
+		if err != nil {
+			return nil, fmt.Errorf("batch delete history entry %d: %w", e.ID, err)
+		}
+		reply.Entries = append(reply.Entries, entry)
+	}
+	if more && len(entries) > 0 {
+		reply.NextPageToken = strconv.FormatUint(entries[len(entries)-1].ID, 10)
+	}
+	return reply, nil
+}
+
+func batchDeleteHistoryQuery(req *pb.ListBatchDeleteHistoryRequest) (schemaManager.BatchDeleteHistoryQuery, error) {
+	q := schemaManager.BatchDeleteHistoryQuery{Collection: req.Collection, Limit: DefaultBatchDeleteHistoryPageSize}
+	if req.PageSize < 0 || req.PageSize > MaxBatchDeleteHistoryPageSize {
+		return q, status.Errorf(codes.InvalidArgument,
+			"page_size must be between 1 and %d, got %d", MaxBatchDeleteHistoryPageSize, req.PageSize)
+	}
+	if req.PageSize > 0 {
+		q.Limit = int(req.PageSize)
+	}
+	if req.PageToken != "" {
+		after, err := strconv.ParseUint(req.PageToken, 10, 64)
+		if err != nil {
+			return q, status.Errorf(codes.InvalidArgument, "invalid page_token %q", req.PageToken)
+		}
+		q.After = after
+	}
+	if req.FromTime != nil {
+		if err := req.FromTime.CheckValid(); err != nil {
+			return q, status.Errorf(codes.InvalidArgument, "from_time: %v", err)
+		}
+		q.From = req.FromTime.AsTime()
+	}
+	if req.ToTime != nil {
+		if err := req.ToTime.CheckValid(); err != nil {
+			return q, status.Errorf(codes.InvalidArgument, "to_time: %v", err)
+		}
+		q.To = req.ToTime.AsTime()
+	}
+	return q, nil
+}
+
+func batchDeleteHistoryEntryToProto(e schemaManager.BatchDeleteHistoryEntry) (*pb.BatchDeleteHistoryEntry, error) {
+	entry := &pb.BatchDeleteHistoryEntry{
+		Request:     &pb.BatchDeleteRequest{},
+		Reply:       &pb.BatchDeleteReply{},
+		Peer:        e.Peer,
+		StartedAt:   timestamppb.New(e.StartedAt),
+		CompletedAt: timestamppb.New(e.CompletedAt),
+	}
+	if err := proto.Unmarshal(e.Request, entry.Request); err != nil {
+		return nil, fmt.Errorf("decode request: %w", err)
+	}
+	if err := proto.Unmarshal(e.Reply, entry.Reply); err != nil {
+		return nil, fmt.Errorf("decode reply: %w", err)
+	}
+	return entry, nil
+}
+
+// batchDeleteHistoryRecorder adds batch deletes to the history in the
+// background, so that batch deletes don't wait for the history to be
+// replicated
+type batchDeleteHistoryRecorder struct {
+	entries chan schemaManager.BatchDeleteHistoryEntry
+}
+
+func newBatchDeleteHistoryRecorder(record func(context.Context, schemaManager.BatchDeleteHistoryEntry) error,
+	logger logrus.FieldLogger,
+) *batchDeleteHistoryRecorder {
+	r := &batchDeleteHistoryRecorder{
+		entries: make(chan schemaManager.BatchDeleteHistoryEntry, batchDeleteHistoryQueueSize),
+	}
+	enterrors.GoWrapper(func() {
+		for entry := range r.entries {
+			if err := record(context.Background(), entry); err != nil {
+				logger.WithError(err).WithField("collection", entry.Collection).Warn("record batch delete in history")
+			}
+		}
+	}, logger)
+	return r
+}
+
+// add queues entry, it returns false if the queue is full
+func (r *batchDeleteHistoryRecorder) add(entry schemaManager.BatchDeleteHistoryEntry) bool {
+	select {
+	case r.entries <- entry:
+		return true
+	default:
+		return false
+	}
+}
+
+// recordBatchDelete adds a batch delete started at started to the history,
+// with reply or, if the delete failed as a whole, with deleteErr. Only a
+// summary is recorded, see batchDeleteHistorySummary. Failing to record the
+// delete doesn't fail it, the objects are deleted already.
+func (s *Service) recordBatchDelete(req *pb.BatchDeleteRequest, reply *pb.BatchDeleteReply,
+	deleteErr error, peerAddr string, started time.Time,
+) {
+	entry := schemaManager.BatchDeleteHistoryEntry{
+		Collection:  schema.UppercaseClassName(req.Collection),
+		Peer:        peerAddr,
+		StartedAt:   started,
+		CompletedAt: time.Now(),
+	}
+	recorded, recordedReply := batchDeleteHistorySummary(req, reply, deleteErr, s.config.GRPC.BatchDeleteHistoryRedactFilters)
+	var err error
+	if entry.Request, err = proto.Marshal(recorded); err == nil {
+		if entry.Reply, err = proto.Marshal(recordedReply); err == nil && !s.batchDeleteHistory.add(entry) {
+			err = errors.New("too many batch deletes waiting to be recorded")
+		}
+	}
+	if err != nil {
+		s.logger.WithError(err).WithField("collection", req.Collection).Warn("record batch delete in history")
+	}
+}
+
+// batchDeleteHistorySummary returns the request and reply recorded for a
+// batch delete. The exclude_uuids, signature and page token of the request
+// are removed. Long text filter values are replaced by their hash, array
+// values are cut to maxRecordedFilterValues and filters which are still
+// larger than maxRecordedFiltersSize are removed. The reply has neither the
+// objects nor more than maxRecordedTenantResults tenant results.
+func batchDeleteHistorySummary(req *pb.BatchDeleteRequest, reply *pb.BatchDeleteReply, deleteErr error,
+	redactFilters bool,
+) (*pb.BatchDeleteRequest, *pb.BatchDeleteReply) {
+	recorded := proto.Clone(req).(*pb.BatchDeleteRequest)
+	recorded.ExcludeUuids, recorded.Signature, recorded.PageToken = nil, "", ""
+	if redactFilters {
+		redactFilterValues(recorded.Filters)
+	} else {
+		shortenFilterValues(recorded.Filters)
+	}
+	if proto.Size(recorded.Filters) > maxRecordedFiltersSize {
+		recorded.Filters = nil
+	}
+
+	var recordedReply *pb.BatchDeleteReply
+	if deleteErr != nil {
+		recordedReply = &pb.BatchDeleteReply{Error: status.Convert(deleteErr).Message()}
+		echoBatchDeleteRequest(recordedReply, req)
+	} else {
+		recordedReply = proto.Clone(reply).(*pb.BatchDeleteReply)
+		recordedReply.Objects, recordedReply.PageToken = nil, ""
+		if len(recordedReply.TenantResults) > maxRecordedTenantResults {
+			recordedReply.TenantResults = recordedReply.TenantResults[:maxRecordedTenantResults]
+		}
+	}
+	return recorded, recordedReply
+}
+
+// shortenFilterValues replaces the text values of f and its nested filters
+// longer than maxRecordedFilterText by their sha256 hash and cuts array
+// values to maxRecordedFilterValues
+func shortenFilterValues(f *pb.Filters) {
+	if f == nil {
+		return
+	}
+	shorten := func(text string) string {
+		if len(text) <= maxRecordedFilterText {
+			return text
+		}
+		sum := sha256.Sum256([]byte(text))
+		return "sha256:" + hex.EncodeToString(sum[:])
+	}
+	switch value := f.TestValue.(type) {
+	case *pb.Filters_ValueText:
+		value.ValueText = shorten(value.ValueText)
+	case *pb.Filters_ValueTextArray:
+		values := value.ValueTextArray.Values
+		values = values[:min(len(values), maxRecordedFilterValues)]
+		for i := range values {
+			values[i] = shorten(values[i])
+		}
+		value.ValueTextArray.Values = values
+	case *pb.Filters_ValueIntArray:
+		values := value.ValueIntArray.Values
+		value.ValueIntArray.Values = values[:min(len(values), maxRecordedFilterValues)]
+	case *pb.Filters_ValueNumberArray:
+		values := value.ValueNumberArray.Values
+		value.ValueNumberArray.Values = values[:min(len(values), maxRecordedFilterValues)]
+	case *pb.Filters_ValueBooleanArray:
+		values := value.ValueBooleanArray.Values
+		value.ValueBooleanArray.Values = values[:min(len(values), maxRecordedFilterValues)]
+	}
+	for _, nested := range f.Filters {
+		shortenFilterValues(nested)
+	}
+}
+
+// redactFilterValues removes the values of f and its nested filters, the
+// operators and properties are kept
+func redactFilterValues(f *pb.Filters) {
+	if f == nil {
+		return
+	}
+	f.TestValue = nil
+	for _, nested := range f.Filters {
+		redactFilterValues(nested)
+	}
+}
+
+// peerAddress returns the address of the client of ctx, if known
+func peerAddress(ctx context.Context) string {
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		return p.Addr.String()
+	}
+	return ""
+}
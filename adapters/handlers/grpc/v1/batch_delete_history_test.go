@@ -0,0 +1,192 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package v1
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	pb "github.com/weaviate/weaviate/grpc/generated/protocol/v1"
+	schemaManager "github.com/weaviate/weaviate/usecases/schema"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestBatchDeleteHistoryQuery(t *testing.T) {
+	from := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	q, err := batchDeleteHistoryQuery(&pb.ListBatchDeleteHistoryRequest{})
+	require.Nil(t, err)
+	assert.Equal(t, schemaManager.BatchDeleteHistoryQuery{Limit: DefaultBatchDeleteHistoryPageSize}, q)
+
+	q, err = batchDeleteHistoryQuery(&pb.ListBatchDeleteHistoryRequest{
+		Collection: "C",
+		FromTime:   timestamppb.New(from),
+		ToTime:     timestamppb.New(from.Add(time.Hour)),
+		PageToken:  "42",
+		PageSize:   10,
+	})
+	require.Nil(t, err)
+	assert.Equal(t, schemaManager.BatchDeleteHistoryQuery{
+		Collection: "C", From: from, To: from.Add(time.Hour), After: 42, Limit: 10,
+	}, q)
+
+	for _, req := range []*pb.ListBatchDeleteHistoryRequest{
+		{PageSize: -1},
+		{PageSize: MaxBatchDeleteHistoryPageSize + 1},
+		{PageToken: "next"},
+		{FromTime: &timestamppb.Timestamp{Nanos: -1}},
+	} {
+		_, err := batchDeleteHistoryQuery(req)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err), req.String())
+	}
+}
+
+func TestBatchDeleteHistoryEntryToProto(t *testing.T) {
+	started := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	req := &pb.BatchDeleteRequest{Collection: "C", DryRun: true}
+	reply := &pb.BatchDeleteReply{Collection: "C", Matches: 3}
+	encodedReq, err := proto.Marshal(req)
+	require.Nil(t, err)
+	encodedReply, err := proto.Marshal(reply)
+	require.Nil(t, err)
+
+	entry, err := batchDeleteHistoryEntryToProto(schemaManager.BatchDeleteHistoryEntry{
+		ID: 1, Collection: "C", Request: encodedReq, Reply: encodedReply, Peer: "10.0.0.1:5000",
+		StartedAt: started, CompletedAt: started.Add(time.Second),
+	})
+	require.Nil(t, err)
+	assert.True(t, proto.Equal(req, entry.Request))
+	assert.True(t, proto.Equal(reply, entry.Reply))
+	assert.Equal(t, "10.0.0.1:5000", entry.Peer)
+	assert.Equal(t, started, entry.StartedAt.AsTime())
+	assert.Equal(t, started.Add(time.Second), entry.CompletedAt.AsTime())
+
+	_, err = batchDeleteHistoryEntryToProto(schemaManager.BatchDeleteHistoryEntry{Request: []byte{0xff}})
+	assert.ErrorContains(t, err, "decode request")
+}
+
+func TestRedactFilterValues(t *testing.T) {
+	filters := &pb.Filters{
+		Operator: pb.Filters_OPERATOR_AND,
+		Filters: []*pb.Filters{
+			{Operator: pb.Filters_OPERATOR_EQUAL, On: []string{"name"}, TestValue: &pb.Filters_ValueText{ValueText: "secret"}},
+			{Operator: pb.Filters_OPERATOR_GREATER_THAN, On: []string{"age"}, TestValue: &pb.Filters_ValueInt{ValueInt: 42}},
+		},
+	}
+	redactFilterValues(filters)
+	redactFilterValues(nil)
+
+	assert.Equal(t, pb.Filters_OPERATOR_AND, filters.Operator)
+	require.Len(t, filters.Filters, 2)
+	for _, f := range filters.Filters {
+		assert.Nil(t, f.TestValue)
+	}
+	assert.Equal(t, []string{"name"}, filters.Filters[0].On)
+	assert.Equal(t, pb.Filters_OPERATOR_GREATER_THAN, filters.Filters[1].Operator)
+}
+
+func TestBatchDeleteHistorySummary(t *testing.T) {
+	long := strings.Repeat("a", maxRecordedFilterText+1)
+	ints := make([]int64, 2*maxRecordedFilterValues)
+	req := &pb.BatchDeleteRequest{
+		Collection:   "C",
+		ExcludeUuids: [][]byte{make([]byte, 16)},
+		Signature:    "signature",
+		Filters: &pb.Filters{
+			Operator: pb.Filters_OPERATOR_AND,
+			Filters: []*pb.Filters{
+				{Operator: pb.Filters_OPERATOR_EQUAL, TestValue: &pb.Filters_ValueText{ValueText: long}},
+				{Operator: pb.Filters_OPERATOR_EQUAL, TestValue: &pb.Filters_ValueText{ValueText: "short"}},
+				{Operator: pb.Filters_OPERATOR_CONTAINS_ANY, TestValue: &pb.Filters_ValueTextArray{
+					ValueTextArray: &pb.TextArray{Values: []string{long, "short"}},
+				}},
+				{Operator: pb.Filters_OPERATOR_CONTAINS_ANY, TestValue: &pb.Filters_ValueIntArray{
+					ValueIntArray: &pb.IntArray{Values: ints},
+				}},
+			},
+		},
+	}
+	tenantResults := make([]*pb.TenantDeleteSummary, 2*maxRecordedTenantResults)
+	reply := &pb.BatchDeleteReply{Matches: 5, Objects: []*pb.BatchDeleteObject{{}}, TenantResults: tenantResults}
+
+	recorded, recordedReply := batchDeleteHistorySummary(req, reply, nil, false)
+	assert.Empty(t, recorded.ExcludeUuids)
+	assert.Empty(t, recorded.Signature)
+	sum := sha256.Sum256([]byte(long))
+	hashed := "sha256:" + hex.EncodeToString(sum[:])
+	assert.Equal(t, hashed, recorded.Filters.Filters[0].GetValueText())
+	assert.Equal(t, "short", recorded.Filters.Filters[1].GetValueText())
+	assert.Equal(t, []string{hashed, "short"}, recorded.Filters.Filters[2].GetValueTextArray().Values)
+	assert.Len(t, recorded.Filters.Filters[3].GetValueIntArray().Values, maxRecordedFilterValues)
+	assert.Equal(t, int64(5), recordedReply.Matches)
+	assert.Empty(t, recordedReply.Objects)
+	assert.Len(t, recordedReply.TenantResults, maxRecordedTenantResults)
+	// the request and reply are left as they are
+	assert.Len(t, req.ExcludeUuids, 1)
+	assert.Equal(t, long, req.Filters.Filters[0].GetValueText())
+	assert.Len(t, reply.TenantResults, 2*maxRecordedTenantResults)
+
+	t.Run("large filters are left out", func(t *testing.T) {
+		large := &pb.Filters{Operator: pb.Filters_OPERATOR_OR}
+		for i := 0; i < maxRecordedFiltersSize/4; i++ {
+			large.Filters = append(large.Filters, &pb.Filters{
+				Operator: pb.Filters_OPERATOR_EQUAL, TestValue: &pb.Filters_ValueInt{ValueInt: int64(i)},
+			})
+		}
+		recorded, _ := batchDeleteHistorySummary(&pb.BatchDeleteRequest{Filters: large}, reply, nil, false)
+		assert.Nil(t, recorded.Filters)
+	})
+
+	t.Run("failed delete", func(t *testing.T) {
+		_, recordedReply := batchDeleteHistorySummary(req, nil, errors.New("shard is read-only"), true)
+		assert.Equal(t, "shard is read-only", recordedReply.Error)
+		assert.Equal(t, "C", recordedReply.Collection)
+	})
+}
+
+func TestBatchDeleteHistoryRecorder(t *testing.T) {
+	logger, _ := test.NewNullLogger()
+	recorded := make(chan schemaManager.BatchDeleteHistoryEntry)
+	release := make(chan struct{})
+	defer close(release)
+	r := newBatchDeleteHistoryRecorder(func(ctx context.Context, entry schemaManager.BatchDeleteHistoryEntry) error {
+		recorded <- entry
+		<-release
+		return nil
+	}, logger)
+
+	require.True(t, r.add(schemaManager.BatchDeleteHistoryEntry{Collection: "C"}))
+	assert.Equal(t, "C", (<-recorded).Collection)
+	// the first entry is still being recorded while the others fill the queue
+	for i := 0; i < batchDeleteHistoryQueueSize; i++ {
+		require.True(t, r.add(schemaManager.BatchDeleteHistoryEntry{}))
+	}
+	assert.False(t, r.add(schemaManager.BatchDeleteHistoryEntry{}))
+}
+
+func TestPeerAddress(t *testing.T) {
+	assert.Empty(t, peerAddress(context.Background()))
+	ctx := peer.NewContext(context.Background(), &peer.Peer{Addr: &net.TCPAddr{IP: net.IPv4(10, 0, 0, 1), Port: 5000}})
+	assert.Equal(t, "10.0.0.1:5000", peerAddress(ctx))
+}
@@ -0,0 +1,222 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package v1
+
+import (
+	"bytes"
+	"container/list"
+	"context"
+	"sync"
+	"time"
+
+	"github.com/weaviate/weaviate/entities/models"
+	pb "github.com/weaviate/weaviate/grpc/generated/protocol/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	// DefaultBatchDeleteIdempotencyTTL is used if the config doesn't set a TTL
+	DefaultBatchDeleteIdempotencyTTL = 24 * time.Hour
+	// maxIdempotencyRecords bounds the memory used for the replies, the least
+	// recently used ones are evicted first
+	maxIdempotencyRecords = 10_000
+)
+
+// idempotencyRecord is the reply of a batch delete and the fingerprint of its
+// request, see requestFingerprint. The reply is nil while the batch delete is
+// running.
+type idempotencyRecord struct {
+	fingerprint []byte
+	reply       *pb.BatchDeleteReply
+}
+
+// idempotencyStore keeps the replies of batch deletes by their idempotency key
+type idempotencyStore interface {
+	Put(key string, record idempotencyRecord)
+	// PutIfAbsent puts record unless key has a record already, which is
+	// returned instead
+	PutIfAbsent(key string, record idempotencyRecord) (idempotencyRecord, bool)
+	Delete(key string)
+}
+
+// lruIdempotencyStore is an in-memory idempotencyStore holding at most
+// maxIdempotencyRecords records, each for the configured TTL
+type lruIdempotencyStore = lruStore[idempotencyRecord]
+
+func newLRUIdempotencyStore(ttl time.Duration, capacity int) *lruIdempotencyStore {
+	if ttl <= 0 {
+		ttl = DefaultBatchDeleteIdempotencyTTL
+	}
+	return newLRUStore[idempotencyRecord](ttl, capacity)
+}
+
+type lruEntry[V any] struct {
+	key     string
+	value   V
+	weight  int
+	expires time.Time
+}
+
+// lruStore holds values of a total weight of at most capacity, each for ttl
+// after it was put. Every value weighs 1 unless the store has a weight func.
+type lruStore[V any] struct {
+	sync.Mutex
+	ttl      time.Duration
+	capacity int
+	weight   func(V) int
+	used     int
+	now      func() time.Time
+	entries  map[string]*list.Element
+	order    *list.List // front is the most recently used
+}
+
+func newLRUStore[V any](ttl time.Duration, capacity int) *lruStore[V] {
+	return newWeightedLRUStore[V](ttl, capacity, func(V) int { return 1 })
+}
+
+func newWeightedLRUStore[V any](ttl time.Duration, capacity int, weight func(V) int) *lruStore[V] {
+	return &lruStore[V]{
+		ttl:      ttl,
+		capacity: capacity,
+		weight:   weight,
+		now:      time.Now,
+		entries:  map[string]*list.Element{},
+		order:    list.New(),
+	}
+}
+
+func (s *lruStore[V]) Get(key string) (V, bool) {
+	s.Lock()
+	defer s.Unlock()
+
+	var zero V
+	el, ok := s.entries[key]
+	if !ok {
+		return zero, false
+	}
+	entry := el.Value.(*lruEntry[V])
+	if !s.now().Before(entry.expires) {
+		s.remove(el)
+		return zero, false
+	}
+	s.order.MoveToFront(el)
+	return entry.value, true
+}
+
+func (s *lruStore[V]) Put(key string, value V) {
+	s.Lock()
+	defer s.Unlock()
+	s.put(key, value)
+}
+
+func (s *lruStore[V]) PutIfAbsent(key string, value V) (V, bool) {
+	s.Lock()
+	defer s.Unlock()
+
+	if el, ok := s.entries[key]; ok {
+		entry := el.Value.(*lruEntry[V])
+		if s.now().Before(entry.expires) {
+			s.order.MoveToFront(el)
+			return entry.value, true
+		}
+	}
+	s.put(key, value)
+	var zero V
+	return zero, false
+}
+
+func (s *lruStore[V]) put(key string, value V) {
+	entry := &lruEntry[V]{key: key, value: value, weight: s.weight(value), expires: s.now().Add(s.ttl)}
+	if el, ok := s.entries[key]; ok {
+		s.used += entry.weight - el.Value.(*lruEntry[V]).weight
+		el.Value = entry
+		s.order.MoveToFront(el)
+	} else {
+		s.used += entry.weight
+		s.entries[key] = s.order.PushFront(entry)
+	}
+
+	// expired entries are dropped when they are looked up or once they are
+	// the least recently used ones. A value heavier than the capacity is
+	// dropped right away.
+	for s.used > s.capacity {
+		s.remove(s.order.Back())
+	}
+}
+
+func (s *lruStore[V]) Delete(key string) {
+	s.Lock()
+	defer s.Unlock()
+
+	if el, ok := s.entries[key]; ok {
+		s.remove(el)
+	}
+}
+
+func (s *lruStore[V]) remove(el *list.Element) {
+	entry := el.Value.(*lruEntry[V])
+	s.order.Remove(el)
+	s.used -= entry.weight
+	delete(s.entries, entry.key)
+}
+
+// requestFingerprint identifies the parameters of req. The request id and the
+// signature may differ between retries, so they are left out.
+func requestFingerprint(req *pb.BatchDeleteRequest) ([]byte, error) {
+	stripped := proto.Clone(req).(*pb.BatchDeleteRequest)
+	stripped.RequestId = ""
+	stripped.Signature = ""
+	return proto.MarshalOptions{Deterministic: true}.Marshal(stripped)
+}
+
+// idempotencyClaim is held by the batch delete which claimed an idempotency
+// key, see claimIdempotencyKey
+type idempotencyClaim struct {
+	// key is the idempotency key scoped to the user, see idempotencyStoreKey
+	key         string
+	fingerprint []byte
+}
+
+// idempotencyStoreKey scopes key to the user of a request, so that users
+// can't see the replies of each other
+func idempotencyStoreKey(ctx context.Context, principal *models.Principal, key string) string {
+	return requestOwner(ctx, principal) + "/" + key
+}
+
+// claimIdempotencyKey marks the idempotency key of req as in use by a running
+// batch delete. The reply of the delete must be put into the store under the
+// key of the claim, or the key must be deleted if the delete failed. If the
+// key was claimed before, the stored reply is returned instead of a claim. A
+// key which is still in use or which was used for a request with other
+// parameters results in an error.
+func claimIdempotencyKey(ctx context.Context, store idempotencyStore, principal *models.Principal,
+	req *pb.BatchDeleteRequest, fingerprint []byte,
+) (*idempotencyClaim, *pb.BatchDeleteReply, error) {
+	claim := &idempotencyClaim{key: idempotencyStoreKey(ctx, principal, req.IdempotencyKey), fingerprint: fingerprint}
+	record, ok := store.PutIfAbsent(claim.key, idempotencyRecord{fingerprint: fingerprint})
+	if !ok {
+		return claim, nil, nil
+	}
+	if !bytes.Equal(record.fingerprint, fingerprint) {
+		return nil, nil, status.Errorf(codes.AlreadyExists,
+			"idempotency key %q was used for a different batch delete request", req.IdempotencyKey)
+	}
+	if record.reply == nil {
+		return nil, nil, status.Errorf(codes.Aborted,
+			"batch delete with idempotency key %q is still running", req.IdempotencyKey)
+	}
+	reply := proto.Clone(record.reply).(*pb.BatchDeleteReply)
+	reply.RequestId = req.RequestId
+	return nil, reply, nil
+}
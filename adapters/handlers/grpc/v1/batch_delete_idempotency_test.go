@@ -0,0 +1,113 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package v1
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+	pb "github.com/weaviate/weaviate/grpc/generated/protocol/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestLRUIdempotencyStore(t *testing.T) {
+	now := time.Now()
+	store := newLRUIdempotencyStore(time.Minute, 2)
+	store.now = func() time.Time { return now }
+	record := func(matches int64) idempotencyRecord {
+		return idempotencyRecord{reply: &pb.BatchDeleteReply{Matches: matches}}
+	}
+
+	store.Put("a", record(1))
+	store.Put("b", record(2))
+	got, ok := store.Get("a")
+	require.True(t, ok)
+	assert.Equal(t, int64(1), got.reply.Matches)
+
+	// b is the least recently used one
+	store.Put("c", record(3))
+	_, ok = store.Get("b")
+	assert.False(t, ok)
+	_, ok = store.Get("c")
+	assert.True(t, ok)
+
+	now = now.Add(time.Minute)
+	_, ok = store.Get("a")
+	assert.False(t, ok)
+	assert.Equal(t, 1, len(store.entries))
+}
+
+func TestClaimIdempotencyKey(t *testing.T) {
+	ctx := context.Background()
+	alice, bob := &models.Principal{Username: "alice"}, &models.Principal{Username: "bob"}
+	store := newLRUIdempotencyStore(0, maxIdempotencyRecords)
+	assert.Equal(t, DefaultBatchDeleteIdempotencyTTL, store.ttl)
+
+	req := &pb.BatchDeleteRequest{
+		Collection: "C", TenantSelection: &pb.BatchDeleteRequest_Tenant{Tenant: "t1"},
+		IdempotencyKey: "key", RequestId: "first",
+	}
+	fingerprint, err := requestFingerprint(req)
+	require.Nil(t, err)
+
+	claim, reply, err := claimIdempotencyKey(ctx, store, alice, req, fingerprint)
+	require.Nil(t, err)
+	require.NotNil(t, claim)
+	assert.Nil(t, reply)
+
+	t.Run("running", func(t *testing.T) {
+		_, _, err := claimIdempotencyKey(ctx, store, alice, req, fingerprint)
+		assert.Equal(t, codes.Aborted, status.Code(err))
+	})
+
+	t.Run("other user", func(t *testing.T) {
+		claim, reply, err := claimIdempotencyKey(ctx, store, bob, req, fingerprint)
+		require.Nil(t, err)
+		assert.NotNil(t, claim)
+		assert.Nil(t, reply)
+	})
+
+	store.Put(claim.key, idempotencyRecord{
+		fingerprint: fingerprint,
+		reply:       &pb.BatchDeleteReply{Matches: 3, Successful: 3, RequestId: "first"},
+	})
+
+	t.Run("retry", func(t *testing.T) {
+		retry := &pb.BatchDeleteRequest{
+			Collection: "C", TenantSelection: &pb.BatchDeleteRequest_Tenant{Tenant: "t1"},
+			IdempotencyKey: "key", RequestId: "second", Signature: "abc",
+		}
+		fingerprint, err := requestFingerprint(retry)
+		require.Nil(t, err)
+		claim, reply, err := claimIdempotencyKey(ctx, store, alice, retry, fingerprint)
+		require.Nil(t, err)
+		assert.Nil(t, claim)
+		assert.Equal(t, int64(3), reply.Successful)
+		assert.Equal(t, "second", reply.RequestId)
+	})
+
+	t.Run("other tenant", func(t *testing.T) {
+		other := &pb.BatchDeleteRequest{
+			Collection: "C", TenantSelection: &pb.BatchDeleteRequest_Tenant{Tenant: "t2"},
+			IdempotencyKey: "key",
+		}
+		fingerprint, err := requestFingerprint(other)
+		require.Nil(t, err)
+		_, _, err = claimIdempotencyKey(ctx, store, alice, other, fingerprint)
+		assert.Equal(t, codes.AlreadyExists, status.Code(err))
+	})
+}
@@ -0,0 +1,68 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package v1
+
+import (
+	"context"
+
+	pb "github.com/weaviate/weaviate/grpc/generated/protocol/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+// SetBatchDeleteInterceptor sets the interceptor the sub-requests of
+// MultiBatchDelete are passed through, so that they are verified, routed and
+// limited like individual BatchDelete calls. Without one the sub-requests are
+// passed to BatchDelete directly.
+func (s *Service) SetBatchDeleteInterceptor(interceptor grpc.UnaryServerInterceptor) {
+	s.batchDeleteInterceptor = interceptor
+}
+
+// MultiBatchDelete runs the batch deletes of req one after another, to not
+// saturate the disks with deletes of several collections at once, and
+// replies with their replies in the same order. Sub-requests failing as a
+// whole get a reply with only the error set.
+func (s *Service) MultiBatchDelete(ctx context.Context, req *pb.MultiBatchDeleteRequest) (*pb.MultiBatchDeleteReply, error) {
+	result := &pb.MultiBatchDeleteReply{Replies: make([]*pb.BatchDeleteReply, 0, len(req.Requests))}
+	for _, sub := range req.Requests {
+		if err := ctx.Err(); err != nil {
+			return nil, status.FromContextError(err).Err()
+		}
+
+		reply, err := s.interceptedBatchDelete(ctx, sub)
+		if err != nil {
+			reply = &pb.BatchDeleteReply{Error: status.Convert(err).Message()}
+			echoBatchDeleteRequest(reply, sub)
+		}
+		result.Replies = append(result.Replies, reply)
+
+		if req.StopOnFirstError && (err != nil || reply.Failed > 0) {
+			break
+		}
+	}
+	return result, nil
+}
+
+func (s *Service) interceptedBatchDelete(ctx context.Context, req *pb.BatchDeleteRequest) (*pb.BatchDeleteReply, error) {
+	if s.batchDeleteInterceptor == nil {
+		return s.BatchDelete(ctx, req)
+	}
+
+	info := &grpc.UnaryServerInfo{Server: s, FullMethod: "/weaviate.v1.Weaviate/BatchDelete"}
+	resp, err := s.batchDeleteInterceptor(ctx, req, info, func(ctx context.Context, req any) (any, error) {
+		return s.BatchDelete(ctx, req.(*pb.BatchDeleteRequest))
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*pb.BatchDeleteReply), nil
+}
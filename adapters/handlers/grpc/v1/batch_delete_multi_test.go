@@ -0,0 +1,99 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package v1
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	pb "github.com/weaviate/weaviate/grpc/generated/protocol/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestMultiBatchDelete(t *testing.T) {
+	// the interceptor answers the sub-requests without deleting anything,
+	// by collection: "Failing" fails as a whole, "Partial" fails to delete an
+	// object and all others succeed
+	newService := func(seen *[]string) *Service {
+		s := &Service{}
+		s.SetBatchDeleteInterceptor(func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+			require.Equal(t, "/weaviate.v1.Weaviate/BatchDelete", info.FullMethod)
+			r := req.(*pb.BatchDeleteRequest)
+			*seen = append(*seen, r.Collection)
+			switch r.Collection {
+			case "Failing":
+				return nil, status.Error(codes.NotFound, "could not find class Failing in schema")
+			case "Partial":
+				return &pb.BatchDeleteReply{Collection: r.Collection, Matches: 2, Successful: 1, Failed: 1}, nil
+			default:
+				return &pb.BatchDeleteReply{Collection: r.Collection, Matches: 1, Successful: 1}, nil
+			}
+		})
+		return s
+	}
+	requests := func(collections ...string) []*pb.BatchDeleteRequest {
+		reqs := make([]*pb.BatchDeleteRequest, len(collections))
+		for i, c := range collections {
+			reqs[i] = &pb.BatchDeleteRequest{Collection: c, RequestId: "r" + c}
+		}
+		return reqs
+	}
+
+	t.Run("replies in the order of the requests", func(t *testing.T) {
+		var seen []string
+		reply, err := newService(&seen).MultiBatchDelete(context.Background(), &pb.MultiBatchDeleteRequest{
+			Requests: requests("A", "Failing", "Partial", "B"),
+		})
+		require.Nil(t, err)
+		require.Equal(t, []string{"A", "Failing", "Partial", "B"}, seen)
+		require.Equal(t, []*pb.BatchDeleteReply{
+			{Collection: "A", Matches: 1, Successful: 1},
+			{Collection: "Failing", RequestId: "rFailing", Error: "could not find class Failing in schema"},
+			{Collection: "Partial", Matches: 2, Successful: 1, Failed: 1},
+			{Collection: "B", Matches: 1, Successful: 1},
+		}, reply.Replies)
+	})
+
+	t.Run("stop on first error", func(t *testing.T) {
+		for name, tt := range map[string]struct {
+			collections []string
+			seen        []string
+		}{
+			"request fails":     {collections: []string{"A", "Failing", "B"}, seen: []string{"A", "Failing"}},
+			"object fails":      {collections: []string{"A", "Partial", "B"}, seen: []string{"A", "Partial"}},
+			"all requests pass": {collections: []string{"A", "B"}, seen: []string{"A", "B"}},
+		} {
+			t.Run(name, func(t *testing.T) {
+				var seen []string
+				reply, err := newService(&seen).MultiBatchDelete(context.Background(), &pb.MultiBatchDeleteRequest{
+					Requests:         requests(tt.collections...),
+					StopOnFirstError: true,
+				})
+				require.Nil(t, err)
+				require.Equal(t, tt.seen, seen)
+				require.Len(t, reply.Replies, len(tt.seen))
+			})
+		}
+	})
+
+	t.Run("cancelled", func(t *testing.T) {
+		var seen []string
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		_, err := newService(&seen).MultiBatchDelete(ctx, &pb.MultiBatchDeleteRequest{Requests: requests("A")})
+		require.Equal(t, codes.Canceled, status.Code(err))
+		require.Empty(t, seen)
+	})
+}
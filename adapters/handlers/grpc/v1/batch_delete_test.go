@@ -12,20 +12,175 @@
 package v1
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"testing"
+	"time"
 
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/require"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
 	"github.com/weaviate/weaviate/entities/filters"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/storagestate"
 	pb "github.com/weaviate/weaviate/grpc/generated/protocol/v1"
 	"github.com/weaviate/weaviate/usecases/objects"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
+func TestBatchDeleteFilterDepth(t *testing.T) {
+	// nestedFilter alternates AND and OR so that the condition ends up depth
+	// levels deep
+	nestedFilter := func(depth int) *pb.Filters {
+		f := &pb.Filters{Operator: pb.Filters_OPERATOR_EQUAL, TestValue: &pb.Filters_ValueText{ValueText: "test"}, On: []string{"name"}}
+		for i := 1; i < depth; i++ {
+			op := pb.Filters_OPERATOR_AND
+			if i%2 == 0 {
+				op = pb.Filters_OPERATOR_OR
+			}
+			f = &pb.Filters{Operator: op, Filters: []*pb.Filters{f, {Operator: pb.Filters_OPERATOR_IS_NULL, On: []string{"name"}}}}
+		}
+		return f
+	}
+
+	require.Equal(t, 0, FilterDepth(nil))
+
+	tests := []struct {
+		depth int
+		valid bool
+	}{
+		{depth: 1, valid: true},
+		{depth: 10, valid: true},
+		{depth: 11, valid: false},
+		{depth: 50, valid: false},
+	}
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("depth %d", tt.depth), func(t *testing.T) {
+			req := &pb.BatchDeleteRequest{Collection: "C", Filters: nestedFilter(tt.depth)}
+			require.Equal(t, tt.depth, FilterDepth(req.Filters))
+
+			err := validateBatchDeleteRequest(req, 10)
+			if tt.valid {
+				require.Nil(t, err)
+				return
+			}
+			require.Equal(t, codes.InvalidArgument, status.Code(err))
+			require.ErrorContains(t, err, fmt.Sprintf("nested %d levels deep", tt.depth))
+		})
+	}
+
+	t.Run("chunk size", func(t *testing.T) {
+		req := &pb.BatchDeleteRequest{Collection: "C", Filters: nestedFilter(1), ChunkSize: MaxBatchDeleteChunkSize}
+		require.Nil(t, validateBatchDeleteRequest(req, 10))
+
+		req.ChunkSize++
+		err := validateBatchDeleteRequest(req, 10)
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+		require.ErrorContains(t, err, "chunk_size is 10001")
+	})
+
+	t.Run("exclude uuids", func(t *testing.T) {
+		id := uuid.New()
+		req := &pb.BatchDeleteRequest{Collection: "C", Filters: nestedFilter(1)}
+		for i := 0; i < MaxBatchDeleteExcludeUUIDs; i++ {
+			req.ExcludeUuids = append(req.ExcludeUuids, id[:])
+		}
+		require.Nil(t, validateBatchDeleteRequest(req, 10))
+
+		req.ExcludeUuids = append(req.ExcludeUuids, id[:])
+		err := validateBatchDeleteRequest(req, 10)
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+		require.ErrorContains(t, err, "10001 exclude_uuids")
+
+		req.ExcludeUuids = [][]byte{id[:], []byte(id.String())}
+		err = validateBatchDeleteRequest(req, 10)
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+		require.ErrorContains(t, err, "exclude_uuids[1] is 36 bytes long")
+	})
+}
+
+func TestBatchDeleteTenants(t *testing.T) {
+	withTenants := func(tenants ...string) *pb.BatchDeleteRequest {
+		return &pb.BatchDeleteRequest{Collection: "C", TenantSelection: &pb.BatchDeleteRequest_TenantList{
+			TenantList: &pb.TenantList{Tenants: tenants},
+		}}
+	}
+	tests := []struct {
+		name     string
+		req      *pb.BatchDeleteRequest
+		expected []string
+	}{
+		{name: "no tenant", req: &pb.BatchDeleteRequest{Collection: "C"}, expected: []string{""}},
+		{
+			name:     "single tenant",
+			req:      &pb.BatchDeleteRequest{Collection: "C", TenantSelection: &pb.BatchDeleteRequest_Tenant{Tenant: "t1"}},
+			expected: []string{"t1"},
+		},
+		{name: "tenant list", req: withTenants("t1", "t2"), expected: []string{"t1", "t2"}},
+		{name: "empty tenant list", req: withTenants()},
+		{name: "empty tenant name", req: withTenants("t1", "")},
+		{name: "duplicate tenant", req: withTenants("t1", "t1")},
+		{name: "all tenants", req: &pb.BatchDeleteRequest{Collection: "C", AllTenants: true}, expected: []string{"t1", "t3"}},
+		{
+			name: "all tenants and tenant",
+			req:  &pb.BatchDeleteRequest{Collection: "C", AllTenants: true, TenantSelection: &pb.BatchDeleteRequest_Tenant{Tenant: "t1"}},
+		},
+		{
+			name: "all tenants and tenant list",
+			req: &pb.BatchDeleteRequest{Collection: "C", AllTenants: true, TenantSelection: &pb.BatchDeleteRequest_TenantList{
+				TenantList: &pb.TenantList{Tenants: []string{"t1"}},
+			}},
+		},
+	}
+	activeTenants := func(class string) ([]string, error) {
+		require.Equal(t, "C", class)
+		return []string{"t1", "t3"}, nil
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tenants, err := batchDeleteTenants(tt.req, activeTenants)
+			if tt.expected == nil {
+				require.Equal(t, codes.InvalidArgument, status.Code(err))
+				return
+			}
+			require.Nil(t, err)
+			require.Equal(t, tt.expected, tenants)
+		})
+	}
+}
+
+func TestMergeBatchDeleteReplies(t *testing.T) {
+	obj := &pb.BatchDeleteObject{Successful: true}
+	merged := mergeBatchDeleteReplies([]string{"t1", "t2"}, []*pb.BatchDeleteReply{
+		{Matches: 3, Successful: 2, Failed: 1, Objects: []*pb.BatchDeleteObject{obj}},
+		{Matches: 3, Successful: 1, Skipped: 1, Excluded: 1, Objects: []*pb.BatchDeleteObject{obj}},
+	})
+	require.Equal(t, &pb.BatchDeleteReply{
+		Matches:    6,
+		Successful: 3,
+		Failed:     1,
+		Skipped:    1,
+		Excluded:   1,
+		Objects:    []*pb.BatchDeleteObject{obj, obj},
+		TenantResults: []*pb.TenantDeleteSummary{
+			{Tenant: "t1", Matches: 3, Successful: 2, Failed: 1},
+			{Tenant: "t2", Matches: 3, Successful: 1, Skipped: 1, Excluded: 1},
+		},
+	}, merged)
+}
+
 func TestBatchDeleteRequest(t *testing.T) {
 	collection := "TestClass"
+	timestampCollection := "TimestampClass"
+	modifiedBefore := time.UnixMilli(1700000000000).UTC()
+	excludeUUID := uuid.MustParse("73f2eb5f-5abf-447a-81ca-74b1dd168247")
 	scheme := schema.Schema{
 		Objects: &models.Schema{
 			Classes: []*models.Class{
@@ -35,6 +190,13 @@ func TestBatchDeleteRequest(t *testing.T) {
 						{Name: "name", DataType: schema.DataTypeText.PropString()},
 					},
 				},
+				{
+					Class: timestampCollection,
+					Properties: []*models.Property{
+						{Name: "name", DataType: schema.DataTypeText.PropString()},
+					},
+					InvertedIndexConfig: &models.InvertedIndexConfig{IndexTimestamps: true},
+				},
 			},
 		},
 	}
@@ -50,6 +212,13 @@ func TestBatchDeleteRequest(t *testing.T) {
 			Value:    &filters.Value{Value: "test", Type: schema.DataTypeText},
 		},
 	}
+	timestampFilterOutput := &filters.LocalFilter{
+		Root: &filters.Clause{
+			On:       &filters.Path{Class: schema.ClassName(timestampCollection), Property: "name"},
+			Operator: filters.OperatorEqual,
+			Value:    &filters.Value{Value: "test", Type: schema.DataTypeText},
+		},
+	}
 	simpleFilterInput := &pb.Filters{Operator: pb.Filters_OPERATOR_EQUAL, TestValue: &pb.Filters_ValueText{ValueText: "test"}, Target: &pb.FilterTarget{Target: &pb.FilterTarget_Property{Property: "name"}}}
 
 	tests := []struct {
@@ -66,6 +235,7 @@ func TestBatchDeleteRequest(t *testing.T) {
 			},
 			out: objects.BatchDeleteParams{
 				ClassName: schema.ClassName(collection),
+				ChunkSize: DefaultBatchDeleteChunkSize,
 				DryRun:    false,
 				Output:    "minimal",
 				Filters:   simpleFilterOutput,
@@ -91,6 +261,7 @@ func TestBatchDeleteRequest(t *testing.T) {
 			},
 			out: objects.BatchDeleteParams{
 				ClassName: schema.ClassName(collection),
+				ChunkSize: DefaultBatchDeleteChunkSize,
 				DryRun:    true,
 				Output:    "minimal",
 				Filters:   simpleFilterOutput,
@@ -107,12 +278,69 @@ func TestBatchDeleteRequest(t *testing.T) {
 			},
 			out: objects.BatchDeleteParams{
 				ClassName: schema.ClassName(collection),
+				ChunkSize: DefaultBatchDeleteChunkSize,
 				DryRun:    false,
 				Output:    "verbose",
 				Filters:   simpleFilterOutput,
 			},
 			error: nil,
 		},
+		{
+			name: "modified before",
+			req: &pb.BatchDeleteRequest{
+				Collection:     timestampCollection,
+				Filters:        simpleFilterInput,
+				ModifiedBefore: timestamppb.New(modifiedBefore),
+			},
+			out: objects.BatchDeleteParams{
+				ClassName:      schema.ClassName(timestampCollection),
+				ChunkSize:      DefaultBatchDeleteChunkSize,
+				Output:         "minimal",
+				Filters:        timestampFilterOutput,
+				ModifiedBefore: modifiedBefore,
+			},
+			error: nil,
+		},
+		{
+			name: "chunk size",
+			req: &pb.BatchDeleteRequest{
+				Collection: collection,
+				Filters:    simpleFilterInput,
+				ChunkSize:  1000,
+			},
+			out: objects.BatchDeleteParams{
+				ClassName: schema.ClassName(collection),
+				ChunkSize: 1000,
+				Output:    "minimal",
+				Filters:   simpleFilterOutput,
+			},
+			error: nil,
+		},
+		{
+			name: "exclude uuids",
+			req: &pb.BatchDeleteRequest{
+				Collection:   collection,
+				Filters:      simpleFilterInput,
+				ExcludeUuids: [][]byte{excludeUUID[:]},
+			},
+			out: objects.BatchDeleteParams{
+				ClassName:    schema.ClassName(collection),
+				ChunkSize:    DefaultBatchDeleteChunkSize,
+				Output:       "minimal",
+				Filters:      simpleFilterOutput,
+				ExcludeUUIDs: []strfmt.UUID{strfmt.UUID(excludeUUID.String())},
+			},
+			error: nil,
+		},
+		{
+			name: "modified before without timestamp index",
+			req: &pb.BatchDeleteRequest{
+				Collection:     collection,
+				Filters:        simpleFilterInput,
+				ModifiedBefore: timestamppb.New(modifiedBefore),
+			},
+			error: fmt.Errorf("modified_before requires indexTimestamps to be enabled on collection %s", collection),
+		},
 	}
 
 	for _, tt := range tests {
@@ -127,12 +355,90 @@ func TestBatchDeleteRequest(t *testing.T) {
 	}
 }
 
+func TestBatchDeleteFilterValidationError(t *testing.T) {
+	class := &models.Class{
+		Class: "C",
+		Properties: []*models.Property{
+			{Name: "name", DataType: schema.DataTypeText.PropString()},
+			{Name: "count", DataType: schema.DataTypeInt.PropString()},
+			{Name: "ref", DataType: schema.DataTypeUUID.PropString()},
+		},
+	}
+	getClass := func(name string) (*models.Class, error) {
+		if name == class.Class {
+			return class, nil
+		}
+		return nil, nil
+	}
+	onProperty := func(prop string) *pb.FilterTarget {
+		return &pb.FilterTarget{Target: &pb.FilterTarget_Property{Property: prop}}
+	}
+	validFilter := &pb.Filters{Operator: pb.Filters_OPERATOR_EQUAL, TestValue: &pb.Filters_ValueText{ValueText: "a"}, Target: onProperty("name")}
+
+	tests := []struct {
+		name     string
+		filters  *pb.Filters
+		expected *pb.FilterValidationError
+	}{
+		{
+			name:     "unknown property",
+			filters:  &pb.Filters{Operator: pb.Filters_OPERATOR_EQUAL, TestValue: &pb.Filters_ValueText{ValueText: "a"}, Target: onProperty("missing")},
+			expected: &pb.FilterValidationError{FieldPath: "missing", Operator: "OPERATOR_EQUAL"},
+		},
+		{
+			name:     "unsupported operator",
+			filters:  &pb.Filters{Operator: pb.Filters_OPERATOR_UNSPECIFIED, TestValue: &pb.Filters_ValueText{ValueText: "a"}, On: []string{"name"}},
+			expected: &pb.FilterValidationError{FieldPath: "name", Operator: "OPERATOR_UNSPECIFIED"},
+		},
+		{
+			name:    "floating point number for int",
+			filters: &pb.Filters{Operator: pb.Filters_OPERATOR_EQUAL, TestValue: &pb.Filters_ValueNumber{ValueNumber: 1.5}, Target: onProperty("count")},
+			expected: &pb.FilterValidationError{
+				FieldPath: "count", Operator: "OPERATOR_EQUAL", ExpectedType: "int", ReceivedValue: "1.5",
+			},
+		},
+		{
+			name: "type mismatch in nested filter",
+			filters: &pb.Filters{Operator: pb.Filters_OPERATOR_AND, Filters: []*pb.Filters{
+				validFilter,
+				{Operator: pb.Filters_OPERATOR_GREATER_THAN, TestValue: &pb.Filters_ValueText{ValueText: "abc"}, Target: onProperty("count")},
+			}},
+			expected: &pb.FilterValidationError{
+				FieldPath: "count", Operator: "OPERATOR_GREATER_THAN", ExpectedType: "int", ReceivedValue: "abc",
+			},
+		},
+		{
+			name:    "operator not supported by property",
+			filters: &pb.Filters{Operator: pb.Filters_OPERATOR_LIKE, TestValue: &pb.Filters_ValueText{ValueText: "a*"}, Target: onProperty("ref")},
+			expected: &pb.FilterValidationError{
+				FieldPath: "ref", Operator: "OPERATOR_LIKE", ExpectedType: "text", ReceivedValue: "a*",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := batchDeleteParamsFromProto(&pb.BatchDeleteRequest{Collection: "C", Filters: tt.filters}, getClass)
+			require.NotNil(t, err)
+			err = fmt.Errorf("batch delete params: %w", err)
+			require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+			detail, ok := pb.ParseFilterValidationError(err)
+			require.True(t, ok)
+			require.True(t, proto.Equal(tt.expected, detail), detail)
+		})
+	}
+
+	_, ok := pb.ParseFilterValidationError(errors.New("other error"))
+	require.False(t, ok)
+}
+
 var (
 	errorString   = "error"
 	noErrorString = ""
 )
 
 func TestBatchDeleteReply(t *testing.T) {
+	unknownErr := []*pb.ErrorBucket{{ErrorCode: batchDeleteErrUnknown, Count: 1, SampleUuid: uuidBytes(UUID1)}}
 	tests := []struct {
 		name     string
 		response objects.BatchDeleteResult
@@ -147,21 +453,26 @@ func TestBatchDeleteReply(t *testing.T) {
 		{
 			name:     "single object with err",
 			response: objects.BatchDeleteResult{Matches: 1, Objects: objects.BatchSimpleObjects{{UUID: UUID1, Err: errors.New("error")}}},
-			out:      &pb.BatchDeleteReply{Matches: 1, Successful: 0, Failed: 1},
+			out:      &pb.BatchDeleteReply{Matches: 1, Successful: 0, Failed: 1, ErrorBreakdown: unknownErr},
 		},
 		{
 			name:     "one error, one successful",
 			response: objects.BatchDeleteResult{Matches: 2, Objects: objects.BatchSimpleObjects{{UUID: UUID1, Err: errors.New("error")}, {UUID: UUID2, Err: nil}}},
-			out:      &pb.BatchDeleteReply{Matches: 2, Successful: 1, Failed: 1},
+			out:      &pb.BatchDeleteReply{Matches: 2, Successful: 1, Failed: 1, ErrorBreakdown: unknownErr},
 		},
 		{
 			name:     "one error, one successful - with verbosity",
 			response: objects.BatchDeleteResult{Matches: 2, Objects: objects.BatchSimpleObjects{{UUID: UUID1, Err: errors.New("error")}, {UUID: UUID2, Err: nil}}},
 			verbose:  true,
 			out: &pb.BatchDeleteReply{Matches: 2, Successful: 1, Failed: 1, Objects: []*pb.BatchDeleteObject{
-				{Uuid: idByte(string(UUID1)), Successful: false, Error: &errorString},
-				{Uuid: idByte(string(UUID2)), Successful: true, Error: &noErrorString},
-			}},
+				{UuidFormat: &pb.BatchDeleteObject_UuidStr{UuidStr: string(UUID1)}, Successful: false, Error: &errorString},
+				{UuidFormat: &pb.BatchDeleteObject_UuidStr{UuidStr: string(UUID2)}, Successful: true, Error: &noErrorString},
+			}, ErrorBreakdown: unknownErr},
+		},
+		{
+			name:     "one successful, one skipped",
+			response: objects.BatchDeleteResult{Matches: 2, Skipped: 1, Objects: objects.BatchSimpleObjects{{UUID: UUID1, Err: nil}}},
+			out:      &pb.BatchDeleteReply{Matches: 2, Successful: 1, Failed: 0, Skipped: 1},
 		},
 	}
 
@@ -173,3 +484,128 @@ func TestBatchDeleteReply(t *testing.T) {
 		})
 	}
 }
+
+func uuidBytes(id strfmt.UUID) []byte {
+	parsed := uuid.MustParse(id.String())
+	return parsed[:]
+}
+
+func TestBatchDeleteErrorBreakdown(t *testing.T) {
+	const (
+		UUID3 = strfmt.UUID("1fca336d-c6b6-4e39-8b5f-3ab4b4b4c2a1")
+		UUID4 = strfmt.UUID("2a1a0c44-1a4c-4c17-a9b4-3bb4e26c0d10")
+	)
+	response := objects.BatchDeleteResult{Matches: 6, Objects: objects.BatchSimpleObjects{
+		{UUID: UUID1, Err: fmt.Errorf("delete object: %w", context.DeadlineExceeded)},
+		{UUID: UUID2, Err: storagestate.ErrStatusReadOnlyWithReason("disk usage too high")},
+		{UUID: UUID3, Err: errors.New("remote shard: context deadline exceeded")},
+		{UUID: UUID4, Err: errors.New(`broadcast: cannot reach enough replicas: consistency level "QUORUM"`)},
+		{UUID: UUID4, Err: errors.New("shard not found")},
+		{UUID: UUID1, Err: nil},
+	}}
+
+	expected := []*pb.ErrorBucket{
+		{ErrorCode: batchDeleteErrTimeout, Count: 2, SampleUuid: uuidBytes(UUID1)},
+		{ErrorCode: batchDeleteErrReadOnly, Count: 1, SampleUuid: uuidBytes(UUID2)},
+		{ErrorCode: batchDeleteErrReplication, Count: 1, SampleUuid: uuidBytes(UUID4)},
+		{ErrorCode: batchDeleteErrShardUnavailable, Count: 1, SampleUuid: uuidBytes(UUID4)},
+	}
+	for _, verbose := range []bool{false, true} {
+		reply, err := batchDeleteReplyFromObjects(response, verbose)
+		require.Nil(t, err)
+		require.Equal(t, int64(5), reply.Failed)
+		require.Equal(t, expected, reply.ErrorBreakdown)
+	}
+
+	t.Run("error without object", func(t *testing.T) {
+		reply, err := batchDeleteReplyFromObjects(objects.BatchDeleteResult{Objects: objects.BatchSimpleObjects{
+			{Err: fmt.Errorf("tenant t1: %w", enterrors.ErrTenantNotActive)},
+		}}, false)
+		require.Nil(t, err)
+		require.Equal(t, []*pb.ErrorBucket{{ErrorCode: batchDeleteErrTenantNotActive, Count: 1}}, reply.ErrorBreakdown)
+	})
+
+	t.Run("merged over tenants", func(t *testing.T) {
+		merged := mergeBatchDeleteReplies([]string{"t1", "t2"}, []*pb.BatchDeleteReply{
+			{ErrorBreakdown: []*pb.ErrorBucket{{ErrorCode: batchDeleteErrTimeout, Count: 2}}},
+			{ErrorBreakdown: []*pb.ErrorBucket{
+				{ErrorCode: batchDeleteErrUnknown, Count: 1, SampleUuid: uuidBytes(UUID2)},
+				{ErrorCode: batchDeleteErrTimeout, Count: 3, SampleUuid: uuidBytes(UUID1)},
+			}},
+		})
+		require.Equal(t, []*pb.ErrorBucket{
+			{ErrorCode: batchDeleteErrTimeout, Count: 5, SampleUuid: uuidBytes(UUID1)},
+			{ErrorCode: batchDeleteErrUnknown, Count: 1, SampleUuid: uuidBytes(UUID2)},
+		}, merged.ErrorBreakdown)
+	})
+}
+
+func TestBatchDeleteObjectUUID(t *testing.T) {
+	const id = "00e110b4-2c8b-4d3e-b2d8-53c2a5cf1f6d"
+	tests := []struct {
+		name   string
+		object *pb.BatchDeleteObject
+	}{
+		{
+			name:   "string",
+			object: &pb.BatchDeleteObject{UuidFormat: &pb.BatchDeleteObject_UuidStr{UuidStr: id}, Successful: true},
+		},
+		{
+			// older servers send the integer value, which drops the leading zero byte
+			name:   "bytes",
+			object: &pb.BatchDeleteObject{UuidFormat: &pb.BatchDeleteObject_Uuid{Uuid: idByte(id)}, Successful: true},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			wire, err := proto.Marshal(tt.object)
+			require.Nil(t, err)
+
+			var decoded pb.BatchDeleteObject
+			require.Nil(t, proto.Unmarshal(wire, &decoded))
+			require.True(t, proto.Equal(tt.object, &decoded))
+			require.Equal(t, id, decoded.UUIDString())
+		})
+	}
+
+	t.Run("no uuid", func(t *testing.T) {
+		require.Empty(t, (&pb.BatchDeleteObject{}).UUIDString())
+	})
+}
+
+func TestEchoBatchDeleteRequest(t *testing.T) {
+	t.Run("single tenant", func(t *testing.T) {
+		reply := &pb.BatchDeleteReply{Matches: 1}
+		echoBatchDeleteRequest(reply, &pb.BatchDeleteRequest{
+			Collection:      "Class",
+			TenantSelection: &pb.BatchDeleteRequest_Tenant{Tenant: "t1"},
+			RequestId:       "req-1",
+		})
+		require.Equal(t, &pb.BatchDeleteReply{Matches: 1, Collection: "Class", Tenant: "t1", RequestId: "req-1"}, reply)
+	})
+
+	t.Run("tenant list", func(t *testing.T) {
+		reply := &pb.BatchDeleteReply{}
+		echoBatchDeleteRequest(reply, &pb.BatchDeleteRequest{
+			Collection:      "Class",
+			TenantSelection: &pb.BatchDeleteRequest_TenantList{TenantList: &pb.TenantList{Tenants: []string{"t1", "t2"}}},
+		})
+		require.Equal(t, &pb.BatchDeleteReply{Collection: "Class"}, reply)
+	})
+}
+
+func TestBatchDeleteReplyTookDuration(t *testing.T) {
+	t.Run("took duration", func(t *testing.T) {
+		reply := &pb.BatchDeleteReply{TookDuration: durationpb.New(1500 * time.Microsecond), Took: 0.0015}
+		require.Equal(t, 1500*time.Microsecond, pb.TookDuration(reply))
+	})
+
+	t.Run("fallback to deprecated seconds", func(t *testing.T) {
+		reply := &pb.BatchDeleteReply{Took: 2}
+		require.Equal(t, 2*time.Second, pb.TookDuration(reply))
+	})
+
+	t.Run("nil reply", func(t *testing.T) {
+		require.Equal(t, time.Duration(0), pb.TookDuration(nil))
+	})
+}
@@ -12,14 +12,40 @@
 package v1
 
 import (
+	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/weaviate/weaviate/entities/filters"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/schema"
 	pb "github.com/weaviate/weaviate/grpc/generated/protocol/v1"
+	authErrs "github.com/weaviate/weaviate/usecases/auth/authorization/errors"
 )
 
+// FilterDepth returns the number of levels of the filter tree, i.e. 1 for a
+// single condition and one more for every nested AND/OR. A nil filter has
+// depth 0. The tree is walked iteratively so that arbitrarily deep filters
+// can be measured safely.
+func FilterDepth(f *pb.Filters) int {
+	if f == nil {
+		return 0
+	}
+	depth := 0
+	for level := []*pb.Filters{f}; len(level) > 0; depth++ {
+		var next []*pb.Filters
+		for _, filter := range level {
+			for _, child := range filter.Filters {
+				if child != nil {
+					next = append(next, child)
+				}
+			}
+		}
+		level = next
+	}
+	return depth
+}
+
 func ExtractFilters(filterIn *pb.Filters, authorizedGetClass func(string) (*models.Class, error), className string) (filters.Clause, error) {
 	returnFilter := filters.Clause{}
 	if filterIn.Operator == pb.Filters_OPERATOR_AND || filterIn.Operator == pb.Filters_OPERATOR_OR {
@@ -71,7 +97,8 @@ func ExtractFilters(filterIn *pb.Filters, authorizedGetClass func(string) (*mode
 		case pb.Filters_OPERATOR_CONTAINS_ALL:
 			returnFilter.Operator = filters.ContainsAll
 		default:
-			return filters.Clause{}, fmt.Errorf("unknown filter operator %v", filterIn.Operator)
+			err := invalidFilter(fmt.Errorf("unknown filter operator %v", filterIn.Operator))
+			return filters.Clause{}, describeInvalidFilter(err, filterIn)
 		}
 
 		var dataType schema.DataType
@@ -84,12 +111,12 @@ func ExtractFilters(filterIn *pb.Filters, authorizedGetClass func(string) (*mode
 
 			dataType, err = extractDataType(authorizedGetClass, returnFilter.Operator, className, filterIn.On)
 			if err != nil {
-				return filters.Clause{}, err
+				return filters.Clause{}, describeInvalidFilter(err, filterIn)
 			}
 		} else {
 			path, dataType2, err := extractPathNew(authorizedGetClass, className, filterIn.Target, returnFilter.Operator)
 			if err != nil {
-				return filters.Clause{}, err
+				return filters.Clause{}, describeInvalidFilter(err, filterIn)
 			}
 			dataType = dataType2
 			returnFilter.On = path
@@ -145,7 +172,9 @@ func ExtractFilters(filterIn *pb.Filters, authorizedGetClass func(string) (*mode
 		if number, ok := val.(float64); ok && dataType == schema.DataTypeInt {
 			val = int(number)
 			if float64(int(number)) != number {
-				return filters.Clause{}, fmt.Errorf("filtering for integer, but received a floating point number %v", number)
+				err := invalidFilterValue(dataType, number,
+					fmt.Errorf("filtering for integer, but received a floating point number %v", number))
+				return filters.Clause{}, describeInvalidFilter(err, filterIn)
 			}
 		}
 
@@ -167,7 +196,9 @@ func ExtractFilters(filterIn *pb.Filters, authorizedGetClass func(string) (*mode
 				valInt := make([]int, len(valSlice))
 				for i := 0; i < len(valSlice); i++ {
 					if float64(int(valSlice[i])) != valSlice[i] {
-						return filters.Clause{}, fmt.Errorf("filtering for integer, but received a floating point number %v", valSlice[i])
+						err := invalidFilterValue(dataType, valSlice[i],
+							fmt.Errorf("filtering for integer, but received a floating point number %v", valSlice[i]))
+						return filters.Clause{}, describeInvalidFilter(err, filterIn)
 					}
 					valInt[i] = int(valSlice[i])
 				}
@@ -200,7 +231,7 @@ func extractDataTypeProperty(authorizedGetClass func(string) (*models.Class, err
 		}
 		prop, err := schema.GetPropertyByName(class, propToCheck)
 		if err != nil {
-			return dataType, err
+			return dataType, invalidFilter(err)
 		}
 		dataType = schema.DataType(prop.DataType[0])
 	} else {
@@ -219,7 +250,7 @@ func extractDataTypeProperty(authorizedGetClass func(string) (*models.Class, err
 		}
 		prop, err := schema.GetPropertyByName(class, propToCheck)
 		if err != nil {
-			return dataType, err
+			return dataType, invalidFilter(err)
 		}
 		if schema.IsRefDataType(prop.DataType) {
 			// This is a filter on a reference property without a path so is counting
@@ -277,7 +308,7 @@ func extractPathNew(authorizedGetClass func(string) (*models.Class, error), clas
 		normalizedRefPropName := schema.LowercaseFirstLetter(singleTarget.On)
 		refProp, err := schema.GetPropertyByName(class, normalizedRefPropName)
 		if err != nil {
-			return nil, "", err
+			return nil, "", invalidFilter(err)
 		}
 		if len(refProp.DataType) != 1 {
 			return nil, "", fmt.Errorf("expected reference property with a single target, got %v for %v ", refProp.DataType, refProp.Name)
@@ -301,3 +332,164 @@ func extractPathNew(authorizedGetClass func(string) (*models.Class, error), clas
 		return nil, "", fmt.Errorf("unknown target type %v", target)
 	}
 }
+
+// filterValidationError is returned for filters which do not match the schema
+// of the collection. Batch deletes send its detail to the client, see
+// filterValidationStatus.
+type filterValidationError struct {
+	detail *pb.FilterValidationError
+	err    error
+}
+
+func (e *filterValidationError) Error() string {
+	return e.err.Error()
+}
+
+func (e *filterValidationError) Unwrap() error {
+	return e.err
+}
+
+func invalidFilter(err error) *filterValidationError {
+	return &filterValidationError{detail: &pb.FilterValidationError{}, err: err}
+}
+
+func invalidFilterValue(expected schema.DataType, received interface{}, err error) *filterValidationError {
+	return &filterValidationError{
+		detail: &pb.FilterValidationError{ExpectedType: string(expected), ReceivedValue: fmt.Sprint(received)},
+		err:    err,
+	}
+}
+
+// describeInvalidFilter sets the path and operator of filterIn on err if err
+// is a filterValidationError
+func describeInvalidFilter(err error, filterIn *pb.Filters) error {
+	var fve *filterValidationError
+	if errors.As(err, &fve) {
+		fve.detail.FieldPath = filterPath(filterIn)
+		fve.detail.Operator = filterIn.Operator.String()
+	}
+	return err
+}
+
+// filterPath returns the path of the property filterIn filters on, its
+// segments separated by dots
+func filterPath(filterIn *pb.Filters) string {
+	if filterIn.Target == nil {
+		return strings.Join(filterIn.On, ".")
+	}
+	var segments []string
+	for target := filterIn.Target; target != nil; {
+		switch t := target.Target.(type) {
+		case *pb.FilterTarget_Property:
+			return strings.Join(append(segments, t.Property), ".")
+		case *pb.FilterTarget_SingleTarget:
+			segments = append(segments, t.SingleTarget.On)
+			target = t.SingleTarget.Target
+		case *pb.FilterTarget_MultiTarget:
+			segments = append(segments, t.MultiTarget.On, t.MultiTarget.TargetCollection)
+			target = t.MultiTarget.Target
+		case *pb.FilterTarget_Count:
+			return strings.Join(append(segments, t.Count.On), ".")
+		default:
+			target = nil
+		}
+	}
+	return strings.Join(segments, ".")
+}
+
+// validateFilterValueTypes returns a filterValidationError for the first
+// condition of filterIn whose value does not have the type of the property it
+// filters on. clause must have been extracted from filterIn.
+func validateFilterValueTypes(filterIn *pb.Filters, clause *filters.Clause) error {
+	if len(clause.Operands) > 0 {
+		for i := range clause.Operands {
+			if i >= len(filterIn.Filters) {
+				break
+			}
+			if err := validateFilterValueTypes(filterIn.Filters[i], &clause.Operands[i]); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	if clause.Value == nil {
+		return nil
+	}
+
+	var ok bool
+	switch v := clause.Value.Value; clause.Value.Type {
+	case schema.DataTypeText, schema.DataTypeString, schema.DataTypeDate:
+		switch v.(type) {
+		case string, []string:
+			ok = true
+		}
+	case schema.DataTypeInt:
+		switch v.(type) {
+		case int, []int:
+			ok = true
+		}
+	case schema.DataTypeNumber:
+		switch v.(type) {
+		case float64, []float64:
+			ok = true
+		}
+	case schema.DataTypeBoolean:
+		switch v.(type) {
+		case bool, []bool:
+			ok = true
+		}
+	case schema.DataTypeGeoCoordinates:
+		_, ok = v.(filters.GeoRange)
+	default:
+		// types without a dedicated value are checked when searching
+		ok = true
+	}
+	if ok {
+		return nil
+	}
+	err := invalidFilterValue(clause.Value.Type, clause.Value.Value, fmt.Errorf(
+		"filter on %q requires a value of type %q, got %v", filterPath(filterIn), clause.Value.Type, clause.Value.Value))
+	return describeInvalidFilter(err, filterIn)
+}
+
+// describeInvalidClause returns err, the error filters.ValidateFilters
+// returned for clause, as filterValidationError describing the first
+// condition of filterIn which fails validation. clause must have been
+// extracted from filterIn. Authorization errors are returned unchanged.
+func describeInvalidClause(err error, authorizedGetClass func(string) (*models.Class, error),
+	filterIn *pb.Filters, clause *filters.Clause,
+) error {
+	if errors.As(err, &authErrs.Forbidden{}) {
+		return err
+	}
+	invalidIn, invalid := invalidCondition(authorizedGetClass, filterIn, clause)
+	if invalid == nil || invalid.Value == nil {
+		return err
+	}
+	fve := invalidFilterValue(invalid.Value.Type, invalid.Value.Value, err)
+	return describeInvalidFilter(fve, invalidIn)
+}
+
+// invalidCondition returns the first condition of clause which fails
+// filters.ValidateFilters together with the filter it was extracted from
+func invalidCondition(authorizedGetClass func(string) (*models.Class, error),
+	filterIn *pb.Filters, clause *filters.Clause,
+) (*pb.Filters, *filters.Clause) {
+	if len(clause.Operands) > 0 {
+		for i := range clause.Operands {
+			if i >= len(filterIn.Filters) {
+				break
+			}
+			if in, c := invalidCondition(authorizedGetClass, filterIn.Filters[i], &clause.Operands[i]); c != nil {
+				return in, c
+			}
+		}
+		return nil, nil
+	}
+	// validating may alter the clause
+	condition := *clause
+	if filters.ValidateFilters(authorizedGetClass, &filters.LocalFilter{Root: &condition}) != nil {
+		return filterIn, clause
+	}
+	return nil, nil
+}
@@ -0,0 +1,102 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package v1
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	pb "github.com/weaviate/weaviate/grpc/generated/protocol/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+// searchRequestWithJoins returns a copy of the search of req which requests
+// the joined properties as reference properties. Resolving those references
+// looks up the joined objects of all results in a batch.
+func searchRequestWithJoins(req *pb.JoinedSearchRequest, maxDepth int,
+	getClass func(string) (*models.Class, error),
+) (*pb.SearchRequest, error) {
+	if req.Search == nil {
+		return nil, fmt.Errorf("joined search request without search")
+	}
+	search := proto.Clone(req.Search).(*pb.SearchRequest)
+	if len(req.Joins) == 0 {
+		return search, nil
+	}
+
+	if search.Properties == nil {
+		search.Properties = &pb.PropertiesRequest{ReturnAllNonrefProperties: true}
+	}
+	if err := addJoins(search.Properties, search.Collection, req.Joins, 1, maxDepth, getClass); err != nil {
+		return nil, err
+	}
+	return search, nil
+}
+
+func addJoins(props *pb.PropertiesRequest, collection string, joins []*pb.JoinClause,
+	depth, maxDepth int, getClass func(string) (*models.Class, error),
+) error {
+	if depth > maxDepth {
+		return fmt.Errorf("joins of collection %q exceed the maximum join depth of %d", collection, maxDepth)
+	}
+
+	class, err := getClass(collection)
+	if err != nil {
+		return err
+	}
+	for _, join := range joins {
+		if err := validateJoin(class, join); err != nil {
+			return err
+		}
+
+		var returned *pb.PropertiesRequest
+		if join.ReturnedProperties != nil {
+			returned = proto.Clone(join.ReturnedProperties).(*pb.PropertiesRequest)
+		}
+		if len(join.Joins) > 0 {
+			if returned == nil {
+				returned = &pb.PropertiesRequest{ReturnAllNonrefProperties: true}
+			}
+			if err := addJoins(returned, join.TargetCollection, join.Joins, depth+1, maxDepth, getClass); err != nil {
+				return err
+			}
+		}
+		props.RefProperties = append(props.RefProperties, &pb.RefPropertiesRequest{
+			ReferenceProperty: join.JoinOnProperty,
+			TargetCollection:  join.TargetCollection,
+			Properties:        returned,
+		})
+	}
+	return nil
+}
+
+// validateJoin checks that the join follows a cross-reference property of
+// class that points to the target collection of the join
+func validateJoin(class *models.Class, join *pb.JoinClause) error {
+	if join.JoinOnProperty == "" || join.TargetCollection == "" {
+		return fmt.Errorf("join of collection %q needs a property and a target collection", class.Class)
+	}
+	prop, err := schema.GetPropertyByName(class, schema.LowercaseFirstLetter(join.JoinOnProperty))
+	if err != nil {
+		return err
+	}
+	if !schema.IsRefDataType(prop.DataType) {
+		return fmt.Errorf("property %q of collection %q is not a cross-reference", prop.Name, class.Class)
+	}
+	if !slices.Contains(prop.DataType, schema.UppercaseClassName(join.TargetCollection)) {
+		return fmt.Errorf("property %q of collection %q does not reference collection %q",
+			prop.Name, class.Class, join.TargetCollection)
+	}
+	return nil
+}
@@ -0,0 +1,133 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package v1
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+	pb "github.com/weaviate/weaviate/grpc/generated/protocol/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestSearchRequestWithJoins(t *testing.T) {
+	classes := map[string]*models.Class{
+		"Article": {Class: "Article", Properties: []*models.Property{
+			{Name: "title", DataType: []string{"text"}},
+			{Name: "author", DataType: []string{"Author"}},
+		}},
+		"Author": {Class: "Author", Properties: []*models.Property{
+			{Name: "name", DataType: []string{"text"}},
+			{Name: "employer", DataType: []string{"Company"}},
+		}},
+		"Company": {Class: "Company", Properties: []*models.Property{
+			{Name: "name", DataType: []string{"text"}},
+		}},
+	}
+	getClass := func(name string) (*models.Class, error) {
+		if class, ok := classes[name]; ok {
+			return class, nil
+		}
+		return nil, fmt.Errorf("could not find class %s in schema", name)
+	}
+	names := &pb.PropertiesRequest{NonRefProperties: []string{"name"}}
+
+	t.Run("joins become reference properties", func(t *testing.T) {
+		req := &pb.JoinedSearchRequest{
+			Search: &pb.SearchRequest{Collection: "Article", Limit: 10},
+			Joins: []*pb.JoinClause{{
+				TargetCollection:   "Author",
+				JoinOnProperty:     "author",
+				ReturnedProperties: names,
+				Joins:              []*pb.JoinClause{{TargetCollection: "Company", JoinOnProperty: "employer"}},
+			}},
+		}
+		original := proto.Clone(req)
+
+		search, err := searchRequestWithJoins(req, 2, getClass)
+		require.Nil(t, err)
+		require.True(t, proto.Equal(&pb.SearchRequest{
+			Collection: "Article",
+			Limit:      10,
+			Properties: &pb.PropertiesRequest{
+				ReturnAllNonrefProperties: true,
+				RefProperties: []*pb.RefPropertiesRequest{{
+					ReferenceProperty: "author",
+					TargetCollection:  "Author",
+					Properties: &pb.PropertiesRequest{
+						NonRefProperties: []string{"name"},
+						RefProperties: []*pb.RefPropertiesRequest{{
+							ReferenceProperty: "employer",
+							TargetCollection:  "Company",
+						}},
+					},
+				}},
+			},
+		}, search), search.String())
+		require.True(t, proto.Equal(original, req), "request must not be modified")
+	})
+
+	t.Run("without joins", func(t *testing.T) {
+		search, err := searchRequestWithJoins(&pb.JoinedSearchRequest{Search: &pb.SearchRequest{Collection: "Article"}}, 1, getClass)
+		require.Nil(t, err)
+		require.True(t, proto.Equal(&pb.SearchRequest{Collection: "Article"}, search))
+	})
+
+	tests := []struct {
+		name  string
+		joins []*pb.JoinClause
+		err   string
+	}{
+		{
+			name:  "missing property",
+			joins: []*pb.JoinClause{{TargetCollection: "Author"}},
+			err:   "needs a property and a target collection",
+		},
+		{
+			name:  "unknown property",
+			joins: []*pb.JoinClause{{TargetCollection: "Author", JoinOnProperty: "editor"}},
+			err:   "no such prop",
+		},
+		{
+			name:  "not a cross-reference",
+			joins: []*pb.JoinClause{{TargetCollection: "Author", JoinOnProperty: "title"}},
+			err:   "is not a cross-reference",
+		},
+		{
+			name:  "other target collection",
+			joins: []*pb.JoinClause{{TargetCollection: "Company", JoinOnProperty: "author"}},
+			err:   "does not reference collection",
+		},
+		{
+			name: "too deep",
+			joins: []*pb.JoinClause{{
+				TargetCollection: "Author", JoinOnProperty: "author",
+				Joins: []*pb.JoinClause{{TargetCollection: "Company", JoinOnProperty: "employer"}},
+			}},
+			err: "exceed the maximum join depth of 1",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := &pb.JoinedSearchRequest{Search: &pb.SearchRequest{Collection: "Article"}, Joins: tt.joins}
+			_, err := searchRequestWithJoins(req, 1, getClass)
+			require.ErrorContains(t, err, tt.err)
+		})
+	}
+
+	t.Run("missing search", func(t *testing.T) {
+		_, err := searchRequestWithJoins(&pb.JoinedSearchRequest{}, 1, getClass)
+		require.NotNil(t, err)
+	})
+}
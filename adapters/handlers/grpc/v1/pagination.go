@@ -0,0 +1,46 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package v1
+
+import (
+	"fmt"
+
+	pb "github.com/weaviate/weaviate/grpc/generated/protocol/v1"
+)
+
+// pageLimit is the number of items to read for a page of pageSize items. One
+// more item than fits the page is read to tell if there is a next page. Zero
+// means all items are read, if pageSize is nil.
+func pageLimit(pageSize *int32) (int, error) {
+	if pageSize == nil {
+		return 0, nil
+	}
+	if *pageSize <= 0 {
+		return 0, fmt.Errorf("page size must be positive, got %d", *pageSize)
+	}
+	return int(*pageSize) + 1, nil
+}
+
+// page cuts the items read with pageLimit to pageSize. The items are the ones
+// after the cursor ordered by key, total is the number of items of all pages.
+// The cursor of the next page is the key of the last item, so items added or
+// removed between requests don't shift the following pages.
+func page[T any](items []T, key func(T) string, total int, pageSize *int32) ([]T, *pb.PageInfo) {
+	info := &pb.PageInfo{TotalCount: int64(total)}
+	if pageSize != nil && len(items) > int(*pageSize) {
+		items = items[:*pageSize]
+		info.HasNextPage = true
+		info.NextCursor = key(items[len(items)-1])
+	}
+	info.PageSize = int32(len(items))
+	return items, info
+}
@@ -0,0 +1,95 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package v1
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	pb "github.com/weaviate/weaviate/grpc/generated/protocol/v1"
+)
+
+func TestPage(t *testing.T) {
+	identity := func(s string) string { return s }
+	size := func(n int32) *int32 { return &n }
+
+	tests := []struct {
+		name     string
+		items    []string
+		total    int
+		pageSize *int32
+		page     []string
+		info     *pb.PageInfo
+	}{
+		{
+			name:  "all",
+			items: []string{"a", "b", "c", "d", "e"},
+			total: 5,
+			page:  []string{"a", "b", "c", "d", "e"},
+			info:  &pb.PageInfo{TotalCount: 5, PageSize: 5},
+		},
+		{
+			name:     "first page",
+			items:    []string{"a", "b", "c"},
+			total:    5,
+			pageSize: size(2),
+			page:     []string{"a", "b"},
+			info:     &pb.PageInfo{TotalCount: 5, PageSize: 2, HasNextPage: true, NextCursor: "b"},
+		},
+		{
+			name:     "last page",
+			items:    []string{"e"},
+			total:    5,
+			pageSize: size(2),
+			page:     []string{"e"},
+			info:     &pb.PageInfo{TotalCount: 5, PageSize: 1},
+		},
+		{
+			name:     "exactly full last page",
+			items:    []string{"b", "c", "d", "e"},
+			total:    5,
+			pageSize: size(4),
+			page:     []string{"b", "c", "d", "e"},
+			info:     &pb.PageInfo{TotalCount: 5, PageSize: 4},
+		},
+		{
+			name:  "cursor after last item",
+			items: []string{},
+			total: 5,
+			page:  []string{},
+			info:  &pb.PageInfo{TotalCount: 5},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			page, info := page(tt.items, identity, tt.total, tt.pageSize)
+			assert.Equal(t, tt.page, page)
+			assert.Equal(t, tt.info, info)
+		})
+	}
+}
+
+func TestPageLimit(t *testing.T) {
+	size := func(n int32) *int32 { return &n }
+
+	limit, err := pageLimit(nil)
+	require.Nil(t, err)
+	assert.Equal(t, 0, limit)
+
+	limit, err = pageLimit(size(10))
+	require.Nil(t, err)
+	assert.Equal(t, 11, limit)
+
+	_, err = pageLimit(size(0))
+	assert.ErrorContains(t, err, "page size must be positive")
+}
@@ -0,0 +1,188 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package v1
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/weaviate/weaviate/entities/models"
+	pb "github.com/weaviate/weaviate/grpc/generated/protocol/v1"
+	authErrs "github.com/weaviate/weaviate/usecases/auth/authorization/errors"
+	schemaManager "github.com/weaviate/weaviate/usecases/schema"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// streamSchemaChunkSize is the maximum number of classes per StreamSchemaChunk
+const streamSchemaChunkSize = 100
+
+// StreamSchema streams the classes the caller may read, ordered by name, in
+// chunks of at most streamSchemaChunkSize classes. Unlike a single reply the
+// stream is not limited by the maximum gRPC message size.
+func (s *Service) StreamSchema(req *pb.StreamSchemaRequest, stream pb.Weaviate_StreamSchemaServer) error {
+	principal, err := s.principalFromContext(stream.Context())
+	if err != nil {
+		return fmt.Errorf("extract auth: %w", err)
+	}
+
+	sch, err := s.schemaManager.GetSchemaFiltered(principal)
+	if err != nil {
+		return fmt.Errorf("get schema: %w", err)
+	}
+	var classes []*models.Class
+	if sch.Objects != nil {
+		classes = sch.Objects.Classes
+	}
+
+	for _, chunk := range schemaChunks(classes, req.ClassPrefix, streamSchemaChunkSize) {
+		if err := stream.Send(chunk); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// schemaChunks returns the classes whose name starts with prefix ordered by
+// name, JSON encoded in chunks of at most size classes
+func schemaChunks(classes []*models.Class, prefix string, size int) []*pb.StreamSchemaChunk {
+	matching := make([]*models.Class, 0, len(classes))
+	for _, class := range classes {
+		if strings.HasPrefix(class.Class, prefix) {
+			matching = append(matching, class)
+		}
+	}
+	sort.Slice(matching, func(i, j int) bool { return matching[i].Class < matching[j].Class })
+
+	var chunks []*pb.StreamSchemaChunk
+	for start := 0; start < len(matching); start += size {
+		end := min(start+size, len(matching))
+		chunk := &pb.StreamSchemaChunk{Classes: make([][]byte, 0, end-start)}
+		for _, class := range matching[start:end] {
+			// a class of the schema can always be encoded
+			raw, _ := json.Marshal(class)
+			chunk.Classes = append(chunk.Classes, raw)
+		}
+		chunks = append(chunks, chunk)
+	}
+	return chunks
+}
+
+// UpdateClass updates the fields of a class named by the update mask of req,
+// see schemaManager.Handler.UpdateClassWithMask
+func (s *Service) UpdateClass(ctx context.Context, req *pb.UpdateClassRequest) (*pb.UpdateClassReply, error) {
+	principal, err := s.principalFromContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("extract auth: %w", err)
+	}
+
+	if len(req.UpdateMask.GetPaths()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "update_mask must name at least one field")
+	}
+	update := &models.Class{}
+	if len(req.Class) > 0 {
+		if err := json.Unmarshal(req.Class, update); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "class is not a JSON encoded class: %v", err)
+		}
+	}
+
+	updated, err := s.schemaManager.UpdateClassWithMask(ctx, principal, req.Collection, update, req.UpdateMask.GetPaths())
+	if err != nil {
+		switch {
+		case errors.Is(err, schemaManager.ErrInvalidUpdateMask):
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		case errors.Is(err, schemaManager.ErrNotFound):
+			return nil, status.Error(codes.NotFound, err.Error())
+		case errors.As(err, &authErrs.Forbidden{}):
+			return nil, status.Error(codes.PermissionDenied, err.Error())
+		default:
+			return nil, err
+		}
+	}
+
+	raw, err := json.Marshal(updated)
+	if err != nil {
+		return nil, fmt.Errorf("marshal class: %w", err)
+	}
+	return &pb.UpdateClassReply{Class: raw}, nil
+}
+
+const (
+	defaultClassInfoInterval = time.Second
+	minClassInfoInterval     = 100 * time.Millisecond
+)
+
+// StreamClassInfo streams snapshots of the shards of a collection until the
+// client cancels the call, see schemaManager.Handler.StreamClassInfo
+func (s *Service) StreamClassInfo(req *pb.StreamClassInfoRequest, stream pb.Weaviate_StreamClassInfoServer) error {
+	principal, err := s.principalFromContext(stream.Context())
+	if err != nil {
+		return fmt.Errorf("extract auth: %w", err)
+	}
+
+	interval := defaultClassInfoInterval
+	if req.IntervalMs > 0 {
+		interval = time.Duration(req.IntervalMs) * time.Millisecond
+	}
+	if interval < minClassInfoInterval {
+		return status.Errorf(codes.InvalidArgument, "interval_ms must be at least %d", minClassInfoInterval.Milliseconds())
+	}
+
+	snapshots, errs := s.schemaManager.StreamClassInfo(stream.Context(), principal, req.Collection, interval)
+	for snapshot := range snapshots {
+		if err := stream.Send(classInfoReply(snapshot)); err != nil {
+			return err
+		}
+	}
+	if err := <-errs; err != nil {
+		switch {
+		case errors.Is(err, schemaManager.ErrNotFound):
+			return status.Error(codes.NotFound, err.Error())
+		case errors.As(err, &authErrs.Forbidden{}):
+			return status.Error(codes.PermissionDenied, err.Error())
+		default:
+			return err
+		}
+	}
+	return nil
+}
+
+func classInfoReply(snapshot schemaManager.ClassInfoSnapshot) *pb.StreamClassInfoReply {
+	lagged := make(map[string][]string, len(snapshot.Replication))
+	for _, r := range snapshot.Replication {
+		lagged[r.Shard] = r.LaggedReplicas
+	}
+
+	reply := &pb.StreamClassInfoReply{
+		TimestampUnixMs: snapshot.Time.UnixMilli(),
+		Shards:          make([]*pb.StreamClassInfoReply_Shard, 0, len(snapshot.Shards)),
+	}
+	for _, shard := range snapshot.Shards {
+		s := &pb.StreamClassInfoReply_Shard{
+			Name:            shard.Name,
+			Status:          shard.Status,
+			VectorQueueSize: shard.VectorQueueSize,
+			LaggedReplicas:  lagged[shard.Name],
+		}
+		if count, ok := snapshot.ObjectCounts[shard.Name]; ok {
+			s.ObjectCount = &count
+		}
+		reply.Shards = append(reply.Shards, s)
+	}
+	sort.Slice(reply.Shards, func(i, j int) bool { return reply.Shards[i].Name < reply.Shards[j].Name })
+	return reply
+}
@@ -0,0 +1,112 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package v1
+
+import (
+	"fmt"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+	pb "github.com/weaviate/weaviate/grpc/generated/protocol/v1"
+	schemaManager "github.com/weaviate/weaviate/usecases/schema"
+	"google.golang.org/grpc"
+)
+
+// fakeStreamSchemaClient replays chunks as the client side of a StreamSchema call
+type fakeStreamSchemaClient struct {
+	grpc.ClientStream
+	chunks []*pb.StreamSchemaChunk
+}
+
+func (c *fakeStreamSchemaClient) Recv() (*pb.StreamSchemaChunk, error) {
+	if len(c.chunks) == 0 {
+		return nil, io.EOF
+	}
+	chunk := c.chunks[0]
+	c.chunks = c.chunks[1:]
+	return chunk, nil
+}
+
+func TestSchemaChunks(t *testing.T) {
+	classes := make([]*models.Class, 0, 250)
+	for i := 249; i >= 0; i-- {
+		classes = append(classes, &models.Class{Class: fmt.Sprintf("Article%03d", i)})
+	}
+	classes = append(classes, &models.Class{Class: "Author"})
+
+	t.Run("chunked and ordered", func(t *testing.T) {
+		chunks := schemaChunks(classes, "", streamSchemaChunkSize)
+		require.Len(t, chunks, 3)
+		require.Len(t, chunks[0].Classes, 100)
+		require.Len(t, chunks[1].Classes, 100)
+		require.Len(t, chunks[2].Classes, 51)
+
+		sch, err := pb.CollectStreamSchema(&fakeStreamSchemaClient{chunks: chunks})
+		require.Nil(t, err)
+		require.Len(t, sch.Objects.Classes, 251)
+		require.Equal(t, "Article000", sch.Objects.Classes[0].Class)
+		require.Equal(t, "Article249", sch.Objects.Classes[249].Class)
+		require.Equal(t, "Author", sch.Objects.Classes[250].Class)
+	})
+
+	t.Run("prefix", func(t *testing.T) {
+		chunks := schemaChunks(classes, "Au", streamSchemaChunkSize)
+		require.Len(t, chunks, 1)
+		sch, err := pb.CollectStreamSchema(&fakeStreamSchemaClient{chunks: chunks})
+		require.Nil(t, err)
+		require.Len(t, sch.Objects.Classes, 1)
+		require.Equal(t, "Author", sch.Objects.Classes[0].Class)
+	})
+
+	t.Run("no match", func(t *testing.T) {
+		chunks := schemaChunks(classes, "Company", streamSchemaChunkSize)
+		require.Empty(t, chunks)
+		sch, err := pb.CollectStreamSchema(&fakeStreamSchemaClient{chunks: chunks})
+		require.Nil(t, err)
+		require.Empty(t, sch.Objects.Classes)
+	})
+
+	t.Run("invalid class", func(t *testing.T) {
+		_, err := pb.CollectStreamSchema(&fakeStreamSchemaClient{
+			chunks: []*pb.StreamSchemaChunk{{Classes: [][]byte{[]byte("{")}}},
+		})
+		require.ErrorContains(t, err, "decode class 0")
+	})
+}
+
+func TestClassInfoReply(t *testing.T) {
+	now := time.UnixMilli(1_700_000_000_000)
+	reply := classInfoReply(schemaManager.ClassInfoSnapshot{
+		Time: now,
+		Shards: models.ShardStatusList{
+			{Name: "S2", Status: "READONLY"},
+			{Name: "S1", Status: "READY", VectorQueueSize: 3},
+		},
+		ObjectCounts: map[string]int64{"S1": 10},
+		Replication: []schemaManager.ShardReplicationStatus{
+			{Shard: "S1", LaggedReplicas: []string{}},
+			{Shard: "S2", LaggedReplicas: []string{"node2"}},
+		},
+	})
+
+	count := int64(10)
+	require.Equal(t, &pb.StreamClassInfoReply{
+		TimestampUnixMs: now.UnixMilli(),
+		Shards: []*pb.StreamClassInfoReply_Shard{
+			{Name: "S1", Status: "READY", VectorQueueSize: 3, ObjectCount: &count, LaggedReplicas: []string{}},
+			{Name: "S2", Status: "READONLY", LaggedReplicas: []string{"node2"}},
+		},
+	}, reply)
+}
@@ -13,7 +13,10 @@ package v1
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"net/http"
+	"sort"
 	"time"
 
 	"github.com/weaviate/weaviate/entities/models"
@@ -33,6 +36,11 @@ import (
 	"github.com/weaviate/weaviate/usecases/auth/authentication/composer"
 	schemaManager "github.com/weaviate/weaviate/usecases/schema"
 	"github.com/weaviate/weaviate/usecases/traverser"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
 )
 
 type Service struct {
@@ -45,6 +53,19 @@ type Service struct {
 	config               *config.Config
 	authorizer           authorization.Authorizer
 	logger               logrus.FieldLogger
+	// batchDeleteReplies answers retried batch deletes with an idempotency key
+	batchDeleteReplies idempotencyStore
+	// batchDeleteCursors returns the objects of verbose batch deletes in pages
+	batchDeleteCursors *batchDeleteCursors
+	// batchDeleteCallbacks posts the results of batch deletes with a callback
+	// URL, see startBatchDeleteJob
+	batchDeleteCallbacks *http.Client
+	batchDeleteJobs      *batchDeleteJobs
+	batchDeleteHistory   *batchDeleteHistoryRecorder
+	batchDeleteQueue     *PriorityDeleteQueue
+	// batchDeleteInterceptor intercepts the sub-requests of MultiBatchDelete,
+	// see SetBatchDeleteInterceptor
+	batchDeleteInterceptor grpc.UnaryServerInterceptor
 }
 
 func NewService(traverser *traverser.Traverser, authComposer composer.TokenFunc,
@@ -61,6 +82,15 @@ func NewService(traverser *traverser.Traverser, authComposer composer.TokenFunc,
 		config:               config,
 		logger:               logger,
 		authorizer:           authorization,
+		batchDeleteReplies:   newLRUIdempotencyStore(config.GRPC.BatchDeleteIdempotencyTTL, maxIdempotencyRecords),
+		batchDeleteCursors: newBatchDeleteCursors(config.GRPC.BatchDeleteCursorTTL,
+			maxBatchDeleteCursorBytes, batchDeletePageSize),
+		batchDeleteCallbacks: newBatchDeleteCallbackClient(config.GRPC.BatchDeleteCallbackTimeout,
+			config.GRPC.BatchDeleteCallbackAllowedHosts),
+		batchDeleteJobs:    newBatchDeleteJobs(),
+		batchDeleteHistory: newBatchDeleteHistoryRecorder(schemaManager.RecordBatchDelete, logger),
+		batchDeleteQueue: NewPriorityDeleteQueue(config.GRPC.BatchDeleteDispatcher.MaxConcurrent,
+			config.GRPC.BatchDeleteDispatcher.MaxQueueDepth, config.GRPC.BatchDeleteDispatcher.LowPriorityPercent),
 	}
 }
 
@@ -72,14 +102,15 @@ func (s *Service) TenantsGet(ctx context.Context, req *pb.TenantsGetRequest) (*p
 		return nil, fmt.Errorf("extract auth: %w", err)
 	}
 
-	retTenants, err := s.tenantsGet(ctx, principal, req)
+	retTenants, pageInfo, err := s.tenantsGet(ctx, principal, req)
 	if err != nil {
 		return nil, fmt.Errorf("get tenants: %w", err)
 	}
 
 	result := &pb.TenantsGetReply{
-		Took:    float32(time.Since(before).Seconds()),
-		Tenants: retTenants,
+		Took:     float32(time.Since(before).Seconds()),
+		Tenants:  retTenants,
+		PageInfo: pageInfo,
 	}
 	return result, nil
 }
@@ -103,33 +134,135 @@ func (s *Service) batchDelete(ctx context.Context, req *pb.BatchDeleteRequest) (
 	if err != nil {
 		return nil, fmt.Errorf("extract auth: %w", err)
 	}
+	if req.PageToken != "" {
+		return s.batchDeleteCursors.nextPage(ctx, principal, req, func(collection string, tenants []string) error {
+			return s.authorizer.Authorize(principal, authorization.DELETE, authorization.ShardsData(collection, tenants...)...)
+		})
+	}
 	replicationProperties := extractReplicationProperties(req.ConsistencyLevel)
+	if replicationProperties == nil {
+		replicationProperties = &additional.ReplicationProperties{
+			ConsistencyLevel: string(s.schemaManager.DefaultConsistencyLevel()),
+		}
+	}
 
-	tenant := ""
-	if req.Tenant != nil {
-		tenant = *req.Tenant
+	tenants, err := batchDeleteTenants(req, s.activeTenants)
+	if err != nil {
+		return nil, err
 	}
+	perTenant := req.GetTenantList() != nil || req.AllTenants
 
-	if err := s.authorizer.Authorize(principal, authorization.DELETE, authorization.ShardsData(req.Collection, tenant)...); err != nil {
+	if err := s.authorizer.Authorize(principal, authorization.DELETE, authorization.ShardsData(req.Collection, tenants...)...); err != nil {
 		return nil, err
 	}
+	// high priority deletes skip the limits of all others, like other
+	// operations affecting the whole cluster they are for admins
+	if normalizedPriority(req.Priority) == pb.BatchDeletePriority_BATCH_DELETE_PRIORITY_HIGH {
+		if err := s.authorizer.Authorize(principal, authorization.UPDATE, authorization.Cluster()); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := validateBatchDeleteRequest(req, s.config.GRPC.MaxFilterDepth); err != nil {
+		return nil, err
+	}
+	if req.CallbackUrl != "" {
+		if err := validateCallbackURL(ctx, req.CallbackUrl, s.config.GRPC.BatchDeleteCallbackAllowedHosts); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "batch delete callback_url: %v", err)
+		}
+	}
 
 	params, err := batchDeleteParamsFromProto(req, s.classGetterWithAuthzFunc(principal))
 	if err != nil {
 		return nil, fmt.Errorf("batch delete params: %w", err)
 	}
 
-	response, err := s.batchManager.DeleteObjectsFromGRPCAfterAuth(ctx, principal, params, replicationProperties, tenant)
+	var claim *idempotencyClaim
+	if req.IdempotencyKey != "" {
+		fingerprint, err := requestFingerprint(req)
+		if err != nil {
+			return nil, fmt.Errorf("batch delete idempotency key: %w", err)
+		}
+		var cached *pb.BatchDeleteReply
+		claim, cached, err = claimIdempotencyKey(ctx, s.batchDeleteReplies, principal, req, fingerprint)
+		if err != nil {
+			return nil, err
+		}
+		if cached != nil {
+			return s.batchDeleteCursors.firstPage(ctx, principal, tenants, cached), nil
+		}
+	}
+
+	peerAddr := peerAddress(ctx)
+	run := func(ctx context.Context) (*pb.BatchDeleteReply, error) {
+		result, err := s.runBatchDelete(ctx, before, principal, req, params, replicationProperties, tenants, perTenant, claim)
+		s.recordBatchDelete(req, result, err, peerAddr, before)
+		return result, err
+	}
+	if req.CallbackUrl != "" {
+		return s.startBatchDeleteJob(ctx, req, tenants, claim, run)
+	}
+	result, err := run(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("batch delete: %w", err)
+		if claim != nil {
+			// a retry may run the delete again
+			s.batchDeleteReplies.Delete(claim.key)
+		}
+		return nil, err
 	}
+	return s.batchDeleteCursors.firstPage(ctx, principal, tenants, result), nil
+}
 
-	result, err := batchDeleteReplyFromObjects(response, req.Verbose)
+// runBatchDelete deletes the objects matched by params in all tenants and
+// stores the reply for the claimed idempotency key, if any
+func (s *Service) runBatchDelete(ctx context.Context, before time.Time, principal *models.Principal,
+	req *pb.BatchDeleteRequest, params objects.BatchDeleteParams,
+	replicationProperties *additional.ReplicationProperties, tenants []string, perTenant bool, claim *idempotencyClaim,
+) (*pb.BatchDeleteReply, error) {
+	release, err := s.batchDeleteQueue.Acquire(ctx, req.Priority)
 	if err != nil {
-		return nil, fmt.Errorf("batch delete reply: %w", err)
+		return nil, err
+	}
+	defer release()
+
+	// tenants are deleted from one after another, an error aborts the
+	// remaining ones
+	replies := make([]*pb.BatchDeleteReply, len(tenants))
+	for i, tenant := range tenants {
+		response, err := s.batchManager.DeleteObjectsFromGRPCAfterAuth(ctx, principal, params, replicationProperties, tenant)
+		if err != nil {
+			if perTenant {
+				return nil, fmt.Errorf("batch delete tenant %q: %w", tenant, err)
+			}
+			return nil, fmt.Errorf("batch delete: %w", err)
+		}
+
+		replies[i], err = batchDeleteReplyFromObjects(response, req.Verbose)
+		if err != nil {
+			return nil, fmt.Errorf("batch delete reply: %w", err)
+		}
 	}
-	result.Took = float32(time.Since(before).Seconds())
 
+	var result *pb.BatchDeleteReply
+	if perTenant {
+		result = mergeBatchDeleteReplies(tenants, replies)
+	} else {
+		result = replies[0]
+	}
+
+	echoBatchDeleteRequest(result, req)
+	took := time.Since(before)
+	result.TookDuration = durationpb.New(took)
+	// keep populating the deprecated field for clients with older stubs
+	result.Took = float32(took.Seconds())
+
+	// the idempotency key of a job keeps its job id, see startBatchDeleteJob
+	if claim != nil && req.CallbackUrl == "" {
+		s.batchDeleteReplies.Put(claim.key, idempotencyRecord{
+			fingerprint: claim.fingerprint,
+			reply:       proto.Clone(result).(*pb.BatchDeleteReply),
+		})
+	}
 	return result, nil
 }
 
@@ -229,6 +362,31 @@ func (s *Service) Search(ctx context.Context, req *pb.SearchRequest) (*pb.Search
 	return result, errInner
 }
 
+// JoinedSearch runs the search of req and adds the properties of the objects
+// that the results reference through the joins of req
+func (s *Service) JoinedSearch(ctx context.Context, req *pb.JoinedSearchRequest) (*pb.SearchReply, error) {
+	var result *pb.SearchReply
+	var errInner error
+
+	if err := enterrors.GoWrapperWithBlock(func() {
+		principal, err := s.principalFromContext(ctx)
+		if err != nil {
+			errInner = fmt.Errorf("extract auth: %w", err)
+			return
+		}
+		search, err := searchRequestWithJoins(req, s.config.GRPC.MaxJoinDepth, s.classGetterWithAuthzFunc(principal))
+		if err != nil {
+			errInner = err
+			return
+		}
+		result, errInner = s.search(ctx, search)
+	}, s.logger); err != nil {
+		return nil, err
+	}
+
+	return result, errInner
+}
+
 func (s *Service) search(ctx context.Context, req *pb.SearchRequest) (*pb.SearchReply, error) {
 	before := time.Now()
 
@@ -260,6 +418,10 @@ func (s *Service) search(ctx context.Context, req *pb.SearchRequest) (*pb.Search
 
 	res, err := s.traverser.GetClass(ctx, principal, searchParams)
 	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) {
+			// e.g. the queryTimeout of the collection
+			return nil, status.Error(codes.DeadlineExceeded, err.Error())
+		}
 		return nil, err
 	}
 
@@ -296,6 +458,26 @@ func (s *Service) classGetterWithAuthzFunc(principal *models.Principal) func(str
 	}
 }
 
+// activeTenants returns the sorted names of the HOT tenants of a multi-tenant
+// class
+func (s *Service) activeTenants(class string) ([]string, error) {
+	ss := s.schemaManager.CopyShardingState(schema.UppercaseClassName(class))
+	if ss == nil {
+		return nil, status.Errorf(codes.NotFound, "could not find class %s in schema", class)
+	}
+	if !ss.PartitioningEnabled {
+		return nil, status.Errorf(codes.InvalidArgument, "all_tenants requires multi-tenancy, collection %s has none", class)
+	}
+	tenants := make([]string, 0, len(ss.Physical))
+	for name, physical := range ss.Physical {
+		if physical.ActivityStatus() == models.TenantActivityStatusHOT {
+			tenants = append(tenants, name)
+		}
+	}
+	sort.Strings(tenants)
+	return tenants, nil
+}
+
 func extractReplicationProperties(level *pb.ConsistencyLevel) *additional.ReplicationProperties {
 	if level == nil {
 		return nil
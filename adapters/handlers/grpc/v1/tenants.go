@@ -20,44 +20,46 @@ import (
 	"github.com/weaviate/weaviate/usecases/schema"
 )
 
-func (s *Service) tenantsGet(ctx context.Context, principal *models.Principal, req *pb.TenantsGetRequest) ([]*pb.Tenant, error) {
+func (s *Service) tenantsGet(ctx context.Context, principal *models.Principal, req *pb.TenantsGetRequest) ([]*pb.Tenant, *pb.PageInfo, error) {
 	if req.Collection == "" {
-		return nil, fmt.Errorf("missing collection %s", req.Collection)
+		return nil, nil, fmt.Errorf("missing collection %s", req.Collection)
 	}
 
-	var err error
-	var tenantResponses []*models.TenantResponse
-	if req.Params == nil {
-		tenantResponses, err = s.schemaManager.GetConsistentTenants(ctx, principal, req.Collection, true, []string{})
-		if err != nil {
-			return nil, err
-		}
-	} else {
+	limit, err := pageLimit(req.PageSize)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var requestedNames []string
+	if req.Params != nil {
 		switch req.GetParams().(type) {
 		case *pb.TenantsGetRequest_Names:
-			requestedNames := req.GetNames().GetValues()
+			requestedNames = req.GetNames().GetValues()
 			if len(requestedNames) == 0 {
-				return nil, fmt.Errorf("must specify at least one tenant name")
-			}
-			tenantResponses, err = s.schemaManager.GetConsistentTenants(ctx, principal, req.Collection, true, requestedNames)
-			if err != nil {
-				return nil, err
+				return nil, nil, fmt.Errorf("must specify at least one tenant name")
 			}
 		default:
-			return nil, fmt.Errorf("unknown tenant parameter %v", req.Params)
+			return nil, nil, fmt.Errorf("unknown tenant parameter %v", req.Params)
 		}
 	}
 
-	tenants := schema.TenantResponsesToTenants(tenantResponses)
+	// the tenants are paged by the schema, only the requested page is read
+	tenantResponses, total, err := s.schemaManager.GetConsistentTenantsPage(ctx, principal,
+		req.Collection, requestedNames, req.Cursor, limit)
+	if err != nil {
+		return nil, nil, err
+	}
+	tenants, pageInfo := page(schema.TenantResponsesToTenants(tenantResponses),
+		func(t *models.Tenant) string { return t.Name }, total, req.PageSize)
 	retTenants := make([]*pb.Tenant, len(tenants))
 	for i, tenant := range tenants {
 		tenantGRPC, err := tenantToGRPC(tenant)
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 		retTenants[i] = tenantGRPC
 	}
-	return retTenants, nil
+	return retTenants, pageInfo, nil
 }
 
 func tenantToGRPC(tenant *models.Tenant) (*pb.Tenant, error) {
@@ -60,6 +60,9 @@ type indices struct {
 	regexpObject              *regexp.Regexp
 	regexpReferences          *regexp.Regexp
 	regexpShardsQueueSize     *regexp.Regexp
+	regexpShardsObjectCount   *regexp.Regexp
+	regexpShardsDataPurged    *regexp.Regexp
+	regexpShardsPrewarm       *regexp.Regexp
 	regexpShardsStatus        *regexp.Regexp
 	regexpShardFiles          *regexp.Regexp
 	regexpShard               *regexp.Regexp
@@ -96,6 +99,12 @@ const (
 		`\/shards\/(` + sh + `)\/references`
 	urlPatternShardsQueueSize = `\/indices\/(` + cl + `)` +
 		`\/shards\/(` + sh + `)\/queuesize`
+	urlPatternShardsObjectCount = `\/indices\/(` + cl + `)` +
+		`\/shards\/(` + sh + `)\/objectcount`
+	urlPatternShardsDataPurged = `\/indices\/(` + cl + `)` +
+		`\/shards\/(` + sh + `)\/purged`
+	urlPatternShardsPrewarm = `\/indices\/(` + cl + `)` +
+		`\/shards\/(` + sh + `)\/prewarm`
 	urlPatternShardsStatus = `\/indices\/(` + cl + `)` +
 		`\/shards\/(` + sh + `)\/status`
 	urlPatternShardFiles = `\/indices\/(` + cl + `)` +
@@ -137,6 +146,9 @@ type shards interface {
 	DeleteObjectBatch(ctx context.Context, indexName, shardName string,
 		uuids []strfmt.UUID, deletionTime time.Time, dryRun bool, schemaVersion uint64) objects.BatchSimpleObjects
 	GetShardQueueSize(ctx context.Context, indexName, shardName string) (int64, error)
+	GetShardObjectCount(ctx context.Context, indexName, shardName string) (int64, error)
+	GetShardDataPurged(ctx context.Context, indexName, shardName string) (bool, error)
+	PrewarmShard(ctx context.Context, indexName, shardName string) error
 	GetShardStatus(ctx context.Context, indexName, shardName string) (string, error)
 	UpdateShardStatus(ctx context.Context, indexName, shardName,
 		targetStatus string, schemaVersion uint64) error
@@ -176,6 +188,9 @@ func NewIndices(shards shards, db db, auth auth, maintenanceModeEnabled func() b
 		regexpObject:              regexp.MustCompile(urlPatternObject),
 		regexpReferences:          regexp.MustCompile(urlPatternReferences),
 		regexpShardsQueueSize:     regexp.MustCompile(urlPatternShardsQueueSize),
+		regexpShardsObjectCount:   regexp.MustCompile(urlPatternShardsObjectCount),
+		regexpShardsDataPurged:    regexp.MustCompile(urlPatternShardsDataPurged),
+		regexpShardsPrewarm:       regexp.MustCompile(urlPatternShardsPrewarm),
 		regexpShardsStatus:        regexp.MustCompile(urlPatternShardsStatus),
 		regexpShardFiles:          regexp.MustCompile(urlPatternShardFiles),
 		regexpShard:               regexp.MustCompile(urlPatternShard),
@@ -300,6 +315,27 @@ func (i *indices) indicesHandler() http.HandlerFunc {
 			}
 			http.Error(w, "405 Method not Allowed", http.StatusMethodNotAllowed)
 			return
+		case i.regexpShardsObjectCount.MatchString(path):
+			if r.Method == http.MethodGet {
+				i.getGetShardObjectCount().ServeHTTP(w, r)
+				return
+			}
+			http.Error(w, "405 Method not Allowed", http.StatusMethodNotAllowed)
+			return
+		case i.regexpShardsDataPurged.MatchString(path):
+			if r.Method == http.MethodGet {
+				i.getGetShardDataPurged().ServeHTTP(w, r)
+				return
+			}
+			http.Error(w, "405 Method not Allowed", http.StatusMethodNotAllowed)
+			return
+		case i.regexpShardsPrewarm.MatchString(path):
+			if r.Method == http.MethodPost {
+				i.postPrewarmShard().ServeHTTP(w, r)
+				return
+			}
+			http.Error(w, "405 Method not Allowed", http.StatusMethodNotAllowed)
+			return
 		case i.regexpShardsStatus.MatchString(path):
 			if r.Method == http.MethodGet {
 				i.getGetShardStatus().ServeHTTP(w, r)
@@ -1197,6 +1233,92 @@ func (i *indices) getGetShardQueueSize() http.Handler {
 	})
 }
 
+func (i *indices) getGetShardObjectCount() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		args := i.regexpShardsObjectCount.FindStringSubmatch(r.URL.Path)
+		if len(args) != 3 {
+			http.Error(w, "invalid URI", http.StatusBadRequest)
+			return
+		}
+
+		index, shard := args[1], args[2]
+
+		defer r.Body.Close()
+
+		i.logger.WithFields(logrus.Fields{
+			"shard":  shard,
+			"action": "GetShardObjectCount",
+		}).Debug("getting shard object count ...")
+
+		count, err := i.shards.GetShardObjectCount(r.Context(), index, shard)
+		if err != nil && errors.As(err, &enterrors.ErrUnprocessable{}) {
+			http.Error(w, err.Error(), http.StatusUnprocessableEntity)
+			return
+		}
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		countBytes, err := IndicesPayloads.GetShardObjectCountResults.Marshal(count)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		IndicesPayloads.GetShardObjectCountResults.SetContentTypeHeader(w)
+		w.Write(countBytes)
+	})
+}
+
+func (i *indices) getGetShardDataPurged() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		args := i.regexpShardsDataPurged.FindStringSubmatch(r.URL.Path)
+		if len(args) != 3 {
+			http.Error(w, "invalid URI", http.StatusBadRequest)
+			return
+		}
+
+		index, shard := args[1], args[2]
+
+		defer r.Body.Close()
+
+		purged, err := i.shards.GetShardDataPurged(r.Context(), index, shard)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		purgedBytes, err := IndicesPayloads.GetShardDataPurgedResults.Marshal(purged)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		IndicesPayloads.GetShardDataPurgedResults.SetContentTypeHeader(w)
+		w.Write(purgedBytes)
+	})
+}
+
+func (i *indices) postPrewarmShard() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		args := i.regexpShardsPrewarm.FindStringSubmatch(r.URL.Path)
+		if len(args) != 3 {
+			http.Error(w, "invalid URI", http.StatusBadRequest)
+			return
+		}
+
+		index, shard := args[1], args[2]
+
+		defer r.Body.Close()
+
+		if err := i.shards.PrewarmShard(r.Context(), index, shard); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+	})
+}
+
 func (i *indices) getGetShardStatus() http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		args := i.regexpShardsStatus.FindStringSubmatch(r.URL.Path)
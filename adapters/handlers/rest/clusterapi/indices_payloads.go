@@ -39,30 +39,34 @@ import (
 var IndicesPayloads = indicesPayloads{}
 
 type indicesPayloads struct {
-	ErrorList                 errorListPayload
-	SingleObject              singleObjectPayload
-	MergeDoc                  mergeDocPayload
-	ObjectList                objectListPayload
-	VersionedObjectList       versionedObjectListPayload
-	SearchResults             searchResultsPayload
-	SearchParams              searchParamsPayload
-	VectorDistanceParams      vectorDistanceParamsPayload
-	VectorDistanceResults     vectorDistanceResultsPayload
-	ReferenceList             referenceListPayload
-	AggregationParams         aggregationParamsPayload
-	AggregationResult         aggregationResultPayload
-	FindUUIDsParams           findUUIDsParamsPayload
-	FindUUIDsResults          findUUIDsResultsPayload
-	BatchDeleteParams         batchDeleteParamsPayload
-	BatchDeleteResults        batchDeleteResultsPayload
-	GetShardQueueSizeParams   getShardQueueSizeParamsPayload
-	GetShardQueueSizeResults  getShardQueueSizeResultsPayload
-	GetShardStatusParams      getShardStatusParamsPayload
-	GetShardStatusResults     getShardStatusResultsPayload
-	UpdateShardStatusParams   updateShardStatusParamsPayload
-	UpdateShardsStatusResults updateShardsStatusResultsPayload
-	ShardFiles                shardFilesPayload
-	IncreaseReplicationFactor increaseReplicationFactorPayload
+	ErrorList                  errorListPayload
+	SingleObject               singleObjectPayload
+	MergeDoc                   mergeDocPayload
+	ObjectList                 objectListPayload
+	VersionedObjectList        versionedObjectListPayload
+	SearchResults              searchResultsPayload
+	SearchParams               searchParamsPayload
+	VectorDistanceParams       vectorDistanceParamsPayload
+	VectorDistanceResults      vectorDistanceResultsPayload
+	ReferenceList              referenceListPayload
+	AggregationParams          aggregationParamsPayload
+	AggregationResult          aggregationResultPayload
+	FindUUIDsParams            findUUIDsParamsPayload
+	FindUUIDsResults           findUUIDsResultsPayload
+	BatchDeleteParams          batchDeleteParamsPayload
+	BatchDeleteResults         batchDeleteResultsPayload
+	GetShardQueueSizeParams    getShardQueueSizeParamsPayload
+	GetShardQueueSizeResults   getShardQueueSizeResultsPayload
+	GetShardObjectCountParams  getShardObjectCountParamsPayload
+	GetShardObjectCountResults getShardObjectCountResultsPayload
+	GetShardDataPurgedParams   getShardDataPurgedParamsPayload
+	GetShardDataPurgedResults  getShardDataPurgedResultsPayload
+	GetShardStatusParams       getShardStatusParamsPayload
+	GetShardStatusResults      getShardStatusResultsPayload
+	UpdateShardStatusParams    updateShardStatusParamsPayload
+	UpdateShardsStatusResults  updateShardsStatusResultsPayload
+	ShardFiles                 shardFilesPayload
+	IncreaseReplicationFactor  increaseReplicationFactorPayload
 }
 
 type increaseReplicationFactorPayload struct{}
@@ -827,6 +831,86 @@ func (p getShardQueueSizeResultsPayload) CheckContentTypeHeader(r *http.Response
 	return ct, ct == p.MIME()
 }
 
+type getShardObjectCountParamsPayload struct{}
+
+func (p getShardObjectCountParamsPayload) MIME() string {
+	return "vnd.weaviate.getshardobjectcountparams+json"
+}
+
+func (p getShardObjectCountParamsPayload) CheckContentTypeHeaderReq(r *http.Request) (string, bool) {
+	ct := r.Header.Get("content-type")
+	return ct, ct == p.MIME()
+}
+
+func (p getShardObjectCountParamsPayload) SetContentTypeHeaderReq(r *http.Request) {
+	r.Header.Set("content-type", p.MIME())
+}
+
+type getShardObjectCountResultsPayload struct{}
+
+func (p getShardObjectCountResultsPayload) Unmarshal(in []byte) (int64, error) {
+	var out int64
+	err := json.Unmarshal(in, &out)
+	return out, err
+}
+
+func (p getShardObjectCountResultsPayload) Marshal(in int64) ([]byte, error) {
+	return json.Marshal(in)
+}
+
+func (p getShardObjectCountResultsPayload) MIME() string {
+	return "application/vnd.weaviate.getshardobjectcountresults+octet-stream"
+}
+
+func (p getShardObjectCountResultsPayload) SetContentTypeHeader(w http.ResponseWriter) {
+	w.Header().Set("content-type", p.MIME())
+}
+
+func (p getShardObjectCountResultsPayload) CheckContentTypeHeader(r *http.Response) (string, bool) {
+	ct := r.Header.Get("content-type")
+	return ct, ct == p.MIME()
+}
+
+type getShardDataPurgedParamsPayload struct{}
+
+func (p getShardDataPurgedParamsPayload) MIME() string {
+	return "vnd.weaviate.getsharddatapurgedparams+json"
+}
+
+func (p getShardDataPurgedParamsPayload) CheckContentTypeHeaderReq(r *http.Request) (string, bool) {
+	ct := r.Header.Get("content-type")
+	return ct, ct == p.MIME()
+}
+
+func (p getShardDataPurgedParamsPayload) SetContentTypeHeaderReq(r *http.Request) {
+	r.Header.Set("content-type", p.MIME())
+}
+
+type getShardDataPurgedResultsPayload struct{}
+
+func (p getShardDataPurgedResultsPayload) Unmarshal(in []byte) (bool, error) {
+	var out bool
+	err := json.Unmarshal(in, &out)
+	return out, err
+}
+
+func (p getShardDataPurgedResultsPayload) Marshal(in bool) ([]byte, error) {
+	return json.Marshal(in)
+}
+
+func (p getShardDataPurgedResultsPayload) MIME() string {
+	return "application/vnd.weaviate.getsharddatapurgedresults+json"
+}
+
+func (p getShardDataPurgedResultsPayload) SetContentTypeHeader(w http.ResponseWriter) {
+	w.Header().Set("content-type", p.MIME())
+}
+
+func (p getShardDataPurgedResultsPayload) CheckContentTypeHeader(r *http.Response) (string, bool) {
+	ct := r.Header.Get("content-type")
+	return ct, ct == p.MIME()
+}
+
 type getShardStatusParamsPayload struct{}
 
 func (p getShardStatusParamsPayload) MIME() string {
@@ -49,6 +49,7 @@ func TestMaintenanceModeIndices(t *testing.T) {
 		{"DELETE", "/objects"},
 		{"POST", "/references"},
 		{"GET", "/queuesize"},
+		{"GET", "/objectcount"},
 		{"GET", "/status"},
 		{"POST", "/status"},
 		{"POST", "/files/myfile"},
@@ -364,6 +364,7 @@ func MakeAppState(ctx context.Context, options *swag.CommandLineOptionsGroup) *s
 	migrator.SetNode(appState.Cluster.LocalName())
 	// TODO-offload: "offload-s3" has to come from config when enable modules more than S3
 	migrator.SetOffloadProvider(appState.Modules, "offload-s3")
+	migrator.SetVectorizer(appState.Modules)
 	appState.Migrator = migrator
 
 	vectorRepo = repo
@@ -425,6 +426,7 @@ func MakeAppState(ctx context.Context, options *swag.CommandLineOptionsGroup) *s
 		SnapshotInterval:       appState.ServerConfig.Config.Raft.SnapshotInterval,
 		SnapshotThreshold:      appState.ServerConfig.Config.Raft.SnapshotThreshold,
 		ConsistencyWaitTimeout: appState.ServerConfig.Config.Raft.ConsistencyWaitTimeout,
+		SchemaChangelogSize:    appState.ServerConfig.Config.Raft.SchemaChangelogSize,
 		MetadataOnlyVoters:     appState.ServerConfig.Config.Raft.MetadataOnlyVoters,
 		EnableOneNodeRecovery:  appState.ServerConfig.Config.Raft.EnableOneNodeRecovery,
 		ForceOneNodeRecovery:   appState.ServerConfig.Config.Raft.ForceOneNodeRecovery,
@@ -441,6 +443,8 @@ func MakeAppState(ctx context.Context, options *swag.CommandLineOptionsGroup) *s
 		FQDNResolverTLD:        appState.ServerConfig.Config.Raft.FQDNResolverTLD,
 		SentryEnabled:          appState.ServerConfig.Config.Sentry.Enabled,
 		AuthzController:        appState.AuthzController,
+		MaxPropertiesPerClass:  appState.ServerConfig.Config.Schema.MaxPropertiesPerClass,
+		MaxTenantsPerClass:     appState.ServerConfig.Config.Schema.MaxTenantsPerClass,
 	}
 	for _, name := range appState.ServerConfig.Config.Raft.Join[:rConfig.BootstrapExpect] {
 		if strings.Contains(name, rConfig.NodeID) {
@@ -458,7 +462,7 @@ func MakeAppState(ctx context.Context, options *swag.CommandLineOptionsGroup) *s
 	)
 
 	offloadmod, _ := appState.Modules.OffloadBackend("offload-s3")
-	schemaManager, err := schemaUC.NewManager(migrator,
+	schemaManager, err := schemaUC.NewManager(migrator, executor,
 		appState.ClusterService.Raft,
 		appState.ClusterService.SchemaReader(),
 		schemaRepo,
@@ -474,7 +478,19 @@ func MakeAppState(ctx context.Context, options *swag.CommandLineOptionsGroup) *s
 		os.Exit(1)
 	}
 
+	if appState.ServerConfig.Config.Monitoring.Enabled {
+		schemaManager.WithMetrics(schemaUC.NewSchemaHandlerMetrics(prometheus.DefaultRegisterer))
+	}
+	// gossip the applied schema index until the server shuts down
+	var appliedIndexCtx context.Context
+	appliedIndexCtx, appState.AppliedIndexCancel = context.WithCancel(context.Background())
+	appState.Cluster.PublishAppliedIndex(appliedIndexCtx, appState.ClusterService.Raft.AppliedIndex, time.Second)
+	schemaManager.WithReplicationChecker(appState.Cluster)
+
 	appState.SchemaManager = schemaManager
+	executor.RegisterEventListener(schemaManager.SchemaEventListener())
+	executor.RegisterEventListener(schemaManager.PropertyAccessListener())
+	appState.DataAuthorizer = schemaUC.NewACLAuthorizer(appState.Authorizer, schemaManager)
 	appState.RemoteIndexIncoming = sharding.NewRemoteIndexIncoming(repo, appState.ClusterService.SchemaReader(), appState.Modules)
 	appState.RemoteNodeIncoming = sharding.NewRemoteNodeIncoming(repo)
 	appState.RemoteReplicaIncoming = replica.NewRemoteReplicaIncoming(repo, appState.ClusterService.SchemaReader())
@@ -490,9 +506,10 @@ func MakeAppState(ctx context.Context, options *swag.CommandLineOptionsGroup) *s
 	appState.Modules.SetSchemaGetter(schemaManager)
 
 	appState.Traverser = traverser.NewTraverser(appState.ServerConfig, appState.Locks,
-		appState.Logger, appState.Authorizer, vectorRepo, explorer, schemaManager,
+		appState.Logger, appState.DataAuthorizer, vectorRepo, explorer, schemaManager,
 		appState.Modules, traverser.NewMetrics(appState.Metrics),
 		appState.ServerConfig.Config.MaximumConcurrentGetRequests)
+	appState.Traverser.WithPropertyAccessRecorder(schemaManager)
 
 	updateSchemaCallback := makeUpdateSchemaCall(appState)
 	executor.RegisterSchemaUpdateCallback(updateSchemaCallback)
@@ -531,7 +548,7 @@ func MakeAppState(ctx context.Context, options *swag.CommandLineOptionsGroup) *s
 
 	batchManager := objects.NewBatchManager(vectorRepo, appState.Modules,
 		appState.Locks, schemaManager, appState.ServerConfig, appState.Logger,
-		appState.Authorizer, appState.Metrics)
+		appState.DataAuthorizer, appState.Metrics)
 	appState.BatchManager = batchManager
 
 	err = migrator.AdjustFilterablePropSettings(ctx)
@@ -574,6 +591,20 @@ func MakeAppState(ctx context.Context, options *swag.CommandLineOptionsGroup) *s
 		}, appState.Logger)
 	}
 
+	// duplicate properties are left by concurrent property additions and need
+	// to be repaired by the operator, the schema is only loaded with the store
+	enterrors.GoWrapper(func() {
+		<-storeReadyCtx.Done()
+		if context.Cause(storeReadyCtx) == metaStoreReadyErr {
+			schemaManager.WarnDuplicateProperties()
+		}
+	}, appState.Logger)
+
+	// delete expired classes until the server shuts down
+	var classExpiryCtx context.Context
+	classExpiryCtx, appState.ClassExpiryCtxCancel = context.WithCancel(context.Background())
+	schemaManager.StartExpiryWorker(classExpiryCtx)
+
 	configureServer = makeConfigureServer(appState)
 
 	// Add dimensions to all the objects in the database, if requested by the user
@@ -658,14 +689,14 @@ func configureAPI(api *operations.WeaviateAPI) http.Handler {
 	setupSchemaHandlers(api, appState.SchemaManager, appState.Metrics, appState.Logger)
 	objectsManager := objects.NewManager(appState.Locks,
 		appState.SchemaManager, appState.ServerConfig, appState.Logger,
-		appState.Authorizer, appState.DB, appState.Modules,
+		appState.DataAuthorizer, appState.DB, appState.Modules,
 		objects.NewMetrics(appState.Metrics), appState.MemWatch)
 	setupObjectHandlers(api, objectsManager, appState.ServerConfig.Config, appState.Logger,
 		appState.Modules, appState.Metrics)
 	setupObjectBatchHandlers(api, appState.BatchManager, appState.Metrics, appState.Logger)
 	setupGraphQLHandlers(api, appState, appState.SchemaManager, appState.ServerConfig.Config.DisableGraphQL,
 		appState.Metrics, appState.Logger)
-	setupMiscHandlers(api, appState.ServerConfig, appState.Modules,
+	setupMiscHandlers(api, appState.ServerConfig, appState.Modules, appState.SchemaManager,
 		appState.Metrics, appState.Logger)
 	setupClassificationHandlers(api, classifier, appState.Metrics, appState.Logger)
 	backupScheduler := startBackupScheduler(appState)
@@ -674,7 +705,7 @@ func configureAPI(api *operations.WeaviateAPI) http.Handler {
 
 	grpcServer := createGrpcServer(appState)
 	setupMiddlewares := makeSetupMiddlewares(appState)
-	setupGlobalMiddleware := makeSetupGlobalMiddleware(appState, api.Context())
+	setupGlobalMiddleware := makeSetupGlobalMiddleware(appState, api.Context(), grpcServer.BatchDelete)
 
 	telemeter := telemetry.New(appState.DB, appState.SchemaManager, appState.Logger)
 	if telemetryEnabled(appState) {
@@ -707,6 +738,8 @@ func configureAPI(api *operations.WeaviateAPI) http.Handler {
 
 		// stop reindexing on server shutdown
 		appState.ReindexCtxCancel()
+		appState.ClassExpiryCtxCancel()
+		appState.AppliedIndexCancel()
 
 		// gracefully stop gRPC server
 		grpcServer.GracefulStop()
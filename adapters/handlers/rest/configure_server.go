@@ -56,7 +56,7 @@ func makeUpdateSchemaCall(appState *state.State) func(schema.Schema) {
 			appState.ServerConfig.Config,
 			appState.Traverser,
 			appState.Modules,
-			appState.Authorizer,
+			appState.DataAuthorizer,
 		)
 		if err != nil && err != utils.ErrEmptySchema {
 			appState.Logger.WithField("action", "graphql_rebuild").
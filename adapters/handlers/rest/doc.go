@@ -36,6 +36,7 @@
 //
 //	Produces:
 //	  - application/json
+//	  - text/plain
 //
 // swagger:meta
 package rest
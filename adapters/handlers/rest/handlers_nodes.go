@@ -21,6 +21,7 @@ import (
 	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/nodes"
 	"github.com/weaviate/weaviate/adapters/handlers/rest/state"
 	"github.com/weaviate/weaviate/adapters/repos/db"
+	"github.com/weaviate/weaviate/cluster/types"
 	enterrors "github.com/weaviate/weaviate/entities/errors"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/verbosity"
@@ -32,6 +33,7 @@ import (
 
 type nodesHandlers struct {
 	manager             *nodesUC.Manager
+	schemaManager       *schemaUC.Manager
 	metricRequestsTotal restApiRequestsTotal
 }
 
@@ -99,6 +101,26 @@ func (n *nodesHandlers) getNodesStatistics(params cluster.ClusterGetStatisticsPa
 	return cluster.NewClusterGetStatisticsOK().WithPayload(statistics)
 }
 
+func (n *nodesHandlers) triggerSnapshot(params cluster.ClusterSnapshotParams, principal *models.Principal) middleware.Responder {
+	if err := n.schemaManager.TriggerSnapshot(params.HTTPRequest.Context(), principal); err != nil {
+		n.metricRequestsTotal.logError("", err)
+		switch {
+		case errors.As(err, &autherrs.Forbidden{}):
+			return cluster.NewClusterSnapshotForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		case errors.Is(err, types.ErrSnapshotInProgress):
+			return cluster.NewClusterSnapshotConflict().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return cluster.NewClusterSnapshotInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	n.metricRequestsTotal.logOk("")
+	return cluster.NewClusterSnapshotOK()
+}
+
 func (n *nodesHandlers) handleGetNodesError(err error) middleware.Responder {
 	n.metricRequestsTotal.logError("", err)
 	if errors.As(err, &enterrors.ErrNotFound{}) {
@@ -123,13 +145,15 @@ func setupNodesHandlers(api *operations.WeaviateAPI,
 	nodesManager := nodesUC.NewManager(appState.Logger, appState.Authorizer,
 		repo, schemaManger)
 
-	h := &nodesHandlers{nodesManager, newNodesRequestsTotal(appState.Metrics, appState.Logger)}
+	h := &nodesHandlers{nodesManager, schemaManger, newNodesRequestsTotal(appState.Metrics, appState.Logger)}
 	api.NodesNodesGetHandler = nodes.
 		NodesGetHandlerFunc(h.getNodesStatus)
 	api.NodesNodesGetClassHandler = nodes.
 		NodesGetClassHandlerFunc(h.getNodesStatusByClass)
 	api.ClusterClusterGetStatisticsHandler = cluster.
 		ClusterGetStatisticsHandlerFunc(h.getNodesStatistics)
+	api.ClusterClusterSnapshotHandler = cluster.
+		ClusterSnapshotHandlerFunc(h.triggerSnapshot)
 }
 
 type nodesRequestsTotal struct {
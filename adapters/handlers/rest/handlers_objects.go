@@ -29,6 +29,7 @@ import (
 	"github.com/weaviate/weaviate/usecases/config"
 	"github.com/weaviate/weaviate/usecases/monitoring"
 	uco "github.com/weaviate/weaviate/usecases/objects"
+	"github.com/weaviate/weaviate/usecases/objects/validation"
 	"github.com/weaviate/weaviate/usecases/replica"
 )
 
@@ -86,8 +87,8 @@ func (h *objectHandlers) addObject(params objects.ObjectsCreateParams,
 	}
 	className := getClassName(params.Body)
 
-	object, err := h.manager.AddObject(params.HTTPRequest.Context(),
-		principal, params.Body, repl)
+	ctx := validation.ContextWithWarnings(params.HTTPRequest.Context())
+	object, err := h.manager.AddObject(ctx, principal, params.Body, repl)
 	if err != nil {
 		h.metricRequestsTotal.logError(className, err)
 		if errors.As(err, &uco.ErrInvalidUserInput{}) {
@@ -96,6 +97,12 @@ func (h *objectHandlers) addObject(params objects.ObjectsCreateParams,
 		} else if errors.As(err, &uco.ErrMultiTenancy{}) {
 			return objects.NewObjectsCreateUnprocessableEntity().
 				WithPayload(errPayloadFromSingleErr(err))
+		} else if errors.As(err, &uco.ErrCollectionReadOnly{}) {
+			return objects.NewObjectsCreateUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		} else if errors.As(err, &uco.ErrClassObjectLimitExceeded{}) {
+			return objects.NewObjectsCreateUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
 		} else if errors.As(err, &autherrs.Forbidden{}) {
 			return objects.NewObjectsCreateForbidden().
 				WithPayload(errPayloadFromSingleErr(err))
@@ -111,7 +118,8 @@ func (h *objectHandlers) addObject(params objects.ObjectsCreateParams,
 	}
 
 	h.metricRequestsTotal.logOk(className)
-	return objects.NewObjectsCreateOK().WithPayload(object)
+	return objects.NewObjectsCreateOK().WithPayload(object).
+		WithXWeaviateDeprecationWarning(deprecationWarning(ctx))
 }
 
 func (h *objectHandlers) validateObject(params objects.ObjectsValidateParams,
@@ -364,7 +372,8 @@ func (h *objectHandlers) updateObject(params objects.ObjectsClassPutParams,
 			WithPayload(errPayloadFromSingleErr(err))
 	}
 
-	object, err := h.manager.UpdateObject(params.HTTPRequest.Context(),
+	ctx := validation.ContextWithWarnings(params.HTTPRequest.Context())
+	object, err := h.manager.UpdateObject(ctx,
 		principal, params.ClassName, params.ID, params.Body, repl)
 	if err != nil {
 		h.metricRequestsTotal.logError(className, err)
@@ -374,6 +383,9 @@ func (h *objectHandlers) updateObject(params objects.ObjectsClassPutParams,
 		} else if errors.As(err, &uco.ErrMultiTenancy{}) {
 			return objects.NewObjectsClassPutUnprocessableEntity().
 				WithPayload(errPayloadFromSingleErr(err))
+		} else if errors.As(err, &uco.ErrCollectionReadOnly{}) {
+			return objects.NewObjectsClassPutUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
 		} else if errors.As(err, &autherrs.Forbidden{}) {
 			return objects.NewObjectsClassPutForbidden().
 				WithPayload(errPayloadFromSingleErr(err))
@@ -389,7 +401,8 @@ func (h *objectHandlers) updateObject(params objects.ObjectsClassPutParams,
 	}
 
 	h.metricRequestsTotal.logOk(className)
-	return objects.NewObjectsClassPutOK().WithPayload(object)
+	return objects.NewObjectsClassPutOK().WithPayload(object).
+		WithXWeaviateDeprecationWarning(deprecationWarning(ctx))
 }
 
 func (h *objectHandlers) headObject(params objects.ObjectsClassHeadParams,
@@ -443,7 +456,8 @@ func (h *objectHandlers) patchObject(params objects.ObjectsClassPatchParams, pri
 			WithPayload(errPayloadFromSingleErr(err))
 	}
 
-	objErr := h.manager.MergeObject(params.HTTPRequest.Context(), principal, updates, repl)
+	ctx := validation.ContextWithWarnings(params.HTTPRequest.Context())
+	objErr := h.manager.MergeObject(ctx, principal, updates, repl)
 	if objErr != nil {
 		h.metricRequestsTotal.logError(getClassName(updates), objErr)
 		switch {
@@ -465,7 +479,14 @@ func (h *objectHandlers) patchObject(params objects.ObjectsClassPatchParams, pri
 	}
 
 	h.metricRequestsTotal.logOk(getClassName(updates))
-	return objects.NewObjectsClassPatchNoContent()
+	return objects.NewObjectsClassPatchNoContent().
+		WithXWeaviateDeprecationWarning(deprecationWarning(ctx))
+}
+
+// deprecationWarning joins the warnings about writes to deprecated
+// properties collected in ctx, it is empty if there were none
+func deprecationWarning(ctx context.Context) string {
+	return strings.Join(validation.WarningsFromContext(ctx), "; ")
 }
 
 func (h *objectHandlers) addObjectReference(
@@ -17,14 +17,17 @@ import (
 	"net/http/httptest"
 	"testing"
 
+	middleware "github.com/go-openapi/runtime/middleware"
 	"github.com/go-openapi/strfmt"
 	"github.com/sirupsen/logrus"
 	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/objects"
 	"github.com/weaviate/weaviate/entities/additional"
 	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
 	"github.com/weaviate/weaviate/usecases/auth/authorization/errors"
 	"github.com/weaviate/weaviate/usecases/config"
 	uco "github.com/weaviate/weaviate/usecases/objects"
+	"github.com/weaviate/weaviate/usecases/objects/validation"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -226,6 +229,48 @@ func TestEnrichObjectsWithLinks(t *testing.T) {
 		}
 	})
 
+	t.Run("add object to deprecated property", func(t *testing.T) {
+		vTrue := true
+		class := &models.Class{
+			Class: "Foo",
+			Properties: []*models.Property{
+				{Name: "name", DataType: schema.DataTypeText.PropString()},
+				{
+					Name: "title", DataType: schema.DataTypeText.PropString(),
+					Deprecated: &vTrue, DeprecationMessage: "use name instead",
+				},
+			},
+		}
+		add := func(class *models.Class, props map[string]interface{}) middleware.Responder {
+			fakeManager := &fakeManager{addObjectClass: class}
+			h := &objectHandlers{manager: fakeManager, metricRequestsTotal: &fakeMetricRequestsTotal{}}
+			return h.addObject(objects.ObjectsCreateParams{
+				HTTPRequest: httptest.NewRequest("POST", "/v1/objects", nil),
+				Body:        &models.Object{Class: "Foo", Properties: props},
+			}, nil)
+		}
+
+		t.Run("no warning without deprecated properties", func(t *testing.T) {
+			parsed, ok := add(class, map[string]interface{}{"name": "foo"}).(*objects.ObjectsCreateOK)
+			require.True(t, ok)
+			assert.Empty(t, parsed.XWeaviateDeprecationWarning)
+		})
+
+		t.Run("warning in header", func(t *testing.T) {
+			parsed, ok := add(class, map[string]interface{}{"title": "foo"}).(*objects.ObjectsCreateOK)
+			require.True(t, ok)
+			assert.Equal(t, "class 'Foo' with property 'title' is deprecated: use name instead",
+				parsed.XWeaviateDeprecationWarning)
+		})
+
+		t.Run("error if class enforces deprecation", func(t *testing.T) {
+			enforcing := *class
+			enforcing.EnforceDeprecation = true
+			_, ok := add(&enforcing, map[string]interface{}{"title": "foo"}).(*objects.ObjectsCreateUnprocessableEntity)
+			assert.True(t, ok)
+		})
+	})
+
 	t.Run("get objects", func(t *testing.T) {
 		type test struct {
 			name           string
@@ -1055,6 +1100,7 @@ type fakeManager struct {
 	getObjectErr    error
 
 	addObjectReturn    *models.Object
+	addObjectClass     *models.Class
 	queryResult        []*models.Object
 	queryErr           *uco.Error
 	updateObjectReturn *models.Object
@@ -1074,9 +1120,16 @@ func (f *fakeManager) HeadObject(context.Context, *models.Principal,
 	return f.headObjectReturn, f.headObjectErr
 }
 
-func (f *fakeManager) AddObject(_ context.Context, _ *models.Principal,
+func (f *fakeManager) AddObject(ctx context.Context, _ *models.Principal,
 	object *models.Object, _ *additional.ReplicationProperties,
 ) (*models.Object, error) {
+	if f.addObjectClass != nil {
+		err := validation.New(nil, &config.WeaviateConfig{}, nil, nil).
+			Object(ctx, f.addObjectClass, object, nil)
+		if err != nil {
+			return nil, uco.NewErrInvalidUserInput("invalid object: %v", err)
+		}
+	}
 	return object, nil
 }
 
@@ -14,12 +14,19 @@ package rest
 import (
 	"errors"
 	"fmt"
+	"net/http"
+	"strings"
 
+	"github.com/go-openapi/runtime"
 	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
 	"github.com/sirupsen/logrus"
 	"github.com/weaviate/weaviate/adapters/handlers/rest/operations"
 	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/schema"
+	clusterSchema "github.com/weaviate/weaviate/cluster/schema"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
 	"github.com/weaviate/weaviate/entities/models"
+	entschema "github.com/weaviate/weaviate/entities/schema"
 	authErrors "github.com/weaviate/weaviate/usecases/auth/authorization/errors"
 	"github.com/weaviate/weaviate/usecases/monitoring"
 	uco "github.com/weaviate/weaviate/usecases/objects"
@@ -34,7 +41,7 @@ type schemaHandlers struct {
 func (s *schemaHandlers) addClass(params schema.SchemaObjectsCreateParams,
 	principal *models.Principal,
 ) middleware.Responder {
-	_, _, err := s.manager.AddClass(params.HTTPRequest.Context(), principal, params.ObjectClass)
+	res, err := s.manager.AddClass(params.HTTPRequest.Context(), principal, params.ObjectClass)
 	if err != nil {
 		s.metricRequestsTotal.logError(params.ObjectClass.Class, err)
 		switch {
@@ -48,7 +55,9 @@ func (s *schemaHandlers) addClass(params schema.SchemaObjectsCreateParams,
 	}
 
 	s.metricRequestsTotal.logOk(params.ObjectClass.Class)
-	return schema.NewSchemaObjectsCreateOK().WithPayload(params.ObjectClass)
+	// respond with the class as stored, including all defaults set by the server
+	return schema.NewSchemaObjectsCreateOK().WithPayload(res.Class).
+		WithXWeaviateConsistencyToken(res.Token().String())
 }
 
 func (s *schemaHandlers) updateClass(params schema.SchemaObjectsUpdateParams,
@@ -76,6 +85,30 @@ func (s *schemaHandlers) updateClass(params schema.SchemaObjectsUpdateParams,
 	return schema.NewSchemaObjectsUpdateOK().WithPayload(params.ObjectClass)
 }
 
+func (s *schemaHandlers) patchClass(params schema.SchemaObjectsPatchParams,
+	principal *models.Principal,
+) middleware.Responder {
+	err := s.manager.SetCollectionReadOnly(params.HTTPRequest.Context(), principal, params.ClassName,
+		params.Body.ReadOnly)
+	if err != nil {
+		s.metricRequestsTotal.logError(params.ClassName, err)
+		switch {
+		case errors.Is(err, schemaUC.ErrNotFound):
+			return schema.NewSchemaObjectsPatchNotFound().
+				WithPayload(errPayloadFromSingleErr(err))
+		case errors.As(err, &authErrors.Forbidden{}):
+			return schema.NewSchemaObjectsPatchForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return schema.NewSchemaObjectsPatchUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	s.metricRequestsTotal.logOk(params.ClassName)
+	return schema.NewSchemaObjectsPatchOK()
+}
+
 func (s *schemaHandlers) getClass(params schema.SchemaObjectsGetParams,
 	principal *models.Principal,
 ) middleware.Responder {
@@ -96,13 +129,19 @@ func (s *schemaHandlers) getClass(params schema.SchemaObjectsGetParams,
 		s.metricRequestsTotal.logUserError(params.ClassName)
 		return schema.NewSchemaObjectsGetNotFound()
 	}
+	if class.MaxObjects > 0 {
+		// the count is kept by the schema, not as part of the class
+		if count, err := s.manager.ClassObjectCount(class.Class); err == nil {
+			class.ObjectCount = count
+		}
+	}
 
 	s.metricRequestsTotal.logOk(params.ClassName)
 	return schema.NewSchemaObjectsGetOK().WithPayload(class)
 }
 
 func (s *schemaHandlers) deleteClass(params schema.SchemaObjectsDeleteParams, principal *models.Principal) middleware.Responder {
-	err := s.manager.DeleteClass(params.HTTPRequest.Context(), principal, params.ClassName)
+	_, err := s.manager.DeleteClass(params.HTTPRequest.Context(), principal, params.ClassName)
 	if err != nil {
 		s.metricRequestsTotal.logError(params.ClassName, err)
 		switch {
@@ -121,7 +160,13 @@ func (s *schemaHandlers) deleteClass(params schema.SchemaObjectsDeleteParams, pr
 func (s *schemaHandlers) addClassProperty(params schema.SchemaObjectsPropertiesAddParams,
 	principal *models.Principal,
 ) middleware.Responder {
-	_, _, err := s.manager.AddClassProperty(params.HTTPRequest.Context(), principal, s.manager.ReadOnlyClass(params.ClassName), params.ClassName, false, params.Body)
+	// respond with the property as stored, with the defaults applied to the
+	// fields the request left unset
+	prop, job, err := s.manager.AddPropertyWithBackfill(params.HTTPRequest.Context(), principal, params.ClassName, params.Body)
+	var backfillErr schemaUC.ErrBackfillNotStarted
+	if errors.As(err, &backfillErr) {
+		err = nil
+	}
 	if err != nil {
 		s.metricRequestsTotal.logError(params.ClassName, err)
 		switch {
@@ -135,11 +180,336 @@ func (s *schemaHandlers) addClassProperty(params schema.SchemaObjectsPropertiesA
 	}
 
 	s.metricRequestsTotal.logOk(params.ClassName)
-	return schema.NewSchemaObjectsPropertiesAddOK().WithPayload(params.Body)
+	res := schema.NewSchemaObjectsPropertiesAddOK().WithPayload(prop).
+		WithXWeaviateBackfillJobID(job)
+	if backfillErr.Err != nil {
+		res = res.WithXWeaviateBackfillError(backfillErr.Error())
+	}
+	return res
+}
+
+func (s *schemaHandlers) listPropertyGroups(params schema.SchemaObjectsGroupsListParams,
+	principal *models.Principal,
+) middleware.Responder {
+	groups, err := s.manager.GetPropertyGroups(principal, params.ClassName)
+	if err != nil {
+		s.metricRequestsTotal.logError(params.ClassName, err)
+		switch {
+		case errors.As(err, &authErrors.Forbidden{}):
+			return schema.NewSchemaObjectsGroupsListForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		case errors.Is(err, schemaUC.ErrNotFound):
+			return schema.NewSchemaObjectsGroupsListNotFound()
+		default:
+			return schema.NewSchemaObjectsGroupsListInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	s.metricRequestsTotal.logOk(params.ClassName)
+	return schema.NewSchemaObjectsGroupsListOK().WithPayload(groups)
+}
+
+func (s *schemaHandlers) createPropertyGroup(params schema.SchemaObjectsGroupsCreateParams,
+	principal *models.Principal,
+) middleware.Responder {
+	err := s.manager.SetPropertyGroup(params.HTTPRequest.Context(), principal, params.ClassName,
+		params.Body.Group, params.Body.Properties)
+	if err != nil {
+		s.metricRequestsTotal.logError(params.ClassName, err)
+		switch {
+		case errors.As(err, &authErrors.Forbidden{}):
+			return schema.NewSchemaObjectsGroupsCreateForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		case errors.Is(err, schemaUC.ErrNotFound):
+			return schema.NewSchemaObjectsGroupsCreateNotFound()
+		default:
+			return schema.NewSchemaObjectsGroupsCreateUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	s.metricRequestsTotal.logOk(params.ClassName)
+	return schema.NewSchemaObjectsGroupsCreateOK().WithPayload(params.Body.Group)
+}
+
+func (s *schemaHandlers) getPropertyGroup(params schema.SchemaObjectsGroupsGetParams,
+	principal *models.Principal,
+) middleware.Responder {
+	props, err := s.manager.GetPropertiesByGroup(principal, params.ClassName, params.GroupName)
+	if err != nil {
+		s.metricRequestsTotal.logError(params.ClassName, err)
+		switch {
+		case errors.As(err, &authErrors.Forbidden{}):
+			return schema.NewSchemaObjectsGroupsGetForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		case errors.Is(err, schemaUC.ErrNotFound):
+			return schema.NewSchemaObjectsGroupsGetNotFound()
+		default:
+			return schema.NewSchemaObjectsGroupsGetInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	s.metricRequestsTotal.logOk(params.ClassName)
+	return schema.NewSchemaObjectsGroupsGetOK().WithPayload(props)
+}
+
+func (s *schemaHandlers) getReplicationStatus(params schema.SchemaObjectsReplicationGetParams,
+	principal *models.Principal,
+) middleware.Responder {
+	statuses, err := s.manager.GetClassReplicationStatus(params.HTTPRequest.Context(), principal, params.ClassName)
+	if err != nil {
+		s.metricRequestsTotal.logError(params.ClassName, err)
+		switch {
+		case errors.As(err, &authErrors.Forbidden{}):
+			return schema.NewSchemaObjectsReplicationGetForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		case errors.Is(err, schemaUC.ErrNotFound):
+			return schema.NewSchemaObjectsReplicationGetNotFound()
+		case errors.Is(err, schemaUC.ErrNoReplicationConfigured):
+			return schema.NewSchemaObjectsReplicationGetUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return schema.NewSchemaObjectsReplicationGetInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	payload := make([]*models.ShardReplicationStatus, len(statuses))
+	for i, status := range statuses {
+		payload[i] = &models.ShardReplicationStatus{
+			Shard:          status.Shard,
+			Replicas:       status.Replicas,
+			SyncedReplicas: status.SyncedReplicas,
+			LaggedReplicas: status.LaggedReplicas,
+			IsHealthy:      status.IsHealthy,
+		}
+	}
+	s.metricRequestsTotal.logOk(params.ClassName)
+	return schema.NewSchemaObjectsReplicationGetOK().WithPayload(payload)
+}
+
+func (s *schemaHandlers) getPropertyAccessStats(params schema.SchemaObjectsStatsGetParams,
+	principal *models.Principal,
+) middleware.Responder {
+	stats, err := s.manager.GetPropertyAccessStats(principal, params.ClassName)
+	if err != nil {
+		s.metricRequestsTotal.logError(params.ClassName, err)
+		switch {
+		case errors.As(err, &authErrors.Forbidden{}):
+			return schema.NewSchemaObjectsStatsGetForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		case errors.Is(err, schemaUC.ErrNotFound):
+			return schema.NewSchemaObjectsStatsGetNotFound()
+		default:
+			return schema.NewSchemaObjectsStatsGetInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	s.metricRequestsTotal.logOk(params.ClassName)
+	return schema.NewSchemaObjectsStatsGetOK().WithPayload(stats)
+}
+
+func (s *schemaHandlers) getPropertyStats(params schema.SchemaObjectsPropertiesStatsGetParams,
+	principal *models.Principal,
+) middleware.Responder {
+	stats, err := s.manager.GetPropertyStats(params.HTTPRequest.Context(), principal,
+		params.ClassName, params.PropertyName)
+	if err != nil {
+		s.metricRequestsTotal.logError(params.ClassName, err)
+		switch {
+		case errors.As(err, &authErrors.Forbidden{}):
+			return schema.NewSchemaObjectsPropertiesStatsGetForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		case errors.Is(err, schemaUC.ErrNotFound):
+			return schema.NewSchemaObjectsPropertiesStatsGetNotFound()
+		case errors.As(err, &uco.ErrInvalidUserInput{}):
+			return schema.NewSchemaObjectsPropertiesStatsGetUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return schema.NewSchemaObjectsPropertiesStatsGetInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	s.metricRequestsTotal.logOk(params.ClassName)
+	return schema.NewSchemaObjectsPropertiesStatsGetOK().WithPayload(&models.PropertyStats{
+		NullCount:          stats.NullCount,
+		TotalCount:         stats.TotalCount,
+		DistinctValueCount: stats.DistinctValueCount,
+		AvgValueLength:     stats.AvgValueLength,
+		ComputedAt:         strfmt.DateTime(stats.ComputedAt),
+	})
+}
+
+func (s *schemaHandlers) backfillVectors(params schema.SchemaObjectsVectorsBackfillParams,
+	principal *models.Principal,
+) middleware.Responder {
+	var opts schemaUC.BackfillVectorOptions
+	if params.Body != nil {
+		opts = schemaUC.BackfillVectorOptions{
+			BatchSize:         int(params.Body.BatchSize),
+			ConcurrentWorkers: int(params.Body.ConcurrentWorkers),
+			OnlyNilVectors:    params.Body.OnlyNilVectors,
+			TargetVector:      params.Body.TargetVector,
+		}
+	}
+	jobID, err := s.manager.BackfillVectors(params.HTTPRequest.Context(), principal, params.ClassName, opts)
+	if err != nil {
+		s.metricRequestsTotal.logError(params.ClassName, err)
+		switch {
+		case errors.As(err, &authErrors.Forbidden{}):
+			return schema.NewSchemaObjectsVectorsBackfillForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		case errors.Is(err, schemaUC.ErrNotFound):
+			return schema.NewSchemaObjectsVectorsBackfillNotFound()
+		case errors.As(err, &uco.ErrInvalidUserInput{}):
+			return schema.NewSchemaObjectsVectorsBackfillUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return schema.NewSchemaObjectsVectorsBackfillInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	s.metricRequestsTotal.logOk(params.ClassName)
+	return schema.NewSchemaObjectsVectorsBackfillAccepted().WithPayload(&models.VectorBackfillJob{ID: jobID})
+}
+
+func (s *schemaHandlers) getVectorBackfill(params schema.SchemaObjectsVectorsBackfillGetParams,
+	principal *models.Principal,
+) middleware.Responder {
+	job, err := s.manager.BackfillVectorStatus(params.HTTPRequest.Context(), principal, params.JobID)
+	if err == nil && job.Class != entschema.UppercaseClassName(params.ClassName) {
+		err = fmt.Errorf("vector backfill job %q of class %q: %w", params.JobID, params.ClassName, schemaUC.ErrNotFound)
+	}
+	if err != nil {
+		s.metricRequestsTotal.logError(params.ClassName, err)
+		switch {
+		case errors.As(err, &authErrors.Forbidden{}):
+			return schema.NewSchemaObjectsVectorsBackfillGetForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		case errors.Is(err, schemaUC.ErrNotFound):
+			return schema.NewSchemaObjectsVectorsBackfillGetNotFound()
+		default:
+			return schema.NewSchemaObjectsVectorsBackfillGetInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	status := &models.VectorBackfillStatus{
+		ID:           job.ID,
+		Class:        job.Class,
+		TargetVector: job.TargetVector,
+		Status:       string(job.Status),
+		Shards:       int64(job.Shards),
+		ShardsDone:   int64(job.ShardsDone),
+		Scanned:      job.Scanned,
+		Updated:      job.Updated,
+		Failed:       job.Failed,
+		Error:        job.Error,
+		StartedAt:    strfmt.DateTime(job.StartedAt),
+	}
+	if !job.FinishedAt.IsZero() {
+		status.FinishedAt = strfmt.DateTime(job.FinishedAt)
+	}
+	s.metricRequestsTotal.logOk(params.ClassName)
+	return schema.NewSchemaObjectsVectorsBackfillGetOK().WithPayload(status)
+}
+
+func (s *schemaHandlers) validateObject(params schema.SchemaObjectsValidateParams,
+	principal *models.Principal,
+) middleware.Responder {
+	properties, ok := params.Body.(map[string]interface{})
+	if !ok {
+		return schema.NewSchemaObjectsValidateUnprocessableEntity().
+			WithPayload(errPayloadFromSingleErr(fmt.Errorf("expected the properties as an object, got %T", params.Body)))
+	}
+
+	validationErrs, err := s.manager.ValidateObjectAgainstClass(principal, params.ClassName, properties)
+	if err != nil {
+		s.metricRequestsTotal.logError(params.ClassName, err)
+		switch {
+		case errors.As(err, &authErrors.Forbidden{}):
+			return schema.NewSchemaObjectsValidateForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		case errors.Is(err, schemaUC.ErrNotFound):
+			return schema.NewSchemaObjectsValidateNotFound()
+		default:
+			return schema.NewSchemaObjectsValidateInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	payload := &models.ObjectValidationResult{
+		Valid:  len(validationErrs) == 0,
+		Errors: make([]*models.PropertyValidationError, len(validationErrs)),
+	}
+	for i, e := range validationErrs {
+		payload.Errors[i] = &models.PropertyValidationError{Property: e.Property, Message: e.Message}
+	}
+	s.metricRequestsTotal.logOk(params.ClassName)
+	return schema.NewSchemaObjectsValidateOK().WithPayload(payload)
+}
+
+func (s *schemaHandlers) getIndexingRecommendations(params schema.SchemaObjectsStatsRecommendationsGetParams,
+	principal *models.Principal,
+) middleware.Responder {
+	recommendations, err := s.manager.GenerateIndexingRecommendations(principal, params.ClassName)
+	if err != nil {
+		s.metricRequestsTotal.logError(params.ClassName, err)
+		switch {
+		case errors.As(err, &authErrors.Forbidden{}):
+			return schema.NewSchemaObjectsStatsRecommendationsGetForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		case errors.Is(err, schemaUC.ErrNotFound):
+			return schema.NewSchemaObjectsStatsRecommendationsGetNotFound()
+		default:
+			return schema.NewSchemaObjectsStatsRecommendationsGetInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	payload := make([]*models.IndexRecommendation, len(recommendations))
+	for i, r := range recommendations {
+		payload[i] = &models.IndexRecommendation{
+			Property:    r.Property,
+			Index:       r.Index,
+			Enable:      r.Enable,
+			AccessCount: r.AccessCount,
+			Reason:      r.Reason,
+		}
+	}
+	s.metricRequestsTotal.logOk(params.ClassName)
+	return schema.NewSchemaObjectsStatsRecommendationsGetOK().WithPayload(payload)
 }
 
 func (s *schemaHandlers) getSchema(params schema.SchemaDumpParams, principal *models.Principal) middleware.Responder {
-	dbSchema, err := s.manager.GetConsistentSchema(principal, *params.Consistency)
+	if query, ok, err := classSearchQuery(params); err != nil {
+		s.metricRequestsTotal.logUserError("")
+		return schema.NewSchemaDumpUnprocessableEntity().WithPayload(errPayloadFromSingleErr(err))
+	} else if ok {
+		return s.searchClasses(query, principal)
+	}
+
+	consistency := *params.Consistency
+	if params.XWeaviateWaitForToken != nil {
+		token, err := schemaUC.ParseReadYourWritesToken(*params.XWeaviateWaitForToken)
+		if err != nil {
+			s.metricRequestsTotal.logUserError("")
+			return schema.NewSchemaDumpUnprocessableEntity().WithPayload(errPayloadFromSingleErr(err))
+		}
+		if err := s.manager.ReadAtToken(params.HTTPRequest.Context(), token); err != nil {
+			s.metricRequestsTotal.logError("", err)
+			return schema.NewSchemaDumpInternalServerError().WithPayload(errPayloadFromSingleErr(err))
+		}
+		// the local schema includes the change of the token
+		consistency = false
+	}
+
+	dbSchema, err := s.manager.GetConsistentSchema(principal, consistency)
 	if err != nil {
 		s.metricRequestsTotal.logError("", err)
 		switch {
@@ -157,6 +527,158 @@ func (s *schemaHandlers) getSchema(params schema.SchemaDumpParams, principal *mo
 	return schema.NewSchemaDumpOK().WithPayload(payload)
 }
 
+func (s *schemaHandlers) exportGraphQLSchema(params schema.SchemaGraphqlParams, principal *models.Principal) middleware.Responder {
+	sdl, err := s.manager.ExportGraphQLSDL(principal)
+	if err != nil {
+		s.metricRequestsTotal.logError("", err)
+		switch {
+		case errors.As(err, &authErrors.Forbidden{}):
+			return schema.NewSchemaGraphqlForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return schema.NewSchemaGraphqlInternalServerError().WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	s.metricRequestsTotal.logOk("")
+	return schema.NewSchemaGraphqlOK().WithPayload(sdl)
+}
+
+func (s *schemaHandlers) getSchemaGraph(params schema.SchemaGraphParams, principal *models.Principal) middleware.Responder {
+	graph, err := s.manager.GetSchemaDependencyGraph(principal)
+	if err != nil {
+		s.metricRequestsTotal.logError("", err)
+		switch {
+		case errors.As(err, &authErrors.Forbidden{}):
+			return schema.NewSchemaGraphForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return schema.NewSchemaGraphInternalServerError().WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	s.metricRequestsTotal.logOk("")
+	switch *params.Format {
+	case "dot":
+		return textResponder(graph.DOT())
+	case "mermaid":
+		return textResponder(graph.Mermaid())
+	}
+	payload := &models.SchemaDependencyGraph{Nodes: graph.Nodes, Cycles: graph.Cycles}
+	payload.Edges = make([]*models.SchemaDependencyEdge, len(graph.Edges))
+	for i, e := range graph.Edges {
+		payload.Edges[i] = &models.SchemaDependencyEdge{From: e.From, To: e.To, Property: e.Property}
+	}
+	return schema.NewSchemaGraphOK().WithPayload(payload)
+}
+
+// textResponder writes text as plain text 200 response, the payload of
+// SchemaGraphOK is the graph as JSON
+func textResponder(text string) middleware.Responder {
+	return middleware.ResponderFunc(func(rw http.ResponseWriter, _ runtime.Producer) {
+		rw.Header().Set("Content-Type", "text/plain; charset=utf-8")
+		rw.WriteHeader(http.StatusOK)
+		rw.Write([]byte(text))
+	})
+}
+
+func (s *schemaHandlers) searchClasses(query schemaUC.ClassSearchQuery, principal *models.Principal) middleware.Responder {
+	classes, err := s.manager.SearchClasses(principal, query)
+	if err != nil {
+		s.metricRequestsTotal.logError("", err)
+		switch {
+		case errors.Is(err, clusterSchema.ErrBadRequest):
+			return schema.NewSchemaDumpUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		case errors.As(err, &authErrors.Forbidden{}):
+			return schema.NewSchemaDumpForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return schema.NewSchemaDumpInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	s.metricRequestsTotal.logOk("")
+	return schema.NewSchemaDumpOK().WithPayload(&models.Schema{Classes: classes})
+}
+
+// classSearchQuery returns the class search of the filter parameters of a
+// schema dump and whether any of them is set
+func classSearchQuery(params schema.SchemaDumpParams) (schemaUC.ClassSearchQuery, bool, error) {
+	var query schemaUC.ClassSearchQuery
+	set := false
+	for _, p := range []struct {
+		param *string
+		field *string
+	}{
+		{params.NamePrefix, &query.NamePrefix},
+		{params.NameContains, &query.NameContains},
+		{params.HasModule, &query.HasModule},
+		{params.Vectorizer, &query.VectorizerEquals},
+		{params.After, &query.After},
+	} {
+		if p.param != nil {
+			*p.field = *p.param
+			set = true
+		}
+	}
+	if params.Module != nil {
+		if params.HasModule != nil && *params.HasModule != *params.Module {
+			return query, true, fmt.Errorf("module and hasModule must not be combined")
+		}
+		query.HasModule = *params.Module
+		set = true
+	}
+	if params.Limit != nil {
+		query.Limit = int(*params.Limit)
+		set = true
+	}
+	if params.HasLabel != nil {
+		set = true
+		query.HasLabel = map[string]string{}
+		for _, pair := range strings.Split(*params.HasLabel, ",") {
+			k, v, ok := strings.Cut(pair, "=")
+			if !ok || k == "" {
+				return query, true, fmt.Errorf("hasLabel: %q is not a key=value pair", pair)
+			}
+			query.HasLabel[k] = v
+		}
+	}
+	return query, set, nil
+}
+
+func (s *schemaHandlers) validateSchema(params schema.SchemaValidateParams, principal *models.Principal) middleware.Responder {
+	issues, err := s.manager.ValidateSchemaIntegrity(principal)
+	if err != nil {
+		s.metricRequestsTotal.logError("", err)
+		switch {
+		case errors.As(err, &authErrors.Forbidden{}):
+			return schema.NewSchemaValidateForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return schema.NewSchemaValidateInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	payload := &models.SchemaIntegrityReport{
+		Issues: make([]*models.SchemaIntegrityIssue, len(issues)),
+	}
+	for i, issue := range issues {
+		payload.Issues[i] = &models.SchemaIntegrityIssue{
+			Class:           issue.Class,
+			Property:        issue.Property,
+			ReferencedClass: issue.ReferencedClass,
+			Module:          issue.Module,
+			IssueType:       string(issue.IssueType),
+		}
+	}
+
+	s.metricRequestsTotal.logOk("")
+	return schema.NewSchemaValidateOK().WithPayload(payload)
+}
+
 func (s *schemaHandlers) getShardsStatus(params schema.SchemaObjectsShardsGetParams,
 	principal *models.Principal,
 ) middleware.Responder {
@@ -186,6 +708,33 @@ func (s *schemaHandlers) getShardsStatus(params schema.SchemaObjectsShardsGetPar
 	return schema.NewSchemaObjectsShardsGetOK().WithPayload(payload)
 }
 
+func (s *schemaHandlers) getShardObjectCount(params schema.SchemaObjectsShardsCountParams,
+	principal *models.Principal,
+) middleware.Responder {
+	count, err := s.manager.GetShardObjectCount(params.HTTPRequest.Context(), principal, params.ClassName, params.ShardName)
+	if err != nil {
+		s.metricRequestsTotal.logError("", err)
+		switch {
+		case errors.As(err, &authErrors.Forbidden{}):
+			return schema.NewSchemaObjectsShardsCountForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		case errors.Is(err, schemaUC.ErrNotFound):
+			return schema.NewSchemaObjectsShardsCountNotFound().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return schema.NewSchemaObjectsShardsCountInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	s.metricRequestsTotal.logOk("")
+	return schema.NewSchemaObjectsShardsCountOK().WithPayload(&models.ShardObjectCount{
+		Class: params.ClassName,
+		Shard: params.ShardName,
+		Count: count,
+	})
+}
+
 func (s *schemaHandlers) updateShardStatus(params schema.SchemaObjectsShardsUpdateParams,
 	principal *models.Principal,
 ) middleware.Responder {
@@ -342,6 +891,28 @@ func (s *schemaHandlers) tenantExists(params schema.TenantExistsParams, principa
 	return schema.NewTenantExistsOK()
 }
 
+func (s *schemaHandlers) prewarmTenant(params schema.TenantsPrewarmParams, principal *models.Principal) middleware.Responder {
+	if err := s.manager.PrewarmTenant(params.HTTPRequest.Context(), principal, params.ClassName, params.TenantName); err != nil {
+		s.metricRequestsTotal.logError(params.ClassName, err)
+		switch {
+		case errors.Is(err, schemaUC.ErrNotFound):
+			return schema.NewTenantsPrewarmNotFound()
+		case errors.As(err, &authErrors.Forbidden{}):
+			return schema.NewTenantsPrewarmForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		case errors.Is(err, enterrors.ErrTenantNotActive):
+			return schema.NewTenantsPrewarmUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return schema.NewTenantsPrewarmInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	s.metricRequestsTotal.logOk(params.ClassName)
+	return schema.NewTenantsPrewarmOK()
+}
+
 func setupSchemaHandlers(api *operations.WeaviateAPI, manager *schemaUC.Manager, metrics *monitoring.PrometheusMetrics, logger logrus.FieldLogger) {
 	h := &schemaHandlers{manager, newSchemaRequestsTotal(metrics, logger)}
 
@@ -354,16 +925,46 @@ func setupSchemaHandlers(api *operations.WeaviateAPI, manager *schemaUC.Manager,
 
 	api.SchemaSchemaObjectsUpdateHandler = schema.
 		SchemaObjectsUpdateHandlerFunc(h.updateClass)
+	api.SchemaSchemaObjectsPatchHandler = schema.
+		SchemaObjectsPatchHandlerFunc(h.patchClass)
 
 	api.SchemaSchemaObjectsGetHandler = schema.
 		SchemaObjectsGetHandlerFunc(h.getClass)
+	api.SchemaSchemaObjectsGroupsListHandler = schema.
+		SchemaObjectsGroupsListHandlerFunc(h.listPropertyGroups)
+	api.SchemaSchemaObjectsGroupsCreateHandler = schema.
+		SchemaObjectsGroupsCreateHandlerFunc(h.createPropertyGroup)
+	api.SchemaSchemaObjectsGroupsGetHandler = schema.
+		SchemaObjectsGroupsGetHandlerFunc(h.getPropertyGroup)
+	api.SchemaSchemaObjectsReplicationGetHandler = schema.
+		SchemaObjectsReplicationGetHandlerFunc(h.getReplicationStatus)
+	api.SchemaSchemaObjectsStatsGetHandler = schema.
+		SchemaObjectsStatsGetHandlerFunc(h.getPropertyAccessStats)
+	api.SchemaSchemaObjectsPropertiesStatsGetHandler = schema.
+		SchemaObjectsPropertiesStatsGetHandlerFunc(h.getPropertyStats)
+	api.SchemaSchemaObjectsValidateHandler = schema.
+		SchemaObjectsValidateHandlerFunc(h.validateObject)
+	api.SchemaSchemaObjectsVectorsBackfillHandler = schema.
+		SchemaObjectsVectorsBackfillHandlerFunc(h.backfillVectors)
+	api.SchemaSchemaObjectsVectorsBackfillGetHandler = schema.
+		SchemaObjectsVectorsBackfillGetHandlerFunc(h.getVectorBackfill)
+	api.SchemaSchemaObjectsStatsRecommendationsGetHandler = schema.
+		SchemaObjectsStatsRecommendationsGetHandlerFunc(h.getIndexingRecommendations)
 	api.SchemaSchemaDumpHandler = schema.
 		SchemaDumpHandlerFunc(h.getSchema)
+	api.SchemaSchemaGraphqlHandler = schema.
+		SchemaGraphqlHandlerFunc(h.exportGraphQLSchema)
+	api.SchemaSchemaGraphHandler = schema.
+		SchemaGraphHandlerFunc(h.getSchemaGraph)
+	api.SchemaSchemaValidateHandler = schema.
+		SchemaValidateHandlerFunc(h.validateSchema)
 
 	api.SchemaSchemaObjectsShardsGetHandler = schema.
 		SchemaObjectsShardsGetHandlerFunc(h.getShardsStatus)
 	api.SchemaSchemaObjectsShardsUpdateHandler = schema.
 		SchemaObjectsShardsUpdateHandlerFunc(h.updateShardStatus)
+	api.SchemaSchemaObjectsShardsCountHandler = schema.
+		SchemaObjectsShardsCountHandlerFunc(h.getShardObjectCount)
 
 	api.SchemaTenantsCreateHandler = schema.TenantsCreateHandlerFunc(h.createTenants)
 	api.SchemaTenantsUpdateHandler = schema.TenantsUpdateHandlerFunc(h.updateTenants)
@@ -371,6 +972,7 @@ func setupSchemaHandlers(api *operations.WeaviateAPI, manager *schemaUC.Manager,
 	api.SchemaTenantsGetHandler = schema.TenantsGetHandlerFunc(h.getTenants)
 	api.SchemaTenantExistsHandler = schema.TenantExistsHandlerFunc(h.tenantExists)
 	api.SchemaTenantsGetOneHandler = schema.TenantsGetOneHandlerFunc(h.getTenant)
+	api.SchemaTenantsPrewarmHandler = schema.TenantsPrewarmHandlerFunc(h.prewarmTenant)
 }
 
 type schemaRequestsTotal struct {
@@ -0,0 +1,158 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rest
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/auth/authentication/composer"
+	authErrors "github.com/weaviate/weaviate/usecases/auth/authorization/errors"
+	schemaUC "github.com/weaviate/weaviate/usecases/schema"
+	"golang.org/x/net/websocket"
+)
+
+const (
+	schemaSubscribePath = "/v1/schema/subscribe"
+	// schemaEventWriteTimeout bounds how long a slow client may block the
+	// delivery of a single event
+	schemaEventWriteTimeout = 10 * time.Second
+	// closeStatusPolicyViolation is sent to subscribers which fell too far
+	// behind
+	closeStatusPolicyViolation = 1008
+)
+
+type schemaSubscriber interface {
+	SubscribeSchemaEvents(principal *models.Principal) (*schemaUC.SchemaSubscription, error)
+	UnsubscribeSchemaEvents(s *schemaUC.SchemaSubscription)
+}
+
+// addSchemaSubscribe serves the schema events over a WebSocket at
+// schemaSubscribePath, which is not part of the swagger spec. Clients
+// authenticate with the same bearer token as for the REST API. Browsers may
+// only connect from pages of the server, see checkSchemaSubscribeOrigin.
+func addSchemaSubscribe(next http.Handler, subscriber schemaSubscriber,
+	tokenFunc composer.TokenFunc, allowAnonymousAccess bool, origin string, logger logrus.FieldLogger,
+) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != schemaSubscribePath {
+			next.ServeHTTP(w, r)
+			return
+		}
+		if r.Method != http.MethodGet {
+			w.Header().Set("Allow", http.MethodGet)
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+
+		principal, err := principalFromRequest(r, tokenFunc, allowAnonymousAccess)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusUnauthorized)
+			return
+		}
+		sub, err := subscriber.SubscribeSchemaEvents(principal)
+		if err != nil {
+			if errors.As(err, &authErrors.Forbidden{}) {
+				http.Error(w, err.Error(), http.StatusForbidden)
+				return
+			}
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		defer subscriber.UnsubscribeSchemaEvents(sub)
+
+		if _, ok := w.(http.Hijacker); !ok {
+			http.Error(w, "websockets are not supported", http.StatusInternalServerError)
+			return
+		}
+
+		websocket.Server{
+			Handshake: checkSchemaSubscribeOrigin(origin),
+			Handler: func(ws *websocket.Conn) {
+				streamSchemaEvents(ws, sub, subscriber, logger)
+			},
+		}.ServeHTTP(w, r)
+	})
+}
+
+// checkSchemaSubscribeOrigin accepts WebSocket handshakes without an Origin
+// header, which only browsers send, and from the host of the request or of
+// the configured origin of the server. Other pages could otherwise subscribe
+// on behalf of their visitors, e.g. if anonymous access is enabled.
+func checkSchemaSubscribeOrigin(origin string) func(*websocket.Config, *http.Request) error {
+	var allowed string
+	if u, err := url.Parse(origin); err == nil {
+		allowed = u.Host
+	}
+	return func(cfg *websocket.Config, r *http.Request) error {
+		from, err := websocket.Origin(cfg, r)
+		if err != nil {
+			return err
+		}
+		if from == nil || strings.EqualFold(from.Host, r.Host) ||
+			(allowed != "" && strings.EqualFold(from.Host, allowed)) {
+			return nil
+		}
+		return fmt.Errorf("origin %q is not allowed", from)
+	}
+}
+
+func streamSchemaEvents(ws *websocket.Conn, sub *schemaUC.SchemaSubscription,
+	subscriber schemaSubscriber, logger logrus.FieldLogger,
+) {
+	// Clients don't send messages, reading only notices when they disconnect
+	enterrors.GoWrapper(func() {
+		io.Copy(io.Discard, ws)
+		subscriber.UnsubscribeSchemaEvents(sub)
+	}, logger)
+
+	for event := range sub.Events() {
+		ws.SetWriteDeadline(time.Now().Add(schemaEventWriteTimeout))
+		if err := websocket.JSON.Send(ws, event); err != nil {
+			logger.WithField("action", "schema_subscribe").WithError(err).
+				Debug("subscriber disconnected")
+			subscriber.UnsubscribeSchemaEvents(sub)
+			return
+		}
+	}
+
+	if sub.Dropped() {
+		logger.WithField("action", "schema_subscribe").
+			Warn("dropped schema subscriber which fell behind")
+		ws.WriteClose(closeStatusPolicyViolation)
+		return
+	}
+	ws.Close()
+}
+
+// principalFromRequest authenticates r by its bearer token, see
+// principalFromContext of the gRPC service
+func principalFromRequest(r *http.Request, tokenFunc composer.TokenFunc,
+	allowAnonymousAccess bool,
+) (*models.Principal, error) {
+	authValue := r.Header.Get("Authorization")
+	if !strings.HasPrefix(authValue, "Bearer ") {
+		if allowAnonymousAccess {
+			return nil, nil
+		}
+		return tokenFunc("", nil)
+	}
+	return tokenFunc(strings.TrimPrefix(authValue, "Bearer "), nil)
+}
@@ -0,0 +1,165 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rest
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+	authErrors "github.com/weaviate/weaviate/usecases/auth/authorization/errors"
+	schemaUC "github.com/weaviate/weaviate/usecases/schema"
+	"golang.org/x/net/websocket"
+)
+
+type fakeSchemaSubscriber struct {
+	*schemaUC.SchemaEventBroadcaster
+	subscribed chan struct{}
+	err        error
+}
+
+func (f *fakeSchemaSubscriber) SubscribeSchemaEvents(*models.Principal) (*schemaUC.SchemaSubscription, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	defer close(f.subscribed)
+	return f.Subscribe(), nil
+}
+
+func (f *fakeSchemaSubscriber) UnsubscribeSchemaEvents(s *schemaUC.SchemaSubscription) {
+	f.Unsubscribe(s)
+}
+
+type teeConn struct {
+	net.Conn
+	r io.Reader
+}
+
+func (c teeConn) Read(p []byte) (int, error) {
+	return c.r.Read(p)
+}
+
+func TestSchemaSubscribe(t *testing.T) {
+	logger, _ := test.NewNullLogger()
+	tokenFunc := func(token string, scopes []string) (*models.Principal, error) {
+		if token != "secret" {
+			return nil, errors.New("unauthorized")
+		}
+		return &models.Principal{Username: "user"}, nil
+	}
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusTeapot)
+	})
+	newServer := func(subscriber *fakeSchemaSubscriber) *httptest.Server {
+		srv := httptest.NewServer(addSchemaSubscribe(next, subscriber, tokenFunc, false, "https://weaviate.example.com", logger))
+		t.Cleanup(srv.Close)
+		return srv
+	}
+	// dialFrom returns the connection from a page of origin and everything
+	// the server sent
+	dialFrom := func(t *testing.T, srv *httptest.Server, origin string) (*websocket.Conn, *bytes.Buffer, error) {
+		cfg, err := websocket.NewConfig("ws"+strings.TrimPrefix(srv.URL, "http")+schemaSubscribePath, origin)
+		require.Nil(t, err)
+		cfg.Header.Set("Authorization", "Bearer secret")
+		conn, err := net.Dial("tcp", cfg.Location.Host)
+		require.Nil(t, err)
+		received := &bytes.Buffer{}
+		ws, err := websocket.NewClient(cfg, teeConn{Conn: conn, r: io.TeeReader(conn, received)})
+		if err != nil {
+			conn.Close()
+			return nil, nil, err
+		}
+		t.Cleanup(func() { ws.Close() })
+		return ws, received, nil
+	}
+	dial := func(t *testing.T, srv *httptest.Server) (*websocket.Conn, *bytes.Buffer) {
+		ws, received, err := dialFrom(t, srv, srv.URL)
+		require.Nil(t, err)
+		return ws, received
+	}
+
+	t.Run("events and drop", func(t *testing.T) {
+		subscriber := &fakeSchemaSubscriber{
+			SchemaEventBroadcaster: schemaUC.NewSchemaEventBroadcaster(2),
+			subscribed:             make(chan struct{}),
+		}
+		ws, received := dial(t, newServer(subscriber))
+		<-subscriber.subscribed
+
+		subscriber.OnClassAdded(&models.Class{Class: "A"})
+		var event schemaUC.SchemaEvent
+		require.Nil(t, websocket.JSON.Receive(ws, &event))
+		assert.Equal(t, schemaUC.SchemaEventClassAdded, event.Type)
+		assert.Equal(t, "A", event.Class)
+
+		// the events are sent faster than the handler can pick them up
+		for i := 0; i < 1000; i++ {
+			subscriber.OnClassDeleted("A")
+		}
+		for {
+			if err := websocket.JSON.Receive(ws, &event); err != nil {
+				require.ErrorIs(t, err, io.EOF)
+				break
+			}
+		}
+		// the client doesn't expose the close status, the last frame must be
+		// an unmasked close frame with status 1008
+		assert.True(t, bytes.HasSuffix(received.Bytes(), []byte{0x88, 0x02, 0x03, 0xf0}))
+	})
+
+	t.Run("origins", func(t *testing.T) {
+		newSubscriber := func() *fakeSchemaSubscriber {
+			return &fakeSchemaSubscriber{
+				SchemaEventBroadcaster: schemaUC.NewSchemaEventBroadcaster(2),
+				subscribed:             make(chan struct{}),
+			}
+		}
+		_, _, err := dialFrom(t, newServer(newSubscriber()), "https://weaviate.example.com")
+		assert.Nil(t, err)
+		_, _, err = dialFrom(t, newServer(newSubscriber()), "https://evil.example.com")
+		assert.NotNil(t, err)
+	})
+
+	t.Run("rejected requests", func(t *testing.T) {
+		subscriber := &fakeSchemaSubscriber{err: authErrors.NewForbidden(&models.Principal{}, "read", "schema")}
+		srv := newServer(subscriber)
+		get := func(method, token string) int {
+			req, err := http.NewRequest(method, srv.URL+schemaSubscribePath, nil)
+			require.Nil(t, err)
+			if token != "" {
+				req.Header.Set("Authorization", "Bearer "+token)
+			}
+			res, err := http.DefaultClient.Do(req)
+			require.Nil(t, err)
+			res.Body.Close()
+			return res.StatusCode
+		}
+		assert.Equal(t, http.StatusUnauthorized, get(http.MethodGet, ""))
+		assert.Equal(t, http.StatusUnauthorized, get(http.MethodGet, "wrong"))
+		assert.Equal(t, http.StatusForbidden, get(http.MethodGet, "secret"))
+		assert.Equal(t, http.StatusMethodNotAllowed, get(http.MethodPost, "secret"))
+
+		res, err := http.Get(srv.URL + "/v1/schema")
+		require.Nil(t, err)
+		res.Body.Close()
+		assert.Equal(t, http.StatusTeapot, res.StatusCode)
+	})
+}
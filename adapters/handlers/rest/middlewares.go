@@ -27,6 +27,8 @@ import (
 	"github.com/weaviate/weaviate/adapters/handlers/rest/raft"
 	"github.com/weaviate/weaviate/adapters/handlers/rest/state"
 	"github.com/weaviate/weaviate/adapters/handlers/rest/swagger_middleware"
+	"github.com/weaviate/weaviate/grpc/sse"
+	"github.com/weaviate/weaviate/usecases/auth/authentication/composer"
 	"github.com/weaviate/weaviate/usecases/config"
 	"github.com/weaviate/weaviate/usecases/modules"
 	"github.com/weaviate/weaviate/usecases/monitoring"
@@ -106,10 +108,27 @@ func makeAddModuleHandlers(modules *modules.Provider) func(http.Handler) http.Ha
 	}
 }
 
+// addBatchDeleteStream serves the gRPC BatchDelete as server-sent events at
+// sse.BatchDeletePath, which is not part of the swagger spec
+func addBatchDeleteStream(next http.Handler, batchDelete sse.BatchDeleteFunc, appState *state.State) http.Handler {
+	stream := sse.NewBatchDeleteHandler(batchDelete,
+		int64(appState.ServerConfig.Config.GRPC.MaxMsgSize), appState.Logger)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == sse.BatchDeletePath {
+			stream.ServeHTTP(w, r)
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
 // The middleware configuration happens before anything, this middleware also applies to serving the swagger.json document.
 // So this is a good place to plug in a panic handling middleware, logging and metrics
 // Contains "x-api-key", "x-api-token" for legacy reasons, older interfaces might need these headers.
-func makeSetupGlobalMiddleware(appState *state.State, context *middleware.Context) func(http.Handler) http.Handler {
+func makeSetupGlobalMiddleware(appState *state.State, context *middleware.Context,
+	batchDelete sse.BatchDeleteFunc,
+) func(http.Handler) http.Handler {
 	return func(handler http.Handler) http.Handler {
 		handleCORS := cors.New(cors.Options{
 			OptionsPassthrough: true,
@@ -127,6 +146,7 @@ func makeSetupGlobalMiddleware(appState *state.State, context *middleware.Contex
 		handler = addLiveAndReadyness(appState, handler)
 		handler = addHandleRoot(handler)
 		handler = makeAddModuleHandlers(appState.Modules)(handler)
+		handler = addBatchDeleteStream(handler, batchDelete, appState)
 		handler = addInjectHeadersIntoContext(handler)
 		handler = makeCatchPanics(appState.Logger, newPanicsRequestsTotal(appState.Metrics, appState.Logger))(handler)
 		if appState.ServerConfig.Config.Monitoring.Enabled {
@@ -141,6 +161,11 @@ func makeSetupGlobalMiddleware(appState *state.State, context *middleware.Contex
 		}
 		// Must be the last middleware as it might skip the next handler
 		handler = addClusterHandlerMiddleware(handler, appState)
+		// Outside of the monitoring, whose response writer can't be hijacked
+		handler = addSchemaSubscribe(handler, appState.SchemaManager,
+			composer.New(appState.ServerConfig.Config.Authentication, appState.APIKey, appState.OIDC),
+			appState.ServerConfig.Config.Authentication.AnonymousAccess.Enabled,
+			appState.ServerConfig.Config.Origin, appState.Logger)
 		if appState.ServerConfig.Config.Sentry.Enabled {
 			handler = addSentryHandler(handler)
 		}
@@ -33,6 +33,10 @@ ObjectsClassPatchNoContent Successfully applied. No content provided.
 swagger:response objectsClassPatchNoContent
 */
 type ObjectsClassPatchNoContent struct {
+	/*Set if the object was written to deprecated properties of a class which doesn't enforce deprecation. Lists the deprecation warnings of these properties.
+
+	 */
+	XWeaviateDeprecationWarning string `json:"x-weaviate-deprecation-warning"`
 }
 
 // NewObjectsClassPatchNoContent creates ObjectsClassPatchNoContent with default headers values
@@ -41,9 +45,27 @@ func NewObjectsClassPatchNoContent() *ObjectsClassPatchNoContent {
 	return &ObjectsClassPatchNoContent{}
 }
 
+// WithXWeaviateDeprecationWarning adds the xWeaviateDeprecationWarning to the objects class patch no content response
+func (o *ObjectsClassPatchNoContent) WithXWeaviateDeprecationWarning(xWeaviateDeprecationWarning string) *ObjectsClassPatchNoContent {
+	o.XWeaviateDeprecationWarning = xWeaviateDeprecationWarning
+	return o
+}
+
+// SetXWeaviateDeprecationWarning sets the xWeaviateDeprecationWarning to the objects class patch no content response
+func (o *ObjectsClassPatchNoContent) SetXWeaviateDeprecationWarning(xWeaviateDeprecationWarning string) {
+	o.XWeaviateDeprecationWarning = xWeaviateDeprecationWarning
+}
+
 // WriteResponse to the client
 func (o *ObjectsClassPatchNoContent) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
 
+	// response header x-weaviate-deprecation-warning
+
+	xWeaviateDeprecationWarning := o.XWeaviateDeprecationWarning
+	if xWeaviateDeprecationWarning != "" {
+		rw.Header().Set("x-weaviate-deprecation-warning", xWeaviateDeprecationWarning)
+	}
+
 	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
 
 	rw.WriteHeader(204)
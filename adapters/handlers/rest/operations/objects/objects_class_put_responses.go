@@ -33,6 +33,10 @@ ObjectsClassPutOK Successfully received.
 swagger:response objectsClassPutOK
 */
 type ObjectsClassPutOK struct {
+	/*Set if the object was written to deprecated properties of a class which doesn't enforce deprecation. Lists the deprecation warnings of these properties.
+
+	 */
+	XWeaviateDeprecationWarning string `json:"x-weaviate-deprecation-warning"`
 
 	/*
 	  In: Body
@@ -46,6 +50,17 @@ func NewObjectsClassPutOK() *ObjectsClassPutOK {
 	return &ObjectsClassPutOK{}
 }
 
+// WithXWeaviateDeprecationWarning adds the xWeaviateDeprecationWarning to the objects class put o k response
+func (o *ObjectsClassPutOK) WithXWeaviateDeprecationWarning(xWeaviateDeprecationWarning string) *ObjectsClassPutOK {
+	o.XWeaviateDeprecationWarning = xWeaviateDeprecationWarning
+	return o
+}
+
+// SetXWeaviateDeprecationWarning sets the xWeaviateDeprecationWarning to the objects class put o k response
+func (o *ObjectsClassPutOK) SetXWeaviateDeprecationWarning(xWeaviateDeprecationWarning string) {
+	o.XWeaviateDeprecationWarning = xWeaviateDeprecationWarning
+}
+
 // WithPayload adds the payload to the objects class put o k response
 func (o *ObjectsClassPutOK) WithPayload(payload *models.Object) *ObjectsClassPutOK {
 	o.Payload = payload
@@ -60,6 +75,13 @@ func (o *ObjectsClassPutOK) SetPayload(payload *models.Object) {
 // WriteResponse to the client
 func (o *ObjectsClassPutOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
 
+	// response header x-weaviate-deprecation-warning
+
+	xWeaviateDeprecationWarning := o.XWeaviateDeprecationWarning
+	if xWeaviateDeprecationWarning != "" {
+		rw.Header().Set("x-weaviate-deprecation-warning", xWeaviateDeprecationWarning)
+	}
+
 	rw.WriteHeader(200)
 	if o.Payload != nil {
 		payload := o.Payload
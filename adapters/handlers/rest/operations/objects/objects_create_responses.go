@@ -33,6 +33,10 @@ ObjectsCreateOK Object created.
 swagger:response objectsCreateOK
 */
 type ObjectsCreateOK struct {
+	/*Set if the object was written to deprecated properties of a class which doesn't enforce deprecation. Lists the deprecation warnings of these properties.
+
+	 */
+	XWeaviateDeprecationWarning string `json:"x-weaviate-deprecation-warning"`
 
 	/*
 	  In: Body
@@ -46,6 +50,17 @@ func NewObjectsCreateOK() *ObjectsCreateOK {
 	return &ObjectsCreateOK{}
 }
 
+// WithXWeaviateDeprecationWarning adds the xWeaviateDeprecationWarning to the objects create o k response
+func (o *ObjectsCreateOK) WithXWeaviateDeprecationWarning(xWeaviateDeprecationWarning string) *ObjectsCreateOK {
+	o.XWeaviateDeprecationWarning = xWeaviateDeprecationWarning
+	return o
+}
+
+// SetXWeaviateDeprecationWarning sets the xWeaviateDeprecationWarning to the objects create o k response
+func (o *ObjectsCreateOK) SetXWeaviateDeprecationWarning(xWeaviateDeprecationWarning string) {
+	o.XWeaviateDeprecationWarning = xWeaviateDeprecationWarning
+}
+
 // WithPayload adds the payload to the objects create o k response
 func (o *ObjectsCreateOK) WithPayload(payload *models.Object) *ObjectsCreateOK {
 	o.Payload = payload
@@ -60,6 +75,13 @@ func (o *ObjectsCreateOK) SetPayload(payload *models.Object) {
 // WriteResponse to the client
 func (o *ObjectsCreateOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
 
+	// response header x-weaviate-deprecation-warning
+
+	xWeaviateDeprecationWarning := o.XWeaviateDeprecationWarning
+	if xWeaviateDeprecationWarning != "" {
+		rw.Header().Set("x-weaviate-deprecation-warning", xWeaviateDeprecationWarning)
+	}
+
 	rw.WriteHeader(200)
 	if o.Payload != nil {
 		payload := o.Payload
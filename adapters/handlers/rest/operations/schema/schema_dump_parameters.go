@@ -20,9 +20,11 @@ import (
 	"net/http"
 
 	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
 	"github.com/go-openapi/runtime/middleware"
 	"github.com/go-openapi/strfmt"
 	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
 )
 
 // NewSchemaDumpParams creates a new SchemaDumpParams object
@@ -49,11 +51,48 @@ type SchemaDumpParams struct {
 	// HTTP Request Object
 	HTTPRequest *http.Request `json:"-"`
 
+	/*Only return the collections whose name sorts after this one. Pass the name of the last collection of a page to get the next one.
+	  In: query
+	*/
+	After *string
 	/*If consistency is true, the request will be proxied to the leader to ensure strong schema consistency
 	  In: header
 	  Default: true
 	*/
 	Consistency *bool
+	/*Only return the collections with all of the given labels, as a comma separated list of key=value pairs.
+	  In: query
+	*/
+	HasLabel *string
+	/*Only return the collections with a module config for the module.
+	  In: query
+	*/
+	HasModule *string
+	/*The maximum number of collections to return. Without limit all matching collections are returned.
+	  Minimum: 0
+	  In: query
+	*/
+	Limit *int64
+	/*Same as hasModule, must not be combined with it.
+	  In: query
+	*/
+	Module *string
+	/*Only return the collections whose name contains the string.
+	  In: query
+	*/
+	NameContains *string
+	/*Only return the collections whose name starts with the prefix.
+	  In: query
+	*/
+	NamePrefix *string
+	/*Only return the collections using the vectorizer, either for the collection or one of its named vectors.
+	  In: query
+	*/
+	Vectorizer *string
+	/*Consistency token returned by a schema change. The node waits until it has applied the change before it reads the schema locally, the consistency header is then ignored.
+	  In: header
+	*/
+	XWeaviateWaitForToken *string
 }
 
 // BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
@@ -65,15 +104,79 @@ func (o *SchemaDumpParams) BindRequest(r *http.Request, route *middleware.Matche
 
 	o.HTTPRequest = r
 
+	qs := runtime.Values(r.URL.Query())
+
+	qAfter, qhkAfter, _ := qs.GetOK("after")
+	if err := o.bindAfter(qAfter, qhkAfter, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := o.bindConsistency(r.Header[http.CanonicalHeaderKey("consistency")], true, route.Formats); err != nil {
 		res = append(res, err)
 	}
+
+	qHasLabel, qhkHasLabel, _ := qs.GetOK("hasLabel")
+	if err := o.bindHasLabel(qHasLabel, qhkHasLabel, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qHasModule, qhkHasModule, _ := qs.GetOK("hasModule")
+	if err := o.bindHasModule(qHasModule, qhkHasModule, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qLimit, qhkLimit, _ := qs.GetOK("limit")
+	if err := o.bindLimit(qLimit, qhkLimit, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qModule, qhkModule, _ := qs.GetOK("module")
+	if err := o.bindModule(qModule, qhkModule, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qNameContains, qhkNameContains, _ := qs.GetOK("nameContains")
+	if err := o.bindNameContains(qNameContains, qhkNameContains, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qNamePrefix, qhkNamePrefix, _ := qs.GetOK("namePrefix")
+	if err := o.bindNamePrefix(qNamePrefix, qhkNamePrefix, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qVectorizer, qhkVectorizer, _ := qs.GetOK("vectorizer")
+	if err := o.bindVectorizer(qVectorizer, qhkVectorizer, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := o.bindXWeaviateWaitForToken(r.Header[http.CanonicalHeaderKey("x-weaviate-wait-for-token")], true, route.Formats); err != nil {
+		res = append(res, err)
+	}
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
 	return nil
 }
 
+// bindAfter binds and validates parameter After from query.
+func (o *SchemaDumpParams) bindAfter(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+	o.After = &raw
+
+	return nil
+}
+
 // bindConsistency binds and validates parameter Consistency from header.
 func (o *SchemaDumpParams) bindConsistency(rawData []string, hasKey bool, formats strfmt.Registry) error {
 	var raw string
@@ -96,3 +199,165 @@ func (o *SchemaDumpParams) bindConsistency(rawData []string, hasKey bool, format
 
 	return nil
 }
+
+// bindHasLabel binds and validates parameter HasLabel from query.
+func (o *SchemaDumpParams) bindHasLabel(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+	o.HasLabel = &raw
+
+	return nil
+}
+
+// bindHasModule binds and validates parameter HasModule from query.
+func (o *SchemaDumpParams) bindHasModule(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+	o.HasModule = &raw
+
+	return nil
+}
+
+// bindLimit binds and validates parameter Limit from query.
+func (o *SchemaDumpParams) bindLimit(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	value, err := swag.ConvertInt64(raw)
+	if err != nil {
+		return errors.InvalidType("limit", "query", "int64", raw)
+	}
+	o.Limit = &value
+
+	if err := o.validateLimit(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateLimit carries on validations for parameter Limit
+func (o *SchemaDumpParams) validateLimit(formats strfmt.Registry) error {
+
+	if err := validate.MinimumInt("limit", "query", *o.Limit, 0, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// bindModule binds and validates parameter Module from query.
+func (o *SchemaDumpParams) bindModule(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+	o.Module = &raw
+
+	return nil
+}
+
+// bindNameContains binds and validates parameter NameContains from query.
+func (o *SchemaDumpParams) bindNameContains(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+	o.NameContains = &raw
+
+	return nil
+}
+
+// bindNamePrefix binds and validates parameter NamePrefix from query.
+func (o *SchemaDumpParams) bindNamePrefix(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+	o.NamePrefix = &raw
+
+	return nil
+}
+
+// bindVectorizer binds and validates parameter Vectorizer from query.
+func (o *SchemaDumpParams) bindVectorizer(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+	o.Vectorizer = &raw
+
+	return nil
+}
+
+// bindXWeaviateWaitForToken binds and validates parameter XWeaviateWaitForToken from header.
+func (o *SchemaDumpParams) bindXWeaviateWaitForToken(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+	o.XWeaviateWaitForToken = &raw
+
+	return nil
+}
@@ -28,7 +28,7 @@ import (
 const SchemaDumpOKCode int = 200
 
 /*
-SchemaDumpOK Successfully dumped the database schema.
+SchemaDumpOK Successfully dumped the database schema. If any of the filter parameters is set, the schema only contains the matching collections ordered by name.
 
 swagger:response schemaDumpOK
 */
@@ -139,6 +139,51 @@ func (o *SchemaDumpForbidden) WriteResponse(rw http.ResponseWriter, producer run
 	}
 }
 
+// SchemaDumpUnprocessableEntityCode is the HTTP code returned for type SchemaDumpUnprocessableEntity
+const SchemaDumpUnprocessableEntityCode int = 422
+
+/*
+SchemaDumpUnprocessableEntity Invalid filter parameters.
+
+swagger:response schemaDumpUnprocessableEntity
+*/
+type SchemaDumpUnprocessableEntity struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewSchemaDumpUnprocessableEntity creates SchemaDumpUnprocessableEntity with default headers values
+func NewSchemaDumpUnprocessableEntity() *SchemaDumpUnprocessableEntity {
+
+	return &SchemaDumpUnprocessableEntity{}
+}
+
+// WithPayload adds the payload to the schema dump unprocessable entity response
+func (o *SchemaDumpUnprocessableEntity) WithPayload(payload *models.ErrorResponse) *SchemaDumpUnprocessableEntity {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the schema dump unprocessable entity response
+func (o *SchemaDumpUnprocessableEntity) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *SchemaDumpUnprocessableEntity) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(422)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
 // SchemaDumpInternalServerErrorCode is the HTTP code returned for type SchemaDumpInternalServerError
 const SchemaDumpInternalServerErrorCode int = 500
 
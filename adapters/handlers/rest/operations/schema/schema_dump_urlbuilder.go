@@ -20,11 +20,24 @@ import (
 	"errors"
 	"net/url"
 	golangswaggerpaths "path"
+
+	"github.com/go-openapi/swag"
 )
 
 // SchemaDumpURL generates an URL for the schema dump operation
 type SchemaDumpURL struct {
+	After        *string
+	HasLabel     *string
+	HasModule    *string
+	Limit        *int64
+	Module       *string
+	NameContains *string
+	NamePrefix   *string
+	Vectorizer   *string
+
 	_basePath string
+	// avoid unkeyed usage
+	_ struct{}
 }
 
 // WithBasePath sets the base path for this url builder, only required when it's different from the
@@ -54,6 +67,74 @@ func (o *SchemaDumpURL) Build() (*url.URL, error) {
 	}
 	_result.Path = golangswaggerpaths.Join(_basePath, _path)
 
+	qs := make(url.Values)
+
+	var afterQ string
+	if o.After != nil {
+		afterQ = *o.After
+	}
+	if afterQ != "" {
+		qs.Set("after", afterQ)
+	}
+
+	var hasLabelQ string
+	if o.HasLabel != nil {
+		hasLabelQ = *o.HasLabel
+	}
+	if hasLabelQ != "" {
+		qs.Set("hasLabel", hasLabelQ)
+	}
+
+	var hasModuleQ string
+	if o.HasModule != nil {
+		hasModuleQ = *o.HasModule
+	}
+	if hasModuleQ != "" {
+		qs.Set("hasModule", hasModuleQ)
+	}
+
+	var limitQ string
+	if o.Limit != nil {
+		limitQ = swag.FormatInt64(*o.Limit)
+	}
+	if limitQ != "" {
+		qs.Set("limit", limitQ)
+	}
+
+	var moduleQ string
+	if o.Module != nil {
+		moduleQ = *o.Module
+	}
+	if moduleQ != "" {
+		qs.Set("module", moduleQ)
+	}
+
+	var nameContainsQ string
+	if o.NameContains != nil {
+		nameContainsQ = *o.NameContains
+	}
+	if nameContainsQ != "" {
+		qs.Set("nameContains", nameContainsQ)
+	}
+
+	var namePrefixQ string
+	if o.NamePrefix != nil {
+		namePrefixQ = *o.NamePrefix
+	}
+	if namePrefixQ != "" {
+		qs.Set("namePrefix", namePrefixQ)
+	}
+
+	var vectorizerQ string
+	if o.Vectorizer != nil {
+		vectorizerQ = *o.Vectorizer
+	}
+	if vectorizerQ != "" {
+		qs.Set("vectorizer", vectorizerQ)
+	}
+
+	_result.RawQuery = qs.Encode()
+
 	return &_result, nil
 }
 
@@ -33,6 +33,10 @@ SchemaObjectsCreateOK Added the new Object class to the schema.
 swagger:response schemaObjectsCreateOK
 */
 type SchemaObjectsCreateOK struct {
+	/*Pass it as x-weaviate-wait-for-token to read the schema including the new class from any node.
+
+	 */
+	XWeaviateConsistencyToken string `json:"x-weaviate-consistency-token"`
 
 	/*
 	  In: Body
@@ -46,6 +50,17 @@ func NewSchemaObjectsCreateOK() *SchemaObjectsCreateOK {
 	return &SchemaObjectsCreateOK{}
 }
 
+// WithXWeaviateConsistencyToken adds the xWeaviateConsistencyToken to the schema objects create o k response
+func (o *SchemaObjectsCreateOK) WithXWeaviateConsistencyToken(xWeaviateConsistencyToken string) *SchemaObjectsCreateOK {
+	o.XWeaviateConsistencyToken = xWeaviateConsistencyToken
+	return o
+}
+
+// SetXWeaviateConsistencyToken sets the xWeaviateConsistencyToken to the schema objects create o k response
+func (o *SchemaObjectsCreateOK) SetXWeaviateConsistencyToken(xWeaviateConsistencyToken string) {
+	o.XWeaviateConsistencyToken = xWeaviateConsistencyToken
+}
+
 // WithPayload adds the payload to the schema objects create o k response
 func (o *SchemaObjectsCreateOK) WithPayload(payload *models.Class) *SchemaObjectsCreateOK {
 	o.Payload = payload
@@ -60,6 +75,13 @@ func (o *SchemaObjectsCreateOK) SetPayload(payload *models.Class) {
 // WriteResponse to the client
 func (o *SchemaObjectsCreateOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
 
+	// response header x-weaviate-consistency-token
+
+	xWeaviateConsistencyToken := o.XWeaviateConsistencyToken
+	if xWeaviateConsistencyToken != "" {
+		rw.Header().Set("x-weaviate-consistency-token", xWeaviateConsistencyToken)
+	}
+
 	rw.WriteHeader(200)
 	if o.Payload != nil {
 		payload := o.Payload
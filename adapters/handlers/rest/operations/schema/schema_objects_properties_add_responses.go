@@ -28,11 +28,19 @@ import (
 const SchemaObjectsPropertiesAddOKCode int = 200
 
 /*
-SchemaObjectsPropertiesAddOK Added the property.
+SchemaObjectsPropertiesAddOK Added the property. The property is returned as stored, with the defaults applied to the fields the request left unset.
 
 swagger:response schemaObjectsPropertiesAddOK
 */
 type SchemaObjectsPropertiesAddOK struct {
+	/*Why the job setting the `defaultValue` of the property on the existing objects could not be started. The property is added nonetheless.
+
+	 */
+	XWeaviateBackfillError string `json:"x-weaviate-backfill-error"`
+	/*ID of the job setting the `defaultValue` of the property on the existing objects. Only set if the property has a `defaultValue`.
+
+	 */
+	XWeaviateBackfillJobID string `json:"x-weaviate-backfill-job-id"`
 
 	/*
 	  In: Body
@@ -46,6 +54,28 @@ func NewSchemaObjectsPropertiesAddOK() *SchemaObjectsPropertiesAddOK {
 	return &SchemaObjectsPropertiesAddOK{}
 }
 
+// WithXWeaviateBackfillError adds the xWeaviateBackfillError to the schema objects properties add o k response
+func (o *SchemaObjectsPropertiesAddOK) WithXWeaviateBackfillError(xWeaviateBackfillError string) *SchemaObjectsPropertiesAddOK {
+	o.XWeaviateBackfillError = xWeaviateBackfillError
+	return o
+}
+
+// SetXWeaviateBackfillError sets the xWeaviateBackfillError to the schema objects properties add o k response
+func (o *SchemaObjectsPropertiesAddOK) SetXWeaviateBackfillError(xWeaviateBackfillError string) {
+	o.XWeaviateBackfillError = xWeaviateBackfillError
+}
+
+// WithXWeaviateBackfillJobID adds the xWeaviateBackfillJobId to the schema objects properties add o k response
+func (o *SchemaObjectsPropertiesAddOK) WithXWeaviateBackfillJobID(xWeaviateBackfillJobID string) *SchemaObjectsPropertiesAddOK {
+	o.XWeaviateBackfillJobID = xWeaviateBackfillJobID
+	return o
+}
+
+// SetXWeaviateBackfillJobID sets the xWeaviateBackfillJobId to the schema objects properties add o k response
+func (o *SchemaObjectsPropertiesAddOK) SetXWeaviateBackfillJobID(xWeaviateBackfillJobID string) {
+	o.XWeaviateBackfillJobID = xWeaviateBackfillJobID
+}
+
 // WithPayload adds the payload to the schema objects properties add o k response
 func (o *SchemaObjectsPropertiesAddOK) WithPayload(payload *models.Property) *SchemaObjectsPropertiesAddOK {
 	o.Payload = payload
@@ -60,6 +90,20 @@ func (o *SchemaObjectsPropertiesAddOK) SetPayload(payload *models.Property) {
 // WriteResponse to the client
 func (o *SchemaObjectsPropertiesAddOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
 
+	// response header x-weaviate-backfill-error
+
+	xWeaviateBackfillError := o.XWeaviateBackfillError
+	if xWeaviateBackfillError != "" {
+		rw.Header().Set("x-weaviate-backfill-error", xWeaviateBackfillError)
+	}
+
+	// response header x-weaviate-backfill-job-id
+
+	xWeaviateBackfillJobID := o.XWeaviateBackfillJobID
+	if xWeaviateBackfillJobID != "" {
+		rw.Header().Set("x-weaviate-backfill-job-id", xWeaviateBackfillJobID)
+	}
+
 	rw.WriteHeader(200)
 	if o.Payload != nil {
 		payload := o.Payload
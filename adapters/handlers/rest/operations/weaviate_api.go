@@ -67,6 +67,7 @@ func NewWeaviateAPI(spec *loads.Document) *WeaviateAPI {
 		YamlConsumer: yamlpc.YAMLConsumer(),
 
 		JSONProducer: runtime.JSONProducer(),
+		TxtProducer:  runtime.TextProducer(),
 
 		WellKnownGetWellKnownOpenidConfigurationHandler: well_known.GetWellKnownOpenidConfigurationHandlerFunc(func(params well_known.GetWellKnownOpenidConfigurationParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation well_known.GetWellKnownOpenidConfiguration has not yet been implemented")
@@ -113,6 +114,9 @@ func NewWeaviateAPI(spec *loads.Document) *WeaviateAPI {
 		ClusterClusterGetStatisticsHandler: cluster.ClusterGetStatisticsHandlerFunc(func(params cluster.ClusterGetStatisticsParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation cluster.ClusterGetStatistics has not yet been implemented")
 		}),
+		ClusterClusterSnapshotHandler: cluster.ClusterSnapshotHandlerFunc(func(params cluster.ClusterSnapshotParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation cluster.ClusterSnapshot has not yet been implemented")
+		}),
 		AuthzCreateRoleHandler: authz.CreateRoleHandlerFunc(func(params authz.CreateRoleParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation authz.CreateRole has not yet been implemented")
 		}),
@@ -218,6 +222,12 @@ func NewWeaviateAPI(spec *loads.Document) *WeaviateAPI {
 		SchemaSchemaDumpHandler: schema.SchemaDumpHandlerFunc(func(params schema.SchemaDumpParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation schema.SchemaDump has not yet been implemented")
 		}),
+		SchemaSchemaGraphHandler: schema.SchemaGraphHandlerFunc(func(params schema.SchemaGraphParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation schema.SchemaGraph has not yet been implemented")
+		}),
+		SchemaSchemaGraphqlHandler: schema.SchemaGraphqlHandlerFunc(func(params schema.SchemaGraphqlParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation schema.SchemaGraphql has not yet been implemented")
+		}),
 		SchemaSchemaObjectsCreateHandler: schema.SchemaObjectsCreateHandlerFunc(func(params schema.SchemaObjectsCreateParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation schema.SchemaObjectsCreate has not yet been implemented")
 		}),
@@ -227,18 +237,57 @@ func NewWeaviateAPI(spec *loads.Document) *WeaviateAPI {
 		SchemaSchemaObjectsGetHandler: schema.SchemaObjectsGetHandlerFunc(func(params schema.SchemaObjectsGetParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation schema.SchemaObjectsGet has not yet been implemented")
 		}),
+		SchemaSchemaObjectsGroupsCreateHandler: schema.SchemaObjectsGroupsCreateHandlerFunc(func(params schema.SchemaObjectsGroupsCreateParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation schema.SchemaObjectsGroupsCreate has not yet been implemented")
+		}),
+		SchemaSchemaObjectsGroupsGetHandler: schema.SchemaObjectsGroupsGetHandlerFunc(func(params schema.SchemaObjectsGroupsGetParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation schema.SchemaObjectsGroupsGet has not yet been implemented")
+		}),
+		SchemaSchemaObjectsGroupsListHandler: schema.SchemaObjectsGroupsListHandlerFunc(func(params schema.SchemaObjectsGroupsListParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation schema.SchemaObjectsGroupsList has not yet been implemented")
+		}),
+		SchemaSchemaObjectsPatchHandler: schema.SchemaObjectsPatchHandlerFunc(func(params schema.SchemaObjectsPatchParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation schema.SchemaObjectsPatch has not yet been implemented")
+		}),
 		SchemaSchemaObjectsPropertiesAddHandler: schema.SchemaObjectsPropertiesAddHandlerFunc(func(params schema.SchemaObjectsPropertiesAddParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation schema.SchemaObjectsPropertiesAdd has not yet been implemented")
 		}),
+		SchemaSchemaObjectsPropertiesStatsGetHandler: schema.SchemaObjectsPropertiesStatsGetHandlerFunc(func(params schema.SchemaObjectsPropertiesStatsGetParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation schema.SchemaObjectsPropertiesStatsGet has not yet been implemented")
+		}),
+		SchemaSchemaObjectsReplicationGetHandler: schema.SchemaObjectsReplicationGetHandlerFunc(func(params schema.SchemaObjectsReplicationGetParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation schema.SchemaObjectsReplicationGet has not yet been implemented")
+		}),
+		SchemaSchemaObjectsShardsCountHandler: schema.SchemaObjectsShardsCountHandlerFunc(func(params schema.SchemaObjectsShardsCountParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation schema.SchemaObjectsShardsCount has not yet been implemented")
+		}),
 		SchemaSchemaObjectsShardsGetHandler: schema.SchemaObjectsShardsGetHandlerFunc(func(params schema.SchemaObjectsShardsGetParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation schema.SchemaObjectsShardsGet has not yet been implemented")
 		}),
 		SchemaSchemaObjectsShardsUpdateHandler: schema.SchemaObjectsShardsUpdateHandlerFunc(func(params schema.SchemaObjectsShardsUpdateParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation schema.SchemaObjectsShardsUpdate has not yet been implemented")
 		}),
+		SchemaSchemaObjectsStatsGetHandler: schema.SchemaObjectsStatsGetHandlerFunc(func(params schema.SchemaObjectsStatsGetParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation schema.SchemaObjectsStatsGet has not yet been implemented")
+		}),
+		SchemaSchemaObjectsStatsRecommendationsGetHandler: schema.SchemaObjectsStatsRecommendationsGetHandlerFunc(func(params schema.SchemaObjectsStatsRecommendationsGetParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation schema.SchemaObjectsStatsRecommendationsGet has not yet been implemented")
+		}),
 		SchemaSchemaObjectsUpdateHandler: schema.SchemaObjectsUpdateHandlerFunc(func(params schema.SchemaObjectsUpdateParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation schema.SchemaObjectsUpdate has not yet been implemented")
 		}),
+		SchemaSchemaObjectsValidateHandler: schema.SchemaObjectsValidateHandlerFunc(func(params schema.SchemaObjectsValidateParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation schema.SchemaObjectsValidate has not yet been implemented")
+		}),
+		SchemaSchemaObjectsVectorsBackfillHandler: schema.SchemaObjectsVectorsBackfillHandlerFunc(func(params schema.SchemaObjectsVectorsBackfillParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation schema.SchemaObjectsVectorsBackfill has not yet been implemented")
+		}),
+		SchemaSchemaObjectsVectorsBackfillGetHandler: schema.SchemaObjectsVectorsBackfillGetHandlerFunc(func(params schema.SchemaObjectsVectorsBackfillGetParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation schema.SchemaObjectsVectorsBackfillGet has not yet been implemented")
+		}),
+		SchemaSchemaValidateHandler: schema.SchemaValidateHandlerFunc(func(params schema.SchemaValidateParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation schema.SchemaValidate has not yet been implemented")
+		}),
 		SchemaTenantExistsHandler: schema.TenantExistsHandlerFunc(func(params schema.TenantExistsParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation schema.TenantExists has not yet been implemented")
 		}),
@@ -254,6 +303,9 @@ func NewWeaviateAPI(spec *loads.Document) *WeaviateAPI {
 		SchemaTenantsGetOneHandler: schema.TenantsGetOneHandlerFunc(func(params schema.TenantsGetOneParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation schema.TenantsGetOne has not yet been implemented")
 		}),
+		SchemaTenantsPrewarmHandler: schema.TenantsPrewarmHandlerFunc(func(params schema.TenantsPrewarmParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation schema.TenantsPrewarm has not yet been implemented")
+		}),
 		SchemaTenantsUpdateHandler: schema.TenantsUpdateHandlerFunc(func(params schema.TenantsUpdateParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation schema.TenantsUpdate has not yet been implemented")
 		}),
@@ -325,6 +377,9 @@ type WeaviateAPI struct {
 	// JSONProducer registers a producer for the following mime types:
 	//   - application/json
 	JSONProducer runtime.Producer
+	// TxtProducer registers a producer for the following mime types:
+	//   - text/plain
+	TxtProducer runtime.Producer
 
 	// OidcAuth registers a function that takes an access token and a collection of required scopes and returns a principal
 	// it performs authentication based on an oauth2 bearer token provided in the request
@@ -363,6 +418,8 @@ type WeaviateAPI struct {
 	ClassificationsClassificationsPostHandler classifications.ClassificationsPostHandler
 	// ClusterClusterGetStatisticsHandler sets the operation handler for the cluster get statistics operation
 	ClusterClusterGetStatisticsHandler cluster.ClusterGetStatisticsHandler
+	// ClusterClusterSnapshotHandler sets the operation handler for the cluster snapshot operation
+	ClusterClusterSnapshotHandler cluster.ClusterSnapshotHandler
 	// AuthzCreateRoleHandler sets the operation handler for the create role operation
 	AuthzCreateRoleHandler authz.CreateRoleHandler
 	// AuthzDeleteRoleHandler sets the operation handler for the delete role operation
@@ -433,20 +490,50 @@ type WeaviateAPI struct {
 	AuthzRevokeRoleHandler authz.RevokeRoleHandler
 	// SchemaSchemaDumpHandler sets the operation handler for the schema dump operation
 	SchemaSchemaDumpHandler schema.SchemaDumpHandler
+	// SchemaSchemaGraphHandler sets the operation handler for the schema graph operation
+	SchemaSchemaGraphHandler schema.SchemaGraphHandler
+	// SchemaSchemaGraphqlHandler sets the operation handler for the schema graphql operation
+	SchemaSchemaGraphqlHandler schema.SchemaGraphqlHandler
 	// SchemaSchemaObjectsCreateHandler sets the operation handler for the schema objects create operation
 	SchemaSchemaObjectsCreateHandler schema.SchemaObjectsCreateHandler
 	// SchemaSchemaObjectsDeleteHandler sets the operation handler for the schema objects delete operation
 	SchemaSchemaObjectsDeleteHandler schema.SchemaObjectsDeleteHandler
 	// SchemaSchemaObjectsGetHandler sets the operation handler for the schema objects get operation
 	SchemaSchemaObjectsGetHandler schema.SchemaObjectsGetHandler
+	// SchemaSchemaObjectsGroupsCreateHandler sets the operation handler for the schema objects groups create operation
+	SchemaSchemaObjectsGroupsCreateHandler schema.SchemaObjectsGroupsCreateHandler
+	// SchemaSchemaObjectsGroupsGetHandler sets the operation handler for the schema objects groups get operation
+	SchemaSchemaObjectsGroupsGetHandler schema.SchemaObjectsGroupsGetHandler
+	// SchemaSchemaObjectsGroupsListHandler sets the operation handler for the schema objects groups list operation
+	SchemaSchemaObjectsGroupsListHandler schema.SchemaObjectsGroupsListHandler
+	// SchemaSchemaObjectsPatchHandler sets the operation handler for the schema objects patch operation
+	SchemaSchemaObjectsPatchHandler schema.SchemaObjectsPatchHandler
 	// SchemaSchemaObjectsPropertiesAddHandler sets the operation handler for the schema objects properties add operation
 	SchemaSchemaObjectsPropertiesAddHandler schema.SchemaObjectsPropertiesAddHandler
+	// SchemaSchemaObjectsPropertiesStatsGetHandler sets the operation handler for the schema objects properties stats get operation
+	SchemaSchemaObjectsPropertiesStatsGetHandler schema.SchemaObjectsPropertiesStatsGetHandler
+	// SchemaSchemaObjectsReplicationGetHandler sets the operation handler for the schema objects replication get operation
+	SchemaSchemaObjectsReplicationGetHandler schema.SchemaObjectsReplicationGetHandler
+	// SchemaSchemaObjectsShardsCountHandler sets the operation handler for the schema objects shards count operation
+	SchemaSchemaObjectsShardsCountHandler schema.SchemaObjectsShardsCountHandler
 	// SchemaSchemaObjectsShardsGetHandler sets the operation handler for the schema objects shards get operation
 	SchemaSchemaObjectsShardsGetHandler schema.SchemaObjectsShardsGetHandler
 	// SchemaSchemaObjectsShardsUpdateHandler sets the operation handler for the schema objects shards update operation
 	SchemaSchemaObjectsShardsUpdateHandler schema.SchemaObjectsShardsUpdateHandler
+	// SchemaSchemaObjectsStatsGetHandler sets the operation handler for the schema objects stats get operation
+	SchemaSchemaObjectsStatsGetHandler schema.SchemaObjectsStatsGetHandler
+	// SchemaSchemaObjectsStatsRecommendationsGetHandler sets the operation handler for the schema objects stats recommendations get operation
+	SchemaSchemaObjectsStatsRecommendationsGetHandler schema.SchemaObjectsStatsRecommendationsGetHandler
 	// SchemaSchemaObjectsUpdateHandler sets the operation handler for the schema objects update operation
 	SchemaSchemaObjectsUpdateHandler schema.SchemaObjectsUpdateHandler
+	// SchemaSchemaObjectsValidateHandler sets the operation handler for the schema objects validate operation
+	SchemaSchemaObjectsValidateHandler schema.SchemaObjectsValidateHandler
+	// SchemaSchemaObjectsVectorsBackfillHandler sets the operation handler for the schema objects vectors backfill operation
+	SchemaSchemaObjectsVectorsBackfillHandler schema.SchemaObjectsVectorsBackfillHandler
+	// SchemaSchemaObjectsVectorsBackfillGetHandler sets the operation handler for the schema objects vectors backfill get operation
+	SchemaSchemaObjectsVectorsBackfillGetHandler schema.SchemaObjectsVectorsBackfillGetHandler
+	// SchemaSchemaValidateHandler sets the operation handler for the schema validate operation
+	SchemaSchemaValidateHandler schema.SchemaValidateHandler
 	// SchemaTenantExistsHandler sets the operation handler for the tenant exists operation
 	SchemaTenantExistsHandler schema.TenantExistsHandler
 	// SchemaTenantsCreateHandler sets the operation handler for the tenants create operation
@@ -457,6 +544,8 @@ type WeaviateAPI struct {
 	SchemaTenantsGetHandler schema.TenantsGetHandler
 	// SchemaTenantsGetOneHandler sets the operation handler for the tenants get one operation
 	SchemaTenantsGetOneHandler schema.TenantsGetOneHandler
+	// SchemaTenantsPrewarmHandler sets the operation handler for the tenants prewarm operation
+	SchemaTenantsPrewarmHandler schema.TenantsPrewarmHandler
 	// SchemaTenantsUpdateHandler sets the operation handler for the tenants update operation
 	SchemaTenantsUpdateHandler schema.TenantsUpdateHandler
 	// WeaviateRootHandler sets the operation handler for the weaviate root operation
@@ -544,6 +633,9 @@ func (o *WeaviateAPI) Validate() error {
 	if o.JSONProducer == nil {
 		unregistered = append(unregistered, "JSONProducer")
 	}
+	if o.TxtProducer == nil {
+		unregistered = append(unregistered, "TxtProducer")
+	}
 
 	if o.OidcAuth == nil {
 		unregistered = append(unregistered, "OidcAuth")
@@ -594,6 +686,9 @@ func (o *WeaviateAPI) Validate() error {
 	if o.ClusterClusterGetStatisticsHandler == nil {
 		unregistered = append(unregistered, "cluster.ClusterGetStatisticsHandler")
 	}
+	if o.ClusterClusterSnapshotHandler == nil {
+		unregistered = append(unregistered, "cluster.ClusterSnapshotHandler")
+	}
 	if o.AuthzCreateRoleHandler == nil {
 		unregistered = append(unregistered, "authz.CreateRoleHandler")
 	}
@@ -699,6 +794,12 @@ func (o *WeaviateAPI) Validate() error {
 	if o.SchemaSchemaDumpHandler == nil {
 		unregistered = append(unregistered, "schema.SchemaDumpHandler")
 	}
+	if o.SchemaSchemaGraphHandler == nil {
+		unregistered = append(unregistered, "schema.SchemaGraphHandler")
+	}
+	if o.SchemaSchemaGraphqlHandler == nil {
+		unregistered = append(unregistered, "schema.SchemaGraphqlHandler")
+	}
 	if o.SchemaSchemaObjectsCreateHandler == nil {
 		unregistered = append(unregistered, "schema.SchemaObjectsCreateHandler")
 	}
@@ -708,18 +809,57 @@ func (o *WeaviateAPI) Validate() error {
 	if o.SchemaSchemaObjectsGetHandler == nil {
 		unregistered = append(unregistered, "schema.SchemaObjectsGetHandler")
 	}
+	if o.SchemaSchemaObjectsGroupsCreateHandler == nil {
+		unregistered = append(unregistered, "schema.SchemaObjectsGroupsCreateHandler")
+	}
+	if o.SchemaSchemaObjectsGroupsGetHandler == nil {
+		unregistered = append(unregistered, "schema.SchemaObjectsGroupsGetHandler")
+	}
+	if o.SchemaSchemaObjectsGroupsListHandler == nil {
+		unregistered = append(unregistered, "schema.SchemaObjectsGroupsListHandler")
+	}
+	if o.SchemaSchemaObjectsPatchHandler == nil {
+		unregistered = append(unregistered, "schema.SchemaObjectsPatchHandler")
+	}
 	if o.SchemaSchemaObjectsPropertiesAddHandler == nil {
 		unregistered = append(unregistered, "schema.SchemaObjectsPropertiesAddHandler")
 	}
+	if o.SchemaSchemaObjectsPropertiesStatsGetHandler == nil {
+		unregistered = append(unregistered, "schema.SchemaObjectsPropertiesStatsGetHandler")
+	}
+	if o.SchemaSchemaObjectsReplicationGetHandler == nil {
+		unregistered = append(unregistered, "schema.SchemaObjectsReplicationGetHandler")
+	}
+	if o.SchemaSchemaObjectsShardsCountHandler == nil {
+		unregistered = append(unregistered, "schema.SchemaObjectsShardsCountHandler")
+	}
 	if o.SchemaSchemaObjectsShardsGetHandler == nil {
 		unregistered = append(unregistered, "schema.SchemaObjectsShardsGetHandler")
 	}
 	if o.SchemaSchemaObjectsShardsUpdateHandler == nil {
 		unregistered = append(unregistered, "schema.SchemaObjectsShardsUpdateHandler")
 	}
+	if o.SchemaSchemaObjectsStatsGetHandler == nil {
+		unregistered = append(unregistered, "schema.SchemaObjectsStatsGetHandler")
+	}
+	if o.SchemaSchemaObjectsStatsRecommendationsGetHandler == nil {
+		unregistered = append(unregistered, "schema.SchemaObjectsStatsRecommendationsGetHandler")
+	}
 	if o.SchemaSchemaObjectsUpdateHandler == nil {
 		unregistered = append(unregistered, "schema.SchemaObjectsUpdateHandler")
 	}
+	if o.SchemaSchemaObjectsValidateHandler == nil {
+		unregistered = append(unregistered, "schema.SchemaObjectsValidateHandler")
+	}
+	if o.SchemaSchemaObjectsVectorsBackfillHandler == nil {
+		unregistered = append(unregistered, "schema.SchemaObjectsVectorsBackfillHandler")
+	}
+	if o.SchemaSchemaObjectsVectorsBackfillGetHandler == nil {
+		unregistered = append(unregistered, "schema.SchemaObjectsVectorsBackfillGetHandler")
+	}
+	if o.SchemaSchemaValidateHandler == nil {
+		unregistered = append(unregistered, "schema.SchemaValidateHandler")
+	}
 	if o.SchemaTenantExistsHandler == nil {
 		unregistered = append(unregistered, "schema.TenantExistsHandler")
 	}
@@ -735,6 +875,9 @@ func (o *WeaviateAPI) Validate() error {
 	if o.SchemaTenantsGetOneHandler == nil {
 		unregistered = append(unregistered, "schema.TenantsGetOneHandler")
 	}
+	if o.SchemaTenantsPrewarmHandler == nil {
+		unregistered = append(unregistered, "schema.TenantsPrewarmHandler")
+	}
 	if o.SchemaTenantsUpdateHandler == nil {
 		unregistered = append(unregistered, "schema.TenantsUpdateHandler")
 	}
@@ -807,6 +950,8 @@ func (o *WeaviateAPI) ProducersFor(mediaTypes []string) map[string]runtime.Produ
 		switch mt {
 		case "application/json":
 			result["application/json"] = o.JSONProducer
+		case "text/plain":
+			result["text/plain"] = o.TxtProducer
 		}
 
 		if p, ok := o.customProducers[mt]; ok {
@@ -910,6 +1055,10 @@ func (o *WeaviateAPI) initHandlerCache() {
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
+	o.handlers["POST"]["/cluster/snapshot"] = cluster.NewClusterSnapshot(o.context, o.ClusterClusterSnapshotHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
 	o.handlers["POST"]["/authz/roles"] = authz.NewCreateRole(o.context, o.AuthzCreateRoleHandler)
 	if o.handlers["DELETE"] == nil {
 		o.handlers["DELETE"] = make(map[string]http.Handler)
@@ -1047,6 +1196,14 @@ func (o *WeaviateAPI) initHandlerCache() {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
 	o.handlers["GET"]["/schema"] = schema.NewSchemaDump(o.context, o.SchemaSchemaDumpHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/schema/graph"] = schema.NewSchemaGraph(o.context, o.SchemaSchemaGraphHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/schema.graphql"] = schema.NewSchemaGraphql(o.context, o.SchemaSchemaGraphqlHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
@@ -1062,19 +1219,71 @@ func (o *WeaviateAPI) initHandlerCache() {
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
+	o.handlers["POST"]["/schema/{className}/groups"] = schema.NewSchemaObjectsGroupsCreate(o.context, o.SchemaSchemaObjectsGroupsCreateHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/schema/{className}/groups/{groupName}"] = schema.NewSchemaObjectsGroupsGet(o.context, o.SchemaSchemaObjectsGroupsGetHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/schema/{className}/groups"] = schema.NewSchemaObjectsGroupsList(o.context, o.SchemaSchemaObjectsGroupsListHandler)
+	if o.handlers["PATCH"] == nil {
+		o.handlers["PATCH"] = make(map[string]http.Handler)
+	}
+	o.handlers["PATCH"]["/schema/{className}"] = schema.NewSchemaObjectsPatch(o.context, o.SchemaSchemaObjectsPatchHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
 	o.handlers["POST"]["/schema/{className}/properties"] = schema.NewSchemaObjectsPropertiesAdd(o.context, o.SchemaSchemaObjectsPropertiesAddHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/schema/{className}/properties/{propertyName}/stats"] = schema.NewSchemaObjectsPropertiesStatsGet(o.context, o.SchemaSchemaObjectsPropertiesStatsGetHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/schema/{className}/replication"] = schema.NewSchemaObjectsReplicationGet(o.context, o.SchemaSchemaObjectsReplicationGetHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/schema/{className}/shards/{shardName}/count"] = schema.NewSchemaObjectsShardsCount(o.context, o.SchemaSchemaObjectsShardsCountHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/schema/{className}/shards"] = schema.NewSchemaObjectsShardsGet(o.context, o.SchemaSchemaObjectsShardsGetHandler)
 	if o.handlers["PUT"] == nil {
 		o.handlers["PUT"] = make(map[string]http.Handler)
 	}
 	o.handlers["PUT"]["/schema/{className}/shards/{shardName}"] = schema.NewSchemaObjectsShardsUpdate(o.context, o.SchemaSchemaObjectsShardsUpdateHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/schema/{className}/stats"] = schema.NewSchemaObjectsStatsGet(o.context, o.SchemaSchemaObjectsStatsGetHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/schema/{className}/stats/recommendations"] = schema.NewSchemaObjectsStatsRecommendationsGet(o.context, o.SchemaSchemaObjectsStatsRecommendationsGetHandler)
 	if o.handlers["PUT"] == nil {
 		o.handlers["PUT"] = make(map[string]http.Handler)
 	}
 	o.handlers["PUT"]["/schema/{className}"] = schema.NewSchemaObjectsUpdate(o.context, o.SchemaSchemaObjectsUpdateHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/schema/{className}/validate-object"] = schema.NewSchemaObjectsValidate(o.context, o.SchemaSchemaObjectsValidateHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/schema/{className}/vectors/backfill"] = schema.NewSchemaObjectsVectorsBackfill(o.context, o.SchemaSchemaObjectsVectorsBackfillHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/schema/{className}/vectors/backfill/{jobId}"] = schema.NewSchemaObjectsVectorsBackfillGet(o.context, o.SchemaSchemaObjectsVectorsBackfillGetHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/schema/validate"] = schema.NewSchemaValidate(o.context, o.SchemaSchemaValidateHandler)
 	if o.handlers["HEAD"] == nil {
 		o.handlers["HEAD"] = make(map[string]http.Handler)
 	}
@@ -1095,6 +1304,10 @@ func (o *WeaviateAPI) initHandlerCache() {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
 	o.handlers["GET"]["/schema/{className}/tenants/{tenantName}"] = schema.NewTenantsGetOne(o.context, o.SchemaTenantsGetOneHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/schema/{className}/tenants/{tenantName}/prewarm"] = schema.NewTenantsPrewarm(o.context, o.SchemaTenantsPrewarmHandler)
 	if o.handlers["PUT"] == nil {
 		o.handlers["PUT"] = make(map[string]http.Handler)
 	}
@@ -35,6 +35,14 @@ type JoinNodeRequest struct {
 	Node string `json:"node"`
 	// Voter is whether or not the node wants to join as a voter in the raft cluster
 	Voter bool `json:"voter"`
+	// AutoRebalance moves shard replicas to the node once it joined
+	AutoRebalance bool `json:"autoRebalance"`
+}
+
+// JoinNodeResponse is returned if the join started a rebalancing
+type JoinNodeResponse struct {
+	// RebalanceJobID can be used to poll the progress of the rebalancing
+	RebalanceJobID string `json:"rebalanceJobId"`
 }
 
 // Validate ensures that r is valid.
@@ -84,9 +92,15 @@ func (h RaftHandler) JoinNode(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// Forward to the handler
-	err = h.schemaHandler.JoinNode(context.Background(), nodeAddr, nodePort, joinRequest.Voter)
+	jobID, err := h.schemaHandler.JoinNode(context.Background(), nodeAddr, nodePort, joinRequest.Voter,
+		schema.JoinOptions{AutoRebalance: joinRequest.AutoRebalance})
 	if err != nil {
 		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if jobID != "" {
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(JoinNodeResponse{RebalanceJobID: jobID})
 	}
 }
 
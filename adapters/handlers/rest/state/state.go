@@ -49,6 +49,9 @@ type State struct {
 	AnonymousAccess *anonymous.Client
 	APIKey          *apikey.Client
 	Authorizer      authorization.Authorizer
+	// DataAuthorizer is Authorizer which also allows the actions on the
+	// objects of a class granted by its ACL, see schemaUC.NewACLAuthorizer
+	DataAuthorizer  authorization.Authorizer
 	AuthzController authorization.Controller
 
 	ServerConfig          *config.WeaviateConfig
@@ -65,15 +68,17 @@ type State struct {
 	RemoteReplicaIncoming *replica.RemoteReplicaIncoming
 	Traverser             *traverser.Traverser
 
-	ClassificationRepo *classifications.DistributedRepo
-	Metrics            *monitoring.PrometheusMetrics
-	ServerMetrics      *monitoring.ServerMetrics
-	BackupManager      *backup.Handler
-	DB                 *db.DB
-	BatchManager       *objects.BatchManager
-	ClusterHttpClient  *http.Client
-	ReindexCtxCancel   context.CancelFunc
-	MemWatch           *memwatch.Monitor
+	ClassificationRepo   *classifications.DistributedRepo
+	Metrics              *monitoring.PrometheusMetrics
+	ServerMetrics        *monitoring.ServerMetrics
+	BackupManager        *backup.Handler
+	DB                   *db.DB
+	BatchManager         *objects.BatchManager
+	ClusterHttpClient    *http.Client
+	ReindexCtxCancel     context.CancelFunc
+	ClassExpiryCtxCancel context.CancelFunc
+	AppliedIndexCancel   context.CancelFunc
+	MemWatch             *memwatch.Monitor
 
 	ClusterService *rCluster.Service
 	TenantActivity *tenantactivity.Handler
@@ -14,11 +14,13 @@ package db
 import (
 	"context"
 	"fmt"
+	"strconv"
 	"time"
 
 	"github.com/go-openapi/strfmt"
 	"github.com/pkg/errors"
 	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/filters"
 	"github.com/weaviate/weaviate/entities/schema"
 	"github.com/weaviate/weaviate/entities/storobj"
 	"github.com/weaviate/weaviate/usecases/memwatch"
@@ -173,29 +175,32 @@ func (db *DB) BatchDeleteObjects(ctx context.Context, params objects.BatchDelete
 		return objects.BatchDeleteResult{}, errors.Errorf("cannot find index for class %v", className)
 	}
 
+	if params.ChunkSize > 0 && params.ModifiedBefore.IsZero() && !idx.replicationEnabled() {
+		return db.batchDeleteObjectsInChunks(ctx, idx, params, deletionTime, tenant, schemaVersion)
+	}
+
 	// find all DocIDs in all shards that match the filter
 	shardDocIDs, err := idx.findUUIDs(ctx, params.Filters, tenant, repl)
 	if err != nil {
 		return objects.BatchDeleteResult{}, errors.Wrapf(err, "cannot find objects")
 	}
-	// prepare to be deleted list of DocIDs from all shards
-	toDelete := map[string][]strfmt.UUID{}
-	limit := db.config.QueryMaximumResults
-
-	matches := int64(0)
-	for shardName, docIDs := range shardDocIDs {
-		docIDsLength := int64(len(docIDs))
-		if matches <= limit {
-			if matches+docIDsLength <= limit {
-				toDelete[shardName] = docIDs
-			} else {
-				toDelete[shardName] = docIDs[:limit-matches]
-			}
+	skipped := int64(0)
+	if !params.ModifiedBefore.IsZero() {
+		// searched after the matches, so that objects updated in between are
+		// protected as well
+		modified, err := idx.findUUIDs(ctx, modifiedAfterFilter(params), tenant, repl)
+		if err != nil {
+			return objects.BatchDeleteResult{}, errors.Wrapf(err, "cannot find modified objects")
 		}
-		matches += docIDsLength
+		skipped = excludeUUIDs(shardDocIDs, modified)
 	}
+	excluded := excludeUUIDList(shardDocIDs, params.ExcludeUUIDs)
+	// prepare to be deleted list of DocIDs from all shards, skipped and
+	// excluded matches don't count towards the limit
+	toDelete, deletable := limitUUIDs(shardDocIDs, db.config.QueryMaximumResults)
+	matches := deletable + skipped + excluded
 
-	if err := db.memMonitor.CheckAlloc(memwatch.EstimateObjectDeleteMemory() * matches); err != nil {
+	if err := db.memMonitor.CheckAlloc(memwatch.EstimateObjectDeleteMemory() * deletable); err != nil {
 		db.logger.WithError(err).Errorf("memory pressure: cannot process batch delete object")
 		return objects.BatchDeleteResult{}, fmt.Errorf("cannot process batch delete object: %w", err)
 	}
@@ -212,10 +217,120 @@ func (db *DB) BatchDeleteObjects(ctx context.Context, params objects.BatchDelete
 		DeletionTime: deletionTime,
 		DryRun:       params.DryRun,
 		Objects:      deletedObjects,
+		Skipped:      skipped,
+		Excluded:     excluded,
 	}
 	return result, nil
 }
 
+// batchDeleteObjectsInChunks deletes the matches of params chunk by chunk,
+// so that only the uuids of a single chunk per shard are held in memory
+func (db *DB) batchDeleteObjectsInChunks(ctx context.Context, idx *Index, params objects.BatchDeleteParams,
+	deletionTime time.Time, tenant string, schemaVersion uint64,
+) (objects.BatchDeleteResult, error) {
+	if err := db.memMonitor.CheckAlloc(memwatch.EstimateObjectDeleteMemory() * int64(params.ChunkSize)); err != nil {
+		db.logger.WithError(err).Errorf("memory pressure: cannot process batch delete object")
+		return objects.BatchDeleteResult{}, fmt.Errorf("cannot process batch delete object: %w", err)
+	}
+
+	limit := db.config.QueryMaximumResults
+	matches, excluded, deletedObjects, err := idx.batchDeleteObjectsInChunks(ctx, params.Filters, params.ExcludeUUIDs,
+		tenant, limit, params.ChunkSize, deletionTime, params.DryRun, schemaVersion)
+	if err != nil {
+		return objects.BatchDeleteResult{}, errors.Wrapf(err, "cannot delete objects")
+	}
+
+	return objects.BatchDeleteResult{
+		Matches:      matches,
+		Limit:        limit,
+		DeletionTime: deletionTime,
+		DryRun:       params.DryRun,
+		Objects:      deletedObjects,
+		Excluded:     excluded,
+	}, nil
+}
+
+// modifiedAfterFilter matches all objects of the batch delete filter which
+// were updated after params.ModifiedBefore
+func modifiedAfterFilter(params objects.BatchDeleteParams) *filters.LocalFilter {
+	return &filters.LocalFilter{Root: &filters.Clause{
+		Operator: filters.OperatorAnd,
+		Operands: []filters.Clause{
+			*params.Filters.Root,
+			{
+				Operator: filters.OperatorGreaterThan,
+				On: &filters.Path{
+					Class:    params.ClassName,
+					Property: filters.InternalPropLastUpdateTimeUnix,
+				},
+				Value: &filters.Value{
+					Value: strconv.FormatInt(params.ModifiedBefore.UnixMilli(), 10),
+					Type:  schema.DataTypeText,
+				},
+			},
+		},
+	}}
+}
+
+// limitUUIDs returns up to limit of the ids of all shards and the number of
+// ids there are in total
+func limitUUIDs(shardUUIDs map[string][]strfmt.UUID, limit int64) (map[string][]strfmt.UUID, int64) {
+	limited := map[string][]strfmt.UUID{}
+	total := int64(0)
+	for shardName, uuids := range shardUUIDs {
+		length := int64(len(uuids))
+		if total <= limit {
+			if total+length <= limit {
+				limited[shardName] = uuids
+			} else {
+				limited[shardName] = uuids[:limit-total]
+			}
+		}
+		total += length
+	}
+	return limited, total
+}
+
+// excludeUUIDs removes the excluded ids from the ids of each shard and returns
+// how many were removed
+func excludeUUIDs(shardUUIDs, excluded map[string][]strfmt.UUID) int64 {
+	removed := int64(0)
+	for shardName, ex := range excluded {
+		uuids, ok := shardUUIDs[shardName]
+		if !ok || len(ex) == 0 {
+			continue
+		}
+
+		exSet := make(map[strfmt.UUID]struct{}, len(ex))
+		for _, id := range ex {
+			exSet[id] = struct{}{}
+		}
+		kept := uuids[:0]
+		for _, id := range uuids {
+			if _, ok := exSet[id]; ok {
+				removed++
+				continue
+			}
+			kept = append(kept, id)
+		}
+		shardUUIDs[shardName] = kept
+	}
+	return removed
+}
+
+// excludeUUIDList removes the excluded ids from the ids of all shards and
+// returns how many were removed
+func excludeUUIDList(shardUUIDs map[string][]strfmt.UUID, excluded []strfmt.UUID) int64 {
+	if len(excluded) == 0 {
+		return 0
+	}
+	perShard := make(map[string][]strfmt.UUID, len(shardUUIDs))
+	for shardName := range shardUUIDs {
+		perShard[shardName] = excluded
+	}
+	return excludeUUIDs(shardUUIDs, perShard)
+}
+
 func estimateBatchMemory(objs objects.BatchObjects) int64 {
 	var sum int64
 	for _, item := range objs {
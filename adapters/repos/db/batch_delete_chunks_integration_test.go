@@ -0,0 +1,165 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/storobj"
+)
+
+func allObjectsFilter() *filters.LocalFilter {
+	return &filters.LocalFilter{Root: &filters.Clause{
+		Operator: filters.OperatorLike,
+		Value:    &filters.Value{Value: "*", Type: schema.DataTypeText},
+		On:       &filters.Path{Property: schema.PropertyName("id")},
+	}}
+}
+
+func putTestObjects(t testing.TB, ctx context.Context, shard ShardLike, className string, count int) {
+	const batchSize = 10_000
+	for start := 0; start < count; start += batchSize {
+		batch := make([]*storobj.Object, min(batchSize, count-start))
+		for i := range batch {
+			batch[i] = testObject(className)
+		}
+		for _, err := range shard.PutObjectBatch(ctx, batch) {
+			require.Nil(t, err)
+		}
+	}
+}
+
+func TestShard_DeleteObjectsInChunks(t *testing.T) {
+	ctx := context.Background()
+	className := "DeleteInChunks"
+	shard, _ := testShard(t, ctx, className)
+	putTestObjects(t, ctx, shard, className, 250)
+
+	t.Run("dry run", func(t *testing.T) {
+		matches, _, objs, err := shard.DeleteObjectsInChunks(ctx, allObjectsFilter(), nil, 1000, 100, time.Now(), true)
+		require.Nil(t, err)
+		assert.Equal(t, int64(250), matches)
+		assert.Len(t, objs, 250)
+		assert.Equal(t, 250, shard.ObjectCount())
+	})
+
+	t.Run("excluded uuids", func(t *testing.T) {
+		uuids, err := shard.FindUUIDs(ctx, allObjectsFilter())
+		require.Nil(t, err)
+		exclude := []strfmt.UUID{uuids[0], uuids[1], "8d5a3aa2-3c8a-4d4e-9b7a-0e0f6c4a3b21"}
+
+		// the excluded matches don't take up the limit
+		matches, excluded, objs, err := shard.DeleteObjectsInChunks(ctx, allObjectsFilter(), exclude, 248, 100, time.Now(), true)
+		require.Nil(t, err)
+		assert.Equal(t, int64(250), matches)
+		assert.Equal(t, int64(2), excluded)
+		require.Len(t, objs, 248)
+		for _, obj := range objs {
+			assert.NotContains(t, exclude[:2], obj.UUID)
+		}
+	})
+
+	t.Run("up to the limit", func(t *testing.T) {
+		matches, _, objs, err := shard.DeleteObjectsInChunks(ctx, allObjectsFilter(), nil, 130, 100, time.Now(), false)
+		require.Nil(t, err)
+		assert.Equal(t, int64(250), matches)
+		require.Len(t, objs, 130)
+		for _, obj := range objs {
+			assert.Nil(t, obj.Err)
+		}
+	})
+
+	t.Run("remaining matches", func(t *testing.T) {
+		matches, _, objs, err := shard.DeleteObjectsInChunks(ctx, allObjectsFilter(), nil, 1000, 7, time.Now(), false)
+		require.Nil(t, err)
+		assert.Equal(t, int64(120), matches)
+		assert.Len(t, objs, 120)
+
+		matches, _, _, err = shard.DeleteObjectsInChunks(ctx, allObjectsFilter(), nil, 1000, 7, time.Now(), false)
+		require.Nil(t, err)
+		assert.Zero(t, matches)
+	})
+
+	t.Run("invalid chunk size", func(t *testing.T) {
+		_, _, _, err := shard.DeleteObjectsInChunks(ctx, allObjectsFilter(), nil, 1000, 0, time.Now(), false)
+		assert.Error(t, err)
+	})
+}
+
+// BenchmarkShard_DeleteObjectsInChunks reports the peak heap in use while
+// dry run deleting 1M matches up to the default query limit, in chunks and
+// with all matches looked up at once
+func BenchmarkShard_DeleteObjectsInChunks(b *testing.B) {
+	const (
+		count = 1_000_000
+		limit = 10_000
+	)
+	ctx := context.Background()
+	className := "DeleteInChunksBenchmark"
+	shard, _ := testShard(b, ctx, className)
+	putTestObjects(b, ctx, shard, className, count)
+
+	run := func(b *testing.B, del func() error) {
+		for i := 0; i < b.N; i++ {
+			runtime.GC()
+			stop := make(chan struct{})
+			peak := make(chan uint64)
+			go func() {
+				var highest uint64
+				var stats runtime.MemStats
+				for {
+					runtime.ReadMemStats(&stats)
+					highest = max(highest, stats.HeapInuse)
+					select {
+					case <-stop:
+						peak <- highest
+						return
+					case <-time.After(10 * time.Millisecond):
+					}
+				}
+			}()
+			require.Nil(b, del())
+			close(stop)
+			b.ReportMetric(float64(<-peak)/(1<<20), "peak-heap-MB")
+		}
+	}
+
+	for _, chunkSize := range []int{100, 1_000, 10_000} {
+		b.Run(fmt.Sprintf("chunk size %d", chunkSize), func(b *testing.B) {
+			run(b, func() error {
+				_, _, _, err := shard.DeleteObjectsInChunks(ctx, allObjectsFilter(), nil, limit, chunkSize, time.Now(), true)
+				return err
+			})
+		})
+	}
+	b.Run("all at once", func(b *testing.B) {
+		run(b, func() error {
+			uuids, err := shard.FindUUIDs(ctx, allObjectsFilter())
+			if err != nil {
+				return err
+			}
+			shard.DeleteObjectBatch(ctx, uuids[:limit], time.Now(), true)
+			return nil
+		})
+	})
+}
@@ -0,0 +1,93 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/stretchr/testify/assert"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/usecases/objects"
+)
+
+func TestBatchDeleteModifiedBefore(t *testing.T) {
+	t.Run("filter", func(t *testing.T) {
+		root := &filters.Clause{
+			Operator: filters.OperatorEqual,
+			On:       &filters.Path{Class: "C", Property: "name"},
+			Value:    &filters.Value{Value: "foo", Type: schema.DataTypeText},
+		}
+		filter := modifiedAfterFilter(objects.BatchDeleteParams{
+			ClassName:      "C",
+			Filters:        &filters.LocalFilter{Root: root},
+			ModifiedBefore: time.UnixMilli(1700000000000),
+		})
+
+		assert.Equal(t, filters.OperatorAnd, filter.Root.Operator)
+		assert.Equal(t, []filters.Clause{*root, {
+			Operator: filters.OperatorGreaterThan,
+			On:       &filters.Path{Class: "C", Property: filters.InternalPropLastUpdateTimeUnix},
+			Value:    &filters.Value{Value: "1700000000000", Type: schema.DataTypeText},
+		}}, filter.Root.Operands)
+	})
+
+	t.Run("exclude uuids", func(t *testing.T) {
+		shardUUIDs := map[string][]strfmt.UUID{
+			"S1": {"a", "b", "c"},
+			"S2": {"d"},
+		}
+		removed := excludeUUIDs(shardUUIDs, map[string][]strfmt.UUID{
+			"S1": {"b", "c"},
+			"S3": {"e"},
+		})
+
+		assert.Equal(t, int64(2), removed)
+		assert.Equal(t, map[string][]strfmt.UUID{
+			"S1": {"a"},
+			"S2": {"d"},
+		}, shardUUIDs)
+	})
+}
+
+func TestBatchDeleteExcludeUUIDList(t *testing.T) {
+	shardUUIDs := map[string][]strfmt.UUID{
+		"S1": {"a", "b", "c"},
+		"S2": {"b", "d"},
+	}
+	assert.Equal(t, int64(0), excludeUUIDList(shardUUIDs, nil))
+
+	removed := excludeUUIDList(shardUUIDs, []strfmt.UUID{"b", "d", "e"})
+	assert.Equal(t, int64(3), removed)
+	assert.Equal(t, map[string][]strfmt.UUID{
+		"S1": {"a", "c"},
+		"S2": {},
+	}, shardUUIDs)
+}
+
+func TestBatchDeleteLimitUUIDs(t *testing.T) {
+	shardUUIDs := map[string][]strfmt.UUID{
+		"S1": {"a", "b", "c", "d"},
+		"S2": {"e"},
+	}
+	// the excluded matches don't take up the limit
+	excludeUUIDList(shardUUIDs, []strfmt.UUID{"b", "c"})
+	limited, total := limitUUIDs(shardUUIDs, 3)
+	assert.Equal(t, int64(3), total)
+	assert.Equal(t, shardUUIDs, limited)
+
+	limited, total = limitUUIDs(map[string][]strfmt.UUID{"S1": {"a", "b", "c"}}, 2)
+	assert.Equal(t, int64(3), total)
+	assert.Equal(t, map[string][]strfmt.UUID{"S1": {"a", "b"}}, limited)
+}
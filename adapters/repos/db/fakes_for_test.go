@@ -247,6 +247,24 @@ func (f *fakeRemoteClient) GetShardQueueSize(ctx context.Context,
 	return 0, nil
 }
 
+func (f *fakeRemoteClient) GetShardObjectCount(ctx context.Context,
+	hostName, indexName, shardName string,
+) (int64, error) {
+	return 0, nil
+}
+
+func (f *fakeRemoteClient) GetShardDataPurged(ctx context.Context,
+	hostName, indexName, shardName string,
+) (bool, error) {
+	return true, nil
+}
+
+func (f *fakeRemoteClient) PrewarmShard(ctx context.Context,
+	hostName, indexName, shardName string,
+) error {
+	return nil
+}
+
 func (f *fakeRemoteClient) GetShardStatus(ctx context.Context,
 	hostName, indexName, shardName string,
 ) (string, error) {
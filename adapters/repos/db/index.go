@@ -173,9 +173,17 @@ type Index struct {
 
 	partitioningEnabled bool
 
+	// shardVectorIndexConfigVersions are the versions of the vector index
+	// configs last applied to each shard, see updateShardVectorIndexConfigs
+	shardVectorIndexConfigVersions map[string]uint64
+	shardVectorIndexConfigLock     sync.Mutex
+
 	invertedIndexConfig     schema.InvertedIndexConfig
 	invertedIndexConfigLock sync.Mutex
 
+	compactionConfig     lsmkv.CompactionConfig
+	compactionConfigLock sync.RWMutex
+
 	// This lock should be used together with the db indexLock.
 	//
 	// The db indexlock locks the map that contains all indices against changes and should be used while iterating.
@@ -275,6 +283,7 @@ func NewIndex(ctx context.Context, cfg IndexConfig,
 		indexCheckpoints:       indexCheckpoints,
 		allocChecker:           allocChecker,
 		shardCreateLocks:       esync.NewKeyLocker(),
+		compactionConfig:       compactionConfigFromModel(class.CompactionConfig),
 	}
 	index.closingCtx, index.closingCancel = context.WithCancel(context.Background())
 
@@ -521,6 +530,70 @@ func (i *Index) updateVectorIndexConfig(ctx context.Context,
 	return nil
 }
 
+// setVectorIndexConfigs sets the vector index configs which shards are
+// initialized with, without updating the shards which are already initialized.
+// The config of the legacy vector index of classes without named vectors is
+// keyed by "".
+func (i *Index) setVectorIndexConfigs(updated map[string]schemaConfig.VectorIndexConfig) {
+	i.vectorIndexUserConfigLock.Lock()
+	defer i.vectorIndexUserConfigLock.Unlock()
+
+	for targetName, targetCfg := range updated {
+		if targetName == "" {
+			i.vectorIndexUserConfig = targetCfg
+			continue
+		}
+		if i.vectorIndexUserConfigs == nil {
+			i.vectorIndexUserConfigs = map[string]schemaConfig.VectorIndexConfig{}
+		}
+		i.vectorIndexUserConfigs[targetName] = targetCfg
+	}
+}
+
+// updateShardVectorIndexConfigs updates a single local shard to the vector
+// index configs set with setVectorIndexConfigs. Updates are applied in order:
+// if the shard has been updated to the given or a later version already,
+// nothing is done. Lazy shards which have not been loaded yet are skipped,
+// they pick up the configs of the index once they are loaded.
+func (i *Index) updateShardVectorIndexConfigs(ctx context.Context, shardName string, version uint64) error {
+	i.shardVectorIndexConfigLock.Lock()
+	defer i.shardVectorIndexConfigLock.Unlock()
+
+	if i.shardVectorIndexConfigVersions[shardName] >= version {
+		return nil
+	}
+	shard := i.shards.Load(shardName)
+	if shard == nil {
+		return nil
+	}
+	if lazyShard, ok := shard.(*LazyLoadShard); ok && !lazyShard.isLoaded() {
+		return nil
+	}
+
+	i.vectorIndexUserConfigLock.Lock()
+	legacy := i.vectorIndexUserConfig
+	named := make(map[string]schemaConfig.VectorIndexConfig, len(i.vectorIndexUserConfigs))
+	for targetName, targetCfg := range i.vectorIndexUserConfigs {
+		named[targetName] = targetCfg
+	}
+	i.vectorIndexUserConfigLock.Unlock()
+
+	var err error
+	if len(named) > 0 {
+		err = shard.UpdateVectorIndexConfigs(ctx, named)
+	} else {
+		err = shard.UpdateVectorIndexConfig(ctx, legacy)
+	}
+	if err != nil {
+		return err
+	}
+	if i.shardVectorIndexConfigVersions == nil {
+		i.shardVectorIndexConfigVersions = map[string]uint64{}
+	}
+	i.shardVectorIndexConfigVersions[shardName] = version
+	return nil
+}
+
 func (i *Index) updateVectorIndexConfigs(ctx context.Context,
 	updated map[string]schemaConfig.VectorIndexConfig,
 ) error {
@@ -2147,6 +2220,26 @@ func (i *Index) dropShards(names []string) error {
 	return ec.ToError()
 }
 
+// closeShard shuts down the local shard name and removes it from the index,
+// its files are kept on disk
+func (i *Index) closeShard(ctx context.Context, name string) error {
+	i.closeLock.RLock()
+	defer i.closeLock.RUnlock()
+
+	if i.closed {
+		return errAlreadyShutdown
+	}
+
+	i.shardCreateLocks.Lock(name)
+	defer i.shardCreateLocks.Unlock(name)
+
+	shard, ok := i.shards.LoadAndDelete(name)
+	if !ok {
+		return nil
+	}
+	return shard.Shutdown(ctx)
+}
+
 func (i *Index) dropCloudShards(ctx context.Context, cloud modulecapabilities.OffloadCloud, names []string, nodeId string) error {
 	i.shardTransferMutex.RLock()
 	defer i.shardTransferMutex.RUnlock()
@@ -2307,6 +2400,77 @@ func (i *Index) IncomingGetShardQueueSize(ctx context.Context, shardName string)
 	return size, nil
 }
 
+func (i *Index) getShardObjectCount(ctx context.Context, shardName string) (int64, error) {
+	shard, release, err := i.GetShard(ctx, shardName)
+	if err != nil {
+		return 0, errors.Wrapf(err, "shard %s", shardName)
+	}
+	if shard == nil {
+		return 0, errors.Errorf("shard %s is not local", shardName)
+	}
+	defer release()
+
+	return int64(shard.ObjectCount()), nil
+}
+
+// getReplicaObjectCounts returns the number of objects per shard and node of
+// every replica of the index. Shards are loaded to be counted.
+func (i *Index) getReplicaObjectCounts(ctx context.Context) (map[string]map[string]int64, error) {
+	state := i.shardState()
+	counts := make(map[string]map[string]int64, len(state.Physical))
+	for shardName, physical := range state.Physical {
+		counts[shardName] = make(map[string]int64, len(physical.BelongsToNodes))
+		for _, node := range physical.BelongsToNodes {
+			var count int64
+			var err error
+			if node == i.getSchema.NodeName() {
+				count, err = i.IncomingGetShardObjectCount(ctx, shardName)
+			} else {
+				count, err = i.remote.GetShardObjectCount(ctx, node, shardName)
+			}
+			if err != nil {
+				return nil, errors.Wrapf(err, "shard %s on node %s", shardName, node)
+			}
+			counts[shardName][node] = count
+		}
+	}
+	return counts, nil
+}
+
+func (i *Index) IncomingGetShardObjectCount(ctx context.Context, shardName string) (int64, error) {
+	shard, release, err := i.getOrInitShard(ctx, shardName)
+	if err != nil {
+		return 0, err
+	}
+	defer release()
+
+	if shard.GetStatus() == storagestate.StatusLoading {
+		return 0, enterrors.NewErrUnprocessable(fmt.Errorf("local %s shard is not ready", shardName))
+	}
+	return int64(shard.ObjectCount()), nil
+}
+
+// IncomingGetShardDataPurged returns whether the local shard shardName is
+// unloaded and its files are removed from disk
+func (i *Index) IncomingGetShardDataPurged(ctx context.Context, shardName string) (bool, error) {
+	if i.shards.Load(shardName) != nil {
+		return false, nil
+	}
+	if _, err := os.Stat(shardPath(i.path(), shardName)); err != nil {
+		if os.IsNotExist(err) {
+			return true, nil
+		}
+		return false, err
+	}
+	return false, nil
+}
+
+// IncomingPrewarmShard loads the local shard shardName into memory and blocks
+// until it is loaded
+func (i *Index) IncomingPrewarmShard(ctx context.Context, shardName string) error {
+	return i.loadLocalShard(ctx, shardName)
+}
+
 func (i *Index) getShardsStatus(ctx context.Context, tenant string) (map[string]string, error) {
 	shardsStatus := make(map[string]string)
 
@@ -2507,6 +2671,72 @@ func (i *Index) batchDeleteObjects(ctx context.Context, shardUUIDs map[string][]
 	return out, nil
 }
 
+// batchDeleteObjectsInChunks combines findUUIDs and batchDeleteObjects for
+// indexes without replication. Local shards look up the uuids of their
+// matches chunkSize at a time, remote shards still return all of them at
+// once. Up to limit matches which aren't excluded are deleted over all
+// shards. It returns the number of matches, which may be larger, and how
+// many of them were excluded.
+func (i *Index) batchDeleteObjectsInChunks(ctx context.Context, filters *filters.LocalFilter, exclude []strfmt.UUID,
+	tenant string, limit int64, chunkSize int, deletionTime time.Time, dryRun bool, schemaVersion uint64,
+) (int64, int64, objects.BatchSimpleObjects, error) {
+	before := time.Now()
+	defer i.metrics.BatchDelete(before, "delete_in_chunks_total")
+
+	if err := i.validateMultiTenancy(tenant); err != nil {
+		return 0, 0, nil, err
+	}
+	shardNames, err := i.targetShardNames(ctx, tenant)
+	if err != nil {
+		return 0, 0, nil, err
+	}
+
+	var (
+		matches, excluded int64
+		out               objects.BatchSimpleObjects
+	)
+	for _, shardName := range shardNames {
+		remaining := max(limit-(matches-excluded), 0)
+		shard, release, err := i.GetShard(ctx, shardName)
+		if err != nil {
+			return 0, 0, nil, fmt.Errorf("delete matches in shard %q: %w", shardName, err)
+		}
+
+		var (
+			shardMatches, shardExcluded int64
+			objs                        objects.BatchSimpleObjects
+		)
+		if shard != nil {
+			i.shardTransferMutex.RLockGuard(func() error {
+				defer release()
+				shardMatches, shardExcluded, objs, err = shard.DeleteObjectsInChunks(ctx, filters, exclude,
+					remaining, chunkSize, deletionTime, dryRun)
+				return nil
+			})
+		} else {
+			var uuids []strfmt.UUID
+			if uuids, err = i.remote.FindUUIDs(ctx, shardName, filters); err == nil {
+				shardMatches = int64(len(uuids))
+				perShard := map[string][]strfmt.UUID{shardName: uuids}
+				shardExcluded = excludeUUIDList(perShard, exclude)
+				if uuids = perShard[shardName]; remaining < int64(len(uuids)) {
+					uuids = uuids[:remaining]
+				}
+				if len(uuids) > 0 {
+					objs = i.remote.DeleteObjectBatch(ctx, shardName, uuids, deletionTime, dryRun, schemaVersion)
+				}
+			}
+		}
+		if err != nil {
+			return 0, 0, nil, fmt.Errorf("delete matches in shard %q: %w", shardName, err)
+		}
+		matches += shardMatches
+		excluded += shardExcluded
+		out = append(out, objs...)
+	}
+	return matches, excluded, out, nil
+}
+
 func (i *Index) IncomingDeleteObjectBatch(ctx context.Context, shardName string,
 	uuids []strfmt.UUID, deletionTime time.Time, dryRun bool, schemaVersion uint64,
 ) objects.BatchSimpleObjects {
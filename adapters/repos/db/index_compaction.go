@@ -0,0 +1,73 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// compactionConfigFromModel converts the compaction settings of a class,
+// unset settings keep the ones of the buckets
+func compactionConfigFromModel(cfg *models.ClassCompactionConfig) lsmkv.CompactionConfig {
+	if cfg == nil {
+		return lsmkv.CompactionConfig{}
+	}
+	return lsmkv.CompactionConfig{
+		MaxSegmentSize:   cfg.MaxSegmentSizeMB * 1024 * 1024,
+		Interval:         time.Duration(cfg.CompactionIntervalSeconds) * time.Second,
+		MinMergeSegments: int(cfg.MinMergeSegments),
+	}
+}
+
+func (i *Index) getCompactionConfig() lsmkv.CompactionConfig {
+	i.compactionConfigLock.RLock()
+	defer i.compactionConfigLock.RUnlock()
+
+	return i.compactionConfig
+}
+
+// updateCompactionConfig applies cfg to the loaded shards. Shards loaded
+// later pick it up when their store is initialized.
+func (i *Index) updateCompactionConfig(cfg lsmkv.CompactionConfig) {
+	// shards being loaded read the config, so the lock can't be held while
+	// waiting for them below
+	i.compactionConfigLock.Lock()
+	i.compactionConfig = cfg
+	i.compactionConfigLock.Unlock()
+
+	i.ForEachLoadedShard(func(name string, shard ShardLike) error {
+		shard.SetCompactionConfig(cfg)
+		return nil
+	})
+}
+
+// getShardsCompactionStats returns the compaction stats of the loaded local
+// shards. Unloaded shards aren't loaded for it.
+func (i *Index) getShardsCompactionStats(tenant string) map[string]*models.CompactionStats {
+	stats := make(map[string]*models.CompactionStats)
+	i.ForEachLoadedShard(func(name string, shard ShardLike) error {
+		if tenant != "" && name != tenant {
+			return nil
+		}
+		shardStats := shard.CompactionStats()
+		stats[name] = &models.CompactionStats{SegmentCount: int64(shardStats.SegmentCount)}
+		if !shardStats.LastCompaction.IsZero() {
+			stats[name].LastCompactionTime = strfmt.DateTime(shardStats.LastCompaction)
+		}
+		return nil
+	})
+	return stats
+}
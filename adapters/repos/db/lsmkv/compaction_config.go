@@ -0,0 +1,134 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package lsmkv
+
+import (
+	"time"
+)
+
+// CompactionConfig overrides the compaction settings of buckets at runtime.
+// Zero values keep the settings the buckets were created with.
+type CompactionConfig struct {
+	// MaxSegmentSize is the size in bytes compacted segments may not exceed
+	MaxSegmentSize int64
+	// Interval is the minimum time between two compaction cycles which find
+	// nothing to compact. Compaction continues without a pause as long as
+	// it finds segments to merge.
+	Interval time.Duration
+	// MinMergeSegments is the number of segments a bucket needs before its
+	// segments are compacted
+	MinMergeSegments int
+}
+
+// CompactionStats describes the segments of a bucket or a store
+type CompactionStats struct {
+	SegmentCount int
+	// LastCompaction is when segments were last merged since startup, the
+	// zero time if they weren't
+	LastCompaction time.Time
+}
+
+// SetCompactionConfig changes the compaction settings of the bucket
+func (b *Bucket) SetCompactionConfig(cfg CompactionConfig) {
+	if b.disk != nil {
+		b.disk.setCompactionConfig(cfg)
+	}
+}
+
+// CompactionStats returns the number of segments of the bucket and when
+// they were last compacted
+func (b *Bucket) CompactionStats() CompactionStats {
+	if b.disk == nil {
+		return CompactionStats{}
+	}
+	return CompactionStats{SegmentCount: b.disk.Len(), LastCompaction: b.disk.lastCompactionTime()}
+}
+
+// SetCompactionConfig changes the compaction settings of all buckets of the
+// store, including the ones created later
+func (s *Store) SetCompactionConfig(cfg CompactionConfig) {
+	s.bucketAccessLock.Lock()
+	defer s.bucketAccessLock.Unlock()
+
+	s.compactionConfig = cfg
+	for _, b := range s.bucketsByName {
+		if b != nil {
+			b.SetCompactionConfig(cfg)
+		}
+	}
+}
+
+// CompactionStats sums up the segments of all buckets of the store. The last
+// compaction is the latest one of any bucket.
+func (s *Store) CompactionStats() CompactionStats {
+	s.bucketAccessLock.RLock()
+	defer s.bucketAccessLock.RUnlock()
+
+	var stats CompactionStats
+	for _, b := range s.bucketsByName {
+		if b == nil {
+			continue
+		}
+		bucketStats := b.CompactionStats()
+		stats.SegmentCount += bucketStats.SegmentCount
+		if bucketStats.LastCompaction.After(stats.LastCompaction) {
+			stats.LastCompaction = bucketStats.LastCompaction
+		}
+	}
+	return stats
+}
+
+func (sg *SegmentGroup) setCompactionConfig(cfg CompactionConfig) {
+	sg.compactionConfigLock.Lock()
+	defer sg.compactionConfigLock.Unlock()
+
+	sg.maxSegmentSize = sg.defaultMaxSegmentSize
+	if cfg.MaxSegmentSize > 0 {
+		sg.maxSegmentSize = cfg.MaxSegmentSize
+	}
+	sg.compactionInterval = cfg.Interval
+	sg.minMergeSegments = cfg.MinMergeSegments
+}
+
+// compactionDue reports whether the compaction cycle should look for
+// segments to compact
+func (sg *SegmentGroup) compactionDue() bool {
+	sg.compactionConfigLock.RLock()
+	interval, minMergeSegments := sg.compactionInterval, sg.minMergeSegments
+	lastCallCompacted := sg.lastCallCompacted
+	sg.compactionConfigLock.RUnlock()
+
+	if interval > 0 && !lastCallCompacted && time.Since(sg.lastCompactionCall) < interval {
+		return false
+	}
+	if minMergeSegments > 0 && sg.Len() < minMergeSegments {
+		return false
+	}
+	return true
+}
+
+func (sg *SegmentGroup) compactionDone(compacted bool) {
+	sg.compactionConfigLock.Lock()
+	defer sg.compactionConfigLock.Unlock()
+
+	sg.lastCallCompacted = compacted
+	if compacted {
+		sg.lastCompaction = time.Now()
+	}
+}
+
+func (sg *SegmentGroup) lastCompactionTime() time.Time {
+	sg.compactionConfigLock.RLock()
+	defer sg.compactionConfigLock.RUnlock()
+
+	return sg.lastCompaction
+}
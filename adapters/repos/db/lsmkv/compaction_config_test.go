@@ -0,0 +1,68 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package lsmkv
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSegmentGroup_CompactionConfig(t *testing.T) {
+	newGroup := func() *SegmentGroup {
+		return &SegmentGroup{
+			segments: []*segment{
+				{size: 4000, path: "segment0", level: 2},
+				{size: 4000, path: "segment1", level: 2},
+			},
+			maxSegmentSize:        10000,
+			defaultMaxSegmentSize: 10000,
+			lastCompactionCall:    time.Now(),
+		}
+	}
+
+	t.Run("max segment size", func(t *testing.T) {
+		sg := newGroup()
+		sg.setCompactionConfig(CompactionConfig{MaxSegmentSize: 5000})
+		pair, _ := sg.findCompactionCandidates()
+		assert.Nil(t, pair)
+
+		sg.setCompactionConfig(CompactionConfig{})
+		pair, _ = sg.findCompactionCandidates()
+		assert.Equal(t, []int{0, 1}, pair, "the default size applies again")
+	})
+
+	t.Run("min merge segments", func(t *testing.T) {
+		sg := newGroup()
+		assert.True(t, sg.compactionDue())
+		sg.setCompactionConfig(CompactionConfig{MinMergeSegments: 3})
+		assert.False(t, sg.compactionDue())
+		sg.setCompactionConfig(CompactionConfig{MinMergeSegments: 2})
+		assert.True(t, sg.compactionDue())
+	})
+
+	t.Run("interval", func(t *testing.T) {
+		sg := newGroup()
+		sg.setCompactionConfig(CompactionConfig{Interval: time.Hour})
+		assert.False(t, sg.compactionDue(), "the last check found nothing")
+
+		sg.compactionDone(true)
+		assert.True(t, sg.compactionDue(), "compaction continues while it finds segments")
+		assert.WithinDuration(t, time.Now(), sg.lastCompactionTime(), time.Minute)
+
+		sg.compactionDone(false)
+		assert.False(t, sg.compactionDue())
+		sg.lastCompactionCall = time.Now().Add(-2 * time.Hour)
+		assert.True(t, sg.compactionDue())
+	})
+}
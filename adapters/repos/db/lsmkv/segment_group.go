@@ -70,8 +70,18 @@ type SegmentGroup struct {
 	calcCountNetAdditions   bool // see bucket for more datails
 	compactLeftOverSegments bool // see bucket for more datails
 
-	allocChecker   memwatch.AllocChecker
-	maxSegmentSize int64
+	allocChecker memwatch.AllocChecker
+
+	// compaction settings which can be changed at runtime, see
+	// SetCompactionConfig
+	compactionConfigLock sync.RWMutex
+	maxSegmentSize       int64
+	// defaultMaxSegmentSize is the maxSegmentSize the group was created with
+	defaultMaxSegmentSize int64
+	compactionInterval    time.Duration
+	minMergeSegments      int
+	lastCompaction        time.Time
+	lastCallCompacted     bool
 
 	segmentCleaner     segmentCleaner
 	cleanupInterval    time.Duration
@@ -117,6 +127,7 @@ func newSegmentGroup(logger logrus.FieldLogger, metrics *Metrics,
 		calcCountNetAdditions:   cfg.calcCountNetAdditions,
 		compactLeftOverSegments: cfg.forceCompaction,
 		maxSegmentSize:          cfg.maxSegmentSize,
+		defaultMaxSegmentSize:   cfg.maxSegmentSize,
 		cleanupInterval:         cfg.cleanupInterval,
 		allocChecker:            allocChecker,
 		lastCompactionCall:      now,
@@ -634,8 +645,12 @@ func (sg *SegmentGroup) compactOrCleanup(shouldAbort cyclemanager.ShouldAbortCal
 	sg.monitorSegments()
 
 	compact := func() bool {
+		if !sg.compactionDue() {
+			return false
+		}
 		sg.lastCompactionCall = time.Now()
 		compacted, err := sg.compactOnce()
+		sg.compactionDone(compacted && err == nil)
 		if err != nil {
 			sg.logger.WithField("action", "lsm_compaction").
 				WithField("path", sg.dir).
@@ -650,11 +650,15 @@ func (s *segmentLevelStats) report(metrics *Metrics,
 }
 
 func (sg *SegmentGroup) compactionFitsSizeLimit(left, right *segment) bool {
-	if sg.maxSegmentSize == 0 {
+	sg.compactionConfigLock.RLock()
+	maxSegmentSize := sg.maxSegmentSize
+	sg.compactionConfigLock.RUnlock()
+
+	if maxSegmentSize == 0 {
 		// no limit is set, always return true
 		return true
 	}
 
 	totalSize := left.size + right.size
-	return totalSize <= sg.maxSegmentSize
+	return totalSize <= maxSegmentSize
 }
@@ -52,6 +52,8 @@ type Store struct {
 
 	cycleCallbacks *storeCycleCallbacks
 	bcreator       BucketCreator
+	// compactionConfig is applied to all buckets, guarded by bucketAccessLock
+	compactionConfig CompactionConfig
 	// Prevent concurrent manipulations to the same Bucket, specially if there is
 	// action on the bucket in the meantime.
 	bucketsLocks *wsync.KeyLocker
@@ -205,6 +207,7 @@ func (s *Store) setBucket(name string, b *Bucket) {
 	s.bucketAccessLock.Lock()
 	defer s.bucketAccessLock.Unlock()
 
+	b.SetCompactionConfig(s.compactionConfig)
 	s.bucketsByName[name] = b
 }
 
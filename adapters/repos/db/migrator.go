@@ -14,6 +14,8 @@ package db
 import (
 	"context"
 	"fmt"
+	"os"
+	"path"
 	"time"
 
 	"github.com/pkg/errors"
@@ -27,6 +29,7 @@ import (
 	"github.com/weaviate/weaviate/cluster/types"
 	"github.com/weaviate/weaviate/entities/errorcompounder"
 	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/entities/jobs"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/modulecapabilities"
 	"github.com/weaviate/weaviate/entities/schema"
@@ -58,14 +61,20 @@ type Migrator struct {
 	cluster processor
 	nodeId  string
 
-	classLocks *esync.KeyLocker
+	classLocks      *esync.KeyLocker
+	backfills       *jobs.Tracker[types.BackfillStatus]
+	vectorBackfills *jobs.Tracker[types.VectorBackfillStatus]
+	// vectorizer is nil until SetVectorizer is called
+	vectorizer batchVectorizer
 }
 
 func NewMigrator(db *DB, logger logrus.FieldLogger) *Migrator {
 	return &Migrator{
-		db:         db,
-		logger:     logger,
-		classLocks: esync.NewKeyLocker(),
+		db:              db,
+		logger:          logger,
+		classLocks:      esync.NewKeyLocker(),
+		backfills:       jobs.NewTracker[types.BackfillStatus](),
+		vectorBackfills: jobs.NewTracker[types.VectorBackfillStatus](),
 	}
 }
 
@@ -167,6 +176,37 @@ func (m *Migrator) DropClass(ctx context.Context, className string, hasFrozen bo
 	return nil
 }
 
+// UnloadClass closes the index of className, its files are kept on disk so
+// that AddClass loads them again
+func (m *Migrator) UnloadClass(ctx context.Context, className string) error {
+	indexID := indexID(schema.ClassName(className))
+
+	m.classLocks.Lock(indexID)
+	defer m.classLocks.Unlock(indexID)
+
+	return m.db.UnloadIndex(ctx, schema.ClassName(className))
+}
+
+// DropUnloadedClass removes the files of className, which must not be loaded
+func (m *Migrator) DropUnloadedClass(ctx context.Context, className string, hasFrozen bool) error {
+	indexID := indexID(schema.ClassName(className))
+
+	m.classLocks.Lock(indexID)
+	defer m.classLocks.Unlock(indexID)
+
+	if m.db.GetIndex(schema.ClassName(className)) != nil {
+		return fmt.Errorf("index for class %q is loaded", className)
+	}
+	if err := os.RemoveAll(path.Join(m.db.config.RootPath, indexID)); err != nil {
+		return fmt.Errorf("remove files of class %q: %w", className, err)
+	}
+
+	if m.cloud != nil && hasFrozen {
+		return m.cloud.Delete(ctx, className, "", "")
+	}
+	return nil
+}
+
 func (m *Migrator) UpdateClass(ctx context.Context, className string, newClassName *string) error {
 	if newClassName != nil {
 		return errors.New("weaviate does not support renaming of classes")
@@ -365,6 +405,44 @@ func (m *Migrator) GetShardsStatus(ctx context.Context, className, tenant string
 	return idx.getShardsStatus(ctx, tenant)
 }
 
+func (m *Migrator) GetShardsCompactionStats(ctx context.Context, className, tenant string) (map[string]*models.CompactionStats, error) {
+	idx := m.db.GetIndex(schema.ClassName(className))
+	if idx == nil {
+		return nil, errors.Errorf("cannot get compaction stats for a non-existing index for %s", className)
+	}
+
+	return idx.getShardsCompactionStats(tenant), nil
+}
+
+func (m *Migrator) ShardObjectCount(ctx context.Context, className, shardName string) (int64, error) {
+	idx := m.db.GetIndex(schema.ClassName(className))
+	if idx == nil {
+		return 0, errors.Errorf("cannot get object count for a non-existing index for %s", className)
+	}
+
+	return idx.getShardObjectCount(ctx, shardName)
+}
+
+// TenantLastActivity returns when the local tenant was last active, or the
+// zero time if its activity hasn't been observed yet
+func (m *Migrator) TenantLastActivity(className, tenant string) time.Time {
+	return m.db.LocalTenantActivity()[className][tenant]
+}
+
+// TenantQueriesInFlight returns the number of requests currently served by
+// the local shard of tenant, it is zero if the shard isn't loaded
+func (m *Migrator) TenantQueriesInFlight(className, tenant string) int64 {
+	idx := m.db.GetIndex(schema.ClassName(className))
+	if idx == nil {
+		return 0
+	}
+	shard := idx.shards.Load(tenant)
+	if shard == nil {
+		return 0
+	}
+	return shard.requestsInFlight()
+}
+
 func (m *Migrator) UpdateShardStatus(ctx context.Context, className, shardName, targetStatus string, schemaVersion uint64) error {
 	indexID := indexID(schema.ClassName(className))
 
@@ -379,6 +457,30 @@ func (m *Migrator) UpdateShardStatus(ctx context.Context, className, shardName,
 	return idx.updateShardStatus(ctx, shardName, targetStatus, schemaVersion)
 }
 
+// MoveShard opens the local shard shardName of className if this node is
+// toNode, so that it serves the copied files. If this node is fromNode, the
+// shard is closed, its files are kept.
+func (m *Migrator) MoveShard(ctx context.Context, className, shardName, fromNode, toNode string) error {
+	indexID := indexID(schema.ClassName(className))
+
+	m.classLocks.Lock(indexID)
+	defer m.classLocks.Unlock(indexID)
+
+	idx := m.db.GetIndex(schema.ClassName(className))
+	if idx == nil {
+		return errors.Errorf("cannot move shard of a non-existing index for %s", className)
+	}
+
+	switch m.nodeId {
+	case toNode:
+		return idx.initLocalShard(ctx, shardName)
+	case fromNode:
+		return idx.closeShard(ctx, shardName)
+	default:
+		return nil
+	}
+}
+
 // NewTenants creates new partitions
 func (m *Migrator) NewTenants(ctx context.Context, class *models.Class, creates []*schemaUC.CreateTenantPayload) error {
 	indexID := indexID(schema.ClassName(class.Class))
@@ -598,6 +700,44 @@ func (m *Migrator) UpdateVectorIndexConfigs(ctx context.Context,
 	return idx.updateVectorIndexConfigs(ctx, updated)
 }
 
+// SetVectorIndexConfigs sets the vector index configs new and lazily loaded
+// shards of the class are initialized with, see UpdateShardVectorIndexConfigs
+func (m *Migrator) SetVectorIndexConfigs(ctx context.Context,
+	className string, updated map[string]schemaConfig.VectorIndexConfig,
+) error {
+	indexID := indexID(schema.ClassName(className))
+
+	m.classLocks.Lock(indexID)
+	defer m.classLocks.Unlock(indexID)
+
+	idx := m.db.GetIndex(schema.ClassName(className))
+	if idx == nil {
+		return errors.Errorf("cannot set vector config of non-existing index for %s", className)
+	}
+
+	idx.setVectorIndexConfigs(updated)
+	return nil
+}
+
+// UpdateShardVectorIndexConfigs updates a single local shard of the class to
+// the vector index configs set with SetVectorIndexConfigs, unless it has been
+// updated to the given or a later version already
+func (m *Migrator) UpdateShardVectorIndexConfigs(ctx context.Context,
+	className, shardName string, version uint64,
+) error {
+	indexID := indexID(schema.ClassName(className))
+
+	m.classLocks.Lock(indexID)
+	defer m.classLocks.Unlock(indexID)
+
+	idx := m.db.GetIndex(schema.ClassName(className))
+	if idx == nil {
+		return errors.Errorf("cannot update vector config of non-existing index for %s", className)
+	}
+
+	return idx.updateShardVectorIndexConfigs(ctx, shardName, version)
+}
+
 func (m *Migrator) ValidateVectorIndexConfigUpdate(
 	old, updated schemaConfig.VectorIndexConfig,
 ) error {
@@ -630,6 +770,10 @@ func (m *Migrator) ValidateInvertedIndexConfigUpdate(old, updated *models.Invert
 	return inverted.ValidateUserConfigUpdate(old, updated)
 }
 
+func (m *Migrator) ValidateComputeExpression(expr string, sourcePropertyType string) error {
+	return schemaUC.ValidateComputeExpression(expr, sourcePropertyType)
+}
+
 func (m *Migrator) UpdateInvertedIndexConfig(ctx context.Context, className string,
 	updated *models.InvertedIndexConfig,
 ) error {
@@ -648,6 +792,168 @@ func (m *Migrator) UpdateInvertedIndexConfig(ctx context.Context, className stri
 	return idx.updateInvertedIndexConfig(ctx, conf)
 }
 
+// ReindexInvertedIndex rebuilds the inverted index buckets of all properties
+// of the local shards of className, e.g. after its inverted index config
+// changed
+func (m *Migrator) ReindexInvertedIndex(ctx context.Context, className string) error {
+	if m.db.GetIndex(schema.ClassName(className)) == nil {
+		return errors.Errorf("cannot reindex non-existing index for %s", className)
+	}
+	class := m.db.schemaGetter.ReadOnlyClass(className)
+	if class == nil {
+		return errors.Errorf("cannot reindex non-existing class %s", className)
+	}
+
+	propNames := make([]string, 0, len(class.Properties))
+	for _, prop := range class.Properties {
+		propNames = append(propNames, prop.Name)
+	}
+	if len(propNames) == 0 {
+		return nil
+	}
+	return m.InvertedReindex(ctx, map[string]any{
+		"ShardInvertedReindexTask_SpecifiedIndex": map[string][]string{className: propNames},
+	})
+}
+
+// tenantCopyBatchSize is the number of objects CopyTenantObjects writes to
+// the target class at once
+const tenantCopyBatchSize = 100
+
+// CopyTenantObjects copies the objects of the local shard of tenant of
+// sourceClassName to the same tenant of targetClassName. The objects are
+// written like a batch import, the target tenant may be held by other nodes.
+// progress is called with the number of objects copied after each batch.
+func (m *Migrator) CopyTenantObjects(ctx context.Context, sourceClassName, targetClassName, tenant string,
+	progress func(copied int64),
+) error {
+	source := m.db.GetIndex(schema.ClassName(sourceClassName))
+	if source == nil {
+		return errors.Errorf("cannot copy tenant of non-existing index for %s", sourceClassName)
+	}
+	target := m.db.GetIndex(schema.ClassName(targetClassName))
+	if target == nil {
+		return errors.Errorf("cannot copy tenant to non-existing index for %s", targetClassName)
+	}
+
+	shard, release, err := source.getOrInitShard(ctx, tenant)
+	if err != nil {
+		return fmt.Errorf("tenant %q of %s: %w", tenant, sourceClassName, err)
+	}
+	defer release()
+
+	var copied int64
+	batch := make([]*storobj.Object, 0, tenantCopyBatchSize)
+	flush := func() error {
+		if len(batch) == 0 {
+			return nil
+		}
+		for _, err := range target.putObjectBatch(ctx, batch, nil, 0) {
+			if err != nil {
+				return fmt.Errorf("copy objects to %s: %w", targetClassName, err)
+			}
+		}
+		copied += int64(len(batch))
+		batch = make([]*storobj.Object, 0, tenantCopyBatchSize)
+		progress(copied)
+		return nil
+	}
+
+	bucket := shard.Store().Bucket(helpers.ObjectsBucketLSM)
+	err = bucket.IterateObjects(ctx, func(obj *storobj.Object) error {
+		obj.Object.Class = targetClassName
+		obj.Object.Tenant = tenant
+		batch = append(batch, obj)
+		if len(batch) < tenantCopyBatchSize {
+			return nil
+		}
+		return flush()
+	})
+	if err != nil {
+		return err
+	}
+	return flush()
+}
+
+// ReplicaObjectCounts returns the number of objects per shard and node of
+// every replica of className. Replicas on other nodes are counted by them.
+func (m *Migrator) ReplicaObjectCounts(ctx context.Context, className string) (map[string]map[string]int64, error) {
+	idx := m.db.GetIndex(schema.ClassName(className))
+	if idx == nil {
+		return nil, errors.Errorf("cannot count objects of a non-existing index for %s", className)
+	}
+	return idx.getReplicaObjectCounts(ctx)
+}
+
+// TenantDataPurged returns whether the shard of tenant of className is
+// unloaded and its files are removed from disk on each of nodes. The other
+// nodes are asked for their shards.
+func (m *Migrator) TenantDataPurged(ctx context.Context, className, tenant string, nodes []string) (bool, error) {
+	idx := m.db.GetIndex(schema.ClassName(className))
+	if idx == nil {
+		return true, nil
+	}
+	for _, node := range nodes {
+		var purged bool
+		var err error
+		if node == m.nodeId {
+			purged, err = idx.IncomingGetShardDataPurged(ctx, tenant)
+		} else {
+			purged, err = idx.remote.GetShardDataPurged(ctx, node, tenant)
+		}
+		if err != nil {
+			return false, fmt.Errorf("tenant %q of %s on node %s: %w", tenant, className, node, err)
+		}
+		if !purged {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// PrewarmTenantIndex loads the shard of tenant of className, including its
+// vector index, on each of nodes and blocks until they are loaded. Lazily
+// loaded shards are otherwise only loaded by the first request accessing
+// them. The other nodes are asked to load their shards.
+func (m *Migrator) PrewarmTenantIndex(ctx context.Context, className, tenant string, nodes []string) error {
+	idx := m.db.GetIndex(schema.ClassName(className))
+	if idx == nil {
+		return errors.Errorf("cannot prewarm tenant of non-existing index for %s", className)
+	}
+	eg := enterrors.NewErrorGroupWrapper(m.logger, "tenant:", tenant)
+	for _, node := range nodes {
+		node := node
+		eg.Go(func() error {
+			var err error
+			if node == m.nodeId {
+				err = idx.loadLocalShard(ctx, tenant)
+			} else {
+				err = idx.remote.PrewarmShard(ctx, node, tenant)
+			}
+			if err != nil {
+				return fmt.Errorf("load tenant %q of %s on node %s: %w", tenant, className, node, err)
+			}
+			return nil
+		})
+	}
+	return eg.Wait()
+}
+
+func (m *Migrator) SetClassCompactionConfig(ctx context.Context, className string, cfg models.ClassCompactionConfig) error {
+	indexID := indexID(schema.ClassName(className))
+
+	m.classLocks.Lock(indexID)
+	defer m.classLocks.Unlock(indexID)
+
+	idx := m.db.GetIndex(schema.ClassName(className))
+	if idx == nil {
+		return errors.Errorf("cannot update compaction config of non-existing index for %s", className)
+	}
+
+	idx.updateCompactionConfig(compactionConfigFromModel(&cfg))
+	return nil
+}
+
 func (m *Migrator) UpdateReplicationConfig(ctx context.Context, className string, cfg *models.ReplicationConfig) error {
 	if cfg == nil {
 		return nil
@@ -674,6 +980,46 @@ func (m *Migrator) UpdateReplicationConfig(ctx context.Context, className string
 	return nil
 }
 
+// MigrateMultiTenancy closes the local index of the class and creates it
+// again with the updated class and sharding state. The class must not hold
+// any objects, which the schema handler checks on every replica while writes
+// to the class are blocked. Should this node hold objects of the class
+// nonetheless, its index is left as it is. No files are removed, the shards
+// of the former sharding state are left on disk.
+func (m *Migrator) MigrateMultiTenancy(ctx context.Context, className string, from, to models.MultiTenancyConfig) error {
+	if from.Enabled == to.Enabled {
+		return nil
+	}
+
+	class := m.db.schemaGetter.ReadOnlyClass(className)
+	if class == nil {
+		return errors.Errorf("cannot migrate multi-tenancy of non-existing class %s", className)
+	}
+	shardState := m.db.schemaGetter.CopyShardingState(className)
+	if shardState == nil {
+		return errors.Errorf("cannot migrate multi-tenancy of class %s without sharding state", className)
+	}
+	if idx := m.db.GetIndex(schema.ClassName(className)); idx != nil {
+		count := 0
+		if err := idx.ForEachShard(func(name string, shard ShardLike) error {
+			count += shard.ObjectCount()
+			return nil
+		}); err != nil {
+			return fmt.Errorf("count objects of class %q: %w", className, err)
+		}
+		if count > 0 {
+			return errors.Errorf("class %s holds %d objects on this node, its index is kept", className, count)
+		}
+	}
+	if err := m.UnloadClass(ctx, className); err != nil {
+		return fmt.Errorf("close index of class %q: %w", className, err)
+	}
+	if err := m.AddClass(ctx, class, shardState); err != nil {
+		return fmt.Errorf("create index of class %q: %w", className, err)
+	}
+	return nil
+}
+
 func (m *Migrator) RecalculateVectorDimensions(ctx context.Context) error {
 	count := 0
 	m.logger.
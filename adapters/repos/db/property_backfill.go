@@ -0,0 +1,159 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/cluster/types"
+	"github.com/weaviate/weaviate/entities/additional"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/usecases/objects"
+)
+
+// backfillBatchSize is the number of objects BackfillProperty writes at once
+const backfillBatchSize = 100
+
+func finishPropertyBackfill(err error) func(job *types.BackfillStatus) {
+	return func(job *types.BackfillStatus) {
+		job.FinishedAt = time.Now().UTC()
+		job.Status = types.BackfillFinished
+		if err != nil {
+			job.Status = types.BackfillFailed
+			job.Error = err.Error()
+		}
+	}
+}
+
+// BackfillProperty sets propName to defaultValue on all objects of className
+// which don't have a value for it. The shards are read from the nodes holding
+// them, and the value is merged into every replica of an object unless it got
+// a value for propName in the meantime. Tenants which aren't active are
+// skipped.
+func (m *Migrator) BackfillProperty(ctx context.Context, className, propName string,
+	defaultValue interface{},
+) (string, error) {
+	idx := m.db.GetIndex(schema.ClassName(className))
+	if idx == nil {
+		return "", errors.Errorf("cannot backfill property of non-existing index for %s", className)
+	}
+
+	job := types.BackfillStatus{
+		ID:        uuid.NewString(),
+		Class:     className,
+		Property:  propName,
+		Status:    types.BackfillRunning,
+		StartedAt: time.Now().UTC(),
+	}
+	m.backfills.Add(job.ID, job)
+	enterrors.GoWrapper(func() {
+		err := m.backfillProperty(context.Background(), idx, job.ID, propName, defaultValue)
+		if err != nil {
+			m.logger.WithField("action", "backfill_property").
+				WithField("class", className).
+				WithField("property", propName).
+				WithField("job", job.ID).
+				WithError(err).Error("backfilling property failed")
+		}
+		m.backfills.Finish(job.ID, finishPropertyBackfill(err))
+	}, m.logger)
+
+	return job.ID, nil
+}
+
+// BackfillStatus returns the backfill job jobID started on this node
+func (m *Migrator) BackfillStatus(jobID string) (types.BackfillStatus, bool) {
+	return m.backfills.Get(jobID)
+}
+
+func (m *Migrator) backfillProperty(ctx context.Context, idx *Index, jobID, propName string,
+	defaultValue interface{},
+) error {
+	state := idx.shardState()
+	for _, name := range state.AllPhysicalShards() {
+		if idx.partitioningEnabled {
+			physical := state.Physical[name]
+			if status := physical.ActivityStatus(); status != models.TenantActivityStatusHOT {
+				m.logger.WithField("action", "backfill_property").
+					WithField("class", idx.Config.ClassName).
+					WithField("tenant", name).
+					WithField("job", jobID).
+					Warnf("skipping backfill of %s tenant", status)
+				continue
+			}
+		}
+		err := backfillShard(ctx, idx, name, propName, defaultValue, func(updated int64) {
+			m.backfills.Update(jobID, func(job *types.BackfillStatus) { job.Updated += updated })
+		})
+		if err != nil {
+			return fmt.Errorf("shard %q: %w", name, err)
+		}
+	}
+	return nil
+}
+
+// backfillShard reads the objects of shardName in batches of
+// backfillBatchSize and merges defaultValue into those without a value for
+// propName
+func backfillShard(ctx context.Context, idx *Index, shardName, propName string,
+	defaultValue interface{}, progress func(updated int64),
+) error {
+	tenant := ""
+	if idx.partitioningEnabled {
+		tenant = shardName
+	}
+
+	cursor := &filters.Cursor{Limit: backfillBatchSize}
+	for {
+		batch, _, err := idx.objectSearchByShard(ctx, backfillBatchSize, nil, nil, nil, cursor,
+			additional.Properties{}, []string{shardName}, nil)
+		if err != nil {
+			return fmt.Errorf("read objects: %w", err)
+		}
+
+		var updated int64
+		for _, obj := range batch {
+			if props, _ := obj.Object.Properties.(map[string]interface{}); props != nil {
+				if _, ok := props[propName]; ok {
+					continue
+				}
+			}
+			merge := objects.MergeDocument{
+				Class:                 idx.Config.ClassName.String(),
+				ID:                    obj.ID(),
+				PrimitiveSchema:       map[string]interface{}{propName: defaultValue},
+				UpdateTime:            time.Now().UnixMilli(),
+				OnlyMissingProperties: true,
+			}
+			if err := idx.mergeObject(ctx, merge, nil, tenant, 0); err != nil {
+				if errors.Is(err, errObjectNotFound) {
+					continue
+				}
+				return fmt.Errorf("update object %s: %w", obj.ID(), err)
+			}
+			updated++
+		}
+		progress(updated)
+
+		if len(batch) < backfillBatchSize {
+			return nil
+		}
+		cursor = &filters.Cursor{After: batch[len(batch)-1].ID().String(), Limit: backfillBatchSize}
+	}
+}
@@ -0,0 +1,103 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+
+package db
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/cluster/types"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/jobs"
+	"github.com/weaviate/weaviate/entities/storobj"
+	"github.com/weaviate/weaviate/usecases/objects"
+)
+
+func TestMigrator_BackfillProperty(t *testing.T) {
+	ctx := context.Background()
+	shd, idx := testShard(t, ctx, "TestClass")
+
+	objects := make([]*storobj.Object, 250)
+	for i := range objects {
+		objects[i] = testObject("TestClass")
+		props := map[string]interface{}{"name": "object"}
+		if i%5 == 0 {
+			props["count"] = float64(7)
+		}
+		objects[i].Object.Properties = props
+	}
+	for _, err := range shd.PutObjectBatch(ctx, objects) {
+		require.Nil(t, err)
+	}
+
+	logger, _ := test.NewNullLogger()
+	m := &Migrator{logger: logger, backfills: jobs.NewTracker[types.BackfillStatus]()}
+	job := types.BackfillStatus{ID: "job", Class: "TestClass", Property: "count", Status: types.BackfillRunning}
+	m.backfills.Add(job.ID, job)
+	err := m.backfillProperty(ctx, idx, job.ID, "count", float64(42))
+	m.backfills.Finish(job.ID, finishPropertyBackfill(err))
+	require.Nil(t, err)
+
+	status, ok := m.BackfillStatus(job.ID)
+	require.True(t, ok)
+	assert.Equal(t, types.BackfillFinished, status.Status)
+	assert.Equal(t, int64(200), status.Updated)
+
+	counts := map[float64]int{}
+	err = shd.Store().Bucket(helpers.ObjectsBucketLSM).IterateObjects(ctx, func(obj *storobj.Object) error {
+		props := obj.Object.Properties.(map[string]interface{})
+		assert.Equal(t, "object", props["name"])
+		counts[props["count"].(float64)]++
+		return nil
+	})
+	require.Nil(t, err)
+	assert.Equal(t, map[float64]int{7: 50, 42: 200}, counts)
+
+	_, ok = m.BackfillStatus("unknown")
+	assert.False(t, ok)
+}
+
+func TestShard_MergeOnlyMissingProperties(t *testing.T) {
+	ctx := context.Background()
+	shd, _ := testShard(t, ctx, "TestClass")
+
+	with, without := testObject("TestClass"), testObject("TestClass")
+	with.Object.Properties = map[string]interface{}{"count": float64(7)}
+	without.Object.Properties = map[string]interface{}{"name": "object"}
+	for _, err := range shd.PutObjectBatch(ctx, []*storobj.Object{with, without}) {
+		require.Nil(t, err)
+	}
+
+	for _, obj := range []*storobj.Object{with, without} {
+		err := shd.MergeObject(ctx, objects.MergeDocument{
+			Class:                 "TestClass",
+			ID:                    obj.ID(),
+			PrimitiveSchema:       map[string]interface{}{"count": float64(42)},
+			UpdateTime:            time.Now().UnixMilli(),
+			OnlyMissingProperties: true,
+		})
+		require.Nil(t, err)
+	}
+
+	for obj, expected := range map[*storobj.Object]float64{with: 7, without: 42} {
+		stored, err := shd.ObjectByID(ctx, obj.ID(), nil, additional.Properties{})
+		require.Nil(t, err)
+		assert.Equal(t, expected, stored.Properties().(map[string]interface{})["count"])
+	}
+}
@@ -0,0 +1,142 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/weaviate/sroar"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
+	"github.com/weaviate/weaviate/cluster/types"
+	"github.com/weaviate/weaviate/entities/schema"
+)
+
+// maxPropertyStatsDistinctValues limits the memory used to count the
+// distinct values of a property, higher counts are reported as this limit
+const maxPropertyStatsDistinctValues = 100_000
+
+// ComputePropertyStats scans the filterable index of propName in the loaded
+// local shards of className. Shards of inactive or not yet loaded tenants are
+// skipped rather than loaded just for the stats. Properties without a
+// filterable index are rejected, their values are only stored with the
+// objects.
+func (m *Migrator) ComputePropertyStats(ctx context.Context, className, propName string) (*types.PropertyStats, error) {
+	idx := m.db.GetIndex(schema.ClassName(className))
+	if idx == nil {
+		return nil, errors.Errorf("cannot compute property stats of non-existing index for %s", className)
+	}
+	prop, err := schema.GetPropertyByName(idx.getSchema.ReadOnlyClass(className), propName)
+	if err != nil {
+		return nil, err
+	}
+
+	var shardNames []string
+	idx.ForEachLoadedShard(func(name string, _ ShardLike) error {
+		shardNames = append(shardNames, name)
+		return nil
+	})
+
+	agg := newPropertyStatsAggregator(isTextDataType(prop.DataType))
+	for _, name := range shardNames {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		shard, release, err := idx.GetShard(ctx, name)
+		if err != nil {
+			return nil, fmt.Errorf("shard %q: %w", name, err)
+		}
+		if lazy, ok := shard.(*LazyLoadShard); shard == nil || ok && !lazy.isLoaded() {
+			// unloaded in the meantime
+			release()
+			continue
+		}
+		err = agg.addShard(shard, propName)
+		release()
+		if err != nil {
+			return nil, fmt.Errorf("shard %q: %w", name, err)
+		}
+	}
+	return agg.stats(), nil
+}
+
+// isTextDataType returns whether the keys of the filterable index of a
+// property with dataType are its tokens, rather than an encoding of its values
+func isTextDataType(dataType []string) bool {
+	dt, ok := schema.AsPrimitive(dataType)
+	return ok && (dt == schema.DataTypeText || dt == schema.DataTypeTextArray ||
+		dt == schema.DataTypeString || dt == schema.DataTypeStringArray)
+}
+
+// propertyStatsAggregator sums up the index of a property across shards.
+// Distinct values are counted exactly up to maxPropertyStatsDistinctValues,
+// which takes memory in the order of the size of the keys of the index.
+type propertyStatsAggregator struct {
+	total      int64
+	withValue  int64
+	valueCount int64
+	// valueLength is only summed up if valueLengths is set, the length of
+	// the keys is only meaningful for tokens
+	valueLengths bool
+	valueLength  int64
+	distinct     map[string]struct{}
+}
+
+func newPropertyStatsAggregator(valueLengths bool) *propertyStatsAggregator {
+	return &propertyStatsAggregator{valueLengths: valueLengths, distinct: map[string]struct{}{}}
+}
+
+func (a *propertyStatsAggregator) addShard(shard ShardLike, propName string) error {
+	bucket := shard.Store().Bucket(helpers.BucketFromPropNameLSM(propName))
+	if bucket == nil || bucket.Strategy() != lsmkv.StrategyRoaringSet {
+		return fmt.Errorf("property %q has no filterable index", propName)
+	}
+
+	// the union of all values is the set of objects with a value
+	withValue := sroar.NewBitmap()
+	c := bucket.CursorRoaringSet()
+	defer c.Close()
+	for k, v := c.First(); k != nil; k, v = c.Next() {
+		card := int64(v.GetCardinality())
+		if card == 0 {
+			continue
+		}
+		if len(a.distinct) < maxPropertyStatsDistinctValues {
+			a.distinct[string(k)] = struct{}{}
+		}
+		a.valueCount += card
+		if a.valueLengths {
+			a.valueLength += card * int64(len(k))
+		}
+		withValue.Or(v)
+	}
+
+	a.total += int64(shard.ObjectCount())
+	a.withValue += int64(withValue.GetCardinality())
+	return nil
+}
+
+func (a *propertyStatsAggregator) stats() *types.PropertyStats {
+	stats := &types.PropertyStats{
+		TotalCount:         a.total,
+		NullCount:          max(a.total-a.withValue, 0),
+		DistinctValueCount: int64(len(a.distinct)),
+		ComputedAt:         time.Now().UTC(),
+	}
+	if a.valueLengths && a.valueCount > 0 {
+		stats.AvgValueLength = float64(a.valueLength) / float64(a.valueCount)
+	}
+	return stats
+}
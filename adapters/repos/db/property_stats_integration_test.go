@@ -0,0 +1,75 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/storobj"
+	enthnsw "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+func TestPropertyStatsAggregator(t *testing.T) {
+	ctx := context.Background()
+	vFalse := false
+	class := &models.Class{
+		Class: "TestClass",
+		Properties: []*models.Property{
+			{Name: "color", DataType: []string{"text"}, Tokenization: models.PropertyTokenizationField},
+			{Name: "note", DataType: []string{"text"}, IndexFilterable: &vFalse},
+		},
+		InvertedIndexConfig: &models.InvertedIndexConfig{},
+	}
+	shd, _ := testShardWithSettings(t, ctx, class, enthnsw.UserConfig{Skip: true}, false, false)
+
+	// 10 objects without color, 20 red and 30 yellow
+	objects := make([]*storobj.Object, 60)
+	for i := range objects {
+		objects[i] = testObject("TestClass")
+		switch {
+		case i < 10:
+			objects[i].Object.Properties = map[string]interface{}{"note": "none"}
+		case i < 30:
+			objects[i].Object.Properties = map[string]interface{}{"color": "red"}
+		default:
+			objects[i].Object.Properties = map[string]interface{}{"color": "yellow"}
+		}
+	}
+	for _, err := range shd.PutObjectBatch(ctx, objects) {
+		require.Nil(t, err)
+	}
+
+	agg := newPropertyStatsAggregator(true)
+	require.Nil(t, agg.addShard(shd, "color"))
+	stats := agg.stats()
+	assert.Equal(t, int64(60), stats.TotalCount)
+	assert.Equal(t, int64(10), stats.NullCount)
+	assert.Equal(t, int64(2), stats.DistinctValueCount)
+	assert.InDelta(t, float64(20*3+30*6)/50, stats.AvgValueLength, 0.0001)
+	assert.False(t, stats.ComputedAt.IsZero())
+
+	// the length of keys which aren't tokens isn't meaningful
+	agg = newPropertyStatsAggregator(false)
+	require.Nil(t, agg.addShard(shd, "color"))
+	stats = agg.stats()
+	assert.Equal(t, int64(2), stats.DistinctValueCount)
+	assert.Zero(t, stats.AvgValueLength)
+
+	agg = newPropertyStatsAggregator(true)
+	assert.ErrorContains(t, agg.addShard(shd, "note"), "no filterable index")
+}
@@ -0,0 +1,118 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/dto"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	enthnsw "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+	"github.com/weaviate/weaviate/usecases/auth/authorization/mocks"
+	"github.com/weaviate/weaviate/usecases/config"
+	"github.com/weaviate/weaviate/usecases/memwatch"
+	"github.com/weaviate/weaviate/usecases/modules"
+	"github.com/weaviate/weaviate/usecases/traverser"
+)
+
+type noopLocks struct{}
+
+func (noopLocks) LockConnector() (func() error, error) { return func() error { return nil }, nil }
+func (noopLocks) LockSchema() (func() error, error)    { return func() error { return nil }, nil }
+
+func TestQueryTimeout(t *testing.T) {
+	dirName := t.TempDir()
+	vTrue := true
+	logger, _ := test.NewNullLogger()
+	class := &models.Class{
+		Class:               "QueryTimeout",
+		VectorIndexConfig:   enthnsw.NewDefaultUserConfig(),
+		InvertedIndexConfig: invertedConfig(),
+		Properties: []*models.Property{{
+			Name:              "number",
+			DataType:          schema.DataTypeInt.PropString(),
+			IndexRangeFilters: &vTrue,
+		}},
+	}
+	schemaGetter := &fakeSchemaGetter{
+		schema:     schema.Schema{Objects: &models.Schema{}},
+		shardState: singleShardState(),
+	}
+	repo, err := New(logger, Config{
+		MemtablesFlushDirtyAfter:  60,
+		RootPath:                  dirName,
+		QueryMaximumResults:       10000,
+		MaxImportGoroutinesFactor: 1,
+	}, &fakeRemoteClient{}, &fakeNodeResolver{}, &fakeRemoteNodeClient{}, &fakeReplicationClient{}, nil, memwatch.NewDummyMonitor())
+	require.Nil(t, err)
+	repo.SetSchemaGetter(schemaGetter)
+	require.Nil(t, repo.WaitForStartup(testCtx()))
+	defer repo.Shutdown(context.Background())
+	migrator := NewMigrator(repo, logger)
+	require.Nil(t, migrator.AddClass(context.Background(), class, schemaGetter.shardState))
+	schemaGetter.schema.Objects.Classes = []*models.Class{class}
+
+	for i := 0; i < 100; i++ {
+		obj := &models.Object{
+			Class:      class.Class,
+			ID:         strfmt.UUID(uuid.NewString()),
+			Properties: map[string]interface{}{"number": int64(i)},
+		}
+		require.Nil(t, repo.PutObject(context.Background(), obj, []float32{1, 2, 3}, nil, nil, 0))
+	}
+
+	prov := modules.NewProvider(logger)
+	prov.SetSchemaGetter(schemaGetter)
+	explorer := traverser.NewExplorer(repo, logger, prov, nil, config.Config{QueryMaximumResults: 10000})
+	explorer.SetSchemaGetter(schemaGetter)
+	tr := traverser.NewTraverser(&config.WeaviateConfig{}, noopLocks{}, logger, mocks.NewMockAuthorizer(),
+		repo, explorer, schemaGetter, nil, nil, -1)
+	params := dto.GetParams{
+		ClassName:  class.Class,
+		Pagination: &filters.Pagination{Limit: 1000},
+		Filters: &filters.LocalFilter{Root: &filters.Clause{
+			Operator: filters.OperatorGreaterThanEqual,
+			On:       &filters.Path{Class: schema.ClassName(class.Class), Property: "number"},
+			Value:    &filters.Value{Value: 0, Type: schema.DataTypeInt},
+		}},
+	}
+
+	t.Run("without timeout", func(t *testing.T) {
+		res, err := tr.GetClass(context.Background(), nil, params)
+		require.Nil(t, err)
+		assert.Len(t, res, 100)
+	})
+
+	t.Run("exceeding the class timeout", func(t *testing.T) {
+		// the schema handler only accepts timeouts of at least a second, a
+		// shorter one makes every query exceed it
+		timeout := time.Nanosecond
+		class.QueryTimeout = &timeout
+		defer func() { class.QueryTimeout = nil }()
+
+		_, err := tr.GetClass(context.Background(), nil, params)
+		require.NotNil(t, err)
+		assert.ErrorIs(t, err, context.DeadlineExceeded, fmt.Sprintf("got %v", err))
+	})
+}
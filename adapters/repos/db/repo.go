@@ -290,6 +290,30 @@ func (db *DB) DeleteIndex(className schema.ClassName) error {
 	return nil
 }
 
+// UnloadIndex shuts the index of className down without deleting its files
+func (db *DB) UnloadIndex(ctx context.Context, className schema.ClassName) error {
+	index := db.GetIndex(className)
+	if index == nil {
+		return nil
+	}
+
+	db.indexLock.Lock()
+	defer db.indexLock.Unlock()
+
+	index.dropIndex.Lock()
+	defer index.dropIndex.Unlock()
+	if err := index.Shutdown(ctx); err != nil && !errors.Is(err, errAlreadyShutdown) {
+		return fmt.Errorf("shutdown index %q: %w", className, err)
+	}
+
+	delete(db.indices, indexID(className))
+
+	if err := db.promMetrics.DeleteClass(className.String()); err != nil {
+		db.logger.Error("can't delete prometheus metrics", err)
+	}
+	return nil
+}
+
 func (db *DB) Shutdown(ctx context.Context) error {
 	db.shutdown <- struct{}{}
 
@@ -72,6 +72,9 @@ type ShardLike interface {
 	UpdateStatus(status string) error                                                   // Set shard status
 	SetStatusReadonly(reason string) error                                              // Set shard status to readonly with reason
 	FindUUIDs(ctx context.Context, filters *filters.LocalFilter) ([]strfmt.UUID, error) // Search and return document ids
+	// Delete up to limit objects matching filters, except the excluded ones, looking up chunkSize uuids at a time
+	DeleteObjectsInChunks(ctx context.Context, filters *filters.LocalFilter, exclude []strfmt.UUID, limit int64,
+		chunkSize int, deletionTime time.Time, dryRun bool) (int64, int64, objects.BatchSimpleObjects, error)
 
 	Counter() *indexcounter.Counter
 	ObjectCount() int
@@ -88,6 +91,8 @@ type ShardLike interface {
 	UpdateVectorIndexConfig(ctx context.Context, updated schemaConfig.VectorIndexConfig) error
 	UpdateVectorIndexConfigs(ctx context.Context, updated map[string]schemaConfig.VectorIndexConfig) error
 	UpdateAsyncReplication(ctx context.Context, enabled bool) error
+	SetCompactionConfig(cfg lsmkv.CompactionConfig)
+	CompactionStats() lsmkv.CompactionStats
 	AddReferencesBatch(ctx context.Context, refs objects.BatchReferences) []error
 	DeleteObjectBatch(ctx context.Context, ids []strfmt.UUID, deletionTime time.Time, dryRun bool) objects.BatchSimpleObjects // Delete many objects by id
 	DeleteObject(ctx context.Context, id strfmt.UUID, deletionTime time.Time) error                                           // Delete object by id
@@ -111,6 +116,7 @@ type ShardLike interface {
 	FillQueue(targetVector string, from uint64) error
 	Shutdown(context.Context) error // Shutdown the shard
 	preventShutdown() (release func(), err error)
+	requestsInFlight() int64
 
 	// TODO tests only
 	ObjectList(ctx context.Context, limit int, sort []filters.Sort, cursor *filters.Cursor,
@@ -447,6 +453,19 @@ func (s *Shard) initHashTree(ctx context.Context) error {
 	return nil
 }
 
+func (s *Shard) SetCompactionConfig(cfg lsmkv.CompactionConfig) {
+	if store := s.Store(); store != nil {
+		store.SetCompactionConfig(cfg)
+	}
+}
+
+func (s *Shard) CompactionStats() lsmkv.CompactionStats {
+	if store := s.Store(); store != nil {
+		return store.CompactionStats()
+	}
+	return lsmkv.CompactionStats{}
+}
+
 func (s *Shard) UpdateAsyncReplication(ctx context.Context, enabled bool) error {
 	s.hashtreeRWMux.Lock()
 	defer s.hashtreeRWMux.Unlock()
@@ -672,6 +691,20 @@ func (s *Shard) ObjectCount() int {
 	return b.Count()
 }
 
+// shardObjectCountCache is implemented by schema getters which cache the
+// object counts of shards, like the schema manager
+type shardObjectCountCache interface {
+	InvalidateShardObjectCounts(class string, shards ...string)
+}
+
+// invalidateObjectCount drops the cached object count of the shard once an
+// object was added or deleted
+func (s *Shard) invalidateObjectCount() {
+	if cache, ok := s.index.getSchema.(shardObjectCountCache); ok {
+		cache.InvalidateShardObjectCounts(s.index.Config.ClassName.String(), s.name)
+	}
+}
+
 // ObjectCountAsync returns the eventually consistent "async" count which is
 // much cheaper to obtain
 func (s *Shard) ObjectCountAsync() int {
@@ -128,6 +128,7 @@ func (s *Shard) initLSMStore() error {
 		return fmt.Errorf("init lsmkv store at %s: %w", s.pathLSM(), err)
 	}
 
+	store.SetCompactionConfig(s.index.getCompactionConfig())
 	s.store = store
 
 	return nil
@@ -181,6 +181,15 @@ func (l *LazyLoadShard) FindUUIDs(ctx context.Context, filters *filters.LocalFil
 	return l.shard.FindUUIDs(ctx, filters)
 }
 
+func (l *LazyLoadShard) DeleteObjectsInChunks(ctx context.Context, filters *filters.LocalFilter, exclude []strfmt.UUID,
+	limit int64, chunkSize int, deletionTime time.Time, dryRun bool,
+) (int64, int64, objects.BatchSimpleObjects, error) {
+	if err := l.Load(ctx); err != nil {
+		return 0, 0, nil, err
+	}
+	return l.shard.DeleteObjectsInChunks(ctx, filters, exclude, limit, chunkSize, deletionTime, dryRun)
+}
+
 func (l *LazyLoadShard) Counter() *indexcounter.Counter {
 	l.mustLoad()
 	return l.shard.Counter()
@@ -276,6 +285,27 @@ func (l *LazyLoadShard) UpdateAsyncReplication(ctx context.Context, enabled bool
 	return l.shard.UpdateAsyncReplication(ctx, enabled)
 }
 
+// SetCompactionConfig only applies to a loaded shard, the shard reads the
+// config of its index when it is loaded
+func (l *LazyLoadShard) SetCompactionConfig(cfg lsmkv.CompactionConfig) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	if l.loaded {
+		l.shard.SetCompactionConfig(cfg)
+	}
+}
+
+func (l *LazyLoadShard) CompactionStats() lsmkv.CompactionStats {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	if !l.loaded {
+		return lsmkv.CompactionStats{}
+	}
+	return l.shard.CompactionStats()
+}
+
 func (l *LazyLoadShard) AddReferencesBatch(ctx context.Context, refs objects.BatchReferences) []error {
 	if err := l.Load(ctx); err != nil {
 		return []error{err}
@@ -478,6 +508,13 @@ func (l *LazyLoadShard) preventShutdown() (release func(), err error) {
 	return l.shard.preventShutdown()
 }
 
+func (l *LazyLoadShard) requestsInFlight() int64 {
+	if !l.isLoaded() {
+		return 0
+	}
+	return l.shard.requestsInFlight()
+}
+
 func (l *LazyLoadShard) HashTreeLevel(ctx context.Context, level int, discriminant *hashtree.Bitset) (digests []hashtree.Digest, err error) {
 	if !l.isLoaded() {
 		return []hashtree.Digest{}, nil
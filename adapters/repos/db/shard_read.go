@@ -683,6 +683,7 @@ func (s *Shard) batchDeleteObject(ctx context.Context, id strfmt.UUID, deletionT
 	if err != nil {
 		return errors.Wrap(err, "delete object from bucket")
 	}
+	s.invalidateObjectCount()
 
 	err = s.cleanupInvertedIndexOnDelete(existing, docID)
 	if err != nil {
@@ -153,6 +153,12 @@ func (s *Shard) preventShutdown() (release func(), err error) {
 	return func() { s.inUseCounter.Add(-1) }, nil
 }
 
+// requestsInFlight returns the number of requests preventing the shutdown of
+// the shard at the moment
+func (s *Shard) requestsInFlight() int64 {
+	return s.inUseCounter.Load()
+}
+
 func (s *Shard) waitForShutdown(ctx context.Context) error {
 	checkInterval := 50 * time.Millisecond
 	timeout := 30 * time.Second
@@ -20,10 +20,13 @@ import (
 	enterrors "github.com/weaviate/weaviate/entities/errors"
 
 	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
 	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
 	"github.com/weaviate/weaviate/adapters/repos/db/inverted"
 	"github.com/weaviate/weaviate/entities/additional"
 	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/storobj"
 	"github.com/weaviate/weaviate/usecases/objects"
 )
 
@@ -185,3 +188,90 @@ func (s *Shard) FindUUIDs(ctx context.Context, filters *filters.LocalFilter) ([]
 	}
 	return uuids[:currIdx], nil
 }
+
+// DeleteObjectsInChunks deletes up to limit objects matching filters, except
+// the ones with an excluded uuid. Only the doc ids of the matches are kept in
+// memory, their uuids are looked up and deleted chunkSize at a time. It
+// returns the number of matches, which may be larger than limit, how many of
+// them were excluded and the results of the deletions.
+func (s *Shard) DeleteObjectsInChunks(ctx context.Context, filters *filters.LocalFilter, exclude []strfmt.UUID,
+	limit int64, chunkSize int, deletionTime time.Time, dryRun bool,
+) (int64, int64, objects.BatchSimpleObjects, error) {
+	if chunkSize <= 0 {
+		return 0, 0, nil, fmt.Errorf("invalid chunk size %d", chunkSize)
+	}
+	docs, err := s.findDocIDs(ctx, filters)
+	if err != nil {
+		return 0, 0, nil, err
+	}
+	matches := int64(len(docs))
+	docs, err = s.excludeDocIDs(docs, exclude)
+	if err != nil {
+		return 0, 0, nil, err
+	}
+	excluded := matches - int64(len(docs))
+	if limit < int64(len(docs)) {
+		docs = docs[:max(limit, 0)]
+	}
+
+	var out objects.BatchSimpleObjects
+	uuids := make([]strfmt.UUID, 0, min(chunkSize, len(docs)))
+	for start := 0; start < len(docs); start += chunkSize {
+		if err := ctx.Err(); err != nil {
+			return matches, excluded, out, err
+		}
+		uuids = uuids[:0]
+		for _, doc := range docs[start:min(start+chunkSize, len(docs))] {
+			uuid, err := s.uuidFromDocID(doc)
+			if err != nil {
+				// see FindUUIDs, most likely the object has been deleted already
+				s.index.logger.WithField("op", "shard.delete_objects_in_chunks").WithField("docID", doc).
+					WithError(err).Debug("failed to find UUID for docID")
+				continue
+			}
+			uuids = append(uuids, uuid)
+		}
+		out = append(out, s.DeleteObjectBatch(ctx, uuids, deletionTime, dryRun)...)
+	}
+	return matches, excluded, out, nil
+}
+
+// excludeDocIDs removes the doc ids of the objects with the excluded uuids
+// from docs. Excluded uuids without an object are ignored.
+func (s *Shard) excludeDocIDs(docs []uint64, exclude []strfmt.UUID) ([]uint64, error) {
+	if len(exclude) == 0 {
+		return docs, nil
+	}
+	bucket := s.store.Bucket(helpers.ObjectsBucketLSM)
+	if bucket == nil {
+		return nil, errors.Errorf("objects bucket not found")
+	}
+
+	excluded := make(map[uint64]struct{}, len(exclude))
+	for _, id := range exclude {
+		idBytes, err := uuid.MustParse(id.String()).MarshalBinary()
+		if err != nil {
+			return nil, err
+		}
+		obj, err := bucket.Get(idBytes)
+		if err != nil {
+			return nil, fmt.Errorf("get object %s: %w", id, err)
+		}
+		if obj == nil {
+			continue
+		}
+		docID, err := storobj.DocIDFromBinary(obj)
+		if err != nil {
+			return nil, fmt.Errorf("get doc id of object %s: %w", id, err)
+		}
+		excluded[docID] = struct{}{}
+	}
+
+	kept := docs[:0]
+	for _, doc := range docs {
+		if _, ok := excluded[doc]; !ok {
+			kept = append(kept, doc)
+		}
+	}
+	return kept, nil
+}
@@ -61,6 +61,7 @@ func (s *Shard) DeleteObject(ctx context.Context, id strfmt.UUID, deletionTime t
 	if err != nil {
 		return fmt.Errorf("delete object from bucket: %w", err)
 	}
+	s.invalidateObjectCount()
 
 	err = s.cleanupInvertedIndexOnDelete(existing, docID)
 	if err != nil {
@@ -137,6 +138,7 @@ func (s *Shard) deleteOne(ctx context.Context, bucket *lsmkv.Bucket, obj, idByte
 	if err != nil {
 		return fmt.Errorf("delete object from bucket: %w", err)
 	}
+	s.invalidateObjectCount()
 
 	err = s.cleanupInvertedIndexOnDelete(obj, docID)
 	if err != nil {
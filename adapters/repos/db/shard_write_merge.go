@@ -125,6 +125,10 @@ func (s *Shard) mergeObjectInStorage(merge objects.MergeDocument,
 		if prevObj == nil {
 			return errObjectNotFound
 		}
+		if skipMerge(prevObj, merge) {
+			obj, status.skipUpsert = prevObj, true
+			return nil
+		}
 
 		obj, _, err = s.mergeObjectData(prevObj, merge)
 		if err != nil {
@@ -256,6 +260,23 @@ func (s *Shard) mergeObjectData(prevObj *storobj.Object,
 	return mergeProps(prevObj, merge), prevObj, nil
 }
 
+// skipMerge returns whether the conditions of merge leave previous as it is
+func skipMerge(previous *storobj.Object, merge objects.MergeDocument) bool {
+	if merge.ExpectedUpdateTime != 0 && previous.LastUpdateTimeUnix() != merge.ExpectedUpdateTime {
+		return true
+	}
+	if !merge.OnlyMissingProperties {
+		return false
+	}
+	properties, _ := previous.Properties().(map[string]interface{})
+	for propName := range merge.PrimitiveSchema {
+		if _, ok := properties[propName]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
 func mergeProps(previous *storobj.Object,
 	merge objects.MergeDocument,
 ) *storobj.Object {
@@ -271,6 +292,9 @@ func mergeProps(previous *storobj.Object,
 	}
 
 	for propName, value := range merge.PrimitiveSchema {
+		if _, ok := properties[propName]; ok && merge.OnlyMissingProperties {
+			continue
+		}
 		// for primitive props, we simply need to overwrite
 		properties[propName] = value
 	}
@@ -289,6 +289,9 @@ func (s *Shard) putObjectLSM(obj *storobj.Object, idBytes []byte,
 	} else if status.skipUpsert {
 		return status, nil
 	}
+	if prevObj == nil {
+		s.invalidateObjectCount()
+	}
 
 	before = time.Now()
 	if err := s.updateInvertedIndexLSM(obj, status, prevObj); err != nil {
@@ -0,0 +1,87 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+
+package db
+
+import (
+	"context"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/models"
+	enthnsw "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+// testPrewarmShard returns a migrator and a shard of className holding count
+// random vectors
+func testPrewarmShard(t testing.TB, ctx context.Context, className string, count int) (*Migrator, *Index, *models.Class, string) {
+	class := &models.Class{Class: className}
+	shard, idx := testShardWithSettings(t, ctx, class, enthnsw.NewDefaultUserConfig(), false, false)
+	objects := createRandomObjects(rand.New(rand.NewSource(7)), className, count, 32)
+	for start := 0; start < count; start += 10_000 {
+		for _, err := range shard.PutObjectBatch(ctx, objects[start:min(start+10_000, count)]) {
+			require.Nil(t, err)
+		}
+	}
+	m := &Migrator{db: &DB{indices: map[string]*Index{indexID(idx.Config.ClassName): idx}}}
+	return m, idx, class, shard.Name()
+}
+
+// unloadTestShard replaces the shard with a lazy shard which isn't loaded, as
+// the shard of a tenant is after its activation
+func unloadTestShard(t testing.TB, ctx context.Context, idx *Index, class *models.Class, name string) {
+	shard, _ := idx.shards.LoadAndDelete(name)
+	require.Nil(t, shard.Shutdown(ctx))
+	lazy, err := idx.initShard(ctx, name, class, nil, false)
+	require.Nil(t, err)
+	idx.shards.Store(name, lazy)
+}
+
+func TestMigrator_PrewarmTenantIndex(t *testing.T) {
+	ctx := context.Background()
+	m, idx, class, name := testPrewarmShard(t, ctx, "PrewarmClass", 100)
+	unloadTestShard(t, ctx, idx, class, name)
+	require.False(t, idx.shards.Load(name).(*LazyLoadShard).isLoaded())
+
+	require.Nil(t, m.PrewarmTenantIndex(ctx, class.Class, name, []string{m.nodeId}))
+	assert.True(t, idx.shards.Load(name).(*LazyLoadShard).isLoaded())
+	// prewarming a loaded tenant does nothing
+	require.Nil(t, m.PrewarmTenantIndex(ctx, class.Class, name, []string{m.nodeId}))
+}
+
+func BenchmarkFirstQueryAfterActivation(b *testing.B) {
+	ctx := context.Background()
+	m, idx, class, name := testPrewarmShard(b, ctx, "PrewarmBenchmark", 100_000)
+	query := [][]float32{make([]float32, 32)}
+
+	for _, prewarm := range []bool{false, true} {
+		b.Run(map[bool]string{false: "cold", true: "prewarmed"}[prewarm], func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				b.StopTimer()
+				unloadTestShard(b, ctx, idx, class, name)
+				if prewarm {
+					require.Nil(b, m.PrewarmTenantIndex(ctx, class.Class, name, []string{m.nodeId}))
+				}
+				b.StartTimer()
+
+				found, _, err := idx.shards.Load(name).ObjectVectorSearch(ctx, query, []string{""}, 0, 10,
+					nil, nil, nil, additional.Properties{}, nil, nil)
+				require.Nil(b, err)
+				require.Len(b, found, 10)
+			}
+		})
+	}
+}
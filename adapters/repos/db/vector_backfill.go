@@ -0,0 +1,289 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/cluster/types"
+	"github.com/weaviate/weaviate/entities/additional"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/modulecapabilities"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/search"
+	"github.com/weaviate/weaviate/entities/storobj"
+	"github.com/weaviate/weaviate/usecases/objects"
+)
+
+// batchVectorizer vectorizes objects like a batch import, it is implemented
+// by the modules provider
+type batchVectorizer interface {
+	BatchUpdateVector(ctx context.Context, class *models.Class, objects []*models.Object,
+		findObjectFn modulecapabilities.FindObjectFn, logger logrus.FieldLogger) (map[int]error, error)
+}
+
+// SetVectorizer sets the vectorizer BackfillVectors vectorizes objects with
+func (m *Migrator) SetVectorizer(v batchVectorizer) {
+	m.vectorizer = v
+}
+
+func finishVectorBackfill(err error) func(job *types.VectorBackfillStatus) {
+	return func(job *types.VectorBackfillStatus) {
+		job.FinishedAt = time.Now().UTC()
+		job.Status = types.BackfillFinished
+		if err != nil {
+			job.Status = types.BackfillFailed
+			job.Error = err.Error()
+		}
+	}
+}
+
+// BackfillVectors vectorizes the objects of className again with the
+// vectorizers of the class. The shards are read from the nodes holding them
+// in batches of opts.BatchSize, opts.ConcurrentWorkers batches are vectorized
+// at a time. Only the vectors are merged into every replica of an object, and
+// only if the object wasn't updated in the meantime. Objects the vectorizer
+// fails for keep their previous vectors. Tenants which aren't active are
+// skipped.
+func (m *Migrator) BackfillVectors(ctx context.Context, className string,
+	opts types.BackfillVectorOptions,
+) (string, error) {
+	if m.vectorizer == nil {
+		return "", errors.Errorf("cannot backfill vectors of %s without vectorizer modules", className)
+	}
+	idx := m.db.GetIndex(schema.ClassName(className))
+	if idx == nil {
+		return "", errors.Errorf("cannot backfill vectors of non-existing index for %s", className)
+	}
+	class := m.db.schemaGetter.ReadOnlyClass(className)
+	if class == nil {
+		return "", errors.Errorf("cannot backfill vectors of non-existing class %s", className)
+	}
+	targets := vectorBackfillTargets(class, opts.TargetVector)
+	if len(targets) == 0 {
+		return "", errors.Errorf("class %s has no vectorizer for target vector %q", className, opts.TargetVector)
+	}
+
+	job := types.VectorBackfillStatus{
+		ID:           uuid.NewString(),
+		Class:        className,
+		TargetVector: opts.TargetVector,
+		Status:       types.BackfillRunning,
+		StartedAt:    time.Now().UTC(),
+	}
+	m.vectorBackfills.Add(job.ID, job)
+	enterrors.GoWrapper(func() {
+		err := m.backfillVectors(context.Background(), idx, class, job.ID, targets, opts)
+		if err != nil {
+			m.logger.WithField("action", "backfill_vectors").
+				WithField("class", className).
+				WithField("target_vector", opts.TargetVector).
+				WithField("job", job.ID).
+				WithError(err).Error("backfilling vectors failed")
+		}
+		m.vectorBackfills.Finish(job.ID, finishVectorBackfill(err))
+	}, m.logger)
+
+	return job.ID, nil
+}
+
+// VectorBackfillStatus returns the vector backfill job jobID started on this
+// node
+func (m *Migrator) VectorBackfillStatus(jobID string) (types.VectorBackfillStatus, bool) {
+	return m.vectorBackfills.Get(jobID)
+}
+
+// vectorBackfillTargets returns the vectors of class to backfill, "" for the
+// legacy vector. Vectors without a vectorizer are never backfilled, their
+// vectors would be removed.
+func vectorBackfillTargets(class *models.Class, targetVector string) []string {
+	targets := schema.VectorizedTargets(class)
+	if targetVector == "" {
+		return targets
+	}
+	if slices.Contains(targets, targetVector) {
+		return []string{targetVector}
+	}
+	return nil
+}
+
+func (m *Migrator) backfillVectors(ctx context.Context, idx *Index, class *models.Class, jobID string,
+	targets []string, opts types.BackfillVectorOptions,
+) error {
+	state := idx.shardState()
+	var shardNames []string
+	for _, name := range state.AllPhysicalShards() {
+		if idx.partitioningEnabled {
+			physical := state.Physical[name]
+			if status := physical.ActivityStatus(); status != models.TenantActivityStatusHOT {
+				m.logger.WithField("action", "backfill_vectors").
+					WithField("class", class.Class).
+					WithField("tenant", name).
+					WithField("job", jobID).
+					Warnf("skipping backfill of %s tenant", status)
+				continue
+			}
+		}
+		shardNames = append(shardNames, name)
+	}
+	m.vectorBackfills.Update(jobID, func(job *types.VectorBackfillStatus) { job.Shards = len(shardNames) })
+
+	for _, name := range shardNames {
+		if err := m.backfillShardVectors(ctx, idx, class, name, jobID, targets, opts); err != nil {
+			return fmt.Errorf("shard %q: %w", name, err)
+		}
+		m.vectorBackfills.Update(jobID, func(job *types.VectorBackfillStatus) { job.ShardsDone++ })
+	}
+	return nil
+}
+
+func (m *Migrator) backfillShardVectors(ctx context.Context, idx *Index, class *models.Class, shardName string,
+	jobID string, targets []string, opts types.BackfillVectorOptions,
+) error {
+	eg, ctx := enterrors.NewErrorGroupWithContextWrapper(m.logger, ctx)
+	eg.SetLimit(opts.ConcurrentWorkers)
+
+	// all vectors are read, as the named vectors of an object are merged
+	// at once
+	addl := additional.Properties{Vector: true}
+	for name := range class.VectorConfig {
+		addl.Vectors = append(addl.Vectors, name)
+	}
+
+	cursor := &filters.Cursor{Limit: opts.BatchSize}
+	var readErr error
+	for ctx.Err() == nil {
+		read, _, err := idx.objectSearchByShard(ctx, opts.BatchSize, nil, nil, nil, cursor,
+			addl, []string{shardName}, nil)
+		if err != nil {
+			readErr = fmt.Errorf("read objects: %w", err)
+			break
+		}
+		m.vectorBackfills.Update(jobID, func(job *types.VectorBackfillStatus) { job.Scanned += int64(len(read)) })
+
+		batch := make([]*storobj.Object, 0, len(read))
+		for _, obj := range read {
+			if !opts.OnlyNilVectors || missesVector(obj, targets) {
+				batch = append(batch, obj)
+			}
+		}
+		if len(batch) > 0 {
+			eg.Go(func() error {
+				return m.vectorizeBackfillBatch(ctx, idx, class, shardName, jobID, targets, batch)
+			})
+		}
+		if len(read) < opts.BatchSize {
+			break
+		}
+		cursor = &filters.Cursor{After: read[len(read)-1].ID().String(), Limit: opts.BatchSize}
+	}
+	if err := eg.Wait(); err != nil {
+		return err
+	}
+	return readErr
+}
+
+// vectorizeBackfillBatch vectorizes batch without the vectors of targets and
+// merges the vectors of the objects the vectorizer succeeded for
+func (m *Migrator) vectorizeBackfillBatch(ctx context.Context, idx *Index, class *models.Class,
+	shardName, jobID string, targets []string, batch []*storobj.Object,
+) error {
+	tenant := ""
+	if idx.partitioningEnabled {
+		tenant = shardName
+	}
+
+	objs := make([]*models.Object, len(batch))
+	for i, obj := range batch {
+		object := obj.Object
+		object.Vector = obj.Vector
+		object.Vectors = make(models.Vectors, len(obj.Vectors))
+		for name, vector := range obj.Vectors {
+			object.Vectors[name] = vector
+		}
+		for _, target := range targets {
+			if target == "" {
+				object.Vector = nil
+			} else {
+				delete(object.Vectors, target)
+			}
+		}
+		object.Tenant = tenant
+		objs[i] = &object
+	}
+
+	vecErrs, err := m.vectorizer.BatchUpdateVector(ctx, class, objs, m.findObject, m.logger)
+	if err != nil {
+		return fmt.Errorf("vectorize objects: %w", err)
+	}
+
+	var updated, failed int64
+	for i, object := range objs {
+		if err := vecErrs[i]; err != nil {
+			failed++
+			m.logger.WithField("action", "backfill_vectors").
+				WithField("class", class.Class).
+				WithField("id", object.ID).
+				WithField("job", jobID).
+				WithError(err).Debug("vectorizing object failed")
+			continue
+		}
+		merge := objects.MergeDocument{
+			Class:              class.Class,
+			ID:                 object.ID,
+			Vector:             object.Vector,
+			Vectors:            object.Vectors,
+			UpdateTime:         time.Now().UnixMilli(),
+			ExpectedUpdateTime: batch[i].LastUpdateTimeUnix(),
+		}
+		if err := idx.mergeObject(ctx, merge, nil, tenant, 0); err != nil {
+			if errors.Is(err, errObjectNotFound) {
+				continue
+			}
+			return fmt.Errorf("update object %s: %w", object.ID, err)
+		}
+		updated++
+	}
+
+	m.vectorBackfills.Update(jobID, func(job *types.VectorBackfillStatus) {
+		job.Updated += updated
+		job.Failed += failed
+	})
+	return nil
+}
+
+// findObject lets reference vectorizers read the objects referenced by the
+// objects they vectorize
+func (m *Migrator) findObject(ctx context.Context, class string, id strfmt.UUID,
+	props search.SelectProperties, addl additional.Properties, tenant string,
+) (*search.Result, error) {
+	return m.db.Object(ctx, class, id, props, addl, nil, tenant)
+}
+
+// missesVector returns whether obj has no vector for one of targets
+func missesVector(obj *storobj.Object, targets []string) bool {
+	for _, target := range targets {
+		if target == "" && len(obj.Vector) == 0 || target != "" && len(obj.Vectors[target]) == 0 {
+			return true
+		}
+	}
+	return false
+}
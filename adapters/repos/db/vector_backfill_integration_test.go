@@ -0,0 +1,170 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/cluster/types"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/jobs"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/modulecapabilities"
+	"github.com/weaviate/weaviate/entities/storobj"
+)
+
+// fakeBatchVectorizer sets the vector {9, 9, 9} on objects without one and
+// fails for objects with the property fail. It calls vectorized, if set,
+// before returning.
+type fakeBatchVectorizer struct {
+	vectorized func(objects []*models.Object)
+}
+
+func (f fakeBatchVectorizer) BatchUpdateVector(ctx context.Context, class *models.Class, objects []*models.Object,
+	findObjectFn modulecapabilities.FindObjectFn, logger logrus.FieldLogger,
+) (map[int]error, error) {
+	errs := map[int]error{}
+	for i, obj := range objects {
+		if props, _ := obj.Properties.(map[string]interface{}); props["fail"] == true {
+			errs[i] = errors.New("vectorizer failed")
+			continue
+		}
+		if obj.Vector == nil {
+			obj.Vector = []float32{9, 9, 9}
+		}
+	}
+	if f.vectorized != nil {
+		f.vectorized(objects)
+	}
+	return errs, nil
+}
+
+func TestMigrator_BackfillVectors(t *testing.T) {
+	ctx := context.Background()
+	class := &models.Class{Class: "TestClass", Vectorizer: "text2vec-fake"}
+	newShard := func(t *testing.T) (ShardLike, *Index) {
+		shd, idx := testShard(t, ctx, "TestClass")
+		objects := make([]*storobj.Object, 250)
+		for i := range objects {
+			objects[i] = testObject("TestClass")
+			objects[i].Object.LastUpdateTimeUnix = 1000
+			props := map[string]interface{}{"name": "object"}
+			if i%5 == 0 {
+				objects[i].Vector = nil
+			}
+			if i%10 == 1 {
+				props["fail"] = true
+			}
+			objects[i].Object.Properties = props
+		}
+		for _, err := range shd.PutObjectBatch(ctx, objects) {
+			require.Nil(t, err)
+		}
+		return shd, idx
+	}
+	vectors := func(t *testing.T, shd ShardLike) map[float32]int {
+		counts := map[float32]int{}
+		err := shd.Store().Bucket(helpers.ObjectsBucketLSM).IterateObjects(ctx, func(obj *storobj.Object) error {
+			require.Len(t, obj.Vector, 3)
+			counts[obj.Vector[0]]++
+			return nil
+		})
+		require.Nil(t, err)
+		return counts
+	}
+
+	t.Run("all vectors", func(t *testing.T) {
+		shd, idx := newShard(t)
+		logger, _ := test.NewNullLogger()
+		m := &Migrator{
+			logger: logger, vectorBackfills: jobs.NewTracker[types.VectorBackfillStatus](),
+			vectorizer: fakeBatchVectorizer{},
+		}
+		job := types.VectorBackfillStatus{ID: "job", Class: "TestClass", Status: types.BackfillRunning}
+		m.vectorBackfills.Add(job.ID, job)
+		err := m.backfillVectors(ctx, idx, class, job.ID, []string{""},
+			types.BackfillVectorOptions{BatchSize: 40, ConcurrentWorkers: 3})
+		m.vectorBackfills.Finish(job.ID, finishVectorBackfill(err))
+		require.Nil(t, err)
+
+		status, ok := m.VectorBackfillStatus(job.ID)
+		require.True(t, ok)
+		assert.Equal(t, types.BackfillFinished, status.Status)
+		assert.Equal(t, 1, status.Shards)
+		assert.Equal(t, 1, status.ShardsDone)
+		assert.Equal(t, int64(250), status.Scanned)
+		assert.Equal(t, int64(225), status.Updated)
+		assert.Equal(t, int64(25), status.Failed)
+		// objects the vectorizer failed for keep their vectors
+		assert.Equal(t, map[float32]int{1: 25, 9: 225}, vectors(t, shd))
+	})
+
+	t.Run("only nil vectors", func(t *testing.T) {
+		shd, idx := newShard(t)
+		logger, _ := test.NewNullLogger()
+		m := &Migrator{
+			logger: logger, vectorBackfills: jobs.NewTracker[types.VectorBackfillStatus](),
+			vectorizer: fakeBatchVectorizer{},
+		}
+		job := types.VectorBackfillStatus{ID: "job", Class: "TestClass", Status: types.BackfillRunning}
+		m.vectorBackfills.Add(job.ID, job)
+		err := m.backfillVectors(ctx, idx, class, job.ID, []string{""},
+			types.BackfillVectorOptions{BatchSize: 40, ConcurrentWorkers: 1, OnlyNilVectors: true})
+		m.vectorBackfills.Finish(job.ID, finishVectorBackfill(err))
+		require.Nil(t, err)
+
+		status, _ := m.VectorBackfillStatus(job.ID)
+		assert.Equal(t, int64(250), status.Scanned)
+		assert.Equal(t, int64(50), status.Updated)
+		assert.Equal(t, int64(0), status.Failed)
+		assert.Equal(t, map[float32]int{1: 200, 9: 50}, vectors(t, shd))
+	})
+
+	t.Run("objects updated meanwhile", func(t *testing.T) {
+		shd, idx := newShard(t)
+		logger, _ := test.NewNullLogger()
+		updated := false
+		vectorizer := fakeBatchVectorizer{vectorized: func(objects []*models.Object) {
+			if updated {
+				return
+			}
+			updated = true
+			obj, err := shd.ObjectByID(ctx, objects[0].ID, nil, additional.Properties{})
+			require.Nil(t, err)
+			obj.Vector = []float32{5, 5, 5}
+			obj.Object.LastUpdateTimeUnix = 2000
+			require.Nil(t, shd.PutObject(ctx, obj))
+		}}
+		m := &Migrator{
+			logger: logger, vectorBackfills: jobs.NewTracker[types.VectorBackfillStatus](),
+			vectorizer: vectorizer,
+		}
+		job := types.VectorBackfillStatus{ID: "job", Class: "TestClass", Status: types.BackfillRunning}
+		m.vectorBackfills.Add(job.ID, job)
+		err := m.backfillVectors(ctx, idx, class, job.ID, []string{""},
+			types.BackfillVectorOptions{BatchSize: 40, ConcurrentWorkers: 1, OnlyNilVectors: true})
+		m.vectorBackfills.Finish(job.ID, finishVectorBackfill(err))
+		require.Nil(t, err)
+
+		// the update isn't overwritten with the stale object
+		assert.Equal(t, map[float32]int{1: 200, 5: 1, 9: 49}, vectors(t, shd))
+	})
+}
@@ -0,0 +1,386 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package cluster
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// ClusterSnapshotReader is a Reader for the ClusterSnapshot structure.
+type ClusterSnapshotReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *ClusterSnapshotReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewClusterSnapshotOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 401:
+		result := NewClusterSnapshotUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewClusterSnapshotForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 409:
+		result := NewClusterSnapshotConflict()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewClusterSnapshotInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewClusterSnapshotOK creates a ClusterSnapshotOK with default headers values
+func NewClusterSnapshotOK() *ClusterSnapshotOK {
+	return &ClusterSnapshotOK{}
+}
+
+/*
+ClusterSnapshotOK describes a response with status code 200, with default header values.
+
+Snapshot successfully taken
+*/
+type ClusterSnapshotOK struct {
+}
+
+// IsSuccess returns true when this cluster snapshot o k response has a 2xx status code
+func (o *ClusterSnapshotOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this cluster snapshot o k response has a 3xx status code
+func (o *ClusterSnapshotOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this cluster snapshot o k response has a 4xx status code
+func (o *ClusterSnapshotOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this cluster snapshot o k response has a 5xx status code
+func (o *ClusterSnapshotOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this cluster snapshot o k response a status code equal to that given
+func (o *ClusterSnapshotOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the cluster snapshot o k response
+func (o *ClusterSnapshotOK) Code() int {
+	return 200
+}
+
+func (o *ClusterSnapshotOK) Error() string {
+	return fmt.Sprintf("[POST /cluster/snapshot][%d] clusterSnapshotOK ", 200)
+}
+
+func (o *ClusterSnapshotOK) String() string {
+	return fmt.Sprintf("[POST /cluster/snapshot][%d] clusterSnapshotOK ", 200)
+}
+
+func (o *ClusterSnapshotOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewClusterSnapshotUnauthorized creates a ClusterSnapshotUnauthorized with default headers values
+func NewClusterSnapshotUnauthorized() *ClusterSnapshotUnauthorized {
+	return &ClusterSnapshotUnauthorized{}
+}
+
+/*
+ClusterSnapshotUnauthorized describes a response with status code 401, with default header values.
+
+Unauthorized or invalid credentials.
+*/
+type ClusterSnapshotUnauthorized struct {
+}
+
+// IsSuccess returns true when this cluster snapshot unauthorized response has a 2xx status code
+func (o *ClusterSnapshotUnauthorized) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this cluster snapshot unauthorized response has a 3xx status code
+func (o *ClusterSnapshotUnauthorized) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this cluster snapshot unauthorized response has a 4xx status code
+func (o *ClusterSnapshotUnauthorized) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this cluster snapshot unauthorized response has a 5xx status code
+func (o *ClusterSnapshotUnauthorized) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this cluster snapshot unauthorized response a status code equal to that given
+func (o *ClusterSnapshotUnauthorized) IsCode(code int) bool {
+	return code == 401
+}
+
+// Code gets the status code for the cluster snapshot unauthorized response
+func (o *ClusterSnapshotUnauthorized) Code() int {
+	return 401
+}
+
+func (o *ClusterSnapshotUnauthorized) Error() string {
+	return fmt.Sprintf("[POST /cluster/snapshot][%d] clusterSnapshotUnauthorized ", 401)
+}
+
+func (o *ClusterSnapshotUnauthorized) String() string {
+	return fmt.Sprintf("[POST /cluster/snapshot][%d] clusterSnapshotUnauthorized ", 401)
+}
+
+func (o *ClusterSnapshotUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewClusterSnapshotForbidden creates a ClusterSnapshotForbidden with default headers values
+func NewClusterSnapshotForbidden() *ClusterSnapshotForbidden {
+	return &ClusterSnapshotForbidden{}
+}
+
+/*
+ClusterSnapshotForbidden describes a response with status code 403, with default header values.
+
+Forbidden
+*/
+type ClusterSnapshotForbidden struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this cluster snapshot forbidden response has a 2xx status code
+func (o *ClusterSnapshotForbidden) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this cluster snapshot forbidden response has a 3xx status code
+func (o *ClusterSnapshotForbidden) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this cluster snapshot forbidden response has a 4xx status code
+func (o *ClusterSnapshotForbidden) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this cluster snapshot forbidden response has a 5xx status code
+func (o *ClusterSnapshotForbidden) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this cluster snapshot forbidden response a status code equal to that given
+func (o *ClusterSnapshotForbidden) IsCode(code int) bool {
+	return code == 403
+}
+
+// Code gets the status code for the cluster snapshot forbidden response
+func (o *ClusterSnapshotForbidden) Code() int {
+	return 403
+}
+
+func (o *ClusterSnapshotForbidden) Error() string {
+	return fmt.Sprintf("[POST /cluster/snapshot][%d] clusterSnapshotForbidden  %+v", 403, o.Payload)
+}
+
+func (o *ClusterSnapshotForbidden) String() string {
+	return fmt.Sprintf("[POST /cluster/snapshot][%d] clusterSnapshotForbidden  %+v", 403, o.Payload)
+}
+
+func (o *ClusterSnapshotForbidden) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *ClusterSnapshotForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewClusterSnapshotConflict creates a ClusterSnapshotConflict with default headers values
+func NewClusterSnapshotConflict() *ClusterSnapshotConflict {
+	return &ClusterSnapshotConflict{}
+}
+
+/*
+ClusterSnapshotConflict describes a response with status code 409, with default header values.
+
+A snapshot is already in progress.
+*/
+type ClusterSnapshotConflict struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this cluster snapshot conflict response has a 2xx status code
+func (o *ClusterSnapshotConflict) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this cluster snapshot conflict response has a 3xx status code
+func (o *ClusterSnapshotConflict) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this cluster snapshot conflict response has a 4xx status code
+func (o *ClusterSnapshotConflict) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this cluster snapshot conflict response has a 5xx status code
+func (o *ClusterSnapshotConflict) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this cluster snapshot conflict response a status code equal to that given
+func (o *ClusterSnapshotConflict) IsCode(code int) bool {
+	return code == 409
+}
+
+// Code gets the status code for the cluster snapshot conflict response
+func (o *ClusterSnapshotConflict) Code() int {
+	return 409
+}
+
+func (o *ClusterSnapshotConflict) Error() string {
+	return fmt.Sprintf("[POST /cluster/snapshot][%d] clusterSnapshotConflict  %+v", 409, o.Payload)
+}
+
+func (o *ClusterSnapshotConflict) String() string {
+	return fmt.Sprintf("[POST /cluster/snapshot][%d] clusterSnapshotConflict  %+v", 409, o.Payload)
+}
+
+func (o *ClusterSnapshotConflict) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *ClusterSnapshotConflict) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewClusterSnapshotInternalServerError creates a ClusterSnapshotInternalServerError with default headers values
+func NewClusterSnapshotInternalServerError() *ClusterSnapshotInternalServerError {
+	return &ClusterSnapshotInternalServerError{}
+}
+
+/*
+ClusterSnapshotInternalServerError describes a response with status code 500, with default header values.
+
+An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.
+*/
+type ClusterSnapshotInternalServerError struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this cluster snapshot internal server error response has a 2xx status code
+func (o *ClusterSnapshotInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this cluster snapshot internal server error response has a 3xx status code
+func (o *ClusterSnapshotInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this cluster snapshot internal server error response has a 4xx status code
+func (o *ClusterSnapshotInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this cluster snapshot internal server error response has a 5xx status code
+func (o *ClusterSnapshotInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this cluster snapshot internal server error response a status code equal to that given
+func (o *ClusterSnapshotInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+// Code gets the status code for the cluster snapshot internal server error response
+func (o *ClusterSnapshotInternalServerError) Code() int {
+	return 500
+}
+
+func (o *ClusterSnapshotInternalServerError) Error() string {
+	return fmt.Sprintf("[POST /cluster/snapshot][%d] clusterSnapshotInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *ClusterSnapshotInternalServerError) String() string {
+	return fmt.Sprintf("[POST /cluster/snapshot][%d] clusterSnapshotInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *ClusterSnapshotInternalServerError) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *ClusterSnapshotInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -92,6 +92,10 @@ ObjectsClassPatchNoContent describes a response with status code 204, with defau
 Successfully applied. No content provided.
 */
 type ObjectsClassPatchNoContent struct {
+
+	/* Set if the object was written to deprecated properties of a class which doesn't enforce deprecation. Lists the deprecation warnings of these properties.
+	 */
+	XWeaviateDeprecationWarning string
 }
 
 // IsSuccess returns true when this objects class patch no content response has a 2xx status code
@@ -134,6 +138,13 @@ func (o *ObjectsClassPatchNoContent) String() string {
 
 func (o *ObjectsClassPatchNoContent) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
 
+	// hydrates response header x-weaviate-deprecation-warning
+	hdrXWeaviateDeprecationWarning := response.GetHeader("x-weaviate-deprecation-warning")
+
+	if hdrXWeaviateDeprecationWarning != "" {
+		o.XWeaviateDeprecationWarning = hdrXWeaviateDeprecationWarning
+	}
+
 	return nil
 }
 
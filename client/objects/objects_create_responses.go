@@ -86,6 +86,11 @@ ObjectsCreateOK describes a response with status code 200, with default header v
 Object created.
 */
 type ObjectsCreateOK struct {
+
+	/* Set if the object was written to deprecated properties of a class which doesn't enforce deprecation. Lists the deprecation warnings of these properties.
+	 */
+	XWeaviateDeprecationWarning string
+
 	Payload *models.Object
 }
 
@@ -133,6 +138,13 @@ func (o *ObjectsCreateOK) GetPayload() *models.Object {
 
 func (o *ObjectsCreateOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
 
+	// hydrates response header x-weaviate-deprecation-warning
+	hdrXWeaviateDeprecationWarning := response.GetHeader("x-weaviate-deprecation-warning")
+
+	if hdrXWeaviateDeprecationWarning != "" {
+		o.XWeaviateDeprecationWarning = hdrXWeaviateDeprecationWarning
+	}
+
 	o.Payload = new(models.Object)
 
 	// response payload
@@ -43,20 +43,50 @@ type ClientOption func(*runtime.ClientOperation)
 type ClientService interface {
 	SchemaDump(params *SchemaDumpParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SchemaDumpOK, error)
 
+	SchemaGraph(params *SchemaGraphParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SchemaGraphOK, error)
+
+	SchemaGraphql(params *SchemaGraphqlParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SchemaGraphqlOK, error)
+
 	SchemaObjectsCreate(params *SchemaObjectsCreateParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SchemaObjectsCreateOK, error)
 
 	SchemaObjectsDelete(params *SchemaObjectsDeleteParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SchemaObjectsDeleteOK, error)
 
 	SchemaObjectsGet(params *SchemaObjectsGetParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SchemaObjectsGetOK, error)
 
+	SchemaObjectsGroupsCreate(params *SchemaObjectsGroupsCreateParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SchemaObjectsGroupsCreateOK, error)
+
+	SchemaObjectsGroupsGet(params *SchemaObjectsGroupsGetParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SchemaObjectsGroupsGetOK, error)
+
+	SchemaObjectsGroupsList(params *SchemaObjectsGroupsListParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SchemaObjectsGroupsListOK, error)
+
+	SchemaObjectsPatch(params *SchemaObjectsPatchParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SchemaObjectsPatchOK, error)
+
 	SchemaObjectsPropertiesAdd(params *SchemaObjectsPropertiesAddParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SchemaObjectsPropertiesAddOK, error)
 
+	SchemaObjectsPropertiesStatsGet(params *SchemaObjectsPropertiesStatsGetParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SchemaObjectsPropertiesStatsGetOK, error)
+
+	SchemaObjectsReplicationGet(params *SchemaObjectsReplicationGetParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SchemaObjectsReplicationGetOK, error)
+
+	SchemaObjectsShardsCount(params *SchemaObjectsShardsCountParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SchemaObjectsShardsCountOK, error)
+
 	SchemaObjectsShardsGet(params *SchemaObjectsShardsGetParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SchemaObjectsShardsGetOK, error)
 
 	SchemaObjectsShardsUpdate(params *SchemaObjectsShardsUpdateParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SchemaObjectsShardsUpdateOK, error)
 
+	SchemaObjectsStatsGet(params *SchemaObjectsStatsGetParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SchemaObjectsStatsGetOK, error)
+
+	SchemaObjectsStatsRecommendationsGet(params *SchemaObjectsStatsRecommendationsGetParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SchemaObjectsStatsRecommendationsGetOK, error)
+
 	SchemaObjectsUpdate(params *SchemaObjectsUpdateParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SchemaObjectsUpdateOK, error)
 
+	SchemaObjectsValidate(params *SchemaObjectsValidateParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SchemaObjectsValidateOK, error)
+
+	SchemaObjectsVectorsBackfill(params *SchemaObjectsVectorsBackfillParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SchemaObjectsVectorsBackfillAccepted, error)
+
+	SchemaObjectsVectorsBackfillGet(params *SchemaObjectsVectorsBackfillGetParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SchemaObjectsVectorsBackfillGetOK, error)
+
+	SchemaValidate(params *SchemaValidateParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SchemaValidateOK, error)
+
 	TenantExists(params *TenantExistsParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*TenantExistsOK, error)
 
 	TenantsCreate(params *TenantsCreateParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*TenantsCreateOK, error)
@@ -67,6 +97,8 @@ type ClientService interface {
 
 	TenantsGetOne(params *TenantsGetOneParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*TenantsGetOneOK, error)
 
+	TenantsPrewarm(params *TenantsPrewarmParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*TenantsPrewarmOK, error)
+
 	TenantsUpdate(params *TenantsUpdateParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*TenantsUpdateOK, error)
 
 	SetTransport(transport runtime.ClientTransport)
@@ -113,6 +145,88 @@ func (a *Client) SchemaDump(params *SchemaDumpParams, authInfo runtime.ClientAut
 	panic(msg)
 }
 
+/*
+SchemaGraph gets the cross reference graph of the collections
+
+Fetch the directed graph of the cross-references between the collections, e.g. to visualize the topology of the schema. Every collection is a node and every cross-reference property an edge to each collection it may reference. Groups of collections which reference each other are reported as cycles.
+*/
+func (a *Client) SchemaGraph(params *SchemaGraphParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SchemaGraphOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewSchemaGraphParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "schema.graph",
+		Method:             "GET",
+		PathPattern:        "/schema/graph",
+		ProducesMediaTypes: []string{"application/json", "text/plain"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &SchemaGraphReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*SchemaGraphOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for schema.graph: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+SchemaGraphql exports the schema as graph q l s d l
+
+Fetch the collection definitions of the schema as GraphQL schema definition language, for client code generation and GraphQL tooling. Every collection becomes a type with a field per property and the Query type has a Get and an Aggregate field per collection.
+*/
+func (a *Client) SchemaGraphql(params *SchemaGraphqlParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SchemaGraphqlOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewSchemaGraphqlParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "schema.graphql",
+		Method:             "GET",
+		PathPattern:        "/schema.graphql",
+		ProducesMediaTypes: []string{"text/plain"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &SchemaGraphqlReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*SchemaGraphqlOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for schema.graphql: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 /*
 SchemaObjectsCreate creates a new object class in the schema
 
@@ -235,22 +349,24 @@ func (a *Client) SchemaObjectsGet(params *SchemaObjectsGetParams, authInfo runti
 }
 
 /*
-SchemaObjectsPropertiesAdd adds a property to an object class
+SchemaObjectsGroupsCreate assigns a property group to properties of a collection
+
+Create a property group by assigning it to existing properties of the collection. Properties which already have a group are moved to the new one.
 */
-func (a *Client) SchemaObjectsPropertiesAdd(params *SchemaObjectsPropertiesAddParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SchemaObjectsPropertiesAddOK, error) {
+func (a *Client) SchemaObjectsGroupsCreate(params *SchemaObjectsGroupsCreateParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SchemaObjectsGroupsCreateOK, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
-		params = NewSchemaObjectsPropertiesAddParams()
+		params = NewSchemaObjectsGroupsCreateParams()
 	}
 	op := &runtime.ClientOperation{
-		ID:                 "schema.objects.properties.add",
+		ID:                 "schema.objects.groups.create",
 		Method:             "POST",
-		PathPattern:        "/schema/{className}/properties",
+		PathPattern:        "/schema/{className}/groups",
 		ProducesMediaTypes: []string{"application/json"},
 		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
 		Schemes:            []string{"https"},
 		Params:             params,
-		Reader:             &SchemaObjectsPropertiesAddReader{formats: a.formats},
+		Reader:             &SchemaObjectsGroupsCreateReader{formats: a.formats},
 		AuthInfo:           authInfo,
 		Context:            params.Context,
 		Client:             params.HTTPClient,
@@ -263,35 +379,33 @@ func (a *Client) SchemaObjectsPropertiesAdd(params *SchemaObjectsPropertiesAddPa
 	if err != nil {
 		return nil, err
 	}
-	success, ok := result.(*SchemaObjectsPropertiesAddOK)
+	success, ok := result.(*SchemaObjectsGroupsCreateOK)
 	if ok {
 		return success, nil
 	}
 	// unexpected success response
 	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
-	msg := fmt.Sprintf("unexpected success response for schema.objects.properties.add: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	msg := fmt.Sprintf("unexpected success response for schema.objects.groups.create: API contract not enforced by server. Client expected to get an error, but got: %T", result)
 	panic(msg)
 }
 
 /*
-SchemaObjectsShardsGet gets the shards status of an object class
-
-Get the status of every shard in the cluster.
+SchemaObjectsGroupsGet gets the properties of a property group
 */
-func (a *Client) SchemaObjectsShardsGet(params *SchemaObjectsShardsGetParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SchemaObjectsShardsGetOK, error) {
+func (a *Client) SchemaObjectsGroupsGet(params *SchemaObjectsGroupsGetParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SchemaObjectsGroupsGetOK, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
-		params = NewSchemaObjectsShardsGetParams()
+		params = NewSchemaObjectsGroupsGetParams()
 	}
 	op := &runtime.ClientOperation{
-		ID:                 "schema.objects.shards.get",
+		ID:                 "schema.objects.groups.get",
 		Method:             "GET",
-		PathPattern:        "/schema/{className}/shards",
+		PathPattern:        "/schema/{className}/groups/{groupName}",
 		ProducesMediaTypes: []string{"application/json"},
 		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
 		Schemes:            []string{"https"},
 		Params:             params,
-		Reader:             &SchemaObjectsShardsGetReader{formats: a.formats},
+		Reader:             &SchemaObjectsGroupsGetReader{formats: a.formats},
 		AuthInfo:           authInfo,
 		Context:            params.Context,
 		Client:             params.HTTPClient,
@@ -304,35 +418,35 @@ func (a *Client) SchemaObjectsShardsGet(params *SchemaObjectsShardsGetParams, au
 	if err != nil {
 		return nil, err
 	}
-	success, ok := result.(*SchemaObjectsShardsGetOK)
+	success, ok := result.(*SchemaObjectsGroupsGetOK)
 	if ok {
 		return success, nil
 	}
 	// unexpected success response
 	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
-	msg := fmt.Sprintf("unexpected success response for schema.objects.shards.get: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	msg := fmt.Sprintf("unexpected success response for schema.objects.groups.get: API contract not enforced by server. Client expected to get an error, but got: %T", result)
 	panic(msg)
 }
 
 /*
-SchemaObjectsShardsUpdate updates a shard status
+SchemaObjectsGroupsList lists the property groups of a collection
 
-Update a shard status for a collection. For example, a shard may have been marked as `READONLY` because its disk was full. After providing more disk space, use this endpoint to set the shard status to `READY` again. There is also a convenience function in each client to set the status of all shards of a collection.
+List the distinct groups of the properties of a collection, ordered by name.
 */
-func (a *Client) SchemaObjectsShardsUpdate(params *SchemaObjectsShardsUpdateParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SchemaObjectsShardsUpdateOK, error) {
+func (a *Client) SchemaObjectsGroupsList(params *SchemaObjectsGroupsListParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SchemaObjectsGroupsListOK, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
-		params = NewSchemaObjectsShardsUpdateParams()
+		params = NewSchemaObjectsGroupsListParams()
 	}
 	op := &runtime.ClientOperation{
-		ID:                 "schema.objects.shards.update",
-		Method:             "PUT",
-		PathPattern:        "/schema/{className}/shards/{shardName}",
+		ID:                 "schema.objects.groups.list",
+		Method:             "GET",
+		PathPattern:        "/schema/{className}/groups",
 		ProducesMediaTypes: []string{"application/json"},
 		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
 		Schemes:            []string{"https"},
 		Params:             params,
-		Reader:             &SchemaObjectsShardsUpdateReader{formats: a.formats},
+		Reader:             &SchemaObjectsGroupsListReader{formats: a.formats},
 		AuthInfo:           authInfo,
 		Context:            params.Context,
 		Client:             params.HTTPClient,
@@ -345,35 +459,35 @@ func (a *Client) SchemaObjectsShardsUpdate(params *SchemaObjectsShardsUpdatePara
 	if err != nil {
 		return nil, err
 	}
-	success, ok := result.(*SchemaObjectsShardsUpdateOK)
+	success, ok := result.(*SchemaObjectsGroupsListOK)
 	if ok {
 		return success, nil
 	}
 	// unexpected success response
 	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
-	msg := fmt.Sprintf("unexpected success response for schema.objects.shards.update: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	msg := fmt.Sprintf("unexpected success response for schema.objects.groups.list: API contract not enforced by server. Client expected to get an error, but got: %T", result)
 	panic(msg)
 }
 
 /*
-SchemaObjectsUpdate updates settings of an existing schema class
+SchemaObjectsPatch changes the read only mode of a collection
 
-Add a property to an existing collection.
+Set or clear the read-only mode of a collection. While read-only, object writes (create, update, batch import) to the collection are rejected. Queries are not affected.
 */
-func (a *Client) SchemaObjectsUpdate(params *SchemaObjectsUpdateParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SchemaObjectsUpdateOK, error) {
+func (a *Client) SchemaObjectsPatch(params *SchemaObjectsPatchParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SchemaObjectsPatchOK, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
-		params = NewSchemaObjectsUpdateParams()
+		params = NewSchemaObjectsPatchParams()
 	}
 	op := &runtime.ClientOperation{
-		ID:                 "schema.objects.update",
-		Method:             "PUT",
+		ID:                 "schema.objects.patch",
+		Method:             "PATCH",
 		PathPattern:        "/schema/{className}",
 		ProducesMediaTypes: []string{"application/json"},
 		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
 		Schemes:            []string{"https"},
 		Params:             params,
-		Reader:             &SchemaObjectsUpdateReader{formats: a.formats},
+		Reader:             &SchemaObjectsPatchReader{formats: a.formats},
 		AuthInfo:           authInfo,
 		Context:            params.Context,
 		Client:             params.HTTPClient,
@@ -386,35 +500,74 @@ func (a *Client) SchemaObjectsUpdate(params *SchemaObjectsUpdateParams, authInfo
 	if err != nil {
 		return nil, err
 	}
-	success, ok := result.(*SchemaObjectsUpdateOK)
+	success, ok := result.(*SchemaObjectsPatchOK)
 	if ok {
 		return success, nil
 	}
 	// unexpected success response
 	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
-	msg := fmt.Sprintf("unexpected success response for schema.objects.update: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	msg := fmt.Sprintf("unexpected success response for schema.objects.patch: API contract not enforced by server. Client expected to get an error, but got: %T", result)
 	panic(msg)
 }
 
 /*
-TenantExists checks whether a tenant exists
+SchemaObjectsPropertiesAdd adds a property to an object class
+*/
+func (a *Client) SchemaObjectsPropertiesAdd(params *SchemaObjectsPropertiesAddParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SchemaObjectsPropertiesAddOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewSchemaObjectsPropertiesAddParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "schema.objects.properties.add",
+		Method:             "POST",
+		PathPattern:        "/schema/{className}/properties",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &SchemaObjectsPropertiesAddReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
-Check if a tenant exists for a specific class
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*SchemaObjectsPropertiesAddOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for schema.objects.properties.add: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+SchemaObjectsPropertiesStatsGet gets the value stats of a property
+
+Get the number of objects without a value, the number of distinct values and the average value length of a property on this node, e.g. to tune its inverted index. The stats are computed by scanning the filterable index of the property, which must therefore have one, and are cached for a few minutes.
 */
-func (a *Client) TenantExists(params *TenantExistsParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*TenantExistsOK, error) {
+func (a *Client) SchemaObjectsPropertiesStatsGet(params *SchemaObjectsPropertiesStatsGetParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SchemaObjectsPropertiesStatsGetOK, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
-		params = NewTenantExistsParams()
+		params = NewSchemaObjectsPropertiesStatsGetParams()
 	}
 	op := &runtime.ClientOperation{
-		ID:                 "tenant.exists",
-		Method:             "HEAD",
-		PathPattern:        "/schema/{className}/tenants/{tenantName}",
+		ID:                 "schema.objects.properties.stats.get",
+		Method:             "GET",
+		PathPattern:        "/schema/{className}/properties/{propertyName}/stats",
 		ProducesMediaTypes: []string{"application/json"},
 		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
 		Schemes:            []string{"https"},
 		Params:             params,
-		Reader:             &TenantExistsReader{formats: a.formats},
+		Reader:             &SchemaObjectsPropertiesStatsGetReader{formats: a.formats},
 		AuthInfo:           authInfo,
 		Context:            params.Context,
 		Client:             params.HTTPClient,
@@ -427,35 +580,35 @@ func (a *Client) TenantExists(params *TenantExistsParams, authInfo runtime.Clien
 	if err != nil {
 		return nil, err
 	}
-	success, ok := result.(*TenantExistsOK)
+	success, ok := result.(*SchemaObjectsPropertiesStatsGetOK)
 	if ok {
 		return success, nil
 	}
 	// unexpected success response
 	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
-	msg := fmt.Sprintf("unexpected success response for tenant.exists: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	msg := fmt.Sprintf("unexpected success response for schema.objects.properties.stats.get: API contract not enforced by server. Client expected to get an error, but got: %T", result)
 	panic(msg)
 }
 
 /*
-TenantsCreate creates a new tenant
+SchemaObjectsReplicationGet gets the replication status of the shards of a collection
 
-Create a new tenant for a collection. Multi-tenancy must be enabled in the collection definition.
+Get for every shard of a collection which of its replicas have applied the latest schema change of the collection. A replica is lagged if its node has not gossiped an applied index at least as high as the version of the collection.
 */
-func (a *Client) TenantsCreate(params *TenantsCreateParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*TenantsCreateOK, error) {
+func (a *Client) SchemaObjectsReplicationGet(params *SchemaObjectsReplicationGetParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SchemaObjectsReplicationGetOK, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
-		params = NewTenantsCreateParams()
+		params = NewSchemaObjectsReplicationGetParams()
 	}
 	op := &runtime.ClientOperation{
-		ID:                 "tenants.create",
-		Method:             "POST",
-		PathPattern:        "/schema/{className}/tenants",
+		ID:                 "schema.objects.replication.get",
+		Method:             "GET",
+		PathPattern:        "/schema/{className}/replication",
 		ProducesMediaTypes: []string{"application/json"},
 		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
 		Schemes:            []string{"https"},
 		Params:             params,
-		Reader:             &TenantsCreateReader{formats: a.formats},
+		Reader:             &SchemaObjectsReplicationGetReader{formats: a.formats},
 		AuthInfo:           authInfo,
 		Context:            params.Context,
 		Client:             params.HTTPClient,
@@ -468,33 +621,35 @@ func (a *Client) TenantsCreate(params *TenantsCreateParams, authInfo runtime.Cli
 	if err != nil {
 		return nil, err
 	}
-	success, ok := result.(*TenantsCreateOK)
+	success, ok := result.(*SchemaObjectsReplicationGetOK)
 	if ok {
 		return success, nil
 	}
 	// unexpected success response
 	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
-	msg := fmt.Sprintf("unexpected success response for tenants.create: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	msg := fmt.Sprintf("unexpected success response for schema.objects.replication.get: API contract not enforced by server. Client expected to get an error, but got: %T", result)
 	panic(msg)
 }
 
 /*
-TenantsDelete delete tenants from a specific class
+SchemaObjectsShardsCount gets the object count of a shard
+
+Get the number of objects in a shard of a collection. The shard has to be local to the node serving the request. Counts are cached until the shard is written to.
 */
-func (a *Client) TenantsDelete(params *TenantsDeleteParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*TenantsDeleteOK, error) {
+func (a *Client) SchemaObjectsShardsCount(params *SchemaObjectsShardsCountParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SchemaObjectsShardsCountOK, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
-		params = NewTenantsDeleteParams()
+		params = NewSchemaObjectsShardsCountParams()
 	}
 	op := &runtime.ClientOperation{
-		ID:                 "tenants.delete",
-		Method:             "DELETE",
-		PathPattern:        "/schema/{className}/tenants",
+		ID:                 "schema.objects.shards.count",
+		Method:             "GET",
+		PathPattern:        "/schema/{className}/shards/{shardName}/count",
 		ProducesMediaTypes: []string{"application/json"},
 		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
 		Schemes:            []string{"https"},
 		Params:             params,
-		Reader:             &TenantsDeleteReader{formats: a.formats},
+		Reader:             &SchemaObjectsShardsCountReader{formats: a.formats},
 		AuthInfo:           authInfo,
 		Context:            params.Context,
 		Client:             params.HTTPClient,
@@ -507,35 +662,35 @@ func (a *Client) TenantsDelete(params *TenantsDeleteParams, authInfo runtime.Cli
 	if err != nil {
 		return nil, err
 	}
-	success, ok := result.(*TenantsDeleteOK)
+	success, ok := result.(*SchemaObjectsShardsCountOK)
 	if ok {
 		return success, nil
 	}
 	// unexpected success response
 	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
-	msg := fmt.Sprintf("unexpected success response for tenants.delete: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	msg := fmt.Sprintf("unexpected success response for schema.objects.shards.count: API contract not enforced by server. Client expected to get an error, but got: %T", result)
 	panic(msg)
 }
 
 /*
-TenantsGet gets the list of tenants
+SchemaObjectsShardsGet gets the shards status of an object class
 
-get all tenants from a specific class
+Get the status of every shard in the cluster.
 */
-func (a *Client) TenantsGet(params *TenantsGetParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*TenantsGetOK, error) {
+func (a *Client) SchemaObjectsShardsGet(params *SchemaObjectsShardsGetParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SchemaObjectsShardsGetOK, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
-		params = NewTenantsGetParams()
+		params = NewSchemaObjectsShardsGetParams()
 	}
 	op := &runtime.ClientOperation{
-		ID:                 "tenants.get",
+		ID:                 "schema.objects.shards.get",
 		Method:             "GET",
-		PathPattern:        "/schema/{className}/tenants",
+		PathPattern:        "/schema/{className}/shards",
 		ProducesMediaTypes: []string{"application/json"},
 		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
 		Schemes:            []string{"https"},
 		Params:             params,
-		Reader:             &TenantsGetReader{formats: a.formats},
+		Reader:             &SchemaObjectsShardsGetReader{formats: a.formats},
 		AuthInfo:           authInfo,
 		Context:            params.Context,
 		Client:             params.HTTPClient,
@@ -548,35 +703,76 @@ func (a *Client) TenantsGet(params *TenantsGetParams, authInfo runtime.ClientAut
 	if err != nil {
 		return nil, err
 	}
-	success, ok := result.(*TenantsGetOK)
+	success, ok := result.(*SchemaObjectsShardsGetOK)
 	if ok {
 		return success, nil
 	}
 	// unexpected success response
 	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
-	msg := fmt.Sprintf("unexpected success response for tenants.get: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	msg := fmt.Sprintf("unexpected success response for schema.objects.shards.get: API contract not enforced by server. Client expected to get an error, but got: %T", result)
 	panic(msg)
 }
 
 /*
-TenantsGetOne gets a specific tenant
+SchemaObjectsShardsUpdate updates a shard status
 
-get a specific tenant for the given class
+Update a shard status for a collection. For example, a shard may have been marked as `READONLY` because its disk was full. After providing more disk space, use this endpoint to set the shard status to `READY` again. There is also a convenience function in each client to set the status of all shards of a collection.
 */
-func (a *Client) TenantsGetOne(params *TenantsGetOneParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*TenantsGetOneOK, error) {
+func (a *Client) SchemaObjectsShardsUpdate(params *SchemaObjectsShardsUpdateParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SchemaObjectsShardsUpdateOK, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
-		params = NewTenantsGetOneParams()
+		params = NewSchemaObjectsShardsUpdateParams()
 	}
 	op := &runtime.ClientOperation{
-		ID:                 "tenants.get.one",
+		ID:                 "schema.objects.shards.update",
+		Method:             "PUT",
+		PathPattern:        "/schema/{className}/shards/{shardName}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &SchemaObjectsShardsUpdateReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*SchemaObjectsShardsUpdateOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for schema.objects.shards.update: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+SchemaObjectsStatsGet gets the access stats of the properties of a collection
+
+Get how often filters and sorts used each property of a collection on this node since it was started. The stats are kept in memory only.
+*/
+func (a *Client) SchemaObjectsStatsGet(params *SchemaObjectsStatsGetParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SchemaObjectsStatsGetOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewSchemaObjectsStatsGetParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "schema.objects.stats.get",
 		Method:             "GET",
-		PathPattern:        "/schema/{className}/tenants/{tenantName}",
+		PathPattern:        "/schema/{className}/stats",
 		ProducesMediaTypes: []string{"application/json"},
 		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
 		Schemes:            []string{"https"},
 		Params:             params,
-		Reader:             &TenantsGetOneReader{formats: a.formats},
+		Reader:             &SchemaObjectsStatsGetReader{formats: a.formats},
 		AuthInfo:           authInfo,
 		Context:            params.Context,
 		Client:             params.HTTPClient,
@@ -589,7 +785,456 @@ func (a *Client) TenantsGetOne(params *TenantsGetOneParams, authInfo runtime.Cli
 	if err != nil {
 		return nil, err
 	}
-	success, ok := result.(*TenantsGetOneOK)
+	success, ok := result.(*SchemaObjectsStatsGetOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for schema.objects.stats.get: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+SchemaObjectsStatsRecommendationsGet gets indexing recommendations for a collection
+
+Compare the access stats of the properties of a collection with their indexes and recommend which filterable indexes to enable or disable.
+*/
+func (a *Client) SchemaObjectsStatsRecommendationsGet(params *SchemaObjectsStatsRecommendationsGetParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SchemaObjectsStatsRecommendationsGetOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewSchemaObjectsStatsRecommendationsGetParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "schema.objects.stats.recommendations.get",
+		Method:             "GET",
+		PathPattern:        "/schema/{className}/stats/recommendations",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &SchemaObjectsStatsRecommendationsGetReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*SchemaObjectsStatsRecommendationsGetOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for schema.objects.stats.recommendations.get: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+SchemaObjectsUpdate updates settings of an existing schema class
+
+Add a property to an existing collection.
+*/
+func (a *Client) SchemaObjectsUpdate(params *SchemaObjectsUpdateParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SchemaObjectsUpdateOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewSchemaObjectsUpdateParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "schema.objects.update",
+		Method:             "PUT",
+		PathPattern:        "/schema/{className}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &SchemaObjectsUpdateReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*SchemaObjectsUpdateOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for schema.objects.update: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+SchemaObjectsValidate validates the properties of an object against a collection
+
+Check the properties of an object before it is written: required properties are present, values match the data types of their properties and references are valid beacons. Properties which are not part of the collection are reported if it sets `strictPropertyValidation`. Nothing is written.
+*/
+func (a *Client) SchemaObjectsValidate(params *SchemaObjectsValidateParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SchemaObjectsValidateOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewSchemaObjectsValidateParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "schema.objects.validate",
+		Method:             "POST",
+		PathPattern:        "/schema/{className}/validate-object",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &SchemaObjectsValidateReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*SchemaObjectsValidateOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for schema.objects.validate: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+SchemaObjectsVectorsBackfill vectorizes the objects of a collection again
+
+Start vectorizing the existing objects of a collection again with its vectorizers, e.g. after a vectorizer was changed and the stored vectors are stale. The objects are vectorized in the background, poll the returned job for the progress. Only the shards on the node handling the request are backfilled, vectors without a vectorizer are never changed.
+*/
+func (a *Client) SchemaObjectsVectorsBackfill(params *SchemaObjectsVectorsBackfillParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SchemaObjectsVectorsBackfillAccepted, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewSchemaObjectsVectorsBackfillParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "schema.objects.vectors.backfill",
+		Method:             "POST",
+		PathPattern:        "/schema/{className}/vectors/backfill",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &SchemaObjectsVectorsBackfillReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*SchemaObjectsVectorsBackfillAccepted)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for schema.objects.vectors.backfill: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+SchemaObjectsVectorsBackfillGet gets the progress of a vector backfill
+
+Get the status and progress of a vector backfill job. Jobs are only known to the node they were started on.
+*/
+func (a *Client) SchemaObjectsVectorsBackfillGet(params *SchemaObjectsVectorsBackfillGetParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SchemaObjectsVectorsBackfillGetOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewSchemaObjectsVectorsBackfillGetParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "schema.objects.vectors.backfill.get",
+		Method:             "GET",
+		PathPattern:        "/schema/{className}/vectors/backfill/{jobId}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &SchemaObjectsVectorsBackfillGetReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*SchemaObjectsVectorsBackfillGetOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for schema.objects.vectors.backfill.get: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+SchemaValidate validates the integrity of the database schema
+
+Check all collection definitions for cross-references to collections which don't exist, module configs of modules which are not enabled and replication factors larger than the number of nodes in the cluster. The schema is not modified.
+*/
+func (a *Client) SchemaValidate(params *SchemaValidateParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SchemaValidateOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewSchemaValidateParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "schema.validate",
+		Method:             "GET",
+		PathPattern:        "/schema/validate",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &SchemaValidateReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*SchemaValidateOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for schema.validate: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+TenantExists checks whether a tenant exists
+
+Check if a tenant exists for a specific class
+*/
+func (a *Client) TenantExists(params *TenantExistsParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*TenantExistsOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewTenantExistsParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "tenant.exists",
+		Method:             "HEAD",
+		PathPattern:        "/schema/{className}/tenants/{tenantName}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &TenantExistsReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*TenantExistsOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for tenant.exists: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+TenantsCreate creates a new tenant
+
+Create a new tenant for a collection. Multi-tenancy must be enabled in the collection definition.
+*/
+func (a *Client) TenantsCreate(params *TenantsCreateParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*TenantsCreateOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewTenantsCreateParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "tenants.create",
+		Method:             "POST",
+		PathPattern:        "/schema/{className}/tenants",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &TenantsCreateReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*TenantsCreateOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for tenants.create: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+TenantsDelete delete tenants from a specific class
+*/
+func (a *Client) TenantsDelete(params *TenantsDeleteParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*TenantsDeleteOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewTenantsDeleteParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "tenants.delete",
+		Method:             "DELETE",
+		PathPattern:        "/schema/{className}/tenants",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &TenantsDeleteReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*TenantsDeleteOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for tenants.delete: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+TenantsGet gets the list of tenants
+
+get all tenants from a specific class
+*/
+func (a *Client) TenantsGet(params *TenantsGetParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*TenantsGetOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewTenantsGetParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "tenants.get",
+		Method:             "GET",
+		PathPattern:        "/schema/{className}/tenants",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &TenantsGetReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*TenantsGetOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for tenants.get: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+TenantsGetOne gets a specific tenant
+
+get a specific tenant for the given class
+*/
+func (a *Client) TenantsGetOne(params *TenantsGetOneParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*TenantsGetOneOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewTenantsGetOneParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "tenants.get.one",
+		Method:             "GET",
+		PathPattern:        "/schema/{className}/tenants/{tenantName}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &TenantsGetOneReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*TenantsGetOneOK)
 	if ok {
 		return success, nil
 	}
@@ -599,6 +1244,47 @@ func (a *Client) TenantsGetOne(params *TenantsGetOneParams, authInfo runtime.Cli
 	panic(msg)
 }
 
+/*
+TenantsPrewarm loads a tenant into memory
+
+Load the index of an active tenant into the memory of every node holding a replica of it and wait until it is loaded, so that the first query to the tenant does not have to load it.
+*/
+func (a *Client) TenantsPrewarm(params *TenantsPrewarmParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*TenantsPrewarmOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewTenantsPrewarmParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "tenants.prewarm",
+		Method:             "POST",
+		PathPattern:        "/schema/{className}/tenants/{tenantName}/prewarm",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &TenantsPrewarmReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*TenantsPrewarmOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for tenants.prewarm: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 /*
 TenantsUpdate updates a tenant
 
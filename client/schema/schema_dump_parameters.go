@@ -73,6 +73,12 @@ SchemaDumpParams contains all the parameters to send to the API endpoint
 */
 type SchemaDumpParams struct {
 
+	/* After.
+
+	   Only return the collections whose name sorts after this one. Pass the name of the last collection of a page to get the next one.
+	*/
+	After *string
+
 	/* Consistency.
 
 	   If consistency is true, the request will be proxied to the leader to ensure strong schema consistency
@@ -81,6 +87,56 @@ type SchemaDumpParams struct {
 	*/
 	Consistency *bool
 
+	/* HasLabel.
+
+	   Only return the collections with all of the given labels, as a comma separated list of key=value pairs.
+	*/
+	HasLabel *string
+
+	/* HasModule.
+
+	   Only return the collections with a module config for the module.
+	*/
+	HasModule *string
+
+	/* Limit.
+
+	   The maximum number of collections to return. Without limit all matching collections are returned.
+
+	   Format: int64
+	*/
+	Limit *int64
+
+	/* Module.
+
+	   Same as hasModule, must not be combined with it.
+	*/
+	Module *string
+
+	/* NameContains.
+
+	   Only return the collections whose name contains the string.
+	*/
+	NameContains *string
+
+	/* NamePrefix.
+
+	   Only return the collections whose name starts with the prefix.
+	*/
+	NamePrefix *string
+
+	/* Vectorizer.
+
+	   Only return the collections using the vectorizer, either for the collection or one of its named vectors.
+	*/
+	Vectorizer *string
+
+	/* XWeaviateWaitForToken.
+
+	   Consistency token returned by a schema change. The node waits until it has applied the change before it reads the schema locally, the consistency header is then ignored.
+	*/
+	XWeaviateWaitForToken *string
+
 	timeout    time.Duration
 	Context    context.Context
 	HTTPClient *http.Client
@@ -145,6 +201,17 @@ func (o *SchemaDumpParams) SetHTTPClient(client *http.Client) {
 	o.HTTPClient = client
 }
 
+// WithAfter adds the after to the schema dump params
+func (o *SchemaDumpParams) WithAfter(after *string) *SchemaDumpParams {
+	o.SetAfter(after)
+	return o
+}
+
+// SetAfter adds the after to the schema dump params
+func (o *SchemaDumpParams) SetAfter(after *string) {
+	o.After = after
+}
+
 // WithConsistency adds the consistency to the schema dump params
 func (o *SchemaDumpParams) WithConsistency(consistency *bool) *SchemaDumpParams {
 	o.SetConsistency(consistency)
@@ -156,6 +223,94 @@ func (o *SchemaDumpParams) SetConsistency(consistency *bool) {
 	o.Consistency = consistency
 }
 
+// WithHasLabel adds the hasLabel to the schema dump params
+func (o *SchemaDumpParams) WithHasLabel(hasLabel *string) *SchemaDumpParams {
+	o.SetHasLabel(hasLabel)
+	return o
+}
+
+// SetHasLabel adds the hasLabel to the schema dump params
+func (o *SchemaDumpParams) SetHasLabel(hasLabel *string) {
+	o.HasLabel = hasLabel
+}
+
+// WithHasModule adds the hasModule to the schema dump params
+func (o *SchemaDumpParams) WithHasModule(hasModule *string) *SchemaDumpParams {
+	o.SetHasModule(hasModule)
+	return o
+}
+
+// SetHasModule adds the hasModule to the schema dump params
+func (o *SchemaDumpParams) SetHasModule(hasModule *string) {
+	o.HasModule = hasModule
+}
+
+// WithLimit adds the limit to the schema dump params
+func (o *SchemaDumpParams) WithLimit(limit *int64) *SchemaDumpParams {
+	o.SetLimit(limit)
+	return o
+}
+
+// SetLimit adds the limit to the schema dump params
+func (o *SchemaDumpParams) SetLimit(limit *int64) {
+	o.Limit = limit
+}
+
+// WithModule adds the module to the schema dump params
+func (o *SchemaDumpParams) WithModule(module *string) *SchemaDumpParams {
+	o.SetModule(module)
+	return o
+}
+
+// SetModule adds the module to the schema dump params
+func (o *SchemaDumpParams) SetModule(module *string) {
+	o.Module = module
+}
+
+// WithNameContains adds the nameContains to the schema dump params
+func (o *SchemaDumpParams) WithNameContains(nameContains *string) *SchemaDumpParams {
+	o.SetNameContains(nameContains)
+	return o
+}
+
+// SetNameContains adds the nameContains to the schema dump params
+func (o *SchemaDumpParams) SetNameContains(nameContains *string) {
+	o.NameContains = nameContains
+}
+
+// WithNamePrefix adds the namePrefix to the schema dump params
+func (o *SchemaDumpParams) WithNamePrefix(namePrefix *string) *SchemaDumpParams {
+	o.SetNamePrefix(namePrefix)
+	return o
+}
+
+// SetNamePrefix adds the namePrefix to the schema dump params
+func (o *SchemaDumpParams) SetNamePrefix(namePrefix *string) {
+	o.NamePrefix = namePrefix
+}
+
+// WithVectorizer adds the vectorizer to the schema dump params
+func (o *SchemaDumpParams) WithVectorizer(vectorizer *string) *SchemaDumpParams {
+	o.SetVectorizer(vectorizer)
+	return o
+}
+
+// SetVectorizer adds the vectorizer to the schema dump params
+func (o *SchemaDumpParams) SetVectorizer(vectorizer *string) {
+	o.Vectorizer = vectorizer
+}
+
+// WithXWeaviateWaitForToken adds the xWeaviateWaitForToken to the schema dump params
+func (o *SchemaDumpParams) WithXWeaviateWaitForToken(xWeaviateWaitForToken *string) *SchemaDumpParams {
+	o.SetXWeaviateWaitForToken(xWeaviateWaitForToken)
+	return o
+}
+
+// SetXWeaviateWaitForToken adds the xWeaviateWaitForToken to the schema dump params
+func (o *SchemaDumpParams) SetXWeaviateWaitForToken(xWeaviateWaitForToken *string) {
+	o.XWeaviateWaitForToken = xWeaviateWaitForToken
+}
+
 // WriteToRequest writes these params to a swagger request
 func (o *SchemaDumpParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
 
@@ -164,6 +319,23 @@ func (o *SchemaDumpParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Re
 	}
 	var res []error
 
+	if o.After != nil {
+
+		// query param after
+		var qrAfter string
+
+		if o.After != nil {
+			qrAfter = *o.After
+		}
+		qAfter := qrAfter
+		if qAfter != "" {
+
+			if err := r.SetQueryParam("after", qAfter); err != nil {
+				return err
+			}
+		}
+	}
+
 	if o.Consistency != nil {
 
 		// header param consistency
@@ -172,6 +344,133 @@ func (o *SchemaDumpParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Re
 		}
 	}
 
+	if o.HasLabel != nil {
+
+		// query param hasLabel
+		var qrHasLabel string
+
+		if o.HasLabel != nil {
+			qrHasLabel = *o.HasLabel
+		}
+		qHasLabel := qrHasLabel
+		if qHasLabel != "" {
+
+			if err := r.SetQueryParam("hasLabel", qHasLabel); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.HasModule != nil {
+
+		// query param hasModule
+		var qrHasModule string
+
+		if o.HasModule != nil {
+			qrHasModule = *o.HasModule
+		}
+		qHasModule := qrHasModule
+		if qHasModule != "" {
+
+			if err := r.SetQueryParam("hasModule", qHasModule); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.Limit != nil {
+
+		// query param limit
+		var qrLimit int64
+
+		if o.Limit != nil {
+			qrLimit = *o.Limit
+		}
+		qLimit := swag.FormatInt64(qrLimit)
+		if qLimit != "" {
+
+			if err := r.SetQueryParam("limit", qLimit); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.Module != nil {
+
+		// query param module
+		var qrModule string
+
+		if o.Module != nil {
+			qrModule = *o.Module
+		}
+		qModule := qrModule
+		if qModule != "" {
+
+			if err := r.SetQueryParam("module", qModule); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.NameContains != nil {
+
+		// query param nameContains
+		var qrNameContains string
+
+		if o.NameContains != nil {
+			qrNameContains = *o.NameContains
+		}
+		qNameContains := qrNameContains
+		if qNameContains != "" {
+
+			if err := r.SetQueryParam("nameContains", qNameContains); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.NamePrefix != nil {
+
+		// query param namePrefix
+		var qrNamePrefix string
+
+		if o.NamePrefix != nil {
+			qrNamePrefix = *o.NamePrefix
+		}
+		qNamePrefix := qrNamePrefix
+		if qNamePrefix != "" {
+
+			if err := r.SetQueryParam("namePrefix", qNamePrefix); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.Vectorizer != nil {
+
+		// query param vectorizer
+		var qrVectorizer string
+
+		if o.Vectorizer != nil {
+			qrVectorizer = *o.Vectorizer
+		}
+		qVectorizer := qrVectorizer
+		if qVectorizer != "" {
+
+			if err := r.SetQueryParam("vectorizer", qVectorizer); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.XWeaviateWaitForToken != nil {
+
+		// header param x-weaviate-wait-for-token
+		if err := r.SetHeaderParam("x-weaviate-wait-for-token", *o.XWeaviateWaitForToken); err != nil {
+			return err
+		}
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
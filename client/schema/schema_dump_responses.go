@@ -52,6 +52,12 @@ func (o *SchemaDumpReader) ReadResponse(response runtime.ClientResponse, consume
 			return nil, err
 		}
 		return nil, result
+	case 422:
+		result := NewSchemaDumpUnprocessableEntity()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
 	case 500:
 		result := NewSchemaDumpInternalServerError()
 		if err := result.readResponse(response, consumer, o.formats); err != nil {
@@ -71,7 +77,7 @@ func NewSchemaDumpOK() *SchemaDumpOK {
 /*
 SchemaDumpOK describes a response with status code 200, with default header values.
 
-Successfully dumped the database schema.
+Successfully dumped the database schema. If any of the filter parameters is set, the schema only contains the matching collections ordered by name.
 */
 type SchemaDumpOK struct {
 	Payload *models.Schema
@@ -255,6 +261,74 @@ func (o *SchemaDumpForbidden) readResponse(response runtime.ClientResponse, cons
 	return nil
 }
 
+// NewSchemaDumpUnprocessableEntity creates a SchemaDumpUnprocessableEntity with default headers values
+func NewSchemaDumpUnprocessableEntity() *SchemaDumpUnprocessableEntity {
+	return &SchemaDumpUnprocessableEntity{}
+}
+
+/*
+SchemaDumpUnprocessableEntity describes a response with status code 422, with default header values.
+
+Invalid filter parameters.
+*/
+type SchemaDumpUnprocessableEntity struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this schema dump unprocessable entity response has a 2xx status code
+func (o *SchemaDumpUnprocessableEntity) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this schema dump unprocessable entity response has a 3xx status code
+func (o *SchemaDumpUnprocessableEntity) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this schema dump unprocessable entity response has a 4xx status code
+func (o *SchemaDumpUnprocessableEntity) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this schema dump unprocessable entity response has a 5xx status code
+func (o *SchemaDumpUnprocessableEntity) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this schema dump unprocessable entity response a status code equal to that given
+func (o *SchemaDumpUnprocessableEntity) IsCode(code int) bool {
+	return code == 422
+}
+
+// Code gets the status code for the schema dump unprocessable entity response
+func (o *SchemaDumpUnprocessableEntity) Code() int {
+	return 422
+}
+
+func (o *SchemaDumpUnprocessableEntity) Error() string {
+	return fmt.Sprintf("[GET /schema][%d] schemaDumpUnprocessableEntity  %+v", 422, o.Payload)
+}
+
+func (o *SchemaDumpUnprocessableEntity) String() string {
+	return fmt.Sprintf("[GET /schema][%d] schemaDumpUnprocessableEntity  %+v", 422, o.Payload)
+}
+
+func (o *SchemaDumpUnprocessableEntity) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *SchemaDumpUnprocessableEntity) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
 // NewSchemaDumpInternalServerError creates a SchemaDumpInternalServerError with default headers values
 func NewSchemaDumpInternalServerError() *SchemaDumpInternalServerError {
 	return &SchemaDumpInternalServerError{}
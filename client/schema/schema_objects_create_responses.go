@@ -80,6 +80,11 @@ SchemaObjectsCreateOK describes a response with status code 200, with default he
 Added the new Object class to the schema.
 */
 type SchemaObjectsCreateOK struct {
+
+	/* Pass it as x-weaviate-wait-for-token to read the schema including the new class from any node.
+	 */
+	XWeaviateConsistencyToken string
+
 	Payload *models.Class
 }
 
@@ -127,6 +132,13 @@ func (o *SchemaObjectsCreateOK) GetPayload() *models.Class {
 
 func (o *SchemaObjectsCreateOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
 
+	// hydrates response header x-weaviate-consistency-token
+	hdrXWeaviateConsistencyToken := response.GetHeader("x-weaviate-consistency-token")
+
+	if hdrXWeaviateConsistencyToken != "" {
+		o.XWeaviateConsistencyToken = hdrXWeaviateConsistencyToken
+	}
+
 	o.Payload = new(models.Class)
 
 	// response payload
@@ -0,0 +1,460 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package schema
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// SchemaObjectsGroupsCreateReader is a Reader for the SchemaObjectsGroupsCreate structure.
+type SchemaObjectsGroupsCreateReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *SchemaObjectsGroupsCreateReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewSchemaObjectsGroupsCreateOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 401:
+		result := NewSchemaObjectsGroupsCreateUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewSchemaObjectsGroupsCreateForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewSchemaObjectsGroupsCreateNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 422:
+		result := NewSchemaObjectsGroupsCreateUnprocessableEntity()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewSchemaObjectsGroupsCreateInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewSchemaObjectsGroupsCreateOK creates a SchemaObjectsGroupsCreateOK with default headers values
+func NewSchemaObjectsGroupsCreateOK() *SchemaObjectsGroupsCreateOK {
+	return &SchemaObjectsGroupsCreateOK{}
+}
+
+/*
+SchemaObjectsGroupsCreateOK describes a response with status code 200, with default header values.
+
+Assigned the group to the properties.
+*/
+type SchemaObjectsGroupsCreateOK struct {
+	Payload *models.PropertyGroup
+}
+
+// IsSuccess returns true when this schema objects groups create o k response has a 2xx status code
+func (o *SchemaObjectsGroupsCreateOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this schema objects groups create o k response has a 3xx status code
+func (o *SchemaObjectsGroupsCreateOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this schema objects groups create o k response has a 4xx status code
+func (o *SchemaObjectsGroupsCreateOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this schema objects groups create o k response has a 5xx status code
+func (o *SchemaObjectsGroupsCreateOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this schema objects groups create o k response a status code equal to that given
+func (o *SchemaObjectsGroupsCreateOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the schema objects groups create o k response
+func (o *SchemaObjectsGroupsCreateOK) Code() int {
+	return 200
+}
+
+func (o *SchemaObjectsGroupsCreateOK) Error() string {
+	return fmt.Sprintf("[POST /schema/{className}/groups][%d] schemaObjectsGroupsCreateOK  %+v", 200, o.Payload)
+}
+
+func (o *SchemaObjectsGroupsCreateOK) String() string {
+	return fmt.Sprintf("[POST /schema/{className}/groups][%d] schemaObjectsGroupsCreateOK  %+v", 200, o.Payload)
+}
+
+func (o *SchemaObjectsGroupsCreateOK) GetPayload() *models.PropertyGroup {
+	return o.Payload
+}
+
+func (o *SchemaObjectsGroupsCreateOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.PropertyGroup)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewSchemaObjectsGroupsCreateUnauthorized creates a SchemaObjectsGroupsCreateUnauthorized with default headers values
+func NewSchemaObjectsGroupsCreateUnauthorized() *SchemaObjectsGroupsCreateUnauthorized {
+	return &SchemaObjectsGroupsCreateUnauthorized{}
+}
+
+/*
+SchemaObjectsGroupsCreateUnauthorized describes a response with status code 401, with default header values.
+
+Unauthorized or invalid credentials.
+*/
+type SchemaObjectsGroupsCreateUnauthorized struct {
+}
+
+// IsSuccess returns true when this schema objects groups create unauthorized response has a 2xx status code
+func (o *SchemaObjectsGroupsCreateUnauthorized) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this schema objects groups create unauthorized response has a 3xx status code
+func (o *SchemaObjectsGroupsCreateUnauthorized) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this schema objects groups create unauthorized response has a 4xx status code
+func (o *SchemaObjectsGroupsCreateUnauthorized) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this schema objects groups create unauthorized response has a 5xx status code
+func (o *SchemaObjectsGroupsCreateUnauthorized) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this schema objects groups create unauthorized response a status code equal to that given
+func (o *SchemaObjectsGroupsCreateUnauthorized) IsCode(code int) bool {
+	return code == 401
+}
+
+// Code gets the status code for the schema objects groups create unauthorized response
+func (o *SchemaObjectsGroupsCreateUnauthorized) Code() int {
+	return 401
+}
+
+func (o *SchemaObjectsGroupsCreateUnauthorized) Error() string {
+	return fmt.Sprintf("[POST /schema/{className}/groups][%d] schemaObjectsGroupsCreateUnauthorized ", 401)
+}
+
+func (o *SchemaObjectsGroupsCreateUnauthorized) String() string {
+	return fmt.Sprintf("[POST /schema/{className}/groups][%d] schemaObjectsGroupsCreateUnauthorized ", 401)
+}
+
+func (o *SchemaObjectsGroupsCreateUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewSchemaObjectsGroupsCreateForbidden creates a SchemaObjectsGroupsCreateForbidden with default headers values
+func NewSchemaObjectsGroupsCreateForbidden() *SchemaObjectsGroupsCreateForbidden {
+	return &SchemaObjectsGroupsCreateForbidden{}
+}
+
+/*
+SchemaObjectsGroupsCreateForbidden describes a response with status code 403, with default header values.
+
+Forbidden
+*/
+type SchemaObjectsGroupsCreateForbidden struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this schema objects groups create forbidden response has a 2xx status code
+func (o *SchemaObjectsGroupsCreateForbidden) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this schema objects groups create forbidden response has a 3xx status code
+func (o *SchemaObjectsGroupsCreateForbidden) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this schema objects groups create forbidden response has a 4xx status code
+func (o *SchemaObjectsGroupsCreateForbidden) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this schema objects groups create forbidden response has a 5xx status code
+func (o *SchemaObjectsGroupsCreateForbidden) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this schema objects groups create forbidden response a status code equal to that given
+func (o *SchemaObjectsGroupsCreateForbidden) IsCode(code int) bool {
+	return code == 403
+}
+
+// Code gets the status code for the schema objects groups create forbidden response
+func (o *SchemaObjectsGroupsCreateForbidden) Code() int {
+	return 403
+}
+
+func (o *SchemaObjectsGroupsCreateForbidden) Error() string {
+	return fmt.Sprintf("[POST /schema/{className}/groups][%d] schemaObjectsGroupsCreateForbidden  %+v", 403, o.Payload)
+}
+
+func (o *SchemaObjectsGroupsCreateForbidden) String() string {
+	return fmt.Sprintf("[POST /schema/{className}/groups][%d] schemaObjectsGroupsCreateForbidden  %+v", 403, o.Payload)
+}
+
+func (o *SchemaObjectsGroupsCreateForbidden) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *SchemaObjectsGroupsCreateForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewSchemaObjectsGroupsCreateNotFound creates a SchemaObjectsGroupsCreateNotFound with default headers values
+func NewSchemaObjectsGroupsCreateNotFound() *SchemaObjectsGroupsCreateNotFound {
+	return &SchemaObjectsGroupsCreateNotFound{}
+}
+
+/*
+SchemaObjectsGroupsCreateNotFound describes a response with status code 404, with default header values.
+
+This collection does not exist
+*/
+type SchemaObjectsGroupsCreateNotFound struct {
+}
+
+// IsSuccess returns true when this schema objects groups create not found response has a 2xx status code
+func (o *SchemaObjectsGroupsCreateNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this schema objects groups create not found response has a 3xx status code
+func (o *SchemaObjectsGroupsCreateNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this schema objects groups create not found response has a 4xx status code
+func (o *SchemaObjectsGroupsCreateNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this schema objects groups create not found response has a 5xx status code
+func (o *SchemaObjectsGroupsCreateNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this schema objects groups create not found response a status code equal to that given
+func (o *SchemaObjectsGroupsCreateNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+// Code gets the status code for the schema objects groups create not found response
+func (o *SchemaObjectsGroupsCreateNotFound) Code() int {
+	return 404
+}
+
+func (o *SchemaObjectsGroupsCreateNotFound) Error() string {
+	return fmt.Sprintf("[POST /schema/{className}/groups][%d] schemaObjectsGroupsCreateNotFound ", 404)
+}
+
+func (o *SchemaObjectsGroupsCreateNotFound) String() string {
+	return fmt.Sprintf("[POST /schema/{className}/groups][%d] schemaObjectsGroupsCreateNotFound ", 404)
+}
+
+func (o *SchemaObjectsGroupsCreateNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewSchemaObjectsGroupsCreateUnprocessableEntity creates a SchemaObjectsGroupsCreateUnprocessableEntity with default headers values
+func NewSchemaObjectsGroupsCreateUnprocessableEntity() *SchemaObjectsGroupsCreateUnprocessableEntity {
+	return &SchemaObjectsGroupsCreateUnprocessableEntity{}
+}
+
+/*
+SchemaObjectsGroupsCreateUnprocessableEntity describes a response with status code 422, with default header values.
+
+Invalid group or unknown properties.
+*/
+type SchemaObjectsGroupsCreateUnprocessableEntity struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this schema objects groups create unprocessable entity response has a 2xx status code
+func (o *SchemaObjectsGroupsCreateUnprocessableEntity) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this schema objects groups create unprocessable entity response has a 3xx status code
+func (o *SchemaObjectsGroupsCreateUnprocessableEntity) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this schema objects groups create unprocessable entity response has a 4xx status code
+func (o *SchemaObjectsGroupsCreateUnprocessableEntity) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this schema objects groups create unprocessable entity response has a 5xx status code
+func (o *SchemaObjectsGroupsCreateUnprocessableEntity) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this schema objects groups create unprocessable entity response a status code equal to that given
+func (o *SchemaObjectsGroupsCreateUnprocessableEntity) IsCode(code int) bool {
+	return code == 422
+}
+
+// Code gets the status code for the schema objects groups create unprocessable entity response
+func (o *SchemaObjectsGroupsCreateUnprocessableEntity) Code() int {
+	return 422
+}
+
+func (o *SchemaObjectsGroupsCreateUnprocessableEntity) Error() string {
+	return fmt.Sprintf("[POST /schema/{className}/groups][%d] schemaObjectsGroupsCreateUnprocessableEntity  %+v", 422, o.Payload)
+}
+
+func (o *SchemaObjectsGroupsCreateUnprocessableEntity) String() string {
+	return fmt.Sprintf("[POST /schema/{className}/groups][%d] schemaObjectsGroupsCreateUnprocessableEntity  %+v", 422, o.Payload)
+}
+
+func (o *SchemaObjectsGroupsCreateUnprocessableEntity) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *SchemaObjectsGroupsCreateUnprocessableEntity) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewSchemaObjectsGroupsCreateInternalServerError creates a SchemaObjectsGroupsCreateInternalServerError with default headers values
+func NewSchemaObjectsGroupsCreateInternalServerError() *SchemaObjectsGroupsCreateInternalServerError {
+	return &SchemaObjectsGroupsCreateInternalServerError{}
+}
+
+/*
+SchemaObjectsGroupsCreateInternalServerError describes a response with status code 500, with default header values.
+
+An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.
+*/
+type SchemaObjectsGroupsCreateInternalServerError struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this schema objects groups create internal server error response has a 2xx status code
+func (o *SchemaObjectsGroupsCreateInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this schema objects groups create internal server error response has a 3xx status code
+func (o *SchemaObjectsGroupsCreateInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this schema objects groups create internal server error response has a 4xx status code
+func (o *SchemaObjectsGroupsCreateInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this schema objects groups create internal server error response has a 5xx status code
+func (o *SchemaObjectsGroupsCreateInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this schema objects groups create internal server error response a status code equal to that given
+func (o *SchemaObjectsGroupsCreateInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+// Code gets the status code for the schema objects groups create internal server error response
+func (o *SchemaObjectsGroupsCreateInternalServerError) Code() int {
+	return 500
+}
+
+func (o *SchemaObjectsGroupsCreateInternalServerError) Error() string {
+	return fmt.Sprintf("[POST /schema/{className}/groups][%d] schemaObjectsGroupsCreateInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *SchemaObjectsGroupsCreateInternalServerError) String() string {
+	return fmt.Sprintf("[POST /schema/{className}/groups][%d] schemaObjectsGroupsCreateInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *SchemaObjectsGroupsCreateInternalServerError) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *SchemaObjectsGroupsCreateInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,384 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package schema
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// SchemaObjectsGroupsGetReader is a Reader for the SchemaObjectsGroupsGet structure.
+type SchemaObjectsGroupsGetReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *SchemaObjectsGroupsGetReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewSchemaObjectsGroupsGetOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 401:
+		result := NewSchemaObjectsGroupsGetUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewSchemaObjectsGroupsGetForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewSchemaObjectsGroupsGetNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewSchemaObjectsGroupsGetInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewSchemaObjectsGroupsGetOK creates a SchemaObjectsGroupsGetOK with default headers values
+func NewSchemaObjectsGroupsGetOK() *SchemaObjectsGroupsGetOK {
+	return &SchemaObjectsGroupsGetOK{}
+}
+
+/*
+SchemaObjectsGroupsGetOK describes a response with status code 200, with default header values.
+
+The properties of the group, empty if no property has the group.
+*/
+type SchemaObjectsGroupsGetOK struct {
+	Payload []*models.Property
+}
+
+// IsSuccess returns true when this schema objects groups get o k response has a 2xx status code
+func (o *SchemaObjectsGroupsGetOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this schema objects groups get o k response has a 3xx status code
+func (o *SchemaObjectsGroupsGetOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this schema objects groups get o k response has a 4xx status code
+func (o *SchemaObjectsGroupsGetOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this schema objects groups get o k response has a 5xx status code
+func (o *SchemaObjectsGroupsGetOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this schema objects groups get o k response a status code equal to that given
+func (o *SchemaObjectsGroupsGetOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the schema objects groups get o k response
+func (o *SchemaObjectsGroupsGetOK) Code() int {
+	return 200
+}
+
+func (o *SchemaObjectsGroupsGetOK) Error() string {
+	return fmt.Sprintf("[GET /schema/{className}/groups/{groupName}][%d] schemaObjectsGroupsGetOK  %+v", 200, o.Payload)
+}
+
+func (o *SchemaObjectsGroupsGetOK) String() string {
+	return fmt.Sprintf("[GET /schema/{className}/groups/{groupName}][%d] schemaObjectsGroupsGetOK  %+v", 200, o.Payload)
+}
+
+func (o *SchemaObjectsGroupsGetOK) GetPayload() []*models.Property {
+	return o.Payload
+}
+
+func (o *SchemaObjectsGroupsGetOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// response payload
+	if err := consumer.Consume(response.Body(), &o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewSchemaObjectsGroupsGetUnauthorized creates a SchemaObjectsGroupsGetUnauthorized with default headers values
+func NewSchemaObjectsGroupsGetUnauthorized() *SchemaObjectsGroupsGetUnauthorized {
+	return &SchemaObjectsGroupsGetUnauthorized{}
+}
+
+/*
+SchemaObjectsGroupsGetUnauthorized describes a response with status code 401, with default header values.
+
+Unauthorized or invalid credentials.
+*/
+type SchemaObjectsGroupsGetUnauthorized struct {
+}
+
+// IsSuccess returns true when this schema objects groups get unauthorized response has a 2xx status code
+func (o *SchemaObjectsGroupsGetUnauthorized) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this schema objects groups get unauthorized response has a 3xx status code
+func (o *SchemaObjectsGroupsGetUnauthorized) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this schema objects groups get unauthorized response has a 4xx status code
+func (o *SchemaObjectsGroupsGetUnauthorized) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this schema objects groups get unauthorized response has a 5xx status code
+func (o *SchemaObjectsGroupsGetUnauthorized) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this schema objects groups get unauthorized response a status code equal to that given
+func (o *SchemaObjectsGroupsGetUnauthorized) IsCode(code int) bool {
+	return code == 401
+}
+
+// Code gets the status code for the schema objects groups get unauthorized response
+func (o *SchemaObjectsGroupsGetUnauthorized) Code() int {
+	return 401
+}
+
+func (o *SchemaObjectsGroupsGetUnauthorized) Error() string {
+	return fmt.Sprintf("[GET /schema/{className}/groups/{groupName}][%d] schemaObjectsGroupsGetUnauthorized ", 401)
+}
+
+func (o *SchemaObjectsGroupsGetUnauthorized) String() string {
+	return fmt.Sprintf("[GET /schema/{className}/groups/{groupName}][%d] schemaObjectsGroupsGetUnauthorized ", 401)
+}
+
+func (o *SchemaObjectsGroupsGetUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewSchemaObjectsGroupsGetForbidden creates a SchemaObjectsGroupsGetForbidden with default headers values
+func NewSchemaObjectsGroupsGetForbidden() *SchemaObjectsGroupsGetForbidden {
+	return &SchemaObjectsGroupsGetForbidden{}
+}
+
+/*
+SchemaObjectsGroupsGetForbidden describes a response with status code 403, with default header values.
+
+Forbidden
+*/
+type SchemaObjectsGroupsGetForbidden struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this schema objects groups get forbidden response has a 2xx status code
+func (o *SchemaObjectsGroupsGetForbidden) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this schema objects groups get forbidden response has a 3xx status code
+func (o *SchemaObjectsGroupsGetForbidden) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this schema objects groups get forbidden response has a 4xx status code
+func (o *SchemaObjectsGroupsGetForbidden) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this schema objects groups get forbidden response has a 5xx status code
+func (o *SchemaObjectsGroupsGetForbidden) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this schema objects groups get forbidden response a status code equal to that given
+func (o *SchemaObjectsGroupsGetForbidden) IsCode(code int) bool {
+	return code == 403
+}
+
+// Code gets the status code for the schema objects groups get forbidden response
+func (o *SchemaObjectsGroupsGetForbidden) Code() int {
+	return 403
+}
+
+func (o *SchemaObjectsGroupsGetForbidden) Error() string {
+	return fmt.Sprintf("[GET /schema/{className}/groups/{groupName}][%d] schemaObjectsGroupsGetForbidden  %+v", 403, o.Payload)
+}
+
+func (o *SchemaObjectsGroupsGetForbidden) String() string {
+	return fmt.Sprintf("[GET /schema/{className}/groups/{groupName}][%d] schemaObjectsGroupsGetForbidden  %+v", 403, o.Payload)
+}
+
+func (o *SchemaObjectsGroupsGetForbidden) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *SchemaObjectsGroupsGetForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewSchemaObjectsGroupsGetNotFound creates a SchemaObjectsGroupsGetNotFound with default headers values
+func NewSchemaObjectsGroupsGetNotFound() *SchemaObjectsGroupsGetNotFound {
+	return &SchemaObjectsGroupsGetNotFound{}
+}
+
+/*
+SchemaObjectsGroupsGetNotFound describes a response with status code 404, with default header values.
+
+This collection does not exist
+*/
+type SchemaObjectsGroupsGetNotFound struct {
+}
+
+// IsSuccess returns true when this schema objects groups get not found response has a 2xx status code
+func (o *SchemaObjectsGroupsGetNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this schema objects groups get not found response has a 3xx status code
+func (o *SchemaObjectsGroupsGetNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this schema objects groups get not found response has a 4xx status code
+func (o *SchemaObjectsGroupsGetNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this schema objects groups get not found response has a 5xx status code
+func (o *SchemaObjectsGroupsGetNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this schema objects groups get not found response a status code equal to that given
+func (o *SchemaObjectsGroupsGetNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+// Code gets the status code for the schema objects groups get not found response
+func (o *SchemaObjectsGroupsGetNotFound) Code() int {
+	return 404
+}
+
+func (o *SchemaObjectsGroupsGetNotFound) Error() string {
+	return fmt.Sprintf("[GET /schema/{className}/groups/{groupName}][%d] schemaObjectsGroupsGetNotFound ", 404)
+}
+
+func (o *SchemaObjectsGroupsGetNotFound) String() string {
+	return fmt.Sprintf("[GET /schema/{className}/groups/{groupName}][%d] schemaObjectsGroupsGetNotFound ", 404)
+}
+
+func (o *SchemaObjectsGroupsGetNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewSchemaObjectsGroupsGetInternalServerError creates a SchemaObjectsGroupsGetInternalServerError with default headers values
+func NewSchemaObjectsGroupsGetInternalServerError() *SchemaObjectsGroupsGetInternalServerError {
+	return &SchemaObjectsGroupsGetInternalServerError{}
+}
+
+/*
+SchemaObjectsGroupsGetInternalServerError describes a response with status code 500, with default header values.
+
+An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.
+*/
+type SchemaObjectsGroupsGetInternalServerError struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this schema objects groups get internal server error response has a 2xx status code
+func (o *SchemaObjectsGroupsGetInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this schema objects groups get internal server error response has a 3xx status code
+func (o *SchemaObjectsGroupsGetInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this schema objects groups get internal server error response has a 4xx status code
+func (o *SchemaObjectsGroupsGetInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this schema objects groups get internal server error response has a 5xx status code
+func (o *SchemaObjectsGroupsGetInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this schema objects groups get internal server error response a status code equal to that given
+func (o *SchemaObjectsGroupsGetInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+// Code gets the status code for the schema objects groups get internal server error response
+func (o *SchemaObjectsGroupsGetInternalServerError) Code() int {
+	return 500
+}
+
+func (o *SchemaObjectsGroupsGetInternalServerError) Error() string {
+	return fmt.Sprintf("[GET /schema/{className}/groups/{groupName}][%d] schemaObjectsGroupsGetInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *SchemaObjectsGroupsGetInternalServerError) String() string {
+	return fmt.Sprintf("[GET /schema/{className}/groups/{groupName}][%d] schemaObjectsGroupsGetInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *SchemaObjectsGroupsGetInternalServerError) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *SchemaObjectsGroupsGetInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,384 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package schema
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// SchemaObjectsGroupsListReader is a Reader for the SchemaObjectsGroupsList structure.
+type SchemaObjectsGroupsListReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *SchemaObjectsGroupsListReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewSchemaObjectsGroupsListOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 401:
+		result := NewSchemaObjectsGroupsListUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewSchemaObjectsGroupsListForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewSchemaObjectsGroupsListNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewSchemaObjectsGroupsListInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewSchemaObjectsGroupsListOK creates a SchemaObjectsGroupsListOK with default headers values
+func NewSchemaObjectsGroupsListOK() *SchemaObjectsGroupsListOK {
+	return &SchemaObjectsGroupsListOK{}
+}
+
+/*
+SchemaObjectsGroupsListOK describes a response with status code 200, with default header values.
+
+The groups of the properties.
+*/
+type SchemaObjectsGroupsListOK struct {
+	Payload []*models.PropertyGroup
+}
+
+// IsSuccess returns true when this schema objects groups list o k response has a 2xx status code
+func (o *SchemaObjectsGroupsListOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this schema objects groups list o k response has a 3xx status code
+func (o *SchemaObjectsGroupsListOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this schema objects groups list o k response has a 4xx status code
+func (o *SchemaObjectsGroupsListOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this schema objects groups list o k response has a 5xx status code
+func (o *SchemaObjectsGroupsListOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this schema objects groups list o k response a status code equal to that given
+func (o *SchemaObjectsGroupsListOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the schema objects groups list o k response
+func (o *SchemaObjectsGroupsListOK) Code() int {
+	return 200
+}
+
+func (o *SchemaObjectsGroupsListOK) Error() string {
+	return fmt.Sprintf("[GET /schema/{className}/groups][%d] schemaObjectsGroupsListOK  %+v", 200, o.Payload)
+}
+
+func (o *SchemaObjectsGroupsListOK) String() string {
+	return fmt.Sprintf("[GET /schema/{className}/groups][%d] schemaObjectsGroupsListOK  %+v", 200, o.Payload)
+}
+
+func (o *SchemaObjectsGroupsListOK) GetPayload() []*models.PropertyGroup {
+	return o.Payload
+}
+
+func (o *SchemaObjectsGroupsListOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// response payload
+	if err := consumer.Consume(response.Body(), &o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewSchemaObjectsGroupsListUnauthorized creates a SchemaObjectsGroupsListUnauthorized with default headers values
+func NewSchemaObjectsGroupsListUnauthorized() *SchemaObjectsGroupsListUnauthorized {
+	return &SchemaObjectsGroupsListUnauthorized{}
+}
+
+/*
+SchemaObjectsGroupsListUnauthorized describes a response with status code 401, with default header values.
+
+Unauthorized or invalid credentials.
+*/
+type SchemaObjectsGroupsListUnauthorized struct {
+}
+
+// IsSuccess returns true when this schema objects groups list unauthorized response has a 2xx status code
+func (o *SchemaObjectsGroupsListUnauthorized) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this schema objects groups list unauthorized response has a 3xx status code
+func (o *SchemaObjectsGroupsListUnauthorized) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this schema objects groups list unauthorized response has a 4xx status code
+func (o *SchemaObjectsGroupsListUnauthorized) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this schema objects groups list unauthorized response has a 5xx status code
+func (o *SchemaObjectsGroupsListUnauthorized) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this schema objects groups list unauthorized response a status code equal to that given
+func (o *SchemaObjectsGroupsListUnauthorized) IsCode(code int) bool {
+	return code == 401
+}
+
+// Code gets the status code for the schema objects groups list unauthorized response
+func (o *SchemaObjectsGroupsListUnauthorized) Code() int {
+	return 401
+}
+
+func (o *SchemaObjectsGroupsListUnauthorized) Error() string {
+	return fmt.Sprintf("[GET /schema/{className}/groups][%d] schemaObjectsGroupsListUnauthorized ", 401)
+}
+
+func (o *SchemaObjectsGroupsListUnauthorized) String() string {
+	return fmt.Sprintf("[GET /schema/{className}/groups][%d] schemaObjectsGroupsListUnauthorized ", 401)
+}
+
+func (o *SchemaObjectsGroupsListUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewSchemaObjectsGroupsListForbidden creates a SchemaObjectsGroupsListForbidden with default headers values
+func NewSchemaObjectsGroupsListForbidden() *SchemaObjectsGroupsListForbidden {
+	return &SchemaObjectsGroupsListForbidden{}
+}
+
+/*
+SchemaObjectsGroupsListForbidden describes a response with status code 403, with default header values.
+
+Forbidden
+*/
+type SchemaObjectsGroupsListForbidden struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this schema objects groups list forbidden response has a 2xx status code
+func (o *SchemaObjectsGroupsListForbidden) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this schema objects groups list forbidden response has a 3xx status code
+func (o *SchemaObjectsGroupsListForbidden) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this schema objects groups list forbidden response has a 4xx status code
+func (o *SchemaObjectsGroupsListForbidden) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this schema objects groups list forbidden response has a 5xx status code
+func (o *SchemaObjectsGroupsListForbidden) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this schema objects groups list forbidden response a status code equal to that given
+func (o *SchemaObjectsGroupsListForbidden) IsCode(code int) bool {
+	return code == 403
+}
+
+// Code gets the status code for the schema objects groups list forbidden response
+func (o *SchemaObjectsGroupsListForbidden) Code() int {
+	return 403
+}
+
+func (o *SchemaObjectsGroupsListForbidden) Error() string {
+	return fmt.Sprintf("[GET /schema/{className}/groups][%d] schemaObjectsGroupsListForbidden  %+v", 403, o.Payload)
+}
+
+func (o *SchemaObjectsGroupsListForbidden) String() string {
+	return fmt.Sprintf("[GET /schema/{className}/groups][%d] schemaObjectsGroupsListForbidden  %+v", 403, o.Payload)
+}
+
+func (o *SchemaObjectsGroupsListForbidden) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *SchemaObjectsGroupsListForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewSchemaObjectsGroupsListNotFound creates a SchemaObjectsGroupsListNotFound with default headers values
+func NewSchemaObjectsGroupsListNotFound() *SchemaObjectsGroupsListNotFound {
+	return &SchemaObjectsGroupsListNotFound{}
+}
+
+/*
+SchemaObjectsGroupsListNotFound describes a response with status code 404, with default header values.
+
+This collection does not exist
+*/
+type SchemaObjectsGroupsListNotFound struct {
+}
+
+// IsSuccess returns true when this schema objects groups list not found response has a 2xx status code
+func (o *SchemaObjectsGroupsListNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this schema objects groups list not found response has a 3xx status code
+func (o *SchemaObjectsGroupsListNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this schema objects groups list not found response has a 4xx status code
+func (o *SchemaObjectsGroupsListNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this schema objects groups list not found response has a 5xx status code
+func (o *SchemaObjectsGroupsListNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this schema objects groups list not found response a status code equal to that given
+func (o *SchemaObjectsGroupsListNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+// Code gets the status code for the schema objects groups list not found response
+func (o *SchemaObjectsGroupsListNotFound) Code() int {
+	return 404
+}
+
+func (o *SchemaObjectsGroupsListNotFound) Error() string {
+	return fmt.Sprintf("[GET /schema/{className}/groups][%d] schemaObjectsGroupsListNotFound ", 404)
+}
+
+func (o *SchemaObjectsGroupsListNotFound) String() string {
+	return fmt.Sprintf("[GET /schema/{className}/groups][%d] schemaObjectsGroupsListNotFound ", 404)
+}
+
+func (o *SchemaObjectsGroupsListNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewSchemaObjectsGroupsListInternalServerError creates a SchemaObjectsGroupsListInternalServerError with default headers values
+func NewSchemaObjectsGroupsListInternalServerError() *SchemaObjectsGroupsListInternalServerError {
+	return &SchemaObjectsGroupsListInternalServerError{}
+}
+
+/*
+SchemaObjectsGroupsListInternalServerError describes a response with status code 500, with default header values.
+
+An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.
+*/
+type SchemaObjectsGroupsListInternalServerError struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this schema objects groups list internal server error response has a 2xx status code
+func (o *SchemaObjectsGroupsListInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this schema objects groups list internal server error response has a 3xx status code
+func (o *SchemaObjectsGroupsListInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this schema objects groups list internal server error response has a 4xx status code
+func (o *SchemaObjectsGroupsListInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this schema objects groups list internal server error response has a 5xx status code
+func (o *SchemaObjectsGroupsListInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this schema objects groups list internal server error response a status code equal to that given
+func (o *SchemaObjectsGroupsListInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+// Code gets the status code for the schema objects groups list internal server error response
+func (o *SchemaObjectsGroupsListInternalServerError) Code() int {
+	return 500
+}
+
+func (o *SchemaObjectsGroupsListInternalServerError) Error() string {
+	return fmt.Sprintf("[GET /schema/{className}/groups][%d] schemaObjectsGroupsListInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *SchemaObjectsGroupsListInternalServerError) String() string {
+	return fmt.Sprintf("[GET /schema/{className}/groups][%d] schemaObjectsGroupsListInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *SchemaObjectsGroupsListInternalServerError) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *SchemaObjectsGroupsListInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,460 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package schema
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// SchemaObjectsPatchReader is a Reader for the SchemaObjectsPatch structure.
+type SchemaObjectsPatchReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *SchemaObjectsPatchReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewSchemaObjectsPatchOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 401:
+		result := NewSchemaObjectsPatchUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewSchemaObjectsPatchForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewSchemaObjectsPatchNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 422:
+		result := NewSchemaObjectsPatchUnprocessableEntity()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewSchemaObjectsPatchInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewSchemaObjectsPatchOK creates a SchemaObjectsPatchOK with default headers values
+func NewSchemaObjectsPatchOK() *SchemaObjectsPatchOK {
+	return &SchemaObjectsPatchOK{}
+}
+
+/*
+SchemaObjectsPatchOK describes a response with status code 200, with default header values.
+
+Read-only mode of the collection was changed successfully
+*/
+type SchemaObjectsPatchOK struct {
+}
+
+// IsSuccess returns true when this schema objects patch o k response has a 2xx status code
+func (o *SchemaObjectsPatchOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this schema objects patch o k response has a 3xx status code
+func (o *SchemaObjectsPatchOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this schema objects patch o k response has a 4xx status code
+func (o *SchemaObjectsPatchOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this schema objects patch o k response has a 5xx status code
+func (o *SchemaObjectsPatchOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this schema objects patch o k response a status code equal to that given
+func (o *SchemaObjectsPatchOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the schema objects patch o k response
+func (o *SchemaObjectsPatchOK) Code() int {
+	return 200
+}
+
+func (o *SchemaObjectsPatchOK) Error() string {
+	return fmt.Sprintf("[PATCH /schema/{className}][%d] schemaObjectsPatchOK ", 200)
+}
+
+func (o *SchemaObjectsPatchOK) String() string {
+	return fmt.Sprintf("[PATCH /schema/{className}][%d] schemaObjectsPatchOK ", 200)
+}
+
+func (o *SchemaObjectsPatchOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewSchemaObjectsPatchUnauthorized creates a SchemaObjectsPatchUnauthorized with default headers values
+func NewSchemaObjectsPatchUnauthorized() *SchemaObjectsPatchUnauthorized {
+	return &SchemaObjectsPatchUnauthorized{}
+}
+
+/*
+SchemaObjectsPatchUnauthorized describes a response with status code 401, with default header values.
+
+Unauthorized or invalid credentials.
+*/
+type SchemaObjectsPatchUnauthorized struct {
+}
+
+// IsSuccess returns true when this schema objects patch unauthorized response has a 2xx status code
+func (o *SchemaObjectsPatchUnauthorized) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this schema objects patch unauthorized response has a 3xx status code
+func (o *SchemaObjectsPatchUnauthorized) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this schema objects patch unauthorized response has a 4xx status code
+func (o *SchemaObjectsPatchUnauthorized) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this schema objects patch unauthorized response has a 5xx status code
+func (o *SchemaObjectsPatchUnauthorized) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this schema objects patch unauthorized response a status code equal to that given
+func (o *SchemaObjectsPatchUnauthorized) IsCode(code int) bool {
+	return code == 401
+}
+
+// Code gets the status code for the schema objects patch unauthorized response
+func (o *SchemaObjectsPatchUnauthorized) Code() int {
+	return 401
+}
+
+func (o *SchemaObjectsPatchUnauthorized) Error() string {
+	return fmt.Sprintf("[PATCH /schema/{className}][%d] schemaObjectsPatchUnauthorized ", 401)
+}
+
+func (o *SchemaObjectsPatchUnauthorized) String() string {
+	return fmt.Sprintf("[PATCH /schema/{className}][%d] schemaObjectsPatchUnauthorized ", 401)
+}
+
+func (o *SchemaObjectsPatchUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewSchemaObjectsPatchForbidden creates a SchemaObjectsPatchForbidden with default headers values
+func NewSchemaObjectsPatchForbidden() *SchemaObjectsPatchForbidden {
+	return &SchemaObjectsPatchForbidden{}
+}
+
+/*
+SchemaObjectsPatchForbidden describes a response with status code 403, with default header values.
+
+Forbidden
+*/
+type SchemaObjectsPatchForbidden struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this schema objects patch forbidden response has a 2xx status code
+func (o *SchemaObjectsPatchForbidden) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this schema objects patch forbidden response has a 3xx status code
+func (o *SchemaObjectsPatchForbidden) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this schema objects patch forbidden response has a 4xx status code
+func (o *SchemaObjectsPatchForbidden) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this schema objects patch forbidden response has a 5xx status code
+func (o *SchemaObjectsPatchForbidden) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this schema objects patch forbidden response a status code equal to that given
+func (o *SchemaObjectsPatchForbidden) IsCode(code int) bool {
+	return code == 403
+}
+
+// Code gets the status code for the schema objects patch forbidden response
+func (o *SchemaObjectsPatchForbidden) Code() int {
+	return 403
+}
+
+func (o *SchemaObjectsPatchForbidden) Error() string {
+	return fmt.Sprintf("[PATCH /schema/{className}][%d] schemaObjectsPatchForbidden  %+v", 403, o.Payload)
+}
+
+func (o *SchemaObjectsPatchForbidden) String() string {
+	return fmt.Sprintf("[PATCH /schema/{className}][%d] schemaObjectsPatchForbidden  %+v", 403, o.Payload)
+}
+
+func (o *SchemaObjectsPatchForbidden) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *SchemaObjectsPatchForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewSchemaObjectsPatchNotFound creates a SchemaObjectsPatchNotFound with default headers values
+func NewSchemaObjectsPatchNotFound() *SchemaObjectsPatchNotFound {
+	return &SchemaObjectsPatchNotFound{}
+}
+
+/*
+SchemaObjectsPatchNotFound describes a response with status code 404, with default header values.
+
+Collection does not exist
+*/
+type SchemaObjectsPatchNotFound struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this schema objects patch not found response has a 2xx status code
+func (o *SchemaObjectsPatchNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this schema objects patch not found response has a 3xx status code
+func (o *SchemaObjectsPatchNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this schema objects patch not found response has a 4xx status code
+func (o *SchemaObjectsPatchNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this schema objects patch not found response has a 5xx status code
+func (o *SchemaObjectsPatchNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this schema objects patch not found response a status code equal to that given
+func (o *SchemaObjectsPatchNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+// Code gets the status code for the schema objects patch not found response
+func (o *SchemaObjectsPatchNotFound) Code() int {
+	return 404
+}
+
+func (o *SchemaObjectsPatchNotFound) Error() string {
+	return fmt.Sprintf("[PATCH /schema/{className}][%d] schemaObjectsPatchNotFound  %+v", 404, o.Payload)
+}
+
+func (o *SchemaObjectsPatchNotFound) String() string {
+	return fmt.Sprintf("[PATCH /schema/{className}][%d] schemaObjectsPatchNotFound  %+v", 404, o.Payload)
+}
+
+func (o *SchemaObjectsPatchNotFound) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *SchemaObjectsPatchNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewSchemaObjectsPatchUnprocessableEntity creates a SchemaObjectsPatchUnprocessableEntity with default headers values
+func NewSchemaObjectsPatchUnprocessableEntity() *SchemaObjectsPatchUnprocessableEntity {
+	return &SchemaObjectsPatchUnprocessableEntity{}
+}
+
+/*
+SchemaObjectsPatchUnprocessableEntity describes a response with status code 422, with default header values.
+
+Invalid update attempt
+*/
+type SchemaObjectsPatchUnprocessableEntity struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this schema objects patch unprocessable entity response has a 2xx status code
+func (o *SchemaObjectsPatchUnprocessableEntity) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this schema objects patch unprocessable entity response has a 3xx status code
+func (o *SchemaObjectsPatchUnprocessableEntity) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this schema objects patch unprocessable entity response has a 4xx status code
+func (o *SchemaObjectsPatchUnprocessableEntity) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this schema objects patch unprocessable entity response has a 5xx status code
+func (o *SchemaObjectsPatchUnprocessableEntity) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this schema objects patch unprocessable entity response a status code equal to that given
+func (o *SchemaObjectsPatchUnprocessableEntity) IsCode(code int) bool {
+	return code == 422
+}
+
+// Code gets the status code for the schema objects patch unprocessable entity response
+func (o *SchemaObjectsPatchUnprocessableEntity) Code() int {
+	return 422
+}
+
+func (o *SchemaObjectsPatchUnprocessableEntity) Error() string {
+	return fmt.Sprintf("[PATCH /schema/{className}][%d] schemaObjectsPatchUnprocessableEntity  %+v", 422, o.Payload)
+}
+
+func (o *SchemaObjectsPatchUnprocessableEntity) String() string {
+	return fmt.Sprintf("[PATCH /schema/{className}][%d] schemaObjectsPatchUnprocessableEntity  %+v", 422, o.Payload)
+}
+
+func (o *SchemaObjectsPatchUnprocessableEntity) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *SchemaObjectsPatchUnprocessableEntity) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewSchemaObjectsPatchInternalServerError creates a SchemaObjectsPatchInternalServerError with default headers values
+func NewSchemaObjectsPatchInternalServerError() *SchemaObjectsPatchInternalServerError {
+	return &SchemaObjectsPatchInternalServerError{}
+}
+
+/*
+SchemaObjectsPatchInternalServerError describes a response with status code 500, with default header values.
+
+An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.
+*/
+type SchemaObjectsPatchInternalServerError struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this schema objects patch internal server error response has a 2xx status code
+func (o *SchemaObjectsPatchInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this schema objects patch internal server error response has a 3xx status code
+func (o *SchemaObjectsPatchInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this schema objects patch internal server error response has a 4xx status code
+func (o *SchemaObjectsPatchInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this schema objects patch internal server error response has a 5xx status code
+func (o *SchemaObjectsPatchInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this schema objects patch internal server error response a status code equal to that given
+func (o *SchemaObjectsPatchInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+// Code gets the status code for the schema objects patch internal server error response
+func (o *SchemaObjectsPatchInternalServerError) Code() int {
+	return 500
+}
+
+func (o *SchemaObjectsPatchInternalServerError) Error() string {
+	return fmt.Sprintf("[PATCH /schema/{className}][%d] schemaObjectsPatchInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *SchemaObjectsPatchInternalServerError) String() string {
+	return fmt.Sprintf("[PATCH /schema/{className}][%d] schemaObjectsPatchInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *SchemaObjectsPatchInternalServerError) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *SchemaObjectsPatchInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -77,9 +77,18 @@ func NewSchemaObjectsPropertiesAddOK() *SchemaObjectsPropertiesAddOK {
 /*
 SchemaObjectsPropertiesAddOK describes a response with status code 200, with default header values.
 
-Added the property.
+Added the property. The property is returned as stored, with the defaults applied to the fields the request left unset.
 */
 type SchemaObjectsPropertiesAddOK struct {
+
+	/* Why the job setting the `defaultValue` of the property on the existing objects could not be started. The property is added nonetheless.
+	 */
+	XWeaviateBackfillError string
+
+	/* ID of the job setting the `defaultValue` of the property on the existing objects. Only set if the property has a `defaultValue`.
+	 */
+	XWeaviateBackfillJobID string
+
 	Payload *models.Property
 }
 
@@ -127,6 +136,20 @@ func (o *SchemaObjectsPropertiesAddOK) GetPayload() *models.Property {
 
 func (o *SchemaObjectsPropertiesAddOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
 
+	// hydrates response header x-weaviate-backfill-error
+	hdrXWeaviateBackfillError := response.GetHeader("x-weaviate-backfill-error")
+
+	if hdrXWeaviateBackfillError != "" {
+		o.XWeaviateBackfillError = hdrXWeaviateBackfillError
+	}
+
+	// hydrates response header x-weaviate-backfill-job-id
+	hdrXWeaviateBackfillJobID := response.GetHeader("x-weaviate-backfill-job-id")
+
+	if hdrXWeaviateBackfillJobID != "" {
+		o.XWeaviateBackfillJobID = hdrXWeaviateBackfillJobID
+	}
+
 	o.Payload = new(models.Property)
 
 	// response payload
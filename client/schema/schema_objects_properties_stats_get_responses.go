@@ -0,0 +1,460 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package schema
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// SchemaObjectsPropertiesStatsGetReader is a Reader for the SchemaObjectsPropertiesStatsGet structure.
+type SchemaObjectsPropertiesStatsGetReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *SchemaObjectsPropertiesStatsGetReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewSchemaObjectsPropertiesStatsGetOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 401:
+		result := NewSchemaObjectsPropertiesStatsGetUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewSchemaObjectsPropertiesStatsGetForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewSchemaObjectsPropertiesStatsGetNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 422:
+		result := NewSchemaObjectsPropertiesStatsGetUnprocessableEntity()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewSchemaObjectsPropertiesStatsGetInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewSchemaObjectsPropertiesStatsGetOK creates a SchemaObjectsPropertiesStatsGetOK with default headers values
+func NewSchemaObjectsPropertiesStatsGetOK() *SchemaObjectsPropertiesStatsGetOK {
+	return &SchemaObjectsPropertiesStatsGetOK{}
+}
+
+/*
+SchemaObjectsPropertiesStatsGetOK describes a response with status code 200, with default header values.
+
+The stats of the property.
+*/
+type SchemaObjectsPropertiesStatsGetOK struct {
+	Payload *models.PropertyStats
+}
+
+// IsSuccess returns true when this schema objects properties stats get o k response has a 2xx status code
+func (o *SchemaObjectsPropertiesStatsGetOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this schema objects properties stats get o k response has a 3xx status code
+func (o *SchemaObjectsPropertiesStatsGetOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this schema objects properties stats get o k response has a 4xx status code
+func (o *SchemaObjectsPropertiesStatsGetOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this schema objects properties stats get o k response has a 5xx status code
+func (o *SchemaObjectsPropertiesStatsGetOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this schema objects properties stats get o k response a status code equal to that given
+func (o *SchemaObjectsPropertiesStatsGetOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the schema objects properties stats get o k response
+func (o *SchemaObjectsPropertiesStatsGetOK) Code() int {
+	return 200
+}
+
+func (o *SchemaObjectsPropertiesStatsGetOK) Error() string {
+	return fmt.Sprintf("[GET /schema/{className}/properties/{propertyName}/stats][%d] schemaObjectsPropertiesStatsGetOK  %+v", 200, o.Payload)
+}
+
+func (o *SchemaObjectsPropertiesStatsGetOK) String() string {
+	return fmt.Sprintf("[GET /schema/{className}/properties/{propertyName}/stats][%d] schemaObjectsPropertiesStatsGetOK  %+v", 200, o.Payload)
+}
+
+func (o *SchemaObjectsPropertiesStatsGetOK) GetPayload() *models.PropertyStats {
+	return o.Payload
+}
+
+func (o *SchemaObjectsPropertiesStatsGetOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.PropertyStats)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewSchemaObjectsPropertiesStatsGetUnauthorized creates a SchemaObjectsPropertiesStatsGetUnauthorized with default headers values
+func NewSchemaObjectsPropertiesStatsGetUnauthorized() *SchemaObjectsPropertiesStatsGetUnauthorized {
+	return &SchemaObjectsPropertiesStatsGetUnauthorized{}
+}
+
+/*
+SchemaObjectsPropertiesStatsGetUnauthorized describes a response with status code 401, with default header values.
+
+Unauthorized or invalid credentials.
+*/
+type SchemaObjectsPropertiesStatsGetUnauthorized struct {
+}
+
+// IsSuccess returns true when this schema objects properties stats get unauthorized response has a 2xx status code
+func (o *SchemaObjectsPropertiesStatsGetUnauthorized) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this schema objects properties stats get unauthorized response has a 3xx status code
+func (o *SchemaObjectsPropertiesStatsGetUnauthorized) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this schema objects properties stats get unauthorized response has a 4xx status code
+func (o *SchemaObjectsPropertiesStatsGetUnauthorized) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this schema objects properties stats get unauthorized response has a 5xx status code
+func (o *SchemaObjectsPropertiesStatsGetUnauthorized) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this schema objects properties stats get unauthorized response a status code equal to that given
+func (o *SchemaObjectsPropertiesStatsGetUnauthorized) IsCode(code int) bool {
+	return code == 401
+}
+
+// Code gets the status code for the schema objects properties stats get unauthorized response
+func (o *SchemaObjectsPropertiesStatsGetUnauthorized) Code() int {
+	return 401
+}
+
+func (o *SchemaObjectsPropertiesStatsGetUnauthorized) Error() string {
+	return fmt.Sprintf("[GET /schema/{className}/properties/{propertyName}/stats][%d] schemaObjectsPropertiesStatsGetUnauthorized ", 401)
+}
+
+func (o *SchemaObjectsPropertiesStatsGetUnauthorized) String() string {
+	return fmt.Sprintf("[GET /schema/{className}/properties/{propertyName}/stats][%d] schemaObjectsPropertiesStatsGetUnauthorized ", 401)
+}
+
+func (o *SchemaObjectsPropertiesStatsGetUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewSchemaObjectsPropertiesStatsGetForbidden creates a SchemaObjectsPropertiesStatsGetForbidden with default headers values
+func NewSchemaObjectsPropertiesStatsGetForbidden() *SchemaObjectsPropertiesStatsGetForbidden {
+	return &SchemaObjectsPropertiesStatsGetForbidden{}
+}
+
+/*
+SchemaObjectsPropertiesStatsGetForbidden describes a response with status code 403, with default header values.
+
+Forbidden
+*/
+type SchemaObjectsPropertiesStatsGetForbidden struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this schema objects properties stats get forbidden response has a 2xx status code
+func (o *SchemaObjectsPropertiesStatsGetForbidden) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this schema objects properties stats get forbidden response has a 3xx status code
+func (o *SchemaObjectsPropertiesStatsGetForbidden) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this schema objects properties stats get forbidden response has a 4xx status code
+func (o *SchemaObjectsPropertiesStatsGetForbidden) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this schema objects properties stats get forbidden response has a 5xx status code
+func (o *SchemaObjectsPropertiesStatsGetForbidden) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this schema objects properties stats get forbidden response a status code equal to that given
+func (o *SchemaObjectsPropertiesStatsGetForbidden) IsCode(code int) bool {
+	return code == 403
+}
+
+// Code gets the status code for the schema objects properties stats get forbidden response
+func (o *SchemaObjectsPropertiesStatsGetForbidden) Code() int {
+	return 403
+}
+
+func (o *SchemaObjectsPropertiesStatsGetForbidden) Error() string {
+	return fmt.Sprintf("[GET /schema/{className}/properties/{propertyName}/stats][%d] schemaObjectsPropertiesStatsGetForbidden  %+v", 403, o.Payload)
+}
+
+func (o *SchemaObjectsPropertiesStatsGetForbidden) String() string {
+	return fmt.Sprintf("[GET /schema/{className}/properties/{propertyName}/stats][%d] schemaObjectsPropertiesStatsGetForbidden  %+v", 403, o.Payload)
+}
+
+func (o *SchemaObjectsPropertiesStatsGetForbidden) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *SchemaObjectsPropertiesStatsGetForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewSchemaObjectsPropertiesStatsGetNotFound creates a SchemaObjectsPropertiesStatsGetNotFound with default headers values
+func NewSchemaObjectsPropertiesStatsGetNotFound() *SchemaObjectsPropertiesStatsGetNotFound {
+	return &SchemaObjectsPropertiesStatsGetNotFound{}
+}
+
+/*
+SchemaObjectsPropertiesStatsGetNotFound describes a response with status code 404, with default header values.
+
+This collection or property does not exist
+*/
+type SchemaObjectsPropertiesStatsGetNotFound struct {
+}
+
+// IsSuccess returns true when this schema objects properties stats get not found response has a 2xx status code
+func (o *SchemaObjectsPropertiesStatsGetNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this schema objects properties stats get not found response has a 3xx status code
+func (o *SchemaObjectsPropertiesStatsGetNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this schema objects properties stats get not found response has a 4xx status code
+func (o *SchemaObjectsPropertiesStatsGetNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this schema objects properties stats get not found response has a 5xx status code
+func (o *SchemaObjectsPropertiesStatsGetNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this schema objects properties stats get not found response a status code equal to that given
+func (o *SchemaObjectsPropertiesStatsGetNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+// Code gets the status code for the schema objects properties stats get not found response
+func (o *SchemaObjectsPropertiesStatsGetNotFound) Code() int {
+	return 404
+}
+
+func (o *SchemaObjectsPropertiesStatsGetNotFound) Error() string {
+	return fmt.Sprintf("[GET /schema/{className}/properties/{propertyName}/stats][%d] schemaObjectsPropertiesStatsGetNotFound ", 404)
+}
+
+func (o *SchemaObjectsPropertiesStatsGetNotFound) String() string {
+	return fmt.Sprintf("[GET /schema/{className}/properties/{propertyName}/stats][%d] schemaObjectsPropertiesStatsGetNotFound ", 404)
+}
+
+func (o *SchemaObjectsPropertiesStatsGetNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewSchemaObjectsPropertiesStatsGetUnprocessableEntity creates a SchemaObjectsPropertiesStatsGetUnprocessableEntity with default headers values
+func NewSchemaObjectsPropertiesStatsGetUnprocessableEntity() *SchemaObjectsPropertiesStatsGetUnprocessableEntity {
+	return &SchemaObjectsPropertiesStatsGetUnprocessableEntity{}
+}
+
+/*
+SchemaObjectsPropertiesStatsGetUnprocessableEntity describes a response with status code 422, with default header values.
+
+The property has no filterable index or a data type which is not supported.
+*/
+type SchemaObjectsPropertiesStatsGetUnprocessableEntity struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this schema objects properties stats get unprocessable entity response has a 2xx status code
+func (o *SchemaObjectsPropertiesStatsGetUnprocessableEntity) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this schema objects properties stats get unprocessable entity response has a 3xx status code
+func (o *SchemaObjectsPropertiesStatsGetUnprocessableEntity) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this schema objects properties stats get unprocessable entity response has a 4xx status code
+func (o *SchemaObjectsPropertiesStatsGetUnprocessableEntity) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this schema objects properties stats get unprocessable entity response has a 5xx status code
+func (o *SchemaObjectsPropertiesStatsGetUnprocessableEntity) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this schema objects properties stats get unprocessable entity response a status code equal to that given
+func (o *SchemaObjectsPropertiesStatsGetUnprocessableEntity) IsCode(code int) bool {
+	return code == 422
+}
+
+// Code gets the status code for the schema objects properties stats get unprocessable entity response
+func (o *SchemaObjectsPropertiesStatsGetUnprocessableEntity) Code() int {
+	return 422
+}
+
+func (o *SchemaObjectsPropertiesStatsGetUnprocessableEntity) Error() string {
+	return fmt.Sprintf("[GET /schema/{className}/properties/{propertyName}/stats][%d] schemaObjectsPropertiesStatsGetUnprocessableEntity  %+v", 422, o.Payload)
+}
+
+func (o *SchemaObjectsPropertiesStatsGetUnprocessableEntity) String() string {
+	return fmt.Sprintf("[GET /schema/{className}/properties/{propertyName}/stats][%d] schemaObjectsPropertiesStatsGetUnprocessableEntity  %+v", 422, o.Payload)
+}
+
+func (o *SchemaObjectsPropertiesStatsGetUnprocessableEntity) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *SchemaObjectsPropertiesStatsGetUnprocessableEntity) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewSchemaObjectsPropertiesStatsGetInternalServerError creates a SchemaObjectsPropertiesStatsGetInternalServerError with default headers values
+func NewSchemaObjectsPropertiesStatsGetInternalServerError() *SchemaObjectsPropertiesStatsGetInternalServerError {
+	return &SchemaObjectsPropertiesStatsGetInternalServerError{}
+}
+
+/*
+SchemaObjectsPropertiesStatsGetInternalServerError describes a response with status code 500, with default header values.
+
+An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.
+*/
+type SchemaObjectsPropertiesStatsGetInternalServerError struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this schema objects properties stats get internal server error response has a 2xx status code
+func (o *SchemaObjectsPropertiesStatsGetInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this schema objects properties stats get internal server error response has a 3xx status code
+func (o *SchemaObjectsPropertiesStatsGetInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this schema objects properties stats get internal server error response has a 4xx status code
+func (o *SchemaObjectsPropertiesStatsGetInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this schema objects properties stats get internal server error response has a 5xx status code
+func (o *SchemaObjectsPropertiesStatsGetInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this schema objects properties stats get internal server error response a status code equal to that given
+func (o *SchemaObjectsPropertiesStatsGetInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+// Code gets the status code for the schema objects properties stats get internal server error response
+func (o *SchemaObjectsPropertiesStatsGetInternalServerError) Code() int {
+	return 500
+}
+
+func (o *SchemaObjectsPropertiesStatsGetInternalServerError) Error() string {
+	return fmt.Sprintf("[GET /schema/{className}/properties/{propertyName}/stats][%d] schemaObjectsPropertiesStatsGetInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *SchemaObjectsPropertiesStatsGetInternalServerError) String() string {
+	return fmt.Sprintf("[GET /schema/{className}/properties/{propertyName}/stats][%d] schemaObjectsPropertiesStatsGetInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *SchemaObjectsPropertiesStatsGetInternalServerError) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *SchemaObjectsPropertiesStatsGetInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
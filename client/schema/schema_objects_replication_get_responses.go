@@ -0,0 +1,458 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package schema
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// SchemaObjectsReplicationGetReader is a Reader for the SchemaObjectsReplicationGet structure.
+type SchemaObjectsReplicationGetReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *SchemaObjectsReplicationGetReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewSchemaObjectsReplicationGetOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 401:
+		result := NewSchemaObjectsReplicationGetUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewSchemaObjectsReplicationGetForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewSchemaObjectsReplicationGetNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 422:
+		result := NewSchemaObjectsReplicationGetUnprocessableEntity()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewSchemaObjectsReplicationGetInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewSchemaObjectsReplicationGetOK creates a SchemaObjectsReplicationGetOK with default headers values
+func NewSchemaObjectsReplicationGetOK() *SchemaObjectsReplicationGetOK {
+	return &SchemaObjectsReplicationGetOK{}
+}
+
+/*
+SchemaObjectsReplicationGetOK describes a response with status code 200, with default header values.
+
+The replication status of the shards, ordered by shard name.
+*/
+type SchemaObjectsReplicationGetOK struct {
+	Payload []*models.ShardReplicationStatus
+}
+
+// IsSuccess returns true when this schema objects replication get o k response has a 2xx status code
+func (o *SchemaObjectsReplicationGetOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this schema objects replication get o k response has a 3xx status code
+func (o *SchemaObjectsReplicationGetOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this schema objects replication get o k response has a 4xx status code
+func (o *SchemaObjectsReplicationGetOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this schema objects replication get o k response has a 5xx status code
+func (o *SchemaObjectsReplicationGetOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this schema objects replication get o k response a status code equal to that given
+func (o *SchemaObjectsReplicationGetOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the schema objects replication get o k response
+func (o *SchemaObjectsReplicationGetOK) Code() int {
+	return 200
+}
+
+func (o *SchemaObjectsReplicationGetOK) Error() string {
+	return fmt.Sprintf("[GET /schema/{className}/replication][%d] schemaObjectsReplicationGetOK  %+v", 200, o.Payload)
+}
+
+func (o *SchemaObjectsReplicationGetOK) String() string {
+	return fmt.Sprintf("[GET /schema/{className}/replication][%d] schemaObjectsReplicationGetOK  %+v", 200, o.Payload)
+}
+
+func (o *SchemaObjectsReplicationGetOK) GetPayload() []*models.ShardReplicationStatus {
+	return o.Payload
+}
+
+func (o *SchemaObjectsReplicationGetOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// response payload
+	if err := consumer.Consume(response.Body(), &o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewSchemaObjectsReplicationGetUnauthorized creates a SchemaObjectsReplicationGetUnauthorized with default headers values
+func NewSchemaObjectsReplicationGetUnauthorized() *SchemaObjectsReplicationGetUnauthorized {
+	return &SchemaObjectsReplicationGetUnauthorized{}
+}
+
+/*
+SchemaObjectsReplicationGetUnauthorized describes a response with status code 401, with default header values.
+
+Unauthorized or invalid credentials.
+*/
+type SchemaObjectsReplicationGetUnauthorized struct {
+}
+
+// IsSuccess returns true when this schema objects replication get unauthorized response has a 2xx status code
+func (o *SchemaObjectsReplicationGetUnauthorized) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this schema objects replication get unauthorized response has a 3xx status code
+func (o *SchemaObjectsReplicationGetUnauthorized) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this schema objects replication get unauthorized response has a 4xx status code
+func (o *SchemaObjectsReplicationGetUnauthorized) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this schema objects replication get unauthorized response has a 5xx status code
+func (o *SchemaObjectsReplicationGetUnauthorized) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this schema objects replication get unauthorized response a status code equal to that given
+func (o *SchemaObjectsReplicationGetUnauthorized) IsCode(code int) bool {
+	return code == 401
+}
+
+// Code gets the status code for the schema objects replication get unauthorized response
+func (o *SchemaObjectsReplicationGetUnauthorized) Code() int {
+	return 401
+}
+
+func (o *SchemaObjectsReplicationGetUnauthorized) Error() string {
+	return fmt.Sprintf("[GET /schema/{className}/replication][%d] schemaObjectsReplicationGetUnauthorized ", 401)
+}
+
+func (o *SchemaObjectsReplicationGetUnauthorized) String() string {
+	return fmt.Sprintf("[GET /schema/{className}/replication][%d] schemaObjectsReplicationGetUnauthorized ", 401)
+}
+
+func (o *SchemaObjectsReplicationGetUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewSchemaObjectsReplicationGetForbidden creates a SchemaObjectsReplicationGetForbidden with default headers values
+func NewSchemaObjectsReplicationGetForbidden() *SchemaObjectsReplicationGetForbidden {
+	return &SchemaObjectsReplicationGetForbidden{}
+}
+
+/*
+SchemaObjectsReplicationGetForbidden describes a response with status code 403, with default header values.
+
+Forbidden
+*/
+type SchemaObjectsReplicationGetForbidden struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this schema objects replication get forbidden response has a 2xx status code
+func (o *SchemaObjectsReplicationGetForbidden) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this schema objects replication get forbidden response has a 3xx status code
+func (o *SchemaObjectsReplicationGetForbidden) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this schema objects replication get forbidden response has a 4xx status code
+func (o *SchemaObjectsReplicationGetForbidden) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this schema objects replication get forbidden response has a 5xx status code
+func (o *SchemaObjectsReplicationGetForbidden) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this schema objects replication get forbidden response a status code equal to that given
+func (o *SchemaObjectsReplicationGetForbidden) IsCode(code int) bool {
+	return code == 403
+}
+
+// Code gets the status code for the schema objects replication get forbidden response
+func (o *SchemaObjectsReplicationGetForbidden) Code() int {
+	return 403
+}
+
+func (o *SchemaObjectsReplicationGetForbidden) Error() string {
+	return fmt.Sprintf("[GET /schema/{className}/replication][%d] schemaObjectsReplicationGetForbidden  %+v", 403, o.Payload)
+}
+
+func (o *SchemaObjectsReplicationGetForbidden) String() string {
+	return fmt.Sprintf("[GET /schema/{className}/replication][%d] schemaObjectsReplicationGetForbidden  %+v", 403, o.Payload)
+}
+
+func (o *SchemaObjectsReplicationGetForbidden) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *SchemaObjectsReplicationGetForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewSchemaObjectsReplicationGetNotFound creates a SchemaObjectsReplicationGetNotFound with default headers values
+func NewSchemaObjectsReplicationGetNotFound() *SchemaObjectsReplicationGetNotFound {
+	return &SchemaObjectsReplicationGetNotFound{}
+}
+
+/*
+SchemaObjectsReplicationGetNotFound describes a response with status code 404, with default header values.
+
+This collection does not exist
+*/
+type SchemaObjectsReplicationGetNotFound struct {
+}
+
+// IsSuccess returns true when this schema objects replication get not found response has a 2xx status code
+func (o *SchemaObjectsReplicationGetNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this schema objects replication get not found response has a 3xx status code
+func (o *SchemaObjectsReplicationGetNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this schema objects replication get not found response has a 4xx status code
+func (o *SchemaObjectsReplicationGetNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this schema objects replication get not found response has a 5xx status code
+func (o *SchemaObjectsReplicationGetNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this schema objects replication get not found response a status code equal to that given
+func (o *SchemaObjectsReplicationGetNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+// Code gets the status code for the schema objects replication get not found response
+func (o *SchemaObjectsReplicationGetNotFound) Code() int {
+	return 404
+}
+
+func (o *SchemaObjectsReplicationGetNotFound) Error() string {
+	return fmt.Sprintf("[GET /schema/{className}/replication][%d] schemaObjectsReplicationGetNotFound ", 404)
+}
+
+func (o *SchemaObjectsReplicationGetNotFound) String() string {
+	return fmt.Sprintf("[GET /schema/{className}/replication][%d] schemaObjectsReplicationGetNotFound ", 404)
+}
+
+func (o *SchemaObjectsReplicationGetNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewSchemaObjectsReplicationGetUnprocessableEntity creates a SchemaObjectsReplicationGetUnprocessableEntity with default headers values
+func NewSchemaObjectsReplicationGetUnprocessableEntity() *SchemaObjectsReplicationGetUnprocessableEntity {
+	return &SchemaObjectsReplicationGetUnprocessableEntity{}
+}
+
+/*
+SchemaObjectsReplicationGetUnprocessableEntity describes a response with status code 422, with default header values.
+
+The collection has a replication factor of 1.
+*/
+type SchemaObjectsReplicationGetUnprocessableEntity struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this schema objects replication get unprocessable entity response has a 2xx status code
+func (o *SchemaObjectsReplicationGetUnprocessableEntity) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this schema objects replication get unprocessable entity response has a 3xx status code
+func (o *SchemaObjectsReplicationGetUnprocessableEntity) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this schema objects replication get unprocessable entity response has a 4xx status code
+func (o *SchemaObjectsReplicationGetUnprocessableEntity) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this schema objects replication get unprocessable entity response has a 5xx status code
+func (o *SchemaObjectsReplicationGetUnprocessableEntity) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this schema objects replication get unprocessable entity response a status code equal to that given
+func (o *SchemaObjectsReplicationGetUnprocessableEntity) IsCode(code int) bool {
+	return code == 422
+}
+
+// Code gets the status code for the schema objects replication get unprocessable entity response
+func (o *SchemaObjectsReplicationGetUnprocessableEntity) Code() int {
+	return 422
+}
+
+func (o *SchemaObjectsReplicationGetUnprocessableEntity) Error() string {
+	return fmt.Sprintf("[GET /schema/{className}/replication][%d] schemaObjectsReplicationGetUnprocessableEntity  %+v", 422, o.Payload)
+}
+
+func (o *SchemaObjectsReplicationGetUnprocessableEntity) String() string {
+	return fmt.Sprintf("[GET /schema/{className}/replication][%d] schemaObjectsReplicationGetUnprocessableEntity  %+v", 422, o.Payload)
+}
+
+func (o *SchemaObjectsReplicationGetUnprocessableEntity) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *SchemaObjectsReplicationGetUnprocessableEntity) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewSchemaObjectsReplicationGetInternalServerError creates a SchemaObjectsReplicationGetInternalServerError with default headers values
+func NewSchemaObjectsReplicationGetInternalServerError() *SchemaObjectsReplicationGetInternalServerError {
+	return &SchemaObjectsReplicationGetInternalServerError{}
+}
+
+/*
+SchemaObjectsReplicationGetInternalServerError describes a response with status code 500, with default header values.
+
+An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.
+*/
+type SchemaObjectsReplicationGetInternalServerError struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this schema objects replication get internal server error response has a 2xx status code
+func (o *SchemaObjectsReplicationGetInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this schema objects replication get internal server error response has a 3xx status code
+func (o *SchemaObjectsReplicationGetInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this schema objects replication get internal server error response has a 4xx status code
+func (o *SchemaObjectsReplicationGetInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this schema objects replication get internal server error response has a 5xx status code
+func (o *SchemaObjectsReplicationGetInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this schema objects replication get internal server error response a status code equal to that given
+func (o *SchemaObjectsReplicationGetInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+// Code gets the status code for the schema objects replication get internal server error response
+func (o *SchemaObjectsReplicationGetInternalServerError) Code() int {
+	return 500
+}
+
+func (o *SchemaObjectsReplicationGetInternalServerError) Error() string {
+	return fmt.Sprintf("[GET /schema/{className}/replication][%d] schemaObjectsReplicationGetInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *SchemaObjectsReplicationGetInternalServerError) String() string {
+	return fmt.Sprintf("[GET /schema/{className}/replication][%d] schemaObjectsReplicationGetInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *SchemaObjectsReplicationGetInternalServerError) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *SchemaObjectsReplicationGetInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
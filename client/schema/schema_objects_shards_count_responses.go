@@ -0,0 +1,398 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package schema
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// SchemaObjectsShardsCountReader is a Reader for the SchemaObjectsShardsCount structure.
+type SchemaObjectsShardsCountReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *SchemaObjectsShardsCountReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewSchemaObjectsShardsCountOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 401:
+		result := NewSchemaObjectsShardsCountUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewSchemaObjectsShardsCountForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewSchemaObjectsShardsCountNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewSchemaObjectsShardsCountInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewSchemaObjectsShardsCountOK creates a SchemaObjectsShardsCountOK with default headers values
+func NewSchemaObjectsShardsCountOK() *SchemaObjectsShardsCountOK {
+	return &SchemaObjectsShardsCountOK{}
+}
+
+/*
+SchemaObjectsShardsCountOK describes a response with status code 200, with default header values.
+
+Found the object count of the shard, returned as body
+*/
+type SchemaObjectsShardsCountOK struct {
+	Payload *models.ShardObjectCount
+}
+
+// IsSuccess returns true when this schema objects shards count o k response has a 2xx status code
+func (o *SchemaObjectsShardsCountOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this schema objects shards count o k response has a 3xx status code
+func (o *SchemaObjectsShardsCountOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this schema objects shards count o k response has a 4xx status code
+func (o *SchemaObjectsShardsCountOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this schema objects shards count o k response has a 5xx status code
+func (o *SchemaObjectsShardsCountOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this schema objects shards count o k response a status code equal to that given
+func (o *SchemaObjectsShardsCountOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the schema objects shards count o k response
+func (o *SchemaObjectsShardsCountOK) Code() int {
+	return 200
+}
+
+func (o *SchemaObjectsShardsCountOK) Error() string {
+	return fmt.Sprintf("[GET /schema/{className}/shards/{shardName}/count][%d] schemaObjectsShardsCountOK  %+v", 200, o.Payload)
+}
+
+func (o *SchemaObjectsShardsCountOK) String() string {
+	return fmt.Sprintf("[GET /schema/{className}/shards/{shardName}/count][%d] schemaObjectsShardsCountOK  %+v", 200, o.Payload)
+}
+
+func (o *SchemaObjectsShardsCountOK) GetPayload() *models.ShardObjectCount {
+	return o.Payload
+}
+
+func (o *SchemaObjectsShardsCountOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ShardObjectCount)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewSchemaObjectsShardsCountUnauthorized creates a SchemaObjectsShardsCountUnauthorized with default headers values
+func NewSchemaObjectsShardsCountUnauthorized() *SchemaObjectsShardsCountUnauthorized {
+	return &SchemaObjectsShardsCountUnauthorized{}
+}
+
+/*
+SchemaObjectsShardsCountUnauthorized describes a response with status code 401, with default header values.
+
+Unauthorized or invalid credentials.
+*/
+type SchemaObjectsShardsCountUnauthorized struct {
+}
+
+// IsSuccess returns true when this schema objects shards count unauthorized response has a 2xx status code
+func (o *SchemaObjectsShardsCountUnauthorized) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this schema objects shards count unauthorized response has a 3xx status code
+func (o *SchemaObjectsShardsCountUnauthorized) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this schema objects shards count unauthorized response has a 4xx status code
+func (o *SchemaObjectsShardsCountUnauthorized) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this schema objects shards count unauthorized response has a 5xx status code
+func (o *SchemaObjectsShardsCountUnauthorized) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this schema objects shards count unauthorized response a status code equal to that given
+func (o *SchemaObjectsShardsCountUnauthorized) IsCode(code int) bool {
+	return code == 401
+}
+
+// Code gets the status code for the schema objects shards count unauthorized response
+func (o *SchemaObjectsShardsCountUnauthorized) Code() int {
+	return 401
+}
+
+func (o *SchemaObjectsShardsCountUnauthorized) Error() string {
+	return fmt.Sprintf("[GET /schema/{className}/shards/{shardName}/count][%d] schemaObjectsShardsCountUnauthorized ", 401)
+}
+
+func (o *SchemaObjectsShardsCountUnauthorized) String() string {
+	return fmt.Sprintf("[GET /schema/{className}/shards/{shardName}/count][%d] schemaObjectsShardsCountUnauthorized ", 401)
+}
+
+func (o *SchemaObjectsShardsCountUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewSchemaObjectsShardsCountForbidden creates a SchemaObjectsShardsCountForbidden with default headers values
+func NewSchemaObjectsShardsCountForbidden() *SchemaObjectsShardsCountForbidden {
+	return &SchemaObjectsShardsCountForbidden{}
+}
+
+/*
+SchemaObjectsShardsCountForbidden describes a response with status code 403, with default header values.
+
+Forbidden
+*/
+type SchemaObjectsShardsCountForbidden struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this schema objects shards count forbidden response has a 2xx status code
+func (o *SchemaObjectsShardsCountForbidden) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this schema objects shards count forbidden response has a 3xx status code
+func (o *SchemaObjectsShardsCountForbidden) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this schema objects shards count forbidden response has a 4xx status code
+func (o *SchemaObjectsShardsCountForbidden) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this schema objects shards count forbidden response has a 5xx status code
+func (o *SchemaObjectsShardsCountForbidden) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this schema objects shards count forbidden response a status code equal to that given
+func (o *SchemaObjectsShardsCountForbidden) IsCode(code int) bool {
+	return code == 403
+}
+
+// Code gets the status code for the schema objects shards count forbidden response
+func (o *SchemaObjectsShardsCountForbidden) Code() int {
+	return 403
+}
+
+func (o *SchemaObjectsShardsCountForbidden) Error() string {
+	return fmt.Sprintf("[GET /schema/{className}/shards/{shardName}/count][%d] schemaObjectsShardsCountForbidden  %+v", 403, o.Payload)
+}
+
+func (o *SchemaObjectsShardsCountForbidden) String() string {
+	return fmt.Sprintf("[GET /schema/{className}/shards/{shardName}/count][%d] schemaObjectsShardsCountForbidden  %+v", 403, o.Payload)
+}
+
+func (o *SchemaObjectsShardsCountForbidden) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *SchemaObjectsShardsCountForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewSchemaObjectsShardsCountNotFound creates a SchemaObjectsShardsCountNotFound with default headers values
+func NewSchemaObjectsShardsCountNotFound() *SchemaObjectsShardsCountNotFound {
+	return &SchemaObjectsShardsCountNotFound{}
+}
+
+/*
+SchemaObjectsShardsCountNotFound describes a response with status code 404, with default header values.
+
+This class or shard does not exist
+*/
+type SchemaObjectsShardsCountNotFound struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this schema objects shards count not found response has a 2xx status code
+func (o *SchemaObjectsShardsCountNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this schema objects shards count not found response has a 3xx status code
+func (o *SchemaObjectsShardsCountNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this schema objects shards count not found response has a 4xx status code
+func (o *SchemaObjectsShardsCountNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this schema objects shards count not found response has a 5xx status code
+func (o *SchemaObjectsShardsCountNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this schema objects shards count not found response a status code equal to that given
+func (o *SchemaObjectsShardsCountNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+// Code gets the status code for the schema objects shards count not found response
+func (o *SchemaObjectsShardsCountNotFound) Code() int {
+	return 404
+}
+
+func (o *SchemaObjectsShardsCountNotFound) Error() string {
+	return fmt.Sprintf("[GET /schema/{className}/shards/{shardName}/count][%d] schemaObjectsShardsCountNotFound  %+v", 404, o.Payload)
+}
+
+func (o *SchemaObjectsShardsCountNotFound) String() string {
+	return fmt.Sprintf("[GET /schema/{className}/shards/{shardName}/count][%d] schemaObjectsShardsCountNotFound  %+v", 404, o.Payload)
+}
+
+func (o *SchemaObjectsShardsCountNotFound) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *SchemaObjectsShardsCountNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewSchemaObjectsShardsCountInternalServerError creates a SchemaObjectsShardsCountInternalServerError with default headers values
+func NewSchemaObjectsShardsCountInternalServerError() *SchemaObjectsShardsCountInternalServerError {
+	return &SchemaObjectsShardsCountInternalServerError{}
+}
+
+/*
+SchemaObjectsShardsCountInternalServerError describes a response with status code 500, with default header values.
+
+An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.
+*/
+type SchemaObjectsShardsCountInternalServerError struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this schema objects shards count internal server error response has a 2xx status code
+func (o *SchemaObjectsShardsCountInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this schema objects shards count internal server error response has a 3xx status code
+func (o *SchemaObjectsShardsCountInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this schema objects shards count internal server error response has a 4xx status code
+func (o *SchemaObjectsShardsCountInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this schema objects shards count internal server error response has a 5xx status code
+func (o *SchemaObjectsShardsCountInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this schema objects shards count internal server error response a status code equal to that given
+func (o *SchemaObjectsShardsCountInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+// Code gets the status code for the schema objects shards count internal server error response
+func (o *SchemaObjectsShardsCountInternalServerError) Code() int {
+	return 500
+}
+
+func (o *SchemaObjectsShardsCountInternalServerError) Error() string {
+	return fmt.Sprintf("[GET /schema/{className}/shards/{shardName}/count][%d] schemaObjectsShardsCountInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *SchemaObjectsShardsCountInternalServerError) String() string {
+	return fmt.Sprintf("[GET /schema/{className}/shards/{shardName}/count][%d] schemaObjectsShardsCountInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *SchemaObjectsShardsCountInternalServerError) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *SchemaObjectsShardsCountInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,384 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package schema
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// SchemaObjectsStatsGetReader is a Reader for the SchemaObjectsStatsGet structure.
+type SchemaObjectsStatsGetReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *SchemaObjectsStatsGetReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewSchemaObjectsStatsGetOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 401:
+		result := NewSchemaObjectsStatsGetUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewSchemaObjectsStatsGetForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewSchemaObjectsStatsGetNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewSchemaObjectsStatsGetInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewSchemaObjectsStatsGetOK creates a SchemaObjectsStatsGetOK with default headers values
+func NewSchemaObjectsStatsGetOK() *SchemaObjectsStatsGetOK {
+	return &SchemaObjectsStatsGetOK{}
+}
+
+/*
+SchemaObjectsStatsGetOK describes a response with status code 200, with default header values.
+
+The access counts of the properties which were used at least once.
+*/
+type SchemaObjectsStatsGetOK struct {
+	Payload models.PropertyAccessStats
+}
+
+// IsSuccess returns true when this schema objects stats get o k response has a 2xx status code
+func (o *SchemaObjectsStatsGetOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this schema objects stats get o k response has a 3xx status code
+func (o *SchemaObjectsStatsGetOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this schema objects stats get o k response has a 4xx status code
+func (o *SchemaObjectsStatsGetOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this schema objects stats get o k response has a 5xx status code
+func (o *SchemaObjectsStatsGetOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this schema objects stats get o k response a status code equal to that given
+func (o *SchemaObjectsStatsGetOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the schema objects stats get o k response
+func (o *SchemaObjectsStatsGetOK) Code() int {
+	return 200
+}
+
+func (o *SchemaObjectsStatsGetOK) Error() string {
+	return fmt.Sprintf("[GET /schema/{className}/stats][%d] schemaObjectsStatsGetOK  %+v", 200, o.Payload)
+}
+
+func (o *SchemaObjectsStatsGetOK) String() string {
+	return fmt.Sprintf("[GET /schema/{className}/stats][%d] schemaObjectsStatsGetOK  %+v", 200, o.Payload)
+}
+
+func (o *SchemaObjectsStatsGetOK) GetPayload() models.PropertyAccessStats {
+	return o.Payload
+}
+
+func (o *SchemaObjectsStatsGetOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// response payload
+	if err := consumer.Consume(response.Body(), &o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewSchemaObjectsStatsGetUnauthorized creates a SchemaObjectsStatsGetUnauthorized with default headers values
+func NewSchemaObjectsStatsGetUnauthorized() *SchemaObjectsStatsGetUnauthorized {
+	return &SchemaObjectsStatsGetUnauthorized{}
+}
+
+/*
+SchemaObjectsStatsGetUnauthorized describes a response with status code 401, with default header values.
+
+Unauthorized or invalid credentials.
+*/
+type SchemaObjectsStatsGetUnauthorized struct {
+}
+
+// IsSuccess returns true when this schema objects stats get unauthorized response has a 2xx status code
+func (o *SchemaObjectsStatsGetUnauthorized) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this schema objects stats get unauthorized response has a 3xx status code
+func (o *SchemaObjectsStatsGetUnauthorized) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this schema objects stats get unauthorized response has a 4xx status code
+func (o *SchemaObjectsStatsGetUnauthorized) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this schema objects stats get unauthorized response has a 5xx status code
+func (o *SchemaObjectsStatsGetUnauthorized) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this schema objects stats get unauthorized response a status code equal to that given
+func (o *SchemaObjectsStatsGetUnauthorized) IsCode(code int) bool {
+	return code == 401
+}
+
+// Code gets the status code for the schema objects stats get unauthorized response
+func (o *SchemaObjectsStatsGetUnauthorized) Code() int {
+	return 401
+}
+
+func (o *SchemaObjectsStatsGetUnauthorized) Error() string {
+	return fmt.Sprintf("[GET /schema/{className}/stats][%d] schemaObjectsStatsGetUnauthorized ", 401)
+}
+
+func (o *SchemaObjectsStatsGetUnauthorized) String() string {
+	return fmt.Sprintf("[GET /schema/{className}/stats][%d] schemaObjectsStatsGetUnauthorized ", 401)
+}
+
+func (o *SchemaObjectsStatsGetUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewSchemaObjectsStatsGetForbidden creates a SchemaObjectsStatsGetForbidden with default headers values
+func NewSchemaObjectsStatsGetForbidden() *SchemaObjectsStatsGetForbidden {
+	return &SchemaObjectsStatsGetForbidden{}
+}
+
+/*
+SchemaObjectsStatsGetForbidden describes a response with status code 403, with default header values.
+
+Forbidden
+*/
+type SchemaObjectsStatsGetForbidden struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this schema objects stats get forbidden response has a 2xx status code
+func (o *SchemaObjectsStatsGetForbidden) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this schema objects stats get forbidden response has a 3xx status code
+func (o *SchemaObjectsStatsGetForbidden) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this schema objects stats get forbidden response has a 4xx status code
+func (o *SchemaObjectsStatsGetForbidden) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this schema objects stats get forbidden response has a 5xx status code
+func (o *SchemaObjectsStatsGetForbidden) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this schema objects stats get forbidden response a status code equal to that given
+func (o *SchemaObjectsStatsGetForbidden) IsCode(code int) bool {
+	return code == 403
+}
+
+// Code gets the status code for the schema objects stats get forbidden response
+func (o *SchemaObjectsStatsGetForbidden) Code() int {
+	return 403
+}
+
+func (o *SchemaObjectsStatsGetForbidden) Error() string {
+	return fmt.Sprintf("[GET /schema/{className}/stats][%d] schemaObjectsStatsGetForbidden  %+v", 403, o.Payload)
+}
+
+func (o *SchemaObjectsStatsGetForbidden) String() string {
+	return fmt.Sprintf("[GET /schema/{className}/stats][%d] schemaObjectsStatsGetForbidden  %+v", 403, o.Payload)
+}
+
+func (o *SchemaObjectsStatsGetForbidden) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *SchemaObjectsStatsGetForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewSchemaObjectsStatsGetNotFound creates a SchemaObjectsStatsGetNotFound with default headers values
+func NewSchemaObjectsStatsGetNotFound() *SchemaObjectsStatsGetNotFound {
+	return &SchemaObjectsStatsGetNotFound{}
+}
+
+/*
+SchemaObjectsStatsGetNotFound describes a response with status code 404, with default header values.
+
+This collection does not exist
+*/
+type SchemaObjectsStatsGetNotFound struct {
+}
+
+// IsSuccess returns true when this schema objects stats get not found response has a 2xx status code
+func (o *SchemaObjectsStatsGetNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this schema objects stats get not found response has a 3xx status code
+func (o *SchemaObjectsStatsGetNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this schema objects stats get not found response has a 4xx status code
+func (o *SchemaObjectsStatsGetNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this schema objects stats get not found response has a 5xx status code
+func (o *SchemaObjectsStatsGetNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this schema objects stats get not found response a status code equal to that given
+func (o *SchemaObjectsStatsGetNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+// Code gets the status code for the schema objects stats get not found response
+func (o *SchemaObjectsStatsGetNotFound) Code() int {
+	return 404
+}
+
+func (o *SchemaObjectsStatsGetNotFound) Error() string {
+	return fmt.Sprintf("[GET /schema/{className}/stats][%d] schemaObjectsStatsGetNotFound ", 404)
+}
+
+func (o *SchemaObjectsStatsGetNotFound) String() string {
+	return fmt.Sprintf("[GET /schema/{className}/stats][%d] schemaObjectsStatsGetNotFound ", 404)
+}
+
+func (o *SchemaObjectsStatsGetNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewSchemaObjectsStatsGetInternalServerError creates a SchemaObjectsStatsGetInternalServerError with default headers values
+func NewSchemaObjectsStatsGetInternalServerError() *SchemaObjectsStatsGetInternalServerError {
+	return &SchemaObjectsStatsGetInternalServerError{}
+}
+
+/*
+SchemaObjectsStatsGetInternalServerError describes a response with status code 500, with default header values.
+
+An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.
+*/
+type SchemaObjectsStatsGetInternalServerError struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this schema objects stats get internal server error response has a 2xx status code
+func (o *SchemaObjectsStatsGetInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this schema objects stats get internal server error response has a 3xx status code
+func (o *SchemaObjectsStatsGetInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this schema objects stats get internal server error response has a 4xx status code
+func (o *SchemaObjectsStatsGetInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this schema objects stats get internal server error response has a 5xx status code
+func (o *SchemaObjectsStatsGetInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this schema objects stats get internal server error response a status code equal to that given
+func (o *SchemaObjectsStatsGetInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+// Code gets the status code for the schema objects stats get internal server error response
+func (o *SchemaObjectsStatsGetInternalServerError) Code() int {
+	return 500
+}
+
+func (o *SchemaObjectsStatsGetInternalServerError) Error() string {
+	return fmt.Sprintf("[GET /schema/{className}/stats][%d] schemaObjectsStatsGetInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *SchemaObjectsStatsGetInternalServerError) String() string {
+	return fmt.Sprintf("[GET /schema/{className}/stats][%d] schemaObjectsStatsGetInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *SchemaObjectsStatsGetInternalServerError) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *SchemaObjectsStatsGetInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,384 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package schema
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// SchemaObjectsStatsRecommendationsGetReader is a Reader for the SchemaObjectsStatsRecommendationsGet structure.
+type SchemaObjectsStatsRecommendationsGetReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *SchemaObjectsStatsRecommendationsGetReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewSchemaObjectsStatsRecommendationsGetOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 401:
+		result := NewSchemaObjectsStatsRecommendationsGetUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewSchemaObjectsStatsRecommendationsGetForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewSchemaObjectsStatsRecommendationsGetNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewSchemaObjectsStatsRecommendationsGetInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewSchemaObjectsStatsRecommendationsGetOK creates a SchemaObjectsStatsRecommendationsGetOK with default headers values
+func NewSchemaObjectsStatsRecommendationsGetOK() *SchemaObjectsStatsRecommendationsGetOK {
+	return &SchemaObjectsStatsRecommendationsGetOK{}
+}
+
+/*
+SchemaObjectsStatsRecommendationsGetOK describes a response with status code 200, with default header values.
+
+The recommendations, ordered by property name.
+*/
+type SchemaObjectsStatsRecommendationsGetOK struct {
+	Payload []*models.IndexRecommendation
+}
+
+// IsSuccess returns true when this schema objects stats recommendations get o k response has a 2xx status code
+func (o *SchemaObjectsStatsRecommendationsGetOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this schema objects stats recommendations get o k response has a 3xx status code
+func (o *SchemaObjectsStatsRecommendationsGetOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this schema objects stats recommendations get o k response has a 4xx status code
+func (o *SchemaObjectsStatsRecommendationsGetOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this schema objects stats recommendations get o k response has a 5xx status code
+func (o *SchemaObjectsStatsRecommendationsGetOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this schema objects stats recommendations get o k response a status code equal to that given
+func (o *SchemaObjectsStatsRecommendationsGetOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the schema objects stats recommendations get o k response
+func (o *SchemaObjectsStatsRecommendationsGetOK) Code() int {
+	return 200
+}
+
+func (o *SchemaObjectsStatsRecommendationsGetOK) Error() string {
+	return fmt.Sprintf("[GET /schema/{className}/stats/recommendations][%d] schemaObjectsStatsRecommendationsGetOK  %+v", 200, o.Payload)
+}
+
+func (o *SchemaObjectsStatsRecommendationsGetOK) String() string {
+	return fmt.Sprintf("[GET /schema/{className}/stats/recommendations][%d] schemaObjectsStatsRecommendationsGetOK  %+v", 200, o.Payload)
+}
+
+func (o *SchemaObjectsStatsRecommendationsGetOK) GetPayload() []*models.IndexRecommendation {
+	return o.Payload
+}
+
+func (o *SchemaObjectsStatsRecommendationsGetOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// response payload
+	if err := consumer.Consume(response.Body(), &o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewSchemaObjectsStatsRecommendationsGetUnauthorized creates a SchemaObjectsStatsRecommendationsGetUnauthorized with default headers values
+func NewSchemaObjectsStatsRecommendationsGetUnauthorized() *SchemaObjectsStatsRecommendationsGetUnauthorized {
+	return &SchemaObjectsStatsRecommendationsGetUnauthorized{}
+}
+
+/*
+SchemaObjectsStatsRecommendationsGetUnauthorized describes a response with status code 401, with default header values.
+
+Unauthorized or invalid credentials.
+*/
+type SchemaObjectsStatsRecommendationsGetUnauthorized struct {
+}
+
+// IsSuccess returns true when this schema objects stats recommendations get unauthorized response has a 2xx status code
+func (o *SchemaObjectsStatsRecommendationsGetUnauthorized) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this schema objects stats recommendations get unauthorized response has a 3xx status code
+func (o *SchemaObjectsStatsRecommendationsGetUnauthorized) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this schema objects stats recommendations get unauthorized response has a 4xx status code
+func (o *SchemaObjectsStatsRecommendationsGetUnauthorized) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this schema objects stats recommendations get unauthorized response has a 5xx status code
+func (o *SchemaObjectsStatsRecommendationsGetUnauthorized) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this schema objects stats recommendations get unauthorized response a status code equal to that given
+func (o *SchemaObjectsStatsRecommendationsGetUnauthorized) IsCode(code int) bool {
+	return code == 401
+}
+
+// Code gets the status code for the schema objects stats recommendations get unauthorized response
+func (o *SchemaObjectsStatsRecommendationsGetUnauthorized) Code() int {
+	return 401
+}
+
+func (o *SchemaObjectsStatsRecommendationsGetUnauthorized) Error() string {
+	return fmt.Sprintf("[GET /schema/{className}/stats/recommendations][%d] schemaObjectsStatsRecommendationsGetUnauthorized ", 401)
+}
+
+func (o *SchemaObjectsStatsRecommendationsGetUnauthorized) String() string {
+	return fmt.Sprintf("[GET /schema/{className}/stats/recommendations][%d] schemaObjectsStatsRecommendationsGetUnauthorized ", 401)
+}
+
+func (o *SchemaObjectsStatsRecommendationsGetUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewSchemaObjectsStatsRecommendationsGetForbidden creates a SchemaObjectsStatsRecommendationsGetForbidden with default headers values
+func NewSchemaObjectsStatsRecommendationsGetForbidden() *SchemaObjectsStatsRecommendationsGetForbidden {
+	return &SchemaObjectsStatsRecommendationsGetForbidden{}
+}
+
+/*
+SchemaObjectsStatsRecommendationsGetForbidden describes a response with status code 403, with default header values.
+
+Forbidden
+*/
+type SchemaObjectsStatsRecommendationsGetForbidden struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this schema objects stats recommendations get forbidden response has a 2xx status code
+func (o *SchemaObjectsStatsRecommendationsGetForbidden) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this schema objects stats recommendations get forbidden response has a 3xx status code
+func (o *SchemaObjectsStatsRecommendationsGetForbidden) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this schema objects stats recommendations get forbidden response has a 4xx status code
+func (o *SchemaObjectsStatsRecommendationsGetForbidden) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this schema objects stats recommendations get forbidden response has a 5xx status code
+func (o *SchemaObjectsStatsRecommendationsGetForbidden) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this schema objects stats recommendations get forbidden response a status code equal to that given
+func (o *SchemaObjectsStatsRecommendationsGetForbidden) IsCode(code int) bool {
+	return code == 403
+}
+
+// Code gets the status code for the schema objects stats recommendations get forbidden response
+func (o *SchemaObjectsStatsRecommendationsGetForbidden) Code() int {
+	return 403
+}
+
+func (o *SchemaObjectsStatsRecommendationsGetForbidden) Error() string {
+	return fmt.Sprintf("[GET /schema/{className}/stats/recommendations][%d] schemaObjectsStatsRecommendationsGetForbidden  %+v", 403, o.Payload)
+}
+
+func (o *SchemaObjectsStatsRecommendationsGetForbidden) String() string {
+	return fmt.Sprintf("[GET /schema/{className}/stats/recommendations][%d] schemaObjectsStatsRecommendationsGetForbidden  %+v", 403, o.Payload)
+}
+
+func (o *SchemaObjectsStatsRecommendationsGetForbidden) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *SchemaObjectsStatsRecommendationsGetForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewSchemaObjectsStatsRecommendationsGetNotFound creates a SchemaObjectsStatsRecommendationsGetNotFound with default headers values
+func NewSchemaObjectsStatsRecommendationsGetNotFound() *SchemaObjectsStatsRecommendationsGetNotFound {
+	return &SchemaObjectsStatsRecommendationsGetNotFound{}
+}
+
+/*
+SchemaObjectsStatsRecommendationsGetNotFound describes a response with status code 404, with default header values.
+
+This collection does not exist
+*/
+type SchemaObjectsStatsRecommendationsGetNotFound struct {
+}
+
+// IsSuccess returns true when this schema objects stats recommendations get not found response has a 2xx status code
+func (o *SchemaObjectsStatsRecommendationsGetNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this schema objects stats recommendations get not found response has a 3xx status code
+func (o *SchemaObjectsStatsRecommendationsGetNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this schema objects stats recommendations get not found response has a 4xx status code
+func (o *SchemaObjectsStatsRecommendationsGetNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this schema objects stats recommendations get not found response has a 5xx status code
+func (o *SchemaObjectsStatsRecommendationsGetNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this schema objects stats recommendations get not found response a status code equal to that given
+func (o *SchemaObjectsStatsRecommendationsGetNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+// Code gets the status code for the schema objects stats recommendations get not found response
+func (o *SchemaObjectsStatsRecommendationsGetNotFound) Code() int {
+	return 404
+}
+
+func (o *SchemaObjectsStatsRecommendationsGetNotFound) Error() string {
+	return fmt.Sprintf("[GET /schema/{className}/stats/recommendations][%d] schemaObjectsStatsRecommendationsGetNotFound ", 404)
+}
+
+func (o *SchemaObjectsStatsRecommendationsGetNotFound) String() string {
+	return fmt.Sprintf("[GET /schema/{className}/stats/recommendations][%d] schemaObjectsStatsRecommendationsGetNotFound ", 404)
+}
+
+func (o *SchemaObjectsStatsRecommendationsGetNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewSchemaObjectsStatsRecommendationsGetInternalServerError creates a SchemaObjectsStatsRecommendationsGetInternalServerError with default headers values
+func NewSchemaObjectsStatsRecommendationsGetInternalServerError() *SchemaObjectsStatsRecommendationsGetInternalServerError {
+	return &SchemaObjectsStatsRecommendationsGetInternalServerError{}
+}
+
+/*
+SchemaObjectsStatsRecommendationsGetInternalServerError describes a response with status code 500, with default header values.
+
+An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.
+*/
+type SchemaObjectsStatsRecommendationsGetInternalServerError struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this schema objects stats recommendations get internal server error response has a 2xx status code
+func (o *SchemaObjectsStatsRecommendationsGetInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this schema objects stats recommendations get internal server error response has a 3xx status code
+func (o *SchemaObjectsStatsRecommendationsGetInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this schema objects stats recommendations get internal server error response has a 4xx status code
+func (o *SchemaObjectsStatsRecommendationsGetInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this schema objects stats recommendations get internal server error response has a 5xx status code
+func (o *SchemaObjectsStatsRecommendationsGetInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this schema objects stats recommendations get internal server error response a status code equal to that given
+func (o *SchemaObjectsStatsRecommendationsGetInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+// Code gets the status code for the schema objects stats recommendations get internal server error response
+func (o *SchemaObjectsStatsRecommendationsGetInternalServerError) Code() int {
+	return 500
+}
+
+func (o *SchemaObjectsStatsRecommendationsGetInternalServerError) Error() string {
+	return fmt.Sprintf("[GET /schema/{className}/stats/recommendations][%d] schemaObjectsStatsRecommendationsGetInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *SchemaObjectsStatsRecommendationsGetInternalServerError) String() string {
+	return fmt.Sprintf("[GET /schema/{className}/stats/recommendations][%d] schemaObjectsStatsRecommendationsGetInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *SchemaObjectsStatsRecommendationsGetInternalServerError) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *SchemaObjectsStatsRecommendationsGetInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,460 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package schema
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// SchemaObjectsValidateReader is a Reader for the SchemaObjectsValidate structure.
+type SchemaObjectsValidateReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *SchemaObjectsValidateReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewSchemaObjectsValidateOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 401:
+		result := NewSchemaObjectsValidateUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewSchemaObjectsValidateForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewSchemaObjectsValidateNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 422:
+		result := NewSchemaObjectsValidateUnprocessableEntity()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewSchemaObjectsValidateInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewSchemaObjectsValidateOK creates a SchemaObjectsValidateOK with default headers values
+func NewSchemaObjectsValidateOK() *SchemaObjectsValidateOK {
+	return &SchemaObjectsValidateOK{}
+}
+
+/*
+SchemaObjectsValidateOK describes a response with status code 200, with default header values.
+
+The properties were validated, see the result for errors.
+*/
+type SchemaObjectsValidateOK struct {
+	Payload *models.ObjectValidationResult
+}
+
+// IsSuccess returns true when this schema objects validate o k response has a 2xx status code
+func (o *SchemaObjectsValidateOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this schema objects validate o k response has a 3xx status code
+func (o *SchemaObjectsValidateOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this schema objects validate o k response has a 4xx status code
+func (o *SchemaObjectsValidateOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this schema objects validate o k response has a 5xx status code
+func (o *SchemaObjectsValidateOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this schema objects validate o k response a status code equal to that given
+func (o *SchemaObjectsValidateOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the schema objects validate o k response
+func (o *SchemaObjectsValidateOK) Code() int {
+	return 200
+}
+
+func (o *SchemaObjectsValidateOK) Error() string {
+	return fmt.Sprintf("[POST /schema/{className}/validate-object][%d] schemaObjectsValidateOK  %+v", 200, o.Payload)
+}
+
+func (o *SchemaObjectsValidateOK) String() string {
+	return fmt.Sprintf("[POST /schema/{className}/validate-object][%d] schemaObjectsValidateOK  %+v", 200, o.Payload)
+}
+
+func (o *SchemaObjectsValidateOK) GetPayload() *models.ObjectValidationResult {
+	return o.Payload
+}
+
+func (o *SchemaObjectsValidateOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ObjectValidationResult)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewSchemaObjectsValidateUnauthorized creates a SchemaObjectsValidateUnauthorized with default headers values
+func NewSchemaObjectsValidateUnauthorized() *SchemaObjectsValidateUnauthorized {
+	return &SchemaObjectsValidateUnauthorized{}
+}
+
+/*
+SchemaObjectsValidateUnauthorized describes a response with status code 401, with default header values.
+
+Unauthorized or invalid credentials.
+*/
+type SchemaObjectsValidateUnauthorized struct {
+}
+
+// IsSuccess returns true when this schema objects validate unauthorized response has a 2xx status code
+func (o *SchemaObjectsValidateUnauthorized) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this schema objects validate unauthorized response has a 3xx status code
+func (o *SchemaObjectsValidateUnauthorized) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this schema objects validate unauthorized response has a 4xx status code
+func (o *SchemaObjectsValidateUnauthorized) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this schema objects validate unauthorized response has a 5xx status code
+func (o *SchemaObjectsValidateUnauthorized) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this schema objects validate unauthorized response a status code equal to that given
+func (o *SchemaObjectsValidateUnauthorized) IsCode(code int) bool {
+	return code == 401
+}
+
+// Code gets the status code for the schema objects validate unauthorized response
+func (o *SchemaObjectsValidateUnauthorized) Code() int {
+	return 401
+}
+
+func (o *SchemaObjectsValidateUnauthorized) Error() string {
+	return fmt.Sprintf("[POST /schema/{className}/validate-object][%d] schemaObjectsValidateUnauthorized ", 401)
+}
+
+func (o *SchemaObjectsValidateUnauthorized) String() string {
+	return fmt.Sprintf("[POST /schema/{className}/validate-object][%d] schemaObjectsValidateUnauthorized ", 401)
+}
+
+func (o *SchemaObjectsValidateUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewSchemaObjectsValidateForbidden creates a SchemaObjectsValidateForbidden with default headers values
+func NewSchemaObjectsValidateForbidden() *SchemaObjectsValidateForbidden {
+	return &SchemaObjectsValidateForbidden{}
+}
+
+/*
+SchemaObjectsValidateForbidden describes a response with status code 403, with default header values.
+
+Forbidden
+*/
+type SchemaObjectsValidateForbidden struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this schema objects validate forbidden response has a 2xx status code
+func (o *SchemaObjectsValidateForbidden) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this schema objects validate forbidden response has a 3xx status code
+func (o *SchemaObjectsValidateForbidden) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this schema objects validate forbidden response has a 4xx status code
+func (o *SchemaObjectsValidateForbidden) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this schema objects validate forbidden response has a 5xx status code
+func (o *SchemaObjectsValidateForbidden) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this schema objects validate forbidden response a status code equal to that given
+func (o *SchemaObjectsValidateForbidden) IsCode(code int) bool {
+	return code == 403
+}
+
+// Code gets the status code for the schema objects validate forbidden response
+func (o *SchemaObjectsValidateForbidden) Code() int {
+	return 403
+}
+
+func (o *SchemaObjectsValidateForbidden) Error() string {
+	return fmt.Sprintf("[POST /schema/{className}/validate-object][%d] schemaObjectsValidateForbidden  %+v", 403, o.Payload)
+}
+
+func (o *SchemaObjectsValidateForbidden) String() string {
+	return fmt.Sprintf("[POST /schema/{className}/validate-object][%d] schemaObjectsValidateForbidden  %+v", 403, o.Payload)
+}
+
+func (o *SchemaObjectsValidateForbidden) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *SchemaObjectsValidateForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewSchemaObjectsValidateNotFound creates a SchemaObjectsValidateNotFound with default headers values
+func NewSchemaObjectsValidateNotFound() *SchemaObjectsValidateNotFound {
+	return &SchemaObjectsValidateNotFound{}
+}
+
+/*
+SchemaObjectsValidateNotFound describes a response with status code 404, with default header values.
+
+This collection does not exist
+*/
+type SchemaObjectsValidateNotFound struct {
+}
+
+// IsSuccess returns true when this schema objects validate not found response has a 2xx status code
+func (o *SchemaObjectsValidateNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this schema objects validate not found response has a 3xx status code
+func (o *SchemaObjectsValidateNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this schema objects validate not found response has a 4xx status code
+func (o *SchemaObjectsValidateNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this schema objects validate not found response has a 5xx status code
+func (o *SchemaObjectsValidateNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this schema objects validate not found response a status code equal to that given
+func (o *SchemaObjectsValidateNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+// Code gets the status code for the schema objects validate not found response
+func (o *SchemaObjectsValidateNotFound) Code() int {
+	return 404
+}
+
+func (o *SchemaObjectsValidateNotFound) Error() string {
+	return fmt.Sprintf("[POST /schema/{className}/validate-object][%d] schemaObjectsValidateNotFound ", 404)
+}
+
+func (o *SchemaObjectsValidateNotFound) String() string {
+	return fmt.Sprintf("[POST /schema/{className}/validate-object][%d] schemaObjectsValidateNotFound ", 404)
+}
+
+func (o *SchemaObjectsValidateNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewSchemaObjectsValidateUnprocessableEntity creates a SchemaObjectsValidateUnprocessableEntity with default headers values
+func NewSchemaObjectsValidateUnprocessableEntity() *SchemaObjectsValidateUnprocessableEntity {
+	return &SchemaObjectsValidateUnprocessableEntity{}
+}
+
+/*
+SchemaObjectsValidateUnprocessableEntity describes a response with status code 422, with default header values.
+
+The body is not an object.
+*/
+type SchemaObjectsValidateUnprocessableEntity struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this schema objects validate unprocessable entity response has a 2xx status code
+func (o *SchemaObjectsValidateUnprocessableEntity) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this schema objects validate unprocessable entity response has a 3xx status code
+func (o *SchemaObjectsValidateUnprocessableEntity) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this schema objects validate unprocessable entity response has a 4xx status code
+func (o *SchemaObjectsValidateUnprocessableEntity) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this schema objects validate unprocessable entity response has a 5xx status code
+func (o *SchemaObjectsValidateUnprocessableEntity) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this schema objects validate unprocessable entity response a status code equal to that given
+func (o *SchemaObjectsValidateUnprocessableEntity) IsCode(code int) bool {
+	return code == 422
+}
+
+// Code gets the status code for the schema objects validate unprocessable entity response
+func (o *SchemaObjectsValidateUnprocessableEntity) Code() int {
+	return 422
+}
+
+func (o *SchemaObjectsValidateUnprocessableEntity) Error() string {
+	return fmt.Sprintf("[POST /schema/{className}/validate-object][%d] schemaObjectsValidateUnprocessableEntity  %+v", 422, o.Payload)
+}
+
+func (o *SchemaObjectsValidateUnprocessableEntity) String() string {
+	return fmt.Sprintf("[POST /schema/{className}/validate-object][%d] schemaObjectsValidateUnprocessableEntity  %+v", 422, o.Payload)
+}
+
+func (o *SchemaObjectsValidateUnprocessableEntity) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *SchemaObjectsValidateUnprocessableEntity) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewSchemaObjectsValidateInternalServerError creates a SchemaObjectsValidateInternalServerError with default headers values
+func NewSchemaObjectsValidateInternalServerError() *SchemaObjectsValidateInternalServerError {
+	return &SchemaObjectsValidateInternalServerError{}
+}
+
+/*
+SchemaObjectsValidateInternalServerError describes a response with status code 500, with default header values.
+
+An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.
+*/
+type SchemaObjectsValidateInternalServerError struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this schema objects validate internal server error response has a 2xx status code
+func (o *SchemaObjectsValidateInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this schema objects validate internal server error response has a 3xx status code
+func (o *SchemaObjectsValidateInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this schema objects validate internal server error response has a 4xx status code
+func (o *SchemaObjectsValidateInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this schema objects validate internal server error response has a 5xx status code
+func (o *SchemaObjectsValidateInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this schema objects validate internal server error response a status code equal to that given
+func (o *SchemaObjectsValidateInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+// Code gets the status code for the schema objects validate internal server error response
+func (o *SchemaObjectsValidateInternalServerError) Code() int {
+	return 500
+}
+
+func (o *SchemaObjectsValidateInternalServerError) Error() string {
+	return fmt.Sprintf("[POST /schema/{className}/validate-object][%d] schemaObjectsValidateInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *SchemaObjectsValidateInternalServerError) String() string {
+	return fmt.Sprintf("[POST /schema/{className}/validate-object][%d] schemaObjectsValidateInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *SchemaObjectsValidateInternalServerError) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *SchemaObjectsValidateInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,386 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package schema
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// SchemaObjectsVectorsBackfillGetReader is a Reader for the SchemaObjectsVectorsBackfillGet structure.
+type SchemaObjectsVectorsBackfillGetReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *SchemaObjectsVectorsBackfillGetReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewSchemaObjectsVectorsBackfillGetOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 401:
+		result := NewSchemaObjectsVectorsBackfillGetUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewSchemaObjectsVectorsBackfillGetForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewSchemaObjectsVectorsBackfillGetNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewSchemaObjectsVectorsBackfillGetInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewSchemaObjectsVectorsBackfillGetOK creates a SchemaObjectsVectorsBackfillGetOK with default headers values
+func NewSchemaObjectsVectorsBackfillGetOK() *SchemaObjectsVectorsBackfillGetOK {
+	return &SchemaObjectsVectorsBackfillGetOK{}
+}
+
+/*
+SchemaObjectsVectorsBackfillGetOK describes a response with status code 200, with default header values.
+
+The status of the job.
+*/
+type SchemaObjectsVectorsBackfillGetOK struct {
+	Payload *models.VectorBackfillStatus
+}
+
+// IsSuccess returns true when this schema objects vectors backfill get o k response has a 2xx status code
+func (o *SchemaObjectsVectorsBackfillGetOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this schema objects vectors backfill get o k response has a 3xx status code
+func (o *SchemaObjectsVectorsBackfillGetOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this schema objects vectors backfill get o k response has a 4xx status code
+func (o *SchemaObjectsVectorsBackfillGetOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this schema objects vectors backfill get o k response has a 5xx status code
+func (o *SchemaObjectsVectorsBackfillGetOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this schema objects vectors backfill get o k response a status code equal to that given
+func (o *SchemaObjectsVectorsBackfillGetOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the schema objects vectors backfill get o k response
+func (o *SchemaObjectsVectorsBackfillGetOK) Code() int {
+	return 200
+}
+
+func (o *SchemaObjectsVectorsBackfillGetOK) Error() string {
+	return fmt.Sprintf("[GET /schema/{className}/vectors/backfill/{jobId}][%d] schemaObjectsVectorsBackfillGetOK  %+v", 200, o.Payload)
+}
+
+func (o *SchemaObjectsVectorsBackfillGetOK) String() string {
+	return fmt.Sprintf("[GET /schema/{className}/vectors/backfill/{jobId}][%d] schemaObjectsVectorsBackfillGetOK  %+v", 200, o.Payload)
+}
+
+func (o *SchemaObjectsVectorsBackfillGetOK) GetPayload() *models.VectorBackfillStatus {
+	return o.Payload
+}
+
+func (o *SchemaObjectsVectorsBackfillGetOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.VectorBackfillStatus)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewSchemaObjectsVectorsBackfillGetUnauthorized creates a SchemaObjectsVectorsBackfillGetUnauthorized with default headers values
+func NewSchemaObjectsVectorsBackfillGetUnauthorized() *SchemaObjectsVectorsBackfillGetUnauthorized {
+	return &SchemaObjectsVectorsBackfillGetUnauthorized{}
+}
+
+/*
+SchemaObjectsVectorsBackfillGetUnauthorized describes a response with status code 401, with default header values.
+
+Unauthorized or invalid credentials.
+*/
+type SchemaObjectsVectorsBackfillGetUnauthorized struct {
+}
+
+// IsSuccess returns true when this schema objects vectors backfill get unauthorized response has a 2xx status code
+func (o *SchemaObjectsVectorsBackfillGetUnauthorized) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this schema objects vectors backfill get unauthorized response has a 3xx status code
+func (o *SchemaObjectsVectorsBackfillGetUnauthorized) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this schema objects vectors backfill get unauthorized response has a 4xx status code
+func (o *SchemaObjectsVectorsBackfillGetUnauthorized) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this schema objects vectors backfill get unauthorized response has a 5xx status code
+func (o *SchemaObjectsVectorsBackfillGetUnauthorized) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this schema objects vectors backfill get unauthorized response a status code equal to that given
+func (o *SchemaObjectsVectorsBackfillGetUnauthorized) IsCode(code int) bool {
+	return code == 401
+}
+
+// Code gets the status code for the schema objects vectors backfill get unauthorized response
+func (o *SchemaObjectsVectorsBackfillGetUnauthorized) Code() int {
+	return 401
+}
+
+func (o *SchemaObjectsVectorsBackfillGetUnauthorized) Error() string {
+	return fmt.Sprintf("[GET /schema/{className}/vectors/backfill/{jobId}][%d] schemaObjectsVectorsBackfillGetUnauthorized ", 401)
+}
+
+func (o *SchemaObjectsVectorsBackfillGetUnauthorized) String() string {
+	return fmt.Sprintf("[GET /schema/{className}/vectors/backfill/{jobId}][%d] schemaObjectsVectorsBackfillGetUnauthorized ", 401)
+}
+
+func (o *SchemaObjectsVectorsBackfillGetUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewSchemaObjectsVectorsBackfillGetForbidden creates a SchemaObjectsVectorsBackfillGetForbidden with default headers values
+func NewSchemaObjectsVectorsBackfillGetForbidden() *SchemaObjectsVectorsBackfillGetForbidden {
+	return &SchemaObjectsVectorsBackfillGetForbidden{}
+}
+
+/*
+SchemaObjectsVectorsBackfillGetForbidden describes a response with status code 403, with default header values.
+
+Forbidden
+*/
+type SchemaObjectsVectorsBackfillGetForbidden struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this schema objects vectors backfill get forbidden response has a 2xx status code
+func (o *SchemaObjectsVectorsBackfillGetForbidden) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this schema objects vectors backfill get forbidden response has a 3xx status code
+func (o *SchemaObjectsVectorsBackfillGetForbidden) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this schema objects vectors backfill get forbidden response has a 4xx status code
+func (o *SchemaObjectsVectorsBackfillGetForbidden) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this schema objects vectors backfill get forbidden response has a 5xx status code
+func (o *SchemaObjectsVectorsBackfillGetForbidden) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this schema objects vectors backfill get forbidden response a status code equal to that given
+func (o *SchemaObjectsVectorsBackfillGetForbidden) IsCode(code int) bool {
+	return code == 403
+}
+
+// Code gets the status code for the schema objects vectors backfill get forbidden response
+func (o *SchemaObjectsVectorsBackfillGetForbidden) Code() int {
+	return 403
+}
+
+func (o *SchemaObjectsVectorsBackfillGetForbidden) Error() string {
+	return fmt.Sprintf("[GET /schema/{className}/vectors/backfill/{jobId}][%d] schemaObjectsVectorsBackfillGetForbidden  %+v", 403, o.Payload)
+}
+
+func (o *SchemaObjectsVectorsBackfillGetForbidden) String() string {
+	return fmt.Sprintf("[GET /schema/{className}/vectors/backfill/{jobId}][%d] schemaObjectsVectorsBackfillGetForbidden  %+v", 403, o.Payload)
+}
+
+func (o *SchemaObjectsVectorsBackfillGetForbidden) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *SchemaObjectsVectorsBackfillGetForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewSchemaObjectsVectorsBackfillGetNotFound creates a SchemaObjectsVectorsBackfillGetNotFound with default headers values
+func NewSchemaObjectsVectorsBackfillGetNotFound() *SchemaObjectsVectorsBackfillGetNotFound {
+	return &SchemaObjectsVectorsBackfillGetNotFound{}
+}
+
+/*
+SchemaObjectsVectorsBackfillGetNotFound describes a response with status code 404, with default header values.
+
+This job does not exist on this node
+*/
+type SchemaObjectsVectorsBackfillGetNotFound struct {
+}
+
+// IsSuccess returns true when this schema objects vectors backfill get not found response has a 2xx status code
+func (o *SchemaObjectsVectorsBackfillGetNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this schema objects vectors backfill get not found response has a 3xx status code
+func (o *SchemaObjectsVectorsBackfillGetNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this schema objects vectors backfill get not found response has a 4xx status code
+func (o *SchemaObjectsVectorsBackfillGetNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this schema objects vectors backfill get not found response has a 5xx status code
+func (o *SchemaObjectsVectorsBackfillGetNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this schema objects vectors backfill get not found response a status code equal to that given
+func (o *SchemaObjectsVectorsBackfillGetNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+// Code gets the status code for the schema objects vectors backfill get not found response
+func (o *SchemaObjectsVectorsBackfillGetNotFound) Code() int {
+	return 404
+}
+
+func (o *SchemaObjectsVectorsBackfillGetNotFound) Error() string {
+	return fmt.Sprintf("[GET /schema/{className}/vectors/backfill/{jobId}][%d] schemaObjectsVectorsBackfillGetNotFound ", 404)
+}
+
+func (o *SchemaObjectsVectorsBackfillGetNotFound) String() string {
+	return fmt.Sprintf("[GET /schema/{className}/vectors/backfill/{jobId}][%d] schemaObjectsVectorsBackfillGetNotFound ", 404)
+}
+
+func (o *SchemaObjectsVectorsBackfillGetNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewSchemaObjectsVectorsBackfillGetInternalServerError creates a SchemaObjectsVectorsBackfillGetInternalServerError with default headers values
+func NewSchemaObjectsVectorsBackfillGetInternalServerError() *SchemaObjectsVectorsBackfillGetInternalServerError {
+	return &SchemaObjectsVectorsBackfillGetInternalServerError{}
+}
+
+/*
+SchemaObjectsVectorsBackfillGetInternalServerError describes a response with status code 500, with default header values.
+
+An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.
+*/
+type SchemaObjectsVectorsBackfillGetInternalServerError struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this schema objects vectors backfill get internal server error response has a 2xx status code
+func (o *SchemaObjectsVectorsBackfillGetInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this schema objects vectors backfill get internal server error response has a 3xx status code
+func (o *SchemaObjectsVectorsBackfillGetInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this schema objects vectors backfill get internal server error response has a 4xx status code
+func (o *SchemaObjectsVectorsBackfillGetInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this schema objects vectors backfill get internal server error response has a 5xx status code
+func (o *SchemaObjectsVectorsBackfillGetInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this schema objects vectors backfill get internal server error response a status code equal to that given
+func (o *SchemaObjectsVectorsBackfillGetInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+// Code gets the status code for the schema objects vectors backfill get internal server error response
+func (o *SchemaObjectsVectorsBackfillGetInternalServerError) Code() int {
+	return 500
+}
+
+func (o *SchemaObjectsVectorsBackfillGetInternalServerError) Error() string {
+	return fmt.Sprintf("[GET /schema/{className}/vectors/backfill/{jobId}][%d] schemaObjectsVectorsBackfillGetInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *SchemaObjectsVectorsBackfillGetInternalServerError) String() string {
+	return fmt.Sprintf("[GET /schema/{className}/vectors/backfill/{jobId}][%d] schemaObjectsVectorsBackfillGetInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *SchemaObjectsVectorsBackfillGetInternalServerError) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *SchemaObjectsVectorsBackfillGetInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
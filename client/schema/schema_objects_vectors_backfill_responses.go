@@ -0,0 +1,460 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package schema
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// SchemaObjectsVectorsBackfillReader is a Reader for the SchemaObjectsVectorsBackfill structure.
+type SchemaObjectsVectorsBackfillReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *SchemaObjectsVectorsBackfillReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 202:
+		result := NewSchemaObjectsVectorsBackfillAccepted()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 401:
+		result := NewSchemaObjectsVectorsBackfillUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewSchemaObjectsVectorsBackfillForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewSchemaObjectsVectorsBackfillNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 422:
+		result := NewSchemaObjectsVectorsBackfillUnprocessableEntity()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewSchemaObjectsVectorsBackfillInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewSchemaObjectsVectorsBackfillAccepted creates a SchemaObjectsVectorsBackfillAccepted with default headers values
+func NewSchemaObjectsVectorsBackfillAccepted() *SchemaObjectsVectorsBackfillAccepted {
+	return &SchemaObjectsVectorsBackfillAccepted{}
+}
+
+/*
+SchemaObjectsVectorsBackfillAccepted describes a response with status code 202, with default header values.
+
+The backfill job was started.
+*/
+type SchemaObjectsVectorsBackfillAccepted struct {
+	Payload *models.VectorBackfillJob
+}
+
+// IsSuccess returns true when this schema objects vectors backfill accepted response has a 2xx status code
+func (o *SchemaObjectsVectorsBackfillAccepted) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this schema objects vectors backfill accepted response has a 3xx status code
+func (o *SchemaObjectsVectorsBackfillAccepted) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this schema objects vectors backfill accepted response has a 4xx status code
+func (o *SchemaObjectsVectorsBackfillAccepted) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this schema objects vectors backfill accepted response has a 5xx status code
+func (o *SchemaObjectsVectorsBackfillAccepted) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this schema objects vectors backfill accepted response a status code equal to that given
+func (o *SchemaObjectsVectorsBackfillAccepted) IsCode(code int) bool {
+	return code == 202
+}
+
+// Code gets the status code for the schema objects vectors backfill accepted response
+func (o *SchemaObjectsVectorsBackfillAccepted) Code() int {
+	return 202
+}
+
+func (o *SchemaObjectsVectorsBackfillAccepted) Error() string {
+	return fmt.Sprintf("[POST /schema/{className}/vectors/backfill][%d] schemaObjectsVectorsBackfillAccepted  %+v", 202, o.Payload)
+}
+
+func (o *SchemaObjectsVectorsBackfillAccepted) String() string {
+	return fmt.Sprintf("[POST /schema/{className}/vectors/backfill][%d] schemaObjectsVectorsBackfillAccepted  %+v", 202, o.Payload)
+}
+
+func (o *SchemaObjectsVectorsBackfillAccepted) GetPayload() *models.VectorBackfillJob {
+	return o.Payload
+}
+
+func (o *SchemaObjectsVectorsBackfillAccepted) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.VectorBackfillJob)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewSchemaObjectsVectorsBackfillUnauthorized creates a SchemaObjectsVectorsBackfillUnauthorized with default headers values
+func NewSchemaObjectsVectorsBackfillUnauthorized() *SchemaObjectsVectorsBackfillUnauthorized {
+	return &SchemaObjectsVectorsBackfillUnauthorized{}
+}
+
+/*
+SchemaObjectsVectorsBackfillUnauthorized describes a response with status code 401, with default header values.
+
+Unauthorized or invalid credentials.
+*/
+type SchemaObjectsVectorsBackfillUnauthorized struct {
+}
+
+// IsSuccess returns true when this schema objects vectors backfill unauthorized response has a 2xx status code
+func (o *SchemaObjectsVectorsBackfillUnauthorized) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this schema objects vectors backfill unauthorized response has a 3xx status code
+func (o *SchemaObjectsVectorsBackfillUnauthorized) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this schema objects vectors backfill unauthorized response has a 4xx status code
+func (o *SchemaObjectsVectorsBackfillUnauthorized) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this schema objects vectors backfill unauthorized response has a 5xx status code
+func (o *SchemaObjectsVectorsBackfillUnauthorized) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this schema objects vectors backfill unauthorized response a status code equal to that given
+func (o *SchemaObjectsVectorsBackfillUnauthorized) IsCode(code int) bool {
+	return code == 401
+}
+
+// Code gets the status code for the schema objects vectors backfill unauthorized response
+func (o *SchemaObjectsVectorsBackfillUnauthorized) Code() int {
+	return 401
+}
+
+func (o *SchemaObjectsVectorsBackfillUnauthorized) Error() string {
+	return fmt.Sprintf("[POST /schema/{className}/vectors/backfill][%d] schemaObjectsVectorsBackfillUnauthorized ", 401)
+}
+
+func (o *SchemaObjectsVectorsBackfillUnauthorized) String() string {
+	return fmt.Sprintf("[POST /schema/{className}/vectors/backfill][%d] schemaObjectsVectorsBackfillUnauthorized ", 401)
+}
+
+func (o *SchemaObjectsVectorsBackfillUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewSchemaObjectsVectorsBackfillForbidden creates a SchemaObjectsVectorsBackfillForbidden with default headers values
+func NewSchemaObjectsVectorsBackfillForbidden() *SchemaObjectsVectorsBackfillForbidden {
+	return &SchemaObjectsVectorsBackfillForbidden{}
+}
+
+/*
+SchemaObjectsVectorsBackfillForbidden describes a response with status code 403, with default header values.
+
+Forbidden
+*/
+type SchemaObjectsVectorsBackfillForbidden struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this schema objects vectors backfill forbidden response has a 2xx status code
+func (o *SchemaObjectsVectorsBackfillForbidden) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this schema objects vectors backfill forbidden response has a 3xx status code
+func (o *SchemaObjectsVectorsBackfillForbidden) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this schema objects vectors backfill forbidden response has a 4xx status code
+func (o *SchemaObjectsVectorsBackfillForbidden) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this schema objects vectors backfill forbidden response has a 5xx status code
+func (o *SchemaObjectsVectorsBackfillForbidden) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this schema objects vectors backfill forbidden response a status code equal to that given
+func (o *SchemaObjectsVectorsBackfillForbidden) IsCode(code int) bool {
+	return code == 403
+}
+
+// Code gets the status code for the schema objects vectors backfill forbidden response
+func (o *SchemaObjectsVectorsBackfillForbidden) Code() int {
+	return 403
+}
+
+func (o *SchemaObjectsVectorsBackfillForbidden) Error() string {
+	return fmt.Sprintf("[POST /schema/{className}/vectors/backfill][%d] schemaObjectsVectorsBackfillForbidden  %+v", 403, o.Payload)
+}
+
+func (o *SchemaObjectsVectorsBackfillForbidden) String() string {
+	return fmt.Sprintf("[POST /schema/{className}/vectors/backfill][%d] schemaObjectsVectorsBackfillForbidden  %+v", 403, o.Payload)
+}
+
+func (o *SchemaObjectsVectorsBackfillForbidden) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *SchemaObjectsVectorsBackfillForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewSchemaObjectsVectorsBackfillNotFound creates a SchemaObjectsVectorsBackfillNotFound with default headers values
+func NewSchemaObjectsVectorsBackfillNotFound() *SchemaObjectsVectorsBackfillNotFound {
+	return &SchemaObjectsVectorsBackfillNotFound{}
+}
+
+/*
+SchemaObjectsVectorsBackfillNotFound describes a response with status code 404, with default header values.
+
+This collection does not exist
+*/
+type SchemaObjectsVectorsBackfillNotFound struct {
+}
+
+// IsSuccess returns true when this schema objects vectors backfill not found response has a 2xx status code
+func (o *SchemaObjectsVectorsBackfillNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this schema objects vectors backfill not found response has a 3xx status code
+func (o *SchemaObjectsVectorsBackfillNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this schema objects vectors backfill not found response has a 4xx status code
+func (o *SchemaObjectsVectorsBackfillNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this schema objects vectors backfill not found response has a 5xx status code
+func (o *SchemaObjectsVectorsBackfillNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this schema objects vectors backfill not found response a status code equal to that given
+func (o *SchemaObjectsVectorsBackfillNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+// Code gets the status code for the schema objects vectors backfill not found response
+func (o *SchemaObjectsVectorsBackfillNotFound) Code() int {
+	return 404
+}
+
+func (o *SchemaObjectsVectorsBackfillNotFound) Error() string {
+	return fmt.Sprintf("[POST /schema/{className}/vectors/backfill][%d] schemaObjectsVectorsBackfillNotFound ", 404)
+}
+
+func (o *SchemaObjectsVectorsBackfillNotFound) String() string {
+	return fmt.Sprintf("[POST /schema/{className}/vectors/backfill][%d] schemaObjectsVectorsBackfillNotFound ", 404)
+}
+
+func (o *SchemaObjectsVectorsBackfillNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewSchemaObjectsVectorsBackfillUnprocessableEntity creates a SchemaObjectsVectorsBackfillUnprocessableEntity with default headers values
+func NewSchemaObjectsVectorsBackfillUnprocessableEntity() *SchemaObjectsVectorsBackfillUnprocessableEntity {
+	return &SchemaObjectsVectorsBackfillUnprocessableEntity{}
+}
+
+/*
+SchemaObjectsVectorsBackfillUnprocessableEntity describes a response with status code 422, with default header values.
+
+Invalid options, or the collection or target vector has no vectorizer.
+*/
+type SchemaObjectsVectorsBackfillUnprocessableEntity struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this schema objects vectors backfill unprocessable entity response has a 2xx status code
+func (o *SchemaObjectsVectorsBackfillUnprocessableEntity) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this schema objects vectors backfill unprocessable entity response has a 3xx status code
+func (o *SchemaObjectsVectorsBackfillUnprocessableEntity) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this schema objects vectors backfill unprocessable entity response has a 4xx status code
+func (o *SchemaObjectsVectorsBackfillUnprocessableEntity) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this schema objects vectors backfill unprocessable entity response has a 5xx status code
+func (o *SchemaObjectsVectorsBackfillUnprocessableEntity) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this schema objects vectors backfill unprocessable entity response a status code equal to that given
+func (o *SchemaObjectsVectorsBackfillUnprocessableEntity) IsCode(code int) bool {
+	return code == 422
+}
+
+// Code gets the status code for the schema objects vectors backfill unprocessable entity response
+func (o *SchemaObjectsVectorsBackfillUnprocessableEntity) Code() int {
+	return 422
+}
+
+func (o *SchemaObjectsVectorsBackfillUnprocessableEntity) Error() string {
+	return fmt.Sprintf("[POST /schema/{className}/vectors/backfill][%d] schemaObjectsVectorsBackfillUnprocessableEntity  %+v", 422, o.Payload)
+}
+
+func (o *SchemaObjectsVectorsBackfillUnprocessableEntity) String() string {
+	return fmt.Sprintf("[POST /schema/{className}/vectors/backfill][%d] schemaObjectsVectorsBackfillUnprocessableEntity  %+v", 422, o.Payload)
+}
+
+func (o *SchemaObjectsVectorsBackfillUnprocessableEntity) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *SchemaObjectsVectorsBackfillUnprocessableEntity) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewSchemaObjectsVectorsBackfillInternalServerError creates a SchemaObjectsVectorsBackfillInternalServerError with default headers values
+func NewSchemaObjectsVectorsBackfillInternalServerError() *SchemaObjectsVectorsBackfillInternalServerError {
+	return &SchemaObjectsVectorsBackfillInternalServerError{}
+}
+
+/*
+SchemaObjectsVectorsBackfillInternalServerError describes a response with status code 500, with default header values.
+
+An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.
+*/
+type SchemaObjectsVectorsBackfillInternalServerError struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this schema objects vectors backfill internal server error response has a 2xx status code
+func (o *SchemaObjectsVectorsBackfillInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this schema objects vectors backfill internal server error response has a 3xx status code
+func (o *SchemaObjectsVectorsBackfillInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this schema objects vectors backfill internal server error response has a 4xx status code
+func (o *SchemaObjectsVectorsBackfillInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this schema objects vectors backfill internal server error response has a 5xx status code
+func (o *SchemaObjectsVectorsBackfillInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this schema objects vectors backfill internal server error response a status code equal to that given
+func (o *SchemaObjectsVectorsBackfillInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+// Code gets the status code for the schema objects vectors backfill internal server error response
+func (o *SchemaObjectsVectorsBackfillInternalServerError) Code() int {
+	return 500
+}
+
+func (o *SchemaObjectsVectorsBackfillInternalServerError) Error() string {
+	return fmt.Sprintf("[POST /schema/{className}/vectors/backfill][%d] schemaObjectsVectorsBackfillInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *SchemaObjectsVectorsBackfillInternalServerError) String() string {
+	return fmt.Sprintf("[POST /schema/{className}/vectors/backfill][%d] schemaObjectsVectorsBackfillInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *SchemaObjectsVectorsBackfillInternalServerError) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *SchemaObjectsVectorsBackfillInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
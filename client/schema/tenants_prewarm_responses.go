@@ -0,0 +1,448 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package schema
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// TenantsPrewarmReader is a Reader for the TenantsPrewarm structure.
+type TenantsPrewarmReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *TenantsPrewarmReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewTenantsPrewarmOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 401:
+		result := NewTenantsPrewarmUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewTenantsPrewarmForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewTenantsPrewarmNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 422:
+		result := NewTenantsPrewarmUnprocessableEntity()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewTenantsPrewarmInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewTenantsPrewarmOK creates a TenantsPrewarmOK with default headers values
+func NewTenantsPrewarmOK() *TenantsPrewarmOK {
+	return &TenantsPrewarmOK{}
+}
+
+/*
+TenantsPrewarmOK describes a response with status code 200, with default header values.
+
+The tenant is loaded
+*/
+type TenantsPrewarmOK struct {
+}
+
+// IsSuccess returns true when this tenants prewarm o k response has a 2xx status code
+func (o *TenantsPrewarmOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this tenants prewarm o k response has a 3xx status code
+func (o *TenantsPrewarmOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this tenants prewarm o k response has a 4xx status code
+func (o *TenantsPrewarmOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this tenants prewarm o k response has a 5xx status code
+func (o *TenantsPrewarmOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this tenants prewarm o k response a status code equal to that given
+func (o *TenantsPrewarmOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the tenants prewarm o k response
+func (o *TenantsPrewarmOK) Code() int {
+	return 200
+}
+
+func (o *TenantsPrewarmOK) Error() string {
+	return fmt.Sprintf("[POST /schema/{className}/tenants/{tenantName}/prewarm][%d] tenantsPrewarmOK ", 200)
+}
+
+func (o *TenantsPrewarmOK) String() string {
+	return fmt.Sprintf("[POST /schema/{className}/tenants/{tenantName}/prewarm][%d] tenantsPrewarmOK ", 200)
+}
+
+func (o *TenantsPrewarmOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewTenantsPrewarmUnauthorized creates a TenantsPrewarmUnauthorized with default headers values
+func NewTenantsPrewarmUnauthorized() *TenantsPrewarmUnauthorized {
+	return &TenantsPrewarmUnauthorized{}
+}
+
+/*
+TenantsPrewarmUnauthorized describes a response with status code 401, with default header values.
+
+Unauthorized or invalid credentials.
+*/
+type TenantsPrewarmUnauthorized struct {
+}
+
+// IsSuccess returns true when this tenants prewarm unauthorized response has a 2xx status code
+func (o *TenantsPrewarmUnauthorized) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this tenants prewarm unauthorized response has a 3xx status code
+func (o *TenantsPrewarmUnauthorized) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this tenants prewarm unauthorized response has a 4xx status code
+func (o *TenantsPrewarmUnauthorized) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this tenants prewarm unauthorized response has a 5xx status code
+func (o *TenantsPrewarmUnauthorized) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this tenants prewarm unauthorized response a status code equal to that given
+func (o *TenantsPrewarmUnauthorized) IsCode(code int) bool {
+	return code == 401
+}
+
+// Code gets the status code for the tenants prewarm unauthorized response
+func (o *TenantsPrewarmUnauthorized) Code() int {
+	return 401
+}
+
+func (o *TenantsPrewarmUnauthorized) Error() string {
+	return fmt.Sprintf("[POST /schema/{className}/tenants/{tenantName}/prewarm][%d] tenantsPrewarmUnauthorized ", 401)
+}
+
+func (o *TenantsPrewarmUnauthorized) String() string {
+	return fmt.Sprintf("[POST /schema/{className}/tenants/{tenantName}/prewarm][%d] tenantsPrewarmUnauthorized ", 401)
+}
+
+func (o *TenantsPrewarmUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewTenantsPrewarmForbidden creates a TenantsPrewarmForbidden with default headers values
+func NewTenantsPrewarmForbidden() *TenantsPrewarmForbidden {
+	return &TenantsPrewarmForbidden{}
+}
+
+/*
+TenantsPrewarmForbidden describes a response with status code 403, with default header values.
+
+Forbidden
+*/
+type TenantsPrewarmForbidden struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this tenants prewarm forbidden response has a 2xx status code
+func (o *TenantsPrewarmForbidden) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this tenants prewarm forbidden response has a 3xx status code
+func (o *TenantsPrewarmForbidden) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this tenants prewarm forbidden response has a 4xx status code
+func (o *TenantsPrewarmForbidden) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this tenants prewarm forbidden response has a 5xx status code
+func (o *TenantsPrewarmForbidden) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this tenants prewarm forbidden response a status code equal to that given
+func (o *TenantsPrewarmForbidden) IsCode(code int) bool {
+	return code == 403
+}
+
+// Code gets the status code for the tenants prewarm forbidden response
+func (o *TenantsPrewarmForbidden) Code() int {
+	return 403
+}
+
+func (o *TenantsPrewarmForbidden) Error() string {
+	return fmt.Sprintf("[POST /schema/{className}/tenants/{tenantName}/prewarm][%d] tenantsPrewarmForbidden  %+v", 403, o.Payload)
+}
+
+func (o *TenantsPrewarmForbidden) String() string {
+	return fmt.Sprintf("[POST /schema/{className}/tenants/{tenantName}/prewarm][%d] tenantsPrewarmForbidden  %+v", 403, o.Payload)
+}
+
+func (o *TenantsPrewarmForbidden) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *TenantsPrewarmForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewTenantsPrewarmNotFound creates a TenantsPrewarmNotFound with default headers values
+func NewTenantsPrewarmNotFound() *TenantsPrewarmNotFound {
+	return &TenantsPrewarmNotFound{}
+}
+
+/*
+TenantsPrewarmNotFound describes a response with status code 404, with default header values.
+
+The tenant not found
+*/
+type TenantsPrewarmNotFound struct {
+}
+
+// IsSuccess returns true when this tenants prewarm not found response has a 2xx status code
+func (o *TenantsPrewarmNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this tenants prewarm not found response has a 3xx status code
+func (o *TenantsPrewarmNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this tenants prewarm not found response has a 4xx status code
+func (o *TenantsPrewarmNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this tenants prewarm not found response has a 5xx status code
+func (o *TenantsPrewarmNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this tenants prewarm not found response a status code equal to that given
+func (o *TenantsPrewarmNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+// Code gets the status code for the tenants prewarm not found response
+func (o *TenantsPrewarmNotFound) Code() int {
+	return 404
+}
+
+func (o *TenantsPrewarmNotFound) Error() string {
+	return fmt.Sprintf("[POST /schema/{className}/tenants/{tenantName}/prewarm][%d] tenantsPrewarmNotFound ", 404)
+}
+
+func (o *TenantsPrewarmNotFound) String() string {
+	return fmt.Sprintf("[POST /schema/{className}/tenants/{tenantName}/prewarm][%d] tenantsPrewarmNotFound ", 404)
+}
+
+func (o *TenantsPrewarmNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewTenantsPrewarmUnprocessableEntity creates a TenantsPrewarmUnprocessableEntity with default headers values
+func NewTenantsPrewarmUnprocessableEntity() *TenantsPrewarmUnprocessableEntity {
+	return &TenantsPrewarmUnprocessableEntity{}
+}
+
+/*
+TenantsPrewarmUnprocessableEntity describes a response with status code 422, with default header values.
+
+The tenant is not active
+*/
+type TenantsPrewarmUnprocessableEntity struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this tenants prewarm unprocessable entity response has a 2xx status code
+func (o *TenantsPrewarmUnprocessableEntity) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this tenants prewarm unprocessable entity response has a 3xx status code
+func (o *TenantsPrewarmUnprocessableEntity) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this tenants prewarm unprocessable entity response has a 4xx status code
+func (o *TenantsPrewarmUnprocessableEntity) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this tenants prewarm unprocessable entity response has a 5xx status code
+func (o *TenantsPrewarmUnprocessableEntity) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this tenants prewarm unprocessable entity response a status code equal to that given
+func (o *TenantsPrewarmUnprocessableEntity) IsCode(code int) bool {
+	return code == 422
+}
+
+// Code gets the status code for the tenants prewarm unprocessable entity response
+func (o *TenantsPrewarmUnprocessableEntity) Code() int {
+	return 422
+}
+
+func (o *TenantsPrewarmUnprocessableEntity) Error() string {
+	return fmt.Sprintf("[POST /schema/{className}/tenants/{tenantName}/prewarm][%d] tenantsPrewarmUnprocessableEntity  %+v", 422, o.Payload)
+}
+
+func (o *TenantsPrewarmUnprocessableEntity) String() string {
+	return fmt.Sprintf("[POST /schema/{className}/tenants/{tenantName}/prewarm][%d] tenantsPrewarmUnprocessableEntity  %+v", 422, o.Payload)
+}
+
+func (o *TenantsPrewarmUnprocessableEntity) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *TenantsPrewarmUnprocessableEntity) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewTenantsPrewarmInternalServerError creates a TenantsPrewarmInternalServerError with default headers values
+func NewTenantsPrewarmInternalServerError() *TenantsPrewarmInternalServerError {
+	return &TenantsPrewarmInternalServerError{}
+}
+
+/*
+TenantsPrewarmInternalServerError describes a response with status code 500, with default header values.
+
+An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.
+*/
+type TenantsPrewarmInternalServerError struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this tenants prewarm internal server error response has a 2xx status code
+func (o *TenantsPrewarmInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this tenants prewarm internal server error response has a 3xx status code
+func (o *TenantsPrewarmInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this tenants prewarm internal server error response has a 4xx status code
+func (o *TenantsPrewarmInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this tenants prewarm internal server error response has a 5xx status code
+func (o *TenantsPrewarmInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this tenants prewarm internal server error response a status code equal to that given
+func (o *TenantsPrewarmInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+// Code gets the status code for the tenants prewarm internal server error response
+func (o *TenantsPrewarmInternalServerError) Code() int {
+	return 500
+}
+
+func (o *TenantsPrewarmInternalServerError) Error() string {
+	return fmt.Sprintf("[POST /schema/{className}/tenants/{tenantName}/prewarm][%d] tenantsPrewarmInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *TenantsPrewarmInternalServerError) String() string {
+	return fmt.Sprintf("[POST /schema/{className}/tenants/{tenantName}/prewarm][%d] tenantsPrewarmInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *TenantsPrewarmInternalServerError) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *TenantsPrewarmInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -25,10 +25,25 @@ type AddClassRequest struct {
 type UpdateClassRequest struct {
 	Class *models.Class
 	State *sharding.State
+	// Compact restricts the update to removing module configs, reference
+	// targets and properties of the class, see Parser.ParseClassCompaction
+	Compact bool `json:",omitempty"`
+	// MultiTenancyFrom is the multi-tenancy config the class is migrated
+	// from if the update enables or disables multi-tenancy of an empty class
+	MultiTenancyFrom *models.MultiTenancyConfig `json:",omitempty"`
+}
+
+// UpdateVectorIndexConfigRequest replaces the vector index configs of the
+// named vectors of a class, keyed by vector name
+type UpdateVectorIndexConfigRequest struct {
+	VectorIndexConfigs map[string]any
 }
 
 type AddPropertyRequest struct {
 	Properties []*models.Property
+	// MaxProperties is the number of properties the class may have after
+	// the request is applied, zero means no limit
+	MaxProperties int `json:",omitempty"`
 }
 
 type DeleteClassRequest struct {
@@ -40,6 +55,55 @@ type UpdateShardStatusRequest struct {
 	SchemaVersion        uint64
 }
 
+// MoveShardRequest replaces FromNode with ToNode in the replicas of Shard
+type MoveShardRequest struct {
+	Class, Shard     string
+	FromNode, ToNode string
+}
+
+// PurgeTenantRequest records that the data of the deleted Tenant of Class
+// was removed from storage at PurgedAt (unix milliseconds)
+type PurgeTenantRequest struct {
+	Class, Tenant string
+	PurgedAt      int64
+}
+
+// SoftDeleteClassRequest moves Class to the deleted classes at DeletedAt
+// until RetainUntil (both unix milliseconds)
+type SoftDeleteClassRequest struct {
+	Class       string
+	DeletedAt   int64
+	RetainUntil int64
+}
+
+// ReserveClassObjectsRequest adds Count to the object count of Class. A
+// negative Count releases objects, e.g. after deletes.
+type ReserveClassObjectsRequest struct {
+	Class string
+	Count int64
+	// Set replaces the object count with Count. It seeds the count of
+	// classes which get a limit from the objects they already have.
+	Set bool
+}
+
+// RecordBatchDeleteRequest adds a batch delete of Collection to the batch
+// delete history. Request and Reply are protobuf encoded, StartedAt and
+// CompletedAt are unix milliseconds.
+type RecordBatchDeleteRequest struct {
+	Collection  string
+	Request     []byte
+	Reply       []byte
+	Peer        string
+	StartedAt   int64
+	CompletedAt int64
+}
+
+// BatchRequest holds the schema commands of a transaction, in the order in
+// which they are applied
+type BatchRequest struct {
+	Commands []*ApplyRequest
+}
+
 type QueryReadOnlyClassesRequest struct {
 	Classes []string
 }
@@ -51,6 +115,11 @@ type QueryReadOnlyClassResponse struct {
 type QueryTenantsRequest struct {
 	Class   string
 	Tenants []string // If empty, all tenants are returned
+	// After and Limit page through the tenants ordered by name. Only tenants
+	// whose name sorts after After are returned, at most Limit of them if
+	// Limit is positive.
+	After string `json:",omitempty"`
+	Limit int    `json:",omitempty"`
 }
 
 type TenantWithVersion struct {
@@ -61,12 +130,18 @@ type TenantWithVersion struct {
 type QueryTenantsResponse struct {
 	ShardVersion uint64
 	Tenants      []*models.TenantResponse
+	// Total is the number of tenants of the request before paging
+	Total int `json:",omitempty"`
 }
 
 type QuerySchemaResponse struct {
 	Schema models.Schema
 }
 
+type QueryReadIndexResponse struct {
+	Index uint64
+}
+
 type QueryShardOwnerRequest struct {
 	Class, Shard string
 }
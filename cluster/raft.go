@@ -29,6 +29,9 @@ type Raft struct {
 	store        *Store
 	cl           client
 	log          *logrus.Logger
+
+	// txn buffers the commands of Execute instead of applying them, see Begin
+	txn *txn
 }
 
 // client to communicate with remote services
@@ -75,6 +78,11 @@ func (s *Raft) SchemaReader() schema.SchemaReader {
 	return s.store.SchemaReader()
 }
 
+// AppliedIndex returns the index of the last log entry applied on this node
+func (s *Raft) AppliedIndex() uint64 {
+	return s.store.lastAppliedIndex.Load()
+}
+
 func (s *Raft) WaitUntilDBRestored(ctx context.Context, period time.Duration, close chan struct{}) error {
 	return s.store.WaitToRestoreDB(ctx, period, close)
 }
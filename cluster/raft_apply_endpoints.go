@@ -23,6 +23,7 @@ import (
 	"github.com/weaviate/weaviate/cluster/schema"
 	"github.com/weaviate/weaviate/cluster/types"
 	"github.com/weaviate/weaviate/entities/models"
+	schemaConfig "github.com/weaviate/weaviate/entities/schema/config"
 	"github.com/weaviate/weaviate/usecases/monitoring"
 	"github.com/weaviate/weaviate/usecases/sharding"
 	"google.golang.org/protobuf/proto"
@@ -50,7 +51,10 @@ func (s *Raft) UpdateClass(ctx context.Context, cls *models.Class, ss *sharding.
 	if cls == nil || cls.Class == "" {
 		return 0, fmt.Errorf("nil class or empty class name : %w", schema.ErrBadRequest)
 	}
-	req := cmd.UpdateClassRequest{Class: cls, State: ss}
+	req := cmd.UpdateClassRequest{
+		Class: cls, State: ss, Compact: types.CompactionFromContext(ctx),
+		MultiTenancyFrom: types.MultiTenancyMigrationFromContext(ctx),
+	}
 	subCommand, err := json.Marshal(&req)
 	if err != nil {
 		return 0, fmt.Errorf("marshal request: %w", err)
@@ -63,6 +67,30 @@ func (s *Raft) UpdateClass(ctx context.Context, cls *models.Class, ss *sharding.
 	return s.Execute(ctx, command)
 }
 
+// UpdateVectorIndexConfig replaces the vector index configs of the named
+// vectors of class. Unlike UpdateClass the entry holds only the given configs.
+func (s *Raft) UpdateVectorIndexConfig(ctx context.Context, class string,
+	configs map[string]schemaConfig.VectorIndexConfig,
+) (uint64, error) {
+	if class == "" || len(configs) == 0 {
+		return 0, fmt.Errorf("empty class name or vector index configs : %w", schema.ErrBadRequest)
+	}
+	req := cmd.UpdateVectorIndexConfigRequest{VectorIndexConfigs: make(map[string]any, len(configs))}
+	for name, cfg := range configs {
+		req.VectorIndexConfigs[name] = cfg
+	}
+	subCommand, err := json.Marshal(&req)
+	if err != nil {
+		return 0, fmt.Errorf("marshal request: %w", err)
+	}
+	command := &cmd.ApplyRequest{
+		Type:       cmd.ApplyRequest_TYPE_UPDATE_VECTOR_INDEX_CONFIG,
+		Class:      class,
+		SubCommand: subCommand,
+	}
+	return s.Execute(ctx, command)
+}
+
 func (s *Raft) DeleteClass(ctx context.Context, name string) (uint64, error) {
 	command := &cmd.ApplyRequest{
 		Type:  cmd.ApplyRequest_TYPE_DELETE_CLASS,
@@ -71,6 +99,85 @@ func (s *Raft) DeleteClass(ctx context.Context, name string) (uint64, error) {
 	return s.Execute(ctx, command)
 }
 
+func (s *Raft) SoftDeleteClass(ctx context.Context, name string, retainUntil time.Time) (uint64, error) {
+	if name == "" {
+		return 0, fmt.Errorf("empty class name : %w", schema.ErrBadRequest)
+	}
+	req := cmd.SoftDeleteClassRequest{Class: name, DeletedAt: time.Now().UnixMilli(), RetainUntil: retainUntil.UnixMilli()}
+	subCommand, err := json.Marshal(&req)
+	if err != nil {
+		return 0, fmt.Errorf("marshal request: %w", err)
+	}
+	command := &cmd.ApplyRequest{
+		Type:       cmd.ApplyRequest_TYPE_SOFT_DELETE_CLASS,
+		Class:      name,
+		SubCommand: subCommand,
+	}
+	return s.Execute(ctx, command)
+}
+
+func (s *Raft) RestoreDeletedClass(ctx context.Context, name string) (uint64, error) {
+	command := &cmd.ApplyRequest{
+		Type:  cmd.ApplyRequest_TYPE_RESTORE_DELETED_CLASS,
+		Class: name,
+	}
+	return s.Execute(ctx, command)
+}
+
+func (s *Raft) PurgeDeletedClass(ctx context.Context, name string) (uint64, error) {
+	command := &cmd.ApplyRequest{
+		Type:  cmd.ApplyRequest_TYPE_PURGE_DELETED_CLASS,
+		Class: name,
+	}
+	return s.Execute(ctx, command)
+}
+
+// ReserveClassObjects adds count to the object count of class. It fails with
+// schema.ErrObjectLimitExceeded if the count would exceed the object limit of
+// the class.
+func (s *Raft) ReserveClassObjects(ctx context.Context, class string, count int64) (uint64, error) {
+	return s.reserveClassObjects(ctx, &cmd.ReserveClassObjectsRequest{Class: class, Count: count})
+}
+
+// SetClassObjectCount replaces the object count of class with count, even
+// if it exceeds the object limit of the class
+func (s *Raft) SetClassObjectCount(ctx context.Context, class string, count int64) (uint64, error) {
+	return s.reserveClassObjects(ctx, &cmd.ReserveClassObjectsRequest{Class: class, Count: count, Set: true})
+}
+
+func (s *Raft) reserveClassObjects(ctx context.Context, req *cmd.ReserveClassObjectsRequest) (uint64, error) {
+	if req.Class == "" {
+		return 0, fmt.Errorf("empty class name : %w", schema.ErrBadRequest)
+	}
+	subCommand, err := json.Marshal(req)
+	if err != nil {
+		return 0, fmt.Errorf("marshal request: %w", err)
+	}
+	command := &cmd.ApplyRequest{
+		Type:       cmd.ApplyRequest_TYPE_RESERVE_CLASS_OBJECTS,
+		Class:      req.Class,
+		SubCommand: subCommand,
+	}
+	return s.Execute(ctx, command)
+}
+
+// RecordBatchDelete adds the batch delete of req to the batch delete history
+func (s *Raft) RecordBatchDelete(ctx context.Context, req *cmd.RecordBatchDeleteRequest) (uint64, error) {
+	if req == nil || req.Collection == "" {
+		return 0, fmt.Errorf("nil request or empty collection : %w", schema.ErrBadRequest)
+	}
+	subCommand, err := json.Marshal(req)
+	if err != nil {
+		return 0, fmt.Errorf("marshal request: %w", err)
+	}
+	command := &cmd.ApplyRequest{
+		Type:       cmd.ApplyRequest_TYPE_RECORD_BATCH_DELETE,
+		Class:      req.Collection,
+		SubCommand: subCommand,
+	}
+	return s.Execute(ctx, command)
+}
+
 func (s *Raft) RestoreClass(ctx context.Context, cls *models.Class, ss *sharding.State) (uint64, error) {
 	if cls == nil || cls.Class == "" {
 		return 0, fmt.Errorf("nil class or empty class name : %w", schema.ErrBadRequest)
@@ -94,7 +201,7 @@ func (s *Raft) AddProperty(ctx context.Context, class string, props ...*models.P
 			return 0, fmt.Errorf("empty property or empty class name : %w", schema.ErrBadRequest)
 		}
 	}
-	req := cmd.AddPropertyRequest{Properties: props}
+	req := cmd.AddPropertyRequest{Properties: props, MaxProperties: s.store.cfg.MaxPropertiesPerClass}
 	subCommand, err := json.Marshal(&req)
 	if err != nil {
 		return 0, fmt.Errorf("marshal request: %w", err)
@@ -124,10 +231,28 @@ func (s *Raft) UpdateShardStatus(ctx context.Context, class, shard, status strin
 	return s.Execute(ctx, command)
 }
 
+func (s *Raft) MoveShard(ctx context.Context, class, shard, fromNode, toNode string) (uint64, error) {
+	if class == "" || shard == "" || fromNode == "" || toNode == "" {
+		return 0, fmt.Errorf("empty class, shard or node : %w", schema.ErrBadRequest)
+	}
+	req := cmd.MoveShardRequest{Class: class, Shard: shard, FromNode: fromNode, ToNode: toNode}
+	subCommand, err := json.Marshal(&req)
+	if err != nil {
+		return 0, fmt.Errorf("marshal request: %w", err)
+	}
+	command := &cmd.ApplyRequest{
+		Type:       cmd.ApplyRequest_TYPE_MOVE_SHARD,
+		Class:      req.Class,
+		SubCommand: subCommand,
+	}
+	return s.Execute(ctx, command)
+}
+
 func (s *Raft) AddTenants(ctx context.Context, class string, req *cmd.AddTenantsRequest) (uint64, error) {
 	if class == "" || req == nil {
 		return 0, fmt.Errorf("empty class name or nil request : %w", schema.ErrBadRequest)
 	}
+	req.MaxTenants = int64(s.store.cfg.MaxTenantsPerClass)
 	subCommand, err := proto.Marshal(req)
 	if err != nil {
 		return 0, fmt.Errorf("marshal request: %w", err)
@@ -172,6 +297,23 @@ func (s *Raft) DeleteTenants(ctx context.Context, class string, req *cmd.DeleteT
 	return s.Execute(ctx, command)
 }
 
+func (s *Raft) PurgeTenant(ctx context.Context, class, tenant string, purgedAt time.Time) (uint64, error) {
+	if class == "" || tenant == "" {
+		return 0, fmt.Errorf("empty class or tenant : %w", schema.ErrBadRequest)
+	}
+	req := cmd.PurgeTenantRequest{Class: class, Tenant: tenant, PurgedAt: purgedAt.UnixMilli()}
+	subCommand, err := json.Marshal(&req)
+	if err != nil {
+		return 0, fmt.Errorf("marshal request: %w", err)
+	}
+	command := &cmd.ApplyRequest{
+		Type:       cmd.ApplyRequest_TYPE_PURGE_TENANT,
+		Class:      req.Class,
+		SubCommand: subCommand,
+	}
+	return s.Execute(ctx, command)
+}
+
 func (s *Raft) UpdateTenantsProcess(ctx context.Context, class string, req *cmd.TenantProcessRequest) (uint64, error) {
 	if class == "" || req == nil {
 		return 0, fmt.Errorf("empty class name or nil request : %w", schema.ErrBadRequest)
@@ -197,12 +339,20 @@ func (s *Raft) StoreSchemaV1() error {
 }
 
 func (s *Raft) Execute(ctx context.Context, req *cmd.ApplyRequest) (uint64, error) {
+	if s.txn != nil {
+		return 0, s.txn.add(ctx, req)
+	}
+
 	t := prometheus.NewTimer(
 		monitoring.GetMetrics().SchemaWrites.WithLabelValues(
 			req.Type.String(),
 		))
 	defer t.ObserveDuration()
 
+	if req.Actor == "" {
+		req.Actor = types.ActorFromContext(ctx)
+	}
+
 	var schemaVersion uint64
 	err := backoff.Retry(func() error {
 		var err error
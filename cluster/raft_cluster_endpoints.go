@@ -99,3 +99,11 @@ func (s *Raft) Stats() map[string]any {
 	s.log.Debug("membership.stats")
 	return s.store.Stats()
 }
+
+// Snapshot triggers a snapshot of the local raft state which compacts the
+// local raft log. Unlike schema changes it doesn't go through the leader, as
+// every node compacts its own log.
+func (s *Raft) Snapshot() error {
+	s.log.Debug("membership.snapshot")
+	return s.store.TakeSnapshot()
+}
@@ -118,9 +118,36 @@ func (s *Raft) QuerySchema() (models.Schema, error) {
 	return resp.Schema, nil
 }
 
+// LinearizableRead blocks until the local schema has applied every change
+// committed before the call. The leader is asked for its read index, so
+// subsequent local reads observe a strongly consistent schema.
+func (s *Raft) LinearizableRead(ctx context.Context) error {
+	command := &cmd.QueryRequest{
+		Type: cmd.QueryRequest_TYPE_GET_READ_INDEX,
+	}
+	queryResp, err := s.Query(ctx, command)
+	if err != nil {
+		return fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	resp := cmd.QueryReadIndexResponse{}
+	if err := json.Unmarshal(queryResp.Payload, &resp); err != nil {
+		return fmt.Errorf("failed to unmarshal query result: %w", err)
+	}
+	return s.store.WaitForAppliedIndex(ctx, time.Millisecond*50, resp.Index)
+}
+
 // QueryTenants build a Query to read the tenants of a given class that will be directed to the leader to ensure we
 // will read the class with strong consistency
 func (s *Raft) QueryTenants(class string, tenants []string) ([]*models.TenantResponse, uint64, error) {
+	res, _, version, err := s.QueryTenantsPage(class, tenants, "", 0)
+	return res, version, err
+}
+
+// QueryTenantsPage is QueryTenants for a page of the tenants ordered by name.
+// Only tenants after the name after are returned, at most limit of them if
+// limit is positive. The number of tenants before paging is returned as well.
+func (s *Raft) QueryTenantsPage(class string, tenants []string, after string, limit int) ([]*models.TenantResponse, int, uint64, error) {
 	ctx := context.Background()
 	if entSentry.Enabled() {
 		transaction := sentry.StartSpan(ctx, "grpc.client",
@@ -133,10 +160,10 @@ func (s *Raft) QueryTenants(class string, tenants []string) ([]*models.TenantRes
 		defer transaction.Finish()
 	}
 	// Build the query and execute it
-	req := cmd.QueryTenantsRequest{Class: class, Tenants: tenants}
+	req := cmd.QueryTenantsRequest{Class: class, Tenants: tenants, After: after, Limit: limit}
 	subCommand, err := json.Marshal(&req)
 	if err != nil {
-		return []*models.TenantResponse{}, 0, fmt.Errorf("marshal request: %w", err)
+		return []*models.TenantResponse{}, 0, 0, fmt.Errorf("marshal request: %w", err)
 	}
 	command := &cmd.QueryRequest{
 		Type:       cmd.QueryRequest_TYPE_GET_TENANTS,
@@ -144,17 +171,17 @@ func (s *Raft) QueryTenants(class string, tenants []string) ([]*models.TenantRes
 	}
 	queryResp, err := s.Query(ctx, command)
 	if err != nil {
-		return []*models.TenantResponse{}, 0, fmt.Errorf("failed to execute query: %w", err)
+		return []*models.TenantResponse{}, 0, 0, fmt.Errorf("failed to execute query: %w", err)
 	}
 
 	// Unmarshal the response
 	resp := cmd.QueryTenantsResponse{}
 	err = json.Unmarshal(queryResp.Payload, &resp)
 	if err != nil {
-		return []*models.TenantResponse{}, 0, fmt.Errorf("failed to unmarshal query result: %w", err)
+		return []*models.TenantResponse{}, 0, 0, fmt.Errorf("failed to unmarshal query result: %w", err)
 	}
 
-	return resp.Tenants, resp.ShardVersion, nil
+	return resp.Tenants, resp.Total, resp.ShardVersion, nil
 }
 
 // QueryShardOwner build a Query to read the tenants of a given class that will be directed to the leader to ensure we
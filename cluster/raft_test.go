@@ -117,7 +117,10 @@ func TestRaftEndpoints(t *testing.T) {
 	getSchema, err := srv.QuerySchema()
 	assert.NoError(t, err)
 	assert.NotNil(t, getSchema)
-	assert.Equal(t, models.Schema{Classes: []*models.Class{readOnlyVClass[cls.Class].Class}}, getSchema)
+	assert.Equal(t, models.Schema{
+		Classes:       []*models.Class{readOnlyVClass[cls.Class].Class},
+		SchemaVersion: 1,
+	}, getSchema)
 
 	// QueryTenants all
 	getTenantsAll, _, err := srv.QueryTenants(cls.Class, []string{})
@@ -194,6 +197,9 @@ func TestRaftEndpoints(t *testing.T) {
 	assert.Equal(t, info, schemaReader.ClassInfo("C"))
 	assert.ErrorIs(t, srv.store.WaitForAppliedIndex(ctx, time.Millisecond*10, srv.store.lastAppliedIndex.Load()+1), types.ErrDeadlineExceeded)
 
+	// LinearizableRead
+	assert.Nil(t, srv.LinearizableRead(ctx))
+
 	// DeleteClass
 	_, err = srv.DeleteClass(ctx, "X")
 	assert.Nil(t, err)
@@ -257,6 +263,48 @@ func TestRaftEndpoints(t *testing.T) {
 	assert.Equal(t, info, schemaReader.ClassInfo("C"))
 	assert.Equal(t, "S2", schemaReader.CopyShardingState("C").Physical["T2"].Status)
 
+	// Transaction
+	txn, err := srv.Begin()
+	assert.Nil(t, err)
+	_, err = txn.AddClass(ctx, &models.Class{Class: "D"}, &sharding.State{Physical: map[string]sharding.Physical{}})
+	assert.Nil(t, err)
+	_, err = txn.AddProperty(ctx, "D", &models.Property{Name: "p1"})
+	assert.Nil(t, err)
+	assert.Equal(t, "", schemaReader.ClassEqual("D"))
+	// reads of the transaction see its changes
+	txnReader := txn.(interface{ SchemaReader() schema.SchemaReader }).SchemaReader()
+	assert.Equal(t, 1, txnReader.ClassInfo("D").Properties)
+	// changes which don't apply fail right away
+	_, err = txn.AddProperty(ctx, "Missing", &models.Property{Name: "p1"})
+	assert.ErrorIs(t, err, schema.ErrSchema)
+	assert.Nil(t, txn.Commit())
+	assert.Equal(t, "D", schemaReader.ClassEqual("D"))
+	assert.Equal(t, 1, schemaReader.ClassInfo("D").Properties)
+	_, err = txn.DeleteClass(ctx, "D")
+	assert.ErrorIs(t, err, types.ErrTxnDone)
+	assert.ErrorIs(t, txn.Commit(), types.ErrTxnDone)
+
+	// Transaction fails as a whole on conflicting changes
+	txn, err = srv.Begin()
+	assert.Nil(t, err)
+	_, err = txn.AddClass(ctx, &models.Class{Class: "E"}, &sharding.State{Physical: map[string]sharding.Physical{}})
+	assert.Nil(t, err)
+	_, err = txn.AddProperty(ctx, "E", &models.Property{Name: "p1"})
+	assert.Nil(t, err)
+	_, err = srv.AddClass(ctx, &models.Class{Class: "E"}, &sharding.State{Physical: map[string]sharding.Physical{}})
+	assert.Nil(t, err)
+	assert.ErrorIs(t, txn.Commit(), schema.ErrSchema)
+	assert.Equal(t, 0, schemaReader.ClassInfo("E").Properties)
+
+	// Transaction rolled back
+	txn, err = srv.Begin()
+	assert.Nil(t, err)
+	_, err = txn.DeleteClass(ctx, "D")
+	assert.Nil(t, err)
+	assert.Nil(t, txn.Rollback())
+	assert.ErrorIs(t, txn.Commit(), types.ErrTxnDone)
+	assert.Equal(t, "D", schemaReader.ClassEqual("D"))
+
 	// Self Join
 	assert.Nil(t, srv.Join(ctx, m.store.cfg.NodeID, addr, true))
 	assert.True(t, srv.store.IsLeader())
@@ -287,6 +335,8 @@ func TestRaftEndpoints(t *testing.T) {
 	// create snapshot
 	assert.Nil(t, srv.store.raft.Barrier(2*time.Second).Error())
 	assert.Nil(t, srv.store.raft.Snapshot().Error())
+	// nothing new to snapshot is not an error for user requested snapshots
+	assert.Nil(t, srv.Snapshot())
 
 	// restore from snapshot
 	assert.Nil(t, srv.Close(ctx))
@@ -315,11 +365,17 @@ func TestRaftStoreInit(t *testing.T) {
 	assert.ErrorIs(t, store.Join(m.store.cfg.NodeID, addr, true), types.ErrNotOpen)
 	assert.ErrorIs(t, store.Remove(m.store.cfg.NodeID), types.ErrNotOpen)
 	assert.ErrorIs(t, store.Notify(m.store.cfg.NodeID, addr), types.ErrNotOpen)
+	assert.ErrorIs(t, store.TakeSnapshot(), types.ErrNotOpen)
 
 	// Already Open
 	store.open.Store(true)
 	assert.Nil(t, store.Open(ctx))
 
+	// snapshot already running
+	store.snapshotting.Store(true)
+	assert.ErrorIs(t, store.TakeSnapshot(), types.ErrSnapshotInProgress)
+	store.snapshotting.Store(false)
+
 	// notify non voter
 	store.cfg.BootstrapExpect = 0
 	assert.Nil(t, store.Notify("A", "localhost:123"))
@@ -0,0 +1,167 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package cluster
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+
+	cmd "github.com/weaviate/weaviate/cluster/proto/api"
+	"github.com/weaviate/weaviate/cluster/schema"
+	"github.com/weaviate/weaviate/cluster/types"
+	"github.com/weaviate/weaviate/entities/models"
+	schemaConfig "github.com/weaviate/weaviate/entities/schema/config"
+	"github.com/weaviate/weaviate/usecases/sharding"
+)
+
+// txn buffers the commands of its write methods and applies them as a single
+// TYPE_BATCH command on Commit
+type txn struct {
+	// raft builds the commands like the endpoints of the parent, but its
+	// Execute hands them to add instead of applying them
+	raft   *Raft
+	parent *Raft
+	// scratch is a copy of the local schema with the buffered commands
+	// applied, see SchemaReader
+	scratch *schema.SchemaManager
+
+	mu       sync.Mutex
+	commands []*cmd.ApplyRequest
+	done     bool
+	// version is the schema version of the committed batch
+	version uint64
+}
+
+// Begin starts an optimistic schema transaction, see [types.Txn]
+func (s *Raft) Begin() (types.Txn, error) {
+	scratch, err := s.store.schemaManager.Copy()
+	if err != nil {
+		return nil, fmt.Errorf("copy schema: %w", err)
+	}
+	t := &txn{parent: s, scratch: scratch}
+	t.raft = &Raft{nodeSelector: s.nodeSelector, store: s.store, cl: s.cl, log: s.log, txn: t}
+	return t, nil
+}
+
+// SchemaReader reads the schema as it was when the transaction began with
+// the buffered changes applied
+func (t *txn) SchemaReader() schema.SchemaReader {
+	return t.scratch.NewSchemaReader()
+}
+
+func (t *txn) add(ctx context.Context, req *cmd.ApplyRequest) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.done {
+		return types.ErrTxnDone
+	}
+	if req.Actor == "" {
+		req.Actor = types.ActorFromContext(ctx)
+	}
+	// commands which don't apply fail right away like outside of a
+	// transaction, the batch is checked again on Commit
+	if err := t.parent.store.applySchemaCommand(t.scratch, req, true, false); err != nil {
+		return err
+	}
+	t.commands = append(t.commands, req)
+	return nil
+}
+
+func (t *txn) Commit() error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.done {
+		return types.ErrTxnDone
+	}
+	t.done = true
+	if len(t.commands) == 0 {
+		return nil
+	}
+
+	subCommand, err := json.Marshal(&cmd.BatchRequest{Commands: t.commands})
+	if err != nil {
+		return fmt.Errorf("marshal request: %w", err)
+	}
+	command := &cmd.ApplyRequest{
+		Type:       cmd.ApplyRequest_TYPE_BATCH,
+		SubCommand: subCommand,
+		Actor:      t.commands[0].Actor,
+	}
+	t.version, err = t.parent.Execute(context.Background(), command)
+	return err
+}
+
+// Version returns the schema version of the committed changes, 0 before
+// Commit and if there were none
+func (t *txn) Version() uint64 {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.version
+}
+
+func (t *txn) Rollback() error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.done {
+		return types.ErrTxnDone
+	}
+	t.done = true
+	t.commands = nil
+	return nil
+}
+
+func (t *txn) AddClass(ctx context.Context, cls *models.Class, ss *sharding.State) (uint64, error) {
+	return t.raft.AddClass(ctx, cls, ss)
+}
+
+func (t *txn) UpdateClass(ctx context.Context, cls *models.Class, ss *sharding.State) (uint64, error) {
+	return t.raft.UpdateClass(ctx, cls, ss)
+}
+
+func (t *txn) UpdateVectorIndexConfig(ctx context.Context, class string,
+	configs map[string]schemaConfig.VectorIndexConfig,
+) (uint64, error) {
+	return t.raft.UpdateVectorIndexConfig(ctx, class, configs)
+}
+
+func (t *txn) DeleteClass(ctx context.Context, name string) (uint64, error) {
+	return t.raft.DeleteClass(ctx, name)
+}
+
+func (t *txn) AddProperty(ctx context.Context, class string, props ...*models.Property) (uint64, error) {
+	return t.raft.AddProperty(ctx, class, props...)
+}
+
+func (t *txn) UpdateShardStatus(ctx context.Context, class, shard, status string) (uint64, error) {
+	return t.raft.UpdateShardStatus(ctx, class, shard, status)
+}
+
+func (t *txn) MoveShard(ctx context.Context, class, shard, fromNode, toNode string) (uint64, error) {
+	return t.raft.MoveShard(ctx, class, shard, fromNode, toNode)
+}
+
+func (t *txn) AddTenants(ctx context.Context, class string, req *cmd.AddTenantsRequest) (uint64, error) {
+	return t.raft.AddTenants(ctx, class, req)
+}
+
+func (t *txn) UpdateTenants(ctx context.Context, class string, req *cmd.UpdateTenantsRequest) (uint64, error) {
+	return t.raft.UpdateTenants(ctx, class, req)
+}
+
+func (t *txn) DeleteTenants(ctx context.Context, class string, req *cmd.DeleteTenantsRequest) (uint64, error) {
+	return t.raft.DeleteTenants(ctx, class, req)
+}
@@ -0,0 +1,138 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"sort"
+	"time"
+
+	command "github.com/weaviate/weaviate/cluster/proto/api"
+)
+
+// MaxBatchDeleteHistory is the number of batch deletes kept in the history,
+// the oldest ones are dropped first
+const MaxBatchDeleteHistory = 10_000
+
+// BatchDeleteHistoryEntry is a batch delete which was run by any node
+type BatchDeleteHistoryEntry struct {
+	// ID is the raft log index of the entry, it increases with every entry
+	ID         uint64 `json:"id"`
+	Collection string `json:"collection"`
+	// Request and Reply are the protobuf encoded BatchDeleteRequest and
+	// BatchDeleteReply of the gRPC API
+	Request     []byte    `json:"request"`
+	Reply       []byte    `json:"reply"`
+	Peer        string    `json:"peer,omitempty"`
+	StartedAt   time.Time `json:"started_at"`
+	CompletedAt time.Time `json:"completed_at"`
+}
+
+// BatchDeleteHistoryQuery selects entries of the batch delete history. Zero
+// values don't restrict the entries.
+type BatchDeleteHistoryQuery struct {
+	Collection string
+	// From and To bound the start of the batch deletes, From is inclusive
+	// and To is exclusive
+	From, To time.Time
+	// After is the ID of the last entry of the previous page
+	After uint64
+	// Limit is the maximum number of entries returned, all if <= 0
+	Limit int
+}
+
+func (q BatchDeleteHistoryQuery) matches(e *BatchDeleteHistoryEntry) bool {
+	return e.ID > q.After &&
+		(q.Collection == "" || e.Collection == q.Collection) &&
+		(q.From.IsZero() || !e.StartedAt.Before(q.From)) &&
+		(q.To.IsZero() || e.StartedAt.Before(q.To))
+}
+
+// batchDeleteHistory is a ring buffer of the last size entries
+type batchDeleteHistory struct {
+	entries []BatchDeleteHistoryEntry
+	// next is the position of the oldest entry once the buffer is full,
+	// it is overwritten by the next entry
+	next int
+	size int
+}
+
+func newBatchDeleteHistory(size int) *batchDeleteHistory {
+	return &batchDeleteHistory{size: size}
+}
+
+func (h *batchDeleteHistory) add(e BatchDeleteHistoryEntry) {
+	if len(h.entries) < h.size {
+		h.entries = append(h.entries, e)
+		return
+	}
+	h.entries[h.next] = e
+	h.next = (h.next + 1) % h.size
+}
+
+// at returns the i-th oldest entry
+func (h *batchDeleteHistory) at(i int) *BatchDeleteHistoryEntry {
+	return &h.entries[(h.next+i)%len(h.entries)]
+}
+
+// ordered returns the entries oldest first
+func (h *batchDeleteHistory) ordered() []BatchDeleteHistoryEntry {
+	return append(append(make([]BatchDeleteHistoryEntry, 0, len(h.entries)),
+		h.entries[h.next:]...), h.entries[:h.next]...)
+}
+
+// reset replaces the entries with the last size of entries, oldest first
+func (h *batchDeleteHistory) reset(entries []BatchDeleteHistoryEntry) {
+	if len(entries) > h.size {
+		entries = entries[len(entries)-h.size:]
+	}
+	h.entries = append(make([]BatchDeleteHistoryEntry, 0, len(entries)), entries...)
+	h.next = 0
+}
+
+// recordBatchDelete adds the batch delete of req to the history with id
+func (s *schema) recordBatchDelete(req *command.RecordBatchDeleteRequest, id uint64) {
+	s.Lock()
+	defer s.Unlock()
+
+	s.batchDeletes.add(BatchDeleteHistoryEntry{
+		ID:          id,
+		Collection:  req.Collection,
+		Request:     req.Request,
+		Reply:       req.Reply,
+		Peer:        req.Peer,
+		StartedAt:   time.UnixMilli(req.StartedAt).UTC(),
+		CompletedAt: time.UnixMilli(req.CompletedAt).UTC(),
+	})
+}
+
+// BatchDeleteHistory returns the entries selected by q, oldest first, and
+// whether there are more entries after the returned ones
+func (s *schema) BatchDeleteHistory(q BatchDeleteHistoryQuery) ([]BatchDeleteHistoryEntry, bool) {
+	s.RLock()
+	defer s.RUnlock()
+
+	h := s.batchDeletes
+	n := len(h.entries)
+	start := sort.Search(n, func(i int) bool { return h.at(i).ID > q.After })
+	var entries []BatchDeleteHistoryEntry
+	for i := start; i < n; i++ {
+		e := h.at(i)
+		if !q.matches(e) {
+			continue
+		}
+		if q.Limit > 0 && len(entries) == q.Limit {
+			return entries, true
+		}
+		entries = append(entries, *e)
+	}
+	return entries, false
+}
@@ -0,0 +1,102 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"sort"
+	"time"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+const (
+	// DefaultChangelogSize is the number of schema changes kept if not
+	// configured
+	DefaultChangelogSize = 1000
+	// MaxChangelogPrevious is the number of the latest changes which keep the
+	// class as it was before them, see SchemaChangeEntry.Previous. The
+	// changelog is part of every snapshot, so older entries drop it.
+	MaxChangelogPrevious = 100
+)
+
+// SchemaChangeEntry describes a single applied schema change
+type SchemaChangeEntry struct {
+	// Version is the schema version after the change was applied
+	Version uint64 `json:"version"`
+	// Timestamp is the time (UTC) the leader appended the change to the log
+	Timestamp time.Time `json:"timestamp"`
+	// Operation is the type of the change, e.g. ADD_CLASS
+	Operation string `json:"operation"`
+	Class     string `json:"class"`
+	// Actor is the user who requested the change, empty for internal changes
+	Actor string `json:"actor,omitempty"`
+	// Properties are the names of the properties added by ADD_PROPERTY
+	Properties []string `json:"properties,omitempty"`
+	// Tenants holds the tenant purged by PURGE_TENANT
+	Tenants []string `json:"tenants,omitempty"`
+	// Previous is the class as it was before UPDATE_CLASS or
+	// UPDATE_VECTOR_INDEX_CONFIG was applied. It is kept so that the update
+	// can be rolled back. Only the last MaxChangelogPrevious entries keep it.
+	Previous *models.Class `json:"previous,omitempty"`
+}
+
+// recordChange bumps the schema version and appends the change to the
+// changelog. It must be called for every applied schema change, in log order,
+// so that all nodes agree on the version.
+func (s *schema) recordChange(entry SchemaChangeEntry, at time.Time) uint64 {
+	s.Lock()
+	defer s.Unlock()
+
+	s.version++
+	entry.Version = s.version
+	entry.Timestamp = at.UTC()
+	s.changelog = append(s.changelog, entry)
+	if size := s.changelogSize; size > 0 && len(s.changelog) > size {
+		// copy to not keep the dropped entries reachable
+		s.changelog = append(make([]SchemaChangeEntry, 0, size), s.changelog[len(s.changelog)-size:]...)
+	}
+	if n := len(s.changelog) - MaxChangelogPrevious - 1; n >= 0 {
+		s.changelog[n].Previous = nil
+	}
+	return s.version
+}
+
+// dropOldPrevious removes the previous classes of changelog entries other
+// than the last MaxChangelogPrevious ones
+func dropOldPrevious(changelog []SchemaChangeEntry) {
+	for i := 0; i < len(changelog)-MaxChangelogPrevious; i++ {
+		changelog[i].Previous = nil
+	}
+}
+
+// SchemaVersion returns the number of schema changes applied so far
+func (s *schema) SchemaVersion() uint64 {
+	s.RLock()
+	defer s.RUnlock()
+	return s.version
+}
+
+// Changelog returns up to limit retained changes with a version greater than
+// since, oldest first. A limit <= 0 returns all of them.
+func (s *schema) Changelog(since uint64, limit int) []SchemaChangeEntry {
+	s.RLock()
+	defer s.RUnlock()
+
+	start := sort.Search(len(s.changelog), func(i int) bool {
+		return s.changelog[i].Version > since
+	})
+	entries := s.changelog[start:]
+	if limit > 0 && len(entries) > limit {
+		entries = entries[:limit]
+	}
+	return append([]SchemaChangeEntry(nil), entries...)
+}
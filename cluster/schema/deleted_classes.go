@@ -0,0 +1,134 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	command "github.com/weaviate/weaviate/cluster/proto/api"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// DeletedClass is a soft deleted class, which is kept with its data until
+// RetainUntil
+type DeletedClass struct {
+	Class       *models.Class
+	DeletedAt   time.Time
+	RetainUntil time.Time
+}
+
+// deletedClass is a class moved out of the schema by SOFT_DELETE_CLASS
+type deletedClass struct {
+	Meta *metaClass `json:"meta"`
+	// DeletedAt and RetainUntil are unix milliseconds
+	DeletedAt   int64 `json:"deleted_at"`
+	RetainUntil int64 `json:"retain_until"`
+}
+
+// deletedClassFolded returns the name of a soft deleted class which differs
+// from name only by case. The files of both would share a directory.
+func (s *schema) deletedClassFolded(name string) string {
+	for deleted := range s.DeletedClasses {
+		if strings.EqualFold(deleted, name) {
+			return deleted
+		}
+	}
+	return ""
+}
+
+func (s *schema) softDeleteClass(req *command.SoftDeleteClassRequest) error {
+	s.Lock()
+	defer s.Unlock()
+
+	meta := s.Classes[req.Class]
+	if meta == nil {
+		return ErrClassNotFound
+	}
+	if children := s.childClasses(req.Class); len(children) > 0 {
+		return fmt.Errorf("%w: class %q is extended by %s", ErrBadRequest, req.Class, strings.Join(children, ", "))
+	}
+	delete(s.Classes, req.Class)
+	if s.DeletedClasses == nil {
+		s.DeletedClasses = make(map[string]*deletedClass)
+	}
+	s.DeletedClasses[req.Class] = &deletedClass{Meta: meta, DeletedAt: req.DeletedAt, RetainUntil: req.RetainUntil}
+	s.objectCounts.invalidate(req.Class)
+	return nil
+}
+
+// restoreDeletedClass moves the soft deleted class name back into the schema
+// and returns the request to load its index with
+func (s *schema) restoreDeletedClass(name string, v uint64) (command.AddClassRequest, error) {
+	s.Lock()
+	defer s.Unlock()
+
+	deleted := s.DeletedClasses[name]
+	if deleted == nil {
+		return command.AddClassRequest{}, fmt.Errorf("deleted %w", ErrClassNotFound)
+	}
+	for existing := range s.Classes {
+		if strings.EqualFold(existing, name) {
+			return command.AddClassRequest{}, fmt.Errorf("%w: %q", ErrClassExists, existing)
+		}
+	}
+	delete(s.DeletedClasses, name)
+
+	meta := deleted.Meta
+	meta.ClassVersion = v
+	s.Classes[name] = meta
+	class := meta.Class
+	state := meta.Sharding.DeepCopy()
+	return command.AddClassRequest{Class: &class, State: &state}, nil
+}
+
+// purgeDeletedClass forgets the soft deleted class name and returns whether
+// it has frozen tenants, whose data is offloaded
+func (s *schema) purgeDeletedClass(name string) (bool, error) {
+	s.Lock()
+	defer s.Unlock()
+
+	deleted := s.DeletedClasses[name]
+	if deleted == nil {
+		return false, fmt.Errorf("deleted %w", ErrClassNotFound)
+	}
+	delete(s.DeletedClasses, name)
+
+	hasFrozen := false
+	for _, shard := range deleted.Meta.Sharding.Physical {
+		if shard.ActivityStatus() == models.TenantActivityStatusFROZEN ||
+			shard.ActivityStatus() == models.TenantActivityStatusFREEZING {
+			hasFrozen = true
+		}
+	}
+	return hasFrozen, nil
+}
+
+// ReadOnlyDeletedClasses returns copies of the soft deleted classes, ordered
+// by name
+func (s *schema) ReadOnlyDeletedClasses() []DeletedClass {
+	s.RLock()
+	defer s.RUnlock()
+
+	classes := make([]DeletedClass, 0, len(s.DeletedClasses))
+	for _, deleted := range s.DeletedClasses {
+		classes = append(classes, DeletedClass{
+			Class:       deleted.Meta.CloneClass(),
+			DeletedAt:   time.UnixMilli(deleted.DeletedAt),
+			RetainUntil: time.UnixMilli(deleted.RetainUntil),
+		})
+	}
+	sort.Slice(classes, func(i, j int) bool { return classes[i].Class.Class < classes[j].Class.Class })
+	return classes
+}
@@ -12,16 +12,20 @@
 package schema
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
+	"strings"
+	"time"
 
 	"github.com/hashicorp/raft"
 	"github.com/sirupsen/logrus"
 	command "github.com/weaviate/weaviate/cluster/proto/api"
 	"github.com/weaviate/weaviate/entities/models"
+	entSchema "github.com/weaviate/weaviate/entities/schema"
 	gproto "google.golang.org/protobuf/proto"
 )
 
@@ -38,15 +42,57 @@ type SchemaManager struct {
 	log    *logrus.Logger
 }
 
-func NewSchemaManager(nodeId string, db Indexer, parser Parser, log *logrus.Logger) *SchemaManager {
+// NewSchemaManager creates the schema state machine. changelogSize is the
+// number of schema changes retained in the changelog, DefaultChangelogSize is
+// used if it is 0.
+func NewSchemaManager(nodeId string, db Indexer, parser Parser, log *logrus.Logger, changelogSize int) *SchemaManager {
+	s := NewSchema(nodeId, db)
+	if changelogSize > 0 {
+		s.changelogSize = changelogSize
+	}
 	return &SchemaManager{
-		schema: NewSchema(nodeId, db),
+		schema: s,
 		db:     db,
 		parser: parser,
 		log:    log,
 	}
 }
 
+// RecordChange adds a successfully applied command to the schema changelog
+// and returns the new schema version. at is the time the command was
+// appended to the log by the leader, previous the class as it was before an
+// UPDATE_CLASS or UPDATE_VECTOR_INDEX_CONFIG command was applied.
+func (s *SchemaManager) RecordChange(cmd *command.ApplyRequest, at time.Time, previous *models.Class) uint64 {
+	entry := SchemaChangeEntry{
+		Operation: strings.TrimPrefix(cmd.Type.String(), "TYPE_"),
+		Class:     cmd.Class,
+		Actor:     cmd.Actor,
+	}
+	switch cmd.Type {
+	case command.ApplyRequest_TYPE_UPDATE_CLASS, command.ApplyRequest_TYPE_UPDATE_VECTOR_INDEX_CONFIG:
+		entry.Previous = previous
+	case command.ApplyRequest_TYPE_PURGE_TENANT:
+		req := command.PurgeTenantRequest{}
+		if err := json.Unmarshal(cmd.SubCommand, &req); err == nil {
+			entry.Tenants = []string{req.Tenant}
+		}
+	case command.ApplyRequest_TYPE_ADD_PROPERTY:
+		req := command.AddPropertyRequest{}
+		if err := json.Unmarshal(cmd.SubCommand, &req); err == nil {
+			for _, p := range req.Properties {
+				entry.Properties = append(entry.Properties, p.Name)
+			}
+		}
+	}
+	return s.schema.recordChange(entry, at)
+}
+
+// ReadOnlyClass returns a shallow copy of class, nil if it does not exist
+func (s *SchemaManager) ReadOnlyClass(class string) *models.Class {
+	cls, _ := s.schema.ReadOnlyClass(class)
+	return cls
+}
+
 func (s *SchemaManager) NewSchemaReader() SchemaReader {
 	return SchemaReader{
 		schema: s.schema,
@@ -74,6 +120,31 @@ func (s *SchemaManager) SetIndexer(idx Indexer) {
 	s.schema.shardReader = idx
 }
 
+// Copy returns a schema manager holding a deep copy of the schema without the
+// changelog, e.g. to check that commands apply cleanly before applying them.
+// The copy shares the db, commands must be applied to it with schemaOnly and
+// without schema callbacks.
+func (s *SchemaManager) Copy() (*SchemaManager, error) {
+	var buf bytes.Buffer
+	s.schema.RLock()
+	err := json.NewEncoder(&buf).Encode(&snapshot{
+		NodeID:         s.schema.nodeID,
+		Classes:        s.schema.Classes,
+		DeletedClasses: s.schema.DeletedClasses,
+		SchemaVersion:  s.schema.version,
+	})
+	s.schema.RUnlock()
+	if err != nil {
+		return nil, fmt.Errorf("encode schema: %w", err)
+	}
+
+	cp := &SchemaManager{schema: NewSchema(s.schema.nodeID, s.db), db: s.db, parser: s.parser, log: s.log}
+	if err := cp.schema.Restore(&buf, s.parser); err != nil {
+		return nil, err
+	}
+	return cp, nil
+}
+
 func (s *SchemaManager) Snapshot() raft.FSMSnapshot {
 	return s.schema
 }
@@ -149,8 +220,15 @@ func (s *SchemaManager) AddClass(cmd *command.ApplyRequest, nodeID string, schem
 	shardingStateCopy := req.State.DeepCopy()
 	return s.apply(
 		applyOp{
-			op:                   cmd.GetType().String(),
-			updateSchema:         func() error { return s.schema.addClass(req.Class, &shardingStateCopy, cmd.Version) },
+			op: cmd.GetType().String(),
+			updateSchema: func() error {
+				// unlike in RestoreClass, where classes may be restored before
+				// the class they extend
+				if err := s.schema.checkParent(req.Class); err != nil {
+					return err
+				}
+				return s.schema.addClass(req.Class, &shardingStateCopy, cmd.Version)
+			},
 			updateStore:          func() error { return s.db.AddClass(req) },
 			schemaOnly:           schemaOnly,
 			enableSchemaCallback: enableSchemaCallback,
@@ -221,6 +299,21 @@ func (s *SchemaManager) UpdateClass(cmd *command.ApplyRequest, nodeID string, sc
 		meta.Class.ReplicationConfig = u.ReplicationConfig
 		meta.Class.MultiTenancyConfig = u.MultiTenancyConfig
 		meta.Class.Description = u.Description
+		// only the mutable settings of properties may differ, see ParseClassUpdate
+		meta.Class.Properties = u.Properties
+		meta.Class.EnforceDeprecation = u.EnforceDeprecation
+		meta.Class.StrictPropertyValidation = u.StrictPropertyValidation
+		meta.Class.ReadOnly = u.ReadOnly
+		meta.Class.MaxObjects = u.MaxObjects
+		// older clients don't send the query timeout
+		if u.QueryTimeout != nil {
+			meta.Class.QueryTimeout = u.QueryTimeout
+		}
+		meta.Class.CompactionConfig = u.CompactionConfig
+		meta.Class.Labels = u.Labels
+		meta.Class.Annotations = u.Annotations
+		meta.Class.ACL = u.ACL
+		meta.Class.ExpiresAt = u.ExpiresAt
 		meta.ClassVersion = cmd.Version
 		if req.State != nil {
 			meta.Sharding = *req.State
@@ -228,11 +321,109 @@ func (s *SchemaManager) UpdateClass(cmd *command.ApplyRequest, nodeID string, sc
 		return nil
 	}
 
+	updateStore := func() error { return s.db.UpdateClass(req) }
+	if req.Compact {
+		update = func(meta *metaClass) error {
+			u, err := s.parser.ParseClassCompaction(&meta.Class, req.Class)
+			if err != nil {
+				return fmt.Errorf("%w :parse class compaction: %w", ErrBadRequest, err)
+			}
+			meta.Class.ModuleConfig = u.ModuleConfig
+			meta.Class.Properties = u.Properties
+			meta.ClassVersion = cmd.Version
+			return nil
+		}
+		// the settings of the indexes don't change
+		updateStore = func() error { return nil }
+	}
+	if req.MultiTenancyFrom != nil {
+		update = func(meta *metaClass) error {
+			if entSchema.MultiTenancyEnabled(&meta.Class) != req.MultiTenancyFrom.Enabled {
+				return fmt.Errorf("%w: multi-tenancy of class %q was changed concurrently", ErrBadRequest, req.Class.Class)
+			}
+			if req.State == nil {
+				return fmt.Errorf("%w: nil sharding state", ErrBadRequest)
+			}
+			if !entSchema.MultiTenancyEnabled(req.Class) && len(meta.Sharding.Physical) > 0 {
+				return fmt.Errorf("%w: class %q has tenants", ErrBadRequest, req.Class.Class)
+			}
+			if err := s.parser.ParseClass(req.Class); err != nil {
+				return fmt.Errorf("%w: parsing class: %w", ErrBadRequest, err)
+			}
+			// the class is empty, hence only the tenancy and sharding change.
+			// Writes are blocked while the transition is prepared, it
+			// restores the read-only mode the class had before.
+			meta.Class.MultiTenancyConfig = req.Class.MultiTenancyConfig
+			meta.Class.ShardingConfig = req.Class.ShardingConfig
+			meta.Class.ReadOnly = req.Class.ReadOnly
+			meta.Sharding = req.State.DeepCopy()
+			meta.ClassVersion = cmd.Version
+			return nil
+		}
+	}
+
 	return s.apply(
 		applyOp{
 			op:                   cmd.GetType().String(),
 			updateSchema:         func() error { return s.schema.updateClass(req.Class.Class, update) },
-			updateStore:          func() error { return s.db.UpdateClass(req) },
+			updateStore:          updateStore,
+			schemaOnly:           schemaOnly,
+			enableSchemaCallback: enableSchemaCallback,
+		},
+	)
+}
+
+// UpdateVectorIndexConfig replaces the vector index configs of the named
+// vectors of a class and leaves the rest of the class as it is
+func (s *SchemaManager) UpdateVectorIndexConfig(cmd *command.ApplyRequest, schemaOnly bool, enableSchemaCallback bool) error {
+	req := command.UpdateVectorIndexConfigRequest{}
+	if err := json.Unmarshal(cmd.SubCommand, &req); err != nil {
+		return fmt.Errorf("%w: %w", ErrBadRequest, err)
+	}
+	if len(req.VectorIndexConfigs) == 0 {
+		return fmt.Errorf("%w: empty vector index configs", ErrBadRequest)
+	}
+
+	update := func(meta *metaClass) error {
+		migratePropertiesIfNecessary(&meta.Class)
+		// the parser expects an update as it has been unmarshaled, so the
+		// current class is round-tripped through JSON before applying the configs
+		b, err := json.Marshal(&meta.Class)
+		if err != nil {
+			return fmt.Errorf("marshal class: %w", err)
+		}
+		var updated models.Class
+		if err := json.Unmarshal(b, &updated); err != nil {
+			return fmt.Errorf("unmarshal class: %w", err)
+		}
+		for name, cfg := range req.VectorIndexConfigs {
+			// "" is the legacy vector index of classes without named vectors
+			if name == "" && len(updated.VectorConfig) == 0 {
+				updated.VectorIndexConfig = cfg
+				continue
+			}
+			vc, ok := updated.VectorConfig[name]
+			if !ok {
+				return fmt.Errorf("%w: class %q has no vector %q", ErrBadRequest, cmd.Class, name)
+			}
+			vc.VectorIndexConfig = cfg
+			updated.VectorConfig[name] = vc
+		}
+		u, err := s.parser.ParseClassUpdate(&meta.Class, &updated)
+		if err != nil {
+			return fmt.Errorf("%w :parse class update: %w", ErrBadRequest, err)
+		}
+		meta.Class.VectorConfig = u.VectorConfig
+		meta.Class.VectorIndexConfig = u.VectorIndexConfig
+		meta.ClassVersion = cmd.Version
+		return nil
+	}
+
+	return s.apply(
+		applyOp{
+			op:                   cmd.GetType().String(),
+			updateSchema:         func() error { return s.schema.updateClass(cmd.Class, update) },
+			updateStore:          func() error { return s.db.UpdateVectorIndexConfig(cmd.Class, req) },
 			schemaOnly:           schemaOnly,
 			enableSchemaCallback: enableSchemaCallback,
 		},
@@ -257,7 +448,7 @@ func (s *SchemaManager) DeleteClass(cmd *command.ApplyRequest, schemaOnly bool,
 	return s.apply(
 		applyOp{
 			op:                   cmd.GetType().String(),
-			updateSchema:         func() error { s.schema.deleteClass(cmd.Class); return nil },
+			updateSchema:         func() error { return s.schema.deleteClass(cmd.Class) },
 			updateStore:          func() error { return s.db.DeleteClass(cmd.Class, hasFrozen) },
 			schemaOnly:           schemaOnly,
 			enableSchemaCallback: enableSchemaCallback,
@@ -265,6 +456,59 @@ func (s *SchemaManager) DeleteClass(cmd *command.ApplyRequest, schemaOnly bool,
 	)
 }
 
+// SoftDeleteClass moves a class to the deleted classes. Its local index is
+// closed, the files are kept until the class is restored or purged.
+func (s *SchemaManager) SoftDeleteClass(cmd *command.ApplyRequest, schemaOnly bool, enableSchemaCallback bool) error {
+	req := command.SoftDeleteClassRequest{}
+	if err := json.Unmarshal(cmd.SubCommand, &req); err != nil {
+		return fmt.Errorf("%w: %w", ErrBadRequest, err)
+	}
+
+	return s.apply(
+		applyOp{
+			op:                   cmd.GetType().String(),
+			updateSchema:         func() error { return s.schema.softDeleteClass(&req) },
+			updateStore:          func() error { return s.db.UnloadClass(cmd.Class) },
+			schemaOnly:           schemaOnly,
+			enableSchemaCallback: enableSchemaCallback,
+		},
+	)
+}
+
+// RestoreDeletedClass moves a soft deleted class back and loads its index
+func (s *SchemaManager) RestoreDeletedClass(cmd *command.ApplyRequest, schemaOnly bool, enableSchemaCallback bool) error {
+	var req command.AddClassRequest
+	return s.apply(
+		applyOp{
+			op: cmd.GetType().String(),
+			updateSchema: func() (err error) {
+				req, err = s.schema.restoreDeletedClass(cmd.Class, cmd.Version)
+				return err
+			},
+			updateStore:          func() error { return s.db.AddClass(req) },
+			schemaOnly:           schemaOnly,
+			enableSchemaCallback: enableSchemaCallback,
+		},
+	)
+}
+
+// PurgeDeletedClass removes a soft deleted class and its files
+func (s *SchemaManager) PurgeDeletedClass(cmd *command.ApplyRequest, schemaOnly bool, enableSchemaCallback bool) error {
+	var hasFrozen bool
+	return s.apply(
+		applyOp{
+			op: cmd.GetType().String(),
+			updateSchema: func() (err error) {
+				hasFrozen, err = s.schema.purgeDeletedClass(cmd.Class)
+				return err
+			},
+			updateStore:          func() error { return s.db.DropUnloadedClass(cmd.Class, hasFrozen) },
+			schemaOnly:           schemaOnly,
+			enableSchemaCallback: enableSchemaCallback,
+		},
+	)
+}
+
 func (s *SchemaManager) AddProperty(cmd *command.ApplyRequest, schemaOnly bool, enableSchemaCallback bool) error {
 	req := command.AddPropertyRequest{}
 	if err := json.Unmarshal(cmd.SubCommand, &req); err != nil {
@@ -276,8 +520,10 @@ func (s *SchemaManager) AddProperty(cmd *command.ApplyRequest, schemaOnly bool,
 
 	return s.apply(
 		applyOp{
-			op:                   cmd.GetType().String(),
-			updateSchema:         func() error { return s.schema.addProperty(cmd.Class, cmd.Version, req.Properties...) },
+			op: cmd.GetType().String(),
+			updateSchema: func() error {
+				return s.schema.addProperty(cmd.Class, cmd.Version, req.MaxProperties, req.Properties...)
+			},
 			updateStore:          func() error { return s.db.AddProperty(cmd.Class, req) },
 			schemaOnly:           schemaOnly,
 			enableSchemaCallback: enableSchemaCallback,
@@ -301,6 +547,24 @@ func (s *SchemaManager) UpdateShardStatus(cmd *command.ApplyRequest, schemaOnly
 	)
 }
 
+// MoveShard hands the replica of a shard over from one node to another. The
+// data must already have been copied to the target node.
+func (s *SchemaManager) MoveShard(cmd *command.ApplyRequest, schemaOnly bool) error {
+	req := command.MoveShardRequest{}
+	if err := json.Unmarshal(cmd.SubCommand, &req); err != nil {
+		return fmt.Errorf("%w: %w", ErrBadRequest, err)
+	}
+
+	return s.apply(
+		applyOp{
+			op:           cmd.GetType().String(),
+			updateSchema: func() error { return s.schema.moveShard(cmd.Class, cmd.Version, &req) },
+			updateStore:  func() error { return s.db.MoveShard(&req) },
+			schemaOnly:   schemaOnly,
+		},
+	)
+}
+
 func (s *SchemaManager) AddTenants(cmd *command.ApplyRequest, schemaOnly bool) error {
 	req := &command.AddTenantsRequest{}
 	if err := gproto.Unmarshal(cmd.SubCommand, req); err != nil {
@@ -352,6 +616,55 @@ func (s *SchemaManager) DeleteTenants(cmd *command.ApplyRequest, schemaOnly bool
 	)
 }
 
+// PurgeTenant records that the data of a deleted tenant was removed. It
+// doesn't change the schema, the command is kept in the changelog.
+func (s *SchemaManager) PurgeTenant(cmd *command.ApplyRequest, schemaOnly bool) error {
+	req := command.PurgeTenantRequest{}
+	if err := json.Unmarshal(cmd.SubCommand, &req); err != nil {
+		return fmt.Errorf("%w: %w", ErrBadRequest, err)
+	}
+
+	return s.apply(
+		applyOp{
+			op:           cmd.GetType().String(),
+			updateSchema: func() error { return s.schema.purgeTenant(cmd.Class, &req) },
+			updateStore:  func() error { return nil },
+			schemaOnly:   schemaOnly,
+		},
+	)
+}
+
+// ReserveClassObjects counts objects against the object limit of a class. It
+// doesn't change the schema and isn't kept in the changelog.
+func (s *SchemaManager) ReserveClassObjects(cmd *command.ApplyRequest, schemaOnly bool) error {
+	req := command.ReserveClassObjectsRequest{}
+	if err := json.Unmarshal(cmd.SubCommand, &req); err != nil {
+		return fmt.Errorf("%w: %w", ErrBadRequest, err)
+	}
+
+	return s.apply(
+		applyOp{
+			op:           cmd.GetType().String(),
+			updateSchema: func() error { return s.schema.reserveClassObjects(&req) },
+			updateStore:  func() error { return nil },
+			schemaOnly:   schemaOnly,
+		},
+	)
+}
+
+// RecordBatchDelete adds a batch delete to the batch delete history, the
+// raft log index of cmd is the ID of the entry. It doesn't change the schema
+// and isn't kept in the changelog.
+func (s *SchemaManager) RecordBatchDelete(cmd *command.ApplyRequest) error {
+	req := command.RecordBatchDeleteRequest{}
+	if err := json.Unmarshal(cmd.SubCommand, &req); err != nil {
+		return fmt.Errorf("%w: %w", ErrBadRequest, err)
+	}
+
+	s.schema.recordBatchDelete(&req, cmd.Version)
+	return nil
+}
+
 func (s *SchemaManager) UpdateTenantsProcess(cmd *command.ApplyRequest, schemaOnly bool) error {
 	req := &command.TenantProcessRequest{}
 	if err := gproto.Unmarshal(cmd.SubCommand, req); err != nil {
@@ -60,13 +60,14 @@ func (sm *SchemaManager) QueryTenants(req *cmd.QueryRequest) ([]byte, error) {
 	}
 
 	// Read the tenants
-	tenants, err := sm.schema.getTenants(subCommand.Class, subCommand.Tenants)
+	tenants, total, err := sm.schema.getTenantsPage(subCommand.Class, subCommand.Tenants,
+		subCommand.After, subCommand.Limit)
 	if err != nil {
 		return []byte{}, fmt.Errorf("could not get tenants: %w", err)
 	}
 
 	// Build the response, marshal and return
-	response := cmd.QueryTenantsResponse{Tenants: tenants}
+	response := cmd.QueryTenantsResponse{Tenants: tenants, Total: total}
 	payload, err := json.Marshal(&response)
 	if err != nil {
 		return []byte{}, fmt.Errorf("could not marshal query response: %w", err)
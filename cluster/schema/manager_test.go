@@ -14,14 +14,18 @@ package schema
 import (
 	"bytes"
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
+	"runtime"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
+	command "github.com/weaviate/weaviate/cluster/proto/api"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/usecases/fakes"
 	"github.com/weaviate/weaviate/usecases/sharding"
@@ -280,6 +284,7 @@ func TestSchemaSnapshot(t *testing.T) {
 	)
 	ss.SetLocalName(node)
 	assert.Nil(t, sc.addClass(cls, ss, 1))
+	sc.recordChange(SchemaChangeEntry{Operation: "ADD_CLASS", Class: "C", Actor: "john"}, time.UnixMilli(1))
 	parser.On("ParseClass", mock.Anything).Return(nil)
 
 	// Create Snapshot
@@ -290,6 +295,8 @@ func TestSchemaSnapshot(t *testing.T) {
 	sc2 := NewSchema("N1", fakes.NewMockSchemaExecutor())
 	assert.Nil(t, sc2.Restore(sink, parser))
 	assert.Equal(t, sc.Classes, sc2.Classes)
+	assert.Equal(t, uint64(1), sc2.SchemaVersion())
+	assert.Equal(t, sc.Changelog(0, 0), sc2.Changelog(0, 0))
 
 	// Encoding error
 	sink2 := &MockSnapshotSink{wErr: errAny, rErr: errAny}
@@ -307,6 +314,85 @@ func TestSchemaSnapshot(t *testing.T) {
 	assert.ErrorContains(t, sc.Restore(sink3, parser2), "pars")
 }
 
+func TestSchemaChangelog(t *testing.T) {
+	sc := NewSchema("N1", fakes.NewMockSchemaExecutor())
+	sc.changelogSize = 3
+	assert.Zero(t, sc.SchemaVersion())
+	assert.Empty(t, sc.Changelog(0, 0))
+
+	for i, class := range []string{"A", "B", "C", "D", "E"} {
+		version := sc.recordChange(SchemaChangeEntry{Operation: "ADD_CLASS", Class: class}, time.UnixMilli(int64(i)))
+		assert.Equal(t, uint64(i+1), version)
+	}
+	assert.Equal(t, uint64(5), sc.SchemaVersion())
+
+	classes := func(entries []SchemaChangeEntry) (cs []string) {
+		for _, e := range entries {
+			cs = append(cs, e.Class)
+		}
+		return cs
+	}
+	// only the last 3 changes are retained
+	assert.Equal(t, []string{"C", "D", "E"}, classes(sc.Changelog(0, 0)))
+	assert.Equal(t, []string{"D", "E"}, classes(sc.Changelog(3, 0)))
+	assert.Equal(t, []string{"C", "D"}, classes(sc.Changelog(1, 2)))
+	assert.Empty(t, sc.Changelog(5, 0))
+
+	entry := sc.Changelog(4, 1)[0]
+	assert.Equal(t, SchemaChangeEntry{
+		Version:   5,
+		Timestamp: time.UnixMilli(4).UTC(),
+		Operation: "ADD_CLASS",
+		Class:     "E",
+	}, entry)
+}
+
+func TestSchemaChangelogPrevious(t *testing.T) {
+	sc := NewSchema("N1", fakes.NewMockSchemaExecutor())
+	previous := &models.Class{Class: "C"}
+	for i := 0; i < MaxChangelogPrevious+2; i++ {
+		sc.recordChange(SchemaChangeEntry{Operation: "UPDATE_CLASS", Class: "C", Previous: previous}, time.Now())
+	}
+
+	// only the last MaxChangelogPrevious entries keep the previous class
+	entries := sc.Changelog(0, 0)
+	require.Len(t, entries, MaxChangelogPrevious+2)
+	assert.Nil(t, entries[0].Previous)
+	assert.Nil(t, entries[1].Previous)
+	for _, e := range entries[2:] {
+		assert.NotNil(t, e.Previous)
+	}
+
+	t.Run("restored snapshot", func(t *testing.T) {
+		changelog := make([]SchemaChangeEntry, MaxChangelogPrevious+1)
+		for i := range changelog {
+			changelog[i].Previous = previous
+		}
+		dropOldPrevious(changelog)
+		assert.Nil(t, changelog[0].Previous)
+		assert.NotNil(t, changelog[1].Previous)
+	})
+}
+
+func TestSchemaManagerRecordChange(t *testing.T) {
+	sm := &SchemaManager{schema: NewSchema("N1", fakes.NewMockSchemaExecutor())}
+	previous := &models.Class{Class: "C", Description: "old"}
+	subCommand, err := json.Marshal(&command.AddPropertyRequest{
+		Properties: []*models.Property{{Name: "p1"}, {Name: "p2"}},
+	})
+	require.Nil(t, err)
+
+	sm.RecordChange(&command.ApplyRequest{Type: command.ApplyRequest_TYPE_UPDATE_CLASS, Class: "C"}, time.UnixMilli(1), previous)
+	sm.RecordChange(&command.ApplyRequest{
+		Type: command.ApplyRequest_TYPE_ADD_PROPERTY, Class: "C", Actor: "john", SubCommand: subCommand,
+	}, time.UnixMilli(2), nil)
+
+	assert.Equal(t, []SchemaChangeEntry{
+		{Version: 1, Timestamp: time.UnixMilli(1).UTC(), Operation: "UPDATE_CLASS", Class: "C", Previous: previous},
+		{Version: 2, Timestamp: time.UnixMilli(2).UTC(), Operation: "ADD_PROPERTY", Class: "C", Actor: "john", Properties: []string{"p1", "p2"}},
+	}, sm.schema.Changelog(0, 0))
+}
+
 // TestPropertiesMigration ensures that our migration function sets proper default values
 // The test verifies that we migrate top level properties and then at least one layer deep nested properties
 func TestPropertiesMigration(t *testing.T) {
@@ -340,15 +426,132 @@ func TestPropertiesMigration(t *testing.T) {
 	require.False(t, *(class.Properties[0].NestedProperties[0].NestedProperties[0].IndexRangeFilters))
 }
 
+func TestSchemaReaderShardObjectCount(t *testing.T) {
+	var (
+		reader = &MockShardReader{count: 5}
+		s      = &schema{
+			Classes:     make(map[string]*metaClass),
+			shardReader: reader,
+		}
+		sc = SchemaReader{s, VersionedSchemaReader{}}
+	)
+
+	_, err := sc.ShardObjectCount("C", "S1")
+	assert.ErrorIs(t, err, ErrClassNotFound)
+
+	ss := &sharding.State{Physical: map[string]sharding.Physical{
+		"S1": {Status: "A"},
+		"S2": {Status: "A"},
+	}}
+	s.addClass(&models.Class{Class: "C"}, ss, 1)
+
+	_, err = sc.ShardObjectCount("C", "Sx")
+	assert.ErrorIs(t, err, ErrShardNotFound)
+
+	count, err := sc.ShardObjectCount("C", "S1")
+	require.Nil(t, err)
+	assert.Equal(t, int64(5), count)
+
+	// cached until invalidated
+	reader.count = 7
+	count, _ = sc.ShardObjectCount("C", "S1")
+	assert.Equal(t, int64(5), count)
+	count, _ = sc.ShardObjectCount("C", "S2")
+	assert.Equal(t, int64(7), count)
+
+	reader.count = 9
+	sc.InvalidateShardObjectCounts("C", "S1")
+	count, _ = sc.ShardObjectCount("C", "S1")
+	assert.Equal(t, int64(9), count)
+	count, _ = sc.ShardObjectCount("C", "S2")
+	assert.Equal(t, int64(7), count)
+
+	// no shards invalidates the whole class
+	reader.count = 11
+	sc.InvalidateShardObjectCounts("C")
+	count, _ = sc.ShardObjectCount("C", "S2")
+	assert.Equal(t, int64(11), count)
+
+	// errors are not cached
+	reader.err = errAny
+	sc.InvalidateShardObjectCounts("C")
+	_, err = sc.ShardObjectCount("C", "S1")
+	assert.ErrorIs(t, err, errAny)
+	reader.err = nil
+	count, err = sc.ShardObjectCount("C", "S1")
+	require.Nil(t, err)
+	assert.Equal(t, int64(11), count)
+}
+
+func BenchmarkSchemaReaderShardObjectCount(b *testing.B) {
+	s := &schema{
+		Classes:     make(map[string]*metaClass),
+		shardReader: &MockShardReader{count: 5},
+	}
+	ss := &sharding.State{Physical: map[string]sharding.Physical{"S1": {Status: "A"}}}
+	s.addClass(&models.Class{Class: "C"}, ss, 1)
+	sc := SchemaReader{s, VersionedSchemaReader{}}
+
+	// ~1000 concurrent callers
+	b.SetParallelism(max(1, 1000/runtime.GOMAXPROCS(0)))
+	b.ReportAllocs()
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			if _, err := sc.ShardObjectCount("C", "S1"); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
+
+func TestSchemaReaderTenantLastActivity(t *testing.T) {
+	var (
+		reader = &MockShardReader{lastActivity: time.UnixMilli(1700000000000)}
+		s      = &schema{
+			Classes:     make(map[string]*metaClass),
+			shardReader: reader,
+		}
+		sc = SchemaReader{s, VersionedSchemaReader{}}
+	)
+
+	_, err := sc.TenantLastActivity("C", "T1")
+	assert.ErrorIs(t, err, ErrClassNotFound)
+
+	ss := &sharding.State{Physical: map[string]sharding.Physical{"T1": {Status: "HOT"}}}
+	s.addClass(&models.Class{Class: "C"}, ss, 1)
+
+	_, err = sc.TenantLastActivity("C", "Tx")
+	assert.ErrorIs(t, err, ErrShardNotFound)
+
+	at, err := sc.TenantLastActivity("C", "T1")
+	require.Nil(t, err)
+	assert.Equal(t, reader.lastActivity, at)
+}
+
 type MockShardReader struct {
-	lst models.ShardStatusList
-	err error
+	lst          models.ShardStatusList
+	err          error
+	count        int64
+	lastActivity time.Time
 }
 
 func (m *MockShardReader) GetShardsStatus(class, tenant string) (models.ShardStatusList, error) {
 	return m.lst, m.err
 }
 
+func (m *MockShardReader) ShardObjectCount(class, shard string) (int64, error) {
+	return m.count, m.err
+}
+
+func (m *MockShardReader) TenantLastActivity(class, tenant string) (time.Time, error) {
+	return m.lastActivity, m.err
+}
+
+func (m *MockShardReader) TenantQueriesInFlight(class, tenant string) (int64, error) {
+	return m.count, m.err
+}
+
 type MockSnapshotSink struct {
 	buf bytes.Buffer
 	io.WriteCloser
@@ -374,3 +577,357 @@ func (m *MockSnapshotSink) Read(p []byte) (n int, err error) {
 	}
 	return m.buf.Read(p)
 }
+
+func TestSchemaMoveShard(t *testing.T) {
+	s := &schema{Classes: make(map[string]*metaClass)}
+	sc := SchemaReader{s, VersionedSchemaReader{}}
+	move := func(shard, from, to string) error {
+		return s.moveShard("C", 2, &command.MoveShardRequest{Class: "C", Shard: shard, FromNode: from, ToNode: to})
+	}
+
+	assert.ErrorIs(t, move("S1", "N1", "N2"), ErrClassNotFound)
+
+	ss := &sharding.State{Physical: map[string]sharding.Physical{
+		"S1": {Name: "S1", BelongsToNodes: []string{"N1", "N2"}},
+	}}
+	s.addClass(&models.Class{Class: "C"}, ss, 1)
+
+	assert.ErrorIs(t, move("Sx", "N1", "N3"), ErrShardNotFound)
+	assert.ErrorIs(t, move("S1", "N3", "N4"), ErrBadRequest)
+	assert.ErrorIs(t, move("S1", "N1", "N2"), ErrBadRequest)
+
+	require.Nil(t, move("S1", "N1", "N3"))
+	replicas, err := sc.ShardReplicas("C", "S1")
+	require.Nil(t, err)
+	assert.Equal(t, []string{"N3", "N2"}, replicas)
+}
+
+func TestSchemaPurgeTenant(t *testing.T) {
+	s := &schema{Classes: make(map[string]*metaClass)}
+	purge := func(tenant string) error {
+		return s.purgeTenant("C", &command.PurgeTenantRequest{Class: "C", Tenant: tenant})
+	}
+
+	assert.ErrorIs(t, purge("T1"), ErrClassNotFound)
+
+	ss := &sharding.State{PartitioningEnabled: true, Physical: map[string]sharding.Physical{
+		"T1": {Name: "T1", BelongsToNodes: []string{"N1"}},
+	}}
+	s.addClass(&models.Class{
+		Class:              "C",
+		MultiTenancyConfig: &models.MultiTenancyConfig{Enabled: true},
+	}, ss, 1)
+
+	assert.ErrorIs(t, purge("T1"), ErrBadRequest)
+	assert.Nil(t, purge("T2"))
+}
+
+func TestSchemaSoftDeleteClass(t *testing.T) {
+	sc := NewSchema("N1", fakes.NewMockSchemaExecutor())
+	ss := &sharding.State{Physical: map[string]sharding.Physical{"S1": {Name: "S1"}}}
+	softDelete := func(class string) error {
+		return sc.softDeleteClass(&command.SoftDeleteClassRequest{Class: class, DeletedAt: 1, RetainUntil: 2})
+	}
+
+	assert.ErrorIs(t, softDelete("C"), ErrClassNotFound)
+	require.Nil(t, sc.addClass(&models.Class{Class: "C"}, ss, 1))
+	require.Nil(t, softDelete("C"))
+	cls, _ := sc.ReadOnlyClass("C")
+	assert.Nil(t, cls)
+	deleted := sc.ReadOnlyDeletedClasses()
+	require.Len(t, deleted, 1)
+	assert.Equal(t, "C", deleted[0].Class.Class)
+	assert.Equal(t, time.UnixMilli(2), deleted[0].RetainUntil)
+
+	// the name stays taken, ignoring case
+	assert.ErrorIs(t, sc.addClass(&models.Class{Class: "c"}, ss, 2), ErrClassExists)
+
+	// snapshots keep soft deleted classes
+	sink := &MockSnapshotSink{}
+	require.Nil(t, sc.Persist(sink))
+	parser := fakes.NewMockParser()
+	parser.On("ParseClass", mock.Anything).Return(nil)
+	sc2 := NewSchema("N1", fakes.NewMockSchemaExecutor())
+	require.Nil(t, sc2.Restore(sink, parser))
+	assert.Equal(t, deleted, sc2.ReadOnlyDeletedClasses())
+
+	req, err := sc.restoreDeletedClass("C", 3)
+	require.Nil(t, err)
+	assert.Equal(t, "C", req.Class.Class)
+	cls, _ = sc.ReadOnlyClass("C")
+	assert.NotNil(t, cls)
+	assert.Empty(t, sc.ReadOnlyDeletedClasses())
+	_, err = sc.restoreDeletedClass("C", 4)
+	assert.ErrorIs(t, err, ErrClassNotFound)
+
+	hasFrozen, err := sc2.purgeDeletedClass("C")
+	require.Nil(t, err)
+	assert.False(t, hasFrozen)
+	assert.Empty(t, sc2.ReadOnlyDeletedClasses())
+	_, err = sc2.purgeDeletedClass("C")
+	assert.ErrorIs(t, err, ErrClassNotFound)
+}
+
+func TestSchemaDeleteExtendedClass(t *testing.T) {
+	sc := NewSchema("N1", fakes.NewMockSchemaExecutor())
+	ss := &sharding.State{Physical: map[string]sharding.Physical{"S1": {Name: "S1"}}}
+	require.Nil(t, sc.addClass(&models.Class{Class: "Parent"}, ss, 1))
+	child := &models.Class{Class: "Child", Extends: "Parent"}
+	require.Nil(t, sc.checkParent(child))
+	require.Nil(t, sc.addClass(child, ss, 2))
+	assert.ErrorIs(t, sc.checkParent(&models.Class{Class: "Orphan", Extends: "Unknown"}), ErrBadRequest)
+
+	assert.ErrorIs(t, sc.deleteClass("Parent"), ErrBadRequest)
+	assert.ErrorIs(t, sc.softDeleteClass(&command.SoftDeleteClassRequest{Class: "Parent"}), ErrBadRequest)
+	require.Nil(t, sc.deleteClass("Child"))
+	require.Nil(t, sc.deleteClass("Parent"))
+}
+
+func TestSchemaAddPropertyLimit(t *testing.T) {
+	sc := NewSchema("N1", fakes.NewMockSchemaExecutor())
+	ss := &sharding.State{Physical: map[string]sharding.Physical{"S1": {Name: "S1"}}}
+	require.Nil(t, sc.addClass(&models.Class{Class: "C", Properties: []*models.Property{{Name: "a"}}}, ss, 1))
+
+	require.Nil(t, sc.addProperty("C", 2, 2, &models.Property{Name: "b"}))
+	assert.ErrorIs(t, sc.addProperty("C", 3, 2, &models.Property{Name: "c"}), ErrBadRequest)
+	// existing properties are not counted twice
+	require.Nil(t, sc.addProperty("C", 3, 2, &models.Property{Name: "B"}))
+	require.Nil(t, sc.addProperty("C", 4, 0, &models.Property{Name: "c"}))
+
+	cls, _ := sc.ReadOnlyClass("C")
+	assert.Len(t, cls.Properties, 3)
+}
+
+func TestSchemaAddTenantsLimit(t *testing.T) {
+	sc := NewSchema("N1", fakes.NewMockSchemaExecutor())
+	ss := &sharding.State{PartitioningEnabled: true, Physical: map[string]sharding.Physical{
+		"T1": {Name: "T1", BelongsToNodes: []string{"N1"}},
+	}}
+	require.Nil(t, sc.addClass(&models.Class{
+		Class:              "C",
+		MultiTenancyConfig: &models.MultiTenancyConfig{Enabled: true},
+	}, ss, 1))
+	add := func(limit int64, names ...string) error {
+		req := &command.AddTenantsRequest{ClusterNodes: []string{"N1"}, MaxTenants: limit}
+		for _, name := range names {
+			req.Tenants = append(req.Tenants, &command.Tenant{Name: name, Status: models.TenantActivityStatusHOT})
+		}
+		return sc.addTenants("C", 2, req)
+	}
+
+	require.Nil(t, add(2, "T2"))
+	assert.ErrorIs(t, add(2, "T3"), ErrBadRequest)
+	// existing tenants are not counted twice
+	require.Nil(t, add(2, "T1", "T2"))
+
+	// the setting of the class takes precedence
+	sc.Classes["C"].Class.MultiTenancyConfig.MaxTenants = 3
+	require.Nil(t, add(2, "T3"))
+	assert.ErrorIs(t, add(0, "T4"), ErrBadRequest)
+	assert.Equal(t, 3, sc.ClassInfo("C").Tenants)
+}
+
+func TestSchemaGetTenantsPage(t *testing.T) {
+	sc := NewSchema("N1", fakes.NewMockSchemaExecutor())
+	ss := &sharding.State{PartitioningEnabled: true, Physical: map[string]sharding.Physical{}}
+	for _, name := range []string{"T4", "T2", "T5", "T1", "T3"} {
+		ss.Physical[name] = sharding.Physical{Name: name, BelongsToNodes: []string{"N1"}}
+	}
+	require.Nil(t, sc.addClass(&models.Class{
+		Class:              "C",
+		MultiTenancyConfig: &models.MultiTenancyConfig{Enabled: true},
+	}, ss, 1))
+	names := func(tenants []*models.TenantResponse) []string {
+		names := make([]string, len(tenants))
+		for i, tenant := range tenants {
+			names[i] = tenant.Name
+		}
+		return names
+	}
+
+	tenants, total, err := sc.getTenantsPage("C", nil, "", 0)
+	require.Nil(t, err)
+	assert.Equal(t, []string{"T1", "T2", "T3", "T4", "T5"}, names(tenants))
+	assert.Equal(t, 5, total)
+
+	tenants, total, err = sc.getTenantsPage("C", nil, "T2", 2)
+	require.Nil(t, err)
+	assert.Equal(t, []string{"T3", "T4"}, names(tenants))
+	assert.Equal(t, 5, total)
+
+	// the cursor doesn't have to be a tenant
+	tenants, _, err = sc.getTenantsPage("C", nil, "T22", 2)
+	require.Nil(t, err)
+	assert.Equal(t, []string{"T3", "T4"}, names(tenants))
+
+	// only the requested tenants which exist are paged
+	tenants, total, err = sc.getTenantsPage("C", []string{"T5", "T1", "X", "T3"}, "T1", 1)
+	require.Nil(t, err)
+	assert.Equal(t, []string{"T3"}, names(tenants))
+	assert.Equal(t, 3, total)
+}
+
+func TestSchemaBatchDeleteHistory(t *testing.T) {
+	sc := NewSchema("N1", fakes.NewMockSchemaExecutor())
+	sc.batchDeletes = newBatchDeleteHistory(3)
+	start := time.UnixMilli(1_700_000_000_000).UTC()
+	record := func(id uint64, collection string) {
+		sc.recordBatchDelete(&command.RecordBatchDeleteRequest{
+			Collection:  collection,
+			Request:     []byte(collection),
+			StartedAt:   start.Add(time.Duration(id) * time.Minute).UnixMilli(),
+			CompletedAt: start.Add(time.Duration(id)*time.Minute + time.Second).UnixMilli(),
+		}, id)
+	}
+	ids := func(entries []BatchDeleteHistoryEntry) []uint64 {
+		var ids []uint64
+		for _, e := range entries {
+			ids = append(ids, e.ID)
+		}
+		return ids
+	}
+
+	entries, more := sc.BatchDeleteHistory(BatchDeleteHistoryQuery{})
+	assert.Empty(t, entries)
+	assert.False(t, more)
+
+	record(1, "A")
+	record(2, "B")
+	entries, _ = sc.BatchDeleteHistory(BatchDeleteHistoryQuery{})
+	require.Len(t, entries, 2)
+	assert.Equal(t, "A", entries[0].Collection)
+	assert.Equal(t, []byte("A"), entries[0].Request)
+	assert.Equal(t, start.Add(time.Minute), entries[0].StartedAt)
+	assert.Equal(t, start.Add(time.Minute+time.Second), entries[0].CompletedAt)
+
+	// the oldest entries are dropped once the history is full
+	record(3, "A")
+	record(4, "B")
+	record(5, "A")
+	entries, _ = sc.BatchDeleteHistory(BatchDeleteHistoryQuery{})
+	assert.Equal(t, []uint64{3, 4, 5}, ids(entries))
+
+	entries, _ = sc.BatchDeleteHistory(BatchDeleteHistoryQuery{Collection: "A"})
+	assert.Equal(t, []uint64{3, 5}, ids(entries))
+	entries, _ = sc.BatchDeleteHistory(BatchDeleteHistoryQuery{
+		From: start.Add(4 * time.Minute), To: start.Add(5 * time.Minute),
+	})
+	assert.Equal(t, []uint64{4}, ids(entries))
+
+	entries, more = sc.BatchDeleteHistory(BatchDeleteHistoryQuery{Limit: 2})
+	assert.Equal(t, []uint64{3, 4}, ids(entries))
+	assert.True(t, more)
+	entries, more = sc.BatchDeleteHistory(BatchDeleteHistoryQuery{After: 4, Limit: 2})
+	assert.Equal(t, []uint64{5}, ids(entries))
+	assert.False(t, more)
+
+	// the history is kept in snapshots
+	sink := &MockSnapshotSink{}
+	require.Nil(t, sc.Persist(sink))
+	parser := fakes.NewMockParser()
+	sc2 := NewSchema("N1", fakes.NewMockSchemaExecutor())
+	require.Nil(t, sc2.Restore(sink, parser))
+	entries, _ = sc2.BatchDeleteHistory(BatchDeleteHistoryQuery{})
+	assert.Equal(t, []uint64{3, 4, 5}, ids(entries))
+	assert.Equal(t, start.Add(5*time.Minute), entries[2].StartedAt)
+}
+
+func TestSchemaReserveClassObjects(t *testing.T) {
+	sc := NewSchema("N1", fakes.NewMockSchemaExecutor())
+	reserve := func(class string, count int64) error {
+		return sc.reserveClassObjects(&command.ReserveClassObjectsRequest{Class: class, Count: count})
+	}
+	ss := &sharding.State{Physical: map[string]sharding.Physical{}}
+
+	assert.ErrorIs(t, reserve("C", 1), ErrClassNotFound)
+	_, err := sc.ClassObjectCount("C")
+	assert.ErrorIs(t, err, ErrClassNotFound)
+
+	require.Nil(t, sc.addClass(&models.Class{Class: "C", MaxObjects: 3}, ss, 1))
+	require.Nil(t, reserve("C", 2))
+	assert.ErrorIs(t, reserve("C", 2), ErrObjectLimitExceeded)
+	require.Nil(t, reserve("C", 1))
+	count, err := sc.ClassObjectCount("C")
+	require.Nil(t, err)
+	assert.Equal(t, int64(3), count)
+
+	// releases never drop below zero
+	require.Nil(t, reserve("C", -5))
+	count, _ = sc.ClassObjectCount("C")
+	assert.Zero(t, count)
+
+	// set counts are accepted beyond the limit
+	require.Nil(t, sc.reserveClassObjects(&command.ReserveClassObjectsRequest{Class: "C", Count: 5, Set: true}))
+	count, _ = sc.ClassObjectCount("C")
+	assert.Equal(t, int64(5), count)
+	assert.ErrorIs(t, reserve("C", 1), ErrObjectLimitExceeded)
+	require.Nil(t, reserve("C", -5))
+
+	// the count is kept in snapshots
+	require.Nil(t, reserve("C", 2))
+	sink := &MockSnapshotSink{}
+	require.Nil(t, sc.Persist(sink))
+	parser := fakes.NewMockParser()
+	parser.On("ParseClass", mock.Anything).Return(nil)
+	sc2 := NewSchema("N1", fakes.NewMockSchemaExecutor())
+	require.Nil(t, sc2.Restore(sink, parser))
+	count, _ = sc2.ClassObjectCount("C")
+	assert.Equal(t, int64(2), count)
+
+	// classes without a limit are counted without limit
+	require.Nil(t, sc.addClass(&models.Class{Class: "D"}, ss, 2))
+	assert.Nil(t, reserve("D", 100))
+}
+
+func TestSchemaManagerUpdateVectorIndexConfig(t *testing.T) {
+	executor := fakes.NewMockSchemaExecutor()
+	parser := fakes.NewMockParser()
+	parser.On("ParseClassUpdate", mock.Anything, mock.Anything).Return(nil, nil)
+	sm := NewSchemaManager("N1", executor, parser, nil, 0)
+	require.Nil(t, sm.schema.addClass(&models.Class{
+		Class: "C",
+		VectorConfig: map[string]models.VectorConfig{
+			"title":   {VectorIndexType: "hnsw", VectorIndexConfig: map[string]any{"ef": float64(64)}},
+			"content": {VectorIndexType: "hnsw", VectorIndexConfig: map[string]any{"ef": float64(64)}},
+		},
+	}, &sharding.State{}, 1))
+
+	apply := func(configs map[string]any) (command.UpdateVectorIndexConfigRequest, error) {
+		req := command.UpdateVectorIndexConfigRequest{VectorIndexConfigs: configs}
+		subCommand, err := json.Marshal(&req)
+		require.Nil(t, err)
+		return req, sm.UpdateVectorIndexConfig(&command.ApplyRequest{
+			Type: command.ApplyRequest_TYPE_UPDATE_VECTOR_INDEX_CONFIG, Class: "C", Version: 2, SubCommand: subCommand,
+		}, false, false)
+	}
+
+	req := command.UpdateVectorIndexConfigRequest{VectorIndexConfigs: map[string]any{"title": map[string]any{"ef": float64(128)}}}
+	executor.On("UpdateVectorIndexConfig", "C", req).Return(nil).Once()
+	_, err := apply(req.VectorIndexConfigs)
+	require.Nil(t, err)
+	executor.AssertExpectations(t)
+
+	class, version := sm.schema.ReadOnlyClass("C")
+	assert.Equal(t, uint64(2), version)
+	assert.Equal(t, map[string]any{"ef": float64(128)}, class.VectorConfig["title"].VectorIndexConfig)
+	assert.Equal(t, map[string]any{"ef": float64(64)}, class.VectorConfig["content"].VectorIndexConfig)
+
+	_, err = apply(map[string]any{"summary": map[string]any{}})
+	assert.ErrorIs(t, err, ErrBadRequest)
+	_, err = apply(nil)
+	assert.ErrorIs(t, err, ErrBadRequest)
+
+	// classes without named vectors key their config by ""
+	require.Nil(t, sm.schema.addClass(&models.Class{
+		Class: "L", VectorIndexType: "hnsw", VectorIndexConfig: map[string]any{"ef": float64(64)},
+	}, &sharding.State{}, 3))
+	req = command.UpdateVectorIndexConfigRequest{VectorIndexConfigs: map[string]any{"": map[string]any{"ef": float64(128)}}}
+	executor.On("UpdateVectorIndexConfig", "L", req).Return(nil).Once()
+	subCommand, err := json.Marshal(&req)
+	require.Nil(t, err)
+	require.Nil(t, sm.UpdateVectorIndexConfig(&command.ApplyRequest{
+		Type: command.ApplyRequest_TYPE_UPDATE_VECTOR_INDEX_CONFIG, Class: "L", Version: 4, SubCommand: subCommand,
+	}, false, false))
+	class, _ = sm.schema.ReadOnlyClass("L")
+	assert.Equal(t, map[string]any{"ef": float64(128)}, class.VectorIndexConfig)
+}
@@ -35,6 +35,8 @@ type (
 		ShardVersion uint64
 		// ShardProcesses map[tenantName-action(FREEZING/UNFREEZING)]map[nodeID]TenantsProcess
 		ShardProcesses map[string]NodeShardProcess
+		// ObjectCount counts the objects reserved against Class.MaxObjects
+		ObjectCount int64
 	}
 )
 
@@ -153,10 +155,17 @@ func (m *metaClass) CopyShardingState() (*sharding.State, uint64) {
 	return &st, m.version()
 }
 
-func (m *metaClass) AddProperty(v uint64, props ...*models.Property) error {
+func (m *metaClass) AddProperty(v uint64, maxProps int, props ...*models.Property) error {
 	m.Lock()
 	defer m.Unlock()
 
+	// the limit is checked here as well as by the handler, concurrent
+	// requests may both pass the check of the handler before either applies
+	if n := countMergedProps(m.Class.Properties, props); maxProps > 0 && n > maxProps && n > len(m.Class.Properties) {
+		return fmt.Errorf("%w: class %s would have %d properties, at most %d are allowed",
+			ErrBadRequest, m.Class.Class, n, maxProps)
+	}
+
 	// update all at once to prevent race condition with concurrent readers
 	mergedProps := MergeProps(m.Class.Properties, props)
 	m.Class.Properties = mergedProps
@@ -164,6 +173,22 @@ func (m *metaClass) AddProperty(v uint64, props ...*models.Property) error {
 	return nil
 }
 
+// countMergedProps returns the number of properties MergeProps(old, new)
+// would return
+func countMergedProps(old, new []*models.Property) int {
+	names := make(map[string]struct{}, len(old))
+	for _, prop := range old {
+		names[strings.ToLower(prop.Name)] = struct{}{}
+	}
+	n := len(old)
+	for _, prop := range new {
+		if _, ok := names[strings.ToLower(prop.Name)]; !ok {
+			n++
+		}
+	}
+	return n
+}
+
 // MergeProps makes sure duplicates are not created by ignoring new props
 // with the same names as old props.
 // If property of nested type is present in both new and old slices,
@@ -185,6 +210,9 @@ func MergeProps(old, new []*models.Property) []*models.Property {
 			mergedProps = append(mergedProps, new[idx])
 		} else {
 			mergedProps[oldIdx].IndexRangeFilters = new[idx].IndexRangeFilters
+			if new[idx].Deprecated != nil {
+				mergedProps[oldIdx].Deprecated = new[idx].Deprecated
+			}
 
 			nestedProperties, merged := entSchema.MergeRecursivelyNestedProperties(
 				mergedProps[oldIdx].NestedProperties,
@@ -205,6 +233,12 @@ func (m *metaClass) AddTenants(nodeID string, req *command.AddTenantsRequest, re
 	m.Lock()
 	defer m.Unlock()
 
+	// the limit is checked here as well as by the handler, concurrent
+	// requests may both pass the check of the handler before either applies
+	if err := m.checkMaxTenants(req); err != nil {
+		return err
+	}
+
 	// TODO-RAFT: Optimize here and avoid iteration twice on the req.Tenants array
 	names := make([]string, len(req.Tenants))
 	for i, tenant := range req.Tenants {
@@ -243,6 +277,58 @@ func (m *metaClass) AddTenants(nodeID string, req *command.AddTenantsRequest, re
 	return nil
 }
 
+// checkMaxTenants checks that adding the tenants of req stays within the
+// maxTenants setting of the class or else req.MaxTenants. The caller must
+// hold the lock.
+func (m *metaClass) checkMaxTenants(req *command.AddTenantsRequest) error {
+	limit := req.MaxTenants
+	if mt := m.Class.MultiTenancyConfig; mt != nil && mt.MaxTenants > 0 {
+		limit = mt.MaxTenants
+	}
+	if limit <= 0 {
+		return nil
+	}
+
+	added := make(map[string]struct{}, len(req.Tenants))
+	for _, t := range req.Tenants {
+		if _, ok := m.Sharding.Physical[t.Name]; !ok {
+			added[t.Name] = struct{}{}
+		}
+	}
+	if n := len(m.Sharding.Physical) + len(added); len(added) > 0 && int64(n) > limit {
+		return fmt.Errorf("%w: class %s would have %d tenants, at most %d are allowed",
+			ErrBadRequest, m.Class.Class, n, limit)
+	}
+	return nil
+}
+
+// MoveShard replaces req.FromNode with req.ToNode in the replicas of req.Shard
+func (m *metaClass) MoveShard(req *command.MoveShardRequest, v uint64) error {
+	m.Lock()
+	defer m.Unlock()
+
+	shard, ok := m.Sharding.Physical[req.Shard]
+	if !ok {
+		return ErrShardNotFound
+	}
+	if !slices.Contains(shard.BelongsToNodes, req.FromNode) {
+		return fmt.Errorf("%w: shard %q is not owned by node %q", ErrBadRequest, req.Shard, req.FromNode)
+	}
+	if slices.Contains(shard.BelongsToNodes, req.ToNode) {
+		return fmt.Errorf("%w: shard %q is already owned by node %q", ErrBadRequest, req.Shard, req.ToNode)
+	}
+
+	p := shard.DeepCopy()
+	for i, node := range p.BelongsToNodes {
+		if node == req.FromNode {
+			p.BelongsToNodes[i] = req.ToNode
+		}
+	}
+	m.Sharding.Physical[req.Shard] = p
+	m.ShardVersion = v
+	return nil
+}
+
 func (m *metaClass) DeleteTenants(req *command.DeleteTenantsRequest, v uint64) error {
 	m.Lock()
 	defer m.Unlock()
@@ -0,0 +1,72 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"sync"
+	"sync/atomic"
+)
+
+type shardKey struct {
+	class string
+	shard string
+}
+
+// shardObjectCounts caches the object count of local shards. Reads of cached
+// counts are lock free. Entries are not kept up to date by writes, instead
+// they are invalidated once a write completes or the shard changes and are
+// loaded again on the next read.
+type shardObjectCounts struct {
+	counts sync.Map // shardKey -> int64
+	// generation is bumped on every invalidation so that loads which raced
+	// with an invalidation don't store a stale count
+	generation atomic.Uint64
+}
+
+func (c *shardObjectCounts) get(class, shard string, load func() (int64, error)) (int64, error) {
+	key := shardKey{class: class, shard: shard}
+	if count, ok := c.counts.Load(key); ok {
+		return count.(int64), nil
+	}
+
+	gen := c.generation.Load()
+	count, err := load()
+	if err != nil {
+		return 0, err
+	}
+	if c.generation.Load() == gen {
+		c.counts.Store(key, count)
+		// an invalidation between the check and the store must still win
+		if c.generation.Load() != gen {
+			c.counts.CompareAndDelete(key, count)
+		}
+	}
+	return count, nil
+}
+
+// invalidate drops the given shards of class, or all shards if none are given
+func (c *shardObjectCounts) invalidate(class string, shards ...string) {
+	c.generation.Add(1)
+	if len(shards) > 0 {
+		for _, shard := range shards {
+			c.counts.Delete(shardKey{class: class, shard: shard})
+		}
+		return
+	}
+
+	c.counts.Range(func(key, _ any) bool {
+		if key.(shardKey).class == class {
+			c.counts.Delete(key)
+		}
+		return true
+	})
+}
@@ -13,6 +13,7 @@ package schema
 
 import (
 	"context"
+	"time"
 
 	"github.com/cenkalti/backoff/v4"
 	"github.com/prometheus/client_golang/prometheus"
@@ -156,6 +157,64 @@ func (rs SchemaReader) GetShardsStatus(class, tenant string) (models.ShardStatus
 	return rs.schema.GetShardsStatus(class, tenant)
 }
 
+// SchemaVersion returns the number of schema changes applied locally
+func (rs SchemaReader) SchemaVersion() uint64 {
+	return rs.schema.SchemaVersion()
+}
+
+// ReadOnlyDeletedClasses returns the soft deleted classes ordered by name
+func (rs SchemaReader) ReadOnlyDeletedClasses() []DeletedClass {
+	t := prometheus.NewTimer(monitoring.GetMetrics().SchemaReadsLocal.WithLabelValues("ReadOnlyDeletedClasses"))
+	defer t.ObserveDuration()
+
+	return rs.schema.ReadOnlyDeletedClasses()
+}
+
+// ClassObjectCount returns the number of objects counted against the object
+// limit of class
+func (rs SchemaReader) ClassObjectCount(class string) (int64, error) {
+	t := prometheus.NewTimer(monitoring.GetMetrics().SchemaReadsLocal.WithLabelValues("ClassObjectCount"))
+	defer t.ObserveDuration()
+
+	return rs.schema.ClassObjectCount(class)
+}
+
+// BatchDeleteHistory returns the batch deletes selected by q, oldest first,
+// and whether there are more after them
+func (rs SchemaReader) BatchDeleteHistory(q BatchDeleteHistoryQuery) ([]BatchDeleteHistoryEntry, bool) {
+	t := prometheus.NewTimer(monitoring.GetMetrics().SchemaReadsLocal.WithLabelValues("BatchDeleteHistory"))
+	defer t.ObserveDuration()
+
+	return rs.schema.BatchDeleteHistory(q)
+}
+
+// SchemaChangelog returns up to limit retained schema changes newer than
+// version since, oldest first
+func (rs SchemaReader) SchemaChangelog(since uint64, limit int) []SchemaChangeEntry {
+	t := prometheus.NewTimer(monitoring.GetMetrics().SchemaReadsLocal.WithLabelValues("SchemaChangelog"))
+	defer t.ObserveDuration()
+
+	return rs.schema.Changelog(since, limit)
+}
+
+// ShardObjectCount returns the cached object count of a local shard. It is
+// meant to be called on hot paths, reads are therefore not timed.
+func (rs SchemaReader) ShardObjectCount(class, shard string) (int64, error) {
+	return rs.schema.ShardObjectCount(class, shard)
+}
+
+func (rs SchemaReader) TenantLastActivity(class, tenant string) (time.Time, error) {
+	return rs.schema.TenantLastActivity(class, tenant)
+}
+
+func (rs SchemaReader) TenantQueriesInFlight(class, tenant string) (int64, error) {
+	return rs.schema.TenantQueriesInFlight(class, tenant)
+}
+
+func (rs SchemaReader) InvalidateShardObjectCounts(class string, shards ...string) {
+	rs.schema.InvalidateShardObjectCounts(class, shards...)
+}
+
 func (rs SchemaReader) Len() int { return rs.schema.len() }
 
 func (rs SchemaReader) retry(f func(*schema) error) error {
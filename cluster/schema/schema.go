@@ -14,8 +14,11 @@ package schema
 import (
 	"errors"
 	"fmt"
+	"slices"
+	"sort"
 	"strings"
 	"sync"
+	"time"
 
 	command "github.com/weaviate/weaviate/cluster/proto/api"
 	"github.com/weaviate/weaviate/cluster/types"
@@ -30,6 +33,9 @@ var (
 	ErrClassNotFound = errors.New("class not found")
 	ErrShardNotFound = errors.New("shard not found")
 	ErrMTDisabled    = errors.New("multi-tenancy is not enabled")
+	// ErrObjectLimitExceeded is returned by RESERVE_CLASS_OBJECTS commands
+	// which would exceed the MaxObjects of a class
+	ErrObjectLimitExceeded = errors.New("object limit exceeded")
 )
 
 type ClassInfo struct {
@@ -51,6 +57,19 @@ type schema struct {
 	shardReader shardReader
 	sync.RWMutex
 	Classes map[string]*metaClass
+	// DeletedClasses are the soft deleted classes by name
+	DeletedClasses map[string]*deletedClass
+
+	// version counts all applied schema changes, changelog keeps the last
+	// changelogSize of them. Both are guarded by the mutex.
+	version       uint64
+	changelog     []SchemaChangeEntry
+	changelogSize int
+
+	objectCounts shardObjectCounts
+
+	// batchDeletes is the history of batch deletes, guarded by the mutex
+	batchDeletes *batchDeleteHistory
 }
 
 func (s *schema) ClassInfo(class string) ClassInfo {
@@ -149,6 +168,7 @@ func (s *schema) ReadOnlySchema() models.Schema {
 		cp.Classes[i] = meta.CloneClass()
 		i++
 	}
+	cp.SchemaVersion = s.version
 
 	return cp
 }
@@ -207,15 +227,68 @@ func (s *schema) GetShardsStatus(class, tenant string) (models.ShardStatusList,
 	return s.shardReader.GetShardsStatus(class, tenant)
 }
 
+// ShardObjectCount returns the number of objects of a local shard. Counts are
+// cached until the shard is written to or changes.
+func (s *schema) ShardObjectCount(class, shard string) (int64, error) {
+	return s.objectCounts.get(class, shard, func() (int64, error) {
+		meta := s.metaClass(class)
+		if meta == nil {
+			return 0, ErrClassNotFound
+		}
+		if _, _, err := meta.ShardOwner(shard); errors.Is(err, ErrShardNotFound) {
+			return 0, err
+		}
+		return s.shardReader.ShardObjectCount(class, shard)
+	})
+}
+
+// TenantLastActivity returns when a local tenant was last read or written.
+// The time is zero if no activity has been observed on this node yet.
+func (s *schema) TenantLastActivity(class, tenant string) (time.Time, error) {
+	meta := s.metaClass(class)
+	if meta == nil {
+		return time.Time{}, ErrClassNotFound
+	}
+	if _, _, err := meta.ShardOwner(tenant); errors.Is(err, ErrShardNotFound) {
+		return time.Time{}, err
+	}
+	return s.shardReader.TenantLastActivity(class, tenant)
+}
+
+// TenantQueriesInFlight returns the number of requests currently served by
+// the local shard of tenant
+func (s *schema) TenantQueriesInFlight(class, tenant string) (int64, error) {
+	meta := s.metaClass(class)
+	if meta == nil {
+		return 0, ErrClassNotFound
+	}
+	if _, _, err := meta.ShardOwner(tenant); errors.Is(err, ErrShardNotFound) {
+		return 0, err
+	}
+	return s.shardReader.TenantQueriesInFlight(class, tenant)
+}
+
+// InvalidateShardObjectCounts drops the cached object counts of the given
+// shards of class, or of all its shards if none are given
+func (s *schema) InvalidateShardObjectCounts(class string, shards ...string) {
+	s.objectCounts.invalidate(class, shards...)
+}
+
 type shardReader interface {
 	GetShardsStatus(class, tenant string) (models.ShardStatusList, error)
+	ShardObjectCount(class, shard string) (int64, error)
+	TenantLastActivity(class, tenant string) (time.Time, error)
+	TenantQueriesInFlight(class, tenant string) (int64, error)
 }
 
 func NewSchema(nodeID string, shardReader shardReader) *schema {
 	return &schema{
-		nodeID:      nodeID,
-		Classes:     make(map[string]*metaClass, 128),
-		shardReader: shardReader,
+		nodeID:         nodeID,
+		Classes:        make(map[string]*metaClass, 128),
+		DeletedClasses: make(map[string]*deletedClass),
+		shardReader:    shardReader,
+		changelogSize:  DefaultChangelogSize,
+		batchDeletes:   newBatchDeleteHistory(MaxBatchDeleteHistory),
 	}
 }
 
@@ -246,6 +319,9 @@ func (s *schema) addClass(cls *models.Class, ss *sharding.State, v uint64) error
 	if exists {
 		return ErrClassExists
 	}
+	if deleted := s.deletedClassFolded(cls.Class); deleted != "" {
+		return fmt.Errorf("%w: %q is soft deleted, restore or purge it first", ErrClassExists, deleted)
+	}
 
 	s.Classes[cls.Class] = &metaClass{
 		Class: *cls, Sharding: *ss, ClassVersion: v, ShardVersion: v,
@@ -262,6 +338,8 @@ func (s *schema) updateClass(name string, f func(*metaClass) error) error {
 	if meta == nil {
 		return ErrClassNotFound
 	}
+	// the update might move shards between nodes
+	s.objectCounts.invalidate(name)
 	return meta.LockGuard(f)
 }
 
@@ -289,13 +367,46 @@ func (s *schema) replaceStatesNodeName(new string) {
 	}
 }
 
-func (s *schema) deleteClass(name string) {
+func (s *schema) deleteClass(name string) error {
 	s.Lock()
 	defer s.Unlock()
+	if children := s.childClasses(name); len(children) > 0 {
+		return fmt.Errorf("%w: class %q is extended by %s", ErrBadRequest, name, strings.Join(children, ", "))
+	}
 	delete(s.Classes, name)
+	s.objectCounts.invalidate(name)
+	return nil
+}
+
+// checkParent returns an error if cls extends a class which doesn't exist
+func (s *schema) checkParent(cls *models.Class) error {
+	if cls.Extends == "" {
+		return nil
+	}
+	s.RLock()
+	defer s.RUnlock()
+	if s.Classes[cls.Extends] == nil {
+		return fmt.Errorf("%w: class %q extends class %q, which doesn't exist", ErrBadRequest, cls.Class, cls.Extends)
+	}
+	return nil
+}
+
+// childClasses returns the names of the classes extending class, ordered by
+// name. The caller must hold the lock.
+func (s *schema) childClasses(class string) []string {
+	var children []string
+	for name, meta := range s.Classes {
+		if meta.Class.Extends == class {
+			children = append(children, name)
+		}
+	}
+	sort.Strings(children)
+	return children
 }
 
-func (s *schema) addProperty(class string, v uint64, props ...*models.Property) error {
+// addProperty adds props to class, maxProps limits the number of properties
+// the class may have afterwards unless it is zero
+func (s *schema) addProperty(class string, v uint64, maxProps int, props ...*models.Property) error {
 	s.Lock()
 	defer s.Unlock()
 
@@ -303,7 +414,7 @@ func (s *schema) addProperty(class string, v uint64, props ...*models.Property)
 	if meta == nil {
 		return ErrClassNotFound
 	}
-	return meta.AddProperty(v, props...)
+	return meta.AddProperty(v, maxProps, props...)
 }
 
 func (s *schema) addTenants(class string, v uint64, req *command.AddTenantsRequest) error {
@@ -320,62 +431,154 @@ func (s *schema) deleteTenants(class string, v uint64, req *command.DeleteTenant
 	if ok, meta, _, err := s.multiTenancyEnabled(class); !ok {
 		return err
 	} else {
+		if len(req.Tenants) > 0 {
+			s.objectCounts.invalidate(class, req.Tenants...)
+		}
 		return meta.DeleteTenants(req, v)
 	}
 }
 
+// purgeTenant checks that the purged tenant is deleted, a tenant created again
+// with the same name must not be recorded as purged
+func (s *schema) purgeTenant(class string, req *command.PurgeTenantRequest) error {
+	ok, meta, _, err := s.multiTenancyEnabled(class)
+	if !ok {
+		return err
+	}
+	exists := false
+	meta.RLockGuard(func(_ *models.Class, ss *sharding.State) error {
+		_, exists = ss.Physical[req.Tenant]
+		return nil
+	})
+	if exists {
+		return fmt.Errorf("%w: tenant %q of class %q is not deleted", ErrBadRequest, req.Tenant, class)
+	}
+	return nil
+}
+
+// reserveClassObjects adds req.Count to the object count of a class. Counts
+// beyond its MaxObjects are rejected, releases never drop below zero. Counts
+// which are set are accepted beyond the limit.
+func (s *schema) reserveClassObjects(req *command.ReserveClassObjectsRequest) error {
+	meta := s.metaClass(req.Class)
+	if meta == nil {
+		return ErrClassNotFound
+	}
+	meta.Lock()
+	defer meta.Unlock()
+
+	if req.Set {
+		meta.ObjectCount = max(req.Count, 0)
+		return nil
+	}
+	count := meta.ObjectCount + req.Count
+	if limit := meta.Class.MaxObjects; req.Count > 0 && limit > 0 && count > limit {
+		return fmt.Errorf("%w: class %q has %d of %d objects, %d more requested",
+			ErrObjectLimitExceeded, req.Class, meta.ObjectCount, limit, req.Count)
+	}
+	meta.ObjectCount = max(count, 0)
+	return nil
+}
+
+// ClassObjectCount returns the number of objects reserved against the
+// MaxObjects of class
+func (s *schema) ClassObjectCount(class string) (int64, error) {
+	meta := s.metaClass(class)
+	if meta == nil {
+		return 0, ErrClassNotFound
+	}
+	meta.RLock()
+	defer meta.RUnlock()
+	return meta.ObjectCount, nil
+}
+
 func (s *schema) updateTenants(class string, v uint64, req *command.UpdateTenantsRequest) error {
 	if ok, meta, _, err := s.multiTenancyEnabled(class); !ok {
 		return err
 	} else {
+		for _, tenant := range req.Tenants {
+			s.objectCounts.invalidate(class, tenant.Name)
+		}
 		return meta.UpdateTenants(s.nodeID, req, v)
 	}
 }
 
+func (s *schema) moveShard(class string, v uint64, req *command.MoveShardRequest) error {
+	meta := s.metaClass(class)
+	if meta == nil {
+		return ErrClassNotFound
+	}
+	s.objectCounts.invalidate(class, req.Shard)
+	return meta.MoveShard(req, v)
+}
+
 func (s *schema) updateTenantsProcess(class string, v uint64, req *command.TenantProcessRequest) error {
 	if ok, meta, _, err := s.multiTenancyEnabled(class); !ok {
 		return err
 	} else {
+		// tenants are moved between nodes or offloaded
+		s.objectCounts.invalidate(class)
 		return meta.UpdateTenantsProcess(s.nodeID, req, v)
 	}
 }
 
 func (s *schema) getTenants(class string, tenants []string) ([]*models.TenantResponse, error) {
+	res, _, err := s.getTenantsPage(class, tenants, "", 0)
+	return res, err
+}
+
+// getTenantsPage returns the tenants of class ordered by name, or the ones of
+// tenants which exist. Only tenants after the name after are returned, at
+// most limit of them if limit is positive. The number of tenants before
+// paging is returned as well.
+func (s *schema) getTenantsPage(class string, tenants []string, after string, limit int) ([]*models.TenantResponse, int, error) {
 	ok, meta, _, err := s.multiTenancyEnabled(class)
 	if !ok {
-		return nil, err
+		return nil, 0, err
 	}
 
 	// Read tenants using the meta lock guard
-	var res []*models.TenantResponse
+	var (
+		res   []*models.TenantResponse
+		total int
+	)
 	f := func(_ *models.Class, ss *sharding.State) error {
+		var names []string
 		if len(tenants) == 0 {
-			res = make([]*models.TenantResponse, len(ss.Physical))
-			i := 0
-			for tenant, physical := range ss.Physical {
-				// Ensure we copy the belongs to nodes array to avoid it being modified
-				cpy := make([]string, len(physical.BelongsToNodes))
-				copy(cpy, physical.BelongsToNodes)
-
-				res[i] = MakeTenantWithBelongsToNodes(tenant, entSchema.ActivityStatus(physical.Status), cpy)
-
-				// Increment our result iterator
-				i++
+			names = make([]string, 0, len(ss.Physical))
+			for tenant := range ss.Physical {
+				names = append(names, tenant)
 			}
 		} else {
-			res = make([]*models.TenantResponse, 0, len(tenants))
+			names = make([]string, 0, len(tenants))
 			for _, tenant := range tenants {
-				if physical, ok := ss.Physical[tenant]; ok {
-					// Ensure we copy the belongs to nodes array to avoid it being modified
-					cpy := make([]string, len(physical.BelongsToNodes))
-					copy(cpy, physical.BelongsToNodes)
-					res = append(res, MakeTenantWithBelongsToNodes(tenant, entSchema.ActivityStatus(physical.Status), cpy))
+				if _, ok := ss.Physical[tenant]; ok {
+					names = append(names, tenant)
 				}
 			}
 		}
+		total = len(names)
+
+		// only the tenants of the page are copied
+		if after != "" {
+			names = slices.DeleteFunc(names, func(name string) bool { return name <= after })
+		}
+		slices.Sort(names)
+		if limit > 0 && len(names) > limit {
+			names = names[:limit]
+		}
+
+		res = make([]*models.TenantResponse, len(names))
+		for i, tenant := range names {
+			physical := ss.Physical[tenant]
+			// Ensure we copy the belongs to nodes array to avoid it being modified
+			cpy := make([]string, len(physical.BelongsToNodes))
+			copy(cpy, physical.BelongsToNodes)
+			res[i] = MakeTenantWithBelongsToNodes(tenant, entSchema.ActivityStatus(physical.Status), cpy)
+		}
 		return nil
 	}
-	return res, meta.RLockGuard(f)
+	return res, total, meta.RLockGuard(f)
 }
 
 func (s *schema) States() map[string]types.ClassState {
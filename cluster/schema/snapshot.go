@@ -24,6 +24,14 @@ type snapshot struct {
 	NodeID     string                `json:"node_id"`
 	SnapshotID string                `json:"snapshot_id"`
 	Classes    map[string]*metaClass `json:"classes"`
+	// DeletedClasses are missing in snapshots of older versions
+	DeletedClasses map[string]*deletedClass `json:"deleted_classes,omitempty"`
+	// SchemaVersion and Changelog are missing in snapshots of older versions
+	SchemaVersion uint64              `json:"schema_version,omitempty"`
+	Changelog     []SchemaChangeEntry `json:"changelog,omitempty"`
+	// BatchDeleteHistory is oldest first, it is missing in snapshots of
+	// older versions
+	BatchDeleteHistory []BatchDeleteHistoryEntry `json:"batch_delete_history,omitempty"`
 }
 
 func (s *schema) Restore(r io.Reader, parser Parser) error {
@@ -37,10 +45,22 @@ func (s *schema) Restore(r io.Reader, parser Parser) error {
 		}
 		cls.Sharding.SetLocalName(s.nodeID)
 	}
+	if snap.DeletedClasses == nil {
+		snap.DeletedClasses = make(map[string]*deletedClass)
+	}
+	for _, deleted := range snap.DeletedClasses {
+		deleted.Meta.Sharding.SetLocalName(s.nodeID)
+	}
 
 	s.Lock()
 	defer s.Unlock()
 	s.Classes = snap.Classes
+	s.DeletedClasses = snap.DeletedClasses
+	s.version = snap.SchemaVersion
+	s.changelog = snap.Changelog
+	// snapshots of older versions keep all previous classes
+	dropOldPrevious(s.changelog)
+	s.batchDeletes.reset(snap.BatchDeleteHistory)
 
 	return nil
 }
@@ -53,9 +73,14 @@ func (s *schema) Persist(sink raft.SnapshotSink) (err error) {
 
 	defer sink.Close()
 	snap := snapshot{
-		NodeID:     s.nodeID,
-		SnapshotID: sink.ID(),
-		Classes:    s.Classes,
+		NodeID:         s.nodeID,
+		SnapshotID:     sink.ID(),
+		Classes:        s.Classes,
+		DeletedClasses: s.DeletedClasses,
+		SchemaVersion:  s.version,
+		Changelog:      s.changelog,
+
+		BatchDeleteHistory: s.batchDeletes.ordered(),
 	}
 	if err := json.NewEncoder(sink).Encode(&snap); err != nil {
 		return fmt.Errorf("encode: %w", err)
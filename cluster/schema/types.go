@@ -13,6 +13,7 @@ package schema
 
 import (
 	"context"
+	"time"
 
 	"github.com/weaviate/weaviate/cluster/proto/api"
 	"github.com/weaviate/weaviate/cluster/types"
@@ -31,14 +32,28 @@ type (
 type Indexer interface {
 	AddClass(api.AddClassRequest) error
 	UpdateClass(api.UpdateClassRequest) error
+	// UpdateVectorIndexConfig applies the vector index configs of the class,
+	// which have already been updated in the schema, to the local shards
+	UpdateVectorIndexConfig(class string, req api.UpdateVectorIndexConfigRequest) error
 	DeleteClass(className string, hasFrozen bool) error
+	// UnloadClass closes the local index of the class, keeping its files.
+	// AddClass opens them again.
+	UnloadClass(className string) error
+	// DropUnloadedClass removes the files of an unloaded class
+	DropUnloadedClass(className string, hasFrozen bool) error
 	AddProperty(class string, req api.AddPropertyRequest) error
 	AddTenants(class string, req *api.AddTenantsRequest) error
 	UpdateTenants(class string, req *api.UpdateTenantsRequest) error
 	DeleteTenants(class string, req *api.DeleteTenantsRequest) error
 	UpdateTenantsProcess(class string, req *api.TenantProcessRequest) error
 	UpdateShardStatus(*api.UpdateShardStatusRequest) error
+	// MoveShard opens the shard on req.ToNode and closes it on req.FromNode,
+	// keeping its files
+	MoveShard(*api.MoveShardRequest) error
 	GetShardsStatus(class, tenant string) (models.ShardStatusList, error)
+	ShardObjectCount(class, shard string) (int64, error)
+	TenantLastActivity(class, tenant string) (time.Time, error)
+	TenantQueriesInFlight(class, tenant string) (int64, error)
 	UpdateIndex(api.UpdateClassRequest) error
 
 	TriggerSchemaUpdateCallbacks()
@@ -59,4 +74,8 @@ type Parser interface {
 
 	// ParseClass parses new updates by providing the current class data.
 	ParseClassUpdate(class, update *models.Class) (*models.Class, error)
+
+	// ParseClassCompaction returns the current class without the module
+	// configs, reference targets and properties the update doesn't have.
+	ParseClassCompaction(class, update *models.Class) (*models.Class, error)
 }
@@ -143,6 +143,14 @@ type Config struct {
 	FQDNResolverTLD    string
 
 	AuthzController authorization.Controller
+
+	// SchemaChangelogSize is the number of schema changes retained in the changelog
+	SchemaChangelogSize int
+
+	// MaxPropertiesPerClass and MaxTenantsPerClass are the schema limits, they
+	// are added to the commands which are checked against them when applied
+	MaxPropertiesPerClass int
+	MaxTenantsPerClass    int
 }
 
 // Store is the implementation of RAFT on this local node. It will handle the local schema and RAFT operations (startup,
@@ -189,6 +197,9 @@ type Store struct {
 	// authZManager is responsible for applying/querying changes committed by RAFT to the rbac representation
 	authZManager *rbac.Manager
 
+	// snapshotting is set while a user requested snapshot is being taken
+	snapshotting atomic.Bool
+
 	// lastAppliedIndexToDB represents the index of the last applied command when the store is opened.
 	lastAppliedIndexToDB atomic.Uint64
 	// / lastAppliedIndex index of latest update to the store
@@ -214,7 +225,7 @@ func NewFSM(cfg Config) Store {
 		})
 	}
 
-	schemaManager := schema.NewSchemaManager(cfg.NodeID, cfg.DB, cfg.Parser, cfg.Logger)
+	schemaManager := schema.NewSchemaManager(cfg.NodeID, cfg.DB, cfg.Parser, cfg.Logger, cfg.SchemaChangelogSize)
 
 	return Store{
 		cfg:           cfg,
@@ -562,6 +573,7 @@ func (st *Store) Stats() map[string]any {
 	stats["candidates"] = st.candidates
 	stats["last_store_log_applied_index"] = st.lastAppliedIndexToDB.Load()
 	stats["last_applied_index"] = st.lastIndex()
+	stats["schema_version"] = st.SchemaReader().SchemaVersion()
 	stats["db_loaded"] = st.dbLoaded.Load()
 
 	// If the raft stats exist, add them as a nested map
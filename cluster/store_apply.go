@@ -12,15 +12,19 @@
 package cluster
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
 	"sync"
+	"time"
 
 	"github.com/hashicorp/raft"
 	"github.com/sirupsen/logrus"
 	"github.com/weaviate/weaviate/cluster/proto/api"
+	"github.com/weaviate/weaviate/cluster/schema"
 	"github.com/weaviate/weaviate/cluster/types"
 	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/entities/models"
 	"google.golang.org/protobuf/proto"
 	gproto "google.golang.org/protobuf/proto"
 )
@@ -135,57 +139,48 @@ func (st *Store) Apply(l *raft.Log) interface{} {
 	}).Debug("server.apply")
 
 	f := func() {}
+	var previous *models.Class
 
 	switch cmd.Type {
 
-	case api.ApplyRequest_TYPE_ADD_CLASS:
-		f = func() {
-			ret.Error = st.schemaManager.AddClass(&cmd, st.cfg.NodeID, schemaOnly, !catchingUp)
-		}
-
-	case api.ApplyRequest_TYPE_RESTORE_CLASS:
-		f = func() {
-			ret.Error = st.schemaManager.RestoreClass(&cmd, st.cfg.NodeID, schemaOnly, !catchingUp)
-		}
-
-	case api.ApplyRequest_TYPE_UPDATE_CLASS:
-		f = func() {
-			ret.Error = st.schemaManager.UpdateClass(&cmd, st.cfg.NodeID, schemaOnly, !catchingUp)
-		}
-
-	case api.ApplyRequest_TYPE_DELETE_CLASS:
-		f = func() {
-			ret.Error = st.schemaManager.DeleteClass(&cmd, schemaOnly, !catchingUp)
-		}
-
-	case api.ApplyRequest_TYPE_ADD_PROPERTY:
-		f = func() {
-			ret.Error = st.schemaManager.AddProperty(&cmd, schemaOnly, !catchingUp)
-		}
-
-	case api.ApplyRequest_TYPE_UPDATE_SHARD_STATUS:
+	case api.ApplyRequest_TYPE_UPDATE_CLASS, api.ApplyRequest_TYPE_UPDATE_VECTOR_INDEX_CONFIG:
+		// keep the class as it was before the update in the changelog
+		previous = st.schemaManager.ReadOnlyClass(cmd.Class)
 		f = func() {
-			ret.Error = st.schemaManager.UpdateShardStatus(&cmd, schemaOnly)
+			ret.Error = st.applySchemaCommand(st.schemaManager, &cmd, schemaOnly, !catchingUp)
 		}
 
-	case api.ApplyRequest_TYPE_ADD_TENANT:
+	case api.ApplyRequest_TYPE_ADD_CLASS,
+		api.ApplyRequest_TYPE_RESTORE_CLASS,
+		api.ApplyRequest_TYPE_DELETE_CLASS,
+		api.ApplyRequest_TYPE_ADD_PROPERTY,
+		api.ApplyRequest_TYPE_UPDATE_SHARD_STATUS,
+		api.ApplyRequest_TYPE_MOVE_SHARD,
+		api.ApplyRequest_TYPE_ADD_TENANT,
+		api.ApplyRequest_TYPE_UPDATE_TENANT,
+		api.ApplyRequest_TYPE_DELETE_TENANT,
+		api.ApplyRequest_TYPE_TENANT_PROCESS,
+		api.ApplyRequest_TYPE_PURGE_TENANT,
+		api.ApplyRequest_TYPE_SOFT_DELETE_CLASS,
+		api.ApplyRequest_TYPE_RESTORE_DELETED_CLASS,
+		api.ApplyRequest_TYPE_PURGE_DELETED_CLASS:
 		f = func() {
-			ret.Error = st.schemaManager.AddTenants(&cmd, schemaOnly)
+			ret.Error = st.applySchemaCommand(st.schemaManager, &cmd, schemaOnly, !catchingUp)
 		}
 
-	case api.ApplyRequest_TYPE_UPDATE_TENANT:
+	case api.ApplyRequest_TYPE_RESERVE_CLASS_OBJECTS:
 		f = func() {
-			ret.Error = st.schemaManager.UpdateTenants(&cmd, schemaOnly)
+			ret.Error = st.schemaManager.ReserveClassObjects(&cmd, schemaOnly)
 		}
 
-	case api.ApplyRequest_TYPE_DELETE_TENANT:
+	case api.ApplyRequest_TYPE_RECORD_BATCH_DELETE:
 		f = func() {
-			ret.Error = st.schemaManager.DeleteTenants(&cmd, schemaOnly)
+			ret.Error = st.schemaManager.RecordBatchDelete(&cmd)
 		}
 
-	case api.ApplyRequest_TYPE_TENANT_PROCESS:
+	case api.ApplyRequest_TYPE_BATCH:
 		f = func() {
-			ret.Error = st.schemaManager.UpdateTenantsProcess(&cmd, schemaOnly)
+			ret.Error = st.applyBatch(&cmd, l.AppendedAt, schemaOnly, !catchingUp)
 		}
 
 	case api.ApplyRequest_TYPE_STORE_SCHEMA_V1:
@@ -237,5 +232,133 @@ func (st *Store) Apply(l *raft.Log) interface{} {
 	enterrors.GoWrapper(g, st.log)
 	wg.Wait()
 
+	if isSchemaChange(cmd.Type) && changedSchema(ret.Error) {
+		st.schemaManager.RecordChange(&cmd, l.AppendedAt, previous)
+	}
+
 	return ret
 }
+
+// applySchemaCommand applies cmd to sm, cmd must be of a type for which
+// isSchemaChange is true
+func (st *Store) applySchemaCommand(sm *schema.SchemaManager, cmd *api.ApplyRequest, schemaOnly, enableSchemaCallback bool) error {
+	switch cmd.Type {
+	case api.ApplyRequest_TYPE_ADD_CLASS:
+		return sm.AddClass(cmd, st.cfg.NodeID, schemaOnly, enableSchemaCallback)
+	case api.ApplyRequest_TYPE_RESTORE_CLASS:
+		return sm.RestoreClass(cmd, st.cfg.NodeID, schemaOnly, enableSchemaCallback)
+	case api.ApplyRequest_TYPE_UPDATE_CLASS:
+		return sm.UpdateClass(cmd, st.cfg.NodeID, schemaOnly, enableSchemaCallback)
+	case api.ApplyRequest_TYPE_UPDATE_VECTOR_INDEX_CONFIG:
+		return sm.UpdateVectorIndexConfig(cmd, schemaOnly, enableSchemaCallback)
+	case api.ApplyRequest_TYPE_DELETE_CLASS:
+		return sm.DeleteClass(cmd, schemaOnly, enableSchemaCallback)
+	case api.ApplyRequest_TYPE_ADD_PROPERTY:
+		return sm.AddProperty(cmd, schemaOnly, enableSchemaCallback)
+	case api.ApplyRequest_TYPE_UPDATE_SHARD_STATUS:
+		return sm.UpdateShardStatus(cmd, schemaOnly)
+	case api.ApplyRequest_TYPE_MOVE_SHARD:
+		return sm.MoveShard(cmd, schemaOnly)
+	case api.ApplyRequest_TYPE_ADD_TENANT:
+		return sm.AddTenants(cmd, schemaOnly)
+	case api.ApplyRequest_TYPE_UPDATE_TENANT:
+		return sm.UpdateTenants(cmd, schemaOnly)
+	case api.ApplyRequest_TYPE_DELETE_TENANT:
+		return sm.DeleteTenants(cmd, schemaOnly)
+	case api.ApplyRequest_TYPE_TENANT_PROCESS:
+		return sm.UpdateTenantsProcess(cmd, schemaOnly)
+	case api.ApplyRequest_TYPE_PURGE_TENANT:
+		return sm.PurgeTenant(cmd, schemaOnly)
+	case api.ApplyRequest_TYPE_SOFT_DELETE_CLASS:
+		return sm.SoftDeleteClass(cmd, schemaOnly, enableSchemaCallback)
+	case api.ApplyRequest_TYPE_RESTORE_DELETED_CLASS:
+		return sm.RestoreDeletedClass(cmd, schemaOnly, enableSchemaCallback)
+	case api.ApplyRequest_TYPE_PURGE_DELETED_CLASS:
+		return sm.PurgeDeletedClass(cmd, schemaOnly, enableSchemaCallback)
+	default:
+		return fmt.Errorf("%w: %s is not a schema command", types.ErrUnknownCommand, cmd.Type)
+	}
+}
+
+// applyBatch applies the commands of a TYPE_BATCH command in order. They are
+// applied to a copy of the schema first so that either all of them change the
+// schema or, if one of them fails, none does. A command whose change of the
+// local store fails keeps its schema change like outside of a batch, the
+// remaining commands are still applied and the errors are returned together.
+// Each command is recorded in the changelog on its own.
+func (st *Store) applyBatch(cmd *api.ApplyRequest, at time.Time, schemaOnly, enableSchemaCallback bool) error {
+	req := api.BatchRequest{}
+	if err := json.Unmarshal(cmd.SubCommand, &req); err != nil {
+		return fmt.Errorf("%w: %w", schema.ErrBadRequest, err)
+	}
+	for i, c := range req.Commands {
+		// restoring a class also restores its directory from a backup, which
+		// can't be checked on a copy of the schema
+		if c == nil || !isSchemaChange(c.Type) || c.Type == api.ApplyRequest_TYPE_RESTORE_CLASS {
+			return fmt.Errorf("%w: command %d of batch is not a supported schema command", schema.ErrBadRequest, i)
+		}
+		c.Version = cmd.Version
+	}
+
+	scratch, err := st.schemaManager.Copy()
+	if err != nil {
+		return fmt.Errorf("copy schema: %w", err)
+	}
+	for i, c := range req.Commands {
+		if err := st.applySchemaCommand(scratch, c, true, false); err != nil {
+			return fmt.Errorf("command %d (%s) of batch: %w", i, c.Type, err)
+		}
+	}
+
+	var errs []error
+	for i, c := range req.Commands {
+		var previous *models.Class
+		if c.Type == api.ApplyRequest_TYPE_UPDATE_CLASS || c.Type == api.ApplyRequest_TYPE_UPDATE_VECTOR_INDEX_CONFIG {
+			previous = st.schemaManager.ReadOnlyClass(c.Class)
+		}
+		err := st.applySchemaCommand(st.schemaManager, c, schemaOnly, enableSchemaCallback)
+		if !changedSchema(err) {
+			// can't happen after the copy accepted the batch
+			return errors.Join(append(errs, fmt.Errorf("command %d (%s) of batch: %w", i, c.Type, err))...)
+		}
+		if err != nil {
+			errs = append(errs, fmt.Errorf("command %d (%s) of batch: %w", i, c.Type, err))
+		}
+		st.schemaManager.RecordChange(c, at, previous)
+	}
+	return errors.Join(errs...)
+}
+
+// changedSchema returns whether a schema command which returned err changed
+// the schema. Only commands rejected by the schema leave it unchanged, the
+// store, e.g. the DB, is updated after the schema. The change must then be
+// recorded anyway, so that the version doesn't fall behind on this node.
+func changedSchema(err error) bool {
+	return err == nil || !errors.Is(err, schema.ErrSchema)
+}
+
+// isSchemaChange returns whether the command changes the schema and thereby
+// bumps the schema version
+func isSchemaChange(t api.ApplyRequest_Type) bool {
+	switch t {
+	case api.ApplyRequest_TYPE_ADD_CLASS,
+		api.ApplyRequest_TYPE_RESTORE_CLASS,
+		api.ApplyRequest_TYPE_UPDATE_CLASS,
+		api.ApplyRequest_TYPE_UPDATE_VECTOR_INDEX_CONFIG,
+		api.ApplyRequest_TYPE_DELETE_CLASS,
+		api.ApplyRequest_TYPE_ADD_PROPERTY,
+		api.ApplyRequest_TYPE_UPDATE_SHARD_STATUS,
+		api.ApplyRequest_TYPE_MOVE_SHARD,
+		api.ApplyRequest_TYPE_ADD_TENANT,
+		api.ApplyRequest_TYPE_UPDATE_TENANT,
+		api.ApplyRequest_TYPE_DELETE_TENANT,
+		api.ApplyRequest_TYPE_TENANT_PROCESS,
+		api.ApplyRequest_TYPE_PURGE_TENANT,
+		api.ApplyRequest_TYPE_SOFT_DELETE_CLASS,
+		api.ApplyRequest_TYPE_RESTORE_DELETED_CLASS,
+		api.ApplyRequest_TYPE_PURGE_DELETED_CLASS:
+		return true
+	default:
+		return false
+	}
+}
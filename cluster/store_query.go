@@ -12,6 +12,7 @@
 package cluster
 
 import (
+	"encoding/json"
 	"fmt"
 
 	"github.com/sirupsen/logrus"
@@ -54,6 +55,11 @@ func (st *Store) Query(req *cmd.QueryRequest) (*cmd.QueryResponse, error) {
 		if err != nil {
 			return &cmd.QueryResponse{}, fmt.Errorf("could not get sharding state: %w", err)
 		}
+	case cmd.QueryRequest_TYPE_GET_READ_INDEX:
+		payload, err = st.queryReadIndex()
+		if err != nil {
+			return &cmd.QueryResponse{}, fmt.Errorf("could not get read index: %w", err)
+		}
 	case cmd.QueryRequest_TYPE_HAS_PERMISSION:
 		payload, err = st.authZManager.HasPermission(req)
 		if err != nil {
@@ -87,3 +93,14 @@ func (st *Store) Query(req *cmd.QueryRequest) (*cmd.QueryResponse, error) {
 	}
 	return &cmd.QueryResponse{Payload: payload}, nil
 }
+
+// queryReadIndex confirms that this node is still the leader and returns the
+// last applied index. Every change acknowledged to a client before the call
+// has been applied at this index, since the leader acknowledges changes only
+// after applying them.
+func (st *Store) queryReadIndex() ([]byte, error) {
+	if err := st.raft.VerifyLeader().Error(); err != nil {
+		return nil, err
+	}
+	return json.Marshal(cmd.QueryReadIndexResponse{Index: st.lastAppliedIndex.Load()})
+}
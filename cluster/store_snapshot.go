@@ -12,12 +12,14 @@
 package cluster
 
 import (
+	"errors"
 	"fmt"
 	"io"
 	"sync"
 
 	"github.com/hashicorp/raft"
 	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/cluster/types"
 	enterrors "github.com/weaviate/weaviate/entities/errors"
 )
 
@@ -38,6 +40,32 @@ func (st *Store) Snapshot() (raft.FSMSnapshot, error) {
 	return st.schemaManager.Snapshot(), nil
 }
 
+// TakeSnapshot forces raft to snapshot the current state and compact the log
+// up to the last applied index. It is meant to be triggered by operators, as
+// raft already takes snapshots periodically based on SnapshotThreshold and
+// SnapshotInterval.
+// It returns ErrSnapshotInProgress if a previously triggered snapshot is still
+// running.
+func (st *Store) TakeSnapshot() error {
+	if !st.open.Load() {
+		return types.ErrNotOpen
+	}
+	if !st.snapshotting.CompareAndSwap(false, true) {
+		return types.ErrSnapshotInProgress
+	}
+	defer st.snapshotting.Store(false)
+
+	st.log.Info("user requested snapshot")
+	if err := st.raft.Snapshot().Error(); err != nil {
+		if errors.Is(err, raft.ErrNothingNewToSnapshot) {
+			st.log.Info("nothing new to snapshot")
+			return nil
+		}
+		return fmt.Errorf("take snapshot: %w", err)
+	}
+	return nil
+}
+
 // Restore is used to restore an FSM from a snapshot. It is not called
 // concurrently with any other command. The FSM must discard all previous
 // state before restoring the snapshot.
@@ -110,6 +110,28 @@ func TestStoreApply(t *testing.T) {
 				return nil
 			},
 		},
+		{
+			name: "AddClass/StoreFailure",
+			req: raft.Log{Data: cmdAsBytes("C1",
+				cmd.ApplyRequest_TYPE_ADD_CLASS,
+				cmd.AddClassRequest{Class: cls, State: ss},
+				nil)},
+			resp: Response{Error: errAny},
+			doBefore: func(m *MockStore) {
+				m.indexer.On("AddClass", mock.Anything).Return(errAny)
+				m.parser.On("ParseClass", mock.Anything).Return(nil)
+			},
+			doAfter: func(ms *MockStore) error {
+				// the schema is changed before the store fails
+				if ms.store.SchemaReader().ReadOnlyClass("C1") == nil {
+					return fmt.Errorf("class is missing")
+				}
+				if v := ms.store.SchemaReader().SchemaVersion(); v != 1 {
+					return fmt.Errorf("schema version want: 1 got: %d", v)
+				}
+				return nil
+			},
+		},
 		{
 			name: "AddClass/Success/MetadataOnly",
 			req: raft.Log{Data: cmdAsBytes("C1",
@@ -259,6 +281,89 @@ func TestStoreApply(t *testing.T) {
 				m.indexer.On("TriggerSchemaUpdateCallbacks").Return()
 			},
 		},
+		{
+			name: "UpdateClass/KeepQueryTimeout",
+			req: raft.Log{Data: cmdAsBytes("C1",
+				cmd.ApplyRequest_TYPE_UPDATE_CLASS,
+				cmd.UpdateClassRequest{Class: &models.Class{Class: "C1"}, State: nil},
+				nil)},
+			resp: Response{Error: nil},
+			doBefore: func(m *MockStore) {
+				doFirst(m)
+				m.parser.On("ParseClassUpdate", mock.Anything, mock.Anything).Return(mock.Anything, nil)
+				m.indexer.On("UpdateClass", mock.Anything).Return(nil)
+				m.indexer.On("AddClass", mock.Anything).Return(nil)
+				timeout := time.Minute
+				m.store.Apply(&raft.Log{
+					Data: cmdAsBytes("C1", cmd.ApplyRequest_TYPE_ADD_CLASS, cmd.AddClassRequest{
+						Class: &models.Class{Class: "C1", QueryTimeout: &timeout}, State: ss,
+					}, nil),
+				})
+			},
+			doAfter: func(ms *MockStore) error {
+				class := ms.store.SchemaReader().ReadOnlyClass("C1")
+				if class == nil || class.QueryTimeout == nil || *class.QueryTimeout != time.Minute {
+					return fmt.Errorf("query timeout was not kept: %v", class)
+				}
+				return nil
+			},
+		},
+		{
+			name: "UpdateClass/DisableMultiTenancyWithTenants",
+			req: raft.Log{Data: cmdAsBytes("C1",
+				cmd.ApplyRequest_TYPE_UPDATE_CLASS,
+				cmd.UpdateClassRequest{
+					Class:            &models.Class{Class: "C1"},
+					State:            &sharding.State{},
+					MultiTenancyFrom: &models.MultiTenancyConfig{Enabled: true},
+				},
+				nil)},
+			resp: Response{Error: schema.ErrBadRequest},
+			doBefore: func(m *MockStore) {
+				doFirst(m)
+				m.indexer.On("AddClass", mock.Anything).Return(nil)
+				m.store.Apply(&raft.Log{
+					Data: cmdAsBytes("C1", cmd.ApplyRequest_TYPE_ADD_CLASS, cmd.AddClassRequest{
+						Class: &models.Class{Class: "C1", MultiTenancyConfig: &models.MultiTenancyConfig{Enabled: true}},
+						State: ss,
+					}, nil),
+				})
+			},
+			doAfter: func(ms *MockStore) error {
+				class := ms.store.SchemaReader().ReadOnlyClass("C1")
+				if class == nil || !class.MultiTenancyConfig.Enabled {
+					return fmt.Errorf("multi-tenancy was disabled: %v", class)
+				}
+				return nil
+			},
+		},
+		{
+			name: "UpdateClass/Compact",
+			req: raft.Log{Data: cmdAsBytes("C1",
+				cmd.ApplyRequest_TYPE_UPDATE_CLASS,
+				cmd.UpdateClassRequest{Class: &models.Class{Class: "C1", ModuleConfig: map[string]any{}}, Compact: true},
+				nil)},
+			resp: Response{Error: nil},
+			doBefore: func(m *MockStore) {
+				doFirst(m)
+				m.parser.On("ParseClassCompaction", mock.Anything, mock.Anything).Return(mock.Anything, nil)
+				m.indexer.On("AddClass", mock.Anything).Return(nil)
+				m.store.Apply(&raft.Log{
+					Data: cmdAsBytes("C1", cmd.ApplyRequest_TYPE_ADD_CLASS, cmd.AddClassRequest{
+						Class: &models.Class{Class: "C1", ModuleConfig: map[string]any{"removed": map[string]any{}}}, State: ss,
+					}, nil),
+				})
+				m.indexer.On("TriggerSchemaUpdateCallbacks").Return()
+			},
+			doAfter: func(ms *MockStore) error {
+				// the indexes are not updated, so the indexer doesn't expect UpdateClass
+				class := ms.store.SchemaReader().ReadOnlyClass("C1")
+				if class == nil || len(class.ModuleConfig.(map[string]any)) != 0 {
+					return fmt.Errorf("module config was not compacted: %v", class)
+				}
+				return nil
+			},
+		},
 		{
 			name: "DeleteClass/Success",
 			req: raft.Log{Data: cmdAsBytes("C1",
@@ -522,6 +627,98 @@ func TestStoreApply(t *testing.T) {
 				return nil
 			},
 		},
+		{
+			name: "Batch/Unmarshal",
+			req: raft.Log{Data: cmdAsBytes("", cmd.ApplyRequest_TYPE_BATCH,
+				nil, &cmd.AddTenantsRequest{})},
+			resp:     Response{Error: schema.ErrBadRequest},
+			doBefore: doFirst,
+		},
+		{
+			name: "Batch/RestoreClass",
+			req: raft.Log{Data: cmdAsBytes("", cmd.ApplyRequest_TYPE_BATCH,
+				cmd.BatchRequest{Commands: []*cmd.ApplyRequest{
+					batchCmd("C1", cmd.ApplyRequest_TYPE_RESTORE_CLASS, cmd.AddClassRequest{Class: cls, State: ss}),
+				}}, nil)},
+			resp:     Response{Error: schema.ErrBadRequest},
+			doBefore: doFirst,
+		},
+		{
+			name: "Batch/Atomic",
+			req: raft.Log{Data: cmdAsBytes("", cmd.ApplyRequest_TYPE_BATCH,
+				cmd.BatchRequest{Commands: []*cmd.ApplyRequest{
+					batchCmd("C1", cmd.ApplyRequest_TYPE_ADD_CLASS, cmd.AddClassRequest{Class: cls, State: ss}),
+					batchCmd("C2", cmd.ApplyRequest_TYPE_ADD_PROPERTY,
+						cmd.AddPropertyRequest{Properties: []*models.Property{{Name: "P1"}}}),
+				}}, nil)},
+			resp: Response{Error: schema.ErrSchema},
+			doBefore: func(m *MockStore) {
+				m.parser.On("ParseClass", mock.Anything).Return(nil)
+			},
+			doAfter: func(ms *MockStore) error {
+				if ms.store.SchemaReader().ReadOnlyClass("C1") != nil {
+					return fmt.Errorf("class of failed batch was added")
+				}
+				if v := ms.store.SchemaReader().SchemaVersion(); v != 0 {
+					return fmt.Errorf("schema version want: 0 got: %d", v)
+				}
+				return nil
+			},
+		},
+		{
+			name: "Batch/StoreFailure",
+			req: raft.Log{Data: cmdAsBytes("", cmd.ApplyRequest_TYPE_BATCH,
+				cmd.BatchRequest{Commands: []*cmd.ApplyRequest{
+					batchCmd("C1", cmd.ApplyRequest_TYPE_ADD_CLASS, cmd.AddClassRequest{Class: cls, State: ss}),
+					batchCmd("C1", cmd.ApplyRequest_TYPE_ADD_PROPERTY,
+						cmd.AddPropertyRequest{Properties: []*models.Property{{Name: "P1"}}}),
+				}}, nil)},
+			resp: Response{Error: errAny},
+			doBefore: func(m *MockStore) {
+				doFirst(m)
+				m.indexer.On("AddClass", mock.Anything).Return(errAny)
+				m.indexer.On("AddProperty", mock.Anything, mock.Anything).Return(nil)
+			},
+			doAfter: func(ms *MockStore) error {
+				// the remaining commands are applied after a store failure
+				class := ms.store.SchemaReader().ReadOnlyClass("C1")
+				if class == nil || len(class.Properties) != 1 {
+					return fmt.Errorf("batch was applied partially")
+				}
+				if v := ms.store.SchemaReader().SchemaVersion(); v != 2 {
+					return fmt.Errorf("schema version want: 2 got: %d", v)
+				}
+				return nil
+			},
+		},
+		{
+			name: "Batch/Success",
+			req: raft.Log{Data: cmdAsBytes("", cmd.ApplyRequest_TYPE_BATCH,
+				cmd.BatchRequest{Commands: []*cmd.ApplyRequest{
+					batchCmd("C1", cmd.ApplyRequest_TYPE_ADD_CLASS, cmd.AddClassRequest{Class: cls, State: ss}),
+					batchCmd("C1", cmd.ApplyRequest_TYPE_ADD_PROPERTY,
+						cmd.AddPropertyRequest{Properties: []*models.Property{{Name: "P1"}}}),
+				}}, nil)},
+			resp: Response{Error: nil},
+			doBefore: func(m *MockStore) {
+				doFirst(m)
+				m.indexer.On("AddClass", mock.Anything).Return(nil)
+				m.indexer.On("AddProperty", mock.Anything, mock.Anything).Return(nil)
+			},
+			doAfter: func(ms *MockStore) error {
+				class := ms.store.SchemaReader().ReadOnlyClass("C1")
+				if class == nil {
+					return fmt.Errorf("class not found")
+				}
+				if len(class.Properties) != 1 || class.Properties[0].Name != "P1" {
+					return fmt.Errorf("property is missing")
+				}
+				if v := ms.store.SchemaReader().SchemaVersion(); v != 2 {
+					return fmt.Errorf("schema version want: 2 got: %d", v)
+				}
+				return nil
+			},
+		},
 	}
 
 	for _, tc := range tests {
@@ -611,6 +808,15 @@ func tryNTimesWithWait(n int, sleepDuration time.Duration, predicate func() bool
 	return false
 }
 
+// batchCmd returns a command of a TYPE_BATCH command
+func batchCmd(class string, cmdType cmd.ApplyRequest_Type, jsonSubCmd interface{}) *cmd.ApplyRequest {
+	subData, err := json.Marshal(jsonSubCmd)
+	if err != nil {
+		panic("json.Marshal( " + err.Error())
+	}
+	return &cmd.ApplyRequest{Type: cmdType, Class: class, SubCommand: subData}
+}
+
 func cmdAsBytes(class string,
 	cmdType cmd.ApplyRequest_Type,
 	jsonSubCmd interface{},
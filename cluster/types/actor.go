@@ -0,0 +1,64 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package types
+
+import (
+	"context"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+type actorKey struct{}
+
+// ContextWithActor attaches the user requesting a change to ctx, so that it is
+// recorded with the resulting RAFT command
+func ContextWithActor(ctx context.Context, actor string) context.Context {
+	return context.WithValue(ctx, actorKey{}, actor)
+}
+
+// ActorFromContext returns the user attached by ContextWithActor, or "" if
+// there is none
+func ActorFromContext(ctx context.Context) string {
+	actor, _ := ctx.Value(actorKey{}).(string)
+	return actor
+}
+
+type compactionKey struct{}
+
+// ContextWithCompaction marks the class updates made with ctx as schema
+// compactions, which may only remove module configs, reference targets and
+// properties instead of changing the class settings
+func ContextWithCompaction(ctx context.Context) context.Context {
+	return context.WithValue(ctx, compactionKey{}, true)
+}
+
+// CompactionFromContext reports whether ctx was marked by ContextWithCompaction
+func CompactionFromContext(ctx context.Context) bool {
+	compact, _ := ctx.Value(compactionKey{}).(bool)
+	return compact
+}
+
+type multiTenancyMigrationKey struct{}
+
+// ContextWithMultiTenancyMigration marks the class updates made with ctx as
+// changes of the multi-tenancy config from the given one, which move the
+// class between a sharded and a multi-tenant index
+func ContextWithMultiTenancyMigration(ctx context.Context, from models.MultiTenancyConfig) context.Context {
+	return context.WithValue(ctx, multiTenancyMigrationKey{}, &from)
+}
+
+// MultiTenancyMigrationFromContext returns the config attached by
+// ContextWithMultiTenancyMigration, or nil if there is none
+func MultiTenancyMigrationFromContext(ctx context.Context) *models.MultiTenancyConfig {
+	from, _ := ctx.Value(multiTenancyMigrationKey{}).(*models.MultiTenancyConfig)
+	return from
+}
@@ -0,0 +1,73 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package types
+
+import "time"
+
+type BackfillState string
+
+const (
+	BackfillRunning  BackfillState = "RUNNING"
+	BackfillFinished BackfillState = "FINISHED"
+	BackfillFailed   BackfillState = "FAILED"
+)
+
+// BackfillStatus describes a job setting the default value of a property on
+// the existing objects of a class
+type BackfillStatus struct {
+	ID       string
+	Class    string
+	Property string
+	Status   BackfillState
+	// Updated is the number of objects set to the default value so far
+	Updated    int64
+	Error      string
+	StartedAt  time.Time
+	FinishedAt time.Time
+}
+
+// BackfillVectorOptions configure a job vectorizing the existing objects of a
+// class again, e.g. after its vectorizer was changed
+type BackfillVectorOptions struct {
+	// BatchSize is the number of objects vectorized at once
+	BatchSize int
+	// ConcurrentWorkers is the number of batches vectorized at the same time
+	ConcurrentWorkers int
+	// OnlyNilVectors skips objects which already have a vector
+	OnlyNilVectors bool
+	// TargetVector is the named vector to backfill. All vectors of the class
+	// are backfilled if it is empty.
+	TargetVector string
+}
+
+// VectorBackfillStatus describes a job vectorizing the existing objects of a
+// class again
+type VectorBackfillStatus struct {
+	ID           string
+	Class        string
+	TargetVector string
+	Status       BackfillState
+	// Shards is the number of local shards of the class, ShardsDone the
+	// number of them backfilled so far
+	Shards     int
+	ShardsDone int
+	// Scanned is the number of objects read so far, Updated the number of
+	// them written with new vectors and Failed the number of them the
+	// vectorizer failed for. Objects skipped because of OnlyNilVectors are
+	// only counted as scanned.
+	Scanned    int64
+	Updated    int64
+	Failed     int64
+	Error      string
+	StartedAt  time.Time
+	FinishedAt time.Time
+}
@@ -22,4 +22,8 @@ var (
 	ErrUnknownCommand = errors.New("unknown command")
 	// ErrDeadlineExceeded represents an error returned when the deadline for waiting for a specific update is exceeded.
 	ErrDeadlineExceeded = errors.New("deadline exceeded for waiting for update")
+	// ErrSnapshotInProgress is returned when a snapshot is requested while a previously requested one is still running.
+	ErrSnapshotInProgress = errors.New("snapshot already in progress")
+	// ErrTxnDone is returned when a schema transaction is used after it was committed or rolled back.
+	ErrTxnDone = errors.New("transaction already committed or rolled back")
 )
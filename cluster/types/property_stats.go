@@ -0,0 +1,29 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package types
+
+import "time"
+
+// PropertyStats describes the values of a property in the loaded local shards
+// of a class, as read from its filterable index
+type PropertyStats struct {
+	// NullCount is the number of objects without a value
+	NullCount  int64
+	TotalCount int64
+	// DistinctValueCount is the number of distinct indexed values, i.e. of
+	// tokens for text properties. Counting stops at 100000.
+	DistinctValueCount int64
+	// AvgValueLength is the average length in bytes of the indexed tokens of
+	// the objects, zero for properties other than text
+	AvgValueLength float64
+	ComputedAt     time.Time
+}
@@ -0,0 +1,44 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package types
+
+import (
+	"context"
+
+	cmd "github.com/weaviate/weaviate/cluster/proto/api"
+	"github.com/weaviate/weaviate/entities/models"
+	schemaConfig "github.com/weaviate/weaviate/entities/schema/config"
+	"github.com/weaviate/weaviate/usecases/sharding"
+)
+
+// Txn is an optimistic schema transaction. Its write methods only buffer the
+// changes and return schema version 0. Commit applies all buffered changes as
+// a single RAFT entry: either all of them change the schema or none does.
+// Conflicts with changes made concurrently outside the transaction are only
+// detected on Commit.
+type Txn interface {
+	AddClass(ctx context.Context, cls *models.Class, ss *sharding.State) (uint64, error)
+	UpdateClass(ctx context.Context, cls *models.Class, ss *sharding.State) (uint64, error)
+	UpdateVectorIndexConfig(ctx context.Context, class string, configs map[string]schemaConfig.VectorIndexConfig) (uint64, error)
+	DeleteClass(ctx context.Context, name string) (uint64, error)
+	AddProperty(ctx context.Context, class string, p ...*models.Property) (uint64, error)
+	UpdateShardStatus(ctx context.Context, class, shard, status string) (uint64, error)
+	MoveShard(ctx context.Context, class, shard, fromNode, toNode string) (uint64, error)
+	AddTenants(ctx context.Context, class string, req *cmd.AddTenantsRequest) (uint64, error)
+	UpdateTenants(ctx context.Context, class string, req *cmd.UpdateTenantsRequest) (uint64, error)
+	DeleteTenants(ctx context.Context, class string, req *cmd.DeleteTenantsRequest) (uint64, error)
+
+	// Commit applies the buffered changes. It is a no-op without changes.
+	Commit() error
+	// Rollback discards the buffered changes.
+	Rollback() error
+}
@@ -11,7 +11,12 @@
 
 package deepcopy
 
-import "github.com/weaviate/weaviate/entities/models"
+import (
+	"slices"
+	"time"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
 
 func Schema(s *models.Schema) *models.Schema {
 	classes := make([]*models.Class, len(s.Classes))
@@ -42,33 +47,68 @@ func Class(c *models.Class) *models.Class {
 		}
 	}
 
+	var compactionConf *models.ClassCompactionConfig = nil
+	if c.CompactionConfig != nil {
+		cc := *c.CompactionConfig
+		compactionConf = &cc
+	}
+
+	var queryTimeout *time.Duration = nil
+	if c.QueryTimeout != nil {
+		qt := *c.QueryTimeout
+		queryTimeout = &qt
+	}
+
 	return &models.Class{
-		Class:               c.Class,
-		Description:         c.Description,
-		ModuleConfig:        c.ModuleConfig,
-		ShardingConfig:      c.ShardingConfig,
-		VectorIndexConfig:   c.VectorIndexConfig,
-		VectorIndexType:     c.VectorIndexType,
-		ReplicationConfig:   replicationConf,
-		Vectorizer:          c.Vectorizer,
-		InvertedIndexConfig: InvertedIndexConfig(c.InvertedIndexConfig),
-		Properties:          properties,
+		Class:                    c.Class,
+		Description:              c.Description,
+		ModuleConfig:             c.ModuleConfig,
+		ShardingConfig:           c.ShardingConfig,
+		VectorIndexConfig:        c.VectorIndexConfig,
+		VectorIndexType:          c.VectorIndexType,
+		ReplicationConfig:        replicationConf,
+		Vectorizer:               c.Vectorizer,
+		InvertedIndexConfig:      InvertedIndexConfig(c.InvertedIndexConfig),
+		EnforceDeprecation:       c.EnforceDeprecation,
+		StrictPropertyValidation: ptrBoolCopy(c.StrictPropertyValidation),
+		ReadOnly:                 ptrBoolCopy(c.ReadOnly),
+		MaxObjects:               c.MaxObjects,
+		QueryTimeout:             queryTimeout,
+		Extends:                  c.Extends,
+		CompactionConfig:         compactionConf,
+		Properties:               properties,
 	}
 }
 
 func Prop(p *models.Property) *models.Property {
 	return &models.Property{
-		DataType:          p.DataType,
-		Description:       p.Description,
-		ModuleConfig:      p.ModuleConfig,
-		Name:              p.Name,
-		Tokenization:      p.Tokenization,
-		IndexFilterable:   ptrBoolCopy(p.IndexFilterable),
-		IndexSearchable:   ptrBoolCopy(p.IndexSearchable),
-		IndexRangeFilters: ptrBoolCopy(p.IndexRangeFilters),
+		DataType:             p.DataType,
+		Description:          p.Description,
+		ModuleConfig:         p.ModuleConfig,
+		Name:                 p.Name,
+		Aliases:              slices.Clone(p.Aliases),
+		Tokenization:         p.Tokenization,
+		IndexFilterable:      ptrBoolCopy(p.IndexFilterable),
+		IndexSearchable:      ptrBoolCopy(p.IndexSearchable),
+		IndexRangeFilters:    ptrBoolCopy(p.IndexRangeFilters),
+		Deprecated:           ptrBoolCopy(p.Deprecated),
+		DeprecationMessage:   p.DeprecationMessage,
+		Inherited:            p.Inherited,
+		DefaultValue:         p.DefaultValue,
+		Required:             ptrBoolCopy(p.Required),
+		Group:                propertyGroup(p.Group),
+		ComputeExpression:    p.ComputeExpression,
+		JSONSchemaValidation: ptrStringCopy(p.JSONSchemaValidation),
 	}
 }
 
+func propertyGroup(g *models.PropertyGroup) *models.PropertyGroup {
+	if g == nil {
+		return nil
+	}
+	return &models.PropertyGroup{Name: g.Name, Description: g.Description}
+}
+
 func ptrBoolCopy(ptrBool *bool) *bool {
 	if ptrBool != nil {
 		b := *ptrBool
@@ -77,6 +117,14 @@ func ptrBoolCopy(ptrBool *bool) *bool {
 	return nil
 }
 
+func ptrStringCopy(ptrString *string) *string {
+	if ptrString != nil {
+		s := *ptrString
+		return &s
+	}
+	return nil
+}
+
 func InvertedIndexConfig(i *models.InvertedIndexConfig) *models.InvertedIndexConfig {
 	if i == nil {
 		return nil
@@ -75,6 +75,16 @@ func validateClause(authorizedGetClass func(string) (*models.Class, error), cw *
 			className)
 	}
 
+	// aliases are replaced with the names of their properties, so that the
+	// storage only sees the latter
+	for path := cw.clause.On; path.Child != nil; path = path.Child {
+		if outer, err := authorizedGetClass(path.Class.String()); err == nil && outer != nil {
+			resolvePathAlias(outer, path)
+		}
+	}
+	resolvePathAlias(class, cw.clause.On.GetInnerMost())
+	propName = cw.getPropertyName()
+
 	propNameTyped := string(propName)
 	lengthPropName, isPropLengthFilter := schema.IsPropertyLength(propNameTyped, 0)
 	if isPropLengthFilter {
@@ -171,6 +181,19 @@ func IsInternalProperty(propName schema.PropertyName) bool {
 	}
 }
 
+// resolvePathAlias replaces the property of path with the property of class
+// it is an alias of, including the property of length filters
+func resolvePathAlias(class *models.Class, path *Path) {
+	name := string(path.Property)
+	if lengthProp, ok := schema.IsPropertyLength(name, 0); ok {
+		if resolved := schema.ResolvePropertyAlias(class, lengthProp); resolved != lengthProp {
+			path.Property = schema.PropertyName(fmt.Sprintf("len(%s)", resolved))
+		}
+		return
+	}
+	path.Property = schema.PropertyName(schema.ResolvePropertyAlias(class, name))
+}
+
 func validateInternalPropertyClause(propName schema.PropertyName, cw *clauseWrapper) error {
 	switch propName {
 	case InternalPropBackwardsCompatID, InternalPropID:
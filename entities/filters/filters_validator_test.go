@@ -320,3 +320,59 @@ func TestClauseWrapper(t *testing.T) {
 		})
 	}
 }
+
+func TestValidatePropertyAliases(t *testing.T) {
+	f := &fakeFinder{}
+	f.On("ReadOnlyClass", "Car").Return(&models.Class{
+		Class: "Car",
+		Properties: []*models.Property{
+			{Name: "horsepower", DataType: []string{"int"}, Aliases: []string{"hp"}},
+			{Name: "maker", DataType: []string{"Manufacturer"}, Aliases: []string{"brand"}},
+		},
+	})
+	f.On("ReadOnlyClass", "Manufacturer").Return(&models.Class{
+		Class: "Manufacturer",
+		Properties: []*models.Property{
+			{Name: "name", DataType: []string{"text"}, Aliases: []string{"title"}},
+		},
+	})
+
+	tests := []struct {
+		name     string
+		on       *Path
+		value    *Value
+		expected *Path
+	}{
+		{
+			name:     "alias",
+			on:       &Path{Class: "Car", Property: "hp"},
+			value:    &Value{Value: 100, Type: schema.DataTypeInt},
+			expected: &Path{Class: "Car", Property: "horsepower"},
+		},
+		{
+			name:     "property length of alias",
+			on:       &Path{Class: "Car", Property: "len(hp)"},
+			value:    &Value{Value: 3, Type: schema.DataTypeInt},
+			expected: &Path{Class: "Car", Property: "len(horsepower)"},
+		},
+		{
+			name: "aliases in reference path",
+			on: &Path{
+				Class: "Car", Property: "brand",
+				Child: &Path{Class: "Manufacturer", Property: "title"},
+			},
+			value: &Value{Value: "Opel", Type: schema.DataTypeText},
+			expected: &Path{
+				Class: "Car", Property: "maker",
+				Child: &Path{Class: "Manufacturer", Property: "name"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cl := &Clause{Operator: OperatorEqual, Value: tt.value, On: tt.on}
+			require.Nil(t, ValidateFilters(f.ReadOnlyClass, &LocalFilter{Root: cl}))
+			assert.Equal(t, tt.expected, cl.On)
+		})
+	}
+}
@@ -60,6 +60,10 @@ func validateSortClause(getClass func(string) *models.Class, className schema.Cl
 			// handle internal properties
 			return nil
 		}
+		// the path is shared with the caller, so that aliases are replaced
+		// with the names of their properties before the sort is applied
+		path[0] = schema.ResolvePropertyAlias(class, path[0])
+		propName = schema.PropertyName(path[0])
 
 		prop, err := schema.GetPropertyByName(class, string(propName))
 		if err != nil {
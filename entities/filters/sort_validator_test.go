@@ -83,3 +83,18 @@ func TestSortValidation(t *testing.T) {
 		})
 	}
 }
+
+func TestSortValidationResolvesAliases(t *testing.T) {
+	sch := &schema.Schema{Objects: &models.Schema{
+		Classes: []*models.Class{{
+			Class: "Car",
+			Properties: []*models.Property{
+				{Name: "horsepower", DataType: []string{"int"}, Aliases: []string{"hp"}},
+			},
+		}},
+	}}
+
+	sort := []Sort{{Path: []string{"hp"}, Order: "asc"}}
+	require.Nil(t, ValidateSort(sch.GetClass, schema.ClassName("Car"), sort))
+	require.Equal(t, []string{"horsepower"}, sort[0].Path)
+}
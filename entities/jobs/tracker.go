@@ -0,0 +1,128 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package jobs
+
+import (
+	"sort"
+	"sync"
+	"time"
+)
+
+const (
+	// DefaultRetention is how long a Tracker keeps finished jobs
+	DefaultRetention = 24 * time.Hour
+	// DefaultMaxFinished is the number of finished jobs a Tracker keeps at
+	// most, the oldest ones are evicted first
+	DefaultMaxFinished = 1000
+)
+
+// Tracker keeps track of the background jobs of type T started on this node,
+// e.g. migrations and backfills, so that their progress can be polled.
+// Running jobs are kept until they are finished, finished jobs are evicted
+// after the retention period or once more than maxFinished jobs are
+// finished. Jobs are not shared with other nodes and don't survive restarts.
+type Tracker[T any] struct {
+	sync.Mutex
+	jobs        map[string]*trackedJob[T]
+	retention   time.Duration
+	maxFinished int
+	now         func() time.Time
+}
+
+type trackedJob[T any] struct {
+	job        T
+	finishedAt time.Time
+}
+
+// NewTracker returns a tracker with DefaultRetention and DefaultMaxFinished
+func NewTracker[T any]() *Tracker[T] {
+	return NewTrackerWithRetention[T](DefaultRetention, DefaultMaxFinished)
+}
+
+// NewTrackerWithRetention returns a tracker keeping up to maxFinished
+// finished jobs for retention
+func NewTrackerWithRetention[T any](retention time.Duration, maxFinished int) *Tracker[T] {
+	return &Tracker[T]{
+		jobs:        map[string]*trackedJob[T]{},
+		retention:   retention,
+		maxFinished: maxFinished,
+		now:         time.Now,
+	}
+}
+
+// Add tracks the running job id
+func (t *Tracker[T]) Add(id string, job T) {
+	t.Lock()
+	defer t.Unlock()
+	t.evict()
+	t.jobs[id] = &trackedJob[T]{job: job}
+}
+
+// Update calls fn with job id, if it is tracked
+func (t *Tracker[T]) Update(id string, fn func(job *T)) {
+	t.Lock()
+	defer t.Unlock()
+	if tracked, ok := t.jobs[id]; ok {
+		fn(&tracked.job)
+	}
+}
+
+// Finish calls fn with job id, if it is tracked, and marks it as finished
+func (t *Tracker[T]) Finish(id string, fn func(job *T)) {
+	t.Lock()
+	defer t.Unlock()
+	tracked, ok := t.jobs[id]
+	if !ok {
+		return
+	}
+	fn(&tracked.job)
+	tracked.finishedAt = t.now()
+	t.evict()
+}
+
+// Get returns a copy of job id
+func (t *Tracker[T]) Get(id string) (T, bool) {
+	t.Lock()
+	defer t.Unlock()
+	tracked, ok := t.jobs[id]
+	if !ok {
+		var zero T
+		return zero, false
+	}
+	return tracked.job, true
+}
+
+// evict removes the jobs finished before the retention period and the oldest
+// finished jobs beyond maxFinished. The caller must hold the lock.
+func (t *Tracker[T]) evict() {
+	cutoff := t.now().Add(-t.retention)
+	var finished []string
+	for id, tracked := range t.jobs {
+		if tracked.finishedAt.IsZero() {
+			continue
+		}
+		if tracked.finishedAt.Before(cutoff) {
+			delete(t.jobs, id)
+			continue
+		}
+		finished = append(finished, id)
+	}
+	if len(finished) <= t.maxFinished {
+		return
+	}
+	sort.Slice(finished, func(i, j int) bool {
+		return t.jobs[finished[i]].finishedAt.Before(t.jobs[finished[j]].finishedAt)
+	})
+	for _, id := range finished[:len(finished)-t.maxFinished] {
+		delete(t.jobs, id)
+	}
+}
@@ -0,0 +1,79 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package jobs
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testJob struct {
+	Done  bool
+	Count int
+}
+
+func TestTracker(t *testing.T) {
+	now := time.Unix(1000, 0)
+	tracker := NewTrackerWithRetention[testJob](time.Hour, 2)
+	tracker.now = func() time.Time { return now }
+
+	tracker.Add("a", testJob{})
+	tracker.Update("a", func(job *testJob) { job.Count++ })
+	tracker.Update("unknown", func(job *testJob) { t.Fatal("unknown job updated") })
+	job, ok := tracker.Get("a")
+	require.True(t, ok)
+	assert.Equal(t, testJob{Count: 1}, job)
+
+	job.Count = 10
+	job, _ = tracker.Get("a")
+	assert.Equal(t, 1, job.Count, "a copy is returned")
+
+	t.Run("finished jobs are evicted after the retention", func(t *testing.T) {
+		tracker.Finish("a", func(job *testJob) { job.Done = true })
+		job, ok := tracker.Get("a")
+		require.True(t, ok)
+		assert.True(t, job.Done)
+
+		now = now.Add(2 * time.Hour)
+		tracker.Add("b", testJob{})
+		_, ok = tracker.Get("a")
+		assert.False(t, ok)
+	})
+
+	t.Run("running jobs are kept", func(t *testing.T) {
+		now = now.Add(48 * time.Hour)
+		tracker.Add("c", testJob{})
+		_, ok := tracker.Get("b")
+		assert.True(t, ok)
+	})
+
+	t.Run("the oldest finished jobs are evicted first", func(t *testing.T) {
+		for i := 0; i < 4; i++ {
+			id := fmt.Sprintf("job-%d", i)
+			tracker.Add(id, testJob{})
+			now = now.Add(time.Second)
+			tracker.Finish(id, func(job *testJob) { job.Done = true })
+		}
+		for i, expected := range []bool{false, false, true, true} {
+			_, ok := tracker.Get(fmt.Sprintf("job-%d", i))
+			assert.Equal(t, expected, ok, i)
+		}
+		for _, id := range []string{"b", "c"} {
+			_, ok := tracker.Get(id)
+			assert.True(t, ok, id)
+		}
+	})
+}
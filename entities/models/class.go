@@ -19,6 +19,7 @@ package models
 import (
 	"context"
 	"strconv"
+	timeext "time"
 
 	"github.com/go-openapi/errors"
 	"github.com/go-openapi/strfmt"
@@ -31,30 +32,73 @@ import (
 // swagger:model Class
 type Class struct {
 
+	// Access control list of the collection. Principals listed here are allowed their actions on the collection in addition to the permissions granted by the configured authorization. Updates of the collection leave it unchanged.
+	ACL []*ACLEntry `json:"acl"`
+
+	// Free-form key-value metadata for external tooling. Keys and values follow the same rules as labels.
+	Annotations map[string]string `json:"annotations,omitempty"`
+
+	// backup config
+	BackupConfig *ClassBackupConfig `json:"backupConfig,omitempty"`
+
 	// Name of the class (a.k.a. 'collection') (required). Multiple words should be concatenated in CamelCase, e.g. `ArticleAuthor`.
 	Class string `json:"class,omitempty"`
 
+	// compaction config
+	CompactionConfig *ClassCompactionConfig `json:"compactionConfig,omitempty"`
+
 	// Description of the collection for metadata purposes.
 	Description string `json:"description,omitempty"`
 
+	// encryption config
+	EncryptionConfig *ClassEncryptionConfig `json:"encryptionConfig,omitempty"`
+
+	// Reject writes to deprecated properties instead of only logging a warning.
+	EnforceDeprecation bool `json:"enforceDeprecation,omitempty"`
+
+	// The collection and all of its objects are deleted automatically once this time has passed. Classes without it never expire.
+	// Format: date-time
+	ExpiresAt *strfmt.DateTime `json:"expiresAt,omitempty"`
+
+	// Name of the parent collection. The collection inherits all properties of its parent, including properties added to the parent later. Immutable.
+	Extends string `json:"extends,omitempty"`
+
 	// inverted index config
 	InvertedIndexConfig *InvertedIndexConfig `json:"invertedIndexConfig,omitempty"`
 
+	// Key-value metadata for external tooling, e.g. team ownership or cost allocation. Keys must match [a-z][a-z0-9./-]{0,62}, values are at most 256 bytes.
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// Maximum number of objects in the collection. Object writes beyond it are rejected. 0 means unlimited.
+	MaxObjects int64 `json:"maxObjects,omitempty"`
+
 	// Configuration specific to modules in a collection context.
 	ModuleConfig interface{} `json:"moduleConfig,omitempty"`
 
 	// multi tenancy config
 	MultiTenancyConfig *MultiTenancyConfig `json:"multiTenancyConfig,omitempty"`
 
+	// Number of objects counted against `maxObjects`. Only set in responses of `GET /v1/schema/{className}` for collections with a limit, ignored when collections are written.
+	ObjectCount int64 `json:"objectCount,omitempty"`
+
 	// Define properties of the collection.
 	Properties []*Property `json:"properties"`
 
+	// Maximum duration of a query of the collection in nanoseconds, between 1 second and 1 hour. Queries which take longer fail with a deadline exceeded error. If not set, the server wide default query timeout is used.
+	QueryTimeout *timeext.Duration `json:"queryTimeout,omitempty"`
+
+	// Reject object writes to the collection, e.g. during maintenance. Queries are not affected. Omitted in updates keeps the current mode.
+	ReadOnly *bool `json:"readOnly,omitempty"`
+
 	// replication config
 	ReplicationConfig *ReplicationConfig `json:"replicationConfig,omitempty"`
 
 	// Manage how the index should be sharded and distributed in the cluster
 	ShardingConfig interface{} `json:"shardingConfig,omitempty"`
 
+	// Reject object writes with properties which are not part of the collection instead of adding them with auto schema. They are also reported by `POST /v1/schema/{className}/validate-object`. Omitted in updates keeps the current setting.
+	StrictPropertyValidation *bool `json:"strictPropertyValidation,omitempty"`
+
 	// Configure named vectors. Either use this field or `vectorizer`, `vectorIndexType`, and `vectorIndexConfig` fields. Available from `v1.24.0`.
 	VectorConfig map[string]VectorConfig `json:"vectorConfig,omitempty"`
 
@@ -72,6 +116,26 @@ type Class struct {
 func (m *Class) Validate(formats strfmt.Registry) error {
 	var res []error
 
+	if err := m.validateACL(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateBackupConfig(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateCompactionConfig(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateEncryptionConfig(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateExpiresAt(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateInvertedIndexConfig(formats); err != nil {
 		res = append(res, err)
 	}
@@ -98,6 +162,101 @@ func (m *Class) Validate(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *Class) validateACL(formats strfmt.Registry) error {
+	if swag.IsZero(m.ACL) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.ACL); i++ {
+		if swag.IsZero(m.ACL[i]) { // not required
+			continue
+		}
+
+		if m.ACL[i] != nil {
+			if err := m.ACL[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("acl" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("acl" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *Class) validateBackupConfig(formats strfmt.Registry) error {
+	if swag.IsZero(m.BackupConfig) { // not required
+		return nil
+	}
+
+	if m.BackupConfig != nil {
+		if err := m.BackupConfig.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("backupConfig")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("backupConfig")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *Class) validateCompactionConfig(formats strfmt.Registry) error {
+	if swag.IsZero(m.CompactionConfig) { // not required
+		return nil
+	}
+
+	if m.CompactionConfig != nil {
+		if err := m.CompactionConfig.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("compactionConfig")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("compactionConfig")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *Class) validateEncryptionConfig(formats strfmt.Registry) error {
+	if swag.IsZero(m.EncryptionConfig) { // not required
+		return nil
+	}
+
+	if m.EncryptionConfig != nil {
+		if err := m.EncryptionConfig.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("encryptionConfig")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("encryptionConfig")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *Class) validateExpiresAt(formats strfmt.Registry) error {
+	if swag.IsZero(m.ExpiresAt) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("expiresAt", "body", "date-time", m.ExpiresAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (m *Class) validateInvertedIndexConfig(formats strfmt.Registry) error {
 	if swag.IsZero(m.InvertedIndexConfig) { // not required
 		return nil
@@ -211,6 +370,22 @@ func (m *Class) validateVectorConfig(formats strfmt.Registry) error {
 func (m *Class) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
 	var res []error
 
+	if err := m.contextValidateACL(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateBackupConfig(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateCompactionConfig(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateEncryptionConfig(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.contextValidateInvertedIndexConfig(ctx, formats); err != nil {
 		res = append(res, err)
 	}
@@ -237,6 +412,74 @@ func (m *Class) ContextValidate(ctx context.Context, formats strfmt.Registry) er
 	return nil
 }
 
+func (m *Class) contextValidateACL(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.ACL); i++ {
+
+		if m.ACL[i] != nil {
+			if err := m.ACL[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("acl" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("acl" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *Class) contextValidateBackupConfig(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.BackupConfig != nil {
+		if err := m.BackupConfig.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("backupConfig")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("backupConfig")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *Class) contextValidateCompactionConfig(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.CompactionConfig != nil {
+		if err := m.CompactionConfig.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("compactionConfig")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("compactionConfig")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *Class) contextValidateEncryptionConfig(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.EncryptionConfig != nil {
+		if err := m.EncryptionConfig.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("encryptionConfig")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("encryptionConfig")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *Class) contextValidateInvertedIndexConfig(ctx context.Context, formats strfmt.Registry) error {
 
 	if m.InvertedIndexConfig != nil {
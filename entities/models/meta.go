@@ -28,6 +28,9 @@ import (
 // swagger:model Meta
 type Meta struct {
 
+	// The consistency level used by requests which don't set one.
+	DefaultConsistencyLevel string `json:"defaultConsistencyLevel,omitempty"`
+
 	// Max message size for GRPC connection in bytes.
 	GrpcMaxMessageSize int64 `json:"grpcMaxMessageSize,omitempty"`
 
@@ -36,6 +36,9 @@ type MultiTenancyConfig struct {
 
 	// Whether or not multi-tenancy is enabled for this class (default: false).
 	Enabled bool `json:"enabled"`
+
+	// Maximum number of tenants of this class, takes precedence over the limit configured for all classes (default: 0, use the configured limit).
+	MaxTenants int64 `json:"maxTenants,omitempty"`
 }
 
 // Validate validates this multi tenancy config
@@ -32,12 +32,30 @@ import (
 // swagger:model Property
 type Property struct {
 
+	// Alternative names of the property, e.g. its names before it was renamed. Objects can be written, filtered and sorted using an alias, read objects contain the value under the name and each alias. Aliases may not collide with the name or aliases of another property.
+	Aliases []string `json:"aliases,omitempty"`
+
+	// Makes the property read-only, its value is computed from another property whenever that one is written, e.g. `len($.name)` or `upper($.category)`. Supported functions are len, upper, lower, first and hash.
+	ComputeExpression string `json:"computeExpression,omitempty"`
+
 	// Data type of the property (required). If it starts with a capital (for example Person), may be a reference to another type.
 	DataType []string `json:"dataType"`
 
+	// Value set on the existing objects when the property is added to a collection. Only supported for `int`, `number` and `boolean` properties. The objects are updated in the background, see the `x-weaviate-backfill-job-id` header of `POST /v1/schema/{className}/properties`.
+	DefaultValue interface{} `json:"defaultValue,omitempty"`
+
+	// Marks the property as deprecated. Writes to a deprecated property are logged as a warning, or rejected if the collection sets `enforceDeprecation`. Set to `false` explicitly to clear the flag.
+	Deprecated *bool `json:"deprecated,omitempty"`
+
+	// Optional message explaining the deprecation of the property, e.g. which property to use instead.
+	DeprecationMessage string `json:"deprecationMessage,omitempty"`
+
 	// Description of the property.
 	Description string `json:"description,omitempty"`
 
+	// group
+	Group *PropertyGroup `json:"group,omitempty"`
+
 	// Whether to include this property in the filterable, Roaring Bitmap index. If `false`, this property cannot be used in `where` filters. <br/><br/>Note: Unrelated to vectorization behavior.
 	IndexFilterable *bool `json:"indexFilterable,omitempty"`
 
@@ -50,6 +68,12 @@ type Property struct {
 	// Optional. Should this property be indexed in the inverted index. Defaults to true. Applicable only to properties of data type text and text[]. If you choose false, you will not be able to use this property in bm25 or hybrid search. This property has no affect on vectorization decisions done by modules
 	IndexSearchable *bool `json:"indexSearchable,omitempty"`
 
+	// Set on properties which the collection inherits from the collection it extends.
+	Inherited bool `json:"inherited,omitempty"`
+
+	// Optional JSON Schema document which the values of the property must match. Applies to text and blob data types, blob values are base64 decoded before they are validated. Only draft 4 is supported, `$ref` must point into the document itself and must not be recursive.
+	JSONSchemaValidation *string `json:"jsonSchemaValidation,omitempty"`
+
 	// Configuration specific to modules this Weaviate instance has installed
 	ModuleConfig interface{} `json:"moduleConfig,omitempty"`
 
@@ -59,6 +83,9 @@ type Property struct {
 	// The properties of the nested object(s). Applies to object and object[] data types.
 	NestedProperties []*NestedProperty `json:"nestedProperties,omitempty"`
 
+	// Reject object writes without a value for the property. Merges may omit it, but not set it to null. Such objects are also reported by `POST /v1/schema/{className}/validate-object`. Omitted in updates keeps the current setting, set to `false` explicitly to clear the flag.
+	Required *bool `json:"required,omitempty"`
+
 	// Determines tokenization of the property as separate words or whole field. Optional. Applies to text and text[] data types. Allowed values are `word` (default; splits on any non-alphanumerical, lowercases), `lowercase` (splits on white spaces, lowercases), `whitespace` (splits on white spaces), `field` (trims). Not supported for remaining data types
 	// Enum: [word lowercase whitespace field trigram gse kagome_kr kagome_ja]
 	Tokenization string `json:"tokenization,omitempty"`
@@ -68,6 +95,10 @@ type Property struct {
 func (m *Property) Validate(formats strfmt.Registry) error {
 	var res []error
 
+	if err := m.validateGroup(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateNestedProperties(formats); err != nil {
 		res = append(res, err)
 	}
@@ -82,6 +113,25 @@ func (m *Property) Validate(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *Property) validateGroup(formats strfmt.Registry) error {
+	if swag.IsZero(m.Group) { // not required
+		return nil
+	}
+
+	if m.Group != nil {
+		if err := m.Group.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("group")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("group")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *Property) validateNestedProperties(formats strfmt.Registry) error {
 	if swag.IsZero(m.NestedProperties) { // not required
 		return nil
@@ -172,6 +222,10 @@ func (m *Property) validateTokenization(formats strfmt.Registry) error {
 func (m *Property) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
 	var res []error
 
+	if err := m.contextValidateGroup(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.contextValidateNestedProperties(ctx, formats); err != nil {
 		res = append(res, err)
 	}
@@ -182,6 +236,22 @@ func (m *Property) ContextValidate(ctx context.Context, formats strfmt.Registry)
 	return nil
 }
 
+func (m *Property) contextValidateGroup(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.Group != nil {
+		if err := m.Group.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("group")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("group")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *Property) contextValidateNestedProperties(ctx context.Context, formats strfmt.Registry) error {
 
 	for i := 0; i < len(m.NestedProperties); i++ {
@@ -38,8 +38,14 @@ type Schema struct {
 	// Format: email
 	Maintainer strfmt.Email `json:"maintainer,omitempty"`
 
+	// Maximum number of properties a class can have, 0 if unlimited.
+	MaxPropertiesPerClass int64 `json:"maxPropertiesPerClass,omitempty"`
+
 	// Name of the schema.
 	Name string `json:"name,omitempty"`
+
+	// Number of schema changes applied on the node serving the request. It increases with every change to classes, properties, shards and tenants.
+	SchemaVersion uint64 `json:"schemaVersion,omitempty"`
 }
 
 // Validate validates this schema
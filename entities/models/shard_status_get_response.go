@@ -19,6 +19,7 @@ package models
 import (
 	"context"
 
+	"github.com/go-openapi/errors"
 	"github.com/go-openapi/strfmt"
 	"github.com/go-openapi/swag"
 )
@@ -28,6 +29,9 @@ import (
 // swagger:model ShardStatusGetResponse
 type ShardStatusGetResponse struct {
 
+	// compaction stats
+	CompactionStats *CompactionStats `json:"compactionStats,omitempty"`
+
 	// Name of the shard
 	Name string `json:"name,omitempty"`
 
@@ -40,11 +44,64 @@ type ShardStatusGetResponse struct {
 
 // Validate validates this shard status get response
 func (m *ShardStatusGetResponse) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateCompactionStats(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *ShardStatusGetResponse) validateCompactionStats(formats strfmt.Registry) error {
+	if swag.IsZero(m.CompactionStats) { // not required
+		return nil
+	}
+
+	if m.CompactionStats != nil {
+		if err := m.CompactionStats.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("compactionStats")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("compactionStats")
+			}
+			return err
+		}
+	}
+
 	return nil
 }
 
-// ContextValidate validates this shard status get response based on context it is used
+// ContextValidate validate this shard status get response based on the context it is used
 func (m *ShardStatusGetResponse) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateCompactionStats(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *ShardStatusGetResponse) contextValidateCompactionStats(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.CompactionStats != nil {
+		if err := m.CompactionStats.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("compactionStats")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("compactionStats")
+			}
+			return err
+		}
+	}
+
 	return nil
 }
 
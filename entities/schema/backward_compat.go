@@ -50,6 +50,19 @@ func GetPropertyByName(c *models.Class, propName string) (*models.Property, erro
 	return nil, fmt.Errorf(ErrorNoSuchProperty, propName, c.Class)
 }
 
+// ResolvePropertyAlias returns the name of the property of c which has the
+// alias propName, or propName itself if no property has it
+func ResolvePropertyAlias(c *models.Class, propName string) string {
+	for _, prop := range c.Properties {
+		for _, alias := range prop.Aliases {
+			if alias == propName {
+				return prop.Name
+			}
+		}
+	}
+	return propName
+}
+
 // GetPropertyDataType checks whether the given string is a valid data type
 func GetPropertyDataType(class *models.Class, propertyName string) (*DataType, error) {
 	// Get the class-property
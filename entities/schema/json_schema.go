@@ -0,0 +1,135 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/go-openapi/spec"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/validate"
+)
+
+// knownJSONSchemaVersions are the accepted values of $schema, without scheme
+// and trailing '#'. Only draft-04 is implemented by the validator.
+var knownJSONSchemaVersions = map[string]struct{}{
+	"json-schema.org/draft-04/schema": {},
+}
+
+// ParseJSONSchema parses the JSON Schema document of a property's
+// jsonSchemaValidation setting. Documents declaring an unknown $schema
+// version, an id or a $ref outside of the document are rejected. The
+// returned schema has all references expanded, so validating against it
+// never resolves or modifies anything.
+func ParseJSONSchema(doc string) (*spec.Schema, error) {
+	var raw map[string]interface{}
+	if err := json.Unmarshal([]byte(doc), &raw); err != nil {
+		return nil, fmt.Errorf("not a JSON object: %w", err)
+	}
+
+	s := &spec.Schema{}
+	if err := json.Unmarshal([]byte(doc), s); err != nil {
+		return nil, fmt.Errorf("invalid JSON schema: %w", err)
+	}
+	if version := string(s.Schema); version != "" {
+		normalized := strings.TrimSuffix(version, "#")
+		normalized = strings.TrimPrefix(strings.TrimPrefix(normalized, "https://"), "http://")
+		if _, ok := knownJSONSchemaVersions[normalized]; !ok {
+			return nil, fmt.Errorf("unsupported JSON schema version %q, only draft-04 is supported", version)
+		}
+	}
+
+	err := walkJSONSchema(s, func(sub *spec.Schema) error {
+		if sub.ID != "" {
+			return fmt.Errorf("id %q is not supported", sub.ID)
+		}
+		if ref := sub.Ref.String(); ref != "" && !sub.Ref.HasFragmentOnly {
+			return fmt.Errorf("$ref %q is not supported, only references within the document are", ref)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := spec.ExpandSchema(s, s, nil); err != nil {
+		return nil, fmt.Errorf("invalid $ref: %w", err)
+	}
+	// recursive references are left as they are by the expansion
+	err = walkJSONSchema(s, func(sub *spec.Schema) error {
+		if ref := sub.Ref.String(); ref != "" {
+			return fmt.Errorf("recursive $ref %q is not supported", ref)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// walkJSONSchema calls fn for s and all its subschemas
+func walkJSONSchema(s *spec.Schema, fn func(*spec.Schema) error) error {
+	if s == nil {
+		return nil
+	}
+	if err := fn(s); err != nil {
+		return err
+	}
+
+	var subs []*spec.Schema
+	if s.Items != nil {
+		subs = append(subs, s.Items.Schema)
+		for i := range s.Items.Schemas {
+			subs = append(subs, &s.Items.Schemas[i])
+		}
+	}
+	for _, list := range [][]spec.Schema{s.AllOf, s.OneOf, s.AnyOf} {
+		for i := range list {
+			subs = append(subs, &list[i])
+		}
+	}
+	subs = append(subs, s.Not)
+	if s.AdditionalProperties != nil {
+		subs = append(subs, s.AdditionalProperties.Schema)
+	}
+	if s.AdditionalItems != nil {
+		subs = append(subs, s.AdditionalItems.Schema)
+	}
+	for _, props := range []map[string]spec.Schema{s.Properties, s.PatternProperties, s.Definitions} {
+		for name := range props {
+			sub := props[name]
+			subs = append(subs, &sub)
+		}
+	}
+	for name := range s.Dependencies {
+		subs = append(subs, s.Dependencies[name].Schema)
+	}
+
+	for _, sub := range subs {
+		if err := walkJSONSchema(sub, fn); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ValidateJSONSchemaValue checks that value is a JSON document matching s,
+// a schema returned by ParseJSONSchema
+func ValidateJSONSchemaValue(s *spec.Schema, value []byte) error {
+	var data interface{}
+	if err := json.Unmarshal(value, &data); err != nil {
+		return fmt.Errorf("not valid JSON: %w", err)
+	}
+	return validate.AgainstSchema(s, data, strfmt.Default)
+}
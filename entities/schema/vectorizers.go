@@ -0,0 +1,49 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"sort"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// vectorizerNone is the vectorizer of vectors which are only set by clients,
+// it matches config.VectorizerModuleNone
+const vectorizerNone = "none"
+
+// VectorizedTargets returns the names of the vectors of class which are set
+// by a vectorizer module, ordered by name. The legacy vector of classes
+// without named vectors is returned as "".
+func VectorizedTargets(class *models.Class) []string {
+	if len(class.VectorConfig) == 0 {
+		if class.Vectorizer == "" || class.Vectorizer == vectorizerNone {
+			return nil
+		}
+		return []string{""}
+	}
+
+	var targets []string
+	for name, cfg := range class.VectorConfig {
+		vectorizers, ok := cfg.Vectorizer.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		for vectorizer := range vectorizers {
+			if vectorizer != vectorizerNone {
+				targets = append(targets, name)
+			}
+		}
+	}
+	sort.Strings(targets)
+	return targets
+}
@@ -0,0 +1,36 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+func TestVectorizedTargets(t *testing.T) {
+	vectorizer := func(name string) interface{} {
+		return map[string]interface{}{name: map[string]interface{}{}}
+	}
+
+	assert.Nil(t, VectorizedTargets(&models.Class{}))
+	assert.Nil(t, VectorizedTargets(&models.Class{Vectorizer: "none"}))
+	assert.Equal(t, []string{""}, VectorizedTargets(&models.Class{Vectorizer: "text2vec-openai"}))
+	assert.Equal(t, []string{"description", "title"}, VectorizedTargets(&models.Class{
+		VectorConfig: map[string]models.VectorConfig{
+			"title":       {Vectorizer: vectorizer("text2vec-openai")},
+			"image":       {Vectorizer: vectorizer("none")},
+			"description": {Vectorizer: vectorizer("text2vec-cohere")},
+		},
+	}))
+}
@@ -0,0 +1,50 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package versioned
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// ReadYourWritesToken identifies the schema state right after a schema
+// change. It wraps the RAFT log index of the change, a node which has applied
+// that index serves reads which see the change.
+type ReadYourWritesToken uint64
+
+func (t ReadYourWritesToken) String() string {
+	return strconv.FormatUint(uint64(t), 10)
+}
+
+// ParseReadYourWritesToken parses a token formatted by String
+func ParseReadYourWritesToken(s string) (ReadYourWritesToken, error) {
+	v, err := strconv.ParseUint(s, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid consistency token %q", s)
+	}
+	return ReadYourWritesToken(v), nil
+}
+
+// AddClassResult is the result of adding a class to the schema
+type AddClassResult struct {
+	// Class is the class as it was added, including its defaults
+	Class *models.Class
+	// Version is the schema version of the change
+	Version uint64
+}
+
+// Token returns the token to read the schema including the added class
+func (r AddClassResult) Token() ReadYourWritesToken {
+	return ReadYourWritesToken(r.Version)
+}
@@ -0,0 +1,268 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/grpc/interceptors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/balancer"
+	"google.golang.org/grpc/balancer/base"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/serviceconfig"
+)
+
+const (
+	// ShardAwareBalancerName is the name the shard aware balancer is
+	// registered with
+	ShardAwareBalancerName = "weaviate_shard_aware"
+
+	// CollectionMetadataKey is the metadata key of the collection a call is
+	// routed by. It is only read by the shard aware balancer, the server
+	// ignores it.
+	CollectionMetadataKey = "x-weaviate-collection"
+
+	// ShardOwnersRefreshInterval is how long a CachedShardResolver uses the
+	// shard owners before it fetches them again
+	ShardOwnersRefreshInterval = 30 * time.Second
+)
+
+func init() {
+	balancer.Register(shardAwareBuilder{})
+}
+
+// ShardResolver returns the address of the node storing the shard of tenant
+// of collection. It returns false if the owner is unknown, the call is then
+// balanced round-robin.
+type ShardResolver interface {
+	ShardOwner(collection, tenant string) (address string, ok bool)
+}
+
+// WithShard adds the collection and tenant a call is routed by to the
+// outgoing metadata of ctx. The tenant is also merged into the request by
+// the server, it must therefore match the tenant of the request, if any.
+func WithShard(ctx context.Context, collection, tenant string) context.Context {
+	return metadata.AppendToOutgoingContext(ctx,
+		CollectionMetadataKey, collection, interceptors.TenantMetadataKey, tenant)
+}
+
+// ShardAwareBalancerOption returns the dial option to balance the calls of a
+// connection with the shard aware balancer. The connection must resolve to
+// the gRPC addresses of all nodes, e.g. by a DNS name with a record per node
+// or a custom resolver. Calls with a collection and tenant in their outgoing
+// metadata, see WithShard, are sent to the owner of the shard as resolved by
+// r, all others are balanced round-robin.
+func ShardAwareBalancerOption(r ShardResolver) grpc.DialOption {
+	id := shardResolvers.add(r)
+	return grpc.WithDefaultServiceConfig(fmt.Sprintf(
+		`{"loadBalancingConfig":[{%q:{"resolver":%q}}]}`, ShardAwareBalancerName, id))
+}
+
+// shardResolvers holds the resolvers of the dial options, the config of a
+// balancer refers to them by id as it is JSON
+var shardResolvers = &resolverRegistry{resolvers: map[string]ShardResolver{}}
+
+type resolverRegistry struct {
+	sync.Mutex
+	next      int
+	resolvers map[string]ShardResolver
+}
+
+func (r *resolverRegistry) add(resolver ShardResolver) string {
+	r.Lock()
+	defer r.Unlock()
+	r.next++
+	id := strconv.Itoa(r.next)
+	r.resolvers[id] = resolver
+	return id
+}
+
+func (r *resolverRegistry) get(id string) (ShardResolver, bool) {
+	r.Lock()
+	defer r.Unlock()
+	resolver, ok := r.resolvers[id]
+	return resolver, ok
+}
+
+type shardAwareConfig struct {
+	serviceconfig.LoadBalancingConfig
+	Resolver string `json:"resolver"`
+}
+
+type shardAwareBuilder struct{}
+
+func (shardAwareBuilder) Name() string {
+	return ShardAwareBalancerName
+}
+
+func (shardAwareBuilder) Build(cc balancer.ClientConn, opts balancer.BuildOptions) balancer.Balancer {
+	b := &ShardAwareBalancer{}
+	b.Balancer = base.NewBalancerBuilder(ShardAwareBalancerName, &shardAwarePickerBuilder{b},
+		base.Config{HealthCheck: true}).Build(cc, opts)
+	return b
+}
+
+func (shardAwareBuilder) ParseConfig(js json.RawMessage) (serviceconfig.LoadBalancingConfig, error) {
+	var cfg shardAwareConfig
+	if err := json.Unmarshal(js, &cfg); err != nil {
+		return nil, fmt.Errorf("parse %s config: %w", ShardAwareBalancerName, err)
+	}
+	if _, ok := shardResolvers.get(cfg.Resolver); !ok {
+		return nil, fmt.Errorf("%s config: unknown resolver %q", ShardAwareBalancerName, cfg.Resolver)
+	}
+	return &cfg, nil
+}
+
+// ShardAwareBalancer balances calls by the shard they target, see
+// ShardAwareBalancerOption. It keeps a sub-connection to every resolved
+// address like round-robin balancing does.
+type ShardAwareBalancer struct {
+	balancer.Balancer
+	// resolver is only accessed by the balancer methods, which gRPC calls
+	// one at a time
+	resolver ShardResolver
+}
+
+func (b *ShardAwareBalancer) UpdateClientConnState(s balancer.ClientConnState) error {
+	if cfg, ok := s.BalancerConfig.(*shardAwareConfig); ok {
+		b.resolver, _ = shardResolvers.get(cfg.Resolver)
+	}
+	return b.Balancer.UpdateClientConnState(s)
+}
+
+type shardAwarePickerBuilder struct {
+	balancer *ShardAwareBalancer
+}
+
+func (pb *shardAwarePickerBuilder) Build(info base.PickerBuildInfo) balancer.Picker {
+	if len(info.ReadySCs) == 0 {
+		return base.NewErrPicker(balancer.ErrNoSubConnAvailable)
+	}
+
+	p := &shardAwarePicker{
+		resolver: pb.balancer.resolver,
+		byAddr:   make(map[string]balancer.SubConn, len(info.ReadySCs)),
+		subConns: make([]balancer.SubConn, 0, len(info.ReadySCs)),
+	}
+	for sc, sci := range info.ReadySCs {
+		p.byAddr[sci.Address.Addr] = sc
+		p.subConns = append(p.subConns, sc)
+	}
+	// like round-robin, start at a random sub-connection so that not all
+	// clients send their first call to the same node
+	p.next.Store(uint32(rand.Intn(len(p.subConns))))
+	return p
+}
+
+type shardAwarePicker struct {
+	resolver ShardResolver
+	byAddr   map[string]balancer.SubConn
+	subConns []balancer.SubConn
+	next     atomic.Uint32
+}
+
+func (p *shardAwarePicker) Pick(info balancer.PickInfo) (balancer.PickResult, error) {
+	if sc, ok := p.shardOwner(info.Ctx); ok {
+		return balancer.PickResult{SubConn: sc}, nil
+	}
+	next := p.next.Add(1)
+	return balancer.PickResult{SubConn: p.subConns[next%uint32(len(p.subConns))]}, nil
+}
+
+func (p *shardAwarePicker) shardOwner(ctx context.Context) (balancer.SubConn, bool) {
+	if p.resolver == nil {
+		return nil, false
+	}
+	md, _ := metadata.FromOutgoingContext(ctx)
+	collection, tenant := md.Get(CollectionMetadataKey), md.Get(interceptors.TenantMetadataKey)
+	if len(collection) == 0 || len(tenant) == 0 {
+		return nil, false
+	}
+	addr, ok := p.resolver.ShardOwner(collection[0], tenant[0])
+	if !ok {
+		return nil, false
+	}
+	// the owner is not connected (yet), any node can forward the call
+	sc, ok := p.byAddr[addr]
+	return sc, ok
+}
+
+// ShardOwners maps collections to their tenants and the addresses of the
+// nodes storing them
+type ShardOwners map[string]map[string]string
+
+// CachedShardResolver resolves shard owners from a copy of the sharding
+// state, which is fetched again in the background once it is older than
+// ShardOwnersRefreshInterval. Shards are unknown until the first fetch
+// succeeded, see Refresh to wait for it.
+type CachedShardResolver struct {
+	fetch  func(ctx context.Context) (ShardOwners, error)
+	logger logrus.FieldLogger
+
+	mu         sync.RWMutex
+	owners     ShardOwners
+	fetchedAt  time.Time
+	refreshing atomic.Bool
+}
+
+// NewCachedShardResolver returns a resolver fetching the shard owners with
+// fetch, e.g. from the nodes status of the REST API. Failed fetches are
+// logged to logger and retried on the next lookup.
+func NewCachedShardResolver(fetch func(ctx context.Context) (ShardOwners, error),
+	logger logrus.FieldLogger,
+) *CachedShardResolver {
+	return &CachedShardResolver{fetch: fetch, logger: logger}
+}
+
+// ShardOwner returns the cached owner of the shard of tenant of collection.
+// It starts a fetch in the background if the copy is too old.
+func (r *CachedShardResolver) ShardOwner(collection, tenant string) (string, bool) {
+	r.mu.RLock()
+	addr, ok := r.owners[collection][tenant]
+	stale := time.Since(r.fetchedAt) > ShardOwnersRefreshInterval
+	r.mu.RUnlock()
+
+	if stale && r.refreshing.CompareAndSwap(false, true) {
+		enterrors.GoWrapper(func() {
+			defer r.refreshing.Store(false)
+			ctx, cancel := context.WithTimeout(context.Background(), ShardOwnersRefreshInterval)
+			defer cancel()
+			if err := r.Refresh(ctx); err != nil {
+				r.logger.WithError(err).Warn("refresh shard owners")
+			}
+		}, r.logger)
+	}
+	return addr, ok
+}
+
+// Refresh fetches the shard owners and replaces the cached copy
+func (r *CachedShardResolver) Refresh(ctx context.Context) error {
+	owners, err := r.fetch(ctx)
+	if err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.owners, r.fetchedAt = owners, time.Now()
+	return nil
+}
@@ -0,0 +1,153 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package client
+
+import (
+	"context"
+	"errors"
+	"net"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/resolver"
+	"google.golang.org/grpc/resolver/manual"
+)
+
+type staticResolver map[string]string
+
+func (r staticResolver) ShardOwner(collection, tenant string) (string, bool) {
+	addr, ok := r[collection+"/"+tenant]
+	return addr, ok
+}
+
+func TestShardAwareBalancer(t *testing.T) {
+	var (
+		mu   sync.Mutex
+		hits = map[string]int{}
+	)
+	startServer := func(t *testing.T) string {
+		lis, err := net.Listen("tcp", "127.0.0.1:0")
+		require.Nil(t, err)
+		addr := lis.Addr().String()
+		srv := grpc.NewServer(grpc.UnaryInterceptor(func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+			mu.Lock()
+			hits[addr]++
+			mu.Unlock()
+			return handler(ctx, req)
+		}))
+		healthpb.RegisterHealthServer(srv, health.NewServer())
+		go srv.Serve(lis)
+		t.Cleanup(srv.Stop)
+		return addr
+	}
+	addr1, addr2 := startServer(t), startServer(t)
+	reset := func() map[string]int {
+		mu.Lock()
+		defer mu.Unlock()
+		last := hits
+		hits = map[string]int{}
+		return last
+	}
+
+	r := manual.NewBuilderWithScheme("shardaware")
+	r.InitialState(resolver.State{Addresses: []resolver.Address{{Addr: addr1}, {Addr: addr2}}})
+	conn, err := grpc.NewClient(r.Scheme()+":///weaviate", grpc.WithResolvers(r),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		ShardAwareBalancerOption(staticResolver{"C/t1": addr1, "C/t2": addr2, "C/gone": "127.0.0.1:1"}))
+	require.Nil(t, err)
+	defer conn.Close()
+	client := healthpb.NewHealthClient(conn)
+
+	call := func(ctx context.Context) {
+		_, err := client.Check(ctx, &healthpb.HealthCheckRequest{}, grpc.WaitForReady(true))
+		require.Nil(t, err)
+	}
+	// sub-connections become ready one after another, wait for both
+	require.Eventually(t, func() bool {
+		call(context.Background())
+		call(context.Background())
+		return len(reset()) == 2
+	}, 5*time.Second, 10*time.Millisecond)
+
+	t.Run("calls are sent to the shard owner", func(t *testing.T) {
+		for i := 0; i < 10; i++ {
+			call(WithShard(context.Background(), "C", "t1"))
+			call(WithShard(context.Background(), "C", "t2"))
+		}
+		assert.Equal(t, map[string]int{addr1: 10, addr2: 10}, reset())
+	})
+
+	t.Run("round-robin without owner", func(t *testing.T) {
+		for _, ctx := range []context.Context{
+			context.Background(),
+			WithShard(context.Background(), "C", "unknown"),
+			WithShard(context.Background(), "C", "gone"),
+		} {
+			for i := 0; i < 10; i++ {
+				call(ctx)
+			}
+			assert.Equal(t, map[string]int{addr1: 5, addr2: 5}, reset())
+		}
+	})
+}
+
+func TestCachedShardResolver(t *testing.T) {
+	var (
+		fetches      atomic.Int32
+		fail         atomic.Bool
+		logger, hook = test.NewNullLogger()
+	)
+	r := NewCachedShardResolver(func(ctx context.Context) (ShardOwners, error) {
+		fetches.Add(1)
+		if fail.Load() {
+			return nil, errors.New("node unreachable")
+		}
+		return ShardOwners{"C": {"t1": "node1:50051"}}, nil
+	}, logger)
+
+	// unknown until fetched in the background
+	_, ok := r.ShardOwner("C", "t1")
+	assert.False(t, ok)
+	require.Eventually(t, func() bool {
+		_, ok := r.ShardOwner("C", "t1")
+		return ok
+	}, 5*time.Second, time.Millisecond)
+
+	addr, ok := r.ShardOwner("C", "t1")
+	assert.True(t, ok)
+	assert.Equal(t, "node1:50051", addr)
+	_, ok = r.ShardOwner("C", "t2")
+	assert.False(t, ok)
+	assert.Equal(t, int32(1), fetches.Load(), "fresh copy is not fetched again")
+
+	// a stale copy is still used while it is fetched again
+	fail.Store(true)
+	r.mu.Lock()
+	r.fetchedAt = time.Now().Add(-ShardOwnersRefreshInterval - time.Second)
+	r.mu.Unlock()
+	_, ok = r.ShardOwner("C", "t1")
+	assert.True(t, ok)
+	require.Eventually(t, func() bool {
+		return hook.LastEntry() != nil && !r.refreshing.Load()
+	}, 5*time.Second, time.Millisecond)
+	assert.Equal(t, int32(2), fetches.Load())
+	assert.Equal(t, "refresh shard owners", hook.LastEntry().Message)
+}
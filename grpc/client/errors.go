@@ -0,0 +1,47 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Package client contains helpers for clients of the Weaviate gRPC API
+package client
+
+import (
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// FieldViolation is the validation failure of a single field of a class
+type FieldViolation struct {
+	// Field is the path of the field within the class, e.g. "vectorizer" or
+	// "properties.title.dataType"
+	Field       string
+	Description string
+}
+
+// ParseSchemaValidationError returns the field violations of err, as returned
+// by requests with a class or properties which fail schema validation. It
+// returns false if err does not carry them.
+func ParseSchemaValidationError(err error) ([]FieldViolation, bool) {
+	st, ok := status.FromError(err)
+	if !ok || st.Code() != codes.InvalidArgument {
+		return nil, false
+	}
+	for _, detail := range st.Details() {
+		if br, ok := detail.(*errdetails.BadRequest); ok {
+			violations := make([]FieldViolation, len(br.FieldViolations))
+			for i, v := range br.FieldViolations {
+				violations[i] = FieldViolation{Field: v.Field, Description: v.Description}
+			}
+			return violations, true
+		}
+	}
+	return nil, false
+}
@@ -0,0 +1,64 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package client
+
+import (
+	"fmt"
+
+	pb "github.com/weaviate/weaviate/grpc/generated/protocol/v1"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// cursorField is the field of list requests which selects the page, it is
+// set to the next_cursor of the previous page
+const cursorField = "cursor"
+
+// PaginateAll fetches all pages of a list RPC, starting with initialReq, and
+// returns their items in order. The requests of the following pages are
+// copies of initialReq with the cursor field set, initialReq is not modified.
+//
+//	tenants, err := client.PaginateAll(&pb.TenantsGetRequest{Collection: "C", PageSize: &size},
+//		func(req *pb.TenantsGetRequest) ([]*pb.Tenant, *pb.PageInfo, error) {
+//			reply, err := c.TenantsGet(ctx, req)
+//			if err != nil {
+//				return nil, nil, err
+//			}
+//			return reply.Tenants, reply.PageInfo, nil
+//		})
+func PaginateAll[R proto.Message, T any](initialReq R, fetch func(R) ([]T, *pb.PageInfo, error)) ([]T, error) {
+	field := initialReq.ProtoReflect().Descriptor().Fields().ByName(cursorField)
+	if field == nil || field.Kind() != protoreflect.StringKind {
+		return nil, fmt.Errorf("%s has no string field %q", initialReq.ProtoReflect().Descriptor().FullName(), cursorField)
+	}
+
+	var all []T
+	req := initialReq
+	for {
+		items, page, err := fetch(req)
+		if err != nil {
+			return all, err
+		}
+		all = append(all, items...)
+		if page == nil || !page.HasNextPage {
+			return all, nil
+		}
+
+		// a server repeating the cursor would be fetched forever
+		cursor := req.ProtoReflect().Get(field).String()
+		if page.NextCursor == "" || page.NextCursor == cursor {
+			return all, fmt.Errorf("invalid next cursor %q after cursor %q", page.NextCursor, cursor)
+		}
+		req = proto.Clone(initialReq).(R)
+		req.ProtoReflect().Set(field, protoreflect.ValueOfString(page.NextCursor))
+	}
+}
@@ -0,0 +1,85 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package client
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	pb "github.com/weaviate/weaviate/grpc/generated/protocol/v1"
+)
+
+func TestPaginateAll(t *testing.T) {
+	names := []string{"a", "b", "c", "d", "e"}
+	size := int32(2)
+	initial := &pb.TenantsGetRequest{Collection: "C", PageSize: &size}
+
+	var cursors []string
+	fetch := func(req *pb.TenantsGetRequest) ([]string, *pb.PageInfo, error) {
+		assert.Equal(t, "C", req.Collection)
+		cursors = append(cursors, req.Cursor)
+		start := 0
+		for start < len(names) && req.Cursor != "" && names[start] <= req.Cursor {
+			start++
+		}
+		end := min(start+int(*req.PageSize), len(names))
+		info := &pb.PageInfo{TotalCount: int64(len(names)), PageSize: int32(end - start)}
+		if end < len(names) {
+			info.HasNextPage = true
+			info.NextCursor = names[end-1]
+		}
+		return names[start:end], info, nil
+	}
+
+	all, err := PaginateAll(initial, fetch)
+	require.Nil(t, err)
+	assert.Equal(t, names, all)
+	assert.Equal(t, []string{"", "b", "d"}, cursors)
+	assert.Empty(t, initial.Cursor, "initial request is not modified")
+
+	t.Run("without page info", func(t *testing.T) {
+		all, err := PaginateAll(initial, func(*pb.TenantsGetRequest) ([]string, *pb.PageInfo, error) {
+			return names, nil, nil
+		})
+		require.Nil(t, err)
+		assert.Equal(t, names, all)
+	})
+
+	t.Run("fetch error", func(t *testing.T) {
+		calls := 0
+		all, err := PaginateAll(initial, func(*pb.TenantsGetRequest) ([]string, *pb.PageInfo, error) {
+			calls++
+			if calls == 2 {
+				return nil, nil, errors.New("unavailable")
+			}
+			return []string{"a"}, &pb.PageInfo{HasNextPage: true, NextCursor: "a"}, nil
+		})
+		assert.ErrorContains(t, err, "unavailable")
+		assert.Equal(t, []string{"a"}, all)
+	})
+
+	t.Run("repeated cursor", func(t *testing.T) {
+		_, err := PaginateAll(initial, func(*pb.TenantsGetRequest) ([]string, *pb.PageInfo, error) {
+			return []string{"a"}, &pb.PageInfo{HasNextPage: true, NextCursor: "a"}, nil
+		})
+		assert.ErrorContains(t, err, "invalid next cursor")
+	})
+
+	t.Run("request without cursor", func(t *testing.T) {
+		_, err := PaginateAll(&pb.TenantNames{}, func(*pb.TenantNames) ([]string, *pb.PageInfo, error) {
+			return nil, nil, nil
+		})
+		assert.ErrorContains(t, err, "has no string field")
+	})
+}
@@ -0,0 +1,30 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package client
+
+import (
+	"github.com/weaviate/weaviate/grpc/interceptors"
+	"google.golang.org/grpc/metadata"
+)
+
+// ExtractVersionFromMetadata returns the versions the server added to the
+// response header md, as received with grpc.Header. Calls which fail before
+// a response is sent carry the header in their trailer instead. It returns
+// false if any of the versions is missing.
+func ExtractVersionFromMetadata(md metadata.MD) (serverVersion, apiVersion string, ok bool) {
+	server := md.Get(interceptors.ServerVersionMetadataKey)
+	api := md.Get(interceptors.APIVersionMetadataKey)
+	if len(server) == 0 || len(api) == 0 {
+		return "", "", false
+	}
+	return server[0], api[0], true
+}
@@ -8,6 +8,8 @@ import (
 
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 )
 
 const (
@@ -17,36 +19,1086 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type BatchDeletePriority int32
+
+const (
+	BatchDeletePriority_BATCH_DELETE_PRIORITY_UNSPECIFIED BatchDeletePriority = 0
+	BatchDeletePriority_BATCH_DELETE_PRIORITY_LOW         BatchDeletePriority = 1
+	BatchDeletePriority_BATCH_DELETE_PRIORITY_NORMAL      BatchDeletePriority = 2
+	BatchDeletePriority_BATCH_DELETE_PRIORITY_HIGH        BatchDeletePriority = 3
+)
+
+// Enum value maps for BatchDeletePriority.
+var (
+	BatchDeletePriority_name = map[int32]string{
+		0: "BATCH_DELETE_PRIORITY_UNSPECIFIED",
+		1: "BATCH_DELETE_PRIORITY_LOW",
+		2: "BATCH_DELETE_PRIORITY_NORMAL",
+		3: "BATCH_DELETE_PRIORITY_HIGH",
+	}
+	BatchDeletePriority_value = map[string]int32{
+		"BATCH_DELETE_PRIORITY_UNSPECIFIED": 0,
+		"BATCH_DELETE_PRIORITY_LOW":         1,
+		"BATCH_DELETE_PRIORITY_NORMAL":      2,
+		"BATCH_DELETE_PRIORITY_HIGH":        3,
+	}
+)
+
+func (x BatchDeletePriority) Enum() *BatchDeletePriority {
+	p := new(BatchDeletePriority)
+	*p = x
+	return p
+}
+
+func (x BatchDeletePriority) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (BatchDeletePriority) Descriptor() protoreflect.EnumDescriptor {
+	return file_v1_batch_delete_proto_enumTypes[0].Descriptor()
+}
+
+func (BatchDeletePriority) Type() protoreflect.EnumType {
+	return &file_v1_batch_delete_proto_enumTypes[0]
+}
+
+func (x BatchDeletePriority) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use BatchDeletePriority.Descriptor instead.
+func (BatchDeletePriority) EnumDescriptor() ([]byte, []int) {
+	return file_v1_batch_delete_proto_rawDescGZIP(), []int{0}
+}
+
+type BatchDeleteJobStatus int32
+
+const (
+	BatchDeleteJobStatus_BATCH_DELETE_JOB_STATUS_UNSPECIFIED BatchDeleteJobStatus = 0
+	BatchDeleteJobStatus_BATCH_DELETE_JOB_STATUS_RUNNING     BatchDeleteJobStatus = 1
+	BatchDeleteJobStatus_BATCH_DELETE_JOB_STATUS_FINISHED    BatchDeleteJobStatus = 2
+	BatchDeleteJobStatus_BATCH_DELETE_JOB_STATUS_FAILED      BatchDeleteJobStatus = 3
+)
+
+// Enum value maps for BatchDeleteJobStatus.
+var (
+	BatchDeleteJobStatus_name = map[int32]string{
+		0: "BATCH_DELETE_JOB_STATUS_UNSPECIFIED",
+		1: "BATCH_DELETE_JOB_STATUS_RUNNING",
+		2: "BATCH_DELETE_JOB_STATUS_FINISHED",
+		3: "BATCH_DELETE_JOB_STATUS_FAILED",
+	}
+	BatchDeleteJobStatus_value = map[string]int32{
+		"BATCH_DELETE_JOB_STATUS_UNSPECIFIED": 0,
+		"BATCH_DELETE_JOB_STATUS_RUNNING":     1,
+		"BATCH_DELETE_JOB_STATUS_FINISHED":    2,
+		"BATCH_DELETE_JOB_STATUS_FAILED":      3,
+	}
+)
+
+func (x BatchDeleteJobStatus) Enum() *BatchDeleteJobStatus {
+	p := new(BatchDeleteJobStatus)
+	*p = x
+	return p
+}
+
+func (x BatchDeleteJobStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (BatchDeleteJobStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_v1_batch_delete_proto_enumTypes[1].Descriptor()
+}
+
+func (BatchDeleteJobStatus) Type() protoreflect.EnumType {
+	return &file_v1_batch_delete_proto_enumTypes[1]
+}
+
+func (x BatchDeleteJobStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use BatchDeleteJobStatus.Descriptor instead.
+func (BatchDeleteJobStatus) EnumDescriptor() ([]byte, []int) {
+	return file_v1_batch_delete_proto_rawDescGZIP(), []int{1}
+}
+
 type BatchDeleteRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Collection       string            `protobuf:"bytes,1,opt,name=collection,proto3" json:"collection,omitempty"`
-	Filters          *Filters          `protobuf:"bytes,2,opt,name=filters,proto3" json:"filters,omitempty"`
-	Verbose          bool              `protobuf:"varint,3,opt,name=verbose,proto3" json:"verbose,omitempty"`
-	DryRun           bool              `protobuf:"varint,4,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
-	ConsistencyLevel *ConsistencyLevel `protobuf:"varint,5,opt,name=consistency_level,json=consistencyLevel,proto3,enum=weaviate.v1.ConsistencyLevel,oneof" json:"consistency_level,omitempty"`
-	Tenant           *string           `protobuf:"bytes,6,opt,name=tenant,proto3,oneof" json:"tenant,omitempty"`
+	Collection       string            `protobuf:"bytes,1,opt,name=collection,proto3" json:"collection,omitempty"`
+	Filters          *Filters          `protobuf:"bytes,2,opt,name=filters,proto3" json:"filters,omitempty"`
+	Verbose          bool              `protobuf:"varint,3,opt,name=verbose,proto3" json:"verbose,omitempty"`
+	DryRun           bool              `protobuf:"varint,4,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	ConsistencyLevel *ConsistencyLevel `protobuf:"varint,5,opt,name=consistency_level,json=consistencyLevel,proto3,enum=weaviate.v1.ConsistencyLevel,oneof" json:"consistency_level,omitempty"`
+	// Types that are assignable to TenantSelection:
+	//
+	//	*BatchDeleteRequest_Tenant
+	//	*BatchDeleteRequest_TenantList
+	TenantSelection isBatchDeleteRequest_TenantSelection `protobuf_oneof:"tenant_selection"`
+	// objects matching the filters which were last updated after this time are
+	// not deleted, requires indexTimestamps on the collection
+	ModifiedBefore *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=modified_before,json=modifiedBefore,proto3" json:"modified_before,omitempty"`
+	// delete from every active tenant of the collection, must not be combined
+	// with tenant or tenant_list
+	AllTenants bool `protobuf:"varint,10,opt,name=all_tenants,json=allTenants,proto3" json:"all_tenants,omitempty"`
+	// echoed in BatchDeleteReply.request_id to correlate replies to requests
+	RequestId string `protobuf:"bytes,11,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	// hex encoded HMAC-SHA256 of the request without the signature, required
+	// if the server is configured with a batch delete signing secret
+	Signature string `protobuf:"bytes,12,opt,name=signature,proto3" json:"signature,omitempty"`
+	// number of matches whose ids are looked up and deleted at a time, which
+	// bounds the memory used for the ids. Defaults to 100, at most 10000.
+	ChunkSize uint32 `protobuf:"varint,13,opt,name=chunk_size,json=chunkSize,proto3" json:"chunk_size,omitempty"`
+	// retries with the same key get the reply of the first successful request
+	// instead of deleting again, for as long as the server keeps the reply
+	// (24 hours by default). Reusing a key for a different request fails with
+	// ALREADY_EXISTS.
+	IdempotencyKey string `protobuf:"bytes,14,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
+	// order in which the server runs queued batch deletes, unspecified is
+	// normal. Deletes are only queued if the server limits the concurrent
+	// deletes. High priority deletes are never queued and require the
+	// permission to update the cluster, low priority ones only use a share of
+	// the concurrent deletes.
+	Priority BatchDeletePriority `protobuf:"varint,15,opt,name=priority,proto3,enum=weaviate.v1.BatchDeletePriority" json:"priority,omitempty"`
+	// 16 byte uuids of objects which are not deleted even if they match the
+	// filters, at most 10000
+	ExcludeUuids [][]byte `protobuf:"bytes,16,rep,name=exclude_uuids,json=excludeUuids,proto3" json:"exclude_uuids,omitempty"`
+	// BatchDeleteReply.page_token of a verbose batch delete to get the next
+	// page of its objects instead of deleting, all other fields are ignored
+	PageToken string `protobuf:"bytes,17,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// HTTPS URL to POST the result to once the batch delete is done. The
+	// delete then runs in the background and the reply only has a job_id,
+	// see GetBatchDeleteJob. Hosts resolving to loopback, private or
+	// link-local addresses are rejected unless the server allows them.
+	CallbackUrl string `protobuf:"bytes,18,opt,name=callback_url,json=callbackUrl,proto3" json:"callback_url,omitempty"`
+}
+
+func (x *BatchDeleteRequest) Reset() {
+	*x = BatchDeleteRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_batch_delete_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchDeleteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchDeleteRequest) ProtoMessage() {}
+
+func (x *BatchDeleteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_batch_delete_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchDeleteRequest.ProtoReflect.Descriptor instead.
+func (*BatchDeleteRequest) Descriptor() ([]byte, []int) {
+	return file_v1_batch_delete_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *BatchDeleteRequest) GetCollection() string {
+	if x != nil {
+		return x.Collection
+	}
+	return ""
+}
+
+func (x *BatchDeleteRequest) GetFilters() *Filters {
+	if x != nil {
+		return x.Filters
+	}
+	return nil
+}
+
+func (x *BatchDeleteRequest) GetVerbose() bool {
+	if x != nil {
+		return x.Verbose
+	}
+	return false
+}
+
+func (x *BatchDeleteRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *BatchDeleteRequest) GetConsistencyLevel() ConsistencyLevel {
+	if x != nil && x.ConsistencyLevel != nil {
+		return *x.ConsistencyLevel
+	}
+	return ConsistencyLevel_CONSISTENCY_LEVEL_UNSPECIFIED
+}
+
+func (m *BatchDeleteRequest) GetTenantSelection() isBatchDeleteRequest_TenantSelection {
+	if m != nil {
+		return m.TenantSelection
+	}
+	return nil
+}
+
+func (x *BatchDeleteRequest) GetTenant() string {
+	if x, ok := x.GetTenantSelection().(*BatchDeleteRequest_Tenant); ok {
+		return x.Tenant
+	}
+	return ""
+}
+
+func (x *BatchDeleteRequest) GetTenantList() *TenantList {
+	if x, ok := x.GetTenantSelection().(*BatchDeleteRequest_TenantList); ok {
+		return x.TenantList
+	}
+	return nil
+}
+
+func (x *BatchDeleteRequest) GetModifiedBefore() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ModifiedBefore
+	}
+	return nil
+}
+
+func (x *BatchDeleteRequest) GetAllTenants() bool {
+	if x != nil {
+		return x.AllTenants
+	}
+	return false
+}
+
+func (x *BatchDeleteRequest) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *BatchDeleteRequest) GetSignature() string {
+	if x != nil {
+		return x.Signature
+	}
+	return ""
+}
+
+func (x *BatchDeleteRequest) GetChunkSize() uint32 {
+	if x != nil {
+		return x.ChunkSize
+	}
+	return 0
+}
+
+func (x *BatchDeleteRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+func (x *BatchDeleteRequest) GetPriority() BatchDeletePriority {
+	if x != nil {
+		return x.Priority
+	}
+	return BatchDeletePriority_BATCH_DELETE_PRIORITY_UNSPECIFIED
+}
+
+func (x *BatchDeleteRequest) GetExcludeUuids() [][]byte {
+	if x != nil {
+		return x.ExcludeUuids
+	}
+	return nil
+}
+
+func (x *BatchDeleteRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *BatchDeleteRequest) GetCallbackUrl() string {
+	if x != nil {
+		return x.CallbackUrl
+	}
+	return ""
+}
+
+type isBatchDeleteRequest_TenantSelection interface {
+	isBatchDeleteRequest_TenantSelection()
+}
+
+type BatchDeleteRequest_Tenant struct {
+	// delete from a single tenant
+	Tenant string `protobuf:"bytes,6,opt,name=tenant,proto3,oneof"`
+}
+
+type BatchDeleteRequest_TenantList struct {
+	// delete from each of the listed tenants, must not be empty
+	TenantList *TenantList `protobuf:"bytes,9,opt,name=tenant_list,json=tenantList,proto3,oneof"`
+}
+
+func (*BatchDeleteRequest_Tenant) isBatchDeleteRequest_TenantSelection() {}
+
+func (*BatchDeleteRequest_TenantList) isBatchDeleteRequest_TenantSelection() {}
+
+type BatchDeleteReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Deprecated: seconds truncated to float precision, use took_duration
+	//
+	// Deprecated: Marked as deprecated in v1/batch_delete.proto.
+	Took         float32              `protobuf:"fixed32,1,opt,name=took,proto3" json:"took,omitempty"`
+	Failed       int64                `protobuf:"varint,2,opt,name=failed,proto3" json:"failed,omitempty"`
+	Matches      int64                `protobuf:"varint,3,opt,name=matches,proto3" json:"matches,omitempty"`
+	Successful   int64                `protobuf:"varint,4,opt,name=successful,proto3" json:"successful,omitempty"`
+	Objects      []*BatchDeleteObject `protobuf:"bytes,5,rep,name=objects,proto3" json:"objects,omitempty"`
+	TookDuration *durationpb.Duration `protobuf:"bytes,6,opt,name=took_duration,json=tookDuration,proto3" json:"took_duration,omitempty"`
+	// matches which were not deleted because of modified_before
+	Skipped int64 `protobuf:"varint,7,opt,name=skipped,proto3" json:"skipped,omitempty"`
+	// per tenant results if the request used tenant_list or all_tenants, the
+	// totals above are summed up over all tenants
+	TenantResults []*TenantDeleteSummary `protobuf:"bytes,8,rep,name=tenant_results,json=tenantResults,proto3" json:"tenant_results,omitempty"`
+	// failed objects grouped by the kind of error, set regardless of verbose
+	ErrorBreakdown []*ErrorBucket `protobuf:"bytes,9,rep,name=error_breakdown,json=errorBreakdown,proto3" json:"error_breakdown,omitempty"`
+	// the collection, tenant and request_id of the request, tenant is empty
+	// unless the request targeted a single tenant
+	Collection string `protobuf:"bytes,10,opt,name=collection,proto3" json:"collection,omitempty"`
+	Tenant     string `protobuf:"bytes,11,opt,name=tenant,proto3" json:"tenant,omitempty"`
+	RequestId  string `protobuf:"bytes,12,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	// matches which were not deleted because of exclude_uuids
+	Excluded int64 `protobuf:"varint,13,opt,name=excluded,proto3" json:"excluded,omitempty"`
+	// set in the replies of MultiBatchDelete to the error of a sub-request
+	// which failed as a whole, the counts are zero then
+	Error string `protobuf:"bytes,14,opt,name=error,proto3" json:"error,omitempty"`
+	// set if objects is only a page of the objects of a verbose batch delete,
+	// pass it as BatchDeleteRequest.page_token to get the next page. Tokens
+	// expire 60 seconds after they were issued by default.
+	PageToken string `protobuf:"bytes,15,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// id of the background job of a batch delete with a callback_url, it is
+	// sent in the x-weaviate-job-id header of the callback
+	JobId string `protobuf:"bytes,16,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+}
+
+func (x *BatchDeleteReply) Reset() {
+	*x = BatchDeleteReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_batch_delete_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchDeleteReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchDeleteReply) ProtoMessage() {}
+
+func (x *BatchDeleteReply) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_batch_delete_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchDeleteReply.ProtoReflect.Descriptor instead.
+func (*BatchDeleteReply) Descriptor() ([]byte, []int) {
+	return file_v1_batch_delete_proto_rawDescGZIP(), []int{1}
+}
+
+// Deprecated: Marked as deprecated in v1/batch_delete.proto.
+func (x *BatchDeleteReply) GetTook() float32 {
+	if x != nil {
+		return x.Took
+	}
+	return 0
+}
+
+func (x *BatchDeleteReply) GetFailed() int64 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+func (x *BatchDeleteReply) GetMatches() int64 {
+	if x != nil {
+		return x.Matches
+	}
+	return 0
+}
+
+func (x *BatchDeleteReply) GetSuccessful() int64 {
+	if x != nil {
+		return x.Successful
+	}
+	return 0
+}
+
+func (x *BatchDeleteReply) GetObjects() []*BatchDeleteObject {
+	if x != nil {
+		return x.Objects
+	}
+	return nil
+}
+
+func (x *BatchDeleteReply) GetTookDuration() *durationpb.Duration {
+	if x != nil {
+		return x.TookDuration
+	}
+	return nil
+}
+
+func (x *BatchDeleteReply) GetSkipped() int64 {
+	if x != nil {
+		return x.Skipped
+	}
+	return 0
+}
+
+func (x *BatchDeleteReply) GetTenantResults() []*TenantDeleteSummary {
+	if x != nil {
+		return x.TenantResults
+	}
+	return nil
+}
+
+func (x *BatchDeleteReply) GetErrorBreakdown() []*ErrorBucket {
+	if x != nil {
+		return x.ErrorBreakdown
+	}
+	return nil
+}
+
+func (x *BatchDeleteReply) GetCollection() string {
+	if x != nil {
+		return x.Collection
+	}
+	return ""
+}
+
+func (x *BatchDeleteReply) GetTenant() string {
+	if x != nil {
+		return x.Tenant
+	}
+	return ""
+}
+
+func (x *BatchDeleteReply) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *BatchDeleteReply) GetExcluded() int64 {
+	if x != nil {
+		return x.Excluded
+	}
+	return 0
+}
+
+func (x *BatchDeleteReply) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *BatchDeleteReply) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *BatchDeleteReply) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+type MultiBatchDeleteRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// run one after another in the given order
+	Requests []*BatchDeleteRequest `protobuf:"bytes,1,rep,name=requests,proto3" json:"requests,omitempty"`
+	// stop after the first sub-request which fails or fails to delete an
+	// object, the replies contain the requests run so far
+	StopOnFirstError bool `protobuf:"varint,2,opt,name=stop_on_first_error,json=stopOnFirstError,proto3" json:"stop_on_first_error,omitempty"`
+}
+
+func (x *MultiBatchDeleteRequest) Reset() {
+	*x = MultiBatchDeleteRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_batch_delete_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MultiBatchDeleteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MultiBatchDeleteRequest) ProtoMessage() {}
+
+func (x *MultiBatchDeleteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_batch_delete_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MultiBatchDeleteRequest.ProtoReflect.Descriptor instead.
+func (*MultiBatchDeleteRequest) Descriptor() ([]byte, []int) {
+	return file_v1_batch_delete_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *MultiBatchDeleteRequest) GetRequests() []*BatchDeleteRequest {
+	if x != nil {
+		return x.Requests
+	}
+	return nil
+}
+
+func (x *MultiBatchDeleteRequest) GetStopOnFirstError() bool {
+	if x != nil {
+		return x.StopOnFirstError
+	}
+	return false
+}
+
+type MultiBatchDeleteReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// in the order of MultiBatchDeleteRequest.requests
+	Replies []*BatchDeleteReply `protobuf:"bytes,1,rep,name=replies,proto3" json:"replies,omitempty"`
+}
+
+func (x *MultiBatchDeleteReply) Reset() {
+	*x = MultiBatchDeleteReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_batch_delete_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MultiBatchDeleteReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MultiBatchDeleteReply) ProtoMessage() {}
+
+func (x *MultiBatchDeleteReply) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_batch_delete_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MultiBatchDeleteReply.ProtoReflect.Descriptor instead.
+func (*MultiBatchDeleteReply) Descriptor() ([]byte, []int) {
+	return file_v1_batch_delete_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *MultiBatchDeleteReply) GetReplies() []*BatchDeleteReply {
+	if x != nil {
+		return x.Replies
+	}
+	return nil
+}
+
+type ListBatchDeleteHistoryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// only batch deletes of this collection, all collections if empty
+	Collection string `protobuf:"bytes,1,opt,name=collection,proto3" json:"collection,omitempty"`
+	// only batch deletes started at or after from_time and before to_time
+	FromTime *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=from_time,json=fromTime,proto3" json:"from_time,omitempty"`
+	ToTime   *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=to_time,json=toTime,proto3" json:"to_time,omitempty"`
+	// ListBatchDeleteHistoryReply.next_page_token of the previous page
+	PageToken string `protobuf:"bytes,4,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// defaults to 100, at most 1000
+	PageSize int32 `protobuf:"varint,5,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+}
+
+func (x *ListBatchDeleteHistoryRequest) Reset() {
+	*x = ListBatchDeleteHistoryRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_batch_delete_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListBatchDeleteHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBatchDeleteHistoryRequest) ProtoMessage() {}
+
+func (x *ListBatchDeleteHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_batch_delete_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBatchDeleteHistoryRequest.ProtoReflect.Descriptor instead.
+func (*ListBatchDeleteHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_v1_batch_delete_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListBatchDeleteHistoryRequest) GetCollection() string {
+	if x != nil {
+		return x.Collection
+	}
+	return ""
+}
+
+func (x *ListBatchDeleteHistoryRequest) GetFromTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FromTime
+	}
+	return nil
+}
+
+func (x *ListBatchDeleteHistoryRequest) GetToTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ToTime
+	}
+	return nil
+}
+
+func (x *ListBatchDeleteHistoryRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListBatchDeleteHistoryRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type ListBatchDeleteHistoryReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// oldest first, the server keeps the last 10000 batch deletes
+	Entries []*BatchDeleteHistoryEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	// empty on the last page
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+}
+
+func (x *ListBatchDeleteHistoryReply) Reset() {
+	*x = ListBatchDeleteHistoryReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_batch_delete_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListBatchDeleteHistoryReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBatchDeleteHistoryReply) ProtoMessage() {}
+
+func (x *ListBatchDeleteHistoryReply) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_batch_delete_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBatchDeleteHistoryReply.ProtoReflect.Descriptor instead.
+func (*ListBatchDeleteHistoryReply) Descriptor() ([]byte, []int) {
+	return file_v1_batch_delete_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ListBatchDeleteHistoryReply) GetEntries() []*BatchDeleteHistoryEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+func (x *ListBatchDeleteHistoryReply) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type GetBatchDeleteJobRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// BatchDeleteReply.job_id, jobs are only known to the node they were
+	// started on
+	JobId string `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+}
+
+func (x *GetBatchDeleteJobRequest) Reset() {
+	*x = GetBatchDeleteJobRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_batch_delete_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetBatchDeleteJobRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBatchDeleteJobRequest) ProtoMessage() {}
+
+func (x *GetBatchDeleteJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_batch_delete_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBatchDeleteJobRequest.ProtoReflect.Descriptor instead.
+func (*GetBatchDeleteJobRequest) Descriptor() ([]byte, []int) {
+	return file_v1_batch_delete_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetBatchDeleteJobRequest) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+type GetBatchDeleteJobReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status BatchDeleteJobStatus `protobuf:"varint,1,opt,name=status,proto3,enum=weaviate.v1.BatchDeleteJobStatus" json:"status,omitempty"`
+	// the reply without its objects once the job is finished
+	Reply *BatchDeleteReply `protobuf:"bytes,2,opt,name=reply,proto3" json:"reply,omitempty"`
+	// set if the job failed
+	Error      string                 `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	StartedAt  *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	FinishedAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=finished_at,json=finishedAt,proto3" json:"finished_at,omitempty"`
+}
+
+func (x *GetBatchDeleteJobReply) Reset() {
+	*x = GetBatchDeleteJobReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_batch_delete_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetBatchDeleteJobReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBatchDeleteJobReply) ProtoMessage() {}
+
+func (x *GetBatchDeleteJobReply) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_batch_delete_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBatchDeleteJobReply.ProtoReflect.Descriptor instead.
+func (*GetBatchDeleteJobReply) Descriptor() ([]byte, []int) {
+	return file_v1_batch_delete_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetBatchDeleteJobReply) GetStatus() BatchDeleteJobStatus {
+	if x != nil {
+		return x.Status
+	}
+	return BatchDeleteJobStatus_BATCH_DELETE_JOB_STATUS_UNSPECIFIED
+}
+
+func (x *GetBatchDeleteJobReply) GetReply() *BatchDeleteReply {
+	if x != nil {
+		return x.Reply
+	}
+	return nil
+}
+
+func (x *GetBatchDeleteJobReply) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *GetBatchDeleteJobReply) GetStartedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartedAt
+	}
+	return nil
+}
+
+func (x *GetBatchDeleteJobReply) GetFinishedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FinishedAt
+	}
+	return nil
+}
+
+type BatchDeleteHistoryEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// a summary of the request without exclude_uuids. Text filter values longer
+	// than 64 bytes are replaced by "sha256:" and their hex encoded hash, array
+	// values are cut to 10 values and filters larger than 8KiB are left out.
+	// The values of the filters are removed if the server is configured to
+	// redact them.
+	Request *BatchDeleteRequest `protobuf:"bytes,1,opt,name=request,proto3" json:"request,omitempty"`
+	// the reply without its objects and with at most 100 tenant_results, or
+	// only with the error of a batch delete which failed as a whole
+	Reply *BatchDeleteReply `protobuf:"bytes,2,opt,name=reply,proto3" json:"reply,omitempty"`
+	// address of the client which sent the request
+	Peer        string                 `protobuf:"bytes,3,opt,name=peer,proto3" json:"peer,omitempty"`
+	StartedAt   *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	CompletedAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"`
+}
+
+func (x *BatchDeleteHistoryEntry) Reset() {
+	*x = BatchDeleteHistoryEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_batch_delete_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchDeleteHistoryEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchDeleteHistoryEntry) ProtoMessage() {}
+
+func (x *BatchDeleteHistoryEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_batch_delete_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchDeleteHistoryEntry.ProtoReflect.Descriptor instead.
+func (*BatchDeleteHistoryEntry) Descriptor() ([]byte, []int) {
+	return file_v1_batch_delete_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *BatchDeleteHistoryEntry) GetRequest() *BatchDeleteRequest {
+	if x != nil {
+		return x.Request
+	}
+	return nil
+}
+
+func (x *BatchDeleteHistoryEntry) GetReply() *BatchDeleteReply {
+	if x != nil {
+		return x.Reply
+	}
+	return nil
+}
+
+func (x *BatchDeleteHistoryEntry) GetPeer() string {
+	if x != nil {
+		return x.Peer
+	}
+	return ""
+}
+
+func (x *BatchDeleteHistoryEntry) GetStartedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartedAt
+	}
+	return nil
+}
+
+func (x *BatchDeleteHistoryEntry) GetCompletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CompletedAt
+	}
+	return nil
+}
+
+type ErrorBucket struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// normalized kind of error, e.g. "shard unavailable"
+	ErrorCode string `protobuf:"bytes,1,opt,name=error_code,json=errorCode,proto3" json:"error_code,omitempty"`
+	Count     int64  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	// one of the objects which failed with this error, empty if the error
+	// is not specific to an object
+	SampleUuid []byte `protobuf:"bytes,3,opt,name=sample_uuid,json=sampleUuid,proto3" json:"sample_uuid,omitempty"`
+}
+
+func (x *ErrorBucket) Reset() {
+	*x = ErrorBucket{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_batch_delete_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ErrorBucket) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ErrorBucket) ProtoMessage() {}
+
+func (x *ErrorBucket) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_batch_delete_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ErrorBucket.ProtoReflect.Descriptor instead.
+func (*ErrorBucket) Descriptor() ([]byte, []int) {
+	return file_v1_batch_delete_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ErrorBucket) GetErrorCode() string {
+	if x != nil {
+		return x.ErrorCode
+	}
+	return ""
+}
+
+func (x *ErrorBucket) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *ErrorBucket) GetSampleUuid() []byte {
+	if x != nil {
+		return x.SampleUuid
+	}
+	return nil
+}
+
+// sent as detail of the InvalidArgument status of batch deletes with filters
+// that do not match the schema of the collection
+type FilterValidationError struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// the filtered property, nested properties are separated by dots, e.g.
+	// "hasAuthor.Author.name"
+	FieldPath string `protobuf:"bytes,1,opt,name=field_path,json=fieldPath,proto3" json:"field_path,omitempty"`
+	Operator  string `protobuf:"bytes,2,opt,name=operator,proto3" json:"operator,omitempty"`
+	// the type the value needs to have, empty if the property is unknown
+	ExpectedType  string `protobuf:"bytes,3,opt,name=expected_type,json=expectedType,proto3" json:"expected_type,omitempty"`
+	ReceivedValue string `protobuf:"bytes,4,opt,name=received_value,json=receivedValue,proto3" json:"received_value,omitempty"`
 }
 
-func (x *BatchDeleteRequest) Reset() {
-	*x = BatchDeleteRequest{}
+func (x *FilterValidationError) Reset() {
+	*x = FilterValidationError{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v1_batch_delete_proto_msgTypes[0]
+		mi := &file_v1_batch_delete_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *BatchDeleteRequest) String() string {
+func (x *FilterValidationError) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BatchDeleteRequest) ProtoMessage() {}
+func (*FilterValidationError) ProtoMessage() {}
 
-func (x *BatchDeleteRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_batch_delete_proto_msgTypes[0]
+func (x *FilterValidationError) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_batch_delete_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -57,82 +1109,116 @@ func (x *BatchDeleteRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BatchDeleteRequest.ProtoReflect.Descriptor instead.
-func (*BatchDeleteRequest) Descriptor() ([]byte, []int) {
-	return file_v1_batch_delete_proto_rawDescGZIP(), []int{0}
+// Deprecated: Use FilterValidationError.ProtoReflect.Descriptor instead.
+func (*FilterValidationError) Descriptor() ([]byte, []int) {
+	return file_v1_batch_delete_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *BatchDeleteRequest) GetCollection() string {
+func (x *FilterValidationError) GetFieldPath() string {
 	if x != nil {
-		return x.Collection
+		return x.FieldPath
 	}
 	return ""
 }
 
-func (x *BatchDeleteRequest) GetFilters() *Filters {
+func (x *FilterValidationError) GetOperator() string {
 	if x != nil {
-		return x.Filters
+		return x.Operator
 	}
-	return nil
+	return ""
 }
 
-func (x *BatchDeleteRequest) GetVerbose() bool {
+func (x *FilterValidationError) GetExpectedType() string {
 	if x != nil {
-		return x.Verbose
+		return x.ExpectedType
 	}
-	return false
+	return ""
 }
 
-func (x *BatchDeleteRequest) GetDryRun() bool {
+func (x *FilterValidationError) GetReceivedValue() string {
 	if x != nil {
-		return x.DryRun
+		return x.ReceivedValue
 	}
-	return false
+	return ""
 }
 
-func (x *BatchDeleteRequest) GetConsistencyLevel() ConsistencyLevel {
-	if x != nil && x.ConsistencyLevel != nil {
-		return *x.ConsistencyLevel
+type TenantList struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tenants []string `protobuf:"bytes,1,rep,name=tenants,proto3" json:"tenants,omitempty"`
+}
+
+func (x *TenantList) Reset() {
+	*x = TenantList{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_batch_delete_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ConsistencyLevel_CONSISTENCY_LEVEL_UNSPECIFIED
 }
 
-func (x *BatchDeleteRequest) GetTenant() string {
-	if x != nil && x.Tenant != nil {
-		return *x.Tenant
+func (x *TenantList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TenantList) ProtoMessage() {}
+
+func (x *TenantList) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_batch_delete_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-type BatchDeleteReply struct {
+// Deprecated: Use TenantList.ProtoReflect.Descriptor instead.
+func (*TenantList) Descriptor() ([]byte, []int) {
+	return file_v1_batch_delete_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *TenantList) GetTenants() []string {
+	if x != nil {
+		return x.Tenants
+	}
+	return nil
+}
+
+type TenantDeleteSummary struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Took       float32              `protobuf:"fixed32,1,opt,name=took,proto3" json:"took,omitempty"`
-	Failed     int64                `protobuf:"varint,2,opt,name=failed,proto3" json:"failed,omitempty"`
-	Matches    int64                `protobuf:"varint,3,opt,name=matches,proto3" json:"matches,omitempty"`
-	Successful int64                `protobuf:"varint,4,opt,name=successful,proto3" json:"successful,omitempty"`
-	Objects    []*BatchDeleteObject `protobuf:"bytes,5,rep,name=objects,proto3" json:"objects,omitempty"`
+	Tenant     string `protobuf:"bytes,1,opt,name=tenant,proto3" json:"tenant,omitempty"`
+	Failed     int64  `protobuf:"varint,2,opt,name=failed,proto3" json:"failed,omitempty"`
+	Matches    int64  `protobuf:"varint,3,opt,name=matches,proto3" json:"matches,omitempty"`
+	Successful int64  `protobuf:"varint,4,opt,name=successful,proto3" json:"successful,omitempty"`
+	Skipped    int64  `protobuf:"varint,5,opt,name=skipped,proto3" json:"skipped,omitempty"`
+	Excluded   int64  `protobuf:"varint,6,opt,name=excluded,proto3" json:"excluded,omitempty"`
 }
 
-func (x *BatchDeleteReply) Reset() {
-	*x = BatchDeleteReply{}
+func (x *TenantDeleteSummary) Reset() {
+	*x = TenantDeleteSummary{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v1_batch_delete_proto_msgTypes[1]
+		mi := &file_v1_batch_delete_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *BatchDeleteReply) String() string {
+func (x *TenantDeleteSummary) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BatchDeleteReply) ProtoMessage() {}
+func (*TenantDeleteSummary) ProtoMessage() {}
 
-func (x *BatchDeleteReply) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_batch_delete_proto_msgTypes[1]
+func (x *TenantDeleteSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_batch_delete_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -143,44 +1229,51 @@ func (x *BatchDeleteReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BatchDeleteReply.ProtoReflect.Descriptor instead.
-func (*BatchDeleteReply) Descriptor() ([]byte, []int) {
-	return file_v1_batch_delete_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use TenantDeleteSummary.ProtoReflect.Descriptor instead.
+func (*TenantDeleteSummary) Descriptor() ([]byte, []int) {
+	return file_v1_batch_delete_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *BatchDeleteReply) GetTook() float32 {
+func (x *TenantDeleteSummary) GetTenant() string {
 	if x != nil {
-		return x.Took
+		return x.Tenant
 	}
-	return 0
+	return ""
 }
 
-func (x *BatchDeleteReply) GetFailed() int64 {
+func (x *TenantDeleteSummary) GetFailed() int64 {
 	if x != nil {
 		return x.Failed
 	}
 	return 0
 }
 
-func (x *BatchDeleteReply) GetMatches() int64 {
+func (x *TenantDeleteSummary) GetMatches() int64 {
 	if x != nil {
 		return x.Matches
 	}
 	return 0
 }
 
-func (x *BatchDeleteReply) GetSuccessful() int64 {
+func (x *TenantDeleteSummary) GetSuccessful() int64 {
 	if x != nil {
 		return x.Successful
 	}
 	return 0
 }
 
-func (x *BatchDeleteReply) GetObjects() []*BatchDeleteObject {
+func (x *TenantDeleteSummary) GetSkipped() int64 {
 	if x != nil {
-		return x.Objects
+		return x.Skipped
 	}
-	return nil
+	return 0
+}
+
+func (x *TenantDeleteSummary) GetExcluded() int64 {
+	if x != nil {
+		return x.Excluded
+	}
+	return 0
 }
 
 type BatchDeleteObject struct {
@@ -188,15 +1281,19 @@ type BatchDeleteObject struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Uuid       []byte  `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
-	Successful bool    `protobuf:"varint,2,opt,name=successful,proto3" json:"successful,omitempty"`
-	Error      *string `protobuf:"bytes,3,opt,name=error,proto3,oneof" json:"error,omitempty"` // empty string means no error
+	// Types that are assignable to UuidFormat:
+	//
+	//	*BatchDeleteObject_Uuid
+	//	*BatchDeleteObject_UuidStr
+	UuidFormat isBatchDeleteObject_UuidFormat `protobuf_oneof:"uuid_format"`
+	Successful bool                           `protobuf:"varint,2,opt,name=successful,proto3" json:"successful,omitempty"`
+	Error      *string                        `protobuf:"bytes,3,opt,name=error,proto3,oneof" json:"error,omitempty"` // empty string means no error
 }
 
 func (x *BatchDeleteObject) Reset() {
 	*x = BatchDeleteObject{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v1_batch_delete_proto_msgTypes[2]
+		mi := &file_v1_batch_delete_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -209,7 +1306,7 @@ func (x *BatchDeleteObject) String() string {
 func (*BatchDeleteObject) ProtoMessage() {}
 
 func (x *BatchDeleteObject) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_batch_delete_proto_msgTypes[2]
+	mi := &file_v1_batch_delete_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -222,16 +1319,30 @@ func (x *BatchDeleteObject) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BatchDeleteObject.ProtoReflect.Descriptor instead.
 func (*BatchDeleteObject) Descriptor() ([]byte, []int) {
-	return file_v1_batch_delete_proto_rawDescGZIP(), []int{2}
+	return file_v1_batch_delete_proto_rawDescGZIP(), []int{13}
+}
+
+func (m *BatchDeleteObject) GetUuidFormat() isBatchDeleteObject_UuidFormat {
+	if m != nil {
+		return m.UuidFormat
+	}
+	return nil
 }
 
 func (x *BatchDeleteObject) GetUuid() []byte {
-	if x != nil {
+	if x, ok := x.GetUuidFormat().(*BatchDeleteObject_Uuid); ok {
 		return x.Uuid
 	}
 	return nil
 }
 
+func (x *BatchDeleteObject) GetUuidStr() string {
+	if x, ok := x.GetUuidFormat().(*BatchDeleteObject_UuidStr); ok {
+		return x.UuidStr
+	}
+	return ""
+}
+
 func (x *BatchDeleteObject) GetSuccessful() bool {
 	if x != nil {
 		return x.Successful
@@ -246,57 +1357,265 @@ func (x *BatchDeleteObject) GetError() string {
 	return ""
 }
 
+type isBatchDeleteObject_UuidFormat interface {
+	isBatchDeleteObject_UuidFormat()
+}
+
+type BatchDeleteObject_Uuid struct {
+	// big-endian integer value of the uuid, use UUIDString to read either format
+	Uuid []byte `protobuf:"bytes,1,opt,name=uuid,proto3,oneof"`
+}
+
+type BatchDeleteObject_UuidStr struct {
+	// canonical 8-4-4-4-12 hex form of the uuid
+	UuidStr string `protobuf:"bytes,4,opt,name=uuid_str,json=uuidStr,proto3,oneof"`
+}
+
+func (*BatchDeleteObject_Uuid) isBatchDeleteObject_UuidFormat() {}
+
+func (*BatchDeleteObject_UuidStr) isBatchDeleteObject_UuidFormat() {}
+
 var File_v1_batch_delete_proto protoreflect.FileDescriptor
 
 var file_v1_batch_delete_proto_rawDesc = []byte{
 	0x0a, 0x15, 0x76, 0x31, 0x2f, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x64, 0x65, 0x6c, 0x65, 0x74,
 	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0b, 0x77, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74,
-	0x65, 0x2e, 0x76, 0x31, 0x1a, 0x0d, 0x76, 0x31, 0x2f, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x22, 0xa6, 0x02, 0x0a, 0x12, 0x42, 0x61, 0x74, 0x63, 0x68, 0x44, 0x65, 0x6c,
-	0x65, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f,
-	0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
-	0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2e, 0x0a, 0x07, 0x66, 0x69,
-	0x6c, 0x74, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x77, 0x65,
-	0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72,
-	0x73, 0x52, 0x07, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65,
-	0x72, 0x62, 0x6f, 0x73, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x76, 0x65, 0x72,
-	0x62, 0x6f, 0x73, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x64, 0x72, 0x79, 0x5f, 0x72, 0x75, 0x6e, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x64, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x12, 0x4f, 0x0a,
-	0x11, 0x63, 0x6f, 0x6e, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x6c, 0x65, 0x76,
-	0x65, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x77, 0x65, 0x61, 0x76, 0x69,
-	0x61, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e,
-	0x63, 0x79, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x48, 0x00, 0x52, 0x10, 0x63, 0x6f, 0x6e, 0x73, 0x69,
-	0x73, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x88, 0x01, 0x01, 0x12, 0x1b,
-	0x0a, 0x06, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01,
-	0x52, 0x06, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x88, 0x01, 0x01, 0x42, 0x14, 0x0a, 0x12, 0x5f,
-	0x63, 0x6f, 0x6e, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x6c, 0x65, 0x76, 0x65,
-	0x6c, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x22, 0xb2, 0x01, 0x0a,
-	0x10, 0x42, 0x61, 0x74, 0x63, 0x68, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6c,
-	0x79, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x6f, 0x6f, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x02, 0x52,
-	0x04, 0x74, 0x6f, 0x6f, 0x6b, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x12, 0x18, 0x0a,
-	0x07, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07,
-	0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x75, 0x63, 0x63, 0x65,
-	0x73, 0x73, 0x66, 0x75, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x73, 0x75, 0x63,
-	0x63, 0x65, 0x73, 0x73, 0x66, 0x75, 0x6c, 0x12, 0x38, 0x0a, 0x07, 0x6f, 0x62, 0x6a, 0x65, 0x63,
-	0x74, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x77, 0x65, 0x61, 0x76, 0x69,
-	0x61, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x44, 0x65, 0x6c, 0x65,
-	0x74, 0x65, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x07, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74,
-	0x73, 0x22, 0x6c, 0x0a, 0x11, 0x42, 0x61, 0x74, 0x63, 0x68, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
-	0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x75,
-	0x63, 0x63, 0x65, 0x73, 0x73, 0x66, 0x75, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a,
-	0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x66, 0x75, 0x6c, 0x12, 0x19, 0x0a, 0x05, 0x65, 0x72,
-	0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72,
-	0x6f, 0x72, 0x88, 0x01, 0x01, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x42,
-	0x75, 0x0a, 0x23, 0x69, 0x6f, 0x2e, 0x77, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x2e, 0x63,
-	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x63, 0x6f, 0x6c, 0x2e, 0x76, 0x31, 0x42, 0x18, 0x57, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65,
-	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x42, 0x61, 0x74, 0x63, 0x68, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
-	0x5a, 0x34, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x77, 0x65, 0x61,
-	0x76, 0x69, 0x61, 0x74, 0x65, 0x2f, 0x77, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x2f, 0x67,
-	0x72, 0x70, 0x63, 0x2f, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x3b, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x65, 0x2e, 0x76, 0x31, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0d, 0x76, 0x31, 0x2f, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x22, 0xf8, 0x05, 0x0a, 0x12, 0x42, 0x61, 0x74, 0x63, 0x68, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x63,
+	0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2e, 0x0a, 0x07, 0x66,
+	0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x77,
+	0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x73, 0x52, 0x07, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x76,
+	0x65, 0x72, 0x62, 0x6f, 0x73, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x76, 0x65,
+	0x72, 0x62, 0x6f, 0x73, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x64, 0x72, 0x79, 0x5f, 0x72, 0x75, 0x6e,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x64, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x12, 0x4f,
+	0x0a, 0x11, 0x63, 0x6f, 0x6e, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x6c, 0x65,
+	0x76, 0x65, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x77, 0x65, 0x61, 0x76,
+	0x69, 0x61, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x73, 0x69, 0x73, 0x74, 0x65,
+	0x6e, 0x63, 0x79, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x48, 0x01, 0x52, 0x10, 0x63, 0x6f, 0x6e, 0x73,
+	0x69, 0x73, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x88, 0x01, 0x01, 0x12,
+	0x18, 0x0a, 0x06, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x48,
+	0x00, 0x52, 0x06, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x12, 0x3a, 0x0a, 0x0b, 0x74, 0x65, 0x6e,
+	0x61, 0x6e, 0x74, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17,
+	0x2e, 0x77, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x65, 0x6e,
+	0x61, 0x6e, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x48, 0x00, 0x52, 0x0a, 0x74, 0x65, 0x6e, 0x61, 0x6e,
+	0x74, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x43, 0x0a, 0x0f, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65,
+	0x64, 0x5f, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0e, 0x6d, 0x6f, 0x64, 0x69,
+	0x66, 0x69, 0x65, 0x64, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x6c,
+	0x6c, 0x5f, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0a, 0x61, 0x6c, 0x6c, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x72,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69,
+	0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73,
+	0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x68, 0x75, 0x6e,
+	0x6b, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x63, 0x68,
+	0x75, 0x6e, 0x6b, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x69, 0x64, 0x65, 0x6d, 0x70,
+	0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0e, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4b, 0x65, 0x79,
+	0x12, 0x3c, 0x0a, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x0f, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x20, 0x2e, 0x77, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x72, 0x69, 0x6f,
+	0x72, 0x69, 0x74, 0x79, 0x52, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x23,
+	0x0a, 0x0d, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x73, 0x18,
+	0x10, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x0c, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x55, 0x75,
+	0x69, 0x64, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x18, 0x11, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x5f, 0x75,
+	0x72, 0x6c, 0x18, 0x12, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x61, 0x6c, 0x6c, 0x62, 0x61,
+	0x63, 0x6b, 0x55, 0x72, 0x6c, 0x42, 0x12, 0x0a, 0x10, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x5f,
+	0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x14, 0x0a, 0x12, 0x5f, 0x63, 0x6f,
+	0x6e, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x22,
+	0xdb, 0x04, 0x0a, 0x10, 0x42, 0x61, 0x74, 0x63, 0x68, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x12, 0x16, 0x0a, 0x04, 0x74, 0x6f, 0x6f, 0x6b, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x02, 0x42, 0x02, 0x18, 0x01, 0x52, 0x04, 0x74, 0x6f, 0x6f, 0x6b, 0x12, 0x16, 0x0a, 0x06,
+	0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x66, 0x61,
+	0x69, 0x6c, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x12, 0x1e,
+	0x0a, 0x0a, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x66, 0x75, 0x6c, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0a, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x66, 0x75, 0x6c, 0x12, 0x38,
+	0x0a, 0x07, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x1e, 0x2e, 0x77, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61,
+	0x74, 0x63, 0x68, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52,
+	0x07, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x12, 0x3e, 0x0a, 0x0d, 0x74, 0x6f, 0x6f, 0x6b,
+	0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x74, 0x6f, 0x6f, 0x6b,
+	0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x6b, 0x69, 0x70,
+	0x70, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x73, 0x6b, 0x69, 0x70, 0x70,
+	0x65, 0x64, 0x12, 0x47, 0x0a, 0x0e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x5f, 0x72, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x77, 0x65, 0x61,
+	0x76, 0x69, 0x61, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x0d, 0x74, 0x65,
+	0x6e, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x41, 0x0a, 0x0f, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x5f, 0x62, 0x72, 0x65, 0x61, 0x6b, 0x64, 0x6f, 0x77, 0x6e, 0x18, 0x09,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x77, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x0e,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x64, 0x6f, 0x77, 0x6e, 0x12, 0x1e,
+	0x0a, 0x0a, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16,
+	0x0a, 0x06, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x5f, 0x69, 0x64, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65,
+	0x64, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65,
+	0x64, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67,
+	0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x15, 0x0a, 0x06, 0x6a, 0x6f, 0x62, 0x5f, 0x69, 0x64,
+	0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6a, 0x6f, 0x62, 0x49, 0x64, 0x22, 0x85, 0x01,
+	0x0a, 0x17, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x42, 0x61, 0x74, 0x63, 0x68, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3b, 0x0a, 0x08, 0x72, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x77, 0x65,
+	0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x08, 0x72, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x12, 0x2d, 0x0a, 0x13, 0x73, 0x74, 0x6f, 0x70, 0x5f, 0x6f,
+	0x6e, 0x5f, 0x66, 0x69, 0x72, 0x73, 0x74, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x10, 0x73, 0x74, 0x6f, 0x70, 0x4f, 0x6e, 0x46, 0x69, 0x72, 0x73, 0x74,
+	0x45, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x50, 0x0a, 0x15, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x42, 0x61,
+	0x74, 0x63, 0x68, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x37,
+	0x0a, 0x07, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x1d, 0x2e, 0x77, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61,
+	0x74, 0x63, 0x68, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x52, 0x07,
+	0x72, 0x65, 0x70, 0x6c, 0x69, 0x65, 0x73, 0x22, 0xe9, 0x01, 0x0a, 0x1d, 0x4c, 0x69, 0x73, 0x74,
+	0x42, 0x61, 0x74, 0x63, 0x68, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x48, 0x69, 0x73, 0x74, 0x6f,
+	0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6c,
+	0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63,
+	0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x37, 0x0a, 0x09, 0x66, 0x72, 0x6f,
+	0x6d, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x08, 0x66, 0x72, 0x6f, 0x6d, 0x54, 0x69,
+	0x6d, 0x65, 0x12, 0x33, 0x0a, 0x07, 0x74, 0x6f, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x06, 0x74, 0x6f, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67,
+	0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73,
+	0x69, 0x7a, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53,
+	0x69, 0x7a, 0x65, 0x22, 0x85, 0x01, 0x0a, 0x1b, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x61, 0x74, 0x63,
+	0x68, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x12, 0x3e, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x77, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x48, 0x69,
+	0x73, 0x74, 0x6f, 0x72, 0x79, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72,
+	0x69, 0x65, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65,
+	0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65,
+	0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x31, 0x0a, 0x18, 0x47,
+	0x65, 0x74, 0x42, 0x61, 0x74, 0x63, 0x68, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4a, 0x6f, 0x62,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x15, 0x0a, 0x06, 0x6a, 0x6f, 0x62, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6a, 0x6f, 0x62, 0x49, 0x64, 0x22, 0x96,
+	0x02, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x42, 0x61, 0x74, 0x63, 0x68, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x39, 0x0a, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x21, 0x2e, 0x77, 0x65, 0x61, 0x76,
+	0x69, 0x61, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x4a, 0x6f, 0x62, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x33, 0x0a, 0x05, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x77, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x52, 0x05, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12,
+	0x39, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x3b, 0x0a, 0x0b, 0x66, 0x69,
+	0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x66, 0x69, 0x6e,
+	0x69, 0x73, 0x68, 0x65, 0x64, 0x41, 0x74, 0x22, 0x97, 0x02, 0x0a, 0x17, 0x42, 0x61, 0x74, 0x63,
+	0x68, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x12, 0x39, 0x0a, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x77, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x33,
+	0x0a, 0x05, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e,
+	0x77, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x74, 0x63,
+	0x68, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x52, 0x05, 0x72, 0x65,
+	0x70, 0x6c, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x65, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x70, 0x65, 0x65, 0x72, 0x12, 0x39, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64,
+	0x41, 0x74, 0x12, 0x3d, 0x0a, 0x0c, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x5f,
+	0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x41,
+	0x74, 0x22, 0x63, 0x0a, 0x0b, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74,
+	0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12,
+	0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x5f,
+	0x75, 0x75, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x73, 0x61, 0x6d, 0x70,
+	0x6c, 0x65, 0x55, 0x75, 0x69, 0x64, 0x22, 0x9e, 0x01, 0x0a, 0x15, 0x46, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72,
+	0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x50, 0x61, 0x74, 0x68, 0x12,
+	0x1a, 0x0a, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x23, 0x0a, 0x0d, 0x65,
+	0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0c, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x5f, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76,
+	0x65, 0x64, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x26, 0x0a, 0x0a, 0x54, 0x65, 0x6e, 0x61, 0x6e,
+	0x74, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x73, 0x22,
+	0xb5, 0x01, 0x0a, 0x13, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x65, 0x6e, 0x61, 0x6e,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x12,
+	0x16, 0x0a, 0x06, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x06, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x61, 0x74, 0x63, 0x68,
+	0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65,
+	0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x66, 0x75, 0x6c, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x66, 0x75,
+	0x6c, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x07, 0x73, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x65,
+	0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x65,
+	0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x64, 0x22, 0x9a, 0x01, 0x0a, 0x11, 0x42, 0x61, 0x74, 0x63,
+	0x68, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x14, 0x0a,
+	0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x00, 0x52, 0x04, 0x75,
+	0x75, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x08, 0x75, 0x75, 0x69, 0x64, 0x5f, 0x73, 0x74, 0x72, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x07, 0x75, 0x75, 0x69, 0x64, 0x53, 0x74, 0x72,
+	0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x66, 0x75, 0x6c, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x66, 0x75, 0x6c,
+	0x12, 0x19, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48,
+	0x01, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x88, 0x01, 0x01, 0x42, 0x0d, 0x0a, 0x0b, 0x75,
+	0x75, 0x69, 0x64, 0x5f, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x2a, 0x9d, 0x01, 0x0a, 0x13, 0x42, 0x61, 0x74, 0x63, 0x68, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x50, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x25, 0x0a, 0x21,
+	0x42, 0x41, 0x54, 0x43, 0x48, 0x5f, 0x44, 0x45, 0x4c, 0x45, 0x54, 0x45, 0x5f, 0x50, 0x52, 0x49,
+	0x4f, 0x52, 0x49, 0x54, 0x59, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45,
+	0x44, 0x10, 0x00, 0x12, 0x1d, 0x0a, 0x19, 0x42, 0x41, 0x54, 0x43, 0x48, 0x5f, 0x44, 0x45, 0x4c,
+	0x45, 0x54, 0x45, 0x5f, 0x50, 0x52, 0x49, 0x4f, 0x52, 0x49, 0x54, 0x59, 0x5f, 0x4c, 0x4f, 0x57,
+	0x10, 0x01, 0x12, 0x20, 0x0a, 0x1c, 0x42, 0x41, 0x54, 0x43, 0x48, 0x5f, 0x44, 0x45, 0x4c, 0x45,
+	0x54, 0x45, 0x5f, 0x50, 0x52, 0x49, 0x4f, 0x52, 0x49, 0x54, 0x59, 0x5f, 0x4e, 0x4f, 0x52, 0x4d,
+	0x41, 0x4c, 0x10, 0x02, 0x12, 0x1e, 0x0a, 0x1a, 0x42, 0x41, 0x54, 0x43, 0x48, 0x5f, 0x44, 0x45,
+	0x4c, 0x45, 0x54, 0x45, 0x5f, 0x50, 0x52, 0x49, 0x4f, 0x52, 0x49, 0x54, 0x59, 0x5f, 0x48, 0x49,
+	0x47, 0x48, 0x10, 0x03, 0x2a, 0xae, 0x01, 0x0a, 0x14, 0x42, 0x61, 0x74, 0x63, 0x68, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x4a, 0x6f, 0x62, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x27, 0x0a,
+	0x23, 0x42, 0x41, 0x54, 0x43, 0x48, 0x5f, 0x44, 0x45, 0x4c, 0x45, 0x54, 0x45, 0x5f, 0x4a, 0x4f,
+	0x42, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49,
+	0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x23, 0x0a, 0x1f, 0x42, 0x41, 0x54, 0x43, 0x48, 0x5f,
+	0x44, 0x45, 0x4c, 0x45, 0x54, 0x45, 0x5f, 0x4a, 0x4f, 0x42, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55,
+	0x53, 0x5f, 0x52, 0x55, 0x4e, 0x4e, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x24, 0x0a, 0x20, 0x42,
+	0x41, 0x54, 0x43, 0x48, 0x5f, 0x44, 0x45, 0x4c, 0x45, 0x54, 0x45, 0x5f, 0x4a, 0x4f, 0x42, 0x5f,
+	0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x46, 0x49, 0x4e, 0x49, 0x53, 0x48, 0x45, 0x44, 0x10,
+	0x02, 0x12, 0x22, 0x0a, 0x1e, 0x42, 0x41, 0x54, 0x43, 0x48, 0x5f, 0x44, 0x45, 0x4c, 0x45, 0x54,
+	0x45, 0x5f, 0x4a, 0x4f, 0x42, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x46, 0x41, 0x49,
+	0x4c, 0x45, 0x44, 0x10, 0x03, 0x42, 0x75, 0x0a, 0x23, 0x69, 0x6f, 0x2e, 0x77, 0x65, 0x61, 0x76,
+	0x69, 0x61, 0x74, 0x65, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x67, 0x72, 0x70, 0x63,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x76, 0x31, 0x42, 0x18, 0x57, 0x65,
+	0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x42, 0x61, 0x74, 0x63, 0x68,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x5a, 0x34, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
+	0x6f, 0x6d, 0x2f, 0x77, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x2f, 0x77, 0x65, 0x61, 0x76,
+	0x69, 0x61, 0x74, 0x65, 0x2f, 0x67, 0x72, 0x70, 0x63, 0x2f, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61,
+	0x74, 0x65, 0x64, 0x3b, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -311,23 +1630,58 @@ func file_v1_batch_delete_proto_rawDescGZIP() []byte {
 	return file_v1_batch_delete_proto_rawDescData
 }
 
-var file_v1_batch_delete_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_v1_batch_delete_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_v1_batch_delete_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
 var file_v1_batch_delete_proto_goTypes = []interface{}{
-	(*BatchDeleteRequest)(nil), // 0: weaviate.v1.BatchDeleteRequest
-	(*BatchDeleteReply)(nil),   // 1: weaviate.v1.BatchDeleteReply
-	(*BatchDeleteObject)(nil),  // 2: weaviate.v1.BatchDeleteObject
-	(*Filters)(nil),            // 3: weaviate.v1.Filters
-	(ConsistencyLevel)(0),      // 4: weaviate.v1.ConsistencyLevel
+	(BatchDeletePriority)(0),              // 0: weaviate.v1.BatchDeletePriority
+	(BatchDeleteJobStatus)(0),             // 1: weaviate.v1.BatchDeleteJobStatus
+	(*BatchDeleteRequest)(nil),            // 2: weaviate.v1.BatchDeleteRequest
+	(*BatchDeleteReply)(nil),              // 3: weaviate.v1.BatchDeleteReply
+	(*MultiBatchDeleteRequest)(nil),       // 4: weaviate.v1.MultiBatchDeleteRequest
+	(*MultiBatchDeleteReply)(nil),         // 5: weaviate.v1.MultiBatchDeleteReply
+	(*ListBatchDeleteHistoryRequest)(nil), // 6: weaviate.v1.ListBatchDeleteHistoryRequest
+	(*ListBatchDeleteHistoryReply)(nil),   // 7: weaviate.v1.ListBatchDeleteHistoryReply
+	(*GetBatchDeleteJobRequest)(nil),      // 8: weaviate.v1.GetBatchDeleteJobRequest
+	(*GetBatchDeleteJobReply)(nil),        // 9: weaviate.v1.GetBatchDeleteJobReply
+	(*BatchDeleteHistoryEntry)(nil),       // 10: weaviate.v1.BatchDeleteHistoryEntry
+	(*ErrorBucket)(nil),                   // 11: weaviate.v1.ErrorBucket
+	(*FilterValidationError)(nil),         // 12: weaviate.v1.FilterValidationError
+	(*TenantList)(nil),                    // 13: weaviate.v1.TenantList
+	(*TenantDeleteSummary)(nil),           // 14: weaviate.v1.TenantDeleteSummary
+	(*BatchDeleteObject)(nil),             // 15: weaviate.v1.BatchDeleteObject
+	(*Filters)(nil),                       // 16: weaviate.v1.Filters
+	(ConsistencyLevel)(0),                 // 17: weaviate.v1.ConsistencyLevel
+	(*timestamppb.Timestamp)(nil),         // 18: google.protobuf.Timestamp
+	(*durationpb.Duration)(nil),           // 19: google.protobuf.Duration
 }
 var file_v1_batch_delete_proto_depIdxs = []int32{
-	3, // 0: weaviate.v1.BatchDeleteRequest.filters:type_name -> weaviate.v1.Filters
-	4, // 1: weaviate.v1.BatchDeleteRequest.consistency_level:type_name -> weaviate.v1.ConsistencyLevel
-	2, // 2: weaviate.v1.BatchDeleteReply.objects:type_name -> weaviate.v1.BatchDeleteObject
-	3, // [3:3] is the sub-list for method output_type
-	3, // [3:3] is the sub-list for method input_type
-	3, // [3:3] is the sub-list for extension type_name
-	3, // [3:3] is the sub-list for extension extendee
-	0, // [0:3] is the sub-list for field type_name
+	16, // 0: weaviate.v1.BatchDeleteRequest.filters:type_name -> weaviate.v1.Filters
+	17, // 1: weaviate.v1.BatchDeleteRequest.consistency_level:type_name -> weaviate.v1.ConsistencyLevel
+	13, // 2: weaviate.v1.BatchDeleteRequest.tenant_list:type_name -> weaviate.v1.TenantList
+	18, // 3: weaviate.v1.BatchDeleteRequest.modified_before:type_name -> google.protobuf.Timestamp
+	0,  // 4: weaviate.v1.BatchDeleteRequest.priority:type_name -> weaviate.v1.BatchDeletePriority
+	15, // 5: weaviate.v1.BatchDeleteReply.objects:type_name -> weaviate.v1.BatchDeleteObject
+	19, // 6: weaviate.v1.BatchDeleteReply.took_duration:type_name -> google.protobuf.Duration
+	14, // 7: weaviate.v1.BatchDeleteReply.tenant_results:type_name -> weaviate.v1.TenantDeleteSummary
+	11, // 8: weaviate.v1.BatchDeleteReply.error_breakdown:type_name -> weaviate.v1.ErrorBucket
+	2,  // 9: weaviate.v1.MultiBatchDeleteRequest.requests:type_name -> weaviate.v1.BatchDeleteRequest
+	3,  // 10: weaviate.v1.MultiBatchDeleteReply.replies:type_name -> weaviate.v1.BatchDeleteReply
+	18, // 11: weaviate.v1.ListBatchDeleteHistoryRequest.from_time:type_name -> google.protobuf.Timestamp
+	18, // 12: weaviate.v1.ListBatchDeleteHistoryRequest.to_time:type_name -> google.protobuf.Timestamp
+	10, // 13: weaviate.v1.ListBatchDeleteHistoryReply.entries:type_name -> weaviate.v1.BatchDeleteHistoryEntry
+	1,  // 14: weaviate.v1.GetBatchDeleteJobReply.status:type_name -> weaviate.v1.BatchDeleteJobStatus
+	3,  // 15: weaviate.v1.GetBatchDeleteJobReply.reply:type_name -> weaviate.v1.BatchDeleteReply
+	18, // 16: weaviate.v1.GetBatchDeleteJobReply.started_at:type_name -> google.protobuf.Timestamp
+	18, // 17: weaviate.v1.GetBatchDeleteJobReply.finished_at:type_name -> google.protobuf.Timestamp
+	2,  // 18: weaviate.v1.BatchDeleteHistoryEntry.request:type_name -> weaviate.v1.BatchDeleteRequest
+	3,  // 19: weaviate.v1.BatchDeleteHistoryEntry.reply:type_name -> weaviate.v1.BatchDeleteReply
+	18, // 20: weaviate.v1.BatchDeleteHistoryEntry.started_at:type_name -> google.protobuf.Timestamp
+	18, // 21: weaviate.v1.BatchDeleteHistoryEntry.completed_at:type_name -> google.protobuf.Timestamp
+	22, // [22:22] is the sub-list for method output_type
+	22, // [22:22] is the sub-list for method input_type
+	22, // [22:22] is the sub-list for extension type_name
+	22, // [22:22] is the sub-list for extension extendee
+	0,  // [0:22] is the sub-list for field type_name
 }
 
 func init() { file_v1_batch_delete_proto_init() }
@@ -362,6 +1716,138 @@ func file_v1_batch_delete_proto_init() {
 			}
 		}
 		file_v1_batch_delete_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MultiBatchDeleteRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_batch_delete_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MultiBatchDeleteReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_batch_delete_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListBatchDeleteHistoryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_batch_delete_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListBatchDeleteHistoryReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_batch_delete_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBatchDeleteJobRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_batch_delete_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBatchDeleteJobReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_batch_delete_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchDeleteHistoryEntry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_batch_delete_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ErrorBucket); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_batch_delete_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FilterValidationError); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_batch_delete_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TenantList); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_batch_delete_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TenantDeleteSummary); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_batch_delete_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*BatchDeleteObject); i {
 			case 0:
 				return &v.state
@@ -374,20 +1860,27 @@ func file_v1_batch_delete_proto_init() {
 			}
 		}
 	}
-	file_v1_batch_delete_proto_msgTypes[0].OneofWrappers = []interface{}{}
-	file_v1_batch_delete_proto_msgTypes[2].OneofWrappers = []interface{}{}
+	file_v1_batch_delete_proto_msgTypes[0].OneofWrappers = []interface{}{
+		(*BatchDeleteRequest_Tenant)(nil),
+		(*BatchDeleteRequest_TenantList)(nil),
+	}
+	file_v1_batch_delete_proto_msgTypes[13].OneofWrappers = []interface{}{
+		(*BatchDeleteObject_Uuid)(nil),
+		(*BatchDeleteObject_UuidStr)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_v1_batch_delete_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   3,
+			NumEnums:      2,
+			NumMessages:   14,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_v1_batch_delete_proto_goTypes,
 		DependencyIndexes: file_v1_batch_delete_proto_depIdxs,
+		EnumInfos:         file_v1_batch_delete_proto_enumTypes,
 		MessageInfos:      file_v1_batch_delete_proto_msgTypes,
 	}.Build()
 	File_v1_batch_delete_proto = out.File
@@ -0,0 +1,32 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package protocol
+
+import (
+	"google.golang.org/grpc/status"
+)
+
+// ParseFilterValidationError returns the FilterValidationError detail of the
+// status of err, as returned by batch deletes with filters that do not match
+// the schema. It returns false if err does not carry one.
+func ParseFilterValidationError(err error) (*FilterValidationError, bool) {
+	st, ok := status.FromError(err)
+	if !ok {
+		return nil, false
+	}
+	for _, detail := range st.Details() {
+		if fve, ok := detail.(*FilterValidationError); ok {
+			return fve, true
+		}
+	}
+	return nil, false
+}
@@ -0,0 +1,28 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package protocol
+
+import (
+	"time"
+)
+
+// TookDuration returns how long the batch delete took. Replies of servers
+// which only set the deprecated float seconds field are supported as well.
+func TookDuration(r *BatchDeleteReply) time.Duration {
+	if r == nil {
+		return 0
+	}
+	if d := r.GetTookDuration(); d != nil {
+		return d.AsDuration()
+	}
+	return time.Duration(float64(r.GetTook()) * float64(time.Second))
+}
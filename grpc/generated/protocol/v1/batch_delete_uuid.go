@@ -0,0 +1,33 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package protocol
+
+import (
+	"github.com/google/uuid"
+)
+
+// UUIDString returns the canonical form of the object's uuid, regardless of
+// whether the server sent it as string or as bytes. Bytes are the big-endian
+// integer value of the uuid and may lack leading zero bytes. It returns an
+// empty string if no valid uuid is set.
+func (x *BatchDeleteObject) UUIDString() string {
+	if s := x.GetUuidStr(); s != "" {
+		return s
+	}
+	b := x.GetUuid()
+	if len(b) == 0 || len(b) > 16 {
+		return ""
+	}
+	var id uuid.UUID
+	copy(id[16-len(b):], b)
+	return id.String()
+}
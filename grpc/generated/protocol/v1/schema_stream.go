@@ -0,0 +1,45 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package protocol
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+)
+
+// CollectStreamSchema receives the chunks of a StreamSchema call until the
+// stream ends and returns the classes they contain as a schema
+func CollectStreamSchema(stream Weaviate_StreamSchemaClient) (schema.Schema, error) {
+	classes := []*models.Class{}
+	for {
+		chunk, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return schema.Schema{}, err
+		}
+		for _, raw := range chunk.Classes {
+			class := &models.Class{}
+			if err := json.Unmarshal(raw, class); err != nil {
+				return schema.Schema{}, fmt.Errorf("decode class %d of the stream: %w", len(classes), err)
+			}
+			classes = append(classes, class)
+		}
+	}
+	return schema.Schema{Objects: &models.Schema{Classes: classes}}, nil
+}
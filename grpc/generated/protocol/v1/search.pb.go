@@ -0,0 +1,276 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+
+package protocol
+
+import (
+	reflect "reflect"
+	sync "sync"
+
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// JoinedSearchRequest runs search on its collection and adds the properties
+// of the objects referenced by each result, as selected by joins. The joined
+// properties are returned as reference properties of the results.
+type JoinedSearchRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Search *SearchRequest `protobuf:"bytes,1,opt,name=search,proto3" json:"search,omitempty"`
+	Joins  []*JoinClause  `protobuf:"bytes,2,rep,name=joins,proto3" json:"joins,omitempty"`
+}
+
+func (x *JoinedSearchRequest) Reset() {
+	*x = JoinedSearchRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_search_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *JoinedSearchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JoinedSearchRequest) ProtoMessage() {}
+
+func (x *JoinedSearchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_search_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JoinedSearchRequest.ProtoReflect.Descriptor instead.
+func (*JoinedSearchRequest) Descriptor() ([]byte, []int) {
+	return file_v1_search_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *JoinedSearchRequest) GetSearch() *SearchRequest {
+	if x != nil {
+		return x.Search
+	}
+	return nil
+}
+
+func (x *JoinedSearchRequest) GetJoins() []*JoinClause {
+	if x != nil {
+		return x.Joins
+	}
+	return nil
+}
+
+type JoinClause struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// target_collection is the collection the objects referenced by
+	// join_on_property are looked up in
+	TargetCollection string `protobuf:"bytes,1,opt,name=target_collection,json=targetCollection,proto3" json:"target_collection,omitempty"`
+	// join_on_property is a cross-reference property of the collection that is
+	// joined from
+	JoinOnProperty string `protobuf:"bytes,2,opt,name=join_on_property,json=joinOnProperty,proto3" json:"join_on_property,omitempty"`
+	// returned_properties selects the properties of the joined objects, all
+	// non-reference properties are returned if it is not set
+	ReturnedProperties *PropertiesRequest `protobuf:"bytes,3,opt,name=returned_properties,json=returnedProperties,proto3" json:"returned_properties,omitempty"`
+	// joins of target_collection, each nesting level counts towards the maximum
+	// join depth of the server
+	Joins []*JoinClause `protobuf:"bytes,4,rep,name=joins,proto3" json:"joins,omitempty"`
+}
+
+func (x *JoinClause) Reset() {
+	*x = JoinClause{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_search_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *JoinClause) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JoinClause) ProtoMessage() {}
+
+func (x *JoinClause) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_search_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JoinClause.ProtoReflect.Descriptor instead.
+func (*JoinClause) Descriptor() ([]byte, []int) {
+	return file_v1_search_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *JoinClause) GetTargetCollection() string {
+	if x != nil {
+		return x.TargetCollection
+	}
+	return ""
+}
+
+func (x *JoinClause) GetJoinOnProperty() string {
+	if x != nil {
+		return x.JoinOnProperty
+	}
+	return ""
+}
+
+func (x *JoinClause) GetReturnedProperties() *PropertiesRequest {
+	if x != nil {
+		return x.ReturnedProperties
+	}
+	return nil
+}
+
+func (x *JoinClause) GetJoins() []*JoinClause {
+	if x != nil {
+		return x.Joins
+	}
+	return nil
+}
+
+var File_v1_search_proto protoreflect.FileDescriptor
+
+var file_v1_search_proto_rawDesc = []byte{
+	0x0a, 0x0f, 0x76, 0x31, 0x2f, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x12, 0x0b, 0x77, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x1a, 0x13,
+	0x76, 0x31, 0x2f, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x67, 0x65, 0x74, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x22, 0x78, 0x0a, 0x13, 0x4a, 0x6f, 0x69, 0x6e, 0x65, 0x64, 0x53, 0x65, 0x61,
+	0x72, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x32, 0x0a, 0x06, 0x73, 0x65,
+	0x61, 0x72, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x77, 0x65, 0x61,
+	0x76, 0x69, 0x61, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x06, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x12, 0x2d,
+	0x0a, 0x05, 0x6a, 0x6f, 0x69, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e,
+	0x77, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4a, 0x6f, 0x69, 0x6e,
+	0x43, 0x6c, 0x61, 0x75, 0x73, 0x65, 0x52, 0x05, 0x6a, 0x6f, 0x69, 0x6e, 0x73, 0x22, 0xe3, 0x01,
+	0x0a, 0x0a, 0x4a, 0x6f, 0x69, 0x6e, 0x43, 0x6c, 0x61, 0x75, 0x73, 0x65, 0x12, 0x2b, 0x0a, 0x11,
+	0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x43,
+	0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x28, 0x0a, 0x10, 0x6a, 0x6f, 0x69,
+	0x6e, 0x5f, 0x6f, 0x6e, 0x5f, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0e, 0x6a, 0x6f, 0x69, 0x6e, 0x4f, 0x6e, 0x50, 0x72, 0x6f, 0x70, 0x65,
+	0x72, 0x74, 0x79, 0x12, 0x4f, 0x0a, 0x13, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x5f,
+	0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1e, 0x2e, 0x77, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50,
+	0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x52, 0x12, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72,
+	0x74, 0x69, 0x65, 0x73, 0x12, 0x2d, 0x0a, 0x05, 0x6a, 0x6f, 0x69, 0x6e, 0x73, 0x18, 0x04, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x77, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x4a, 0x6f, 0x69, 0x6e, 0x43, 0x6c, 0x61, 0x75, 0x73, 0x65, 0x52, 0x05, 0x6a, 0x6f,
+	0x69, 0x6e, 0x73, 0x42, 0x70, 0x0a, 0x23, 0x69, 0x6f, 0x2e, 0x77, 0x65, 0x61, 0x76, 0x69, 0x61,
+	0x74, 0x65, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x76, 0x31, 0x42, 0x13, 0x57, 0x65, 0x61, 0x76,
+	0x69, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5a,
+	0x34, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x77, 0x65, 0x61, 0x76,
+	0x69, 0x61, 0x74, 0x65, 0x2f, 0x77, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x2f, 0x67, 0x72,
+	0x70, 0x63, 0x2f, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x3b, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_v1_search_proto_rawDescOnce sync.Once
+	file_v1_search_proto_rawDescData = file_v1_search_proto_rawDesc
+)
+
+func file_v1_search_proto_rawDescGZIP() []byte {
+	file_v1_search_proto_rawDescOnce.Do(func() {
+		file_v1_search_proto_rawDescData = protoimpl.X.CompressGZIP(file_v1_search_proto_rawDescData)
+	})
+	return file_v1_search_proto_rawDescData
+}
+
+var file_v1_search_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_v1_search_proto_goTypes = []interface{}{
+	(*JoinedSearchRequest)(nil), // 0: weaviate.v1.JoinedSearchRequest
+	(*JoinClause)(nil),          // 1: weaviate.v1.JoinClause
+	(*SearchRequest)(nil),       // 2: weaviate.v1.SearchRequest
+	(*PropertiesRequest)(nil),   // 3: weaviate.v1.PropertiesRequest
+}
+var file_v1_search_proto_depIdxs = []int32{
+	2, // 0: weaviate.v1.JoinedSearchRequest.search:type_name -> weaviate.v1.SearchRequest
+	1, // 1: weaviate.v1.JoinedSearchRequest.joins:type_name -> weaviate.v1.JoinClause
+	3, // 2: weaviate.v1.JoinClause.returned_properties:type_name -> weaviate.v1.PropertiesRequest
+	1, // 3: weaviate.v1.JoinClause.joins:type_name -> weaviate.v1.JoinClause
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_v1_search_proto_init() }
+func file_v1_search_proto_init() {
+	if File_v1_search_proto != nil {
+		return
+	}
+	file_v1_search_get_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_v1_search_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*JoinedSearchRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_search_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*JoinClause); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_v1_search_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_v1_search_proto_goTypes,
+		DependencyIndexes: file_v1_search_proto_depIdxs,
+		MessageInfos:      file_v1_search_proto_msgTypes,
+	}.Build()
+	File_v1_search_proto = out.File
+	file_v1_search_proto_rawDesc = nil
+	file_v1_search_proto_goTypes = nil
+	file_v1_search_proto_depIdxs = nil
+}
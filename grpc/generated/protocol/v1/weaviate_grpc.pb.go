@@ -20,9 +20,16 @@ const _ = grpc.SupportPackageIsVersion7
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type WeaviateClient interface {
 	Search(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*SearchReply, error)
+	JoinedSearch(ctx context.Context, in *JoinedSearchRequest, opts ...grpc.CallOption) (*SearchReply, error)
 	BatchObjects(ctx context.Context, in *BatchObjectsRequest, opts ...grpc.CallOption) (*BatchObjectsReply, error)
 	BatchDelete(ctx context.Context, in *BatchDeleteRequest, opts ...grpc.CallOption) (*BatchDeleteReply, error)
+	MultiBatchDelete(ctx context.Context, in *MultiBatchDeleteRequest, opts ...grpc.CallOption) (*MultiBatchDeleteReply, error)
+	ListBatchDeleteHistory(ctx context.Context, in *ListBatchDeleteHistoryRequest, opts ...grpc.CallOption) (*ListBatchDeleteHistoryReply, error)
+	GetBatchDeleteJob(ctx context.Context, in *GetBatchDeleteJobRequest, opts ...grpc.CallOption) (*GetBatchDeleteJobReply, error)
 	TenantsGet(ctx context.Context, in *TenantsGetRequest, opts ...grpc.CallOption) (*TenantsGetReply, error)
+	StreamSchema(ctx context.Context, in *StreamSchemaRequest, opts ...grpc.CallOption) (Weaviate_StreamSchemaClient, error)
+	UpdateClass(ctx context.Context, in *UpdateClassRequest, opts ...grpc.CallOption) (*UpdateClassReply, error)
+	StreamClassInfo(ctx context.Context, in *StreamClassInfoRequest, opts ...grpc.CallOption) (Weaviate_StreamClassInfoClient, error)
 }
 
 type weaviateClient struct {
@@ -42,6 +49,15 @@ func (c *weaviateClient) Search(ctx context.Context, in *SearchRequest, opts ...
 	return out, nil
 }
 
+func (c *weaviateClient) JoinedSearch(ctx context.Context, in *JoinedSearchRequest, opts ...grpc.CallOption) (*SearchReply, error) {
+	out := new(SearchReply)
+	err := c.cc.Invoke(ctx, "/weaviate.v1.Weaviate/JoinedSearch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *weaviateClient) BatchObjects(ctx context.Context, in *BatchObjectsRequest, opts ...grpc.CallOption) (*BatchObjectsReply, error) {
 	out := new(BatchObjectsReply)
 	err := c.cc.Invoke(ctx, "/weaviate.v1.Weaviate/BatchObjects", in, out, opts...)
@@ -60,6 +76,33 @@ func (c *weaviateClient) BatchDelete(ctx context.Context, in *BatchDeleteRequest
 	return out, nil
 }
 
+func (c *weaviateClient) MultiBatchDelete(ctx context.Context, in *MultiBatchDeleteRequest, opts ...grpc.CallOption) (*MultiBatchDeleteReply, error) {
+	out := new(MultiBatchDeleteReply)
+	err := c.cc.Invoke(ctx, "/weaviate.v1.Weaviate/MultiBatchDelete", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *weaviateClient) ListBatchDeleteHistory(ctx context.Context, in *ListBatchDeleteHistoryRequest, opts ...grpc.CallOption) (*ListBatchDeleteHistoryReply, error) {
+	out := new(ListBatchDeleteHistoryReply)
+	err := c.cc.Invoke(ctx, "/weaviate.v1.Weaviate/ListBatchDeleteHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *weaviateClient) GetBatchDeleteJob(ctx context.Context, in *GetBatchDeleteJobRequest, opts ...grpc.CallOption) (*GetBatchDeleteJobReply, error) {
+	out := new(GetBatchDeleteJobReply)
+	err := c.cc.Invoke(ctx, "/weaviate.v1.Weaviate/GetBatchDeleteJob", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *weaviateClient) TenantsGet(ctx context.Context, in *TenantsGetRequest, opts ...grpc.CallOption) (*TenantsGetReply, error) {
 	out := new(TenantsGetReply)
 	err := c.cc.Invoke(ctx, "/weaviate.v1.Weaviate/TenantsGet", in, out, opts...)
@@ -69,14 +112,94 @@ func (c *weaviateClient) TenantsGet(ctx context.Context, in *TenantsGetRequest,
 	return out, nil
 }
 
+func (c *weaviateClient) StreamSchema(ctx context.Context, in *StreamSchemaRequest, opts ...grpc.CallOption) (Weaviate_StreamSchemaClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Weaviate_ServiceDesc.Streams[0], "/weaviate.v1.Weaviate/StreamSchema", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &weaviateStreamSchemaClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Weaviate_StreamSchemaClient interface {
+	Recv() (*StreamSchemaChunk, error)
+	grpc.ClientStream
+}
+
+type weaviateStreamSchemaClient struct {
+	grpc.ClientStream
+}
+
+func (x *weaviateStreamSchemaClient) Recv() (*StreamSchemaChunk, error) {
+	m := new(StreamSchemaChunk)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *weaviateClient) UpdateClass(ctx context.Context, in *UpdateClassRequest, opts ...grpc.CallOption) (*UpdateClassReply, error) {
+	out := new(UpdateClassReply)
+	err := c.cc.Invoke(ctx, "/weaviate.v1.Weaviate/UpdateClass", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *weaviateClient) StreamClassInfo(ctx context.Context, in *StreamClassInfoRequest, opts ...grpc.CallOption) (Weaviate_StreamClassInfoClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Weaviate_ServiceDesc.Streams[1], "/weaviate.v1.Weaviate/StreamClassInfo", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &weaviateStreamClassInfoClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Weaviate_StreamClassInfoClient interface {
+	Recv() (*StreamClassInfoReply, error)
+	grpc.ClientStream
+}
+
+type weaviateStreamClassInfoClient struct {
+	grpc.ClientStream
+}
+
+func (x *weaviateStreamClassInfoClient) Recv() (*StreamClassInfoReply, error) {
+	m := new(StreamClassInfoReply)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // WeaviateServer is the server API for Weaviate service.
 // All implementations must embed UnimplementedWeaviateServer
 // for forward compatibility
 type WeaviateServer interface {
 	Search(context.Context, *SearchRequest) (*SearchReply, error)
+	JoinedSearch(context.Context, *JoinedSearchRequest) (*SearchReply, error)
 	BatchObjects(context.Context, *BatchObjectsRequest) (*BatchObjectsReply, error)
 	BatchDelete(context.Context, *BatchDeleteRequest) (*BatchDeleteReply, error)
+	MultiBatchDelete(context.Context, *MultiBatchDeleteRequest) (*MultiBatchDeleteReply, error)
+	ListBatchDeleteHistory(context.Context, *ListBatchDeleteHistoryRequest) (*ListBatchDeleteHistoryReply, error)
+	GetBatchDeleteJob(context.Context, *GetBatchDeleteJobRequest) (*GetBatchDeleteJobReply, error)
 	TenantsGet(context.Context, *TenantsGetRequest) (*TenantsGetReply, error)
+	StreamSchema(*StreamSchemaRequest, Weaviate_StreamSchemaServer) error
+	UpdateClass(context.Context, *UpdateClassRequest) (*UpdateClassReply, error)
+	StreamClassInfo(*StreamClassInfoRequest, Weaviate_StreamClassInfoServer) error
 	mustEmbedUnimplementedWeaviateServer()
 }
 
@@ -87,15 +210,36 @@ type UnimplementedWeaviateServer struct {
 func (UnimplementedWeaviateServer) Search(context.Context, *SearchRequest) (*SearchReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Search not implemented")
 }
+func (UnimplementedWeaviateServer) JoinedSearch(context.Context, *JoinedSearchRequest) (*SearchReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method JoinedSearch not implemented")
+}
 func (UnimplementedWeaviateServer) BatchObjects(context.Context, *BatchObjectsRequest) (*BatchObjectsReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BatchObjects not implemented")
 }
 func (UnimplementedWeaviateServer) BatchDelete(context.Context, *BatchDeleteRequest) (*BatchDeleteReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BatchDelete not implemented")
 }
+func (UnimplementedWeaviateServer) MultiBatchDelete(context.Context, *MultiBatchDeleteRequest) (*MultiBatchDeleteReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MultiBatchDelete not implemented")
+}
+func (UnimplementedWeaviateServer) ListBatchDeleteHistory(context.Context, *ListBatchDeleteHistoryRequest) (*ListBatchDeleteHistoryReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBatchDeleteHistory not implemented")
+}
+func (UnimplementedWeaviateServer) GetBatchDeleteJob(context.Context, *GetBatchDeleteJobRequest) (*GetBatchDeleteJobReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBatchDeleteJob not implemented")
+}
 func (UnimplementedWeaviateServer) TenantsGet(context.Context, *TenantsGetRequest) (*TenantsGetReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TenantsGet not implemented")
 }
+func (UnimplementedWeaviateServer) StreamSchema(*StreamSchemaRequest, Weaviate_StreamSchemaServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamSchema not implemented")
+}
+func (UnimplementedWeaviateServer) UpdateClass(context.Context, *UpdateClassRequest) (*UpdateClassReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateClass not implemented")
+}
+func (UnimplementedWeaviateServer) StreamClassInfo(*StreamClassInfoRequest, Weaviate_StreamClassInfoServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamClassInfo not implemented")
+}
 func (UnimplementedWeaviateServer) mustEmbedUnimplementedWeaviateServer() {}
 
 // UnsafeWeaviateServer may be embedded to opt out of forward compatibility for this service.
@@ -127,6 +271,24 @@ func _Weaviate_Search_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Weaviate_JoinedSearch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JoinedSearchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WeaviateServer).JoinedSearch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/weaviate.v1.Weaviate/JoinedSearch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WeaviateServer).JoinedSearch(ctx, req.(*JoinedSearchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Weaviate_BatchObjects_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(BatchObjectsRequest)
 	if err := dec(in); err != nil {
@@ -163,6 +325,60 @@ func _Weaviate_BatchDelete_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Weaviate_MultiBatchDelete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MultiBatchDeleteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WeaviateServer).MultiBatchDelete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/weaviate.v1.Weaviate/MultiBatchDelete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WeaviateServer).MultiBatchDelete(ctx, req.(*MultiBatchDeleteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Weaviate_ListBatchDeleteHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBatchDeleteHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WeaviateServer).ListBatchDeleteHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/weaviate.v1.Weaviate/ListBatchDeleteHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WeaviateServer).ListBatchDeleteHistory(ctx, req.(*ListBatchDeleteHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Weaviate_GetBatchDeleteJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBatchDeleteJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WeaviateServer).GetBatchDeleteJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/weaviate.v1.Weaviate/GetBatchDeleteJob",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WeaviateServer).GetBatchDeleteJob(ctx, req.(*GetBatchDeleteJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Weaviate_TenantsGet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(TenantsGetRequest)
 	if err := dec(in); err != nil {
@@ -181,6 +397,66 @@ func _Weaviate_TenantsGet_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Weaviate_StreamSchema_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamSchemaRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(WeaviateServer).StreamSchema(m, &weaviateStreamSchemaServer{stream})
+}
+
+type Weaviate_StreamSchemaServer interface {
+	Send(*StreamSchemaChunk) error
+	grpc.ServerStream
+}
+
+type weaviateStreamSchemaServer struct {
+	grpc.ServerStream
+}
+
+func (x *weaviateStreamSchemaServer) Send(m *StreamSchemaChunk) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Weaviate_UpdateClass_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateClassRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WeaviateServer).UpdateClass(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/weaviate.v1.Weaviate/UpdateClass",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WeaviateServer).UpdateClass(ctx, req.(*UpdateClassRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Weaviate_StreamClassInfo_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamClassInfoRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(WeaviateServer).StreamClassInfo(m, &weaviateStreamClassInfoServer{stream})
+}
+
+type Weaviate_StreamClassInfoServer interface {
+	Send(*StreamClassInfoReply) error
+	grpc.ServerStream
+}
+
+type weaviateStreamClassInfoServer struct {
+	grpc.ServerStream
+}
+
+func (x *weaviateStreamClassInfoServer) Send(m *StreamClassInfoReply) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 // Weaviate_ServiceDesc is the grpc.ServiceDesc for Weaviate service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -192,6 +468,10 @@ var Weaviate_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Search",
 			Handler:    _Weaviate_Search_Handler,
 		},
+		{
+			MethodName: "JoinedSearch",
+			Handler:    _Weaviate_JoinedSearch_Handler,
+		},
 		{
 			MethodName: "BatchObjects",
 			Handler:    _Weaviate_BatchObjects_Handler,
@@ -200,11 +480,38 @@ var Weaviate_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "BatchDelete",
 			Handler:    _Weaviate_BatchDelete_Handler,
 		},
+		{
+			MethodName: "MultiBatchDelete",
+			Handler:    _Weaviate_MultiBatchDelete_Handler,
+		},
+		{
+			MethodName: "ListBatchDeleteHistory",
+			Handler:    _Weaviate_ListBatchDeleteHistory_Handler,
+		},
+		{
+			MethodName: "GetBatchDeleteJob",
+			Handler:    _Weaviate_GetBatchDeleteJob_Handler,
+		},
 		{
 			MethodName: "TenantsGet",
 			Handler:    _Weaviate_TenantsGet_Handler,
 		},
+		{
+			MethodName: "UpdateClass",
+			Handler:    _Weaviate_UpdateClass_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamSchema",
+			Handler:       _Weaviate_StreamSchema_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "StreamClassInfo",
+			Handler:       _Weaviate_StreamClassInfo_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "v1/weaviate.proto",
 }
@@ -0,0 +1,101 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package health
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+)
+
+// ServiceName is the service reported by the HealthServer besides the
+// overall server health, which is requested with an empty service name
+const ServiceName = "weaviate.v1.Weaviate"
+
+// DefaultWatchInterval is how often Watch checks for status changes
+const DefaultWatchInterval = time.Second
+
+// LeaderReader returns the address and ID of the current raft leader of the
+// schema store, empty strings if there is none or it is unknown
+type LeaderReader interface {
+	LeaderWithID() (string, string)
+}
+
+// HealthServer implements the gRPC Health Checking Protocol. The server is
+// SERVING as long as the node knows the leader of the schema store, as it
+// cannot serve schema changes or consistent reads otherwise.
+type HealthServer struct {
+	grpc_health_v1.UnimplementedHealthServer
+
+	leader        LeaderReader
+	watchInterval time.Duration
+}
+
+func NewHealthServer(leader LeaderReader, watchInterval time.Duration) *HealthServer {
+	if watchInterval <= 0 {
+		watchInterval = DefaultWatchInterval
+	}
+	return &HealthServer{leader: leader, watchInterval: watchInterval}
+}
+
+func (s *HealthServer) Check(ctx context.Context, req *grpc_health_v1.HealthCheckRequest) (*grpc_health_v1.HealthCheckResponse, error) {
+	if !knownService(req.Service) {
+		return nil, status.Errorf(codes.NotFound, "unknown service %q", req.Service)
+	}
+	return &grpc_health_v1.HealthCheckResponse{Status: s.status()}, nil
+}
+
+// Watch sends the current status and then every change of it until the
+// client cancels the stream. Unknown services are reported as
+// SERVICE_UNKNOWN, as required by the protocol.
+func (s *HealthServer) Watch(req *grpc_health_v1.HealthCheckRequest, stream grpc_health_v1.Health_WatchServer) error {
+	current := grpc_health_v1.HealthCheckResponse_SERVICE_UNKNOWN
+	if knownService(req.Service) {
+		current = s.status()
+	}
+	if err := stream.Send(&grpc_health_v1.HealthCheckResponse{Status: current}); err != nil {
+		return err
+	}
+
+	ticker := time.NewTicker(s.watchInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-stream.Context().Done():
+			return status.Error(codes.Canceled, "stream has ended")
+		case <-ticker.C:
+			if !knownService(req.Service) {
+				continue
+			}
+			if next := s.status(); next != current {
+				current = next
+				if err := stream.Send(&grpc_health_v1.HealthCheckResponse{Status: current}); err != nil {
+					return err
+				}
+			}
+		}
+	}
+}
+
+func (s *HealthServer) status() grpc_health_v1.HealthCheckResponse_ServingStatus {
+	if addr, _ := s.leader.LeaderWithID(); addr == "" {
+		return grpc_health_v1.HealthCheckResponse_NOT_SERVING
+	}
+	return grpc_health_v1.HealthCheckResponse_SERVING
+}
+
+func knownService(service string) bool {
+	return service == "" || service == ServiceName
+}
@@ -0,0 +1,124 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package health
+
+import (
+	"context"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+type fakeLeader struct {
+	sync.Mutex
+	addr string
+}
+
+func (f *fakeLeader) LeaderWithID() (string, string) {
+	f.Lock()
+	defer f.Unlock()
+	return f.addr, f.addr
+}
+
+func (f *fakeLeader) set(addr string) {
+	f.Lock()
+	defer f.Unlock()
+	f.addr = addr
+}
+
+func newTestClient(t *testing.T, leader LeaderReader) grpc_health_v1.HealthClient {
+	lis := bufconn.Listen(1024 * 1024)
+	s := grpc.NewServer()
+	grpc_health_v1.RegisterHealthServer(s, NewHealthServer(leader, 10*time.Millisecond))
+	go s.Serve(lis)
+	t.Cleanup(s.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return lis.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.Nil(t, err)
+	t.Cleanup(func() { conn.Close() })
+	return grpc_health_v1.NewHealthClient(conn)
+}
+
+func TestHealthServerCheck(t *testing.T) {
+	ctx := context.Background()
+	leader := &fakeLeader{addr: "node1:8300"}
+	client := newTestClient(t, leader)
+
+	for _, service := range []string{"", ServiceName} {
+		resp, err := client.Check(ctx, &grpc_health_v1.HealthCheckRequest{Service: service})
+		require.Nil(t, err)
+		assert.Equal(t, grpc_health_v1.HealthCheckResponse_SERVING, resp.Status)
+	}
+
+	leader.set("")
+	resp, err := client.Check(ctx, &grpc_health_v1.HealthCheckRequest{})
+	require.Nil(t, err)
+	assert.Equal(t, grpc_health_v1.HealthCheckResponse_NOT_SERVING, resp.Status)
+
+	_, err = client.Check(ctx, &grpc_health_v1.HealthCheckRequest{Service: "unknown"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestHealthServerWatchLeaderElection(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	leader := &fakeLeader{addr: "node1:8300"}
+	client := newTestClient(t, leader)
+
+	stream, err := client.Watch(ctx, &grpc_health_v1.HealthCheckRequest{Service: ServiceName})
+	require.Nil(t, err)
+	next := func() grpc_health_v1.HealthCheckResponse_ServingStatus {
+		resp, err := stream.Recv()
+		require.Nil(t, err)
+		return resp.Status
+	}
+
+	assert.Equal(t, grpc_health_v1.HealthCheckResponse_SERVING, next())
+
+	// the leader is lost while a new one is elected
+	leader.set("")
+	assert.Equal(t, grpc_health_v1.HealthCheckResponse_NOT_SERVING, next())
+
+	// a new leader was elected, changing the leader only does not affect
+	// the status
+	leader.set("node2:8300")
+	assert.Equal(t, grpc_health_v1.HealthCheckResponse_SERVING, next())
+	leader.set("node3:8300")
+	leader.set("")
+	assert.Equal(t, grpc_health_v1.HealthCheckResponse_NOT_SERVING, next())
+}
+
+func TestHealthServerWatchUnknownService(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	client := newTestClient(t, &fakeLeader{addr: "node1:8300"})
+
+	stream, err := client.Watch(ctx, &grpc_health_v1.HealthCheckRequest{Service: "unknown"})
+	require.Nil(t, err)
+	resp, err := stream.Recv()
+	require.Nil(t, err)
+	assert.Equal(t, grpc_health_v1.HealthCheckResponse_SERVICE_UNKNOWN, resp.Status)
+}
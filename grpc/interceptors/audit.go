@@ -0,0 +1,191 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package interceptors
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	pb "github.com/weaviate/weaviate/grpc/generated/protocol/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// AuditInterceptor writes an audit record of every batch delete, including
+// dry runs and failed requests, as a line of JSON.
+type AuditInterceptor struct {
+	sync.Mutex
+	w             io.Writer
+	redactFilters bool
+	now           func() time.Time
+}
+
+// NewAuditInterceptor writes the audit records to w. Records contain the
+// filters of the request unless redactFilters is set.
+func NewAuditInterceptor(w io.Writer, redactFilters bool) *AuditInterceptor {
+	return &AuditInterceptor{w: w, redactFilters: redactFilters, now: time.Now}
+}
+
+type auditRecord struct {
+	Timestamp        time.Time       `json:"timestamp"`
+	Peer             string          `json:"peer"`
+	Collection       string          `json:"collection"`
+	Tenant           string          `json:"tenant,omitempty"`
+	Tenants          []string        `json:"tenants,omitempty"`
+	DryRun           bool            `json:"dry_run"`
+	ConsistencyLevel string          `json:"consistency_level,omitempty"`
+	Filters          json.RawMessage `json:"filters,omitempty"`
+	Matches          int64           `json:"matches"`
+	Successful       int64           `json:"successful"`
+	Failed           int64           `json:"failed"`
+	DurationMs       float64         `json:"duration_ms"`
+	Error            string          `json:"error,omitempty"`
+}
+
+// Unary returns the interceptor to chain into the gRPC server. It records the
+// request after it has been handled. Failing to write a record does not fail
+// the request.
+func (a *AuditInterceptor) Unary() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		r, ok := req.(*pb.BatchDeleteRequest)
+		if !ok {
+			return handler(ctx, req)
+		}
+
+		start := a.now()
+		reply, err := handler(ctx, req)
+		a.write(ctx, r, reply, err, start)
+		return reply, err
+	}
+}
+
+func (a *AuditInterceptor) write(ctx context.Context, req *pb.BatchDeleteRequest, reply any, err error, start time.Time) {
+	record := auditRecord{
+		Timestamp:  start.UTC(),
+		Collection: req.Collection,
+		Tenant:     req.GetTenant(),
+		Tenants:    req.GetTenantList().GetTenants(),
+		DryRun:     req.DryRun,
+		DurationMs: float64(a.now().Sub(start).Microseconds()) / 1000,
+	}
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		record.Peer = p.Addr.String()
+	}
+	if req.ConsistencyLevel != nil {
+		record.ConsistencyLevel = req.ConsistencyLevel.String()
+	}
+	if !a.redactFilters && req.Filters != nil {
+		if filters, mErr := protojson.Marshal(req.Filters); mErr == nil {
+			record.Filters = filters
+		}
+	}
+	if res, ok := reply.(*pb.BatchDeleteReply); ok && res != nil {
+		record.Matches = res.Matches
+		record.Successful = res.Successful
+		record.Failed = res.Failed
+	}
+	if err != nil {
+		record.Error = err.Error()
+	}
+
+	line, mErr := json.Marshal(record)
+	if mErr != nil {
+		return
+	}
+	a.Lock()
+	defer a.Unlock()
+	a.w.Write(append(line, '\n'))
+}
+
+// NewLogrusAuditWriter returns a writer which logs every audit record written
+// to it as info message of logger
+func NewLogrusAuditWriter(logger *logrus.Logger) io.Writer {
+	return &logrusAuditWriter{logger: logger}
+}
+
+type logrusAuditWriter struct {
+	logger *logrus.Logger
+}
+
+func (w *logrusAuditWriter) Write(p []byte) (int, error) {
+	w.logger.WithField("action", "grpc_audit").Info(strings.TrimSuffix(string(p), "\n"))
+	return len(p), nil
+}
+
+// DailyFileWriter appends to a file which is replaced by a new one every day
+// (UTC). The date is added to the name of the file, e.g. audit.log is written
+// to audit-2024-01-31.log on January 31st.
+type DailyFileWriter struct {
+	sync.Mutex
+	path string
+	now  func() time.Time
+	date string
+	file *os.File
+}
+
+// NewDailyFileWriter returns a writer for path. Its directory is created on
+// the first write if it does not exist.
+func NewDailyFileWriter(path string) *DailyFileWriter {
+	return &DailyFileWriter{path: path, now: time.Now}
+}
+
+func (w *DailyFileWriter) Write(p []byte) (int, error) {
+	w.Lock()
+	defer w.Unlock()
+
+	date := w.now().UTC().Format(time.DateOnly)
+	if w.file == nil || w.date != date {
+		if err := w.open(date); err != nil {
+			return 0, err
+		}
+	}
+	return w.file.Write(p)
+}
+
+func (w *DailyFileWriter) open(date string) error {
+	if w.file != nil {
+		w.file.Close()
+		w.file = nil
+	}
+	if err := os.MkdirAll(filepath.Dir(w.path), 0o755); err != nil {
+		return fmt.Errorf("create audit log directory: %w", err)
+	}
+	ext := filepath.Ext(w.path)
+	name := fmt.Sprintf("%s-%s%s", strings.TrimSuffix(w.path, ext), date, ext)
+	f, err := os.OpenFile(name, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o600)
+	if err != nil {
+		return fmt.Errorf("open audit log: %w", err)
+	}
+	w.file, w.date = f, date
+	return nil
+}
+
+// Close closes the current file
+func (w *DailyFileWriter) Close() error {
+	w.Lock()
+	defer w.Unlock()
+	if w.file == nil {
+		return nil
+	}
+	err := w.file.Close()
+	w.file = nil
+	return err
+}
@@ -0,0 +1,125 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package interceptors
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	pb "github.com/weaviate/weaviate/grpc/generated/protocol/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/peer"
+)
+
+func TestAuditInterceptor(t *testing.T) {
+	now := time.Date(2024, 1, 31, 12, 0, 0, 0, time.UTC)
+	info := &grpc.UnaryServerInfo{FullMethod: "/weaviate.v1.Weaviate/BatchDelete"}
+	ctx := peer.NewContext(context.Background(), &peer.Peer{
+		Addr: &net.TCPAddr{IP: net.IPv4(10, 0, 0, 1), Port: 4242},
+	})
+	quorum := pb.ConsistencyLevel_CONSISTENCY_LEVEL_QUORUM
+	req := &pb.BatchDeleteRequest{
+		Collection:       "C",
+		DryRun:           true,
+		ConsistencyLevel: &quorum,
+		TenantSelection:  &pb.BatchDeleteRequest_Tenant{Tenant: "t1"},
+		Filters: &pb.Filters{
+			Operator:  pb.Filters_OPERATOR_EQUAL,
+			On:        []string{"name"},
+			TestValue: &pb.Filters_ValueText{ValueText: "secret"},
+		},
+	}
+
+	call := func(redact bool, req any, reply any, err error) map[string]any {
+		var buf bytes.Buffer
+		audit := NewAuditInterceptor(&buf, redact)
+		calls := 0
+		audit.now = func() time.Time {
+			calls++
+			return now.Add(time.Duration(calls-1) * 1500 * time.Microsecond)
+		}
+		gotReply, gotErr := audit.Unary()(ctx, req, info, func(ctx context.Context, req any) (any, error) {
+			return reply, err
+		})
+		assert.Equal(t, reply, gotReply)
+		assert.Equal(t, err, gotErr)
+		if buf.Len() == 0 {
+			return nil
+		}
+		var record map[string]any
+		require.Nil(t, json.Unmarshal(buf.Bytes(), &record))
+		return record
+	}
+
+	t.Run("records batch deletes", func(t *testing.T) {
+		record := call(false, req, &pb.BatchDeleteReply{Matches: 3, Successful: 2, Failed: 1}, nil)
+		assert.Equal(t, "2024-01-31T12:00:00Z", record["timestamp"])
+		assert.Equal(t, "10.0.0.1:4242", record["peer"])
+		assert.Equal(t, "C", record["collection"])
+		assert.Equal(t, "t1", record["tenant"])
+		assert.Equal(t, true, record["dry_run"])
+		assert.Equal(t, "CONSISTENCY_LEVEL_QUORUM", record["consistency_level"])
+		assert.Equal(t, float64(3), record["matches"])
+		assert.Equal(t, float64(2), record["successful"])
+		assert.Equal(t, float64(1), record["failed"])
+		assert.Equal(t, 1.5, record["duration_ms"])
+		assert.Contains(t, record["filters"], "on")
+		assert.NotContains(t, record, "error")
+	})
+
+	t.Run("redacts filters", func(t *testing.T) {
+		record := call(true, req, &pb.BatchDeleteReply{}, nil)
+		assert.NotContains(t, record, "filters")
+	})
+
+	t.Run("records failed requests", func(t *testing.T) {
+		record := call(false, req, nil, errors.New("no such class"))
+		assert.Equal(t, "no such class", record["error"])
+		assert.Equal(t, float64(0), record["matches"])
+	})
+
+	t.Run("ignores other requests", func(t *testing.T) {
+		assert.Nil(t, call(false, &pb.SearchRequest{Collection: "C"}, &pb.SearchReply{}, nil))
+	})
+}
+
+func TestDailyFileWriter(t *testing.T) {
+	dir := t.TempDir()
+	now := time.Date(2024, 1, 31, 23, 59, 0, 0, time.UTC)
+	w := NewDailyFileWriter(filepath.Join(dir, "audit", "audit.log"))
+	w.now = func() time.Time { return now }
+	defer w.Close()
+
+	_, err := w.Write([]byte("first\n"))
+	require.Nil(t, err)
+	_, err = w.Write([]byte("second\n"))
+	require.Nil(t, err)
+	now = now.Add(time.Minute)
+	_, err = w.Write([]byte("third\n"))
+	require.Nil(t, err)
+
+	content, err := os.ReadFile(filepath.Join(dir, "audit", "audit-2024-01-31.log"))
+	require.Nil(t, err)
+	assert.Equal(t, "first\nsecond\n", string(content))
+	content, err = os.ReadFile(filepath.Join(dir, "audit", "audit-2024-02-01.log"))
+	require.Nil(t, err)
+	assert.Equal(t, "third\n", string(content))
+}
@@ -0,0 +1,143 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package interceptors
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	pb "github.com/weaviate/weaviate/grpc/generated/protocol/v1"
+	"go.opentelemetry.io/otel/attribute"
+	otelcodes "go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func newTestProvider() (*sdktrace.TracerProvider, *tracetest.InMemoryExporter) {
+	exporter := tracetest.NewInMemoryExporter()
+	return sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter)), exporter
+}
+
+func attributes(span tracetest.SpanStub) map[attribute.Key]attribute.Value {
+	attrs := map[attribute.Key]attribute.Value{}
+	for _, kv := range span.Attributes {
+		attrs[kv.Key] = kv.Value
+	}
+	return attrs
+}
+
+func TestUnaryServerInterceptor(t *testing.T) {
+	const (
+		traceID = "4bf92f3577b34da6a3ce929d0e0e4736"
+		spanID  = "00f067aa0ba902b7"
+	)
+	info := &grpc.UnaryServerInfo{FullMethod: "/weaviate.v1.Weaviate/BatchDelete"}
+
+	t.Run("successful request", func(t *testing.T) {
+		tp, exporter := newTestProvider()
+		interceptor := unaryServerInterceptor(tp)
+
+		ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(
+			"traceparent", "00-"+traceID+"-"+spanID+"-01",
+		))
+		var handlerSpan trace.SpanContext
+		_, err := interceptor(ctx, &pb.BatchDeleteRequest{Collection: "Books"}, info,
+			func(ctx context.Context, req any) (any, error) {
+				handlerSpan = trace.SpanContextFromContext(ctx)
+				return &pb.BatchDeleteReply{}, nil
+			})
+		require.Nil(t, err)
+
+		spans := exporter.GetSpans()
+		require.Len(t, spans, 1)
+		span := spans[0]
+		assert.Equal(t, "weaviate.v1.Weaviate/BatchDelete", span.Name)
+		assert.Equal(t, trace.SpanKindServer, span.SpanKind)
+		assert.Equal(t, traceID, span.SpanContext.TraceID().String())
+		assert.Equal(t, spanID, span.Parent.SpanID().String())
+		assert.True(t, span.Parent.IsRemote())
+		assert.Equal(t, span.SpanContext.SpanID(), handlerSpan.SpanID(), "handler must see the new span")
+		assert.Equal(t, otelcodes.Unset, span.Status.Code)
+
+		attrs := attributes(span)
+		assert.Equal(t, "Books", attrs[CollectionKey].AsString())
+		assert.Equal(t, "weaviate.v1.Weaviate", attrs[semconv.RPCServiceKey].AsString())
+		assert.Equal(t, "BatchDelete", attrs[semconv.RPCMethodKey].AsString())
+		assert.Equal(t, int64(codes.OK), attrs[semconv.RPCGRPCStatusCodeKey].AsInt64())
+	})
+
+	t.Run("failed request without trace context", func(t *testing.T) {
+		tp, exporter := newTestProvider()
+		interceptor := unaryServerInterceptor(tp)
+
+		_, err := interceptor(context.Background(), &pb.BatchDeleteRequest{}, info,
+			func(ctx context.Context, req any) (any, error) {
+				return nil, status.Error(codes.NotFound, "no such collection")
+			})
+		require.NotNil(t, err)
+
+		spans := exporter.GetSpans()
+		require.Len(t, spans, 1)
+		span := spans[0]
+		assert.False(t, span.Parent.IsValid(), "must start a new trace")
+		assert.Equal(t, otelcodes.Error, span.Status.Code)
+		assert.Equal(t, "no such collection", span.Status.Description)
+
+		attrs := attributes(span)
+		assert.NotContains(t, attrs, CollectionKey)
+		assert.Equal(t, int64(codes.NotFound), attrs[semconv.RPCGRPCStatusCodeKey].AsInt64())
+	})
+}
+
+type fakeServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *fakeServerStream) Context() context.Context {
+	return s.ctx
+}
+
+func TestStreamServerInterceptor(t *testing.T) {
+	tp, exporter := newTestProvider()
+	interceptor := streamServerInterceptor(tp)
+
+	info := &grpc.StreamServerInfo{FullMethod: "/weaviate.v1.Weaviate/Stream"}
+	var handlerSpan trace.SpanContext
+	err := interceptor(nil, &fakeServerStream{ctx: context.Background()}, info,
+		func(srv any, stream grpc.ServerStream) error {
+			handlerSpan = trace.SpanContextFromContext(stream.Context())
+			return status.Error(codes.Unavailable, "shutting down")
+		})
+	require.NotNil(t, err)
+
+	spans := exporter.GetSpans()
+	require.Len(t, spans, 1)
+	span := spans[0]
+	assert.Equal(t, "weaviate.v1.Weaviate/Stream", span.Name)
+	assert.Equal(t, span.SpanContext.SpanID(), handlerSpan.SpanID(), "stream must carry the new span")
+	assert.Equal(t, otelcodes.Error, span.Status.Code)
+	assert.Equal(t, int64(codes.Unavailable), attributes(span)[semconv.RPCGRPCStatusCodeKey].AsInt64())
+}
+
+func TestWeaviateOTelInterceptors(t *testing.T) {
+	assert.Empty(t, WeaviateOTelInterceptors(false))
+	assert.Len(t, WeaviateOTelInterceptors(true), 2)
+}
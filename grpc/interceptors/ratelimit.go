@@ -0,0 +1,201 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package interceptors
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	pb "github.com/weaviate/weaviate/grpc/generated/protocol/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// DefaultRateLimitClass is the key of GRPC.ClassRateLimits whose limit is
+	// shared by the batch deletes of all collections without a limit of
+	// their own
+	DefaultRateLimitClass = "*"
+	// RetryAfterMetadataKey is set in the trailer of rejected requests to the
+	// number of seconds after which the request can be retried
+	RetryAfterMetadataKey = "retry-after"
+)
+
+// PrincipalFunc returns the principal authenticated by the credentials of
+// the incoming request
+type PrincipalFunc func(ctx context.Context) (*models.Principal, error)
+
+// RateLimitUnaryServerInterceptor limits the number of objects batch deletes
+// may match per second. The limits map a name to the number of objects
+// replenished per second, which also is the maximum burst. A request is
+// counted against the first of these limits which is positive:
+//
+//   - principalLimits of the username of the principal returned by principal
+//   - classLimits of the collection of the request
+//   - classLimits of DefaultRateLimitClass, shared by all such collections
+//
+// Requests without any of these limits are not limited. The collections of
+// classLimits are matched case-insensitively on their first letter, like
+// class names.
+//
+// The objects matched by a request are only known after it has been handled,
+// so a request is admitted as long as its limit has tokens left and its
+// matches are deducted afterwards, possibly leaving the limit in debt. Once
+// exhausted, requests are rejected with codes.ResourceExhausted until enough
+// tokens are replenished.
+func RateLimitUnaryServerInterceptor(classLimits, principalLimits map[string]int64,
+	principal PrincipalFunc,
+) grpc.UnaryServerInterceptor {
+	return newRateLimiter(classLimits, principalLimits, principal, time.Now).intercept
+}
+
+type rateLimiter struct {
+	sync.Mutex
+	classLimits     map[string]int64
+	principalLimits map[string]int64
+	principal       PrincipalFunc
+	buckets         map[rateLimitKey]*tokenBucket
+	now             func() time.Time
+}
+
+// rateLimitKey identifies a bucket, either of a principal or of a class
+type rateLimitKey struct {
+	principal bool
+	name      string
+}
+
+func (k rateLimitKey) String() string {
+	if k.principal {
+		return fmt.Sprintf("user %q", k.name)
+	}
+	return fmt.Sprintf("class %q", k.name)
+}
+
+func newRateLimiter(classLimits, principalLimits map[string]int64, principal PrincipalFunc,
+	now func() time.Time,
+) *rateLimiter {
+	normalized := make(map[string]int64, len(classLimits))
+	for class, limit := range classLimits {
+		if class != DefaultRateLimitClass {
+			class = schema.UppercaseClassName(class)
+		}
+		normalized[class] = limit
+	}
+	return &rateLimiter{
+		classLimits:     normalized,
+		principalLimits: principalLimits,
+		principal:       principal,
+		buckets:         map[rateLimitKey]*tokenBucket{},
+		now:             now,
+	}
+}
+
+func (l *rateLimiter) intercept(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	r, ok := req.(*pb.BatchDeleteRequest)
+	if !ok {
+		return handler(ctx, req)
+	}
+
+	key, limit := l.limit(ctx, r)
+	if limit <= 0 {
+		return handler(ctx, req)
+	}
+	bucket := l.bucket(key, limit)
+
+	if wait := bucket.wait(l.now()); wait > 0 {
+		seconds := strconv.Itoa(int(math.Ceil(wait.Seconds())))
+		grpc.SetTrailer(ctx, metadata.Pairs(RetryAfterMetadataKey, seconds))
+		return nil, status.Errorf(codes.ResourceExhausted,
+			"rate limit of %s exceeded, retry after %ss", key, seconds)
+	}
+
+	reply, err := handler(ctx, req)
+	if res, ok := reply.(*pb.BatchDeleteReply); ok && err == nil {
+		bucket.take(float64(res.Matches), l.now())
+	}
+	return reply, err
+}
+
+// limit returns the bucket req is counted against and its limit, which is
+// zero if req is not limited. Requests whose principal can't be
+// authenticated are counted against their class, they are rejected by the
+// handler anyway.
+func (l *rateLimiter) limit(ctx context.Context, req *pb.BatchDeleteRequest) (rateLimitKey, int64) {
+	if len(l.principalLimits) > 0 && l.principal != nil {
+		if p, err := l.principal(ctx); err == nil && p != nil {
+			if limit := l.principalLimits[p.Username]; limit > 0 {
+				return rateLimitKey{principal: true, name: p.Username}, limit
+			}
+		}
+	}
+	class := schema.UppercaseClassName(req.Collection)
+	if limit := l.classLimits[class]; limit > 0 {
+		return rateLimitKey{name: class}, limit
+	}
+	return rateLimitKey{name: DefaultRateLimitClass}, l.classLimits[DefaultRateLimitClass]
+}
+
+// bucket returns the bucket of key, it is created with limit if it doesn't
+// exist yet
+func (l *rateLimiter) bucket(key rateLimitKey, limit int64) *tokenBucket {
+	l.Lock()
+	defer l.Unlock()
+	b, ok := l.buckets[key]
+	if !ok {
+		b = &tokenBucket{rate: float64(limit), tokens: float64(limit), last: l.now()}
+		l.buckets[key] = b
+	}
+	return b
+}
+
+// tokenBucket holds up to rate tokens and is refilled by rate tokens per
+// second. Taking more tokens than available leaves it negative.
+type tokenBucket struct {
+	sync.Mutex
+	rate   float64
+	tokens float64
+	last   time.Time
+}
+
+func (b *tokenBucket) refill(now time.Time) {
+	if elapsed := now.Sub(b.last).Seconds(); elapsed > 0 {
+		b.tokens = math.Min(b.rate, b.tokens+elapsed*b.rate)
+	}
+	b.last = now
+}
+
+// wait returns how long it takes until at least one token is available, zero
+// if one is available now
+func (b *tokenBucket) wait(now time.Time) time.Duration {
+	b.Lock()
+	defer b.Unlock()
+	b.refill(now)
+	if b.tokens >= 1 {
+		return 0
+	}
+	return time.Duration((1 - b.tokens) / b.rate * float64(time.Second))
+}
+
+func (b *tokenBucket) take(n float64, now time.Time) {
+	b.Lock()
+	defer b.Unlock()
+	b.refill(now)
+	b.tokens -= n
+}
@@ -0,0 +1,130 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package interceptors
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+	pb "github.com/weaviate/weaviate/grpc/generated/protocol/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+type fakeTransportStream struct {
+	grpc.ServerTransportStream
+	trailer metadata.MD
+}
+
+func (s *fakeTransportStream) SetTrailer(md metadata.MD) error {
+	s.trailer = metadata.Join(s.trailer, md)
+	return nil
+}
+
+func TestRateLimitUnaryServerInterceptor(t *testing.T) {
+	now := time.Now()
+	principal := func(ctx context.Context) (*models.Principal, error) {
+		md, _ := metadata.FromIncomingContext(ctx)
+		if users := md.Get("user"); len(users) > 0 {
+			return &models.Principal{Username: users[0]}, nil
+		}
+		return nil, nil
+	}
+	limiter := newRateLimiter(map[string]int64{"c": 10, DefaultRateLimitClass: 5},
+		map[string]int64{"alice": 3}, principal, func() time.Time { return now })
+	info := &grpc.UnaryServerInfo{FullMethod: "/weaviate.v1.Weaviate/BatchDelete"}
+
+	call := func(ctx context.Context, req any, matches int64) (*fakeTransportStream, error) {
+		stream := &fakeTransportStream{}
+		ctx = grpc.NewContextWithServerTransportStream(ctx, stream)
+		_, err := limiter.intercept(ctx, req, info, func(ctx context.Context, req any) (any, error) {
+			return &pb.BatchDeleteReply{Matches: matches}, nil
+		})
+		return stream, err
+	}
+	asUser := func(user string) context.Context {
+		return metadata.NewIncomingContext(context.Background(), metadata.Pairs("user", user))
+	}
+	ctx := context.Background()
+
+	t.Run("admits requests until the tokens are used up", func(t *testing.T) {
+		_, err := call(ctx, &pb.BatchDeleteRequest{Collection: "C"}, 15)
+		require.Nil(t, err)
+
+		stream, err := call(ctx, &pb.BatchDeleteRequest{Collection: "c"}, 1)
+		require.NotNil(t, err)
+		assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+		assert.Contains(t, err.Error(), `class "C"`)
+		// 5 tokens in debt plus the one required at 10 tokens per second
+		assert.Equal(t, []string{"1"}, stream.trailer.Get(RetryAfterMetadataKey))
+	})
+
+	t.Run("tokens are replenished", func(t *testing.T) {
+		now = now.Add(600 * time.Millisecond)
+		_, err := call(ctx, &pb.BatchDeleteRequest{Collection: "C"}, 0)
+		require.Nil(t, err)
+	})
+
+	t.Run("the limit of a user takes precedence", func(t *testing.T) {
+		_, err := call(asUser("alice"), &pb.BatchDeleteRequest{Collection: "C"}, 4)
+		require.Nil(t, err)
+
+		stream, err := call(asUser("alice"), &pb.BatchDeleteRequest{Collection: "Other"}, 1)
+		require.NotNil(t, err)
+		assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+		assert.Contains(t, err.Error(), `user "alice"`)
+		assert.Equal(t, []string{"1"}, stream.trailer.Get(RetryAfterMetadataKey))
+
+		// the limit of the collection itself is not affected
+		_, err = call(ctx, &pb.BatchDeleteRequest{Collection: "C"}, 0)
+		require.Nil(t, err)
+	})
+
+	t.Run("other collections share the default limit", func(t *testing.T) {
+		_, err := call(asUser("bob"), &pb.BatchDeleteRequest{Collection: "Other"}, 6)
+		require.Nil(t, err)
+
+		stream, err := call(ctx, &pb.BatchDeleteRequest{Collection: "Another"}, 1)
+		require.NotNil(t, err)
+		assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+		assert.Equal(t, []string{"1"}, stream.trailer.Get(RetryAfterMetadataKey))
+	})
+
+	t.Run("metadata doesn't select the limit", func(t *testing.T) {
+		md := metadata.Pairs("x-weaviate-rate-limit-class", "Unlimited")
+		_, err := call(metadata.NewIncomingContext(ctx, md), &pb.BatchDeleteRequest{Collection: "Another"}, 1)
+		require.NotNil(t, err)
+		assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+	})
+
+	t.Run("without a default limit", func(t *testing.T) {
+		limiter := newRateLimiter(map[string]int64{"C": 1}, nil, nil, time.Now)
+		for i := 0; i < 3; i++ {
+			_, err := limiter.intercept(ctx, &pb.BatchDeleteRequest{Collection: "Other"}, info,
+				func(ctx context.Context, req any) (any, error) {
+					return &pb.BatchDeleteReply{Matches: 1000}, nil
+				})
+			require.Nil(t, err)
+		}
+	})
+
+	t.Run("other requests are not limited", func(t *testing.T) {
+		_, err := call(asUser("alice"), &pb.SearchRequest{Collection: "C"}, 0)
+		require.Nil(t, err)
+	})
+}
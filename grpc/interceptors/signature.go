@@ -0,0 +1,93 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package interceptors
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+
+	pb "github.com/weaviate/weaviate/grpc/generated/protocol/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// SignBatchDeleteRequest sets the signature of req to the HMAC-SHA256 of the
+// request keyed with secret. It must be called after all other fields of the
+// request have been set.
+func SignBatchDeleteRequest(req *pb.BatchDeleteRequest, secret []byte) error {
+	if req == nil {
+		return errors.New("sign batch delete request: nil request")
+	}
+	if len(secret) == 0 {
+		return errors.New("sign batch delete request: empty secret")
+	}
+	mac, err := batchDeleteMAC(req, secret)
+	if err != nil {
+		return err
+	}
+	req.Signature = hex.EncodeToString(mac)
+	return nil
+}
+
+// BatchDeleteSignatureUnaryServerInterceptor rejects batch deletes whose
+// signature doesn't match the request with codes.Unauthenticated. Nothing is
+// verified if secret is empty.
+func BatchDeleteSignatureUnaryServerInterceptor(secret []byte) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		r, ok := req.(*pb.BatchDeleteRequest)
+		if !ok || len(secret) == 0 {
+			return handler(ctx, req)
+		}
+		if err := verifyBatchDeleteSignature(r, secret); err != nil {
+			return nil, status.Error(codes.Unauthenticated, err.Error())
+		}
+		return handler(ctx, req)
+	}
+}
+
+func verifyBatchDeleteSignature(req *pb.BatchDeleteRequest, secret []byte) error {
+	if req.Signature == "" {
+		return errors.New("batch delete request is not signed")
+	}
+	signature, err := hex.DecodeString(req.Signature)
+	if err != nil {
+		return errors.New("batch delete request signature is not hex encoded")
+	}
+	mac, err := batchDeleteMAC(req, secret)
+	if err != nil {
+		return err
+	}
+	if !hmac.Equal(signature, mac) {
+		return errors.New("invalid batch delete request signature")
+	}
+	return nil
+}
+
+// batchDeleteMAC computes the HMAC-SHA256 of the deterministic wire encoding
+// of req without its signature
+func batchDeleteMAC(req *pb.BatchDeleteRequest, secret []byte) ([]byte, error) {
+	unsigned := proto.Clone(req).(*pb.BatchDeleteRequest)
+	unsigned.Signature = ""
+	b, err := proto.MarshalOptions{Deterministic: true}.Marshal(unsigned)
+	if err != nil {
+		return nil, fmt.Errorf("marshal batch delete request: %w", err)
+	}
+	h := hmac.New(sha256.New, secret)
+	h.Write(b)
+	return h.Sum(nil), nil
+}
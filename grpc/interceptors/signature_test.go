@@ -0,0 +1,99 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package interceptors
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	pb "github.com/weaviate/weaviate/grpc/generated/protocol/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestBatchDeleteSignatureUnaryServerInterceptor(t *testing.T) {
+	secret := []byte("s3cr3t")
+	info := &grpc.UnaryServerInfo{FullMethod: "/weaviate.v1.Weaviate/BatchDelete"}
+	newRequest := func() *pb.BatchDeleteRequest {
+		return &pb.BatchDeleteRequest{
+			Collection: "C",
+			Filters: &pb.Filters{
+				Operator: pb.Filters_OPERATOR_EQUAL, On: []string{"name"},
+				TestValue: &pb.Filters_ValueText{ValueText: "foo"},
+			},
+			TenantSelection: &pb.BatchDeleteRequest_Tenant{Tenant: "t1"},
+			RequestId:       "r1",
+		}
+	}
+	call := func(secret []byte, req any) (bool, error) {
+		var handled bool
+		_, err := BatchDeleteSignatureUnaryServerInterceptor(secret)(context.Background(), req, info,
+			func(ctx context.Context, req any) (any, error) {
+				handled = true
+				return &pb.BatchDeleteReply{}, nil
+			})
+		return handled, err
+	}
+
+	t.Run("valid signature", func(t *testing.T) {
+		req := newRequest()
+		require.Nil(t, SignBatchDeleteRequest(req, secret))
+		assert.Len(t, req.Signature, 64)
+
+		handled, err := call(secret, req)
+		require.Nil(t, err)
+		assert.True(t, handled)
+	})
+
+	t.Run("tampered collection name", func(t *testing.T) {
+		req := newRequest()
+		require.Nil(t, SignBatchDeleteRequest(req, secret))
+		req.Collection = "Other"
+
+		handled, err := call(secret, req)
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+		assert.False(t, handled)
+	})
+
+	t.Run("signed with another secret", func(t *testing.T) {
+		req := newRequest()
+		require.Nil(t, SignBatchDeleteRequest(req, []byte("other")))
+
+		_, err := call(secret, req)
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	})
+
+	t.Run("unsigned or malformed", func(t *testing.T) {
+		for _, signature := range []string{"", "not hex"} {
+			req := newRequest()
+			req.Signature = signature
+			_, err := call(secret, req)
+			assert.Equal(t, codes.Unauthenticated, status.Code(err))
+		}
+	})
+
+	t.Run("missing secret skips verification", func(t *testing.T) {
+		handled, err := call(nil, newRequest())
+		require.Nil(t, err)
+		assert.True(t, handled)
+		assert.Error(t, SignBatchDeleteRequest(newRequest(), nil))
+	})
+
+	t.Run("other requests are not verified", func(t *testing.T) {
+		handled, err := call(secret, &pb.SearchRequest{Collection: "C"})
+		require.Nil(t, err)
+		assert.True(t, handled)
+	})
+}
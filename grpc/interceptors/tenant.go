@@ -0,0 +1,96 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package interceptors
+
+import (
+	"context"
+
+	pb "github.com/weaviate/weaviate/grpc/generated/protocol/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// TenantMetadataKey is the metadata key which routes a request to a tenant
+// without setting the tenant in the request itself, e.g. by a load balancer
+const TenantMetadataKey = "x-weaviate-tenant"
+
+// TenantUnaryServerInterceptor merges the tenant passed in the
+// TenantMetadataKey metadata into the tenant field of the request before the
+// handler sees it. Requests which set a different tenant than the metadata are
+// rejected with codes.InvalidArgument.
+func TenantUnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		tenant, err := tenantFromMetadata(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if tenant != "" {
+			if err := mergeTenant(req, tenant); err != nil {
+				return nil, err
+			}
+		}
+		return handler(ctx, req)
+	}
+}
+
+func tenantFromMetadata(ctx context.Context) (string, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	values := md.Get(TenantMetadataKey)
+	if len(values) == 0 {
+		return "", nil
+	}
+	for _, v := range values[1:] {
+		if v != values[0] {
+			return "", status.Errorf(codes.InvalidArgument,
+				"conflicting %s metadata values %q and %q", TenantMetadataKey, values[0], v)
+		}
+	}
+	return values[0], nil
+}
+
+// mergeTenant sets tenant on all requests which have a tenant field. Other
+// requests are left unchanged.
+func mergeTenant(req any, tenant string) error {
+	switch r := req.(type) {
+	case *pb.BatchDeleteRequest:
+		if r.GetTenantList() != nil {
+			return status.Errorf(codes.InvalidArgument,
+				"tenant_list cannot be combined with %s metadata", TenantMetadataKey)
+		}
+		if r.GetTenant() != "" && r.GetTenant() != tenant {
+			return conflictingTenantError(r.GetTenant(), tenant)
+		}
+		r.TenantSelection = &pb.BatchDeleteRequest_Tenant{Tenant: tenant}
+	case *pb.SearchRequest:
+		if r.Tenant != "" && r.Tenant != tenant {
+			return conflictingTenantError(r.Tenant, tenant)
+		}
+		r.Tenant = tenant
+	case *pb.BatchObjectsRequest:
+		for _, obj := range r.Objects {
+			if obj.Tenant != "" && obj.Tenant != tenant {
+				return conflictingTenantError(obj.Tenant, tenant)
+			}
+		}
+		for _, obj := range r.Objects {
+			obj.Tenant = tenant
+		}
+	}
+	return nil
+}
+
+func conflictingTenantError(requested, routed string) error {
+	return status.Errorf(codes.InvalidArgument,
+		"request tenant %q conflicts with %s metadata %q", requested, TenantMetadataKey, routed)
+}
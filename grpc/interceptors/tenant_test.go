@@ -0,0 +1,119 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package interceptors
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	pb "github.com/weaviate/weaviate/grpc/generated/protocol/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestTenantUnaryServerInterceptor(t *testing.T) {
+	interceptor := TenantUnaryServerInterceptor()
+	info := &grpc.UnaryServerInfo{FullMethod: "/weaviate.v1.Weaviate/BatchDelete"}
+	tenant := func(s string) *pb.BatchDeleteRequest_Tenant { return &pb.BatchDeleteRequest_Tenant{Tenant: s} }
+	withTenant := func(tenants ...string) context.Context {
+		md := metadata.MD{}
+		md.Append(TenantMetadataKey, tenants...)
+		return metadata.NewIncomingContext(context.Background(), md)
+	}
+
+	call := func(ctx context.Context, req any) (any, error) {
+		return interceptor(ctx, req, info, func(ctx context.Context, req any) (any, error) {
+			return req, nil
+		})
+	}
+
+	tests := []struct {
+		name     string
+		ctx      context.Context
+		req      any
+		expected any
+		errCode  codes.Code
+	}{
+		{
+			name:     "no metadata keeps request tenant",
+			ctx:      context.Background(),
+			req:      &pb.BatchDeleteRequest{Collection: "C", TenantSelection: tenant("t1")},
+			expected: &pb.BatchDeleteRequest{Collection: "C", TenantSelection: tenant("t1")},
+		},
+		{
+			name:     "metadata sets missing tenant",
+			ctx:      withTenant("t1"),
+			req:      &pb.BatchDeleteRequest{Collection: "C"},
+			expected: &pb.BatchDeleteRequest{Collection: "C", TenantSelection: tenant("t1")},
+		},
+		{
+			name:     "metadata matches request tenant",
+			ctx:      withTenant("t1"),
+			req:      &pb.SearchRequest{Collection: "C", Tenant: "t1"},
+			expected: &pb.SearchRequest{Collection: "C", Tenant: "t1"},
+		},
+		{
+			name: "metadata sets tenant of batch objects",
+			ctx:  withTenant("t1"),
+			req: &pb.BatchObjectsRequest{Objects: []*pb.BatchObject{
+				{Collection: "C"}, {Collection: "C", Tenant: "t1"},
+			}},
+			expected: &pb.BatchObjectsRequest{Objects: []*pb.BatchObject{
+				{Collection: "C", Tenant: "t1"}, {Collection: "C", Tenant: "t1"},
+			}},
+		},
+		{
+			name:    "metadata conflicts with request tenant",
+			ctx:     withTenant("t2"),
+			req:     &pb.BatchDeleteRequest{Collection: "C", TenantSelection: tenant("t1")},
+			errCode: codes.InvalidArgument,
+		},
+		{
+			name: "metadata conflicts with batch object tenant",
+			ctx:  withTenant("t2"),
+			req: &pb.BatchObjectsRequest{Objects: []*pb.BatchObject{
+				{Collection: "C"}, {Collection: "C", Tenant: "t1"},
+			}},
+			errCode: codes.InvalidArgument,
+		},
+		{
+			name: "metadata conflicts with tenant list",
+			ctx:  withTenant("t1"),
+			req: &pb.BatchDeleteRequest{Collection: "C", TenantSelection: &pb.BatchDeleteRequest_TenantList{
+				TenantList: &pb.TenantList{Tenants: []string{"t1", "t2"}},
+			}},
+			errCode: codes.InvalidArgument,
+		},
+		{
+			name:    "conflicting metadata values",
+			ctx:     withTenant("t1", "t2"),
+			req:     &pb.BatchDeleteRequest{Collection: "C"},
+			errCode: codes.InvalidArgument,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := call(tt.ctx, tt.req)
+			if tt.errCode != codes.OK {
+				require.NotNil(t, err)
+				assert.Equal(t, tt.errCode, status.Code(err))
+				return
+			}
+			require.Nil(t, err)
+			assert.Equal(t, tt.expected, resp)
+		})
+	}
+}
@@ -0,0 +1,58 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package interceptors
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// Metadata keys of the versions added to the response header of every RPC
+const (
+	ServerVersionMetadataKey = "x-weaviate-server-version"
+	APIVersionMetadataKey    = "x-weaviate-api-version"
+)
+
+// VersionProvider returns the versions reported to clients
+type VersionProvider interface {
+	ServerVersion() string
+	APIVersion() string
+}
+
+// VersionUnaryServerInterceptor adds the versions of p to the response header
+// metadata of unary RPCs
+func VersionUnaryServerInterceptor(p VersionProvider) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		// only fails if the header has been sent already, which the
+		// handler can't have done yet
+		_ = grpc.SetHeader(ctx, versionMetadata(p))
+		return handler(ctx, req)
+	}
+}
+
+// VersionStreamServerInterceptor is the streaming counterpart of
+// VersionUnaryServerInterceptor
+func VersionStreamServerInterceptor(p VersionProvider) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		_ = ss.SetHeader(versionMetadata(p))
+		return handler(srv, ss)
+	}
+}
+
+func versionMetadata(p VersionProvider) metadata.MD {
+	return metadata.Pairs(
+		ServerVersionMetadataKey, p.ServerVersion(),
+		APIVersionMetadataKey, p.APIVersion(),
+	)
+}
@@ -0,0 +1,178 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Package sse serves gRPC calls as HTTP/1.1 server-sent events for clients
+// in networks which block gRPC.
+package sse
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/netip"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+	pb "github.com/weaviate/weaviate/grpc/generated/protocol/v1"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// BatchDeletePath is the path the BatchDeleteHandler is mounted at
+const BatchDeletePath = "/v1/batch/objects/delete/stream"
+
+// ObjectsPerEvent is the number of objects sent in each objects event of a
+// verbose batch delete
+const ObjectsPerEvent = 100
+
+// BatchDeleteFunc is the BatchDelete call of the gRPC service
+type BatchDeleteFunc func(ctx context.Context, req *pb.BatchDeleteRequest) (*pb.BatchDeleteReply, error)
+
+// BatchDeleteHandler serves BatchDelete to clients which can't use gRPC. The
+// request body is a BatchDeleteRequest encoded as protobuf JSON. The reply is
+// a stream of server-sent events:
+//
+//	event: objects   {"objects": [...]}, ObjectsPerEvent objects of a verbose reply each
+//	event: summary   the BatchDeleteReply without its objects, ends the stream
+//	event: error     {"code": ..., "message": ...} if the call failed, ends the stream
+//
+// The headers of the request are passed to BatchDelete as incoming gRPC
+// metadata, so authentication works as for gRPC clients.
+type BatchDeleteHandler struct {
+	batchDelete  BatchDeleteFunc
+	maxBodyBytes int64
+	logger       logrus.FieldLogger
+}
+
+func NewBatchDeleteHandler(batchDelete BatchDeleteFunc, maxBodyBytes int64,
+	logger logrus.FieldLogger,
+) *BatchDeleteHandler {
+	return &BatchDeleteHandler{batchDelete: batchDelete, maxBodyBytes: maxBodyBytes, logger: logger}
+}
+
+func (h *BatchDeleteHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		http.Error(w, "streaming is not supported", http.StatusInternalServerError)
+		return
+	}
+
+	req, err := h.parseRequest(w, r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	// stops proxies like nginx from buffering the events
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+	flusher.Flush()
+
+	reply, err := h.batchDelete(incomingContext(r), req)
+	if err != nil {
+		st := status.Convert(err)
+		h.writeEvent(w, flusher, "error", map[string]string{
+			"code":    st.Code().String(),
+			"message": st.Message(),
+		})
+		return
+	}
+
+	if req.Verbose {
+		for start := 0; start < len(reply.Objects); start += ObjectsPerEvent {
+			end := min(start+ObjectsPerEvent, len(reply.Objects))
+			objects := make([]json.RawMessage, 0, end-start)
+			for _, obj := range reply.Objects[start:end] {
+				data, err := protojson.Marshal(obj)
+				if err != nil {
+					h.writeEvent(w, flusher, "error", map[string]string{"message": err.Error()})
+					return
+				}
+				objects = append(objects, data)
+			}
+			if !h.writeEvent(w, flusher, "objects", map[string][]json.RawMessage{"objects": objects}) {
+				return
+			}
+		}
+	}
+
+	summary := proto.Clone(reply).(*pb.BatchDeleteReply)
+	summary.Objects = nil
+	data, err := protojson.Marshal(summary)
+	if err != nil {
+		h.writeEvent(w, flusher, "error", map[string]string{"message": err.Error()})
+		return
+	}
+	h.writeEvent(w, flusher, "summary", json.RawMessage(data))
+}
+
+func (h *BatchDeleteHandler) parseRequest(w http.ResponseWriter, r *http.Request) (*pb.BatchDeleteRequest, error) {
+	body := r.Body
+	if h.maxBodyBytes > 0 {
+		body = http.MaxBytesReader(w, r.Body, h.maxBodyBytes)
+	}
+	data, err := io.ReadAll(body)
+	if err != nil {
+		return nil, fmt.Errorf("read request: %w", err)
+	}
+	req := &pb.BatchDeleteRequest{}
+	if err := protojson.Unmarshal(data, req); err != nil {
+		return nil, fmt.Errorf("invalid BatchDeleteRequest: %w", err)
+	}
+	return req, nil
+}
+
+// writeEvent writes and flushes one event and reports whether the client
+// is still connected
+func (h *BatchDeleteHandler) writeEvent(w io.Writer, flusher http.Flusher, event string, data any) bool {
+	encoded, err := json.Marshal(data)
+	if err != nil {
+		h.logger.WithField("action", "sse_batch_delete").WithError(err).
+			Error("encode event")
+		return false
+	}
+	if _, err := fmt.Fprintf(w, "event: %s\ndata: %s\n\n", event, encoded); err != nil {
+		h.logger.WithField("action", "sse_batch_delete").WithError(err).
+			Debug("client disconnected")
+		return false
+	}
+	flusher.Flush()
+	return true
+}
+
+// incomingContext returns the context of r with its headers as incoming gRPC
+// metadata and its remote address as peer
+func incomingContext(r *http.Request) context.Context {
+	md := metadata.MD{}
+	for k, v := range r.Header {
+		// gRPC metadata keys are lowercase
+		md.Append(strings.ToLower(k), v...)
+	}
+	ctx := metadata.NewIncomingContext(r.Context(), md)
+	if addr, err := netip.ParseAddrPort(r.RemoteAddr); err == nil {
+		ctx = peer.NewContext(ctx, &peer.Peer{Addr: net.TCPAddrFromAddrPort(addr)})
+	}
+	return ctx
+}
@@ -0,0 +1,137 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package sse
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	pb "github.com/weaviate/weaviate/grpc/generated/protocol/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+type event struct {
+	name string
+	data string
+}
+
+func readEvents(t *testing.T, body string) []event {
+	var events []event
+	var current event
+	scanner := bufio.NewScanner(strings.NewReader(body))
+	for scanner.Scan() {
+		line := scanner.Text()
+		switch {
+		case strings.HasPrefix(line, "event: "):
+			current.name = strings.TrimPrefix(line, "event: ")
+		case strings.HasPrefix(line, "data: "):
+			current.data = strings.TrimPrefix(line, "data: ")
+		case line == "":
+			events = append(events, current)
+			current = event{}
+		}
+	}
+	require.Nil(t, scanner.Err())
+	return events
+}
+
+func TestBatchDeleteHandler(t *testing.T) {
+	logger, _ := test.NewNullLogger()
+	serve := func(fn BatchDeleteFunc, method, body string) *httptest.ResponseRecorder {
+		r := httptest.NewRequest(method, BatchDeletePath, strings.NewReader(body))
+		r.Header.Set("Authorization", "Bearer secret")
+		w := httptest.NewRecorder()
+		NewBatchDeleteHandler(fn, 1024, logger).ServeHTTP(w, r)
+		return w
+	}
+
+	t.Run("verbose reply", func(t *testing.T) {
+		var received *pb.BatchDeleteRequest
+		var auth []string
+		fn := func(ctx context.Context, req *pb.BatchDeleteRequest) (*pb.BatchDeleteReply, error) {
+			received = req
+			md, _ := metadata.FromIncomingContext(ctx)
+			auth = md.Get("authorization")
+			reply := &pb.BatchDeleteReply{Matches: 250, Successful: 250, Collection: req.Collection}
+			for i := 0; i < 250; i++ {
+				reply.Objects = append(reply.Objects, &pb.BatchDeleteObject{Successful: true})
+			}
+			return reply, nil
+		}
+
+		w := serve(fn, http.MethodPost, `{"collection": "C", "verbose": true, "dry_run": true}`)
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "text/event-stream", w.Header().Get("Content-Type"))
+		assert.Equal(t, "C", received.Collection)
+		assert.True(t, received.DryRun)
+		assert.Equal(t, []string{"Bearer secret"}, auth)
+
+		events := readEvents(t, w.Body.String())
+		require.Len(t, events, 4)
+		for i, size := range []int{100, 100, 50} {
+			assert.Equal(t, "objects", events[i].name)
+			var objects struct {
+				Objects []json.RawMessage `json:"objects"`
+			}
+			require.Nil(t, json.Unmarshal([]byte(events[i].data), &objects))
+			assert.Len(t, objects.Objects, size)
+		}
+		assert.Equal(t, "summary", events[3].name)
+		var summary map[string]any
+		require.Nil(t, json.Unmarshal([]byte(events[3].data), &summary))
+		assert.Equal(t, "250", summary["matches"])
+		assert.Equal(t, "C", summary["collection"])
+		assert.NotContains(t, summary, "objects")
+	})
+
+	t.Run("objects only if verbose", func(t *testing.T) {
+		fn := func(ctx context.Context, req *pb.BatchDeleteRequest) (*pb.BatchDeleteReply, error) {
+			return &pb.BatchDeleteReply{Matches: 1, Objects: []*pb.BatchDeleteObject{{Successful: true}}}, nil
+		}
+		events := readEvents(t, serve(fn, http.MethodPost, `{"collection": "C"}`).Body.String())
+		require.Len(t, events, 1)
+		assert.Equal(t, "summary", events[0].name)
+	})
+
+	t.Run("failed call", func(t *testing.T) {
+		fn := func(ctx context.Context, req *pb.BatchDeleteRequest) (*pb.BatchDeleteReply, error) {
+			return nil, status.Error(codes.PermissionDenied, "forbidden")
+		}
+		w := serve(fn, http.MethodPost, `{"collection": "C"}`)
+		assert.Equal(t, http.StatusOK, w.Code)
+		events := readEvents(t, w.Body.String())
+		require.Len(t, events, 1)
+		assert.Equal(t, "error", events[0].name)
+		assert.JSONEq(t, `{"code": "PermissionDenied", "message": "forbidden"}`, events[0].data)
+	})
+
+	t.Run("invalid requests", func(t *testing.T) {
+		fn := func(ctx context.Context, req *pb.BatchDeleteRequest) (*pb.BatchDeleteReply, error) {
+			t.Fatal("must not be called")
+			return nil, nil
+		}
+		assert.Equal(t, http.StatusBadRequest, serve(fn, http.MethodPost, `{"collection": 1}`).Code)
+		assert.Equal(t, http.StatusBadRequest, serve(fn, http.MethodPost, `{"unknown": true}`).Code)
+		assert.Equal(t, http.StatusBadRequest, serve(fn, http.MethodPost, strings.Repeat(" ", 2048)+"{}").Code)
+		assert.Equal(t, http.StatusMethodNotAllowed, serve(fn, http.MethodGet, "").Code)
+	})
+}
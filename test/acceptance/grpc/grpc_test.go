@@ -13,8 +13,6 @@ package test
 
 import (
 	"context"
-	"math/big"
-	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -26,11 +24,6 @@ import (
 	"google.golang.org/grpc/health/grpc_health_v1"
 )
 
-func idByte(id string) []byte {
-	hexInteger, _ := new(big.Int).SetString(strings.Replace(id, "-", "", -1), 16)
-	return hexInteger.Bytes()
-}
-
 func TestGRPC(t *testing.T) {
 	grpcClient, conn := newClient(t)
 
@@ -269,7 +262,7 @@ func TestGRPC(t *testing.T) {
 		require.Equal(t, resp.Matches, int64(1))
 		require.Equal(t, resp.Successful, int64(1))
 		require.Equal(t, resp.Failed, int64(0))
-		require.Equal(t, resp.Objects[0].Uuid, idByte(books.Dune.String()))
+		require.Equal(t, books.Dune.String(), resp.Objects[0].UUIDString())
 	})
 
 	t.Run("gRPC Search removed", func(t *testing.T) {
@@ -0,0 +1,77 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package cluster
+
+import (
+	"context"
+	"encoding/binary"
+	"time"
+
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+)
+
+// appliedIndexMetaLen is the length of the node metadata holding the applied
+// index
+const appliedIndexMetaLen = 8
+
+func encodeAppliedIndex(idx uint64) []byte {
+	return binary.BigEndian.AppendUint64(make([]byte, 0, appliedIndexMetaLen), idx)
+}
+
+func decodeAppliedIndex(meta []byte) (uint64, bool) {
+	if len(meta) != appliedIndexMetaLen {
+		return 0, false
+	}
+	return binary.BigEndian.Uint64(meta), true
+}
+
+// PublishAppliedIndex gossips the schema log index returned by source to the
+// other nodes. source is polled every period and the node metadata is only
+// updated if the index changed. Publishing stops once ctx is done.
+func (s *State) PublishAppliedIndex(ctx context.Context, source func() uint64, period time.Duration) {
+	enterrors.GoWrapper(func() {
+		t := time.NewTicker(period)
+		defer t.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-t.C:
+			}
+			idx := source()
+			if s.delegate.appliedIndex.Swap(idx) == idx {
+				continue
+			}
+			s.listLock.RLock()
+			err := s.list.UpdateNode(period)
+			s.listLock.RUnlock()
+			if err != nil {
+				s.delegate.log.WithField("action", "delegate.applied_index").WithError(err).
+					Warn("failed to gossip applied index")
+			}
+		}
+	}, s.delegate.log)
+}
+
+// AppliedIndex returns the schema log index last gossiped by node. It returns
+// false if node is unknown or hasn't gossiped its index yet.
+func (s *State) AppliedIndex(node string) (uint64, bool) {
+	s.listLock.RLock()
+	defer s.listLock.RUnlock()
+
+	for _, m := range s.list.Members() {
+		if m.Name == node {
+			return decodeAppliedIndex(m.Meta)
+		}
+	}
+	return 0, false
+}
@@ -18,6 +18,7 @@ import (
 	"math/rand"
 	"sort"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	enterrors "github.com/weaviate/weaviate/entities/errors"
@@ -119,6 +120,10 @@ type delegate struct {
 
 	mutex    sync.Mutex
 	hostInfo NodeInfo
+
+	// appliedIndex is the schema log index applied by this node, it is
+	// gossiped as node metadata, see State.PublishAppliedIndex
+	appliedIndex atomic.Uint64
 }
 
 func (d *delegate) setOwnSpace(x DiskUsage) {
@@ -159,7 +164,10 @@ func (d *delegate) init(diskSpace func(path string) (DiskUsage, error)) error {
 // when broadcasting an alive message. It's length is limited to
 // the given byte size. This metadata is available in the Node structure.
 func (d *delegate) NodeMeta(limit int) (meta []byte) {
-	return nil
+	if limit < appliedIndexMetaLen {
+		return nil
+	}
+	return encodeAppliedIndex(d.appliedIndex.Load())
 }
 
 // LocalState is used for a TCP Push/Pull. This is sent to
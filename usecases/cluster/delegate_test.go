@@ -12,7 +12,9 @@
 package cluster
 
 import (
+	"context"
 	"fmt"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -273,6 +275,20 @@ func TestDelegateLocalState(t *testing.T) {
 	})
 }
 
+func TestDelegateNodeMeta(t *testing.T) {
+	d := delegate{Name: "N0"}
+	d.appliedIndex.Store(42)
+
+	meta := d.NodeMeta(512)
+	idx, ok := decodeAppliedIndex(meta)
+	assert.True(t, ok)
+	assert.Equal(t, uint64(42), idx)
+
+	assert.Nil(t, d.NodeMeta(appliedIndexMetaLen-1), "no room for the applied index")
+	_, ok = decodeAppliedIndex(nil)
+	assert.False(t, ok, "older nodes gossip no metadata")
+}
+
 func TestDelegateUpdater(t *testing.T) {
 	logger, _ := test.NewNullLogger()
 	now := time.Now().UnixMilli() - 1
@@ -311,3 +327,20 @@ func TestDelegateUpdater(t *testing.T) {
 	assert.Greater(t, got.LastTimeMilli, now)
 	assert.Equal(t, DiskUsage{3 * 2, 3}, got.DiskUsage)
 }
+
+func TestPublishAppliedIndexStops(t *testing.T) {
+	logger, _ := test.NewNullLogger()
+	s := State{delegate: delegate{Name: "N0", log: logger}}
+
+	var calls atomic.Int64
+	ctx, cancel := context.WithCancel(context.Background())
+	// the index never changes, so the member list isn't needed
+	s.PublishAppliedIndex(ctx, func() uint64 { calls.Add(1); return 0 }, time.Millisecond)
+
+	assert.Eventually(t, func() bool { return calls.Load() > 0 }, time.Second, time.Millisecond)
+	cancel()
+	time.Sleep(10 * time.Millisecond)
+	stopped := calls.Load()
+	time.Sleep(20 * time.Millisecond)
+	assert.Equal(t, stopped, calls.Load(), "source polled after ctx was done")
+}
@@ -18,6 +18,9 @@ type Authentication struct {
 	OIDC            OIDC            `json:"oidc" yaml:"oidc"`
 	AnonymousAccess AnonymousAccess `json:"anonymous_access" yaml:"anonymous_access"`
 	APIKey          APIKey
+	// BatchDeleteSigningSecret makes the gRPC server reject batch deletes
+	// which aren't signed with it, see BatchDeleteRequest.signature
+	BatchDeleteSigningSecret string `json:"batch_delete_signing_secret" yaml:"batch_delete_signing_secret"`
 }
 
 // DefaultAuthentication is the default authentication scheme when no authentication is provided
@@ -80,6 +80,8 @@ type Flags struct {
 	RaftSnapshotThreshold  int      `long:"raft-snap-threshold" description:"number of outstanding log entries before performing a snapshot"`
 	RaftSnapshotInterval   int      `long:"raft-snap-interval" description:"controls how often raft checks if it should perform a snapshot"`
 	RaftMetadataOnlyVoters bool     `long:"raft-metadata-only-voters" description:"configures the voters to store metadata exclusively, without storing any other data"`
+
+	GRPCEnableReflection bool `long:"grpc-enable-reflection" description:"register the gRPC server reflection service, e.g. for grpcurl"`
 }
 
 // Config outline of the config file
@@ -102,6 +104,7 @@ type Config struct {
 	ModulesPath                         string                   `json:"modules_path" yaml:"modules_path"`
 	ModuleHttpClientTimeout             time.Duration            `json:"modules_client_timeout" yaml:"modules_client_timeout"`
 	AutoSchema                          AutoSchema               `json:"auto_schema" yaml:"auto_schema"`
+	Schema                              Schema                   `json:"schema" yaml:"schema"`
 	Cluster                             cluster.Config           `json:"cluster" yaml:"cluster"`
 	Replication                         replication.GlobalConfig `json:"replication" yaml:"replication"`
 	Monitoring                          monitoring.Config        `json:"monitoring" yaml:"monitoring"`
@@ -194,6 +197,45 @@ func (a AutoSchema) Validate() error {
 	return nil
 }
 
+// Schema configures the behavior of the schema for all classes
+type Schema struct {
+	// AutoActivateTenants turns inactive tenants of every multi-tenant class
+	// HOT on their first read or write, regardless of the
+	// autoTenantActivation setting of the class
+	AutoActivateTenants bool `json:"autoActivateTenants" yaml:"autoActivateTenants"`
+	// AutoActivateTenantsTimeout limits how long a request waits for the
+	// implicit activation of a tenant to complete
+	AutoActivateTenantsTimeout time.Duration `json:"autoActivateTenantsTimeout" yaml:"autoActivateTenantsTimeout"`
+	// MaxPropertiesPerClass limits the number of top level properties of a
+	// class, 0 means unlimited
+	MaxPropertiesPerClass int `json:"maxPropertiesPerClass" yaml:"maxPropertiesPerClass"`
+	// MaxTenantsPerClass limits the number of tenants of a multi-tenant class,
+	// 0 means unlimited. The maxTenants setting of a class takes precedence.
+	MaxTenantsPerClass int `json:"maxTenantsPerClass" yaml:"maxTenantsPerClass"`
+	// MaxConcurrentMoves limits how many shards are moved at the same time
+	// when a node joining the cluster is rebalanced
+	MaxConcurrentMoves int `json:"maxConcurrentMoves" yaml:"maxConcurrentMoves"`
+	// PurgeTenantTimeout limits how long PurgeTenant waits for the data of
+	// the tenant to be removed from storage
+	PurgeTenantTimeout time.Duration `json:"purgeTenantTimeout" yaml:"purgeTenantTimeout"`
+	// DefaultConsistencyLevel is used by requests which don't set a
+	// consistency level, one of ONE, QUORUM and ALL
+	DefaultConsistencyLevel string `json:"defaultConsistencyLevel" yaml:"defaultConsistencyLevel"`
+	// SoftDelete makes DeleteClass keep deleted classes with their data for
+	// SoftDeleteRetentionDays, during which they can be restored
+	SoftDelete              bool `json:"softDelete" yaml:"softDelete"`
+	SoftDeleteRetentionDays int  `json:"softDeleteRetentionDays" yaml:"softDeleteRetentionDays"`
+	// MaxInheritanceDepth limits how many ancestors a class may have through
+	// the classes it extends
+	MaxInheritanceDepth int `json:"maxInheritanceDepth" yaml:"maxInheritanceDepth"`
+	// PropertyStatsCacheTTL is how long the computed stats of a property are
+	// returned before they are computed again
+	PropertyStatsCacheTTL time.Duration `json:"propertyStatsCacheTTL" yaml:"propertyStatsCacheTTL"`
+	// DefaultQueryTimeout limits the duration of queries of classes without a
+	// queryTimeout, 0 means unlimited
+	DefaultQueryTimeout time.Duration `json:"defaultQueryTimeout" yaml:"defaultQueryTimeout"`
+}
+
 // QueryDefaults for optional parameters
 type QueryDefaults struct {
 	Limit int64 `json:"limit" yaml:"limit"`
@@ -212,6 +254,68 @@ type GRPC struct {
 	CertFile   string `json:"certFile" yaml:"certFile"`
 	KeyFile    string `json:"keyFile" yaml:"keyFile"`
 	MaxMsgSize int    `json:"maxMsgSize" yaml:"maxMsgSize"`
+	// OTelInterceptors enables OpenTelemetry spans for every gRPC request
+	OTelInterceptors bool `json:"otelInterceptors" yaml:"otelInterceptors"`
+	// MaxFilterDepth limits how deeply AND/OR filters of a batch delete
+	// request may be nested
+	MaxFilterDepth int `json:"maxFilterDepth" yaml:"maxFilterDepth"`
+	// MaxJoinDepth limits how deeply the joins of a joined search request may
+	// be nested
+	MaxJoinDepth int `json:"maxJoinDepth" yaml:"maxJoinDepth"`
+	// EnableReflection registers the gRPC server reflection service, which
+	// exposes the full API description to any client
+	EnableReflection bool `json:"enableReflection" yaml:"enableReflection"`
+	// ClassRateLimits limits how many objects batch deletes may match per
+	// second, keyed by collection. The limit of "*" is shared by all
+	// collections without a limit of their own.
+	ClassRateLimits map[string]int64 `json:"classRateLimits" yaml:"classRateLimits"`
+	// PrincipalRateLimits limits how many objects the batch deletes of a
+	// user may match per second, keyed by username. They take precedence
+	// over ClassRateLimits.
+	PrincipalRateLimits map[string]int64 `json:"principalRateLimits" yaml:"principalRateLimits"`
+	// AuditLog records every batch delete request
+	AuditLog GRPCAuditLog `json:"auditLog" yaml:"auditLog"`
+	// BatchDeleteIdempotencyTTL is how long the replies of batch deletes
+	// with an idempotency key are kept to answer retries
+	BatchDeleteIdempotencyTTL time.Duration `json:"batchDeleteIdempotencyTTL" yaml:"batchDeleteIdempotencyTTL"`
+	// BatchDeleteCursorTTL is how long the remaining objects of a paginated
+	// verbose batch delete are kept after a page was returned
+	BatchDeleteCursorTTL time.Duration `json:"batchDeleteCursorTTL" yaml:"batchDeleteCursorTTL"`
+	// BatchDeleteCallbackTimeout bounds the POST of the result of a batch
+	// delete to its callback URL
+	BatchDeleteCallbackTimeout time.Duration `json:"batchDeleteCallbackTimeout" yaml:"batchDeleteCallbackTimeout"`
+	// BatchDeleteCallbackAllowedHosts, if set, are the only hosts batch
+	// delete callbacks are posted to. They may resolve to private addresses,
+	// which are rejected for all other hosts.
+	BatchDeleteCallbackAllowedHosts []string `json:"batchDeleteCallbackAllowedHosts" yaml:"batchDeleteCallbackAllowedHosts"`
+	// BatchDeleteDispatcher schedules batch deletes by their priority
+	BatchDeleteDispatcher GRPCBatchDeleteDispatcher `json:"batchDeleteDispatcher" yaml:"batchDeleteDispatcher"`
+	// BatchDeleteHistoryRedactFilters removes the values of the filters of
+	// batch deletes before they are added to the batch delete history
+	BatchDeleteHistoryRedactFilters bool `json:"batchDeleteHistoryRedactFilters" yaml:"batchDeleteHistoryRedactFilters"`
+}
+
+// GRPCBatchDeleteDispatcher limits how many gRPC batch deletes run at a time.
+// Further deletes are queued by priority, high priority deletes always run
+// right away. Batch deletes over REST are not limited.
+type GRPCBatchDeleteDispatcher struct {
+	// MaxConcurrent is the number of batch deletes running at a time, 0 (the
+	// default) doesn't limit them
+	MaxConcurrent int `json:"maxConcurrent" yaml:"maxConcurrent"`
+	// MaxQueueDepth is the number of deletes queued per priority, further
+	// ones are rejected. 0 (the default) doesn't limit the queues.
+	MaxQueueDepth int `json:"maxQueueDepth" yaml:"maxQueueDepth"`
+	// LowPriorityPercent is the share of MaxConcurrent low priority deletes
+	// may use, at least one
+	LowPriorityPercent int `json:"lowPriorityPercent" yaml:"lowPriorityPercent"`
+}
+
+// GRPCAuditLog writes a JSON line per batch delete request to the server log,
+// or to a file rotated daily if Path is set
+type GRPCAuditLog struct {
+	Enabled       bool   `json:"enabled" yaml:"enabled"`
+	Path          string `json:"path" yaml:"path"`
+	RedactFilters bool   `json:"redactFilters" yaml:"redactFilters"`
 }
 
 type Profiling struct {
@@ -346,6 +450,7 @@ type Raft struct {
 	ElectionTimeout        time.Duration
 	SnapshotInterval       time.Duration
 	ConsistencyWaitTimeout time.Duration
+	SchemaChangelogSize    int
 
 	BootstrapTimeout   time.Duration
 	BootstrapExpect    int
@@ -562,6 +667,9 @@ func (f *WeaviateConfig) fromFlags(flags *Flags) {
 	if flags.RaftMetadataOnlyVoters {
 		f.Config.Raft.MetadataOnlyVoters = true
 	}
+	if flags.GRPCEnableReflection {
+		f.Config.GRPC.EnableReflection = true
+	}
 }
 
 func configErr(err error) error {
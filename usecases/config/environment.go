@@ -177,6 +177,10 @@ func FromEnv(config *Config) error {
 
 	}
 
+	if v := os.Getenv("AUTHENTICATION_BATCH_DELETE_SIGNING_SECRET"); v != "" {
+		config.Authentication.BatchDeleteSigningSecret = v
+	}
+
 	if entcfg.Enabled(os.Getenv("AUTHORIZATION_ADMINLIST_ENABLED")) {
 		config.Authorization.AdminList.Enabled = true
 
@@ -412,6 +416,81 @@ func FromEnv(config *Config) error {
 		config.AutoSchema.DefaultDate = v
 	}
 
+	config.Schema.AutoActivateTenants = entcfg.Enabled(os.Getenv("SCHEMA_AUTO_ACTIVATE_TENANTS"))
+	if err := parsePositiveInt(
+		"SCHEMA_AUTO_ACTIVATE_TENANTS_TIMEOUT",
+		func(val int) { config.Schema.AutoActivateTenantsTimeout = time.Second * time.Duration(val) },
+		DefaultAutoActivateTenantsTimeout,
+	); err != nil {
+		return err
+	}
+	if err := parseNonNegativeInt(
+		"SCHEMA_MAX_PROPERTIES_PER_CLASS",
+		func(val int) { config.Schema.MaxPropertiesPerClass = val },
+		DefaultMaxPropertiesPerClass,
+	); err != nil {
+		return err
+	}
+	if err := parseNonNegativeInt(
+		"SCHEMA_MAX_TENANTS_PER_CLASS",
+		func(val int) { config.Schema.MaxTenantsPerClass = val },
+		DefaultMaxTenantsPerClass,
+	); err != nil {
+		return err
+	}
+	if err := parsePositiveInt(
+		"SCHEMA_MAX_CONCURRENT_MOVES",
+		func(val int) { config.Schema.MaxConcurrentMoves = val },
+		DefaultMaxConcurrentMoves,
+	); err != nil {
+		return err
+	}
+	if err := parsePositiveInt(
+		"SCHEMA_PURGE_TENANT_TIMEOUT",
+		func(val int) { config.Schema.PurgeTenantTimeout = time.Second * time.Duration(val) },
+		DefaultPurgeTenantTimeout,
+	); err != nil {
+		return err
+	}
+	config.Schema.SoftDelete = entcfg.Enabled(os.Getenv("SCHEMA_SOFT_DELETE"))
+	if err := parsePositiveInt(
+		"SCHEMA_SOFT_DELETE_RETENTION_DAYS",
+		func(val int) { config.Schema.SoftDeleteRetentionDays = val },
+		DefaultSoftDeleteRetentionDays,
+	); err != nil {
+		return err
+	}
+	if err := parsePositiveInt(
+		"SCHEMA_MAX_INHERITANCE_DEPTH",
+		func(val int) { config.Schema.MaxInheritanceDepth = val },
+		DefaultMaxInheritanceDepth,
+	); err != nil {
+		return err
+	}
+	if err := parsePositiveInt(
+		"SCHEMA_PROPERTY_STATS_CACHE_TTL",
+		func(val int) { config.Schema.PropertyStatsCacheTTL = time.Second * time.Duration(val) },
+		DefaultPropertyStatsCacheTTL,
+	); err != nil {
+		return err
+	}
+	if err := parseNonNegativeInt(
+		"SCHEMA_DEFAULT_QUERY_TIMEOUT",
+		func(val int) { config.Schema.DefaultQueryTimeout = time.Second * time.Duration(val) },
+		DefaultQueryTimeout,
+	); err != nil {
+		return err
+	}
+	config.Schema.DefaultConsistencyLevel = DefaultConsistencyLevel
+	if v := os.Getenv("SCHEMA_DEFAULT_CONSISTENCY_LEVEL"); v != "" {
+		switch level := strings.ToUpper(v); level {
+		case "ONE", "QUORUM", "ALL":
+			config.Schema.DefaultConsistencyLevel = level
+		default:
+			return fmt.Errorf("SCHEMA_DEFAULT_CONSISTENCY_LEVEL must be one of ONE, QUORUM and ALL. Got: %v", v)
+		}
+	}
+
 	ru, err := parseResourceUsageEnvVars()
 	if err != nil {
 		return err
@@ -460,6 +539,66 @@ func FromEnv(config *Config) error {
 	); err != nil {
 		return err
 	}
+	if err := parsePositiveInt(
+		"GRPC_MAX_FILTER_DEPTH",
+		func(val int) { config.GRPC.MaxFilterDepth = val },
+		DefaultGRPCMaxFilterDepth,
+	); err != nil {
+		return err
+	}
+
+	if err := parsePositiveInt(
+		"GRPC_MAX_JOIN_DEPTH",
+		func(val int) { config.GRPC.MaxJoinDepth = val },
+		DefaultGRPCMaxJoinDepth,
+	); err != nil {
+		return err
+	}
+	if err := parseNonNegativeInt(
+		"GRPC_BATCH_DELETE_MAX_CONCURRENT",
+		func(val int) { config.GRPC.BatchDeleteDispatcher.MaxConcurrent = val },
+		DefaultGRPCBatchDeleteMaxConcurrent,
+	); err != nil {
+		return err
+	}
+	if err := parseNonNegativeInt(
+		"GRPC_BATCH_DELETE_MAX_QUEUE_DEPTH",
+		func(val int) { config.GRPC.BatchDeleteDispatcher.MaxQueueDepth = val },
+		DefaultGRPCBatchDeleteMaxQueueDepth,
+	); err != nil {
+		return err
+	}
+	if err := parsePositiveInt(
+		"GRPC_BATCH_DELETE_LOW_PRIORITY_PERCENT",
+		func(val int) { config.GRPC.BatchDeleteDispatcher.LowPriorityPercent = val },
+		DefaultGRPCBatchDeleteLowPriorityPercent,
+	); err != nil {
+		return err
+	}
+	if err := parsePositiveInt(
+		"GRPC_BATCH_DELETE_IDEMPOTENCY_TTL",
+		func(val int) { config.GRPC.BatchDeleteIdempotencyTTL = time.Second * time.Duration(val) },
+		DefaultGRPCBatchDeleteIdempotencyTTL,
+	); err != nil {
+		return err
+	}
+	if err := parsePositiveInt(
+		"GRPC_BATCH_DELETE_CURSOR_TTL",
+		func(val int) { config.GRPC.BatchDeleteCursorTTL = time.Second * time.Duration(val) },
+		DefaultGRPCBatchDeleteCursorTTL,
+	); err != nil {
+		return err
+	}
+	if err := parsePositiveInt(
+		"GRPC_BATCH_DELETE_CALLBACK_TIMEOUT_SECONDS",
+		func(val int) { config.GRPC.BatchDeleteCallbackTimeout = time.Second * time.Duration(val) },
+		DefaultGRPCBatchDeleteCallbackTimeout,
+	); err != nil {
+		return err
+	}
+	if v := os.Getenv("GRPC_BATCH_DELETE_CALLBACK_ALLOWED_HOSTS"); v != "" {
+		config.GRPC.BatchDeleteCallbackAllowedHosts = strings.Split(v, ",")
+	}
 	config.GRPC.CertFile = ""
 	if v := os.Getenv("GRPC_CERT_FILE"); v != "" {
 		config.GRPC.CertFile = v
@@ -468,6 +607,12 @@ func FromEnv(config *Config) error {
 	if v := os.Getenv("GRPC_KEY_FILE"); v != "" {
 		config.GRPC.KeyFile = v
 	}
+	config.GRPC.OTelInterceptors = entcfg.Enabled(os.Getenv("GRPC_OTEL_INTERCEPTORS_ENABLED"))
+	config.GRPC.EnableReflection = entcfg.Enabled(os.Getenv("GRPC_ENABLE_REFLECTION"))
+	config.GRPC.AuditLog.Enabled = entcfg.Enabled(os.Getenv("GRPC_AUDIT_LOG_ENABLED"))
+	config.GRPC.AuditLog.Path = os.Getenv("GRPC_AUDIT_LOG_PATH")
+	config.GRPC.AuditLog.RedactFilters = entcfg.Enabled(os.Getenv("GRPC_AUDIT_LOG_REDACT_FILTERS"))
+	config.GRPC.BatchDeleteHistoryRedactFilters = entcfg.Enabled(os.Getenv("GRPC_BATCH_DELETE_HISTORY_REDACT_FILTERS"))
 
 	config.DisableGraphQL = entcfg.Enabled(os.Getenv("DISABLE_GRAPHQL"))
 
@@ -575,6 +720,14 @@ func parseRAFTConfig(hostname string) (Raft, error) {
 		return cfg, err
 	}
 
+	if err := parsePositiveInt(
+		"RAFT_SCHEMA_CHANGELOG_SIZE",
+		func(val int) { cfg.SchemaChangelogSize = val },
+		1000,
+	); err != nil {
+		return cfg, err
+	}
+
 	if err := parsePositiveInt(
 		"RAFT_HEARTBEAT_TIMEOUT",
 		func(val int) { cfg.HeartbeatTimeout = time.Second * time.Duration(val) },
@@ -811,9 +964,29 @@ const (
 	DefaultMaxConcurrentGetRequests            = 0
 	DefaultGRPCPort                            = 50051
 	DefaultGRPCMaxMsgSize                      = 10 * 1024 * 1024
+	DefaultGRPCMaxFilterDepth                  = 10
+	DefaultGRPCMaxJoinDepth                    = 1
+	DefaultGRPCBatchDeleteIdempotencyTTL       = 24 * 60 * 60
+	DefaultGRPCBatchDeleteCursorTTL            = 60
+	DefaultGRPCBatchDeleteCallbackTimeout      = 10
+	DefaultGRPCBatchDeleteMaxConcurrent        = 0
+	DefaultGRPCBatchDeleteMaxQueueDepth        = 0
+	DefaultGRPCBatchDeleteLowPriorityPercent   = 10
 	DefaultMinimumReplicationFactor            = 1
+	DefaultAutoActivateTenantsTimeout          = 30
+	DefaultMaxPropertiesPerClass               = 1000
+	DefaultMaxTenantsPerClass                  = 100000
+	DefaultMaxConcurrentMoves                  = 2
+	DefaultPurgeTenantTimeout                  = 60
+	DefaultSoftDeleteRetentionDays             = 7
+	DefaultMaxInheritanceDepth                 = 5
+	DefaultPropertyStatsCacheTTL               = 5 * 60
+	DefaultQueryTimeout                        = 30
 )
 
+// DefaultConsistencyLevel is used if SCHEMA_DEFAULT_CONSISTENCY_LEVEL is not set
+const DefaultConsistencyLevel = "ONE"
+
 const VectorizerModuleNone = "none"
 
 // DefaultGossipBindPort uses the hashicorp/memberlist default
@@ -15,6 +15,7 @@ import (
 	"errors"
 	"os"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -638,6 +639,65 @@ func TestEnvironmentMinimumReplicationFactor(t *testing.T) {
 	}
 }
 
+func TestEnvironmentSchemaDefaultConsistencyLevel(t *testing.T) {
+	levels := []struct {
+		name        string
+		value       []string
+		expected    string
+		expectedErr bool
+	}{
+		{"Valid", []string{"QUORUM"}, "QUORUM", false},
+		{"lower case", []string{"all"}, "ALL", false},
+		{"not given", []string{}, DefaultConsistencyLevel, false},
+		{"invalid level", []string{"TWO"}, "", true},
+	}
+	for _, tt := range levels {
+		t.Run(tt.name, func(t *testing.T) {
+			if len(tt.value) == 1 {
+				t.Setenv("SCHEMA_DEFAULT_CONSISTENCY_LEVEL", tt.value[0])
+			}
+			conf := Config{}
+			err := FromEnv(&conf)
+
+			if tt.expectedErr {
+				require.NotNil(t, err)
+			} else {
+				require.Equal(t, tt.expected, conf.Schema.DefaultConsistencyLevel)
+			}
+		})
+	}
+}
+
+func TestEnvironmentSchemaDefaultQueryTimeout(t *testing.T) {
+	factors := []struct {
+		name        string
+		value       []string
+		expected    time.Duration
+		expectedErr bool
+	}{
+		{"Valid", []string{"60"}, time.Minute, false},
+		{"disabled", []string{"0"}, 0, false},
+		{"not given", []string{}, DefaultQueryTimeout * time.Second, false},
+		{"negative", []string{"-1"}, 0, true},
+		{"not parsable", []string{"30s"}, 0, true},
+	}
+	for _, tt := range factors {
+		t.Run(tt.name, func(t *testing.T) {
+			if len(tt.value) == 1 {
+				t.Setenv("SCHEMA_DEFAULT_QUERY_TIMEOUT", tt.value[0])
+			}
+			conf := Config{}
+			err := FromEnv(&conf)
+
+			if tt.expectedErr {
+				require.NotNil(t, err)
+			} else {
+				require.Equal(t, tt.expected, conf.Schema.DefaultQueryTimeout)
+			}
+		})
+	}
+}
+
 func TestEnvironmentQueryDefaults_Limit(t *testing.T) {
 	factors := []struct {
 		name     string
@@ -33,3 +33,8 @@ func (m *MockParser) ParseClassUpdate(class, update *models.Class) (*models.Clas
 	args := m.Called(class)
 	return update, args.Error(1)
 }
+
+func (m *MockParser) ParseClassCompaction(class, update *models.Class) (*models.Class, error) {
+	args := m.Called(class)
+	return update, args.Error(1)
+}
@@ -13,6 +13,7 @@ package fakes
 
 import (
 	"context"
+	"time"
 
 	"github.com/stretchr/testify/mock"
 	"github.com/weaviate/weaviate/cluster/proto/api"
@@ -43,6 +44,11 @@ func (m *MockSchemaExecutor) UpdateClass(req cmd.UpdateClassRequest) error {
 	return args.Error(0)
 }
 
+func (m *MockSchemaExecutor) UpdateVectorIndexConfig(class string, req cmd.UpdateVectorIndexConfigRequest) error {
+	args := m.Called(class, req)
+	return args.Error(0)
+}
+
 func (m *MockSchemaExecutor) UpdateIndex(req cmd.UpdateClassRequest) error {
 	args := m.Called(req)
 	return args.Error(0)
@@ -57,6 +63,16 @@ func (m *MockSchemaExecutor) DeleteClass(name string, hasFrozen bool) error {
 	return args.Error(0)
 }
 
+func (m *MockSchemaExecutor) UnloadClass(name string) error {
+	args := m.Called(name)
+	return args.Error(0)
+}
+
+func (m *MockSchemaExecutor) DropUnloadedClass(name string, hasFrozen bool) error {
+	args := m.Called(name)
+	return args.Error(0)
+}
+
 func (m *MockSchemaExecutor) AddProperty(class string, req cmd.AddPropertyRequest) error {
 	args := m.Called(class, req)
 	return args.Error(0)
@@ -87,11 +103,31 @@ func (m *MockSchemaExecutor) UpdateShardStatus(req *cmd.UpdateShardStatusRequest
 	return args.Error(0)
 }
 
+func (m *MockSchemaExecutor) MoveShard(req *cmd.MoveShardRequest) error {
+	args := m.Called(req)
+	return args.Error(0)
+}
+
 func (m *MockSchemaExecutor) GetShardsStatus(class, tenant string) (models.ShardStatusList, error) {
 	args := m.Called(class, tenant)
 	return models.ShardStatusList{}, args.Error(1)
 }
 
+func (m *MockSchemaExecutor) ShardObjectCount(class, shard string) (int64, error) {
+	args := m.Called(class, shard)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockSchemaExecutor) TenantLastActivity(class, tenant string) (time.Time, error) {
+	args := m.Called(class, tenant)
+	return args.Get(0).(time.Time), args.Error(1)
+}
+
+func (m *MockSchemaExecutor) TenantQueriesInFlight(class, tenant string) (int64, error) {
+	args := m.Called(class, tenant)
+	return args.Get(0).(int64), args.Error(1)
+}
+
 func (m *MockSchemaExecutor) Open(ctx context.Context) error {
 	args := m.Called(ctx)
 	return args.Error(0)
@@ -71,12 +71,19 @@ func (m *Manager) AddObject(ctx context.Context, principal *models.Principal, ob
 func (m *Manager) addObjectToConnectorAndSchema(ctx context.Context, principal *models.Principal,
 	object *models.Object, repl *additional.ReplicationProperties,
 ) (*models.Object, error) {
+	if err := checkWritable(ctx, m.schemaManager, principal, object.Class); err != nil {
+		return nil, err
+	}
+
 	id, err := m.checkIDOrAssignNew(ctx, principal, object.Class, object.ID, repl, object.Tenant)
 	if err != nil {
 		return nil, err
 	}
 	object.ID = id
 
+	if err := m.schemaManager.ResolvePropertyAliases(object); err != nil {
+		return nil, NewErrInvalidUserInput("invalid object: %v", err)
+	}
 	schemaVersion, err := m.autoSchemaManager.autoSchema(ctx, principal, true, object)
 	if err != nil {
 		return nil, errors.Wrap(err, "invalid object")
@@ -111,8 +118,12 @@ func (m *Manager) addObjectToConnectorAndSchema(ctx context.Context, principal *
 	if err := m.schemaManager.WaitForUpdate(ctx, schemaVersion); err != nil {
 		return nil, fmt.Errorf("error waiting for local schema to catch up to version %d: %w", schemaVersion, err)
 	}
+	if err := reserveObjects(ctx, m.schemaManager, vclasses[object.Class].Class, 1); err != nil {
+		return nil, err
+	}
 	err = m.vectorRepo.PutObject(ctx, object, object.Vector, object.Vectors, repl, schemaVersion)
 	if err != nil {
+		releaseObjects(ctx, m.schemaManager, m.logger, object.Class, 1)
 		return nil, fmt.Errorf("put object: %w", err)
 	}
 
@@ -167,11 +178,32 @@ func (m *Manager) validateObjectAndNormalizeNames(ctx context.Context,
 	if err != nil {
 		return err
 	}
+	if err := m.schemaManager.ResolveComputedProperties(class, incoming); err != nil {
+		return err
+	}
 
-	return validation.New(m.vectorRepo.Exists, m.config, repl).
+	return validation.New(m.vectorRepo.Exists, m.config, repl, m.logger).
 		Object(ctx, class, incoming, existing)
 }
 
+// validatePatchAndNormalizeNames validates the updates of a merge like
+// validateObjectAndNormalizeNames, they may omit required properties
+func (m *Manager) validatePatchAndNormalizeNames(ctx context.Context,
+	principal *models.Principal, repl *additional.ReplicationProperties,
+	updates *models.Object, existing *models.Object,
+) error {
+	class, err := m.validateSchema(ctx, principal, updates)
+	if err != nil {
+		return err
+	}
+	if err := m.schemaManager.ResolveComputedProperties(class, updates); err != nil {
+		return err
+	}
+
+	return validation.New(m.vectorRepo.Exists, m.config, repl, m.logger).
+		Patch(ctx, class, updates, existing)
+}
+
 func (m *Manager) validateSchema(ctx context.Context,
 	principal *models.Principal, obj *models.Object,
 ) (*models.Class, error) {
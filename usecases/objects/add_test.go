@@ -34,6 +34,7 @@ func Test_Add_Object_WithNoVectorizerModule(t *testing.T) {
 		vectorRepo      *fakeVectorRepo
 		modulesProvider *fakeModulesProvider
 		manager         *Manager
+		readOnly        = true
 	)
 
 	sch := schema.Schema{
@@ -51,6 +52,12 @@ func Test_Add_Object_WithNoVectorizerModule(t *testing.T) {
 						Skip: true,
 					},
 				},
+				{
+					Class:             "FooReadOnly",
+					Vectorizer:        config.VectorizerModuleNone,
+					VectorIndexConfig: hnsw.UserConfig{},
+					ReadOnly:          &readOnly,
+				},
 			},
 		},
 	}
@@ -103,6 +110,20 @@ func Test_Add_Object_WithNoVectorizerModule(t *testing.T) {
 		assert.Equal(t, uuidDuringCreation, res.ID, "check that connector add ID and user response match")
 	})
 
+	t.Run("in a read-only collection", func(t *testing.T) {
+		reset()
+
+		ctx := context.Background()
+		object := &models.Object{
+			Vector: []float32{0.1, 0.2, 0.3},
+			Class:  "FooReadOnly",
+		}
+
+		_, err := manager.AddObject(ctx, nil, object, nil)
+		assert.Equal(t, ErrCollectionReadOnly{Class: "FooReadOnly"}, err)
+		vectorRepo.AssertNotCalled(t, "PutObject", mock.Anything, mock.Anything)
+	})
+
 	t.Run("with an explicit (correct) ID set", func(t *testing.T) {
 		reset()
 
@@ -125,7 +125,8 @@ func (m *autoSchemaManager) autoSchema(ctx context.Context, principal *models.Pr
 
 			vclasses[schema.UppercaseClassName(object.Class)] = versioned.Class{Class: schemaClass, Version: schemaVersion}
 			classcache.RemoveClassFromContext(ctx, object.Class)
-		} else {
+		} else if schemaClass.StrictPropertyValidation == nil || !*schemaClass.StrictPropertyValidation {
+			// strict classes reject unknown properties in the validation
 			if newProperties := schema.DedupProperties(schemaClass.Properties, properties); len(newProperties) > 0 {
 				err := m.authorizer.Authorize(principal, authorization.UPDATE, authorization.CollectionsMetadata(schemaClass.Class)...)
 				if err != nil {
@@ -160,8 +161,8 @@ func (m *autoSchemaManager) createClass(ctx context.Context, principal *models.P
 	m.logger.
 		WithField("auto_schema", "createClass").
 		Debugf("create class %s", className)
-	newClass, schemaVersion, err := m.schemaManager.AddClass(ctx, principal, class)
-	return newClass, schemaVersion, err
+	res, err := m.schemaManager.AddClass(ctx, principal, class)
+	return res.Class, res.Version, err
 }
 
 func (m *autoSchemaManager) getProperties(object *models.Object) ([]*models.Property, error) {
@@ -747,6 +747,47 @@ func Test_autoSchemaManager_autoSchema_update(t *testing.T) {
 	assert.Equal(t, "int[]", getProperty((schemaAfter.Objects.Classes)[0].Properties, "numberArray").DataType[0])
 }
 
+func Test_autoSchemaManager_autoSchema_strict(t *testing.T) {
+	strict := true
+	logger, _ := test.NewNullLogger()
+	schemaManager := &fakeSchemaManager{
+		GetSchemaResponse: schema.Schema{
+			Objects: &models.Schema{
+				Classes: []*models.Class{
+					{
+						Class:                    "Publication",
+						StrictPropertyValidation: &strict,
+						Properties:               []*models.Property{{Name: "age", DataType: []string{"int"}}},
+					},
+				},
+			},
+		},
+	}
+	autoSchemaManager := &autoSchemaManager{
+		schemaManager: schemaManager,
+		vectorRepo:    &fakeVectorRepo{},
+		config: config.AutoSchema{
+			Enabled:       true,
+			DefaultString: schema.DataTypeText.String(),
+			DefaultNumber: "int",
+		},
+		authorizer: fakeAuthorizer{},
+		logger:     logger,
+	}
+	obj := &models.Object{
+		Class:      "Publication",
+		Properties: map[string]interface{}{"name": "Jodie Sparrow", "age": json.Number("30")},
+	}
+
+	_, err := autoSchemaManager.autoSchema(context.Background(), &models.Principal{}, true, obj)
+	require.Nil(t, err)
+
+	// the unknown property is left to the validation, which rejects it
+	properties := schemaManager.GetSchemaResponse.Objects.Classes[0].Properties
+	require.Len(t, properties, 1)
+	assert.Equal(t, "age", properties[0].Name)
+}
+
 func Test_autoSchemaManager_getProperties(t *testing.T) {
 	type testCase struct {
 		name               string
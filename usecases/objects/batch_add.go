@@ -105,7 +105,11 @@ func (b *BatchManager) addObjects(ctx context.Context, principal *models.Princip
 	if err := b.schemaManager.WaitForUpdate(ctx, maxSchemaVersion); err != nil {
 		return nil, fmt.Errorf("error waiting for local schema to catch up to version %d: %w", maxSchemaVersion, err)
 	}
-	if res, err = b.vectorRepo.BatchPutObjects(ctx, batchObjects, repl, maxSchemaVersion); err != nil {
+	reserved := b.reserveBatchObjects(ctx, principal, batchObjects, repl)
+	res, err = b.vectorRepo.BatchPutObjects(ctx, batchObjects, repl, maxSchemaVersion)
+	// the objects are updated in place with the errors of failed writes
+	b.releaseFailedBatchObjects(ctx, reserved, batchObjects, err != nil)
+	if err != nil {
 		return nil, NewErrInternal("batch objects: %#v", err)
 	}
 
@@ -122,7 +126,7 @@ func (b *BatchManager) validateAndGetVector(ctx context.Context, principal *mode
 		objectsPerClass       = make(map[string][]*models.Object)
 		classPerClassName     = make(map[string]*models.Class)
 		originalIndexPerClass = make(map[string][]int)
-		validator             = validation.New(b.vectorRepo.Exists, b.config, repl)
+		validator             = validation.New(b.vectorRepo.Exists, b.config, repl, b.logger)
 	)
 
 	// validate each object and sort by class (==vectorizer)
@@ -135,6 +139,15 @@ func (b *BatchManager) validateAndGetVector(ctx context.Context, principal *mode
 			continue
 		}
 
+		if err := checkWritable(ctx, b.schemaManager, principal, obj.Class); err != nil {
+			batchObjects[i].Err = err
+			continue
+		}
+
+		if err := b.schemaManager.ResolvePropertyAliases(obj); err != nil {
+			batchObjects[i].Err = err
+			continue
+		}
 		schemaVersion, err := b.autoSchemaManager.autoSchema(ctx, principal, true, obj)
 		if err != nil {
 			batchObjects[i].Err = err
@@ -178,6 +191,10 @@ func (b *BatchManager) validateAndGetVector(ctx context.Context, principal *mode
 		// If it was not changed, same class will be fetched from cache
 		classPerClassName[obj.Class] = class
 
+		if err := b.schemaManager.ResolveComputedProperties(class, obj); err != nil {
+			batchObjects[i].Err = err
+			continue
+		}
 		if err := validator.Object(ctx, class, obj, nil); err != nil {
 			batchObjects[i].Err = err
 			continue
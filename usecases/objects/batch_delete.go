@@ -73,7 +73,11 @@ func (b *BatchManager) DeleteObjectsFromGRPCAfterAuth(ctx context.Context, princ
 	defer b.metrics.BatchDeleteDec()
 
 	deletionTime := time.UnixMilli(b.timeSource.Now())
-	return b.vectorRepo.BatchDeleteObjects(ctx, params, deletionTime, repl, tenant, 0)
+	result, err := b.vectorRepo.BatchDeleteObjects(ctx, params, deletionTime, repl, tenant, 0)
+	if err == nil {
+		b.releaseDeletedObjects(ctx, params.ClassName.String(), result)
+	}
+	return result, err
 }
 
 func (b *BatchManager) deleteObjects(ctx context.Context, principal *models.Principal,
@@ -98,6 +102,7 @@ func (b *BatchManager) deleteObjects(ctx context.Context, principal *models.Prin
 	if err != nil {
 		return nil, fmt.Errorf("batch delete objects: %w", err)
 	}
+	b.releaseDeletedObjects(ctx, params.ClassName.String(), result)
 
 	return b.toResponse(match, params.Output, result)
 }
@@ -77,6 +77,14 @@ type BatchDeleteParams struct {
 	DeletionTime time.Time
 	DryRun       bool
 	Output       string
+	// ModifiedBefore protects objects updated after it from being deleted,
+	// zero means all matches are deleted
+	ModifiedBefore time.Time
+	// ChunkSize is the number of matches whose uuids are looked up and
+	// deleted at a time, zero looks up all matches before deleting them
+	ChunkSize int
+	// ExcludeUUIDs are not deleted even if they match the filters
+	ExcludeUUIDs []strfmt.UUID
 }
 
 type BatchDeleteResult struct {
@@ -85,6 +93,12 @@ type BatchDeleteResult struct {
 	DeletionTime time.Time
 	DryRun       bool
 	Objects      BatchSimpleObjects
+	// Skipped are the matches which were not deleted because of
+	// BatchDeleteParams.ModifiedBefore
+	Skipped int64
+	// Excluded are the matches which were not deleted because of
+	// BatchDeleteParams.ExcludeUUIDs
+	Excluded int64
 }
 
 type BatchDeleteResponse struct {
@@ -70,6 +70,16 @@ func (m *Manager) DeleteObject(ctx context.Context,
 	if err := m.schemaManager.WaitForUpdate(ctx, vclasses[class].Version); err != nil {
 		return fmt.Errorf("error waiting for local schema to catch up to version %d: %w", vclasses[class].Version, err)
 	}
+	// only objects which exist are released from the object limit. Deletes
+	// of the same object on this node are serialized, so that only one of
+	// them releases it.
+	counted := false
+	if c := vclasses[class].Class; c != nil && c.MaxObjects > 0 {
+		key := class + "/" + tenant + "/" + id.String()
+		m.countedDeletes.Lock(key)
+		defer m.countedDeletes.Unlock(key)
+		counted, _ = m.vectorRepo.Exists(ctx, class, id, repl, tenant)
+	}
 	if err = m.vectorRepo.DeleteObject(ctx, class, id, time.UnixMilli(m.timeSource.Now()), repl, tenant, vclasses[class].Version); err != nil {
 		var e1 ErrMultiTenancy
 		if errors.As(err, &e1) {
@@ -81,6 +91,9 @@ func (m *Manager) DeleteObject(ctx context.Context,
 		}
 		return NewErrInternal("could not delete object from vector repo: %v", err)
 	}
+	if counted {
+		releaseObjects(ctx, m.schemaManager, m.logger, class, 1)
+	}
 
 	return nil
 }
@@ -112,6 +125,7 @@ func (m *Manager) deleteObjectFromRepo(ctx context.Context, id strfmt.UUID, dele
 		if err != nil {
 			return NewErrInternal("could not delete object from vector repo: %v", err)
 		}
+		releaseObjects(ctx, m.schemaManager, m.logger, object.Class, 1)
 		deleteCounter++
 	}
 }
@@ -70,6 +70,27 @@ func NewErrInvalidUserInput(format string, args ...interface{}) ErrInvalidUserIn
 	return ErrInvalidUserInput{msg: fmt.Sprintf(format, args...)}
 }
 
+// ErrCollectionReadOnly indicates a write to a collection in read-only mode
+type ErrCollectionReadOnly struct {
+	Class string
+}
+
+func (e ErrCollectionReadOnly) Error() string {
+	return fmt.Sprintf("collection %q is read-only", e.Class)
+}
+
+// ErrClassObjectLimitExceeded indicates a write of objects beyond the
+// MaxObjects of a class. Current is the count when the write was rejected.
+type ErrClassObjectLimitExceeded struct {
+	Class   string
+	Limit   int64
+	Current int64
+}
+
+func (e ErrClassObjectLimitExceeded) Error() string {
+	return fmt.Sprintf("class %q is limited to %d objects and holds %d", e.Class, e.Limit, e.Current)
+}
+
 // ErrInternal indicates something went wrong during processing
 type ErrInternal struct {
 	msg string
@@ -51,6 +51,8 @@ type fakeSchemaManager struct {
 	GetSchemaResponse schema.Schema
 	GetschemaErr      error
 	tenantsEnabled    bool
+	// objectCounts are the reserved objects by class, see ReserveClassObjects
+	objectCounts map[string]int64
 }
 
 func (f *fakeSchemaManager) UpdatePropertyAddDataType(ctx context.Context, principal *models.Principal,
@@ -125,7 +127,7 @@ func (f *fakeSchemaManager) ReadOnlyClass(name string) *models.Class {
 
 func (f *fakeSchemaManager) AddClass(ctx context.Context, principal *models.Principal,
 	class *models.Class,
-) (*models.Class, uint64, error) {
+) (versioned.AddClassResult, error) {
 	if f.GetSchemaResponse.Objects == nil {
 		f.GetSchemaResponse.Objects = schema.Empty().Objects
 	}
@@ -139,7 +141,17 @@ func (f *fakeSchemaManager) AddClass(ctx context.Context, principal *models.Prin
 		classes = []*models.Class{class}
 	}
 	f.GetSchemaResponse.Objects.Classes = classes
-	return class, 0, nil
+	return versioned.AddClassResult{Class: class}, nil
+}
+
+func (f *fakeSchemaManager) ResolvePropertyAliases(object *models.Object) error {
+	return nil
+}
+
+func (f *fakeSchemaManager) ExpandPropertyAliases(object *models.Object) {}
+
+func (f *fakeSchemaManager) ResolveComputedProperties(class *models.Class, object *models.Object) error {
+	return nil
 }
 
 func (f *fakeSchemaManager) AddClassProperty(ctx context.Context, principal *models.Principal,
@@ -186,6 +198,21 @@ func (f *fakeSchemaManager) WaitForUpdate(ctx context.Context, schemaVersion uin
 	return nil
 }
 
+func (f *fakeSchemaManager) ReserveClassObjects(ctx context.Context, class string, count int64) error {
+	if f.objectCounts == nil {
+		f.objectCounts = map[string]int64{}
+	}
+	if c := f.ReadOnlyClass(class); c != nil && c.MaxObjects > 0 && count > 0 && f.objectCounts[class]+count > c.MaxObjects {
+		return errors.New("object limit exceeded")
+	}
+	f.objectCounts[class] = max(f.objectCounts[class]+count, 0)
+	return nil
+}
+
+func (f *fakeSchemaManager) ClassObjectCount(class string) (int64, error) {
+	return f.objectCounts[class], nil
+}
+
 func (f *fakeSchemaManager) StorageCandidates() []string {
 	return []string{}
 }
@@ -53,7 +53,9 @@ func (m *Manager) GetObject(ctx context.Context, principal *models.Principal,
 		m.trackUsageSingle(res)
 	}
 
-	return res.ObjectWithVector(additional.Vector), nil
+	obj := res.ObjectWithVector(additional.Vector)
+	m.schemaManager.ExpandPropertyAliases(obj)
+	return obj, nil
 }
 
 // GetObjects Class from the connected DB
@@ -168,7 +170,11 @@ func (m *Manager) getObjectsFromRepo(ctx context.Context,
 		m.trackUsageList(res)
 	}
 
-	return res.ObjectsWithVector(additional.Vector), nil
+	objs := res.ObjectsWithVector(additional.Vector)
+	for _, obj := range objs {
+		m.schemaManager.ExpandPropertyAliases(obj)
+	}
+	return objs, nil
 }
 
 func (m *Manager) getSort(sort, order *string) []filters.Sort {
@@ -30,6 +30,7 @@ import (
 	"github.com/weaviate/weaviate/entities/schema"
 	"github.com/weaviate/weaviate/entities/schema/crossref"
 	"github.com/weaviate/weaviate/entities/search"
+	esync "github.com/weaviate/weaviate/entities/sync"
 	"github.com/weaviate/weaviate/entities/versioned"
 	"github.com/weaviate/weaviate/usecases/auth/authorization"
 	"github.com/weaviate/weaviate/usecases/config"
@@ -37,7 +38,16 @@ import (
 )
 
 type schemaManager interface {
-	AddClass(ctx context.Context, principal *models.Principal, class *models.Class) (*models.Class, uint64, error)
+	AddClass(ctx context.Context, principal *models.Principal, class *models.Class) (versioned.AddClassResult, error)
+	// ResolveComputedProperties writes the computed properties of class into
+	// object. It fails if object sets one of them.
+	ResolveComputedProperties(class *models.Class, object *models.Object) error
+	// ResolvePropertyAliases moves the values object sets under property
+	// aliases to the properties themselves
+	ResolvePropertyAliases(object *models.Object) error
+	// ExpandPropertyAliases copies the property values of object to all
+	// aliases of the properties
+	ExpandPropertyAliases(object *models.Object)
 	AddTenants(ctx context.Context, principal *models.Principal, class string, tenants []*models.Tenant) (uint64, error)
 	GetClass(ctx context.Context, principal *models.Principal, name string) (*models.Class, error)
 	// ReadOnlyClass return class model.
@@ -65,6 +75,13 @@ type schemaManager interface {
 
 	// GetConsistentSchema retrieves a locally cached copy of the schema
 	GetConsistentSchema(principal *models.Principal, consistency bool) (schema.Schema, error)
+
+	// ReserveClassObjects counts objects against the MaxObjects of a class,
+	// a negative count releases them
+	ReserveClassObjects(ctx context.Context, class string, count int64) error
+	// ClassObjectCount returns the number of objects counted against the
+	// MaxObjects of a class
+	ClassObjectCount(class string) (int64, error)
 }
 
 // Manager manages kind changes at a use-case level, i.e. agnostic of
@@ -81,6 +98,9 @@ type Manager struct {
 	autoSchemaManager *autoSchemaManager
 	metrics           objectsMetrics
 	allocChecker      *memwatch.Monitor
+	// countedDeletes serializes deletes of objects counted against an object
+	// limit, see DeleteObject
+	countedDeletes *esync.KeyLocker
 }
 
 type objectsMetrics interface {
@@ -178,6 +198,7 @@ func NewManager(locks locks, schemaManager schemaManager,
 		autoSchemaManager: newAutoSchemaManager(schemaManager, vectorRepo, config, authorizer, logger),
 		metrics:           metrics,
 		allocChecker:      allocChecker,
+		countedDeletes:    esync.NewKeyLocker(),
 	}
 }
 
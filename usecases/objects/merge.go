@@ -23,6 +23,7 @@ import (
 	"github.com/weaviate/weaviate/entities/schema"
 	"github.com/weaviate/weaviate/entities/schema/crossref"
 	"github.com/weaviate/weaviate/usecases/auth/authorization"
+	autherrs "github.com/weaviate/weaviate/usecases/auth/authorization/errors"
 	"github.com/weaviate/weaviate/usecases/config"
 	"github.com/weaviate/weaviate/usecases/memwatch"
 )
@@ -37,6 +38,13 @@ type MergeDocument struct {
 	UpdateTime           int64                       `json:"updateTime"`
 	AdditionalProperties models.AdditionalProperties `json:"additionalProperties"`
 	PropertiesToDelete   []string                    `json:"propertiesToDelete"`
+	// OnlyMissingProperties only sets the properties of PrimitiveSchema the
+	// object has no value for yet. The object is left as it is if it has a
+	// value for all of them.
+	OnlyMissingProperties bool `json:"onlyMissingProperties,omitempty"`
+	// ExpectedUpdateTime, if set, only merges the document if the object was
+	// last updated at that time, i.e. it wasn't changed since it was read
+	ExpectedUpdateTime int64 `json:"expectedUpdateTime,omitempty"`
 }
 
 func (m *Manager) MergeObject(ctx context.Context, principal *models.Principal,
@@ -62,6 +70,16 @@ func (m *Manager) MergeObject(ctx context.Context, principal *models.Principal,
 	}
 
 	ctx = classcache.ContextWithClassCache(ctx)
+	if err := checkWritable(ctx, m.schemaManager, principal, cls); err != nil {
+		switch {
+		case errors.As(err, &ErrCollectionReadOnly{}):
+			return &Error{"read only", StatusUnprocessableEntity, err}
+		case errors.As(err, &autherrs.Forbidden{}):
+			return &Error{err.Error(), StatusForbidden, err}
+		default:
+			return &Error{"get class", StatusInternalServerError, err}
+		}
+	}
 	obj, err := m.vectorRepo.Object(ctx, cls, id, nil, additional.Properties{}, repl, updates.Tenant)
 	if err != nil {
 		switch err.(type) {
@@ -79,6 +97,9 @@ func (m *Manager) MergeObject(ctx context.Context, principal *models.Principal,
 		return &Error{"not found", StatusNotFound, err}
 	}
 
+	if err := m.schemaManager.ResolvePropertyAliases(updates); err != nil {
+		return &Error{"bad request", StatusBadRequest, NewErrInvalidUserInput("invalid object: %v", err)}
+	}
 	var schemaVersion uint64
 	if schemaVersion, err = m.autoSchemaManager.autoSchema(ctx, principal, false, updates); err != nil {
 		return &Error{"bad request", StatusBadRequest, NewErrInvalidUserInput("invalid object: %v", err)}
@@ -94,7 +115,7 @@ func (m *Manager) MergeObject(ctx context.Context, principal *models.Principal,
 	}
 
 	prevObj := obj.Object()
-	if err := m.validateObjectAndNormalizeNames(
+	if err := m.validatePatchAndNormalizeNames(
 		ctx, principal, repl, updates, prevObj); err != nil {
 		return &Error{"bad request", StatusBadRequest, err}
 	}
@@ -0,0 +1,168 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package objects
+
+import (
+	"context"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// reserveObjects counts count new objects against the MaxObjects of class
+// before they are written. It returns ErrClassObjectLimitExceeded if the
+// limit would be exceeded. Classes without a limit pass.
+func reserveObjects(ctx context.Context, sm schemaManager, class *models.Class, count int64) error {
+	if class == nil || class.MaxObjects <= 0 || count <= 0 {
+		return nil
+	}
+	exceeded := func() error {
+		current, err := sm.ClassObjectCount(class.Class)
+		if err == nil && current+count > class.MaxObjects {
+			return ErrClassObjectLimitExceeded{Class: class.Class, Limit: class.MaxObjects, Current: current}
+		}
+		return nil
+	}
+	// reject early without a schema write if the local count is too high
+	if err := exceeded(); err != nil {
+		return err
+	}
+	if err := sm.ReserveClassObjects(ctx, class.Class, count); err != nil {
+		// the reservation is rejected on the leader, whose error doesn't
+		// carry the counts
+		if limitErr := exceeded(); limitErr != nil {
+			return limitErr
+		}
+		return NewErrInternal("reserve objects of class %q: %v", class.Class, err)
+	}
+	return nil
+}
+
+// releaseObjects removes count objects of className from its object count
+// after deletes or failed writes. Failures only leave the count too high,
+// so they are logged.
+func releaseObjects(ctx context.Context, sm schemaManager, logger logrus.FieldLogger,
+	className string, count int64,
+) {
+	if count <= 0 {
+		return
+	}
+	if class := sm.ReadOnlyClass(className); class == nil || class.MaxObjects <= 0 {
+		return
+	}
+	if err := sm.ReserveClassObjects(ctx, className, -count); err != nil {
+		logger.WithField("action", "release_objects").WithField("class", className).
+			WithError(err).Warn("could not release objects from the object count")
+	}
+}
+
+// reserveBatchObjects reserves the new objects of a batch per class, see
+// reserveObjects. The objects of a class which exceed its limit fail as a
+// whole. Objects which replace existing ones and repeated IDs within the
+// batch are not counted. It returns the indexes of the reserved objects by
+// class.
+func (b *BatchManager) reserveBatchObjects(ctx context.Context, principal *models.Principal,
+	objects BatchObjects, repl *additional.ReplicationProperties,
+) map[string][]int {
+	indexes := map[string][]int{}
+	for i, obj := range objects {
+		if obj.Err == nil && obj.Object != nil {
+			indexes[obj.Object.Class] = append(indexes[obj.Object.Class], i)
+		}
+	}
+
+	reserved := map[string][]int{}
+	for className, idx := range indexes {
+		vclasses, err := b.schemaManager.GetCachedClass(ctx, principal, className)
+		if err != nil {
+			for _, i := range idx {
+				objects[i].Err = err
+			}
+			continue
+		}
+		class := vclasses[className].Class
+		if class == nil || class.MaxObjects <= 0 {
+			continue
+		}
+
+		newIdx, err := b.newBatchObjects(ctx, objects, idx, repl)
+		if err == nil {
+			err = reserveObjects(ctx, b.schemaManager, class, int64(len(newIdx)))
+		}
+		if err != nil {
+			for _, i := range idx {
+				objects[i].Err = err
+			}
+			continue
+		}
+		reserved[className] = newIdx
+	}
+	return reserved
+}
+
+// newBatchObjects returns the indexes of the objects at idx which don't exist
+// yet, each ID once
+func (b *BatchManager) newBatchObjects(ctx context.Context, objects BatchObjects, idx []int,
+	repl *additional.ReplicationProperties,
+) ([]int, error) {
+	var newIdx []int
+	seen := map[string]struct{}{}
+	for _, i := range idx {
+		obj := objects[i].Object
+		key := obj.Tenant + "/" + obj.ID.String()
+		if _, ok := seen[key]; ok {
+			continue
+		}
+		seen[key] = struct{}{}
+
+		exists, err := b.vectorRepo.Exists(ctx, obj.Class, obj.ID, repl, obj.Tenant)
+		if err != nil {
+			return nil, NewErrInternal("check if object %s exists: %v", obj.ID, err)
+		}
+		if !exists {
+			newIdx = append(newIdx, i)
+		}
+	}
+	return newIdx, nil
+}
+
+// releaseFailedBatchObjects releases the reserved objects of a batch which
+// were not written, all of them if the whole batch failed
+func (b *BatchManager) releaseFailedBatchObjects(ctx context.Context, reserved map[string][]int,
+	objects BatchObjects, batchFailed bool,
+) {
+	for className, idx := range reserved {
+		failed := int64(0)
+		for _, i := range idx {
+			if batchFailed || objects[i].Err != nil {
+				failed++
+			}
+		}
+		releaseObjects(ctx, b.schemaManager, b.logger, className, failed)
+	}
+}
+
+// releaseDeletedObjects releases the objects removed by a batch delete
+func (b *BatchManager) releaseDeletedObjects(ctx context.Context, className string, result BatchDeleteResult) {
+	if result.DryRun {
+		return
+	}
+	deleted := int64(0)
+	for _, obj := range result.Objects {
+		if obj.Err == nil {
+			deleted++
+		}
+	}
+	releaseObjects(ctx, b.schemaManager, b.logger, className, deleted)
+}
@@ -0,0 +1,156 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package objects
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+	"github.com/weaviate/weaviate/usecases/auth/authorization/mocks"
+	"github.com/weaviate/weaviate/usecases/config"
+)
+
+func Test_ClassObjectLimit(t *testing.T) {
+	ctx := context.Background()
+	sch := schema.Schema{
+		Objects: &models.Schema{
+			Classes: []*models.Class{
+				{
+					Class:             "Limited",
+					Vectorizer:        config.VectorizerModuleNone,
+					VectorIndexConfig: hnsw.UserConfig{},
+					MaxObjects:        3,
+				},
+				{
+					Class:             "Unlimited",
+					Vectorizer:        config.VectorizerModuleNone,
+					VectorIndexConfig: hnsw.UserConfig{},
+				},
+			},
+		},
+	}
+	newObjects := func(class string, n int) []*models.Object {
+		objects := make([]*models.Object, n)
+		for i := range objects {
+			objects[i] = &models.Object{Class: class, Vector: []float32{0.1, 0.2, 0.3}}
+		}
+		return objects
+	}
+
+	t.Run("single objects", func(t *testing.T) {
+		vectorRepo := &fakeVectorRepo{}
+		vectorRepo.On("PutObject", mock.Anything, mock.Anything).Return(nil)
+		schemaManager := &fakeSchemaManager{GetSchemaResponse: sch}
+		logger, _ := test.NewNullLogger()
+		modulesProvider := getFakeModulesProvider()
+		modulesProvider.On("UpdateVector", mock.Anything, mock.AnythingOfType(FindObjectFn)).Return(nil, nil)
+		manager := NewManager(&fakeLocks{}, schemaManager, &config.WeaviateConfig{}, logger,
+			mocks.NewMockAuthorizer(), vectorRepo, modulesProvider, &fakeMetrics{}, nil)
+
+		var added []*models.Object
+		for _, obj := range newObjects("Limited", 3) {
+			res, err := manager.AddObject(ctx, nil, obj, nil)
+			require.Nil(t, err)
+			added = append(added, res)
+		}
+		_, err := manager.AddObject(ctx, nil, newObjects("Limited", 1)[0], nil)
+		assert.Equal(t, ErrClassObjectLimitExceeded{Class: "Limited", Limit: 3, Current: 3}, err)
+		vectorRepo.AssertNumberOfCalls(t, "PutObject", 3)
+
+		// deletes release objects, failed writes don't count
+		vectorRepo.On("Exists", "Limited", added[0].ID).Return(true, nil)
+		vectorRepo.On("DeleteObject", "Limited", added[0].ID, mock.Anything).Return(nil)
+		require.Nil(t, manager.DeleteObject(ctx, nil, "Limited", added[0].ID, nil, ""))
+		assert.Equal(t, int64(2), schemaManager.objectCounts["Limited"])
+
+		failing := &fakeVectorRepo{}
+		failing.On("Exists", mock.Anything, mock.Anything).Return(false, nil)
+		failing.On("PutObject", mock.Anything, mock.Anything).Return(errors.New("disk full"))
+		manager.vectorRepo = failing
+		_, err = manager.AddObject(ctx, nil, newObjects("Limited", 1)[0], nil)
+		require.NotNil(t, err)
+		assert.Equal(t, int64(2), schemaManager.objectCounts["Limited"])
+
+		// classes without a limit are not counted
+		manager.vectorRepo = vectorRepo
+		_, err = manager.AddObject(ctx, nil, newObjects("Unlimited", 1)[0], nil)
+		require.Nil(t, err)
+		assert.Zero(t, schemaManager.objectCounts["Unlimited"])
+	})
+
+	t.Run("batches", func(t *testing.T) {
+		vectorRepo := &fakeVectorRepo{}
+		existingID := strfmt.UUID("8d5a7f4e-3f5c-4d8b-9e0a-1b2c3d4e5f60")
+		vectorRepo.On("BatchPutObjects", mock.Anything).Return(nil)
+		vectorRepo.On("Exists", "Limited", existingID).Return(true, nil)
+		vectorRepo.On("Exists", mock.Anything, mock.Anything).Return(false, nil)
+		schemaManager := &fakeSchemaManager{GetSchemaResponse: sch}
+		logger, _ := test.NewNullLogger()
+		modulesProvider := getFakeModulesProvider()
+		modulesProvider.On("BatchUpdateVector").Return(nil, nil)
+		manager := NewBatchManager(vectorRepo, modulesProvider, &fakeLocks{}, schemaManager,
+			&config.WeaviateConfig{}, logger, mocks.NewMockAuthorizer(), nil)
+
+		res, err := manager.AddObjects(ctx, nil, newObjects("Limited", 2), nil, nil)
+		require.Nil(t, err)
+		for _, obj := range res {
+			assert.Nil(t, obj.Err)
+		}
+
+		// the objects of a class beyond its limit fail together, other
+		// classes are written
+		objects := append(newObjects("Limited", 2), newObjects("Unlimited", 1)...)
+		res, err = manager.AddObjects(ctx, nil, objects, nil, nil)
+		require.Nil(t, err)
+		limitErr := ErrClassObjectLimitExceeded{Class: "Limited", Limit: 3, Current: 2}
+		assert.Equal(t, limitErr, res[0].Err)
+		assert.Equal(t, limitErr, res[1].Err)
+		assert.Nil(t, res[2].Err)
+		assert.Equal(t, int64(2), schemaManager.objectCounts["Limited"])
+
+		res, err = manager.AddObjects(ctx, nil, newObjects("Limited", 1), nil, nil)
+		require.Nil(t, err)
+		assert.Nil(t, res[0].Err)
+		assert.Equal(t, int64(3), schemaManager.objectCounts["Limited"])
+
+		// batch deletes release the deleted objects
+		vectorRepo.On("BatchDeleteObjects", mock.Anything).Return(BatchDeleteResult{
+			Matches: 2,
+			Objects: BatchSimpleObjects{{UUID: res[0].UUID}, {Err: errors.New("failed")}},
+		}, nil)
+		_, err = manager.DeleteObjectsFromGRPCAfterAuth(ctx, nil, BatchDeleteParams{ClassName: "Limited"}, nil, "")
+		require.Nil(t, err)
+		assert.Equal(t, int64(2), schemaManager.objectCounts["Limited"])
+
+		// existing objects and repeated IDs are counted once
+		newID := strfmt.UUID("0b8c7d6e-5f4a-4b3c-8d2e-1f0a9b8c7d6e")
+		objects = newObjects("Limited", 3)
+		objects[0].ID = existingID
+		objects[1].ID = newID
+		objects[2].ID = newID
+		res, err = manager.AddObjects(ctx, nil, objects, nil, nil)
+		require.Nil(t, err)
+		for _, obj := range res {
+			assert.Nil(t, obj.Err)
+		}
+		assert.Equal(t, int64(3), schemaManager.objectCounts["Limited"])
+	})
+}
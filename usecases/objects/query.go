@@ -109,5 +109,9 @@ func (m *Manager) Query(ctx context.Context, principal *models.Principal, params
 		m.trackUsageList(res)
 	}
 
-	return res.ObjectsWithVector(q.Additional.Vector), nil
+	objs := res.ObjectsWithVector(q.Additional.Vector)
+	for _, obj := range objs {
+		m.schemaManager.ExpandPropertyAliases(obj)
+	}
+	return objs, nil
 }
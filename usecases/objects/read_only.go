@@ -0,0 +1,35 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package objects
+
+import (
+	"context"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// checkWritable returns ErrCollectionReadOnly if className is in read-only
+// mode. Unknown classes pass, they are taken care of by auto schema and
+// validation.
+func checkWritable(ctx context.Context, sm schemaManager,
+	principal *models.Principal, className string,
+) error {
+	vclasses, err := sm.GetCachedClass(ctx, principal, className)
+	if err != nil {
+		return err
+	}
+	if vclass, ok := vclasses[className]; ok && vclass.Class != nil &&
+		vclass.Class.ReadOnly != nil && *vclass.Class.ReadOnly {
+		return ErrCollectionReadOnly{Class: className}
+	}
+	return nil
+}
@@ -71,7 +71,7 @@ func (m *Manager) AddObjectReference(ctx context.Context, principal *models.Prin
 		return &Error{"cannot lock", StatusInternalServerError, err}
 	}
 	defer unlock()
-	validator := validation.New(m.vectorRepo.Exists, m.config, repl)
+	validator := validation.New(m.vectorRepo.Exists, m.config, repl, m.logger)
 	targetRef, reqClass, schemaVersion, err := input.validate(ctx, principal, validator, m.schemaManager)
 	if err != nil {
 		if errors.As(err, &ErrMultiTenancy{}) {
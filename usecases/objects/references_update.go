@@ -84,7 +84,7 @@ func (m *Manager) UpdateObjectReferences(ctx context.Context, principal *models.
 	}
 	defer unlock()
 
-	validator := validation.New(m.vectorRepo.Exists, m.config, repl)
+	validator := validation.New(m.vectorRepo.Exists, m.config, repl, m.logger)
 	parsedTargetRefs, schemaVersion, err := input.validate(ctx, principal, validator, m.schemaManager, tenant)
 	if err != nil {
 		if errors.As(err, &ErrMultiTenancy{}) {
@@ -63,12 +63,18 @@ func (m *Manager) updateObjectToConnectorAndSchema(ctx context.Context,
 	if id != updates.ID {
 		return nil, NewErrInvalidUserInput("invalid update: field 'id' is immutable")
 	}
+	if err := checkWritable(ctx, m.schemaManager, principal, className); err != nil {
+		return nil, err
+	}
 
 	obj, err := m.getObjectFromRepo(ctx, className, id, additional.Properties{}, repl, updates.Tenant)
 	if err != nil {
 		return nil, err
 	}
 
+	if err := m.schemaManager.ResolvePropertyAliases(updates); err != nil {
+		return nil, NewErrInvalidUserInput("invalid object: %v", err)
+	}
 	var schemaVersion uint64
 	if schemaVersion, err = m.autoSchemaManager.autoSchema(ctx, principal, false, updates); err != nil {
 		return nil, NewErrInvalidUserInput("invalid object: %v", err)
@@ -37,6 +37,9 @@ func (m *Manager) ValidateObject(ctx context.Context, principal *models.Principa
 	defer unlock()
 
 	ctx = classcache.ContextWithClassCache(ctx)
+	if err := m.schemaManager.ResolvePropertyAliases(obj); err != nil {
+		return NewErrInvalidUserInput("invalid object: %v", err)
+	}
 	err = m.validateObjectAndNormalizeNames(ctx, principal, repl, obj, nil)
 	if err != nil {
 		return err
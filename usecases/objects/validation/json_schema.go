@@ -0,0 +1,90 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package validation
+
+import (
+	"encoding/base64"
+	"fmt"
+	"sync"
+
+	"github.com/go-openapi/spec"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+)
+
+// PropertyValidationError is returned if the value of a property doesn't
+// match the JSON Schema set in its jsonSchemaValidation
+type PropertyValidationError struct {
+	Class    string
+	Property string
+	Err      error
+}
+
+func (e *PropertyValidationError) Error() string {
+	return fmt.Sprintf("property '%s' on class '%s' does not match its JSON schema: %v",
+		e.Property, e.Class, e.Err)
+}
+
+func (e *PropertyValidationError) Unwrap() error {
+	return e.Err
+}
+
+// jsonSchemas caches the parsed JSON Schema documents by their source, they
+// are validated when the property is added so parsing them again is wasted.
+// The cached schemas are shared by concurrent validations, which is safe as
+// ParseJSONSchema expands all references up front.
+var jsonSchemas sync.Map
+
+func parsedJSONSchema(doc string) (*spec.Schema, error) {
+	if s, ok := jsonSchemas.Load(doc); ok {
+		return s.(*spec.Schema), nil
+	}
+	s, err := schema.ParseJSONSchema(doc)
+	if err != nil {
+		return nil, err
+	}
+	cached, _ := jsonSchemas.LoadOrStore(doc, s)
+	return cached.(*spec.Schema), nil
+}
+
+// validateJSONSchema checks the validated value of a text or blob property
+// against the JSON Schema of the property. Blob values are decoded first.
+func validateJSONSchema(className string, property *models.Property,
+	dataType schema.DataType, value interface{},
+) error {
+	if property.JSONSchemaValidation == nil {
+		return nil
+	}
+
+	var doc []byte
+	switch dataType {
+	case schema.DataTypeText:
+		doc = []byte(value.(string))
+	case schema.DataTypeBlob:
+		decoded, err := base64.StdEncoding.DecodeString(value.(string))
+		if err != nil {
+			return &PropertyValidationError{Class: className, Property: property.Name, Err: err}
+		}
+		doc = decoded
+	default:
+		return nil
+	}
+
+	s, err := parsedJSONSchema(*property.JSONSchemaValidation)
+	if err != nil {
+		return &PropertyValidationError{Class: className, Property: property.Name, Err: err}
+	}
+	if err := schema.ValidateJSONSchemaValue(s, doc); err != nil {
+		return &PropertyValidationError{Class: className, Property: property.Name, Err: err}
+	}
+	return nil
+}
@@ -18,6 +18,7 @@ import (
 	"strings"
 
 	"github.com/go-openapi/strfmt"
+	"github.com/sirupsen/logrus"
 	"github.com/weaviate/weaviate/entities/additional"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/schema/crossref"
@@ -67,15 +68,17 @@ type Validator struct {
 	exists           exists
 	config           *config.WeaviateConfig
 	replicationProps *additional.ReplicationProperties
+	logger           logrus.FieldLogger
 }
 
 func New(exists exists, config *config.WeaviateConfig,
-	repl *additional.ReplicationProperties,
+	repl *additional.ReplicationProperties, logger logrus.FieldLogger,
 ) *Validator {
 	return &Validator{
 		exists:           exists,
 		config:           config,
 		replicationProps: repl,
+		logger:           logger,
 	}
 }
 
@@ -90,6 +93,25 @@ func (v *Validator) Object(ctx context.Context, class *models.Class,
 		return err
 	}
 
+	if err := v.properties(ctx, class, incoming, existing); err != nil {
+		return err
+	}
+	return requiredProperties(class, incoming)
+}
+
+// Patch validates the properties of a merge into existing like Object. The
+// required properties of class may be missing, they are kept from existing.
+func (v *Validator) Patch(ctx context.Context, class *models.Class,
+	incoming *models.Object, existing *models.Object,
+) error {
+	if incoming.Class == "" {
+		return errors.New(ErrorMissingClass)
+	}
+
+	if err := v.vector(ctx, class, incoming); err != nil {
+		return err
+	}
+
 	return v.properties(ctx, class, incoming, existing)
 }
 
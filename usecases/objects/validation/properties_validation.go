@@ -19,6 +19,7 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/schema"
 	"github.com/weaviate/weaviate/entities/schema/crossref"
@@ -37,6 +38,12 @@ const (
 	ErrorMissingSingleRefLocationURL string = "class '%s' with property '%s' requires exactly 3 arguments: 'beacon', 'locationUrl' and 'type'. 'locationUrl' is missing, check your input schema"
 	// ErrorMissingSingleRefType message
 	ErrorMissingSingleRefType string = "class '%s' with property '%s' requires exactly 3 arguments: 'beacon', 'locationUrl' and 'type'. 'type' is missing, check your input schema"
+	// ErrorDeprecatedProperty message
+	ErrorDeprecatedProperty string = "class '%s' with property '%s' is deprecated and class enforces deprecation%s"
+	// WarningDeprecatedProperty message
+	WarningDeprecatedProperty string = "class '%s' with property '%s' is deprecated%s"
+	// ErrorMissingRequiredProperty message
+	ErrorMissingRequiredProperty string = "class '%s' requires a value for property '%s'"
 )
 
 func (v *Validator) properties(ctx context.Context, class *models.Class,
@@ -73,7 +80,12 @@ func (v *Validator) properties(ctx context.Context, class *models.Class,
 
 	for propertyKey, propertyValue := range inputSchema {
 		if propertyValue == nil {
-			continue // nil values are removed and filtered out
+			// nil values are removed and filtered out, which required
+			// properties can't be
+			if property, err := schema.GetPropertyByName(class, schema.LowercaseFirstLetter(propertyKey)); err == nil && isRequired(property) {
+				return fmt.Errorf(ErrorMissingRequiredProperty, class.Class, property.Name)
+			}
+			continue
 		}
 
 		// properties in the class are saved with lower case first letter
@@ -82,6 +94,9 @@ func (v *Validator) properties(ctx context.Context, class *models.Class,
 		if err != nil {
 			return err
 		}
+		if err := v.deprecatedProperty(ctx, class, property); err != nil {
+			return err
+		}
 		dataType, err := schema.GetPropertyDataType(class, propertyKeyLowerCase)
 		if err != nil {
 			return err
@@ -133,6 +148,9 @@ func (v *Validator) properties(ctx context.Context, class *models.Class,
 		if err != nil {
 			return err
 		}
+		if err := validateJSONSchema(className, property, *dataType, data); err != nil {
+			return err
+		}
 
 		returnSchema[propertyKeyLowerCase] = data
 	}
@@ -143,6 +161,49 @@ func (v *Validator) properties(ctx context.Context, class *models.Class,
 	return nil
 }
 
+// deprecatedProperty rejects writes to a deprecated property if the class
+// enforces deprecation. Otherwise the write is logged and added to the
+// warnings of ctx, if it collects them.
+func (v *Validator) deprecatedProperty(ctx context.Context, class *models.Class, property *models.Property) error {
+	if property.Deprecated == nil || !*property.Deprecated {
+		return nil
+	}
+
+	var msg string
+	if property.DeprecationMessage != "" {
+		msg = ": " + property.DeprecationMessage
+	}
+	if class.EnforceDeprecation {
+		return fmt.Errorf(ErrorDeprecatedProperty, class.Class, property.Name, msg)
+	}
+
+	if v.logger != nil {
+		v.logger.WithFields(logrus.Fields{
+			"action":   "validate_deprecated_property",
+			"class":    class.Class,
+			"property": property.Name,
+		}).Warnf("write to deprecated property%s", msg)
+	}
+	addWarning(ctx, fmt.Sprintf(WarningDeprecatedProperty, class.Class, property.Name, msg))
+	return nil
+}
+
+// requiredProperties checks that object has a value for every required
+// property of class, after its properties were validated
+func requiredProperties(class *models.Class, object *models.Object) error {
+	props, _ := object.Properties.(map[string]interface{})
+	for _, property := range class.Properties {
+		if isRequired(property) && props[property.Name] == nil {
+			return fmt.Errorf(ErrorMissingRequiredProperty, class.Class, property.Name)
+		}
+	}
+	return nil
+}
+
+func isRequired(property *models.Property) bool {
+	return property.Required != nil && *property.Required
+}
+
 func nestedPropertiesToMap(nestedProperties []*models.NestedProperty) map[string]*models.NestedProperty {
 	nestedPropertiesMap := map[string]*models.NestedProperty{}
 	for _, nestedProperty := range nestedProperties {
@@ -13,6 +13,7 @@ package validation
 
 import (
 	"context"
+	"encoding/base64"
 	"encoding/json"
 	"fmt"
 	"reflect"
@@ -21,6 +22,8 @@ import (
 
 	"github.com/go-openapi/strfmt"
 	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"github.com/sirupsen/logrus/hooks/test"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/weaviate/weaviate/entities/additional"
@@ -229,6 +232,143 @@ func TestProperties(t *testing.T) {
 	}
 }
 
+func TestProperties_Deprecated(t *testing.T) {
+	vTrue := true
+	newClass := func(enforce bool) *models.Class {
+		return &models.Class{
+			Class:              "Article",
+			EnforceDeprecation: enforce,
+			Properties: []*models.Property{
+				{Name: "title", DataType: schema.DataTypeText.PropString()},
+				{
+					Name: "headline", DataType: schema.DataTypeText.PropString(),
+					Deprecated: &vTrue, DeprecationMessage: "use title instead",
+				},
+			},
+		}
+	}
+	newValidator := func() (*Validator, *test.Hook) {
+		logger, hook := test.NewNullLogger()
+		return &Validator{logger: logger}, hook
+	}
+
+	t.Run("not deprecated property", func(t *testing.T) {
+		validator, hook := newValidator()
+		obj := &models.Object{Class: "Article", Properties: map[string]any{"title": "foo"}}
+		require.NoError(t, validator.properties(context.Background(), newClass(true), obj, nil))
+		assert.Empty(t, hook.AllEntries())
+	})
+
+	t.Run("deprecated property is logged", func(t *testing.T) {
+		validator, hook := newValidator()
+		obj := &models.Object{Class: "Article", Properties: map[string]any{"headline": "foo"}}
+		require.NoError(t, validator.properties(context.Background(), newClass(false), obj, nil))
+		require.Len(t, hook.AllEntries(), 1)
+		assert.Equal(t, logrus.WarnLevel, hook.LastEntry().Level)
+		assert.Equal(t, "headline", hook.LastEntry().Data["property"])
+		assert.Contains(t, hook.LastEntry().Message, "use title instead")
+		assert.Empty(t, WarningsFromContext(context.Background()))
+	})
+
+	t.Run("deprecated property is returned as warning", func(t *testing.T) {
+		validator, hook := newValidator()
+		ctx := ContextWithWarnings(context.Background())
+		obj := &models.Object{Class: "Article", Properties: map[string]any{"title": "foo", "headline": "foo"}}
+		require.NoError(t, validator.properties(ctx, newClass(false), obj, nil))
+		require.Len(t, hook.AllEntries(), 1)
+		assert.Equal(t, []string{
+			"class 'Article' with property 'headline' is deprecated: use title instead",
+		}, WarningsFromContext(ctx))
+	})
+
+	t.Run("deprecated property is rejected", func(t *testing.T) {
+		validator, hook := newValidator()
+		obj := &models.Object{Class: "Article", Properties: map[string]any{"headline": "foo"}}
+		ctx := ContextWithWarnings(context.Background())
+		err := validator.properties(ctx, newClass(true), obj, nil)
+		require.ErrorContains(t, err, "class 'Article' with property 'headline' is deprecated")
+		assert.ErrorContains(t, err, "use title instead")
+		assert.Empty(t, hook.AllEntries())
+		assert.Empty(t, WarningsFromContext(ctx))
+	})
+}
+
+func TestProperties_Required(t *testing.T) {
+	vTrue := true
+	class := &models.Class{
+		Class: "Article",
+		Properties: []*models.Property{
+			{Name: "title", DataType: schema.DataTypeText.PropString(), Required: &vTrue},
+			{Name: "body", DataType: schema.DataTypeText.PropString()},
+		},
+	}
+	validator := &Validator{}
+	newObject := func(props map[string]any) *models.Object {
+		return &models.Object{Class: "Article", Properties: props}
+	}
+
+	t.Run("objects", func(t *testing.T) {
+		require.NoError(t, validator.Object(context.Background(), class, newObject(map[string]any{"title": "a"}), nil))
+
+		err := validator.Object(context.Background(), class, newObject(map[string]any{"body": "a"}), nil)
+		assert.EqualError(t, err, "class 'Article' requires a value for property 'title'")
+		err = validator.Object(context.Background(), class, newObject(nil), nil)
+		assert.EqualError(t, err, "class 'Article' requires a value for property 'title'")
+		err = validator.Object(context.Background(), class, newObject(map[string]any{"title": nil}), nil)
+		assert.EqualError(t, err, "class 'Article' requires a value for property 'title'")
+	})
+
+	t.Run("patches", func(t *testing.T) {
+		require.NoError(t, validator.Patch(context.Background(), class, newObject(map[string]any{"body": "a"}), nil))
+
+		err := validator.Patch(context.Background(), class, newObject(map[string]any{"Title": nil}), nil)
+		assert.EqualError(t, err, "class 'Article' requires a value for property 'title'")
+	})
+}
+
+func TestProperties_JSONSchema(t *testing.T) {
+	jsonSchema := `{"$schema": "http://json-schema.org/draft-04/schema#", "type": "object",
+		"definitions": {"count": {"type": "integer"}}, "required": ["kind"],
+		"properties": {"kind": {"type": "string"}, "count": {"$ref": "#/definitions/count"}}}`
+	class := &models.Class{
+		Class: "Event",
+		Properties: []*models.Property{
+			{Name: "payload", DataType: schema.DataTypeText.PropString(), JSONSchemaValidation: &jsonSchema},
+			{Name: "raw", DataType: schema.DataTypeBlob.PropString(), JSONSchemaValidation: &jsonSchema},
+			{Name: "note", DataType: schema.DataTypeText.PropString()},
+		},
+	}
+	validate := func(props map[string]any) error {
+		logger, _ := test.NewNullLogger()
+		obj := &models.Object{Class: "Event", Properties: props}
+		return (&Validator{logger: logger}).properties(context.Background(), class, obj, nil)
+	}
+	encode := func(s string) string { return base64.StdEncoding.EncodeToString([]byte(s)) }
+
+	t.Run("valid", func(t *testing.T) {
+		require.NoError(t, validate(map[string]any{
+			"payload": `{"kind": "click", "count": 3}`,
+			"raw":     encode(`{"kind": "view"}`),
+			"note":    "not json",
+		}))
+	})
+
+	for name, props := range map[string]map[string]any{
+		"invalid JSON":            {"payload": `{"kind": `},
+		"schema mismatch":         {"payload": `{"count": 3}`},
+		"wrong type":              {"payload": `{"kind": "click", "count": "three"}`},
+		"blob schema mismatch":    {"raw": encode(`{"count": 3}`)},
+		"blob is not JSON at all": {"raw": encode("plain text")},
+	} {
+		t.Run(name, func(t *testing.T) {
+			err := validate(props)
+			var verr *PropertyValidationError
+			require.ErrorAs(t, err, &verr)
+			assert.Equal(t, "Event", verr.Class)
+		})
+	}
+}
+
 func extractBeacon(t *testing.T, props models.PropertySchema) strfmt.URI {
 	require.IsType(t, map[string]any{}, props)
 	require.Contains(t, props.(map[string]any), "inJournal")
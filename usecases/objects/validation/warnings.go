@@ -0,0 +1,56 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package validation
+
+import (
+	"context"
+	"sync"
+)
+
+const warningsKey = "validationWarnings"
+
+type warnings struct {
+	sync.Mutex
+	messages []string
+}
+
+// ContextWithWarnings returns a context which collects the warnings of the
+// objects validated with it, e.g. writes to deprecated properties. Without
+// it the warnings are only logged.
+func ContextWithWarnings(ctx context.Context) context.Context {
+	if ctx.Value(warningsKey) != nil {
+		return ctx
+	}
+	return context.WithValue(ctx, warningsKey, &warnings{})
+}
+
+// WarningsFromContext returns the warnings collected in a context created
+// by ContextWithWarnings
+func WarningsFromContext(ctx context.Context) []string {
+	w, ok := ctx.Value(warningsKey).(*warnings)
+	if !ok {
+		return nil
+	}
+	w.Lock()
+	defer w.Unlock()
+	return append([]string(nil), w.messages...)
+}
+
+func addWarning(ctx context.Context, msg string) {
+	w, ok := ctx.Value(warningsKey).(*warnings)
+	if !ok {
+		return
+	}
+	w.Lock()
+	defer w.Unlock()
+	w.messages = append(w.messages, msg)
+}
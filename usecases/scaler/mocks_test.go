@@ -116,6 +116,11 @@ func (r *fakeNodeResolver) NodeHostname(nodeName string) (string, bool) {
 	return host, ok
 }
 
+// LocalName needed to override the common cluster.NodeSelector
+func (r *fakeNodeResolver) LocalName() string {
+	return r.NodeName
+}
+
 type fakeSource struct {
 	mock.Mock
 }
@@ -172,6 +172,26 @@ func (s *Scaler) scaleOut(ctx context.Context, className string, ssBefore *shard
 	return &ssAfter, nil
 }
 
+// CopyShard copies shard of className from node fromNode to node toNode, which
+// must not hold a replica of it yet. The copy is pushed by fromNode in the
+// same way new replicas are created when scaling out. The sharding state is
+// not changed.
+func (s *Scaler) CopyShard(ctx context.Context, className, shard, fromNode, toNode string) error {
+	dist := ShardDist{shard: []string{toNode}}
+	if fromNode == s.cluster.LocalName() {
+		return s.LocalScaleOut(ctx, className, dist)
+	}
+
+	host, ok := s.cluster.NodeHostname(fromNode)
+	if !ok {
+		return fmt.Errorf("%w, %q", ErrUnresolvedName, fromNode)
+	}
+	if err := s.client.IncreaseReplicationFactor(ctx, host, className, dist); err != nil {
+		return fmt.Errorf("copy shard %q of class %q on node %q: %w", shard, className, fromNode, err)
+	}
+	return nil
+}
+
 // LocalScaleOut syncs local shards with new replicas.
 //
 // This is the meat&bones of this implementation.
@@ -168,3 +168,28 @@ func TestScalerScaleOut(t *testing.T) {
 		assert.Nil(t, err)
 	})
 }
+
+func TestScalerCopyShard(t *testing.T) {
+	ctx := context.Background()
+	t.Run("RemoteShard", func(t *testing.T) {
+		f := newFakeFactory()
+		f.Client.On("IncreaseReplicationFactor", anyVal, "H3", "C", ShardDist{"S3": {"N2"}}).Return(nil)
+		assert.Nil(t, f.Scaler("").CopyShard(ctx, "C", "S3", "N3", "N2"))
+		f.Client.AssertExpectations(t)
+	})
+	t.Run("RemoteShardFails", func(t *testing.T) {
+		f := newFakeFactory()
+		f.Client.On("IncreaseReplicationFactor", anyVal, "H3", "C", anyVal).Return(errAny)
+		assert.ErrorIs(t, f.Scaler("").CopyShard(ctx, "C", "S3", "N3", "N2"), errAny)
+	})
+	t.Run("UnresolvedName", func(t *testing.T) {
+		f := newFakeFactory()
+		delete(f.NodeHostMap, "N3")
+		assert.ErrorIs(t, f.Scaler("").CopyShard(ctx, "C", "S3", "N3", "N2"), ErrUnresolvedName)
+	})
+	t.Run("LocalShard", func(t *testing.T) {
+		f := newFakeFactory()
+		f.Source.On("ShardsBackup", anyVal, anyVal, "C", []string{"S1"}).Return(backup.ClassDescriptor{}, errAny)
+		assert.ErrorIs(t, f.Scaler("").CopyShard(ctx, "C", "S1", "N1", "N2"), errAny)
+	})
+}
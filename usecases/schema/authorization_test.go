@@ -22,8 +22,11 @@ import (
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	"github.com/weaviate/weaviate/entities/models"
+	schemaConfig "github.com/weaviate/weaviate/entities/schema/config"
 	"github.com/weaviate/weaviate/usecases/auth/authorization"
+	authzErrors "github.com/weaviate/weaviate/usecases/auth/authorization/errors"
 	"github.com/weaviate/weaviate/usecases/auth/authorization/mocks"
+	"github.com/weaviate/weaviate/usecases/replica"
 )
 
 // A component-test like test suite that makes sure that every available UC is
@@ -39,6 +42,98 @@ func Test_Schema_Authorization(t *testing.T) {
 	tests := []testCase{
 		{
 			methodName:        "GetSchema",
+			additionalArgs:    []interface{}{replica.One},
+			expectedVerb:      authorization.READ,
+			expectedResources: authorization.CollectionsMetadata(),
+		},
+		{
+			methodName:        "ExportGraphQLSDL",
+			expectedVerb:      authorization.READ,
+			expectedResources: authorization.CollectionsMetadata(),
+		},
+		{
+			methodName:        "GetSchemaDependencyGraph",
+			expectedVerb:      authorization.READ,
+			expectedResources: authorization.CollectionsMetadata(),
+		},
+		{
+			methodName:        "CompactSchema",
+			additionalArgs:    []interface{}{true},
+			expectedVerb:      authorization.UPDATE,
+			expectedResources: []string{authorization.Cluster()},
+		},
+		{
+			methodName:        "RepairDuplicateProperties",
+			additionalArgs:    []interface{}{"Class"},
+			expectedVerb:      authorization.UPDATE,
+			expectedResources: authorization.CollectionsMetadata("Class"),
+		},
+		{
+			methodName:        "GetPropertiesByGroup",
+			additionalArgs:    []interface{}{"className", "group"},
+			expectedVerb:      authorization.READ,
+			expectedResources: authorization.CollectionsMetadata("className"),
+		},
+		{
+			methodName:        "GetPropertyGroups",
+			additionalArgs:    []interface{}{"className"},
+			expectedVerb:      authorization.READ,
+			expectedResources: authorization.CollectionsMetadata("className"),
+		},
+		{
+			methodName:        "SetPropertyGroup",
+			additionalArgs:    []interface{}{"className", &models.PropertyGroup{Name: "group"}, []string{"prop"}},
+			expectedVerb:      authorization.UPDATE,
+			expectedResources: authorization.CollectionsMetadata("className"),
+		},
+		{
+			methodName:        "EstimateClassSize",
+			additionalArgs:    []interface{}{&models.Class{Class: "ClassName"}, int64(10)},
+			expectedVerb:      authorization.READ,
+			expectedResources: authorization.CollectionsMetadata("ClassName"),
+		},
+		{
+			methodName:        "GetSchemaChangelog",
+			additionalArgs:    []interface{}{uint64(0), 0},
+			expectedVerb:      authorization.READ,
+			expectedResources: authorization.CollectionsMetadata(),
+		},
+		{
+			methodName:        "SearchClasses",
+			additionalArgs:    []interface{}{ClassSearchQuery{}},
+			expectedVerb:      authorization.READ,
+			expectedResources: authorization.CollectionsMetadata(),
+		},
+		{
+			methodName:        "RestoreDeletedClass",
+			additionalArgs:    []interface{}{"Class"},
+			expectedVerb:      authorization.CREATE,
+			expectedResources: authorization.CollectionsMetadata("Class"),
+		},
+		{
+			methodName:        "ListDeletedClasses",
+			expectedVerb:      authorization.READ,
+			expectedResources: authorization.CollectionsMetadata(),
+		},
+		{
+			methodName:        "SubscribeSchemaEvents",
+			expectedVerb:      authorization.READ,
+			expectedResources: authorization.CollectionsMetadata(),
+		},
+		{
+			methodName:        "ListClassesByModule",
+			additionalArgs:    []interface{}{"text2vec-openai"},
+			expectedVerb:      authorization.READ,
+			expectedResources: authorization.CollectionsMetadata(),
+		},
+		{
+			methodName:        "ListClassesByVectorizer",
+			additionalArgs:    []interface{}{"text2vec-openai"},
+			expectedVerb:      authorization.READ,
+			expectedResources: authorization.CollectionsMetadata(),
+		},
+		{
+			methodName:        "ValidateSchemaIntegrity",
 			expectedVerb:      authorization.READ,
 			expectedResources: authorization.CollectionsMetadata(),
 		},
@@ -54,6 +149,12 @@ func Test_Schema_Authorization(t *testing.T) {
 			expectedVerb:      authorization.READ,
 			expectedResources: authorization.CollectionsMetadata("classname"),
 		},
+		{
+			methodName:        "GetClassReplicationStatus",
+			additionalArgs:    []interface{}{"classname"},
+			expectedVerb:      authorization.READ,
+			expectedResources: authorization.CollectionsMetadata("Classname"),
+		},
 		{
 			methodName:        "GetConsistentClass",
 			additionalArgs:    []interface{}{"classname", false},
@@ -72,12 +173,150 @@ func Test_Schema_Authorization(t *testing.T) {
 			expectedVerb:      authorization.CREATE,
 			expectedResources: authorization.CollectionsMetadata("Classname"),
 		},
+		{
+			methodName:        "CloneClass",
+			additionalArgs:    []interface{}{"source", "target", (*ClassCloneOverrides)(nil)},
+			expectedVerb:      authorization.READ,
+			expectedResources: authorization.CollectionsMetadata("Source"),
+		},
+		{
+			methodName:        "SetClassLabels",
+			additionalArgs:    []interface{}{"class", map[string]*string{}},
+			expectedVerb:      authorization.UPDATE,
+			expectedResources: authorization.CollectionsMetadata("class"),
+		},
+		{
+			methodName:        "SetClassAnnotations",
+			additionalArgs:    []interface{}{"class", map[string]*string{}},
+			expectedVerb:      authorization.UPDATE,
+			expectedResources: authorization.CollectionsMetadata("class"),
+		},
+		{
+			methodName:        "MigrateInvertedIndex",
+			additionalArgs:    []interface{}{"class", &models.InvertedIndexConfig{}},
+			expectedVerb:      authorization.UPDATE,
+			expectedResources: authorization.CollectionsMetadata("class"),
+		},
+		{
+			methodName:        "GetInvertedIndexMigration",
+			additionalArgs:    []interface{}{"class", "id"},
+			expectedVerb:      authorization.READ,
+			expectedResources: authorization.CollectionsMetadata("class"),
+		},
+		{
+			methodName:        "UpdateVectorIndexConfig",
+			additionalArgs:    []interface{}{"Class", map[string]schemaConfig.VectorIndexConfig{}},
+			expectedVerb:      authorization.UPDATE,
+			expectedResources: authorization.CollectionsMetadata("Class"),
+		},
+		{
+			methodName:        "MoveShard",
+			additionalArgs:    []interface{}{"class", "shard", "node-1", "node-2"},
+			expectedVerb:      authorization.UPDATE,
+			expectedResources: authorization.ShardsMetadata("class", "shard"),
+		},
+		{
+			methodName:        "ShardMoveStatus",
+			additionalArgs:    []interface{}{"id"},
+			expectedVerb:      authorization.UPDATE,
+			expectedResources: authorization.ShardsMetadata("Class", "shard"),
+		},
+		{
+			methodName:        "MigrateTenant",
+			additionalArgs:    []interface{}{"source", "target", "tenant", true},
+			expectedVerb:      authorization.DELETE,
+			expectedResources: authorization.ShardsMetadata("Source", "tenant"),
+		},
+		{
+			methodName:        "TenantMigrationStatus",
+			additionalArgs:    []interface{}{"id"},
+			expectedVerb:      authorization.READ,
+			expectedResources: authorization.ShardsMetadata("Source", "tenant"),
+		},
 		{
 			methodName:        "UpdateClass",
 			additionalArgs:    []interface{}{"class", &models.Class{Class: "class"}},
 			expectedVerb:      authorization.UPDATE,
 			expectedResources: authorization.CollectionsMetadata("class"),
 		},
+		{
+			methodName:        "Authorize",
+			additionalArgs:    []interface{}{ACLActionRead, "class"},
+			expectedVerb:      authorization.READ,
+			expectedResources: authorization.CollectionsData("Class"),
+		},
+		{
+			methodName:        "SetClassACL",
+			additionalArgs:    []interface{}{"class", []*models.ACLEntry{}},
+			expectedVerb:      authorization.CREATE,
+			expectedResources: authorization.CollectionsMetadata("Class"),
+		},
+		{
+			methodName:        "ImportClassFromRemote",
+			additionalArgs:    []interface{}{"http://remote", "key", "Source", "Target", RemoteImportOptions{}},
+			expectedVerb:      authorization.CREATE,
+			expectedResources: authorization.CollectionsMetadata("Target"),
+		},
+		{
+			methodName:        "GetPropertyAccessStats",
+			additionalArgs:    []interface{}{"Class"},
+			expectedVerb:      authorization.READ,
+			expectedResources: authorization.CollectionsMetadata("Class"),
+		},
+		{
+			methodName:        "ValidateObjectAgainstClass",
+			additionalArgs:    []interface{}{"Class", map[string]interface{}{}},
+			expectedVerb:      authorization.READ,
+			expectedResources: authorization.CollectionsMetadata("Class"),
+		},
+		{
+			methodName:        "GenerateIndexingRecommendations",
+			additionalArgs:    []interface{}{"Class"},
+			expectedVerb:      authorization.READ,
+			expectedResources: authorization.CollectionsMetadata("Class"),
+		},
+		{
+			methodName:        "AddPropertyAlias",
+			additionalArgs:    []interface{}{"class", "prop", "alias"},
+			expectedVerb:      authorization.UPDATE,
+			expectedResources: authorization.CollectionsMetadata("Class"),
+		},
+		{
+			methodName:        "UpdateMultiTenancyConfig",
+			additionalArgs:    []interface{}{"class", models.MultiTenancyConfig{}},
+			expectedVerb:      authorization.UPDATE,
+			expectedResources: authorization.CollectionsMetadata("Class"),
+		},
+		{
+			methodName:        "GetClassACL",
+			additionalArgs:    []interface{}{"class"},
+			expectedVerb:      authorization.READ,
+			expectedResources: authorization.CollectionsMetadata("Class"),
+		},
+		{
+			methodName:        "GetClassDependents",
+			additionalArgs:    []interface{}{"class"},
+			expectedVerb:      authorization.READ,
+			expectedResources: authorization.CollectionsMetadata(),
+		},
+		{
+			methodName:        "SafeDeleteClass",
+			additionalArgs:    []interface{}{"class"},
+			expectedVerb:      authorization.READ,
+			expectedResources: authorization.CollectionsMetadata(),
+		},
+		{
+			methodName:        "UpdateClassWithMask",
+			additionalArgs:    []interface{}{"class", &models.Class{}, []string{"description"}},
+			expectedVerb:      authorization.UPDATE,
+			expectedResources: authorization.CollectionsMetadata("Class"),
+		},
+		{
+			methodName:        "SetCollectionReadOnly",
+			additionalArgs:    []interface{}{"classname", true},
+			expectedVerb:      authorization.UPDATE,
+			expectedResources: authorization.CollectionsMetadata("classname"),
+		},
 		{
 			methodName:        "DeleteClass",
 			additionalArgs:    []interface{}{"somename"},
@@ -90,6 +329,60 @@ func Test_Schema_Authorization(t *testing.T) {
 			expectedVerb:      authorization.UPDATE,
 			expectedResources: authorization.CollectionsMetadata("classname"),
 		},
+		{
+			methodName:        "AddProperty",
+			additionalArgs:    []interface{}{"classname", &models.Property{}},
+			expectedVerb:      authorization.UPDATE,
+			expectedResources: authorization.CollectionsMetadata("Classname"),
+		},
+		{
+			methodName:        "AddPropertyWithBackfill",
+			additionalArgs:    []interface{}{"classname", &models.Property{}},
+			expectedVerb:      authorization.UPDATE,
+			expectedResources: authorization.CollectionsMetadata("Classname"),
+		},
+		{
+			methodName:        "AddClassPropertyWithBackfill",
+			additionalArgs:    []interface{}{&models.Class{Class: "classname"}, "classname", false, &models.Property{}},
+			expectedVerb:      authorization.UPDATE,
+			expectedResources: authorization.CollectionsMetadata("classname"),
+		},
+		{
+			methodName:        "BackfillStatus",
+			additionalArgs:    []interface{}{"job"},
+			expectedVerb:      authorization.READ,
+			expectedResources: authorization.CollectionsMetadata(""),
+		},
+		{
+			methodName:        "BackfillVectors",
+			additionalArgs:    []interface{}{"classname", BackfillVectorOptions{}},
+			expectedVerb:      authorization.UPDATE,
+			expectedResources: authorization.CollectionsData("classname"),
+		},
+		{
+			methodName:        "ListBatchDeleteHistory",
+			additionalArgs:    []interface{}{BatchDeleteHistoryQuery{Collection: "C"}},
+			expectedVerb:      authorization.READ,
+			expectedResources: authorization.CollectionsData("C"),
+		},
+		{
+			methodName:        "BackfillVectorStatus",
+			additionalArgs:    []interface{}{"job"},
+			expectedVerb:      authorization.READ,
+			expectedResources: authorization.CollectionsData(""),
+		},
+		{
+			methodName:        "GetPropertyStats",
+			additionalArgs:    []interface{}{"classname", "someprop"},
+			expectedVerb:      authorization.READ,
+			expectedResources: authorization.CollectionsMetadata("Classname"),
+		},
+		{
+			methodName:        "GetPropertyByName",
+			additionalArgs:    []interface{}{"classname", "someprop"},
+			expectedVerb:      authorization.READ,
+			expectedResources: authorization.CollectionsMetadata("Classname"),
+		},
 		{
 			methodName:        "DeleteClassProperty",
 			additionalArgs:    []interface{}{"somename", "someprop"},
@@ -102,12 +395,33 @@ func Test_Schema_Authorization(t *testing.T) {
 			expectedVerb:      authorization.UPDATE,
 			expectedResources: authorization.ShardsMetadata("className", "shardName"),
 		},
+		{
+			methodName:        "BulkAddProperty",
+			additionalArgs:    []interface{}{[]ClassPropertyAddition{{Class: "className", Property: &models.Property{}}}},
+			expectedVerb:      authorization.UPDATE,
+			expectedResources: authorization.CollectionsMetadata("ClassName"),
+		},
+		{
+			methodName: "AddClassesFromOpenAPI",
+			additionalArgs: []interface{}{
+				[]byte(`{"openapi": "3.0.0", "components": {"schemas": {"className": {"type": "object"}}}}`),
+				OpenAPIImportOptions{},
+			},
+			expectedVerb:      authorization.CREATE,
+			expectedResources: authorization.CollectionsMetadata("ClassName"),
+		},
 		{
 			methodName:        "ShardsStatus",
 			additionalArgs:    []interface{}{"className", "tenant"},
 			expectedVerb:      authorization.READ,
 			expectedResources: authorization.ShardsMetadata("className", "tenant"),
 		},
+		{
+			methodName:        "GetShardObjectCount",
+			additionalArgs:    []interface{}{"className", "shardName"},
+			expectedVerb:      authorization.READ,
+			expectedResources: authorization.ShardsMetadata("className", "shardName"),
+		},
 		{
 			methodName:        "AddTenants",
 			additionalArgs:    []interface{}{"className", []*models.Tenant{{Name: "P1"}}},
@@ -122,12 +436,26 @@ func Test_Schema_Authorization(t *testing.T) {
 			expectedVerb:      authorization.UPDATE,
 			expectedResources: authorization.ShardsMetadata("className", "P1"),
 		},
+		{
+			methodName: "UpdateTenantsStatus",
+			additionalArgs: []interface{}{"className", []TenantStatusUpdate{
+				{Name: "P1", Status: models.TenantActivityStatusINACTIVE},
+			}},
+			expectedVerb:      authorization.UPDATE,
+			expectedResources: authorization.ShardsMetadata("ClassName"),
+		},
 		{
 			methodName:        "DeleteTenants",
 			additionalArgs:    []interface{}{"className", []string{"P1"}},
 			expectedVerb:      authorization.DELETE,
 			expectedResources: authorization.ShardsMetadata("className", "P1"),
 		},
+		{
+			methodName:        "PurgeTenant",
+			additionalArgs:    []interface{}{"className", "P1"},
+			expectedVerb:      authorization.DELETE,
+			expectedResources: authorization.ShardsMetadata("className", "P1"),
+		},
 		{
 			methodName:        "GetTenants",
 			additionalArgs:    []interface{}{"className"},
@@ -140,12 +468,47 @@ func Test_Schema_Authorization(t *testing.T) {
 			expectedVerb:      authorization.READ,
 			expectedResources: authorization.ShardsMetadata("className"),
 		},
+		{
+			methodName:        "GetConsistentTenantsPage",
+			additionalArgs:    []interface{}{"className", []string{}, "", 0},
+			expectedVerb:      authorization.READ,
+			expectedResources: authorization.ShardsMetadata("className"),
+		},
+		{
+			methodName:        "TriggerSnapshot",
+			expectedVerb:      authorization.UPDATE,
+			expectedResources: []string{authorization.Cluster()},
+		},
+		{
+			methodName:        "RebalanceStatus",
+			additionalArgs:    []interface{}{"jobID"},
+			expectedVerb:      authorization.READ,
+			expectedResources: []string{authorization.Cluster()},
+		},
+		{
+			methodName:        "RollbackToVersion",
+			additionalArgs:    []interface{}{uint64(1)},
+			expectedVerb:      authorization.UPDATE,
+			expectedResources: []string{authorization.Cluster()},
+		},
 		{
 			methodName:        "ConsistentTenantExists",
 			additionalArgs:    []interface{}{"className", false, "P1"},
 			expectedVerb:      authorization.READ,
 			expectedResources: authorization.ShardsMetadata("className"),
 		},
+		{
+			methodName:        "GetTenantStatus",
+			additionalArgs:    []interface{}{"className", "P1"},
+			expectedVerb:      authorization.READ,
+			expectedResources: authorization.ShardsMetadata("className", "P1"),
+		},
+		{
+			methodName:        "PrewarmTenant",
+			additionalArgs:    []interface{}{"className", "P1"},
+			expectedVerb:      authorization.READ,
+			expectedResources: authorization.ShardsMetadata("ClassName", "P1"),
+		},
 	}
 
 	t.Run("verify that a test for every public method exists", func(t *testing.T) {
@@ -166,7 +529,37 @@ func Test_Schema_Authorization(t *testing.T) {
 				// Cluster/nodes related endpoint
 				"JoinNode", "RemoveNode", "Nodes", "NodeName", "ClusterHealthScore", "ClusterStatus", "ResolveParentNodes",
 				// revert to schema v0 (non raft)
-				"StoreSchemaV1":
+				"StoreSchemaV1",
+				// filters per class instead of aborting, see Test_Schema_FilteredAuthorization
+				"GetSchemaFiltered",
+				// only waits for the local schema, no data is returned
+				"WaitForSchemaConsistency", "ReadAtToken",
+				// wiring of schema event listeners and validators, not user facing
+				"RegisterListener", "UnregisterListener", "RegisterClassValidator", "SchemaEventListener",
+				// computes values of objects being written, no schema access
+				"ResolveComputedProperties",
+				// rename properties of objects being written and read, the
+				// objects are authorized by the objects manager
+				"ResolvePropertyAliases", "ExpandPropertyAliases",
+				// bookkeeping of the queries, see GetPropertyAccessStats
+				"RecordPropertyAccess", "PropertyAccessListener",
+				// startup check, logs only
+				"WarnDuplicateProperties",
+				// object counts of writes, which the objects manager authorizes
+				"ReserveClassObjects",
+				// history of batch deletes, which the gRPC service authorizes
+				"RecordBatchDelete",
+				// wiring at startup, not user facing
+				"WithMetrics", "WithReplicationChecker", "WithBackupScheduler", "WithKMSClient",
+				"StartExpiryWorker",
+				// the methods of the TxnHandler authorize each change
+				"WithTransaction",
+				// authorization errors are sent on the error channel, see TestHandler_StreamClassInfo
+				"StreamClassInfo",
+				// operator override without principal, see GET /v1/meta for the effective value
+				"DefaultConsistencyLevel", "SetDefaultConsistencyLevel",
+				// ends a subscription authorized by SubscribeSchemaEvents
+				"UnsubscribeSchemaEvents":
 				// don't require auth on methods which are exported because other
 				// packages need to call them for maintenance and other regular jobs,
 				// but aren't user facing
@@ -184,10 +577,23 @@ func Test_Schema_Authorization(t *testing.T) {
 				authorizer.SetErr(errors.New("just a test fake"))
 				handler, fakeSchemaManager := newTestHandlerWithCustomAuthorizer(t, &fakeDB{}, authorizer)
 				fakeSchemaManager.On("ReadOnlySchema").Return(models.Schema{})
-				fakeSchemaManager.On("ReadOnlyClass", mock.Anything).Return(models.Class{})
+				fakeSchemaManager.On("ReadOnlyClass", mock.Anything).Return(&models.Class{})
+				fakeSchemaManager.On("BackfillStatus", mock.Anything).Return(BackfillStatus{ID: "job"}, true)
+				fakeSchemaManager.On("VectorBackfillStatus", mock.Anything).Return(VectorBackfillStatus{ID: "job"}, true)
+				handler.shardMoves.Add("id", MoveStatus{ID: "id", Class: "Class", Shard: "shard"})
+				handler.tenantMigrations.Add("id", TenantMigrationStatus{ID: "id", SourceClass: "Source", Tenant: "tenant"})
 
 				var args []interface{}
-				if test.methodName == "GetSchema" || test.methodName == "GetConsistentSchema" {
+				if test.methodName == "GetSchema" || test.methodName == "GetConsistentSchema" ||
+					test.methodName == "ValidateSchemaIntegrity" || test.methodName == "GetPropertyByName" ||
+					test.methodName == "SearchClasses" || test.methodName == "GetPropertiesByGroup" ||
+					test.methodName == "GetPropertyGroups" || test.methodName == "EstimateClassSize" ||
+					test.methodName == "Authorize" || test.methodName == "GetClassDependents" ||
+					test.methodName == "GetPropertyAccessStats" || test.methodName == "GenerateIndexingRecommendations" ||
+					test.methodName == "ListClassesByModule" || test.methodName == "ListClassesByVectorizer" ||
+					test.methodName == "ValidateObjectAgainstClass" || test.methodName == "ListDeletedClasses" ||
+					test.methodName == "SubscribeSchemaEvents" || test.methodName == "ExportGraphQLSDL" ||
+					test.methodName == "GetSchemaDependencyGraph" || test.methodName == "GetSchemaChangelog" {
 					// no context on this method
 					args = append([]interface{}{principal}, test.additionalArgs...)
 				} else {
@@ -205,6 +611,59 @@ func Test_Schema_Authorization(t *testing.T) {
 	})
 }
 
+func Test_Schema_FilteredAuthorization(t *testing.T) {
+	principal := &models.Principal{Username: "tenant-a"}
+	fullSchema := models.Schema{Classes: []*models.Class{
+		{Class: "ClassA"},
+		{Class: "ClassB"},
+		{Class: "ClassC"},
+	}}
+
+	t.Run("only authorized classes are returned", func(t *testing.T) {
+		authorizer := mocks.NewAuthorizer(t)
+		authorizer.On("Authorize", principal, authorization.READ, authorization.CollectionsMetadata("ClassA")[0]).Return(nil)
+		authorizer.On("Authorize", principal, authorization.READ, authorization.CollectionsMetadata("ClassB")[0]).
+			Return(authzErrors.NewForbidden(principal, authorization.READ, authorization.CollectionsMetadata("ClassB")...))
+		authorizer.On("Authorize", principal, authorization.READ, authorization.CollectionsMetadata("ClassC")[0]).Return(nil)
+
+		handler, fakeSchemaManager := newTestHandlerWithCustomAuthorizer(t, &fakeDB{}, authorizer)
+		fakeSchemaManager.On("ReadOnlySchema").Return(fullSchema)
+
+		sch, err := handler.GetSchemaFiltered(principal)
+		require.Nil(t, err)
+		require.NotNil(t, sch.Objects)
+		require.Len(t, sch.Objects.Classes, 2)
+		assert.Equal(t, "ClassA", sch.Objects.Classes[0].Class)
+		assert.Equal(t, "ClassC", sch.Objects.Classes[1].Class)
+	})
+
+	t.Run("no authorized classes returns an empty schema", func(t *testing.T) {
+		authorizer := mocks.NewAuthorizer(t)
+		authorizer.On("Authorize", principal, authorization.READ, mock.Anything).
+			Return(authzErrors.NewForbidden(principal, authorization.READ, authorization.CollectionsMetadata()...))
+
+		handler, fakeSchemaManager := newTestHandlerWithCustomAuthorizer(t, &fakeDB{}, authorizer)
+		fakeSchemaManager.On("ReadOnlySchema").Return(fullSchema)
+
+		sch, err := handler.GetSchemaFiltered(principal)
+		require.Nil(t, err)
+		require.NotNil(t, sch.Objects)
+		assert.Empty(t, sch.Objects.Classes)
+	})
+
+	t.Run("non authorization errors abort the request", func(t *testing.T) {
+		authorizer := mocks.NewAuthorizer(t)
+		authorizer.On("Authorize", principal, authorization.READ, mock.Anything).
+			Return(errors.New("authorizer unavailable")).Once()
+
+		handler, fakeSchemaManager := newTestHandlerWithCustomAuthorizer(t, &fakeDB{}, authorizer)
+		fakeSchemaManager.On("ReadOnlySchema").Return(fullSchema)
+
+		_, err := handler.GetSchemaFiltered(principal)
+		assert.EqualError(t, err, "authorizer unavailable")
+	})
+}
+
 // inspired by https://stackoverflow.com/a/33008200
 func callFuncByName(manager interface{}, funcName string, params ...interface{}) (out []reflect.Value, err error) {
 	managerValue := reflect.ValueOf(manager)
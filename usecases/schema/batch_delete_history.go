@@ -0,0 +1,71 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	command "github.com/weaviate/weaviate/cluster/proto/api"
+	clusterSchema "github.com/weaviate/weaviate/cluster/schema"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
+)
+
+type (
+	// BatchDeleteHistoryEntry is a batch delete kept in the history, see
+	// RecordBatchDelete
+	BatchDeleteHistoryEntry = clusterSchema.BatchDeleteHistoryEntry
+	// BatchDeleteHistoryQuery selects entries of ListBatchDeleteHistory
+	BatchDeleteHistoryQuery = clusterSchema.BatchDeleteHistoryQuery
+)
+
+// RecordBatchDelete adds a batch delete which has been run to the history.
+// The history is kept by the schema so that all nodes have the batch deletes
+// of the whole cluster, only the last clusterSchema.MaxBatchDeleteHistory
+// are retained.
+func (h *Handler) RecordBatchDelete(ctx context.Context, entry BatchDeleteHistoryEntry) error {
+	_, err := h.schemaManager.RecordBatchDelete(ctx, &command.RecordBatchDeleteRequest{
+		Collection:  entry.Collection,
+		Request:     entry.Request,
+		Reply:       entry.Reply,
+		Peer:        entry.Peer,
+		StartedAt:   entry.StartedAt.UnixMilli(),
+		CompletedAt: entry.CompletedAt.UnixMilli(),
+	})
+	return err
+}
+
+// ListBatchDeleteHistory returns the recorded batch deletes selected by q,
+// oldest first, and whether there are more after them. Without a collection
+// principal needs to be allowed to read the data of all collections.
+func (h *Handler) ListBatchDeleteHistory(ctx context.Context, principal *models.Principal,
+	q BatchDeleteHistoryQuery,
+) ([]BatchDeleteHistoryEntry, bool, error) {
+	if q.Collection != "" {
+		q.Collection = schema.UppercaseClassName(q.Collection)
+	}
+	if err := h.Authorizer.Authorize(principal, authorization.READ, authorization.CollectionsData(q.Collection)...); err != nil {
+		return nil, false, err
+	}
+	if q.Limit < 0 {
+		return nil, false, fmt.Errorf("limit must not be negative, got %d: %w", q.Limit, clusterSchema.ErrBadRequest)
+	}
+	if !q.From.IsZero() && !q.To.IsZero() && !q.From.Before(q.To) {
+		return nil, false, fmt.Errorf("from %s must be before to %s: %w",
+			q.From.Format(time.RFC3339), q.To.Format(time.RFC3339), clusterSchema.ErrBadRequest)
+	}
+	entries, more := h.schemaReader.BatchDeleteHistory(q)
+	return entries, more, nil
+}
@@ -0,0 +1,59 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	command "github.com/weaviate/weaviate/cluster/proto/api"
+	clusterSchema "github.com/weaviate/weaviate/cluster/schema"
+)
+
+func TestHandler_BatchDeleteHistory(t *testing.T) {
+	ctx := context.Background()
+	handler, fakeSchemaManager := newTestHandler(t, &fakeDB{})
+	started := time.UnixMilli(1_700_000_000_000).UTC()
+
+	fakeSchemaManager.On("RecordBatchDelete", &command.RecordBatchDeleteRequest{
+		Collection:  "Car",
+		Request:     []byte("request"),
+		Reply:       []byte("reply"),
+		Peer:        "10.0.0.1:5000",
+		StartedAt:   started.UnixMilli(),
+		CompletedAt: started.Add(time.Second).UnixMilli(),
+	}).Return(nil)
+	require.Nil(t, handler.RecordBatchDelete(ctx, BatchDeleteHistoryEntry{
+		Collection:  "Car",
+		Request:     []byte("request"),
+		Reply:       []byte("reply"),
+		Peer:        "10.0.0.1:5000",
+		StartedAt:   started,
+		CompletedAt: started.Add(time.Second),
+	}))
+
+	q := BatchDeleteHistoryQuery{Collection: "Car", From: started, Limit: 10}
+	entries := []BatchDeleteHistoryEntry{{ID: 7, Collection: "Car", StartedAt: started}}
+	fakeSchemaManager.On("BatchDeleteHistory", q).Return(entries, true)
+	got, more, err := handler.ListBatchDeleteHistory(ctx, nil, q)
+	require.Nil(t, err)
+	assert.Equal(t, entries, got)
+	assert.True(t, more)
+
+	_, _, err = handler.ListBatchDeleteHistory(ctx, nil, BatchDeleteHistoryQuery{Limit: -1})
+	assert.ErrorIs(t, err, clusterSchema.ErrBadRequest)
+	_, _, err = handler.ListBatchDeleteHistory(ctx, nil, BatchDeleteHistoryQuery{From: started, To: started})
+	assert.ErrorIs(t, err, clusterSchema.ErrBadRequest)
+}
@@ -17,7 +17,9 @@ import (
 	"fmt"
 	"os"
 	"reflect"
+	"slices"
 	"strings"
+	"time"
 
 	entcfg "github.com/weaviate/weaviate/entities/config"
 	"github.com/weaviate/weaviate/entities/replication"
@@ -26,10 +28,12 @@ import (
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/sirupsen/logrus"
 	"github.com/weaviate/weaviate/adapters/repos/db/inverted/stopwords"
+	clusterSchema "github.com/weaviate/weaviate/cluster/schema"
 	"github.com/weaviate/weaviate/entities/backup"
 	"github.com/weaviate/weaviate/entities/classcache"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/schema"
+	schemaConfig "github.com/weaviate/weaviate/entities/schema/config"
 	"github.com/weaviate/weaviate/entities/vectorindex"
 	"github.com/weaviate/weaviate/entities/versioned"
 	"github.com/weaviate/weaviate/usecases/auth/authorization"
@@ -41,10 +45,10 @@ import (
 )
 
 func (h *Handler) GetClass(ctx context.Context, principal *models.Principal, name string) (*models.Class, error) {
+	name = schema.UppercaseClassName(name)
 	if err := h.Authorizer.Authorize(principal, authorization.READ, authorization.CollectionsMetadata(name)...); err != nil {
 		return nil, err
 	}
-	name = schema.UppercaseClassName(name)
 
 	cl := h.schemaReader.ReadOnlyClass(name)
 	return cl, nil
@@ -61,10 +65,10 @@ func (h *Handler) GetConsistentClass(ctx context.Context, principal *models.Prin
 
 	if consistency {
 		vclasses, err := h.schemaManager.QueryReadOnlyClasses(name)
-		return vclasses[name].Class, vclasses[name].Version, err
+		return h.redactEncryptionKey(principal, vclasses[name].Class), vclasses[name].Version, err
 	}
 	class, err := h.schemaReader.ReadOnlyClassWithVersion(ctx, name, 0)
-	return class, 0, err
+	return h.redactEncryptionKey(principal, class), 0, err
 }
 
 func (h *Handler) GetCachedClass(ctxWithClassCache context.Context,
@@ -103,16 +107,20 @@ func (h *Handler) GetCachedClass(ctxWithClassCache context.Context,
 // AddClass to the schema
 func (h *Handler) AddClass(ctx context.Context, principal *models.Principal,
 	cls *models.Class,
-) (*models.Class, uint64, error) {
+) (AddClassResult, error) {
+	defer h.metrics.track(opAddClass)()
+
 	cls.Class = schema.UppercaseClassName(cls.Class)
 	cls.Properties = schema.LowercaseAllPropertyNames(cls.Properties)
+	// the object count is kept by the schema, see ReserveClassObjects
+	cls.ObjectCount = 0
 
 	err := h.Authorizer.Authorize(principal, authorization.CREATE, authorization.CollectionsMetadata(cls.Class)...)
 	if err != nil {
-		return nil, 0, err
+		return AddClassResult{}, err
 	}
 	if err := h.Authorizer.Authorize(principal, authorization.READ, authorization.CollectionsMetadata(cls.Class)...); err != nil {
-		return nil, 0, err
+		return AddClassResult{}, err
 	}
 
 	classGetterWithAuth := func(name string) (*models.Class, error) {
@@ -123,29 +131,36 @@ func (h *Handler) AddClass(ctx context.Context, principal *models.Principal,
 	}
 
 	if cls.ShardingConfig != nil && schema.MultiTenancyEnabled(cls) {
-		return nil, 0, fmt.Errorf("cannot have both shardingConfig and multiTenancyConfig")
+		return AddClassResult{}, fmt.Errorf("cannot have both shardingConfig and multiTenancyConfig")
 	} else if cls.MultiTenancyConfig == nil {
 		cls.MultiTenancyConfig = &models.MultiTenancyConfig{}
 	} else if cls.MultiTenancyConfig.Enabled {
 		cls.ShardingConfig = shardingcfg.Config{DesiredCount: 0} // tenant shards will be created dynamically
 	}
 
+	if err := h.inheritProperties(cls, classGetterWithAuth); err != nil {
+		return AddClassResult{}, err
+	}
+
 	if err := h.setNewClassDefaults(cls, h.config.Replication); err != nil {
-		return nil, 0, err
+		return AddClassResult{}, err
 	}
 
 	if err := h.validateCanAddClass(ctx, cls, classGetterWithAuth, false); err != nil {
-		return nil, 0, err
+		return AddClassResult{}, err
 	}
 	// migrate only after validation in completed
 	h.migrateClassSettings(cls)
 	if err := h.parser.ParseClass(cls); err != nil {
-		return nil, 0, err
+		return AddClassResult{}, err
 	}
 
 	err = h.invertedConfigValidator(cls.InvertedIndexConfig)
 	if err != nil {
-		return nil, 0, err
+		return AddClassResult{}, err
+	}
+	if err := h.runClassValidators(ctx, cls); err != nil {
+		return AddClassResult{}, err
 	}
 
 	shardState, err := sharding.InitState(cls.Class,
@@ -153,13 +168,111 @@ func (h *Handler) AddClass(ctx context.Context, principal *models.Principal,
 		h.clusterState.LocalName(), h.schemaManager.StorageCandidates(), cls.ReplicationConfig.Factor,
 		schema.MultiTenancyEnabled(cls))
 	if err != nil {
-		return nil, 0, errors.Wrap(err, "init sharding state")
+		return AddClassResult{}, errors.Wrap(err, "init sharding state")
 	}
-	version, err := h.schemaManager.AddClass(ctx, cls, shardState)
+	version, err := h.schemaManager.AddClass(withActor(ctx, principal), cls, shardState)
 	if err != nil {
-		return nil, 0, err
+		h.logEntry(withActor(ctx, principal), cls.Class, "").WithError(err).Log(writeErrorLevel(err), "add class")
+		return AddClassResult{}, err
 	}
-	return cls, version, err
+	h.notify(ctx, func(l EventListener) { l.OnClassAdded(cls) })
+	if cls.BackupConfig != nil {
+		h.scheduleBackups(ctx, cls.Class, cls.BackupConfig)
+	}
+	return AddClassResult{Class: cls, Version: version}, nil
+}
+
+// ClassCloneOverrides are the settings of a cloned class which differ from
+// the source class. Zero values keep the setting of the source class.
+type ClassCloneOverrides struct {
+	// VectorIndexType replaces the vector index type. The vector index config
+	// of the source is dropped as it is specific to its index type.
+	VectorIndexType string
+	// ReplicationFactor replaces the replication factor if > 0
+	ReplicationFactor int64
+	// ModuleConfig replaces the module config of the source class
+	ModuleConfig interface{}
+	// RewriteSelfReferences makes cross-references to the source class point
+	// to the clone instead
+	RewriteSelfReferences bool
+}
+
+// CloneClass creates targetName with the schema of sourceName. The clone
+// starts without any data and goes through the same validation as AddClass.
+func (h *Handler) CloneClass(ctx context.Context, principal *models.Principal,
+	sourceName, targetName string, overrides *ClassCloneOverrides,
+) error {
+	sourceName = schema.UppercaseClassName(sourceName)
+	targetName = schema.UppercaseClassName(targetName)
+	if err := h.Authorizer.Authorize(principal, authorization.READ, authorization.CollectionsMetadata(sourceName)...); err != nil {
+		return err
+	}
+
+	source := h.schemaReader.ReadOnlyClass(sourceName)
+	if source == nil {
+		return fmt.Errorf("class %q: %w", sourceName, ErrNotFound)
+	}
+	if h.schemaReader.ReadOnlyClass(targetName) != nil {
+		return fmt.Errorf("class %q: %w", targetName, clusterSchema.ErrClassExists)
+	}
+
+	clone, err := cloneClass(source, targetName, overrides)
+	if err != nil {
+		return fmt.Errorf("clone class %q: %w", sourceName, err)
+	}
+	_, err = h.AddClass(ctx, principal, clone)
+	return err
+}
+
+// cloneClass deep-copies source, renames it to target and applies overrides.
+// Settings derived when the source was added are reset so that AddClass
+// derives them again for the clone.
+func cloneClass(source *models.Class, target string, overrides *ClassCloneOverrides) (*models.Class, error) {
+	b, err := json.Marshal(source)
+	if err != nil {
+		return nil, err
+	}
+	clone := &models.Class{}
+	if err := json.Unmarshal(b, clone); err != nil {
+		return nil, err
+	}
+	clone.Class = target
+
+	if schema.MultiTenancyEnabled(clone) {
+		// tenant shards are created dynamically
+		clone.ShardingConfig = nil
+	} else if cfg, ok := clone.ShardingConfig.(map[string]interface{}); ok {
+		delete(cfg, "actualCount")
+		delete(cfg, "actualVirtualCount")
+		delete(cfg, "desiredVirtualCount")
+	}
+
+	if overrides == nil {
+		return clone, nil
+	}
+	if overrides.VectorIndexType != "" && overrides.VectorIndexType != clone.VectorIndexType {
+		clone.VectorIndexType = overrides.VectorIndexType
+		clone.VectorIndexConfig = nil
+	}
+	if overrides.ReplicationFactor > 0 {
+		if clone.ReplicationConfig == nil {
+			clone.ReplicationConfig = &models.ReplicationConfig{}
+		}
+		clone.ReplicationConfig.Factor = overrides.ReplicationFactor
+	}
+	if overrides.ModuleConfig != nil {
+		clone.ModuleConfig = overrides.ModuleConfig
+	}
+	if overrides.RewriteSelfReferences {
+		for _, prop := range clone.Properties {
+			for i, dt := range prop.DataType {
+				if dt == source.Class {
+					prop.DataType[i] = target
+				}
+			}
+		}
+	}
+	return clone, nil
 }
 
 func (h *Handler) RestoreClass(ctx context.Context, d *backup.ClassDescriptor, m map[string]string) error {
@@ -216,30 +329,136 @@ func (h *Handler) RestoreClass(ctx context.Context, d *backup.ClassDescriptor, m
 	return err
 }
 
-// DeleteClass from the schema
-func (h *Handler) DeleteClass(ctx context.Context, principal *models.Principal, class string) error {
+// DeleteClass from the schema. It returns the schema version of the deletion,
+// see WaitForSchemaConsistency. With SoftDelete the class is moved to the
+// deleted classes instead, see RestoreDeletedClass.
+func (h *Handler) DeleteClass(ctx context.Context, principal *models.Principal, class string) (uint64, error) {
+	defer h.metrics.track(opDeleteClass)()
+
 	err := h.Authorizer.Authorize(principal, authorization.DELETE, authorization.CollectionsMetadata(class)...)
 	if err != nil {
-		return err
+		return 0, err
 	}
 	if err := h.Authorizer.Authorize(principal, authorization.READ, authorization.CollectionsMetadata(class)...); err != nil {
-		return err
+		return 0, err
 	}
 
 	class = schema.UppercaseClassName(class)
+	if children := h.childClasses(class); len(children) > 0 {
+		names := make([]string, len(children))
+		for i, c := range children {
+			names[i] = c.Class
+		}
+		return 0, ErrClassHasChildren{Class: class, Children: names}
+	}
 
-	_, err = h.schemaManager.DeleteClass(ctx, class)
-	return err
+	var version uint64
+	if h.SoftDelete {
+		version, err = h.schemaManager.SoftDeleteClass(withActor(ctx, principal), class, h.softDeleteRetainUntil(time.Now()))
+	} else {
+		version, err = h.schemaManager.DeleteClass(withActor(ctx, principal), class)
+	}
+	if err != nil {
+		h.logEntry(withActor(ctx, principal), class, "").WithError(err).Log(writeErrorLevel(err), "delete class")
+		return 0, err
+	}
+	h.notify(ctx, func(l EventListener) { l.OnClassDeleted(class) })
+	return version, nil
+}
+
+// SetCollectionReadOnly sets or clears the read-only mode of a class. While
+// read-only, object writes to the class are rejected, queries are not affected.
+func (h *Handler) SetCollectionReadOnly(ctx context.Context, principal *models.Principal,
+	className string, readOnly bool,
+) error {
+	err := h.Authorizer.Authorize(principal, authorization.UPDATE, authorization.CollectionsMetadata(className)...)
+	if err != nil {
+		return err
+	}
+
+	className = schema.UppercaseClassName(className)
+	initial := h.schemaReader.ReadOnlyClass(className)
+	if initial == nil {
+		return fmt.Errorf("class %q: %w", className, ErrNotFound)
+	}
+	if (initial.ReadOnly != nil && *initial.ReadOnly) == readOnly {
+		return nil
+	}
+
+	updated := *initial
+	updated.ReadOnly = &readOnly
+	if _, err = h.schemaManager.UpdateClass(withActor(ctx, principal), &updated, nil); err != nil {
+		return err
+	}
+	h.notify(ctx, func(l EventListener) { l.OnClassUpdated(&updated) })
+	return nil
 }
 
 func (h *Handler) UpdateClass(ctx context.Context, principal *models.Principal,
 	className string, updated *models.Class,
 ) error {
+	defer h.metrics.track(opUpdateClass)()
+
 	err := h.Authorizer.Authorize(principal, authorization.UPDATE, authorization.CollectionsMetadata(className)...)
 	if err != nil || updated == nil {
 		return err
 	}
+	return h.updateClass(ctx, principal, className, updated)
+}
 
+// UpdateVectorIndexConfig updates the vector index configs of the named vectors
+// of a class, keyed by vector name, and leaves the rest of the class as it
+// is. The config of classes without named vectors is keyed by "". In contrast to UpdateClass, which reloads all shards of the class at
+// once, the shards pick up the new configs one after another.
+func (h *Handler) UpdateVectorIndexConfig(ctx context.Context, principal *models.Principal,
+	class string, configs map[string]schemaConfig.VectorIndexConfig,
+) error {
+	defer h.metrics.track(opUpdateClass)()
+
+	className := schema.UppercaseClassName(class)
+	if err := h.Authorizer.Authorize(principal, authorization.UPDATE, authorization.CollectionsMetadata(className)...); err != nil {
+		return err
+	}
+	if len(configs) == 0 {
+		return fmt.Errorf("%w: no vector index configs given", clusterSchema.ErrBadRequest)
+	}
+
+	initial := h.schemaReader.ReadOnlyClass(className)
+	if initial == nil {
+		return ErrNotFound
+	}
+	old := vectorIndexConfigsByName(initial)
+	updated := make(map[string]schemaConfig.VectorIndexConfig, len(old))
+	for name, cfg := range old {
+		updated[name] = cfg
+	}
+	changed := map[string]schemaConfig.VectorIndexConfig{}
+	for name, cfg := range configs {
+		if _, ok := old[name]; !ok {
+			return fmt.Errorf("%w: class %q has no vector %q", clusterSchema.ErrBadRequest, className, name)
+		}
+		if cfg == nil {
+			return fmt.Errorf("%w: empty vector index config for vector %q", clusterSchema.ErrBadRequest, name)
+		}
+		updated[name] = cfg
+		if !reflect.DeepEqual(old[name], cfg) {
+			changed[name] = cfg
+		}
+	}
+	if err := h.validator.ValidateVectorIndexConfigsUpdate(old, updated); err != nil {
+		return fmt.Errorf("%w: validate vector index configs: %w", clusterSchema.ErrBadRequest, err)
+	}
+	if len(changed) == 0 {
+		return nil
+	}
+
+	_, err := h.schemaManager.UpdateVectorIndexConfig(withActor(ctx, principal), className, changed)
+	return err
+}
+
+func (h *Handler) updateClass(ctx context.Context, principal *models.Principal,
+	className string, updated *models.Class,
+) error {
 	// make sure unset optionals on 'updated' don't lead to an error, as all
 	// optionals would have been set with defaults on the initial already
 	if err := h.setClassDefaults(updated, h.config.Replication); err != nil {
@@ -262,7 +481,7 @@ func (h *Handler) UpdateClass(ctx context.Context, principal *models.Principal,
 		return fmt.Errorf("parse vector config: %w", err)
 	}
 
-	if err := h.validateVectorSettings(updated); err != nil {
+	if err := h.validateClassUpdate(updated); err != nil {
 		return err
 	}
 
@@ -271,6 +490,19 @@ func (h *Handler) UpdateClass(ctx context.Context, principal *models.Principal,
 
 	// first layer of defense is basic validation if class already exists
 	if initial != nil {
+		// the ACL can only be changed with SetClassACL, which requires more
+		// permissions than updating the class
+		updated.ACL = initial.ACL
+		if updated.StrictPropertyValidation == nil {
+			updated.StrictPropertyValidation = initial.StrictPropertyValidation
+		}
+		if updated.ReadOnly == nil {
+			updated.ReadOnly = initial.ReadOnly
+		}
+		if err := validateUpdatingEncryption(initial, updated); err != nil {
+			return err
+		}
+
 		_, err := validateUpdatingMT(initial, updated)
 		if err != nil {
 			return err
@@ -296,9 +528,25 @@ func (h *Handler) UpdateClass(ctx context.Context, principal *models.Principal,
 			return err
 		}
 	}
+	if err := h.runClassValidators(ctx, updated); err != nil {
+		return err
+	}
 
-	_, err = h.schemaManager.UpdateClass(ctx, updated, shardingState)
-	return err
+	if _, err := h.schemaManager.UpdateClass(withActor(ctx, principal), updated, shardingState); err != nil {
+		return err
+	}
+	h.notify(ctx, func(l EventListener) { l.OnClassUpdated(updated) })
+
+	if updated.MaxObjects > 0 && (initial == nil || initial.MaxObjects <= 0) {
+		if err := h.seedClassObjectCount(ctx, updated.Class); err != nil {
+			return fmt.Errorf("class %q was updated, but counting its objects failed: %w", updated.Class, err)
+		}
+	}
+
+	if initial == nil || !reflect.DeepEqual(initial.BackupConfig, updated.BackupConfig) {
+		h.scheduleBackups(ctx, updated.Class, updated.BackupConfig)
+	}
+	return nil
 }
 
 func (m *Handler) setNewClassDefaults(class *models.Class, globalCfg replication.GlobalConfig) error {
@@ -555,51 +803,71 @@ func (h *Handler) validateProperty(
 	class *models.Class, existingPropertyNames map[string]bool,
 	relaxCrossRefValidation bool, classGetterWithAuth func(string) (*models.Class, error), props ...*models.Property,
 ) error {
-	for _, property := range props {
-		if _, err := schema.ValidatePropertyName(property.Name); err != nil {
-			return err
-		}
+	verr := &ValidationError{}
+	h.addPropertyViolations(verr, class, existingPropertyNames, relaxCrossRefValidation, classGetterWithAuth, props...)
+	return verr.errOrNil()
+}
 
-		if err := schema.ValidateReservedPropertyName(property.Name); err != nil {
-			return err
+// addPropertyViolations records all validation failures of props in verr
+func (h *Handler) addPropertyViolations(verr *ValidationError,
+	class *models.Class, existingPropertyNames map[string]bool,
+	relaxCrossRefValidation bool, classGetterWithAuth func(string) (*models.Class, error), props ...*models.Property,
+) {
+	for _, property := range props {
+		nameField := propertyField(property, "name")
+		if _, err := schema.ValidatePropertyName(property.Name); verr.add(nameField, err) {
+			continue
 		}
+		verr.add(nameField, schema.ValidateReservedPropertyName(property.Name))
 
 		if existingPropertyNames[strings.ToLower(property.Name)] {
-			return fmt.Errorf("class %q: conflict for property %q: already in use or provided multiple times", class.Class, property.Name)
+			verr.add(nameField, fmt.Errorf("class %q: conflict for property %q: already in use or provided multiple times", class.Class, property.Name))
 		}
 
-		// Validate data type of property.
+		// Validate data type of property. The checks depending on the data type
+		// are skipped if it is invalid.
 		propertyDataType, err := schema.FindPropertyDataTypeWithRefsAndAuth(classGetterWithAuth, property.DataType,
 			relaxCrossRefValidation, schema.ClassName(class.Class))
 		if err != nil {
-			return errors.Wrapf(err, "property '%s': invalid dataType: %v", property.Name, property.DataType)
-		}
-
-		if propertyDataType.IsNested() {
-			if err := validateNestedProperties(property.NestedProperties, property.Name); err != nil {
-				return err
-			}
+			verr.add(propertyField(property, "dataType"),
+				errors.Wrapf(err, "property '%s': invalid dataType: %v", property.Name, property.DataType))
 		} else {
-			if len(property.NestedProperties) > 0 {
-				return fmt.Errorf("property '%s': nestedProperties not allowed for data types other than object/object[]",
-					property.Name)
+			if propertyDataType.IsNested() {
+				verr.add(propertyField(property, "nestedProperties"),
+					validateNestedProperties(property.NestedProperties, property.Name))
+			} else if len(property.NestedProperties) > 0 {
+				verr.add(propertyField(property, "nestedProperties"),
+					fmt.Errorf("property '%s': nestedProperties not allowed for data types other than object/object[]",
+						property.Name))
 			}
+
+			verr.add(propertyField(property, "tokenization"),
+				h.validatePropertyTokenization(property.Tokenization, propertyDataType))
+			verr.add(propertyField(property, "jsonSchemaValidation"),
+				validatePropertyJSONSchema(property, propertyDataType))
+			verr.add(propertyField(property, "defaultValue"),
+				validatePropertyDefaultValue(property, propertyDataType))
 		}
 
-		if err := h.validatePropertyTokenization(property.Tokenization, propertyDataType); err != nil {
-			return err
+		verr.add(propertyField(property, ""), h.validatePropertyIndexing(property))
+		if !relaxCrossRefValidation {
+			verr.add(propertyField(property, "indexFilterable"), validateReferenceIndexing(property))
 		}
+		verr.add(propertyField(property, "moduleConfig"), h.validatePropModuleConfig(class, property))
 
-		if err := h.validatePropertyIndexing(property); err != nil {
-			return err
+		if err := validatePropertyGroup(property.Group); err != nil {
+			verr.add(propertyField(property, "group"), fmt.Errorf("property '%s': %w", property.Name, err))
 		}
 
-		if err := h.validatePropModuleConfig(class, property); err != nil {
-			return err
+		if len(property.Aliases) > 0 {
+			verr.add(propertyField(property, "aliases"),
+				validatePropertyAliases(slices.Concat(class.Properties, props), property))
 		}
-	}
 
-	return nil
+		if property.ComputeExpression != "" {
+			verr.add(propertyField(property, "computeExpression"), h.validateComputedProperty(class, property, props))
+		}
+	}
 }
 
 func setInvertedConfigDefaults(class *models.Class) {
@@ -625,39 +893,81 @@ func setInvertedConfigDefaults(class *models.Class) {
 	}
 }
 
+// validateClassUpdate returns a *ValidationError with all failures of the
+// settings of an updated class, or nil if they are valid
+func (h *Handler) validateClassUpdate(class *models.Class) error {
+	verr := &ValidationError{}
+	verr.add("vectorConfig", h.validateVectorSettings(class))
+	verr.add("labels", validateClassMetadataEntries("label", class.Labels))
+	verr.add("annotations", validateClassMetadataEntries("annotation", class.Annotations))
+	verr.add("maxObjects", validateMaxObjects(class))
+	verr.add("queryTimeout", validateQueryTimeout(class))
+	verr.add("compactionConfig", validateCompactionConfig(class))
+	verr.add("backupConfig", validateBackupConfig(class))
+	return verr.errOrNil()
+}
+
+// validateCanAddClass returns a *ValidationError with all validation failures
+// of class, or nil if it is valid
 func (h *Handler) validateCanAddClass(
 	ctx context.Context, class *models.Class, classGetterWithAuth func(string) (*models.Class, error),
 	relaxCrossRefValidation bool,
 ) error {
+	verr := &ValidationError{}
 	if _, err := schema.ValidateClassName(class.Class); err != nil {
-		return err
+		verr.add("class", err)
+	}
+
+	if limit := h.config.Schema.MaxPropertiesPerClass; limit > 0 && len(class.Properties) > limit {
+		verr.add("properties", ErrMaxPropertiesExceeded{Class: class.Class, Current: len(class.Properties), Max: limit})
 	}
 
 	existingPropertyNames := map[string]bool{}
 	for _, property := range class.Properties {
-		if err := h.validateProperty(class, existingPropertyNames, relaxCrossRefValidation, classGetterWithAuth, property); err != nil {
-			return err
-		}
+		h.addPropertyViolations(verr, class, existingPropertyNames, relaxCrossRefValidation, classGetterWithAuth, property)
 		existingPropertyNames[strings.ToLower(property.Name)] = true
 	}
 
-	if err := h.validateVectorSettings(class); err != nil {
-		return err
+	var invalidVectors bool
+	if !hasTargetVectors(class) {
+		invalidVectors = verr.add("vectorizer", h.validateVectorizer(class.Vectorizer))
+		invalidVectors = verr.add("vectorIndexType", h.validateVectorIndexType(class.VectorIndexType)) || invalidVectors
+	} else {
+		invalidVectors = verr.add("vectorConfig", h.validateVectorSettings(class))
 	}
 
-	if err := h.moduleConfig.ValidateClass(ctx, class); err != nil {
-		return err
+	// the module config can only be validated for valid vector settings
+	if !invalidVectors {
+		verr.add("moduleConfig", h.moduleConfig.ValidateClass(ctx, class))
 	}
 
-	if err := validateMT(class); err != nil {
-		return err
-	}
+	verr.add("multiTenancyConfig", validateMT(class))
+	verr.add("labels", validateClassMetadataEntries("label", class.Labels))
+	verr.add("annotations", validateClassMetadataEntries("annotation", class.Annotations))
+	verr.add("acl", validateClassACL(class.ACL))
+	verr.add("maxObjects", validateMaxObjects(class))
+	verr.add("queryTimeout", validateQueryTimeout(class))
+	verr.add("compactionConfig", validateCompactionConfig(class))
+	verr.add("backupConfig", validateBackupConfig(class))
+	verr.add("encryptionConfig", h.validateEncryptionConfig(class))
+	verr.add("replicationConfig", replica.ValidateConfig(class, h.config.Replication))
 
-	if err := replica.ValidateConfig(class, h.config.Replication); err != nil {
-		return err
-	}
+	return verr.errOrNil()
+}
 
-	// all is fine!
+// validatePropertyJSONSchema checks that a jsonSchemaValidation document is
+// a valid JSON Schema set on a text or blob property
+func validatePropertyJSONSchema(property *models.Property, propertyDataType schema.PropertyDataType) error {
+	if property.JSONSchemaValidation == nil {
+		return nil
+	}
+	if !propertyDataType.IsPrimitive() ||
+		(propertyDataType.AsPrimitive() != schema.DataTypeText && propertyDataType.AsPrimitive() != schema.DataTypeBlob) {
+		return fmt.Errorf("property '%s': jsonSchemaValidation is only allowed for data types text and blob", property.Name)
+	}
+	if _, err := schema.ParseJSONSchema(*property.JSONSchemaValidation); err != nil {
+		return fmt.Errorf("property '%s': jsonSchemaValidation: %w", property.Name, err)
+	}
 	return nil
 }
 
@@ -713,6 +1023,18 @@ func (h *Handler) validatePropertyTokenization(tokenization string, propertyData
 	return fmt.Errorf("Tokenization is not allowed for reference data type")
 }
 
+// validateReferenceIndexing rejects reference properties without a filterable
+// index, references are resolved through it. Such properties can still be
+// restored from backups made before.
+func validateReferenceIndexing(prop *models.Property) error {
+	_, isPrimitive := schema.AsPrimitive(prop.DataType)
+	if _, isNested := schema.AsNested(prop.DataType); !isPrimitive && !isNested &&
+		prop.IndexFilterable != nil && !*prop.IndexFilterable {
+		return fmt.Errorf("`indexFilterable` can not be disabled for reference data types")
+	}
+	return nil
+}
+
 func (h *Handler) validatePropertyIndexing(prop *models.Property) error {
 	if prop.IndexInverted != nil {
 		if prop.IndexFilterable != nil || prop.IndexSearchable != nil || prop.IndexRangeFilters != nil {
@@ -821,6 +1143,10 @@ func validateMT(class *models.Class) error {
 		return fmt.Errorf("can't enable autoTenantActivation on a non-multi-tenant class")
 	}
 
+	if class.MultiTenancyConfig != nil && class.MultiTenancyConfig.MaxTenants < 0 {
+		return fmt.Errorf("maxTenants must not be negative, got %d", class.MultiTenancyConfig.MaxTenants)
+	}
+
 	return nil
 }
 
@@ -846,6 +1172,10 @@ func validateImmutableFields(initial, updated *models.Class) error {
 			name:     "class name",
 			accessor: func(c *models.Class) string { return c.Class },
 		},
+		{
+			name:     "extends",
+			accessor: func(c *models.Class) string { return c.Extends },
+		},
 	}
 
 	if err := validateImmutableTextFields(initial, updated, immutableFields...); err != nil {
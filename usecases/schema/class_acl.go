@@ -0,0 +1,208 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	clusterSchema "github.com/weaviate/weaviate/cluster/schema"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
+)
+
+// Actions which can be granted by the ACL of a class
+const (
+	ACLActionRead   = "read"
+	ACLActionWrite  = "write"
+	ACLActionDelete = "delete"
+)
+
+// aclActionVerbs maps the ACL actions to the verbs checked by the Authorizer
+// if the ACL of a class doesn't allow an action
+var aclActionVerbs = map[string]string{
+	ACLActionRead:   authorization.READ,
+	ACLActionWrite:  authorization.UPDATE,
+	ACLActionDelete: authorization.DELETE,
+}
+
+// aclVerbActions maps the verbs on the data of a class to the ACL actions
+// which grant them
+var aclVerbActions = map[string]string{
+	authorization.READ:   ACLActionRead,
+	authorization.CREATE: ACLActionWrite,
+	authorization.UPDATE: ACLActionWrite,
+	authorization.DELETE: ACLActionDelete,
+}
+
+// aclClassReader reads the classes whose ACLs are checked
+type aclClassReader interface {
+	ReadOnlyClass(name string) *models.Class
+}
+
+// aclAuthorizer allows the verbs on the data of classes which their ACLs
+// grant and leaves all other decisions to next
+type aclAuthorizer struct {
+	classes aclClassReader
+	next    authorization.Authorizer
+}
+
+// NewACLAuthorizer returns an Authorizer which checks the ACLs of the classes
+// before authorizer. A verb on the objects of a class is allowed if the ACL
+// of the class grants the matching action, reading the metadata of a class
+// is allowed if its ACL grants any action. The remaining resources are
+// passed on to authorizer.
+func NewACLAuthorizer(authorizer authorization.Authorizer, classes aclClassReader) authorization.Authorizer {
+	return aclAuthorizer{classes: classes, next: authorizer}
+}
+
+func (a aclAuthorizer) Authorize(principal *models.Principal, verb string, resources ...string) error {
+	if principal == nil || principal.Username == "" || len(resources) == 0 {
+		return a.next.Authorize(principal, verb, resources...)
+	}
+	remaining := make([]string, 0, len(resources))
+	for _, resource := range resources {
+		if !a.grants(principal, verb, resource) {
+			remaining = append(remaining, resource)
+		}
+	}
+	if len(remaining) == 0 {
+		return nil
+	}
+	return a.next.Authorize(principal, verb, remaining...)
+}
+
+// grants tells if the ACL of the class of resource grants verb to principal
+func (a aclAuthorizer) grants(principal *models.Principal, verb, resource string) bool {
+	// data/collections/{class}/shards/{shard}/objects/{id} or
+	// schema/collections/{class}/shards/{shard}
+	parts := strings.Split(resource, "/")
+	if len(parts) < 3 || parts[1] != "collections" || parts[2] == "*" {
+		return false
+	}
+	switch parts[0] {
+	case authorization.DataDomain:
+		action, ok := aclVerbActions[verb]
+		return ok && a.allowed(principal, parts[2], func(actions []string) bool {
+			return slices.Contains(actions, action)
+		})
+	case authorization.SchemaDomain:
+		return verb == authorization.READ && a.allowed(principal, parts[2], func(actions []string) bool {
+			return len(actions) > 0
+		})
+	default:
+		return false
+	}
+}
+
+// allowed tells if the ACL entry of principal in class satisfies match.
+// Anonymous principals are never granted anything by an ACL.
+func (a aclAuthorizer) allowed(principal *models.Principal, class string, match func(actions []string) bool) bool {
+	if principal == nil || principal.Username == "" {
+		return false
+	}
+	c := a.classes.ReadOnlyClass(class)
+	if c == nil {
+		return false
+	}
+	for _, entry := range c.ACL {
+		if entry != nil && entry.Principal == principal.Username && match(entry.Actions) {
+			return true
+		}
+	}
+	return false
+}
+
+// Authorize checks if principal may perform action on the objects of class.
+// The ACL of the class is checked first, if it doesn't allow the action the
+// decision is left to the Authorizer.
+func (h *Handler) Authorize(principal *models.Principal, action, class string) error {
+	verb, ok := aclActionVerbs[action]
+	if !ok {
+		return fmt.Errorf("unknown action %q", action)
+	}
+	return NewACLAuthorizer(h.Authorizer, h.schemaReader).
+		Authorize(principal, verb, authorization.CollectionsData(class)...)
+}
+
+// SetClassACL replaces the ACL of class, an empty acl removes it. It requires
+// all permissions on the metadata of the class, like manage_collections
+// grants them.
+func (h *Handler) SetClassACL(ctx context.Context, principal *models.Principal,
+	class string, acl []*models.ACLEntry,
+) error {
+	className := schema.UppercaseClassName(class)
+	for _, verb := range []string{authorization.CREATE, authorization.READ, authorization.UPDATE, authorization.DELETE} {
+		if err := h.Authorizer.Authorize(principal, verb, authorization.CollectionsMetadata(className)...); err != nil {
+			return err
+		}
+	}
+	if err := validateClassACL(acl); err != nil {
+		return fmt.Errorf("%w: %w", clusterSchema.ErrBadRequest, err)
+	}
+
+	initial := h.schemaReader.ReadOnlyClass(className)
+	if initial == nil {
+		return fmt.Errorf("class %q: %w", className, ErrNotFound)
+	}
+	updated := *initial
+	updated.ACL = nil
+	if len(acl) > 0 {
+		updated.ACL = acl
+	}
+	if _, err := h.schemaManager.UpdateClass(withActor(ctx, principal), &updated, nil); err != nil {
+		return err
+	}
+	h.notify(ctx, func(l EventListener) { l.OnClassUpdated(&updated) })
+	return nil
+}
+
+// GetClassACL returns the ACL of class
+func (h *Handler) GetClassACL(ctx context.Context, principal *models.Principal, class string) ([]*models.ACLEntry, error) {
+	className := schema.UppercaseClassName(class)
+	err := h.Authorizer.Authorize(principal, authorization.READ, authorization.CollectionsMetadata(className)...)
+	if err != nil {
+		return nil, err
+	}
+	c := h.schemaReader.ReadOnlyClass(className)
+	if c == nil {
+		return nil, fmt.Errorf("class %q: %w", className, ErrNotFound)
+	}
+	return c.ACL, nil
+}
+
+// validateClassACL checks that every entry names a principal at most once
+// and only grants known actions
+func validateClassACL(acl []*models.ACLEntry) error {
+	principals := make(map[string]struct{}, len(acl))
+	for i, entry := range acl {
+		if entry == nil || entry.Principal == "" {
+			return fmt.Errorf("acl entry %d: principal is required", i)
+		}
+		if _, ok := principals[entry.Principal]; ok {
+			return fmt.Errorf("acl entry %d: duplicate principal %q", i, entry.Principal)
+		}
+		principals[entry.Principal] = struct{}{}
+		if len(entry.Actions) == 0 {
+			return fmt.Errorf("acl entry %d: no actions given", i)
+		}
+		for _, action := range entry.Actions {
+			if _, ok := aclActionVerbs[action]; !ok {
+				return fmt.Errorf("acl entry %d: unknown action %q", i, action)
+			}
+		}
+	}
+	return nil
+}
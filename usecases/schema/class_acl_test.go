@@ -0,0 +1,205 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	clusterSchema "github.com/weaviate/weaviate/cluster/schema"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
+	"github.com/weaviate/weaviate/usecases/auth/authorization/mocks"
+)
+
+func TestHandler_Authorize(t *testing.T) {
+	class := &models.Class{Class: "C", ACL: []*models.ACLEntry{
+		{Principal: "alice", Actions: []string{ACLActionRead, ACLActionWrite}},
+	}}
+
+	tests := []struct {
+		name             string
+		principal        *models.Principal
+		action           string
+		expectAuthorizer bool
+		expectedVerb     string
+	}{
+		{name: "allowed by acl", principal: &models.Principal{Username: "alice"}, action: ACLActionWrite},
+		{
+			name: "action not in acl", principal: &models.Principal{Username: "alice"}, action: ACLActionDelete,
+			expectAuthorizer: true, expectedVerb: authorization.DELETE,
+		},
+		{
+			name: "principal not in acl", principal: &models.Principal{Username: "bob"}, action: ACLActionRead,
+			expectAuthorizer: true, expectedVerb: authorization.READ,
+		},
+		{name: "anonymous", action: ACLActionWrite, expectAuthorizer: true, expectedVerb: authorization.UPDATE},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			authorizer := mocks.NewMockAuthorizer()
+			authorizer.SetErr(errors.New("denied"))
+			handler, fakeSchemaManager := newTestHandlerWithCustomAuthorizer(t, &fakeDB{}, authorizer)
+			fakeSchemaManager.On("ReadOnlyClass", "C").Return(class)
+
+			err := handler.Authorize(tt.principal, tt.action, "c")
+			if !tt.expectAuthorizer {
+				require.Nil(t, err)
+				assert.Empty(t, authorizer.Calls())
+				return
+			}
+			assert.EqualError(t, err, "denied")
+			require.Len(t, authorizer.Calls(), 1)
+			assert.Equal(t, mocks.AuthZReq{
+				Principal: tt.principal, Verb: tt.expectedVerb, Resources: authorization.CollectionsData("C"),
+			}, authorizer.Calls()[0])
+		})
+	}
+
+	t.Run("unknown action", func(t *testing.T) {
+		handler, _ := newTestHandler(t, &fakeDB{})
+		assert.Error(t, handler.Authorize(nil, "admin", "C"))
+	})
+}
+
+func TestACLAuthorizer(t *testing.T) {
+	classes := &fakeSchemaManager{}
+	classes.On("ReadOnlyClass", "C").Return(&models.Class{Class: "C", ACL: []*models.ACLEntry{
+		{Principal: "alice", Actions: []string{ACLActionRead, ACLActionWrite}},
+		{Principal: "bob", Actions: []string{ACLActionDelete}},
+	}})
+	classes.On("ReadOnlyClass", "Other").Return(&models.Class{Class: "Other"})
+	alice := &models.Principal{Username: "alice"}
+	bob := &models.Principal{Username: "bob"}
+
+	tests := []struct {
+		name      string
+		principal *models.Principal
+		verb      string
+		resources []string
+		// passed on to the wrapped authorizer, nil if allowed by the acl
+		expected []string
+	}{
+		{name: "read objects", principal: alice, verb: authorization.READ, resources: authorization.ShardsData("C", "t1")},
+		{name: "create objects", principal: alice, verb: authorization.CREATE, resources: authorization.ShardsData("c", "")},
+		{name: "update an object", principal: alice, verb: authorization.UPDATE, resources: []string{authorization.Objects("C", "", "id")}},
+		{name: "read metadata", principal: bob, verb: authorization.READ, resources: authorization.ShardsMetadata("C", "t1")},
+		{
+			name: "action not granted", principal: bob, verb: authorization.UPDATE,
+			resources: authorization.ShardsData("C", "t1"), expected: authorization.ShardsData("C", "t1"),
+		},
+		{
+			name: "metadata updates aren't granted", principal: alice, verb: authorization.UPDATE,
+			resources: authorization.CollectionsMetadata("C"), expected: authorization.CollectionsMetadata("C"),
+		},
+		{
+			name: "all classes", principal: alice, verb: authorization.READ,
+			resources: authorization.CollectionsData(), expected: authorization.CollectionsData(),
+		},
+		{
+			name: "only the other class is passed on", principal: alice, verb: authorization.READ,
+			resources: authorization.CollectionsData("C", "Other"), expected: authorization.CollectionsData("Other"),
+		},
+		{
+			name: "anonymous", verb: authorization.READ,
+			resources: authorization.CollectionsData("C"), expected: authorization.CollectionsData("C"),
+		},
+		{
+			name: "other domains", principal: alice, verb: authorization.READ,
+			resources: []string{authorization.Cluster()}, expected: []string{authorization.Cluster()},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			next := mocks.NewMockAuthorizer()
+			next.SetErr(errors.New("denied"))
+			err := NewACLAuthorizer(next, classes).Authorize(tt.principal, tt.verb, tt.resources...)
+			if tt.expected == nil {
+				require.Nil(t, err)
+				assert.Empty(t, next.Calls())
+				return
+			}
+			assert.EqualError(t, err, "denied")
+			require.Len(t, next.Calls(), 1)
+			assert.Equal(t, mocks.AuthZReq{Principal: tt.principal, Verb: tt.verb, Resources: tt.expected}, next.Calls()[0])
+		})
+	}
+}
+
+func TestHandler_SetClassACL(t *testing.T) {
+	ctx := context.Background()
+	initial := &models.Class{Class: "C", Description: "events"}
+
+	t.Run("set", func(t *testing.T) {
+		acl := []*models.ACLEntry{{Principal: "alice", Actions: []string{ACLActionRead}}}
+		handler, fakeSchemaManager := newTestHandler(t, &fakeDB{})
+		fakeSchemaManager.On("ReadOnlyClass", "C").Return(initial)
+		fakeSchemaManager.On("UpdateClass", mock.MatchedBy(func(c *models.Class) bool {
+			return assert.ObjectsAreEqual(acl, c.ACL) && c.Description == "events"
+		}), mock.Anything).Return(nil)
+
+		require.Nil(t, handler.SetClassACL(ctx, nil, "c", acl))
+		fakeSchemaManager.AssertExpectations(t)
+		assert.Nil(t, initial.ACL)
+	})
+
+	for name, acl := range map[string][]*models.ACLEntry{
+		"no principal":        {{Actions: []string{ACLActionRead}}},
+		"duplicate principal": {{Principal: "a", Actions: []string{ACLActionRead}}, {Principal: "a", Actions: []string{ACLActionDelete}}},
+		"no actions":          {{Principal: "a"}},
+		"unknown action":      {{Principal: "a", Actions: []string{"admin"}}},
+	} {
+		t.Run(name, func(t *testing.T) {
+			handler, fakeSchemaManager := newTestHandler(t, &fakeDB{})
+			err := handler.SetClassACL(ctx, nil, "C", acl)
+			assert.ErrorIs(t, err, clusterSchema.ErrBadRequest)
+			fakeSchemaManager.AssertNotCalled(t, "UpdateClass", mock.Anything, mock.Anything)
+		})
+	}
+
+	t.Run("unknown class", func(t *testing.T) {
+		handler, fakeSchemaManager := newTestHandler(t, &fakeDB{})
+		fakeSchemaManager.On("ReadOnlyClass", "C").Return(nil)
+		assert.ErrorIs(t, handler.SetClassACL(ctx, nil, "C", nil), ErrNotFound)
+	})
+
+	t.Run("updates of the class keep the acl", func(t *testing.T) {
+		acl := []*models.ACLEntry{{Principal: "alice", Actions: []string{ACLActionRead}}}
+		handler, fakeSchemaManager := newTestHandler(t, &fakeDB{})
+		fakeSchemaManager.On("ReadOnlyClass", "C").Return(&models.Class{
+			Class: "C", ACL: acl, ReplicationConfig: &models.ReplicationConfig{Factor: 1},
+		})
+		fakeSchemaManager.On("UpdateClass", mock.MatchedBy(func(c *models.Class) bool {
+			return assert.ObjectsAreEqual(acl, c.ACL)
+		}), mock.Anything).Return(nil)
+
+		require.Nil(t, handler.UpdateClass(ctx, nil, "C", &models.Class{
+			Class: "C", ACL: []*models.ACLEntry{{Principal: "bob", Actions: []string{ACLActionDelete}}},
+			ReplicationConfig: &models.ReplicationConfig{Factor: 1},
+		}))
+		fakeSchemaManager.AssertExpectations(t)
+	})
+}
+
+func TestHandler_GetClassACL(t *testing.T) {
+	acl := []*models.ACLEntry{{Principal: "alice", Actions: []string{ACLActionRead}}}
+	handler, fakeSchemaManager := newTestHandler(t, &fakeDB{})
+	fakeSchemaManager.On("ReadOnlyClass", "C").Return(&models.Class{Class: "C", ACL: acl})
+
+	got, err := handler.GetClassACL(context.Background(), nil, "c")
+	require.Nil(t, err)
+	assert.Equal(t, acl, got)
+}
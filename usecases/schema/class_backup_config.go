@@ -0,0 +1,190 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	clusterSchema "github.com/weaviate/weaviate/cluster/schema"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// backupScheduler creates the backups of classes on their schedule
+type backupScheduler interface {
+	// ScheduleClass replaces the schedule of class by cfg. A disabled cfg
+	// stops the backups of class.
+	ScheduleClass(class string, cfg models.ClassBackupConfig) error
+}
+
+// WithBackupScheduler makes AddClass and UpdateClass pass new and changed
+// backup configs to s
+func (h *Handler) WithBackupScheduler(s backupScheduler) {
+	h.backupScheduler = s
+}
+
+// scheduleBackups passes the backup config of a committed class change to the
+// backup scheduler. A removed config schedules no backups, like a disabled
+// one. The class change can't be undone anymore, failures are therefore only
+// logged.
+func (h *Handler) scheduleBackups(ctx context.Context, class string, cfg *models.ClassBackupConfig) {
+	if h.backupScheduler == nil {
+		return
+	}
+	var schedule models.ClassBackupConfig
+	if cfg != nil {
+		schedule = *cfg
+	}
+	if err := h.backupScheduler.ScheduleClass(class, schedule); err != nil {
+		h.logEntry(ctx, class, "").WithField("action", "schedule_class_backups").
+			WithError(err).Error("class was changed, but scheduling its backups failed")
+	}
+}
+
+// ErrInvalidCronExpression is returned for backup schedules which aren't
+// valid cron expressions. Field is the field of the expression which is
+// invalid, e.g. "hour", or empty if the expression as a whole is.
+type ErrInvalidCronExpression struct {
+	Expression string
+	Field      string
+	Reason     string
+}
+
+func (e ErrInvalidCronExpression) Error() string {
+	if e.Field == "" {
+		return fmt.Sprintf("invalid cron expression %q: %s", e.Expression, e.Reason)
+	}
+	return fmt.Sprintf("invalid cron expression %q: %s: %s", e.Expression, e.Field, e.Reason)
+}
+
+// Unwrap makes the error a bad request like the other validation failures
+func (e ErrInvalidCronExpression) Unwrap() error {
+	return clusterSchema.ErrBadRequest
+}
+
+// validateBackupConfig checks the backup config of class, if any
+func validateBackupConfig(class *models.Class) error {
+	cfg := class.BackupConfig
+	if cfg == nil {
+		return nil
+	}
+	if cfg.RetentionDays < 0 {
+		return fmt.Errorf("%w: backupConfig.retentionDays must not be negative, got %d",
+			clusterSchema.ErrBadRequest, cfg.RetentionDays)
+	}
+	if cfg.Schedule == "" {
+		if cfg.Enabled {
+			return fmt.Errorf("%w: backupConfig.schedule is required for enabled backups", clusterSchema.ErrBadRequest)
+		}
+		return nil
+	}
+	return validateCronExpression(cfg.Schedule)
+}
+
+// cronDescriptors are the shorthands for common schedules
+var cronDescriptors = map[string]bool{
+	"@yearly": true, "@annually": true, "@monthly": true, "@weekly": true,
+	"@daily": true, "@midnight": true, "@hourly": true,
+}
+
+type cronField struct {
+	name     string
+	min, max int
+	// names are the alternative names of the values, starting at min
+	names []string
+}
+
+// cronFields are the fields of a cron expression. Sunday is 0 or 7.
+var cronFields = []cronField{
+	{name: "minute", min: 0, max: 59},
+	{name: "hour", min: 0, max: 23},
+	{name: "day of month", min: 1, max: 31},
+	{name: "month", min: 1, max: 12, names: []string{
+		"JAN", "FEB", "MAR", "APR", "MAY", "JUN", "JUL", "AUG", "SEP", "OCT", "NOV", "DEC",
+	}},
+	{name: "day of week", min: 0, max: 7, names: []string{"SUN", "MON", "TUE", "WED", "THU", "FRI", "SAT"}},
+}
+
+// validateCronExpression checks that expr is a cron expression with the five
+// standard fields or one of cronDescriptors. Each field is a comma separated
+// list of values, ranges (1-5) or wildcards (*), ranges and wildcards may have
+// a step (*/15).
+func validateCronExpression(expr string) error {
+	if strings.HasPrefix(expr, "@") {
+		if !cronDescriptors[expr] {
+			return ErrInvalidCronExpression{Expression: expr, Reason: "unknown descriptor"}
+		}
+		return nil
+	}
+
+	fields := strings.Fields(expr)
+	if len(fields) != len(cronFields) {
+		return ErrInvalidCronExpression{
+			Expression: expr,
+			Reason:     fmt.Sprintf("expected %d fields, got %d", len(cronFields), len(fields)),
+		}
+	}
+	for i, field := range fields {
+		if reason := cronFields[i].validate(field); reason != "" {
+			return ErrInvalidCronExpression{Expression: expr, Field: cronFields[i].name, Reason: reason}
+		}
+	}
+	return nil
+}
+
+// validate returns why expr is not a valid value of f, or an empty string
+func (f cronField) validate(expr string) string {
+	for _, part := range strings.Split(expr, ",") {
+		rng, step, hasStep := strings.Cut(part, "/")
+		if hasStep {
+			if n, err := strconv.Atoi(step); err != nil || n <= 0 {
+				return fmt.Sprintf("invalid step %q", step)
+			}
+		}
+		if rng == "*" {
+			continue
+		}
+
+		from, to, isRange := strings.Cut(rng, "-")
+		start, ok := f.value(from)
+		if !ok {
+			return fmt.Sprintf("invalid value %q, must be between %d and %d", from, f.min, f.max)
+		}
+		if !isRange {
+			// a step after a single value starts a range up to max
+			continue
+		}
+		end, ok := f.value(to)
+		if !ok {
+			return fmt.Sprintf("invalid value %q, must be between %d and %d", to, f.min, f.max)
+		}
+		if start > end {
+			return fmt.Sprintf("range %q ends before it starts", rng)
+		}
+	}
+	return ""
+}
+
+func (f cronField) value(s string) (int, bool) {
+	for i, name := range f.names {
+		if strings.EqualFold(s, name) {
+			return f.min + i, true
+		}
+	}
+	n, err := strconv.Atoi(s)
+	if err != nil || n < f.min || n > f.max {
+		return 0, false
+	}
+	return n, true
+}
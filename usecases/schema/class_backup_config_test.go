@@ -0,0 +1,179 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	clusterSchema "github.com/weaviate/weaviate/cluster/schema"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+type fakeBackupScheduler struct {
+	scheduled map[string]models.ClassBackupConfig
+	err       error
+}
+
+func (f *fakeBackupScheduler) ScheduleClass(class string, cfg models.ClassBackupConfig) error {
+	if f.err != nil {
+		return f.err
+	}
+	f.scheduled[class] = cfg
+	return nil
+}
+
+func TestValidateCronExpression(t *testing.T) {
+	for _, expr := range []string{
+		"* * * * *",
+		"0 3 * * *",
+		"*/15 0-6,22-23 * * MON-FRI",
+		"30 2 1 jan,jul 0",
+		"0 0 * * 7",
+		"5/10 * * * *",
+		"@daily",
+	} {
+		assert.Nil(t, validateCronExpression(expr), expr)
+	}
+
+	for expr, field := range map[string]string{
+		"* * * *":        "",
+		"* * * * * *":    "",
+		"@every 5m":      "",
+		"60 * * * *":     "minute",
+		"* 24 * * *":     "hour",
+		"* * 0 * *":      "day of month",
+		"* * * FOO *":    "month",
+		"* * * * 8":      "day of week",
+		"*/0 * * * *":    "minute",
+		"10-5 * * * *":   "minute",
+		"1,,2 * * * *":   "minute",
+		"* 1-x * * *":    "hour",
+		"*/abc * * * *":  "minute",
+		"* * * 1-13/2 *": "month",
+	} {
+		var cronErr ErrInvalidCronExpression
+		err := validateCronExpression(expr)
+		require.True(t, errors.As(err, &cronErr), expr)
+		assert.Equal(t, expr, cronErr.Expression)
+		assert.Equal(t, field, cronErr.Field, expr)
+		assert.ErrorIs(t, err, clusterSchema.ErrBadRequest)
+	}
+}
+
+func TestValidateBackupConfig(t *testing.T) {
+	valid := []*models.ClassBackupConfig{
+		nil,
+		{},
+		{Schedule: "0 3 * * *", DestinationBucket: "backups", RetentionDays: 7, Enabled: true},
+		{Schedule: "0 3 * * *"},
+	}
+	for _, cfg := range valid {
+		assert.Nil(t, validateBackupConfig(&models.Class{BackupConfig: cfg}))
+	}
+
+	invalid := []*models.ClassBackupConfig{
+		{Schedule: "0 3 * *"},
+		{Schedule: "0 3 * * *", RetentionDays: -1},
+		{Enabled: true},
+	}
+	for _, cfg := range invalid {
+		assert.ErrorIs(t, validateBackupConfig(&models.Class{BackupConfig: cfg}), clusterSchema.ErrBadRequest)
+	}
+}
+
+func TestHandler_UpdateClassSchedulesBackups(t *testing.T) {
+	ctx := context.Background()
+	daily := &models.ClassBackupConfig{Schedule: "@daily", DestinationBucket: "backups", Enabled: true}
+
+	update := func(t *testing.T, initial, updated *models.ClassBackupConfig, scheduler *fakeBackupScheduler) error {
+		handler, fakeSchemaManager := newTestHandler(t, &fakeDB{})
+		handler.WithBackupScheduler(scheduler)
+		class := func(cfg *models.ClassBackupConfig) *models.Class {
+			return &models.Class{Class: "C", ReplicationConfig: &models.ReplicationConfig{Factor: 1}, BackupConfig: cfg}
+		}
+		fakeSchemaManager.On("ReadOnlyClass", "C").Return(class(initial))
+		fakeSchemaManager.On("UpdateClass", mock.Anything, mock.Anything).Return(nil).Maybe()
+		return handler.UpdateClass(ctx, nil, "C", class(updated))
+	}
+
+	t.Run("changed config", func(t *testing.T) {
+		scheduler := &fakeBackupScheduler{scheduled: map[string]models.ClassBackupConfig{}}
+		require.Nil(t, update(t, nil, daily, scheduler))
+		assert.Equal(t, map[string]models.ClassBackupConfig{"C": *daily}, scheduler.scheduled)
+	})
+
+	t.Run("removed config", func(t *testing.T) {
+		scheduler := &fakeBackupScheduler{scheduled: map[string]models.ClassBackupConfig{}}
+		require.Nil(t, update(t, daily, nil, scheduler))
+		assert.Equal(t, map[string]models.ClassBackupConfig{"C": {}}, scheduler.scheduled)
+	})
+
+	t.Run("unchanged config", func(t *testing.T) {
+		scheduler := &fakeBackupScheduler{scheduled: map[string]models.ClassBackupConfig{}}
+		unchanged := *daily
+		require.Nil(t, update(t, daily, &unchanged, scheduler))
+		assert.Empty(t, scheduler.scheduled)
+	})
+
+	t.Run("invalid schedule", func(t *testing.T) {
+		scheduler := &fakeBackupScheduler{scheduled: map[string]models.ClassBackupConfig{}}
+		err := update(t, nil, &models.ClassBackupConfig{Schedule: "daily"}, scheduler)
+		assert.ErrorAs(t, err, &ErrInvalidCronExpression{})
+		assert.Empty(t, scheduler.scheduled)
+	})
+
+	t.Run("scheduling fails", func(t *testing.T) {
+		handler, fakeSchemaManager := newTestHandler(t, &fakeDB{})
+		logger, hook := test.NewNullLogger()
+		handler.logger = logger
+		handler.WithBackupScheduler(&fakeBackupScheduler{err: errors.New("scheduler stopped")})
+		class := &models.Class{Class: "C", ReplicationConfig: &models.ReplicationConfig{Factor: 1}, BackupConfig: daily}
+		fakeSchemaManager.On("ReadOnlyClass", "C").Return(&models.Class{Class: "C", ReplicationConfig: &models.ReplicationConfig{Factor: 1}})
+		fakeSchemaManager.On("UpdateClass", mock.Anything, mock.Anything).Return(nil)
+
+		// the class is updated anyway
+		require.Nil(t, handler.UpdateClass(ctx, nil, "C", class))
+		require.NotNil(t, hook.LastEntry())
+		assert.Equal(t, logrus.ErrorLevel, hook.LastEntry().Level)
+		assert.ErrorContains(t, hook.LastEntry().Data[logrus.ErrorKey].(error), "scheduler stopped")
+	})
+}
+
+func TestHandler_AddClassSchedulesBackups(t *testing.T) {
+	ctx := context.Background()
+
+	add := func(t *testing.T, cfg *models.ClassBackupConfig) *fakeBackupScheduler {
+		handler, fakeSchemaManager := newTestHandler(t, &fakeDB{})
+		scheduler := &fakeBackupScheduler{scheduled: map[string]models.ClassBackupConfig{}}
+		handler.WithBackupScheduler(scheduler)
+		fakeSchemaManager.On("AddClass", mock.Anything, mock.Anything).Return(nil)
+		_, err := handler.AddClass(ctx, nil, &models.Class{Class: "C", Vectorizer: "none", BackupConfig: cfg})
+		require.Nil(t, err)
+		return scheduler
+	}
+
+	t.Run("with config", func(t *testing.T) {
+		daily := &models.ClassBackupConfig{Schedule: "@daily", DestinationBucket: "backups", Enabled: true}
+		assert.Equal(t, map[string]models.ClassBackupConfig{"C": *daily}, add(t, daily).scheduled)
+	})
+
+	t.Run("without config", func(t *testing.T) {
+		assert.Empty(t, add(t, nil).scheduled)
+	})
+}
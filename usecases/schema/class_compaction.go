@@ -0,0 +1,42 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"fmt"
+
+	clusterSchema "github.com/weaviate/weaviate/cluster/schema"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// validateCompactionConfig checks that the compaction settings of class are
+// positive. Unset settings, i.e. 0, keep the global ones.
+func validateCompactionConfig(class *models.Class) error {
+	cfg := class.CompactionConfig
+	if cfg == nil {
+		return nil
+	}
+	for _, setting := range []struct {
+		name  string
+		value int64
+	}{
+		{"maxSegmentSizeMB", cfg.MaxSegmentSizeMB},
+		{"compactionIntervalSeconds", cfg.CompactionIntervalSeconds},
+		{"minMergeSegments", cfg.MinMergeSegments},
+	} {
+		if setting.value < 0 {
+			return fmt.Errorf("%w: compactionConfig.%s must be positive, got %d",
+				clusterSchema.ErrBadRequest, setting.name, setting.value)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,41 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	clusterSchema "github.com/weaviate/weaviate/cluster/schema"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+func TestValidateCompactionConfig(t *testing.T) {
+	valid := []*models.ClassCompactionConfig{
+		nil,
+		{},
+		{MaxSegmentSizeMB: 512, CompactionIntervalSeconds: 60, MinMergeSegments: 4},
+		{MinMergeSegments: 2},
+	}
+	for _, cfg := range valid {
+		assert.Nil(t, validateCompactionConfig(&models.Class{CompactionConfig: cfg}))
+	}
+
+	invalid := []*models.ClassCompactionConfig{
+		{MaxSegmentSizeMB: -1},
+		{CompactionIntervalSeconds: -60},
+		{MaxSegmentSizeMB: 512, MinMergeSegments: -2},
+	}
+	for _, cfg := range invalid {
+		assert.ErrorIs(t, validateCompactionConfig(&models.Class{CompactionConfig: cfg}), clusterSchema.ErrBadRequest)
+	}
+}
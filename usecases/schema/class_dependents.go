@@ -0,0 +1,96 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
+)
+
+// ClassDependency is a cross-reference property of SourceClass pointing to
+// another class
+type ClassDependency struct {
+	SourceClass  string
+	PropertyName string
+	// PropertyType is the data type of the property, i.e. all classes it
+	// may reference
+	PropertyType []string
+}
+
+// ErrClassHasDependents is returned by SafeDeleteClass if the class is still
+// referenced by other classes
+type ErrClassHasDependents struct {
+	Class      string
+	Dependents []ClassDependency
+}
+
+func (e ErrClassHasDependents) Error() string {
+	refs := make([]string, len(e.Dependents))
+	for i, d := range e.Dependents {
+		refs[i] = d.SourceClass + "." + d.PropertyName
+	}
+	return fmt.Sprintf("class %s is referenced by %s", e.Class, strings.Join(refs, ", "))
+}
+
+// GetClassDependents returns the cross-reference properties of other classes
+// which may reference class, ordered by class and property name. References
+// of class to itself are not included.
+func (h *Handler) GetClassDependents(principal *models.Principal, class string) ([]ClassDependency, error) {
+	if err := h.Authorizer.Authorize(principal, authorization.READ, authorization.CollectionsMetadata()...); err != nil {
+		return nil, err
+	}
+
+	class = schema.UppercaseClassName(class)
+	var dependents []ClassDependency
+	for _, c := range h.schemaReader.ReadOnlySchema().Classes {
+		if c.Class == class {
+			continue
+		}
+		for _, p := range c.Properties {
+			if slices.Contains(p.DataType, class) {
+				dependents = append(dependents, ClassDependency{
+					SourceClass:  c.Class,
+					PropertyName: p.Name,
+					PropertyType: p.DataType,
+				})
+			}
+		}
+	}
+	sort.Slice(dependents, func(i, j int) bool {
+		if dependents[i].SourceClass != dependents[j].SourceClass {
+			return dependents[i].SourceClass < dependents[j].SourceClass
+		}
+		return dependents[i].PropertyName < dependents[j].PropertyName
+	})
+	return dependents, nil
+}
+
+// SafeDeleteClass deletes class like DeleteClass unless other classes still
+// reference it, in which case ErrClassHasDependents is returned
+func (h *Handler) SafeDeleteClass(ctx context.Context, principal *models.Principal, class string) error {
+	dependents, err := h.GetClassDependents(principal, class)
+	if err != nil {
+		return err
+	}
+	if len(dependents) > 0 {
+		return ErrClassHasDependents{Class: schema.UppercaseClassName(class), Dependents: dependents}
+	}
+	_, err = h.DeleteClass(ctx, principal, class)
+	return err
+}
@@ -0,0 +1,82 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+func TestHandler_GetClassDependents(t *testing.T) {
+	s := models.Schema{Classes: []*models.Class{
+		{Class: "Person", Properties: []*models.Property{
+			{Name: "name", DataType: []string{"text"}},
+			{Name: "friends", DataType: []string{"Person"}},
+			{Name: "owns", DataType: []string{"Car", "Bike"}},
+		}},
+		{Class: "Garage", Properties: []*models.Property{
+			{Name: "parked", DataType: []string{"Car"}},
+		}},
+		{Class: "Car", Properties: []*models.Property{
+			{Name: "model", DataType: []string{"text"}},
+			{Name: "towedBy", DataType: []string{"Car"}},
+		}},
+	}}
+
+	t.Run("dependents", func(t *testing.T) {
+		handler, fakeSchemaManager := newTestHandler(t, &fakeDB{})
+		fakeSchemaManager.On("ReadOnlySchema").Return(s)
+
+		dependents, err := handler.GetClassDependents(nil, "car")
+		require.Nil(t, err)
+		assert.Equal(t, []ClassDependency{
+			{SourceClass: "Garage", PropertyName: "parked", PropertyType: []string{"Car"}},
+			{SourceClass: "Person", PropertyName: "owns", PropertyType: []string{"Car", "Bike"}},
+		}, dependents)
+	})
+
+	t.Run("self references only", func(t *testing.T) {
+		handler, fakeSchemaManager := newTestHandler(t, &fakeDB{})
+		fakeSchemaManager.On("ReadOnlySchema").Return(s)
+
+		dependents, err := handler.GetClassDependents(nil, "Person")
+		require.Nil(t, err)
+		assert.Empty(t, dependents)
+	})
+
+	t.Run("safe delete is blocked by dependents", func(t *testing.T) {
+		handler, fakeSchemaManager := newTestHandler(t, &fakeDB{})
+		fakeSchemaManager.On("ReadOnlySchema").Return(s)
+
+		err := handler.SafeDeleteClass(context.Background(), nil, "Car")
+		var target ErrClassHasDependents
+		require.True(t, errors.As(err, &target))
+		assert.Len(t, target.Dependents, 2)
+		assert.EqualError(t, err, "class Car is referenced by Garage.parked, Person.owns")
+		fakeSchemaManager.AssertNotCalled(t, "DeleteClass", mock.Anything)
+	})
+
+	t.Run("safe delete without dependents", func(t *testing.T) {
+		handler, fakeSchemaManager := newTestHandler(t, &fakeDB{})
+		fakeSchemaManager.On("ReadOnlySchema").Return(s)
+		fakeSchemaManager.On("DeleteClass", "Person").Return(nil)
+
+		require.Nil(t, handler.SafeDeleteClass(context.Background(), nil, "person"))
+		fakeSchemaManager.AssertExpectations(t)
+	})
+}
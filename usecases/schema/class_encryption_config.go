@@ -0,0 +1,100 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"fmt"
+	"reflect"
+
+	clusterSchema "github.com/weaviate/weaviate/cluster/schema"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
+)
+
+// redactedKeyID replaces the key id of encryption configs for users who may
+// not update the class
+const redactedKeyID = "[redacted]"
+
+// kmsClient looks up encryption keys in an external key management service
+type kmsClient interface {
+	VerifyKeyExists(keyID string) error
+}
+
+// WithKMSClient makes AddClass verify the encryption keys of classes with c.
+// Without a client classes can't enable encryption, which is the case for
+// now: no key management service is wired in at startup.
+func (h *Handler) WithKMSClient(c kmsClient) {
+	h.kmsClient = c
+}
+
+// validateEncryptionConfig checks the encryption config of class, if any, and
+// that the key of an enabled config exists
+func (h *Handler) validateEncryptionConfig(class *models.Class) error {
+	cfg := class.EncryptionConfig
+	if cfg == nil {
+		return nil
+	}
+	switch cfg.Algorithm {
+	case models.ClassEncryptionConfigAlgorithmAESDash256DashGCM, models.ClassEncryptionConfigAlgorithmChaCha20DashPoly1305:
+	case "":
+		if cfg.Enabled {
+			return fmt.Errorf("%w: encryptionConfig.algorithm is required for enabled encryption", clusterSchema.ErrBadRequest)
+		}
+	default:
+		return fmt.Errorf("%w: encryptionConfig.algorithm %q is not supported, use %q or %q", clusterSchema.ErrBadRequest,
+			cfg.Algorithm, models.ClassEncryptionConfigAlgorithmAESDash256DashGCM, models.ClassEncryptionConfigAlgorithmChaCha20DashPoly1305)
+	}
+	if !cfg.Enabled {
+		return nil
+	}
+	if cfg.KeyID == "" {
+		return fmt.Errorf("%w: encryptionConfig.keyId is required for enabled encryption", clusterSchema.ErrBadRequest)
+	}
+	if h.kmsClient == nil {
+		return fmt.Errorf("%w: encryption requires a key management service, none is configured", clusterSchema.ErrBadRequest)
+	}
+	if err := h.kmsClient.VerifyKeyExists(cfg.KeyID); err != nil {
+		return fmt.Errorf("verify encryption key %q: %w", cfg.KeyID, err)
+	}
+	return nil
+}
+
+// validateUpdatingEncryption keeps the encryption config of initial if
+// updated has none, older clients don't send it. The config can't be changed,
+// the stored data is encrypted with it.
+func validateUpdatingEncryption(initial, updated *models.Class) error {
+	if updated.EncryptionConfig == nil {
+		updated.EncryptionConfig = initial.EncryptionConfig
+		return nil
+	}
+	if !reflect.DeepEqual(initial.EncryptionConfig, updated.EncryptionConfig) {
+		return fmt.Errorf("%w: encryptionConfig is immutable", clusterSchema.ErrBadRequest)
+	}
+	return nil
+}
+
+// redactEncryptionKey returns class with the key id of its encryption config
+// redacted, unless principal may update class. The class of the schema is
+// not modified, a copy is returned instead.
+func (h *Handler) redactEncryptionKey(principal *models.Principal, class *models.Class) *models.Class {
+	if class == nil || class.EncryptionConfig == nil || class.EncryptionConfig.KeyID == "" {
+		return class
+	}
+	if err := h.Authorizer.Authorize(principal, authorization.UPDATE, authorization.CollectionsMetadata(class.Class)...); err == nil {
+		return class
+	}
+	redacted := *class
+	cfg := *class.EncryptionConfig
+	cfg.KeyID = redactedKeyID
+	redacted.EncryptionConfig = &cfg
+	return &redacted
+}
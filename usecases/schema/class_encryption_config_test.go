@@ -0,0 +1,154 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	clusterSchema "github.com/weaviate/weaviate/cluster/schema"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
+	"github.com/weaviate/weaviate/usecases/auth/authorization/mocks"
+)
+
+type fakeKMSClient struct {
+	keys map[string]bool
+}
+
+func (f *fakeKMSClient) VerifyKeyExists(keyID string) error {
+	if !f.keys[keyID] {
+		return errors.New("key not found")
+	}
+	return nil
+}
+
+func TestValidateEncryptionConfig(t *testing.T) {
+	handler, _ := newTestHandler(t, &fakeDB{})
+	handler.WithKMSClient(&fakeKMSClient{keys: map[string]bool{"key-1": true}})
+
+	valid := []*models.ClassEncryptionConfig{
+		nil,
+		{},
+		{Algorithm: "AES-256-GCM", KeyID: "key-1", Enabled: true},
+		{Algorithm: "ChaCha20-Poly1305", KeyID: "key-1", Enabled: true},
+		{Algorithm: "AES-256-GCM"},
+	}
+	for _, cfg := range valid {
+		assert.Nil(t, handler.validateEncryptionConfig(&models.Class{EncryptionConfig: cfg}))
+	}
+
+	invalid := []*models.ClassEncryptionConfig{
+		{Algorithm: "AES-128-CBC"},
+		{KeyID: "key-1", Enabled: true},
+		{Algorithm: "AES-256-GCM", Enabled: true},
+	}
+	for _, cfg := range invalid {
+		assert.ErrorIs(t, handler.validateEncryptionConfig(&models.Class{EncryptionConfig: cfg}), clusterSchema.ErrBadRequest)
+	}
+
+	err := handler.validateEncryptionConfig(&models.Class{EncryptionConfig: &models.ClassEncryptionConfig{
+		Algorithm: "AES-256-GCM", KeyID: "unknown", Enabled: true,
+	}})
+	assert.ErrorContains(t, err, "key not found")
+
+	t.Run("without kms", func(t *testing.T) {
+		handler, _ := newTestHandler(t, &fakeDB{})
+		err := handler.validateEncryptionConfig(&models.Class{EncryptionConfig: &models.ClassEncryptionConfig{
+			Algorithm: "AES-256-GCM", KeyID: "key-1", Enabled: true,
+		}})
+		assert.ErrorIs(t, err, clusterSchema.ErrBadRequest)
+	})
+}
+
+func TestValidateUpdatingEncryption(t *testing.T) {
+	cfg := &models.ClassEncryptionConfig{Algorithm: "AES-256-GCM", KeyID: "key-1", Enabled: true}
+
+	updated := &models.Class{}
+	require.Nil(t, validateUpdatingEncryption(&models.Class{EncryptionConfig: cfg}, updated))
+	assert.Equal(t, cfg, updated.EncryptionConfig, "missing config is kept")
+
+	same := *cfg
+	assert.Nil(t, validateUpdatingEncryption(&models.Class{EncryptionConfig: cfg}, &models.Class{EncryptionConfig: &same}))
+
+	for _, changed := range []*models.ClassEncryptionConfig{
+		{Algorithm: "AES-256-GCM", KeyID: "key-2", Enabled: true},
+		{Algorithm: "AES-256-GCM", KeyID: "key-1"},
+	} {
+		err := validateUpdatingEncryption(&models.Class{EncryptionConfig: cfg}, &models.Class{EncryptionConfig: changed})
+		assert.ErrorIs(t, err, clusterSchema.ErrBadRequest)
+	}
+	err := validateUpdatingEncryption(&models.Class{}, &models.Class{EncryptionConfig: cfg})
+	assert.ErrorIs(t, err, clusterSchema.ErrBadRequest)
+}
+
+func TestGetConsistentClassRedactsKeyID(t *testing.T) {
+	ctx := context.Background()
+	reader := &models.Principal{Username: "reader"}
+	admin := &models.Principal{Username: "admin"}
+	class := &models.Class{
+		Class:            "C",
+		EncryptionConfig: &models.ClassEncryptionConfig{Algorithm: "AES-256-GCM", KeyID: "key-1", Enabled: true},
+	}
+
+	authorizer := mocks.NewAuthorizer(t)
+	authorizer.On("Authorize", mock.Anything, authorization.READ, authorization.CollectionsMetadata("C")[0]).Return(nil)
+	authorizer.On("Authorize", admin, authorization.UPDATE, authorization.CollectionsMetadata("C")[0]).Return(nil)
+	authorizer.On("Authorize", reader, authorization.UPDATE, authorization.CollectionsMetadata("C")[0]).
+		Return(errors.New("forbidden"))
+	handler, fakeSchemaManager := newTestHandlerWithCustomAuthorizer(t, &fakeDB{}, authorizer)
+	fakeSchemaManager.On("ReadOnlyClassWithVersion", mock.Anything, "C", uint64(0)).Return(class, nil)
+
+	got, _, err := handler.GetConsistentClass(ctx, reader, "C", false)
+	require.Nil(t, err)
+	assert.Equal(t, redactedKeyID, got.EncryptionConfig.KeyID)
+	assert.Equal(t, "AES-256-GCM", got.EncryptionConfig.Algorithm)
+	assert.Equal(t, "key-1", class.EncryptionConfig.KeyID, "the class of the schema is not modified")
+
+	got, _, err = handler.GetConsistentClass(ctx, admin, "C", false)
+	require.Nil(t, err)
+	assert.Equal(t, "key-1", got.EncryptionConfig.KeyID)
+}
+
+func TestSchemaReadsRedactKeyID(t *testing.T) {
+	reader := &models.Principal{Username: "reader"}
+	class := &models.Class{
+		Class:            "C",
+		EncryptionConfig: &models.ClassEncryptionConfig{Algorithm: "AES-256-GCM", KeyID: "key-1", Enabled: true},
+	}
+
+	authorizer := mocks.NewAuthorizer(t)
+	authorizer.On("Authorize", reader, authorization.READ, mock.Anything).Return(nil)
+	authorizer.On("Authorize", reader, authorization.UPDATE, authorization.CollectionsMetadata("C")[0]).
+		Return(errors.New("forbidden"))
+	handler, fakeSchemaManager := newTestHandlerWithCustomAuthorizer(t, &fakeDB{}, authorizer)
+	fakeSchemaManager.On("ReadOnlySchema").Return(models.Schema{Classes: []*models.Class{class}})
+	fakeSchemaManager.On("SchemaChangelog", uint64(0), 0).
+		Return([]SchemaChangeEntry{{Operation: "UPDATE_CLASS", Class: "C", Previous: class}})
+
+	filtered, err := handler.GetSchemaFiltered(reader)
+	require.Nil(t, err)
+	assert.Equal(t, redactedKeyID, filtered.Objects.Classes[0].EncryptionConfig.KeyID)
+
+	found, err := handler.SearchClasses(reader, ClassSearchQuery{})
+	require.Nil(t, err)
+	assert.Equal(t, redactedKeyID, found[0].EncryptionConfig.KeyID)
+
+	changes, err := handler.GetSchemaChangelog(reader, 0, 0)
+	require.Nil(t, err)
+	assert.Equal(t, redactedKeyID, changes[0].Previous.EncryptionConfig.KeyID)
+	assert.Equal(t, "key-1", class.EncryptionConfig.KeyID, "the class of the schema is not modified")
+}
@@ -0,0 +1,62 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"context"
+	"time"
+
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+)
+
+// classExpiryInterval is how often StartExpiryWorker looks for expired classes
+const classExpiryInterval = time.Minute
+
+// StartExpiryWorker deletes the classes whose ExpiresAt has passed and purges
+// the soft deleted classes retained long enough in the background, looking
+// for them every minute until ctx is done. Only the leader deletes classes,
+// so that each class is deleted once no matter how many nodes run the worker.
+func (h *Handler) StartExpiryWorker(ctx context.Context) {
+	enterrors.GoWrapper(func() {
+		ticker := time.NewTicker(classExpiryInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				h.deleteExpiredClasses(ctx, time.Now())
+				h.purgeDeletedClasses(ctx, time.Now())
+			}
+		}
+	}, h.logger)
+}
+
+// deleteExpiredClasses deletes the classes which expired before now. The
+// deletion is system-initiated and therefore not authorized.
+func (h *Handler) deleteExpiredClasses(ctx context.Context, now time.Time) {
+	if _, leader := h.schemaManager.LeaderWithID(); leader != h.clusterState.LocalName() {
+		return
+	}
+
+	for _, class := range h.GetSchemaSkipAuth().Objects.Classes {
+		if class.ExpiresAt == nil || time.Time(*class.ExpiresAt).After(now) {
+			continue
+		}
+		logger := h.logEntry(ctx, class.Class, "").WithField("action", "class_expiry").
+			WithField("expires_at", class.ExpiresAt.String())
+		logger.Info("deleting expired class")
+		if _, err := h.schemaManager.DeleteClass(ctx, class.Class); err != nil {
+			logger.WithError(err).Error("deleting expired class failed")
+		}
+	}
+}
@@ -0,0 +1,59 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/stretchr/testify/mock"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+func TestHandler_DeleteExpiredClasses(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+	at := func(t time.Time) *strfmt.DateTime {
+		dt := strfmt.DateTime(t)
+		return &dt
+	}
+	classes := []*models.Class{
+		{Class: "Expired", ExpiresAt: at(now.Add(-time.Second))},
+		{Class: "Failing", ExpiresAt: at(now.Add(-time.Hour))},
+		{Class: "Later", ExpiresAt: at(now.Add(time.Second))},
+		{Class: "Permanent"},
+	}
+
+	t.Run("leader deletes expired classes", func(t *testing.T) {
+		handler, fakeSchemaManager := newTestHandler(t, &fakeDB{})
+		fakeSchemaManager.On("LeaderWithID").Return("addr", handler.clusterState.LocalName())
+		fakeSchemaManager.On("ReadOnlySchema").Return(models.Schema{Classes: classes})
+		fakeSchemaManager.On("DeleteClass", "Failing").Return(errors.New("leader changed")).Once()
+		fakeSchemaManager.On("DeleteClass", "Expired").Return(nil).Once()
+
+		handler.deleteExpiredClasses(ctx, now)
+		fakeSchemaManager.AssertExpectations(t)
+		fakeSchemaManager.AssertNumberOfCalls(t, "DeleteClass", 2)
+	})
+
+	t.Run("followers do not delete classes", func(t *testing.T) {
+		handler, fakeSchemaManager := newTestHandler(t, &fakeDB{})
+		fakeSchemaManager.On("LeaderWithID").Return("addr", "other-node")
+
+		handler.deleteExpiredClasses(ctx, now)
+		fakeSchemaManager.AssertNotCalled(t, "ReadOnlySchema")
+		fakeSchemaManager.AssertNotCalled(t, "DeleteClass", mock.Anything)
+	})
+}
@@ -0,0 +1,123 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	clusterSchema "github.com/weaviate/weaviate/cluster/schema"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
+)
+
+// ClassInfoSnapshot is the state of the shards of a class at Time
+type ClassInfoSnapshot struct {
+	Time   time.Time
+	Shards models.ShardStatusList
+	// ObjectCounts are the object counts of the active shards held by this
+	// node, keyed by shard name
+	ObjectCounts map[string]int64
+	// Replication is empty for classes without replication
+	Replication []ShardReplicationStatus
+}
+
+// StreamClassInfo sends a snapshot of class right away and then every
+// interval until ctx is done. Both channels are closed when the stream ends,
+// an error is sent beforehand if it ended for another reason than ctx.
+func (h *Handler) StreamClassInfo(ctx context.Context, principal *models.Principal,
+	class string, interval time.Duration,
+) (<-chan ClassInfoSnapshot, <-chan error) {
+	snapshots := make(chan ClassInfoSnapshot)
+	errs := make(chan error, 1)
+
+	class = schema.UppercaseClassName(class)
+	if err := h.Authorizer.Authorize(principal, authorization.READ, authorization.ShardsMetadata(class)...); err != nil {
+		errs <- err
+		close(snapshots)
+		close(errs)
+		return snapshots, errs
+	}
+	if interval <= 0 {
+		errs <- fmt.Errorf("%w: interval must be positive, got %s", clusterSchema.ErrBadRequest, interval)
+		close(snapshots)
+		close(errs)
+		return snapshots, errs
+	}
+
+	enterrors.GoWrapper(func() {
+		defer close(errs)
+		defer close(snapshots)
+
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			snapshot, err := h.classInfoSnapshot(ctx, class)
+			if err != nil {
+				if ctx.Err() == nil {
+					errs <- err
+				}
+				return
+			}
+			select {
+			case snapshots <- snapshot:
+			case <-ctx.Done():
+				return
+			}
+			select {
+			case <-ticker.C:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}, h.logger)
+	return snapshots, errs
+}
+
+func (h *Handler) classInfoSnapshot(ctx context.Context, class string) (ClassInfoSnapshot, error) {
+	snapshot := ClassInfoSnapshot{Time: time.Now(), ObjectCounts: map[string]int64{}}
+
+	state := h.schemaReader.CopyShardingState(class)
+	if state == nil {
+		return snapshot, fmt.Errorf("class %q: %w", class, ErrNotFound)
+	}
+	shards, err := h.schemaReader.GetShardsStatus(class, "")
+	if err != nil {
+		return snapshot, fmt.Errorf("shards status: %w", err)
+	}
+	snapshot.Shards = shards
+
+	for _, shard := range state.AllLocalPhysicalShards() {
+		// inactive tenants aren't loaded
+		if schema.ActivityStatus(state.Physical[shard].Status) != models.TenantActivityStatusHOT {
+			continue
+		}
+		count, err := h.schemaReader.ShardObjectCount(class, shard)
+		if err != nil {
+			return snapshot, fmt.Errorf("count objects of shard %q: %w", shard, err)
+		}
+		snapshot.ObjectCounts[shard] = count
+	}
+
+	if h.replicationChecker != nil {
+		replication, err := h.classReplicationStatus(ctx, class)
+		if err != nil && !errors.Is(err, ErrNoReplicationConfigured) {
+			return snapshot, fmt.Errorf("replication status: %w", err)
+		}
+		snapshot.Replication = replication
+	}
+	return snapshot, nil
+}
@@ -0,0 +1,89 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
+	"github.com/weaviate/weaviate/usecases/auth/authorization/mocks"
+	"github.com/weaviate/weaviate/usecases/sharding"
+)
+
+func TestHandler_StreamClassInfo(t *testing.T) {
+	state := &sharding.State{Physical: map[string]sharding.Physical{
+		"S1": {Name: "S1", BelongsToNodes: []string{"node1"}},
+		"S2": {Name: "S2", BelongsToNodes: []string{"node2"}},
+		"S3": {Name: "S3", BelongsToNodes: []string{"node1"}, Status: models.TenantActivityStatusCOLD},
+	}}
+	state.SetLocalName("node1")
+	shards := models.ShardStatusList{{Name: "S1", Status: "READY"}}
+
+	t.Run("snapshots until cancelled", func(t *testing.T) {
+		handler, fakeSchemaManager := newTestHandler(t, &fakeDB{})
+		fakeSchemaManager.On("CopyShardingState", "C").Return(state)
+		fakeSchemaManager.On("GetShardsStatus", "C", "").Return(shards, nil)
+		fakeSchemaManager.On("ShardObjectCount", "C", "S1").Return(int64(7), nil)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		snapshots, errs := handler.StreamClassInfo(ctx, nil, "c", time.Millisecond)
+		for i := 0; i < 3; i++ {
+			snapshot := <-snapshots
+			assert.Equal(t, shards, snapshot.Shards)
+			assert.Equal(t, map[string]int64{"S1": 7}, snapshot.ObjectCounts)
+			assert.Empty(t, snapshot.Replication)
+			assert.False(t, snapshot.Time.IsZero())
+		}
+		cancel()
+
+		// drain a snapshot which may have been sent before the cancellation
+		for range snapshots {
+		}
+		assert.Nil(t, <-errs)
+	})
+
+	t.Run("class not found", func(t *testing.T) {
+		handler, fakeSchemaManager := newTestHandler(t, &fakeDB{})
+		fakeSchemaManager.On("CopyShardingState", "C").Return((*sharding.State)(nil))
+
+		snapshots, errs := handler.StreamClassInfo(context.Background(), nil, "C", time.Second)
+		_, ok := <-snapshots
+		assert.False(t, ok)
+		assert.ErrorIs(t, <-errs, ErrNotFound)
+	})
+
+	t.Run("not authorized", func(t *testing.T) {
+		authorizer := mocks.NewMockAuthorizer()
+		authorizer.SetErr(errors.New("forbidden"))
+		handler, _ := newTestHandlerWithCustomAuthorizer(t, &fakeDB{}, authorizer)
+
+		snapshots, errs := handler.StreamClassInfo(context.Background(), nil, "c", time.Second)
+		_, ok := <-snapshots
+		assert.False(t, ok)
+		assert.EqualError(t, <-errs, "forbidden")
+		require.Len(t, authorizer.Calls(), 1)
+		assert.Equal(t, authorization.ShardsMetadata("C"), authorizer.Calls()[0].Resources)
+	})
+
+	t.Run("invalid interval", func(t *testing.T) {
+		handler, _ := newTestHandler(t, &fakeDB{})
+
+		_, errs := handler.StreamClassInfo(context.Background(), nil, "C", 0)
+		assert.ErrorContains(t, <-errs, "interval must be positive")
+	})
+}
@@ -0,0 +1,191 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	clusterSchema "github.com/weaviate/weaviate/cluster/schema"
+	"github.com/weaviate/weaviate/entities/deepcopy"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
+	"github.com/weaviate/weaviate/usecases/config"
+)
+
+// ErrClassHasChildren is returned by DeleteClass for classes which other
+// classes extend
+type ErrClassHasChildren struct {
+	Class    string
+	Children []string
+}
+
+func (e ErrClassHasChildren) Error() string {
+	return fmt.Sprintf("class %q is extended by %s, delete them first", e.Class, strings.Join(e.Children, ", "))
+}
+
+// inheritProperties copies the properties of the class cls extends into cls,
+// marked as inherited. The parent must exist and cls may have at most
+// Schema.MaxInheritanceDepth ancestors. Properties of cls can't have the name
+// of an inherited one.
+func (h *Handler) inheritProperties(cls *models.Class, classGetter func(string) (*models.Class, error)) error {
+	for _, prop := range cls.Properties {
+		prop.Inherited = false
+	}
+	if cls.Extends == "" {
+		return nil
+	}
+
+	cls.Extends = schema.UppercaseClassName(cls.Extends)
+	parent, err := classGetter(cls.Extends)
+	if err != nil {
+		return err
+	}
+	if parent == nil {
+		return fmt.Errorf("%w: class %q extends class %q, which doesn't exist",
+			clusterSchema.ErrBadRequest, cls.Class, cls.Extends)
+	}
+
+	maxDepth := h.config.Schema.MaxInheritanceDepth
+	if maxDepth <= 0 {
+		maxDepth = config.DefaultMaxInheritanceDepth
+	}
+	depth := 1
+	for ancestor := parent; ancestor.Extends != ""; depth++ {
+		if ancestor = h.schemaReader.ReadOnlyClass(ancestor.Extends); ancestor == nil {
+			break
+		}
+	}
+	if depth > maxDepth {
+		return fmt.Errorf("%w: class %q would have %d ancestors, at most %d are allowed",
+			clusterSchema.ErrBadRequest, cls.Class, depth, maxDepth)
+	}
+
+	own := make(map[string]bool, len(cls.Properties))
+	for _, prop := range cls.Properties {
+		own[strings.ToLower(prop.Name)] = true
+	}
+	inherited := make([]*models.Property, 0, len(parent.Properties)+len(cls.Properties))
+	for _, prop := range parent.Properties {
+		if own[strings.ToLower(prop.Name)] {
+			return fmt.Errorf("%w: property %q of class %q is inherited from class %q",
+				clusterSchema.ErrBadRequest, prop.Name, cls.Class, parent.Class)
+		}
+		p := deepcopy.Prop(prop)
+		p.Inherited = true
+		inherited = append(inherited, p)
+	}
+	cls.Properties = append(inherited, cls.Properties...)
+	return nil
+}
+
+// childClasses returns the classes extending class, ordered by name
+func (h *Handler) childClasses(class string) []*models.Class {
+	var children []*models.Class
+	for _, c := range h.schemaReader.ReadOnlySchema().Classes {
+		if c.Extends == class {
+			children = append(children, c)
+		}
+	}
+	sort.Slice(children, func(i, j int) bool { return children[i].Class < children[j].Class })
+	return children
+}
+
+// descendantClasses returns the classes inheriting from class, breadth first
+func (h *Handler) descendantClasses(class string) []*models.Class {
+	var descendants []*models.Class
+	visited := map[string]bool{class: true}
+	for queue := []string{class}; len(queue) > 0; queue = queue[1:] {
+		for _, child := range h.childClasses(queue[0]) {
+			if visited[child.Class] {
+				continue
+			}
+			visited[child.Class] = true
+			descendants = append(descendants, child)
+			queue = append(queue, child.Class)
+		}
+	}
+	return descendants
+}
+
+// validatePropertyInheritance checks that the classes inheriting from class
+// can inherit props, which are about to be added to class. It returns them.
+func (h *Handler) validatePropertyInheritance(principal *models.Principal, class string,
+	props []*models.Property,
+) ([]*models.Class, error) {
+	descendants := h.descendantClasses(class)
+	for _, d := range descendants {
+		if err := h.Authorizer.Authorize(principal, authorization.UPDATE, authorization.CollectionsMetadata(d.Class)...); err != nil {
+			return nil, err
+		}
+		for _, prop := range props {
+			if existing, err := schema.GetPropertyByName(d, prop.Name); err == nil && !existing.Inherited {
+				return nil, fmt.Errorf("%w: class %q, which inherits from class %q, has its own property %q",
+					clusterSchema.ErrBadRequest, d.Class, class, prop.Name)
+			}
+		}
+		if err := h.validateMaxProperties(d.Class, props); err != nil {
+			return nil, err
+		}
+	}
+	return descendants, nil
+}
+
+// addInheritedProperties adds props to class and, marked as inherited, to
+// the classes inheriting from it. With descendants all classes are changed in
+// one transaction, so that either all of them get the properties or none
+// does. It returns the schema version of the change.
+func (h *Handler) addInheritedProperties(ctx context.Context, principal *models.Principal, class string,
+	descendants []*models.Class, props []*models.Property,
+) (uint64, error) {
+	if len(descendants) == 0 {
+		version, err := h.schemaManager.AddProperty(withActor(ctx, principal), class, props...)
+		if err != nil {
+			return 0, err
+		}
+		for _, prop := range props {
+			h.notify(ctx, func(l EventListener) { l.OnPropertyAdded(class, prop) })
+		}
+		return version, nil
+	}
+
+	add := func(th TxnHandler) error {
+		if _, err := th.schemaManager.AddProperty(withActor(ctx, principal), class, props...); err != nil {
+			return err
+		}
+		for _, prop := range props {
+			th.notify(ctx, func(l EventListener) { l.OnPropertyAdded(class, prop) })
+		}
+		for _, d := range descendants {
+			inherited := make([]*models.Property, len(props))
+			for i, prop := range props {
+				inherited[i] = deepcopy.Prop(prop)
+				inherited[i].Inherited = true
+			}
+			if _, err := th.schemaManager.AddProperty(withActor(ctx, principal), d.Class, inherited...); err != nil {
+				return fmt.Errorf("inherit properties to class %q: %w", d.Class, err)
+			}
+			for _, prop := range inherited {
+				th.notify(ctx, func(l EventListener) { l.OnPropertyAdded(d.Class, prop) })
+			}
+		}
+		return nil
+	}
+	if h.pendingEvents != nil {
+		// h is a TxnHandler, the changes are part of its transaction
+		return 0, add(TxnHandler{Handler: h})
+	}
+	return h.withTransaction(ctx, principal, add)
+}
@@ -0,0 +1,144 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	clusterSchema "github.com/weaviate/weaviate/cluster/schema"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+func TestHandler_ClassInheritance(t *testing.T) {
+	ctx := context.Background()
+	textProp := func(name string, inherited bool) *models.Property {
+		return &models.Property{Name: name, DataType: []string{"text"}, Inherited: inherited}
+	}
+	parent := &models.Class{Class: "Parent", Properties: []*models.Property{textProp("name", false)}}
+	child := &models.Class{
+		Class: "Child", Extends: "Parent",
+		Properties: []*models.Property{textProp("name", true), textProp("age", false)},
+	}
+	grandchild := &models.Class{Class: "Grandchild", Extends: "Child", Properties: child.Properties}
+	hierarchy := models.Schema{Classes: []*models.Class{grandchild, parent, child, {Class: "Other"}}}
+
+	t.Run("add class inherits properties", func(t *testing.T) {
+		handler, fakeSchemaManager := newTestHandler(t, &fakeDB{})
+		fakeSchemaManager.On("ReadOnlyClass", "Parent").Return(parent)
+		fakeSchemaManager.On("AddClass", mock.Anything, mock.Anything).Return(nil)
+
+		_, err := handler.AddClass(ctx, nil, &models.Class{
+			Class: "Child", Extends: "parent", Vectorizer: "none",
+			Properties: []*models.Property{textProp("age", true)},
+		})
+		require.Nil(t, err)
+		added := fakeSchemaManager.Calls[len(fakeSchemaManager.Calls)-1].Arguments.Get(0).(*models.Class)
+		assert.Equal(t, "Parent", added.Extends)
+		require.Len(t, added.Properties, 2)
+		assert.Equal(t, "name", added.Properties[0].Name)
+		assert.True(t, added.Properties[0].Inherited)
+		assert.Equal(t, "age", added.Properties[1].Name)
+		assert.False(t, added.Properties[1].Inherited)
+		assert.False(t, parent.Properties[0].Inherited, "the parent is not changed")
+	})
+
+	t.Run("add class with invalid parent", func(t *testing.T) {
+		handler, fakeSchemaManager := newTestHandler(t, &fakeDB{})
+		handler.config.Schema.MaxInheritanceDepth = 1
+		fakeSchemaManager.On("ReadOnlyClass", "Parent").Return(parent)
+		fakeSchemaManager.On("ReadOnlyClass", "Child").Return(child)
+		fakeSchemaManager.On("ReadOnlyClass", "Unknown").Return(nil)
+
+		for name, cls := range map[string]*models.Class{
+			"missing parent":     {Class: "C", Extends: "Unknown"},
+			"too deep":           {Class: "C", Extends: "Child"},
+			"inherited property": {Class: "C", Extends: "Parent", Properties: []*models.Property{textProp("name", false)}},
+		} {
+			t.Run(name, func(t *testing.T) {
+				_, err := handler.AddClass(ctx, nil, cls)
+				assert.ErrorIs(t, err, clusterSchema.ErrBadRequest)
+			})
+		}
+		fakeSchemaManager.AssertNotCalled(t, "AddClass", mock.Anything, mock.Anything)
+	})
+
+	t.Run("add property propagates to descendants", func(t *testing.T) {
+		handler, fakeSchemaManager := newTestHandler(t, &fakeDB{})
+		fakeSchemaManager.On("ReadOnlySchema").Return(hierarchy)
+		city := func(inherited bool) interface{} {
+			return mock.MatchedBy(func(props []*models.Property) bool {
+				return len(props) == 1 && props[0].Name == "city" && props[0].Inherited == inherited
+			})
+		}
+		txn := &fakeTxn{}
+		txn.On("AddProperty", "Parent", city(false)).Return(nil).Once()
+		txn.On("AddProperty", "Child", city(true)).Return(nil).Once()
+		txn.On("AddProperty", "Grandchild", city(true)).Return(nil).Once()
+		txn.On("Commit").Return(nil).Once()
+		fakeSchemaManager.On("Begin").Return(txn, nil).Once()
+
+		cls := *parent
+		_, _, err := handler.AddClassProperty(ctx, nil, &cls, "Parent", false, textProp("city", true))
+		require.Nil(t, err)
+		txn.AssertExpectations(t)
+		fakeSchemaManager.AssertNotCalled(t, "AddProperty", mock.Anything, mock.Anything)
+		var order []string
+		for _, call := range txn.Calls {
+			if call.Method == "AddProperty" {
+				order = append(order, call.Arguments.String(0))
+			}
+		}
+		assert.Equal(t, []string{"Parent", "Child", "Grandchild"}, order)
+	})
+
+	t.Run("add property failing for a descendant", func(t *testing.T) {
+		handler, fakeSchemaManager := newTestHandler(t, &fakeDB{})
+		fakeSchemaManager.On("ReadOnlySchema").Return(hierarchy)
+		txn := &fakeTxn{}
+		txn.On("AddProperty", "Parent", mock.Anything).Return(nil).Once()
+		txn.On("AddProperty", "Child", mock.Anything).Return(clusterSchema.ErrClassNotFound).Once()
+		txn.On("Rollback").Return(nil).Once()
+		fakeSchemaManager.On("Begin").Return(txn, nil).Once()
+
+		cls := *parent
+		_, _, err := handler.AddClassProperty(ctx, nil, &cls, "Parent", false, textProp("city", false))
+		assert.ErrorIs(t, err, clusterSchema.ErrClassNotFound)
+		txn.AssertExpectations(t)
+		txn.AssertNotCalled(t, "Commit")
+	})
+
+	t.Run("add property clashing with a descendant", func(t *testing.T) {
+		handler, fakeSchemaManager := newTestHandler(t, &fakeDB{})
+		fakeSchemaManager.On("ReadOnlySchema").Return(hierarchy)
+
+		cls := *parent
+		_, _, err := handler.AddClassProperty(ctx, nil, &cls, "Parent", false, textProp("age", false))
+		assert.ErrorIs(t, err, clusterSchema.ErrBadRequest)
+		fakeSchemaManager.AssertNotCalled(t, "AddProperty", mock.Anything, mock.Anything)
+	})
+
+	t.Run("delete class with children", func(t *testing.T) {
+		handler, fakeSchemaManager := newTestHandler(t, &fakeDB{})
+		fakeSchemaManager.On("ReadOnlySchema").Return(hierarchy)
+		fakeSchemaManager.On("DeleteClass", "Grandchild").Return(nil)
+
+		_, err := handler.DeleteClass(ctx, nil, "Parent")
+		assert.Equal(t, ErrClassHasChildren{Class: "Parent", Children: []string{"Child"}}, err)
+		_, err = handler.DeleteClass(ctx, nil, "Grandchild")
+		assert.Nil(t, err)
+		fakeSchemaManager.AssertNumberOfCalls(t, "DeleteClass", 1)
+	})
+}
@@ -0,0 +1,110 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	clusterSchema "github.com/weaviate/weaviate/cluster/schema"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
+)
+
+const maxClassMetadataValueLength = 256
+
+var classMetadataKeyRegex = regexp.MustCompile(`^[a-z][a-z0-9./-]{0,62}$`)
+
+// SetClassLabels merges labels into the labels of class. A nil value deletes
+// the key, a non-nil empty map deletes all labels. Only the labels of the
+// class are changed.
+func (h *Handler) SetClassLabels(ctx context.Context, principal *models.Principal,
+	class string, labels map[string]*string,
+) error {
+	return h.setClassMetadata(ctx, principal, class, labels,
+		func(c *models.Class) *map[string]string { return &c.Labels })
+}
+
+// SetClassAnnotations merges annotations into the annotations of class with
+// the same semantics as SetClassLabels.
+func (h *Handler) SetClassAnnotations(ctx context.Context, principal *models.Principal,
+	class string, annotations map[string]*string,
+) error {
+	return h.setClassMetadata(ctx, principal, class, annotations,
+		func(c *models.Class) *map[string]string { return &c.Annotations })
+}
+
+func (h *Handler) setClassMetadata(ctx context.Context, principal *models.Principal,
+	className string, changes map[string]*string, field func(*models.Class) *map[string]string,
+) error {
+	err := h.Authorizer.Authorize(principal, authorization.UPDATE, authorization.CollectionsMetadata(className)...)
+	if err != nil {
+		return err
+	}
+	if changes == nil {
+		return nil
+	}
+
+	className = schema.UppercaseClassName(className)
+	initial := h.schemaReader.ReadOnlyClass(className)
+	if initial == nil {
+		return fmt.Errorf("class %q: %w", className, ErrNotFound)
+	}
+
+	updated := *initial
+	merged := map[string]string{}
+	if len(changes) > 0 {
+		for k, v := range *field(initial) {
+			merged[k] = v
+		}
+		for k, v := range changes {
+			if v == nil {
+				delete(merged, k)
+			} else {
+				merged[k] = *v
+			}
+		}
+	}
+	if len(merged) == 0 {
+		merged = nil
+	}
+	*field(&updated) = merged
+
+	if err := validateClassMetadata(&updated); err != nil {
+		return fmt.Errorf("%w: %w", clusterSchema.ErrBadRequest, err)
+	}
+	_, err = h.schemaManager.UpdateClass(withActor(ctx, principal), &updated, nil)
+	return err
+}
+
+// validateClassMetadata validates the labels and annotations of class
+func validateClassMetadata(class *models.Class) error {
+	if err := validateClassMetadataEntries("label", class.Labels); err != nil {
+		return err
+	}
+	return validateClassMetadataEntries("annotation", class.Annotations)
+}
+
+func validateClassMetadataEntries(kind string, entries map[string]string) error {
+	for k, v := range entries {
+		if !classMetadataKeyRegex.MatchString(k) {
+			return fmt.Errorf("invalid %s key %q: must match %s", kind, k, classMetadataKeyRegex)
+		}
+		if len(v) > maxClassMetadataValueLength {
+			return fmt.Errorf("value of %s %q is %d bytes long, at most %d bytes are allowed",
+				kind, k, len(v), maxClassMetadataValueLength)
+		}
+	}
+	return nil
+}
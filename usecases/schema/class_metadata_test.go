@@ -0,0 +1,121 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	clusterSchema "github.com/weaviate/weaviate/cluster/schema"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+func TestHandler_SetClassLabels(t *testing.T) {
+	ctx := context.Background()
+	value := func(s string) *string { return &s }
+	initial := &models.Class{
+		Class:       "C",
+		Labels:      map[string]string{"team": "search", "env": "staging"},
+		Annotations: map[string]string{"owner": "alice"},
+	}
+
+	tests := []struct {
+		name     string
+		changes  map[string]*string
+		expected map[string]string
+	}{
+		{
+			name:     "merge",
+			changes:  map[string]*string{"env": value("prod"), "cost.center/id": value("42")},
+			expected: map[string]string{"team": "search", "env": "prod", "cost.center/id": "42"},
+		},
+		{
+			name:     "nil value deletes key",
+			changes:  map[string]*string{"env": nil, "unknown": nil},
+			expected: map[string]string{"team": "search"},
+		},
+		{
+			name:     "empty map clears all",
+			changes:  map[string]*string{},
+			expected: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			handler, fakeSchemaManager := newTestHandler(t, &fakeDB{})
+			fakeSchemaManager.On("ReadOnlyClass", "C").Return(initial)
+			fakeSchemaManager.On("UpdateClass", mock.MatchedBy(func(c *models.Class) bool {
+				return assert.ObjectsAreEqual(tt.expected, c.Labels) &&
+					assert.ObjectsAreEqual(initial.Annotations, c.Annotations)
+			}), mock.Anything).Return(nil)
+
+			require.Nil(t, handler.SetClassLabels(ctx, nil, "C", tt.changes))
+			fakeSchemaManager.AssertExpectations(t)
+			// the class of the schema must not be modified in place
+			assert.Equal(t, map[string]string{"team": "search", "env": "staging"}, initial.Labels)
+		})
+	}
+
+	t.Run("nil map is a no-op", func(t *testing.T) {
+		handler, fakeSchemaManager := newTestHandler(t, &fakeDB{})
+		require.Nil(t, handler.SetClassAnnotations(ctx, nil, "C", nil))
+		fakeSchemaManager.AssertNotCalled(t, "UpdateClass", mock.Anything, mock.Anything)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		handler, fakeSchemaManager := newTestHandler(t, &fakeDB{})
+		fakeSchemaManager.On("ReadOnlyClass", "C").Return(initial)
+
+		err := handler.SetClassAnnotations(ctx, nil, "C", map[string]*string{"Owner": value("bob")})
+		assert.ErrorIs(t, err, clusterSchema.ErrBadRequest)
+		err = handler.SetClassLabels(ctx, nil, "C", map[string]*string{"team": value(strings.Repeat("a", 257))})
+		assert.ErrorIs(t, err, clusterSchema.ErrBadRequest)
+	})
+
+	t.Run("class not found", func(t *testing.T) {
+		handler, fakeSchemaManager := newTestHandler(t, &fakeDB{})
+		fakeSchemaManager.On("ReadOnlyClass", "Unknown").Return(nil)
+		err := handler.SetClassLabels(ctx, nil, "Unknown", map[string]*string{})
+		assert.ErrorIs(t, err, ErrNotFound)
+	})
+}
+
+func TestValidateClassMetadata(t *testing.T) {
+	tests := []struct {
+		key   string
+		value string
+		valid bool
+	}{
+		{key: "team", valid: true},
+		{key: "app.kubernetes.io/part-of", valid: true},
+		{key: "a" + strings.Repeat("b", 62), valid: true},
+		{key: "a" + strings.Repeat("b", 63), valid: false},
+		{key: "", valid: false},
+		{key: "1team", valid: false},
+		{key: "Team", valid: false},
+		{key: "team_name", valid: false},
+		{key: "team", value: strings.Repeat("ü", 128), valid: true},
+		{key: "team", value: strings.Repeat("ü", 128) + "a", valid: false},
+	}
+	for _, tt := range tests {
+		err := validateClassMetadata(&models.Class{Labels: map[string]string{tt.key: tt.value}})
+		if tt.valid {
+			assert.Nil(t, err, tt.key)
+		} else {
+			assert.NotNil(t, err, tt.key)
+		}
+	}
+}
@@ -0,0 +1,93 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"context"
+	"fmt"
+
+	clusterSchema "github.com/weaviate/weaviate/cluster/schema"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// ReserveClassObjects counts objects of class against its MaxObjects before
+// they are written, a negative count releases them again after deletes or
+// failed writes. The count is kept in the schema so that concurrent writes on
+// all nodes see the same count. Classes without a limit are not counted, the
+// count of a class is seeded from its shards when it gets a limit. The count
+// is dropped with the class and released when tenants are deleted.
+func (h *Handler) ReserveClassObjects(ctx context.Context, class string, count int64) error {
+	c := h.schemaReader.ReadOnlyClass(class)
+	if c == nil {
+		return fmt.Errorf("class %q: %w", class, ErrNotFound)
+	}
+	if c.MaxObjects <= 0 || count == 0 {
+		return nil
+	}
+	_, err := h.schemaManager.ReserveClassObjects(ctx, class, count)
+	return err
+}
+
+// seedClassObjectCount sets the object count of class to the objects it
+// already has. It is called when a limit is set on a class which wasn't
+// counted before.
+func (h *Handler) seedClassObjectCount(ctx context.Context, class string) error {
+	counts, err := h.dataMigrator.ReplicaObjectCounts(ctx, class)
+	if err != nil {
+		return fmt.Errorf("count objects of class %q: %w", class, err)
+	}
+	_, err = h.schemaManager.SetClassObjectCount(ctx, class, shardObjectCount(counts, nil))
+	return err
+}
+
+// releaseTenantObjects releases the objects of tenants of class after they
+// were deleted. counts are the object counts of the class before the delete.
+// Failures only leave the count too high, so they are logged.
+func (h *Handler) releaseTenantObjects(ctx context.Context, class string, tenants []string,
+	counts map[string]map[string]int64,
+) {
+	count := shardObjectCount(counts, tenants)
+	if count == 0 {
+		return
+	}
+	if _, err := h.schemaManager.ReserveClassObjects(ctx, class, -count); err != nil {
+		h.logger.WithField("action", "release_tenant_objects").WithField("class", class).
+			WithError(err).Warn("could not release objects of deleted tenants")
+	}
+}
+
+// shardObjectCount sums the object counts of shards, all of them if shards is
+// nil. Each shard counts with its largest replica.
+func shardObjectCount(counts map[string]map[string]int64, shards []string) int64 {
+	if shards == nil {
+		for shard := range counts {
+			shards = append(shards, shard)
+		}
+	}
+	total := int64(0)
+	for _, shard := range shards {
+		largest := int64(0)
+		for _, count := range counts[shard] {
+			largest = max(largest, count)
+		}
+		total += largest
+	}
+	return total
+}
+
+// validateMaxObjects checks that the object limit of class isn't negative
+func validateMaxObjects(class *models.Class) error {
+	if class.MaxObjects < 0 {
+		return fmt.Errorf("%w: maxObjects must not be negative, got %d", clusterSchema.ErrBadRequest, class.MaxObjects)
+	}
+	return nil
+}
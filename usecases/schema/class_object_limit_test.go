@@ -0,0 +1,59 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	clusterSchema "github.com/weaviate/weaviate/cluster/schema"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+func TestHandler_ReserveClassObjects(t *testing.T) {
+	ctx := context.Background()
+	handler, fakeSchemaManager := newTestHandler(t, &fakeDB{})
+	fakeSchemaManager.On("ReadOnlyClass", "Limited").Return(&models.Class{Class: "Limited", MaxObjects: 10})
+	fakeSchemaManager.On("ReadOnlyClass", "Unlimited").Return(&models.Class{Class: "Unlimited"})
+	fakeSchemaManager.On("ReadOnlyClass", "Unknown").Return(nil)
+	fakeSchemaManager.On("ReserveClassObjects", "Limited", int64(2)).Return(nil).Once()
+	fakeSchemaManager.On("ReserveClassObjects", "Limited", int64(-1)).Return(nil).Once()
+
+	require.Nil(t, handler.ReserveClassObjects(ctx, "Limited", 2))
+	require.Nil(t, handler.ReserveClassObjects(ctx, "Limited", -1))
+	require.Nil(t, handler.ReserveClassObjects(ctx, "Unlimited", 2))
+	assert.ErrorIs(t, handler.ReserveClassObjects(ctx, "Unknown", 2), ErrNotFound)
+	fakeSchemaManager.AssertExpectations(t)
+	fakeSchemaManager.AssertNumberOfCalls(t, "ReserveClassObjects", 2)
+}
+
+func TestHandler_SeedClassObjectCount(t *testing.T) {
+	ctx := context.Background()
+	handler, fakeSchemaManager := newTestHandler(t, &fakeDB{})
+	fakeSchemaManager.On("ReplicaObjectCounts", mock.Anything, "Limited").Return(map[string]map[string]int64{
+		"shard-1": {"node-1": 5, "node-2": 4},
+		"shard-2": {"node-1": 2},
+	}, nil)
+	fakeSchemaManager.On("SetClassObjectCount", "Limited", int64(7)).Return(nil).Once()
+
+	require.Nil(t, handler.seedClassObjectCount(ctx, "Limited"))
+	fakeSchemaManager.AssertExpectations(t)
+}
+
+func TestValidateMaxObjects(t *testing.T) {
+	assert.Nil(t, validateMaxObjects(&models.Class{}))
+	assert.Nil(t, validateMaxObjects(&models.Class{MaxObjects: 5}))
+	assert.ErrorIs(t, validateMaxObjects(&models.Class{MaxObjects: -1}), clusterSchema.ErrBadRequest)
+}
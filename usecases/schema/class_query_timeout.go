@@ -0,0 +1,39 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"fmt"
+	"time"
+
+	clusterSchema "github.com/weaviate/weaviate/cluster/schema"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+const (
+	// MinQueryTimeout and MaxQueryTimeout bound the queryTimeout of classes
+	MinQueryTimeout = time.Second
+	MaxQueryTimeout = time.Hour
+)
+
+// validateQueryTimeout checks that the query timeout of class, if set, is
+// between MinQueryTimeout and MaxQueryTimeout
+func validateQueryTimeout(class *models.Class) error {
+	if class.QueryTimeout == nil {
+		return nil
+	}
+	if timeout := *class.QueryTimeout; timeout < MinQueryTimeout || timeout > MaxQueryTimeout {
+		return fmt.Errorf("%w: queryTimeout must be between %s and %s, got %s", clusterSchema.ErrBadRequest,
+			MinQueryTimeout, MaxQueryTimeout, timeout)
+	}
+	return nil
+}
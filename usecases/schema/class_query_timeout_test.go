@@ -0,0 +1,50 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	clusterSchema "github.com/weaviate/weaviate/cluster/schema"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+func TestValidateQueryTimeout(t *testing.T) {
+	timeout := func(d time.Duration) *models.Class { return &models.Class{QueryTimeout: &d} }
+
+	assert.Nil(t, validateQueryTimeout(&models.Class{}))
+	for _, d := range []time.Duration{MinQueryTimeout, 30 * time.Second, MaxQueryTimeout} {
+		assert.Nil(t, validateQueryTimeout(timeout(d)), d)
+	}
+	for _, d := range []time.Duration{0, -time.Second, MinQueryTimeout - 1, MaxQueryTimeout + 1} {
+		assert.ErrorIs(t, validateQueryTimeout(timeout(d)), clusterSchema.ErrBadRequest, d)
+	}
+}
+
+func TestHandler_AddClass_QueryTimeout(t *testing.T) {
+	ctx := context.Background()
+	handler, fakeSchemaManager := newTestHandler(t, &fakeDB{})
+	fakeSchemaManager.On("AddClass", mock.Anything, mock.Anything).Return(nil)
+
+	tooShort := 500 * time.Millisecond
+	_, err := handler.AddClass(ctx, nil, &models.Class{Class: "Short", Vectorizer: "none", QueryTimeout: &tooShort})
+	assert.ErrorContains(t, err, "queryTimeout must be between 1s and 1h0m0s")
+
+	timeout := time.Minute
+	_, err = handler.AddClass(ctx, nil, &models.Class{Class: "Minute", Vectorizer: "none", QueryTimeout: &timeout})
+	require.Nil(t, err)
+}
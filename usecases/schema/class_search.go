@@ -0,0 +1,126 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	clusterSchema "github.com/weaviate/weaviate/cluster/schema"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
+)
+
+// ClassSearchQuery selects the classes returned by SearchClasses. A class
+// has to match all of the set fields.
+type ClassSearchQuery struct {
+	NamePrefix   string
+	NameContains string
+	// HasLabel matches classes with all of the given labels and values
+	HasLabel map[string]string
+	// HasModule matches classes with a module config for the module
+	HasModule string
+	// VectorizerEquals matches classes with the vectorizer, either the
+	// vectorizer of the class or one of its named vectors
+	VectorizerEquals string
+	// Limit is the maximum number of classes returned, 0 is unlimited
+	Limit int
+	// After only matches classes whose name sorts after it. Pass the name of
+	// the last class of the previous page to get the next one.
+	After string
+}
+
+// SearchClasses returns the classes matching query ordered by name
+func (h *Handler) SearchClasses(principal *models.Principal, query ClassSearchQuery) ([]*models.Class, error) {
+	err := h.Authorizer.Authorize(principal, authorization.READ, authorization.CollectionsMetadata()...)
+	if err != nil {
+		return nil, err
+	}
+	if query.Limit < 0 {
+		return nil, fmt.Errorf("limit must not be negative, got %d: %w", query.Limit, clusterSchema.ErrBadRequest)
+	}
+
+	classes := h.schemaReader.ReadOnlySchema().Classes
+	matching := []*models.Class{}
+	for _, class := range classes {
+		if query.matches(class) {
+			matching = append(matching, class)
+		}
+	}
+	sort.Slice(matching, func(i, j int) bool { return matching[i].Class < matching[j].Class })
+	if query.Limit > 0 && len(matching) > query.Limit {
+		matching = matching[:query.Limit]
+	}
+	for i, class := range matching {
+		matching[i] = h.redactEncryptionKey(principal, class)
+	}
+	return matching, nil
+}
+
+// ListClassesByModule returns the classes with a module config for
+// moduleName ordered by name, e.g. to find the classes depending on a module
+// before it is removed
+func (h *Handler) ListClassesByModule(principal *models.Principal, moduleName string) ([]*models.Class, error) {
+	if moduleName == "" {
+		return nil, fmt.Errorf("module name must not be empty: %w", clusterSchema.ErrBadRequest)
+	}
+	return h.SearchClasses(principal, ClassSearchQuery{HasModule: moduleName})
+}
+
+// ListClassesByVectorizer returns the classes using vectorizer ordered by
+// name, either for the class or for one of its named vectors
+func (h *Handler) ListClassesByVectorizer(principal *models.Principal, vectorizer string) ([]*models.Class, error) {
+	if vectorizer == "" {
+		return nil, fmt.Errorf("vectorizer must not be empty: %w", clusterSchema.ErrBadRequest)
+	}
+	return h.SearchClasses(principal, ClassSearchQuery{VectorizerEquals: vectorizer})
+}
+
+func (q ClassSearchQuery) matches(class *models.Class) bool {
+	if class.Class <= q.After ||
+		!strings.HasPrefix(class.Class, q.NamePrefix) ||
+		!strings.Contains(class.Class, q.NameContains) {
+		return false
+	}
+	for k, v := range q.HasLabel {
+		if value, ok := class.Labels[k]; !ok || value != v {
+			return false
+		}
+	}
+	if q.HasModule != "" {
+		cfg, _ := class.ModuleConfig.(map[string]interface{})
+		if cfg[q.HasModule] == nil {
+			return false
+		}
+	}
+	if q.VectorizerEquals != "" && !hasVectorizer(class, q.VectorizerEquals) {
+		return false
+	}
+	return true
+}
+
+// hasVectorizer checks if vectorizer is the vectorizer of class or of one of
+// its named vectors
+func hasVectorizer(class *models.Class, vectorizer string) bool {
+	if class.Vectorizer == vectorizer {
+		return true
+	}
+	for _, cfg := range class.VectorConfig {
+		if v, ok := cfg.Vectorizer.(map[string]interface{}); ok {
+			if _, ok := v[vectorizer]; ok {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,94 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	clusterSchema "github.com/weaviate/weaviate/cluster/schema"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+func TestHandler_SearchClasses(t *testing.T) {
+	classes := []*models.Class{
+		{Class: "Book", Vectorizer: "text2vec-openai", Labels: map[string]string{"team": "search", "env": "prod"}},
+		{Class: "Author", Vectorizer: "none", ModuleConfig: map[string]interface{}{"generative-openai": map[string]interface{}{}}},
+		{Class: "BookReview", VectorConfig: map[string]models.VectorConfig{
+			"review": {Vectorizer: map[string]interface{}{"text2vec-cohere": map[string]interface{}{}}},
+		}, Labels: map[string]string{"team": "search"}},
+		{Class: "Bookshelf", Vectorizer: "none"},
+		{Class: "Publisher", ModuleConfig: map[string]interface{}{"generative-openai": map[string]interface{}{}}},
+	}
+
+	tests := []struct {
+		name     string
+		query    ClassSearchQuery
+		expected []string
+	}{
+		{name: "all", query: ClassSearchQuery{}, expected: []string{"Author", "Book", "BookReview", "Bookshelf", "Publisher"}},
+		{name: "prefix", query: ClassSearchQuery{NamePrefix: "Book"}, expected: []string{"Book", "BookReview", "Bookshelf"}},
+		{name: "contains", query: ClassSearchQuery{NameContains: "o"}, expected: []string{"Author", "Book", "BookReview", "Bookshelf"}},
+		{name: "label", query: ClassSearchQuery{HasLabel: map[string]string{"team": "search"}}, expected: []string{"Book", "BookReview"}},
+		{name: "labels", query: ClassSearchQuery{HasLabel: map[string]string{"team": "search", "env": "prod"}}, expected: []string{"Book"}},
+		{name: "module", query: ClassSearchQuery{HasModule: "generative-openai"}, expected: []string{"Author", "Publisher"}},
+		{name: "vectorizer", query: ClassSearchQuery{VectorizerEquals: "none"}, expected: []string{"Author", "Bookshelf"}},
+		{name: "named vector", query: ClassSearchQuery{VectorizerEquals: "text2vec-cohere"}, expected: []string{"BookReview"}},
+		{name: "combined", query: ClassSearchQuery{NamePrefix: "Book", VectorizerEquals: "none"}, expected: []string{"Bookshelf"}},
+		{name: "limit", query: ClassSearchQuery{Limit: 2}, expected: []string{"Author", "Book"}},
+		{name: "next page", query: ClassSearchQuery{Limit: 2, After: "Book"}, expected: []string{"BookReview", "Bookshelf"}},
+		{name: "last page", query: ClassSearchQuery{Limit: 2, After: "Bookshelf"}, expected: []string{"Publisher"}},
+		{name: "no match", query: ClassSearchQuery{NamePrefix: "Company"}, expected: []string{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			handler, fakeSchemaManager := newTestHandler(t, &fakeDB{})
+			fakeSchemaManager.On("ReadOnlySchema").Return(models.Schema{Classes: classes})
+
+			found, err := handler.SearchClasses(nil, tt.query)
+			require.Nil(t, err)
+			names := make([]string, len(found))
+			for i, class := range found {
+				names[i] = class.Class
+			}
+			assert.Equal(t, tt.expected, names)
+		})
+	}
+
+	t.Run("list by module and vectorizer", func(t *testing.T) {
+		handler, fakeSchemaManager := newTestHandler(t, &fakeDB{})
+		fakeSchemaManager.On("ReadOnlySchema").Return(models.Schema{Classes: classes})
+
+		found, err := handler.ListClassesByModule(nil, "generative-openai")
+		require.Nil(t, err)
+		require.Len(t, found, 2)
+		assert.Equal(t, "Author", found[0].Class)
+		assert.Equal(t, "Publisher", found[1].Class)
+
+		found, err = handler.ListClassesByVectorizer(nil, "text2vec-openai")
+		require.Nil(t, err)
+		require.Len(t, found, 1)
+		assert.Equal(t, "Book", found[0].Class)
+
+		_, err = handler.ListClassesByModule(nil, "")
+		assert.ErrorIs(t, err, clusterSchema.ErrBadRequest)
+		_, err = handler.ListClassesByVectorizer(nil, "")
+		assert.ErrorIs(t, err, clusterSchema.ErrBadRequest)
+	})
+
+	t.Run("negative limit", func(t *testing.T) {
+		handler, _ := newTestHandler(t, &fakeDB{})
+		_, err := handler.SearchClasses(nil, ClassSearchQuery{Limit: -1})
+		assert.ErrorIs(t, err, clusterSchema.ErrBadRequest)
+	})
+}
@@ -0,0 +1,106 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
+	"github.com/weaviate/weaviate/usecases/config"
+)
+
+// DeletedClassEntry is a class deleted by DeleteClass with SoftDelete. Its
+// data is kept until RetainUntil.
+type DeletedClassEntry struct {
+	Class       *models.Class
+	DeletedAt   time.Time
+	RetainUntil time.Time
+}
+
+// RestoreDeletedClass moves the soft deleted class back into the schema
+// together with its data. It fails if a class of the same name was added in
+// the meantime. Classes restored from backups use RestoreClass instead.
+func (h *Handler) RestoreDeletedClass(ctx context.Context, principal *models.Principal, class string) error {
+	class = schema.UppercaseClassName(class)
+	err := h.Authorizer.Authorize(principal, authorization.CREATE, authorization.CollectionsMetadata(class)...)
+	if err != nil {
+		return err
+	}
+
+	if !h.isDeletedClass(class) {
+		return fmt.Errorf("deleted class %q: %w", class, ErrNotFound)
+	}
+	if _, err := h.schemaManager.RestoreDeletedClass(withActor(ctx, principal), class); err != nil {
+		return fmt.Errorf("restore deleted class %q: %w", class, err)
+	}
+	if restored := h.schemaReader.ReadOnlyClass(class); restored != nil {
+		h.notify(ctx, func(l EventListener) { l.OnClassAdded(restored) })
+	}
+	return nil
+}
+
+// ListDeletedClasses returns the soft deleted classes ordered by name
+func (h *Handler) ListDeletedClasses(principal *models.Principal) ([]*DeletedClassEntry, error) {
+	err := h.Authorizer.Authorize(principal, authorization.READ, authorization.CollectionsMetadata()...)
+	if err != nil {
+		return nil, err
+	}
+
+	deleted := h.schemaReader.ReadOnlyDeletedClasses()
+	entries := make([]*DeletedClassEntry, len(deleted))
+	for i, d := range deleted {
+		entries[i] = &DeletedClassEntry{Class: d.Class, DeletedAt: d.DeletedAt, RetainUntil: d.RetainUntil}
+	}
+	return entries, nil
+}
+
+func (h *Handler) isDeletedClass(class string) bool {
+	for _, d := range h.schemaReader.ReadOnlyDeletedClasses() {
+		if d.Class.Class == class {
+			return true
+		}
+	}
+	return false
+}
+
+// softDeleteRetainUntil is when a class soft deleted at now is purged
+func (h *Handler) softDeleteRetainUntil(now time.Time) time.Time {
+	days := h.config.Schema.SoftDeleteRetentionDays
+	if days <= 0 {
+		days = config.DefaultSoftDeleteRetentionDays
+	}
+	return now.AddDate(0, 0, days)
+}
+
+// purgeDeletedClasses removes the soft deleted classes and their data once
+// they are retained long enough, see StartExpiryWorker
+func (h *Handler) purgeDeletedClasses(ctx context.Context, now time.Time) {
+	if _, leader := h.schemaManager.LeaderWithID(); leader != h.clusterState.LocalName() {
+		return
+	}
+
+	for _, d := range h.schemaReader.ReadOnlyDeletedClasses() {
+		if d.RetainUntil.After(now) {
+			continue
+		}
+		logger := h.logEntry(ctx, d.Class.Class, "").WithField("action", "purge_deleted_class").
+			WithField("deleted_at", d.DeletedAt)
+		logger.Info("purging soft deleted class")
+		if _, err := h.schemaManager.PurgeDeletedClass(ctx, d.Class.Class); err != nil {
+			logger.WithError(err).Error("purging soft deleted class failed")
+		}
+	}
+}
@@ -0,0 +1,93 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	clusterSchema "github.com/weaviate/weaviate/cluster/schema"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+func TestHandler_SoftDeleteClass(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+	deleted := []clusterSchema.DeletedClass{
+		{Class: &models.Class{Class: "Expired"}, DeletedAt: now.Add(-time.Hour), RetainUntil: now.Add(-time.Second)},
+		{Class: &models.Class{Class: "Failing"}, DeletedAt: now.Add(-time.Hour), RetainUntil: now.Add(-time.Minute)},
+		{Class: &models.Class{Class: "Retained"}, DeletedAt: now, RetainUntil: now.Add(time.Hour)},
+	}
+
+	t.Run("delete class keeps the class", func(t *testing.T) {
+		handler, fakeSchemaManager := newTestHandler(t, &fakeDB{})
+		handler.SoftDelete = true
+		handler.config.Schema.SoftDeleteRetentionDays = 2
+		fakeSchemaManager.On("SoftDeleteClass", "C1", mock.MatchedBy(func(retainUntil time.Time) bool {
+			return retainUntil.After(now.AddDate(0, 0, 2).Add(-time.Minute)) &&
+				retainUntil.Before(now.AddDate(0, 0, 2).Add(time.Minute))
+		})).Return(nil)
+
+		_, err := handler.DeleteClass(ctx, nil, "c1")
+		require.Nil(t, err)
+		fakeSchemaManager.AssertExpectations(t)
+		fakeSchemaManager.AssertNotCalled(t, "DeleteClass", mock.Anything)
+	})
+
+	t.Run("restore", func(t *testing.T) {
+		handler, fakeSchemaManager := newTestHandler(t, &fakeDB{})
+		fakeSchemaManager.On("ReadOnlyDeletedClasses").Return(deleted)
+		fakeSchemaManager.On("RestoreDeletedClass", "Retained").Return(nil)
+		fakeSchemaManager.On("ReadOnlyClass", "Retained").Return(&models.Class{Class: "Retained"})
+
+		require.Nil(t, handler.RestoreDeletedClass(ctx, nil, "retained"))
+		assert.ErrorIs(t, handler.RestoreDeletedClass(ctx, nil, "Unknown"), ErrNotFound)
+		fakeSchemaManager.AssertNumberOfCalls(t, "RestoreDeletedClass", 1)
+	})
+
+	t.Run("list", func(t *testing.T) {
+		handler, fakeSchemaManager := newTestHandler(t, &fakeDB{})
+		fakeSchemaManager.On("ReadOnlyDeletedClasses").Return(deleted)
+
+		entries, err := handler.ListDeletedClasses(nil)
+		require.Nil(t, err)
+		require.Len(t, entries, 3)
+		assert.Equal(t, "Retained", entries[2].Class.Class)
+		assert.Equal(t, now.Add(time.Hour), entries[2].RetainUntil)
+	})
+
+	t.Run("leader purges classes retained long enough", func(t *testing.T) {
+		handler, fakeSchemaManager := newTestHandler(t, &fakeDB{})
+		fakeSchemaManager.On("LeaderWithID").Return("addr", handler.clusterState.LocalName())
+		fakeSchemaManager.On("ReadOnlyDeletedClasses").Return(deleted)
+		fakeSchemaManager.On("PurgeDeletedClass", "Failing").Return(errors.New("leader changed")).Once()
+		fakeSchemaManager.On("PurgeDeletedClass", "Expired").Return(nil).Once()
+
+		handler.purgeDeletedClasses(ctx, now)
+		fakeSchemaManager.AssertExpectations(t)
+		fakeSchemaManager.AssertNumberOfCalls(t, "PurgeDeletedClass", 2)
+	})
+
+	t.Run("followers do not purge classes", func(t *testing.T) {
+		handler, fakeSchemaManager := newTestHandler(t, &fakeDB{})
+		fakeSchemaManager.On("LeaderWithID").Return("addr", "other-node")
+
+		handler.purgeDeletedClasses(ctx, now)
+		fakeSchemaManager.AssertNotCalled(t, "ReadOnlyDeletedClasses")
+		fakeSchemaManager.AssertNotCalled(t, "PurgeDeletedClass", mock.Anything)
+	})
+}
@@ -14,6 +14,7 @@ package schema
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"strings"
 	"testing"
@@ -23,26 +24,80 @@ import (
 	"github.com/stretchr/testify/require"
 	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
 	"github.com/weaviate/weaviate/adapters/repos/db/inverted/stopwords"
+	clusterSchema "github.com/weaviate/weaviate/cluster/schema"
 	"github.com/weaviate/weaviate/entities/backup"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/replication"
 	"github.com/weaviate/weaviate/entities/schema"
+	schemaConfig "github.com/weaviate/weaviate/entities/schema/config"
 	"github.com/weaviate/weaviate/entities/vectorindex/hnsw"
 	"github.com/weaviate/weaviate/usecases/cluster/mocks"
 	"github.com/weaviate/weaviate/usecases/config"
+	"github.com/weaviate/weaviate/usecases/replica"
 	"github.com/weaviate/weaviate/usecases/sharding"
 	shardingConfig "github.com/weaviate/weaviate/usecases/sharding/config"
 )
 
 func Test_GetSchema(t *testing.T) {
 	t.Parallel()
-	handler, fakeSchemaManager := newTestHandler(t, &fakeDB{})
-	fakeSchemaManager.On("ReadOnlySchema").Return(models.Schema{})
 
-	sch, err := handler.GetSchema(nil)
-	assert.Nil(t, err)
-	assert.NotNil(t, sch)
-	fakeSchemaManager.AssertExpectations(t)
+	t.Run("local read", func(t *testing.T) {
+		for _, consistency := range []replica.ConsistencyLevel{"", replica.One} {
+			handler, fakeSchemaManager := newTestHandler(t, &fakeDB{})
+			fakeSchemaManager.On("ReadOnlySchema").Return(models.Schema{})
+
+			sch, err := handler.GetSchema(nil, consistency)
+			assert.Nil(t, err)
+			assert.NotNil(t, sch)
+			fakeSchemaManager.AssertExpectations(t)
+			fakeSchemaManager.AssertNotCalled(t, "LinearizableRead", mock.Anything)
+		}
+	})
+
+	t.Run("linearizable read", func(t *testing.T) {
+		for _, consistency := range []replica.ConsistencyLevel{replica.Quorum, replica.All} {
+			handler, fakeSchemaManager := newTestHandler(t, &fakeDB{})
+			fakeSchemaManager.On("LinearizableRead", mock.Anything).Return(nil).Once()
+			fakeSchemaManager.On("ReadOnlySchema").Return(models.Schema{})
+
+			_, err := handler.GetSchema(nil, consistency)
+			assert.Nil(t, err)
+			fakeSchemaManager.AssertExpectations(t)
+		}
+	})
+
+	t.Run("leader unreachable", func(t *testing.T) {
+		handler, fakeSchemaManager := newTestHandler(t, &fakeDB{})
+		errLeader := errors.New("leader unreachable")
+		fakeSchemaManager.On("LinearizableRead", mock.Anything).Return(errLeader)
+
+		_, err := handler.GetSchema(nil, replica.Quorum)
+		assert.ErrorIs(t, err, errLeader)
+	})
+
+	t.Run("unknown consistency", func(t *testing.T) {
+		handler, _ := newTestHandler(t, &fakeDB{})
+		_, err := handler.GetSchema(nil, "TWO")
+		assert.ErrorIs(t, err, clusterSchema.ErrBadRequest)
+	})
+
+	t.Run("overridden default consistency", func(t *testing.T) {
+		handler, fakeSchemaManager := newTestHandler(t, &fakeDB{})
+		assert.Equal(t, replica.One, handler.DefaultConsistencyLevel())
+		require.Nil(t, handler.SetDefaultConsistencyLevel(replica.Quorum))
+		assert.ErrorIs(t, handler.SetDefaultConsistencyLevel("TWO"), clusterSchema.ErrBadRequest)
+		assert.Equal(t, replica.Quorum, handler.DefaultConsistencyLevel())
+
+		fakeSchemaManager.On("LinearizableRead", mock.Anything).Return(nil).Once()
+		fakeSchemaManager.On("ReadOnlySchema").Return(models.Schema{})
+		_, err := handler.GetSchema(nil, "")
+		assert.Nil(t, err)
+		fakeSchemaManager.AssertExpectations(t)
+
+		copied := *handler
+		require.Nil(t, copied.SetDefaultConsistencyLevel(replica.All))
+		assert.Equal(t, replica.All, handler.DefaultConsistencyLevel(), "override must apply to copies of the handler")
+	})
 }
 
 func Test_AddClass(t *testing.T) {
@@ -62,7 +117,7 @@ func Test_AddClass(t *testing.T) {
 		}
 		fakeSchemaManager.On("AddClass", mock.Anything, mock.Anything).Return(nil)
 
-		_, _, err := handler.AddClass(ctx, nil, &class)
+		_, err := handler.AddClass(ctx, nil, &class)
 		assert.Nil(t, err)
 
 		fakeSchemaManager.AssertExpectations(t)
@@ -71,30 +126,30 @@ func Test_AddClass(t *testing.T) {
 	t.Run("with empty class name", func(t *testing.T) {
 		handler, _ := newTestHandler(t, &fakeDB{})
 		class := models.Class{}
-		_, _, err := handler.AddClass(ctx, nil, &class)
+		_, err := handler.AddClass(ctx, nil, &class)
 		assert.EqualError(t, err, "'' is not a valid class name")
 	})
 
 	t.Run("with reserved class name", func(t *testing.T) {
 		handler, _ := newTestHandler(t, &fakeDB{})
 		class := models.Class{Class: config.DefaultRaftDir}
-		_, _, err := handler.AddClass(ctx, nil, &class)
+		_, err := handler.AddClass(ctx, nil, &class)
 		assert.EqualError(t, err, fmt.Sprintf("parse class name: class name `%s` is reserved", config.DefaultRaftDir))
 
 		class = models.Class{Class: "rAFT"}
-		_, _, err = handler.AddClass(ctx, nil, &class)
+		_, err = handler.AddClass(ctx, nil, &class)
 		assert.EqualError(t, err, fmt.Sprintf("parse class name: class name `%s` is reserved", config.DefaultRaftDir))
 
 		class = models.Class{Class: "rAfT"}
-		_, _, err = handler.AddClass(ctx, nil, &class)
+		_, err = handler.AddClass(ctx, nil, &class)
 		assert.EqualError(t, err, fmt.Sprintf("parse class name: class name `%s` is reserved", config.DefaultRaftDir))
 
 		class = models.Class{Class: "RaFT"}
-		_, _, err = handler.AddClass(ctx, nil, &class)
+		_, err = handler.AddClass(ctx, nil, &class)
 		assert.EqualError(t, err, fmt.Sprintf("parse class name: class name `%s` is reserved", config.DefaultRaftDir))
 
 		class = models.Class{Class: "RAFT"}
-		_, _, err = handler.AddClass(ctx, nil, &class)
+		_, err = handler.AddClass(ctx, nil, &class)
 		assert.EqualError(t, err, fmt.Sprintf("parse class name: class name `%s` is reserved", config.DefaultRaftDir))
 	})
 
@@ -120,7 +175,7 @@ func Test_AddClass(t *testing.T) {
 		}
 		fakeSchemaManager.On("AddClass", expectedClass, mock.Anything).Return(nil)
 
-		_, _, err := handler.AddClass(ctx, nil, &class)
+		_, err := handler.AddClass(ctx, nil, &class)
 		require.Nil(t, err)
 		fakeSchemaManager.AssertExpectations(t)
 	})
@@ -151,7 +206,7 @@ func Test_AddClass(t *testing.T) {
 			Stopwords:              expectedStopwordConfig,
 		}
 		fakeSchemaManager.On("AddClass", expectedClass, mock.Anything).Return(nil)
-		_, _, err := handler.AddClass(ctx, nil, &class)
+		_, err := handler.AddClass(ctx, nil, &class)
 		require.Nil(t, err)
 		fakeSchemaManager.AssertExpectations(t)
 	})
@@ -212,7 +267,7 @@ func Test_AddClass(t *testing.T) {
 						fakeSchemaManager.On("AddClass", mock.Anything, mock.Anything).Return(nil)
 					}
 
-					_, _, err := handler.AddClass(context.Background(), nil, class)
+					_, err := handler.AddClass(context.Background(), nil, class)
 					if tc.expectedErrMsg == "" {
 						require.Nil(t, err)
 					} else {
@@ -345,7 +400,7 @@ func Test_AddClass(t *testing.T) {
 		handler, _ := newTestHandler(t, &fakeDB{})
 
 		// Vectorizer while VectorConfig exists
-		_, _, err := handler.AddClass(ctx, nil, &models.Class{
+		_, err := handler.AddClass(ctx, nil, &models.Class{
 			Class:      "NewClass",
 			Vectorizer: "some",
 			VectorConfig: map[string]models.VectorConfig{"custom": {
@@ -357,7 +412,7 @@ func Test_AddClass(t *testing.T) {
 		assert.EqualError(t, err, "class.vectorizer \"some\" can not be set if class.vectorConfig is configured")
 
 		// VectorIndexType while VectorConfig exists
-		_, _, err = handler.AddClass(ctx, nil, &models.Class{
+		_, err = handler.AddClass(ctx, nil, &models.Class{
 			Class:           "NewClass",
 			VectorIndexType: "some",
 			VectorConfig: map[string]models.VectorConfig{"custom": {
@@ -369,7 +424,7 @@ func Test_AddClass(t *testing.T) {
 		assert.EqualError(t, err, "class.vectorIndexType \"some\" can not be set if class.vectorConfig is configured")
 
 		// VectorConfig is invalid VectorIndexType
-		_, _, err = handler.AddClass(ctx, nil, &models.Class{
+		_, err = handler.AddClass(ctx, nil, &models.Class{
 			Class: "NewClass",
 			VectorConfig: map[string]models.VectorConfig{"custom": {
 				VectorIndexType:   "invalid",
@@ -380,7 +435,7 @@ func Test_AddClass(t *testing.T) {
 		assert.EqualError(t, err, "target vector \"custom\": unrecognized or unsupported vectorIndexType \"invalid\"")
 
 		// VectorConfig is invalid Vectorizer
-		_, _, err = handler.AddClass(ctx, nil, &models.Class{
+		_, err = handler.AddClass(ctx, nil, &models.Class{
 			Class: "NewClass",
 			VectorConfig: map[string]models.VectorConfig{"custom": {
 				VectorIndexType:   "flat",
@@ -390,6 +445,35 @@ func Test_AddClass(t *testing.T) {
 		})
 		assert.EqualError(t, err, "target vector \"custom\": vectorizer: invalid vectorizer \"invalid\"")
 	})
+
+	t.Run("with several validation failures", func(t *testing.T) {
+		handler, _ := newTestHandler(t, &fakeDB{})
+
+		_, err := handler.AddClass(ctx, nil, &models.Class{
+			Class:           "NewClass",
+			Vectorizer:      "none",
+			VectorIndexType: "invalid",
+			Properties: []*models.Property{
+				{Name: "typeProp", DataType: []string{"invalid"}},
+				{Name: "tokenizationProp", DataType: []string{"text"}, Tokenization: "invalid"},
+			},
+		})
+		var verr *ValidationError
+		require.ErrorAs(t, err, &verr)
+
+		fields := make([]string, len(verr.Violations))
+		for i, v := range verr.Violations {
+			fields[i] = v.Field
+		}
+		assert.Equal(t, []string{
+			"properties.typeProp.dataType",
+			"properties.tokenizationProp.tokenization",
+			"vectorIndexType",
+		}, fields)
+		// the message is the one of the first failure, as before all
+		// failures were collected
+		assert.EqualError(t, err, verr.Violations[0].Err.Error())
+	})
 }
 
 func Test_AddClass_DefaultsAndMigration(t *testing.T) {
@@ -486,7 +570,7 @@ func Test_AddClass_DefaultsAndMigration(t *testing.T) {
 			fakeSchemaManager.On("AddClass", mock.Anything, mock.Anything).Return(nil)
 			fakeSchemaManager.On("ReadOnlyClass", mock.Anything, mock.Anything).Return(nil)
 
-			_, _, err := handler.AddClass(ctx, nil, &class)
+			_, err := handler.AddClass(ctx, nil, &class)
 			require.Nil(t, err)
 		})
 
@@ -651,7 +735,7 @@ func Test_AddClass_DefaultsAndMigration(t *testing.T) {
 		t.Run("create class with all properties", func(t *testing.T) {
 			handler, fakeSchemaManager := newTestHandler(t, &fakeDB{})
 			fakeSchemaManager.On("AddClass", mock.Anything, mock.Anything).Return(nil)
-			_, _, err := handler.AddClass(ctx, nil, &class)
+			_, err := handler.AddClass(ctx, nil, &class)
 			require.Nil(t, err)
 			fakeSchemaManager.AssertExpectations(t)
 		})
@@ -816,7 +900,7 @@ func Test_Validation_ClassNames(t *testing.T) {
 					if test.valid {
 						fakeSchemaManager.On("AddClass", class, mock.Anything).Return(nil)
 					}
-					_, _, err := handler.AddClass(context.Background(), nil, class)
+					_, err := handler.AddClass(context.Background(), nil, class)
 					t.Log(err)
 					assert.Equal(t, test.valid, err == nil)
 					fakeSchemaManager.AssertExpectations(t)
@@ -836,7 +920,7 @@ func Test_Validation_ClassNames(t *testing.T) {
 					if test.valid {
 						fakeSchemaManager.On("AddClass", class, mock.Anything).Return(nil)
 					}
-					_, _, err := handler.AddClass(context.Background(), nil, class)
+					_, err := handler.AddClass(context.Background(), nil, class)
 					t.Log(err)
 					assert.Equal(t, test.valid, err == nil)
 					fakeSchemaManager.AssertExpectations(t)
@@ -932,7 +1016,7 @@ func Test_Validation_PropertyNames(t *testing.T) {
 					if test.valid {
 						fakeSchemaManager.On("AddClass", class, mock.Anything).Return(nil)
 					}
-					_, _, err := handler.AddClass(context.Background(), nil, class)
+					_, err := handler.AddClass(context.Background(), nil, class)
 					t.Log(err)
 					assert.Equal(t, test.valid, err == nil)
 					fakeSchemaManager.AssertExpectations(t)
@@ -956,7 +1040,7 @@ func Test_Validation_PropertyNames(t *testing.T) {
 					if test.valid {
 						fakeSchemaManager.On("AddClass", class, mock.Anything).Return(nil)
 					}
-					_, _, err := handler.AddClass(context.Background(), nil, class)
+					_, err := handler.AddClass(context.Background(), nil, class)
 					t.Log(err)
 					assert.Equal(t, test.valid, err == nil)
 					fakeSchemaManager.AssertExpectations(t)
@@ -982,7 +1066,7 @@ func Test_Validation_PropertyNames(t *testing.T) {
 					}
 
 					fakeSchemaManager.On("AddClass", class, mock.Anything).Return(nil)
-					_, _, err := handler.AddClass(context.Background(), nil, class)
+					_, err := handler.AddClass(context.Background(), nil, class)
 					require.Nil(t, err)
 
 					property := &models.Property{
@@ -1016,7 +1100,7 @@ func Test_Validation_PropertyNames(t *testing.T) {
 					if test.valid {
 						fakeSchemaManager.On("AddClass", class, mock.Anything).Return(nil)
 					}
-					_, _, err := handler.AddClass(ctx, nil, class)
+					_, err := handler.AddClass(ctx, nil, class)
 					t.Log(err)
 					assert.Equal(t, test.valid, err == nil)
 					fakeSchemaManager.AssertExpectations(t)
@@ -1344,7 +1428,7 @@ func Test_UpdateClass(t *testing.T) {
 				if len(test.initial.Properties) > 0 {
 					fakeSchemaManager.On("ReadOnlyClass", test.initial.Class, mock.Anything).Return(test.initial)
 				}
-				_, _, err := handler.AddClass(ctx, nil, test.initial)
+				_, err := handler.AddClass(ctx, nil, test.initial)
 				assert.Nil(t, err)
 				store.AddClass(test.initial)
 
@@ -1363,6 +1447,29 @@ func Test_UpdateClass(t *testing.T) {
 			})
 		}
 	})
+
+	t.Run("with several validation failures", func(t *testing.T) {
+		handler, fakeSchemaManager := newTestHandler(t, &fakeDB{})
+		class := &models.Class{Class: "Existing", Vectorizer: "none"}
+		fakeSchemaManager.On("ReadOnlyClass", "Existing", mock.Anything).Return(class)
+
+		err := handler.UpdateClass(context.Background(), nil, "Existing", &models.Class{
+			Class:      "Existing",
+			Vectorizer: "none",
+			Labels:     map[string]string{"invalid key": "value"},
+			MaxObjects: -1,
+		})
+		var verr *ValidationError
+		require.ErrorAs(t, err, &verr)
+
+		fields := make([]string, len(verr.Violations))
+		for i, v := range verr.Violations {
+			fields[i] = v.Field
+		}
+		assert.Equal(t, []string{"labels", "maxObjects"}, fields)
+		assert.EqualError(t, err, verr.Violations[0].Err.Error())
+		fakeSchemaManager.AssertNotCalled(t, "UpdateClass", mock.Anything, mock.Anything)
+	})
 }
 
 func TestRestoreClass_WithCircularRefs(t *testing.T) {
@@ -1372,6 +1479,7 @@ func TestRestoreClass_WithCircularRefs(t *testing.T) {
 	// when restoring, we need to relax this validation.
 
 	t.Parallel()
+	vFalse := false
 	handler, fakeSchemaManager := newTestHandler(t, &fakeDB{})
 
 	classes := []*models.Class{
@@ -1407,6 +1515,8 @@ func TestRestoreClass_WithCircularRefs(t *testing.T) {
 			}, {
 				Name:     "to_Class_B",
 				DataType: []string{"Class_B"},
+				// backups made before references had to be filterable
+				IndexFilterable: &vFalse,
 			}},
 			Vectorizer: "none",
 		},
@@ -1527,7 +1637,7 @@ func Test_DeleteClass(t *testing.T) {
 			fakeSchemaManager.On("DeleteClass", canonical).Return(nil)
 
 			// but layer above like handler's `DeleteClass` should work independent of case sensitivity.
-			err := handler.DeleteClass(ctx, nil, test.classToDelete)
+			_, err := handler.DeleteClass(ctx, nil, test.classToDelete)
 			if test.expErr {
 				require.NotNil(t, err)
 				assert.Contains(t, err.Error(), test.expErrMsg)
@@ -1539,6 +1649,128 @@ func Test_DeleteClass(t *testing.T) {
 	}
 }
 
+func Test_SetCollectionReadOnly(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	vTrue := true
+
+	t.Run("class does not exist", func(t *testing.T) {
+		handler, fakeSchemaManager := newTestHandler(t, &fakeDB{})
+		fakeSchemaManager.On("ReadOnlyClass", "C1").Return(nil)
+
+		err := handler.SetCollectionReadOnly(ctx, nil, "c1", true)
+		require.ErrorIs(t, err, ErrNotFound)
+		fakeSchemaManager.AssertNotCalled(t, "UpdateClass", mock.Anything, mock.Anything)
+	})
+
+	t.Run("enable read-only mode", func(t *testing.T) {
+		handler, fakeSchemaManager := newTestHandler(t, &fakeDB{})
+		fakeSchemaManager.On("ReadOnlyClass", "C1").Return(&models.Class{Class: "C1"})
+		fakeSchemaManager.On("UpdateClass", &models.Class{Class: "C1", ReadOnly: &vTrue}, (*sharding.State)(nil)).Return(nil)
+
+		require.Nil(t, handler.SetCollectionReadOnly(ctx, nil, "c1", true))
+		fakeSchemaManager.AssertExpectations(t)
+	})
+
+	t.Run("unchanged mode is a no-op", func(t *testing.T) {
+		handler, fakeSchemaManager := newTestHandler(t, &fakeDB{})
+		fakeSchemaManager.On("ReadOnlyClass", "C1").Return(&models.Class{Class: "C1", ReadOnly: &vTrue})
+
+		require.Nil(t, handler.SetCollectionReadOnly(ctx, nil, "C1", true))
+		fakeSchemaManager.AssertNotCalled(t, "UpdateClass", mock.Anything, mock.Anything)
+	})
+
+	t.Run("class updates", func(t *testing.T) {
+		vFalse := false
+		for name, tt := range map[string]struct {
+			readOnly *bool
+			expected bool
+		}{
+			"without the mode keep it": {readOnly: nil, expected: true},
+			"with the mode change it":  {readOnly: &vFalse, expected: false},
+		} {
+			t.Run(name, func(t *testing.T) {
+				handler, fakeSchemaManager := newTestHandler(t, &fakeDB{})
+				class := func(readOnly *bool) *models.Class {
+					return &models.Class{Class: "C1", ReplicationConfig: &models.ReplicationConfig{Factor: 1}, ReadOnly: readOnly}
+				}
+				fakeSchemaManager.On("ReadOnlyClass", "C1").Return(class(&vTrue))
+				fakeSchemaManager.On("UpdateClass", mock.MatchedBy(func(c *models.Class) bool {
+					return c.ReadOnly != nil && *c.ReadOnly == tt.expected
+				}), mock.Anything).Return(nil)
+
+				require.Nil(t, handler.UpdateClass(ctx, nil, "C1", class(tt.readOnly)))
+				fakeSchemaManager.AssertExpectations(t)
+			})
+		}
+	})
+}
+
+func Test_UpdateVectorIndexConfig(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	current := hnsw.NewDefaultUserConfig()
+	class := &models.Class{
+		Class: "C1",
+		VectorConfig: map[string]models.VectorConfig{
+			"title":   {VectorIndexType: "hnsw", VectorIndexConfig: current},
+			"content": {VectorIndexType: "hnsw", VectorIndexConfig: current},
+		},
+	}
+	updated := hnsw.NewDefaultUserConfig()
+	updated.EF = 256
+
+	t.Run("writes only changed configs", func(t *testing.T) {
+		handler, fakeSchemaManager := newTestHandler(t, &fakeDB{})
+		fakeSchemaManager.On("ReadOnlyClass", "C1").Return(class)
+		fakeSchemaManager.On("UpdateVectorIndexConfig", "C1",
+			map[string]schemaConfig.VectorIndexConfig{"title": updated}).Return(nil)
+
+		err := handler.UpdateVectorIndexConfig(ctx, nil, "c1", map[string]schemaConfig.VectorIndexConfig{
+			"title": updated, "content": current,
+		})
+		require.Nil(t, err)
+		fakeSchemaManager.AssertExpectations(t)
+	})
+
+	t.Run("legacy vector index", func(t *testing.T) {
+		handler, fakeSchemaManager := newTestHandler(t, &fakeDB{})
+		fakeSchemaManager.On("ReadOnlyClass", "L1").Return(&models.Class{
+			Class: "L1", VectorIndexType: "hnsw", VectorIndexConfig: current,
+		})
+		fakeSchemaManager.On("UpdateVectorIndexConfig", "L1",
+			map[string]schemaConfig.VectorIndexConfig{"": updated}).Return(nil)
+
+		err := handler.UpdateVectorIndexConfig(ctx, nil, "L1", map[string]schemaConfig.VectorIndexConfig{"": updated})
+		require.Nil(t, err)
+		fakeSchemaManager.AssertExpectations(t)
+	})
+
+	t.Run("unchanged configs are a no-op", func(t *testing.T) {
+		handler, fakeSchemaManager := newTestHandler(t, &fakeDB{})
+		fakeSchemaManager.On("ReadOnlyClass", "C1").Return(class)
+
+		err := handler.UpdateVectorIndexConfig(ctx, nil, "C1", map[string]schemaConfig.VectorIndexConfig{"title": current})
+		require.Nil(t, err)
+		fakeSchemaManager.AssertNotCalled(t, "UpdateVectorIndexConfig", mock.Anything, mock.Anything)
+	})
+
+	t.Run("invalid requests", func(t *testing.T) {
+		handler, fakeSchemaManager := newTestHandler(t, &fakeDB{})
+		fakeSchemaManager.On("ReadOnlyClass", "C1").Return(class)
+		fakeSchemaManager.On("ReadOnlyClass", "C2").Return(nil)
+
+		err := handler.UpdateVectorIndexConfig(ctx, nil, "C2", map[string]schemaConfig.VectorIndexConfig{"title": updated})
+		assert.ErrorIs(t, err, ErrNotFound)
+		err = handler.UpdateVectorIndexConfig(ctx, nil, "C1", map[string]schemaConfig.VectorIndexConfig{"summary": updated})
+		assert.ErrorIs(t, err, clusterSchema.ErrBadRequest)
+		err = handler.UpdateVectorIndexConfig(ctx, nil, "C1", nil)
+		assert.ErrorIs(t, err, clusterSchema.ErrBadRequest)
+		fakeSchemaManager.AssertNotCalled(t, "UpdateVectorIndexConfig", mock.Anything, mock.Anything)
+	})
+}
+
 func Test_GetConsistentClass(t *testing.T) {
 	t.Parallel()
 	ctx := context.Background()
@@ -1630,10 +1862,10 @@ func Test_AddClass_MultiTenancy(t *testing.T) {
 		}
 
 		fakeSchemaManager.On("AddClass", mock.Anything, mock.Anything).Return(nil)
-		c, _, err := handler.AddClass(ctx, nil, &class)
+		res, err := handler.AddClass(ctx, nil, &class)
 		require.Nil(t, err)
-		assert.False(t, schema.AutoTenantCreationEnabled(c))
-		assert.False(t, schema.AutoTenantActivationEnabled(c))
+		assert.False(t, schema.AutoTenantCreationEnabled(res.Class))
+		assert.False(t, schema.AutoTenantActivationEnabled(res.Class))
 	})
 
 	t.Run("with MT enabled and all optional settings", func(t *testing.T) {
@@ -1649,10 +1881,10 @@ func Test_AddClass_MultiTenancy(t *testing.T) {
 		}
 
 		fakeSchemaManager.On("AddClass", mock.Anything, mock.Anything).Return(nil)
-		c, _, err := handler.AddClass(ctx, nil, &class)
+		res, err := handler.AddClass(ctx, nil, &class)
 		require.Nil(t, err)
-		assert.True(t, schema.AutoTenantCreationEnabled(c))
-		assert.True(t, schema.AutoTenantActivationEnabled(c))
+		assert.True(t, schema.AutoTenantCreationEnabled(res.Class))
+		assert.True(t, schema.AutoTenantActivationEnabled(res.Class))
 	})
 
 	t.Run("with MT disabled, but auto tenant creation on", func(t *testing.T) {
@@ -1664,7 +1896,7 @@ func Test_AddClass_MultiTenancy(t *testing.T) {
 		}
 
 		fakeSchemaManager.On("AddClass", mock.Anything, mock.Anything).Return(nil)
-		_, _, err := handler.AddClass(ctx, nil, &class)
+		_, err := handler.AddClass(ctx, nil, &class)
 		require.NotNil(t, err)
 	})
 
@@ -1677,7 +1909,7 @@ func Test_AddClass_MultiTenancy(t *testing.T) {
 		}
 
 		fakeSchemaManager.On("AddClass", mock.Anything, mock.Anything).Return(nil)
-		_, _, err := handler.AddClass(ctx, nil, &class)
+		_, err := handler.AddClass(ctx, nil, &class)
 		require.NotNil(t, err)
 	})
 }
@@ -1741,3 +1973,104 @@ func Test_SetClassDefaults(t *testing.T) {
 		})
 	}
 }
+
+func Test_CloneClass(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	source := &models.Class{
+		Class: "Source",
+		Properties: []*models.Property{
+			{DataType: []string{"text"}, Name: "textProp"},
+			{DataType: []string{"Source"}, Name: "self"},
+		},
+		Vectorizer:        "none",
+		VectorIndexType:   "hnsw",
+		VectorIndexConfig: map[string]interface{}{"ef": float64(42)},
+		ReplicationConfig: &models.ReplicationConfig{Factor: 1},
+		ShardingConfig: map[string]interface{}{
+			"desiredCount": float64(1), "actualCount": float64(1), "actualVirtualCount": float64(128),
+		},
+	}
+
+	t.Run("with overrides", func(t *testing.T) {
+		handler, fakeSchemaManager := newTestHandler(t, &fakeDB{})
+		fakeSchemaManager.On("ReadOnlyClass", "Source").Return(source)
+		fakeSchemaManager.On("ReadOnlyClass", "Target").Return(nil)
+		var added *models.Class
+		fakeSchemaManager.On("AddClass", mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+			added = args.Get(0).(*models.Class)
+		})
+
+		err := handler.CloneClass(ctx, nil, "Source", "target", &ClassCloneOverrides{
+			VectorIndexType:       "flat",
+			RewriteSelfReferences: true,
+		})
+		require.Nil(t, err)
+		fakeSchemaManager.AssertExpectations(t)
+
+		require.NotNil(t, added)
+		assert.Equal(t, "Target", added.Class)
+		assert.Equal(t, "flat", added.VectorIndexType)
+		assert.Equal(t, []string{"Target"}, added.Properties[1].DataType)
+		assert.Equal(t, 1, added.ShardingConfig.(shardingConfig.Config).ActualCount)
+		// the source must not be modified
+		assert.Equal(t, []string{"Source"}, source.Properties[1].DataType)
+		assert.Equal(t, float64(128), source.ShardingConfig.(map[string]interface{})["actualVirtualCount"])
+	})
+
+	t.Run("target exists", func(t *testing.T) {
+		handler, fakeSchemaManager := newTestHandler(t, &fakeDB{})
+		fakeSchemaManager.On("ReadOnlyClass", "Source").Return(source)
+		fakeSchemaManager.On("ReadOnlyClass", "Target").Return(&models.Class{Class: "Target"})
+
+		err := handler.CloneClass(ctx, nil, "Source", "Target", nil)
+		assert.ErrorIs(t, err, clusterSchema.ErrClassExists)
+	})
+
+	t.Run("source not found", func(t *testing.T) {
+		handler, fakeSchemaManager := newTestHandler(t, &fakeDB{})
+		fakeSchemaManager.On("ReadOnlyClass", "Source").Return(nil)
+
+		err := handler.CloneClass(ctx, nil, "Source", "Target", nil)
+		assert.ErrorIs(t, err, ErrNotFound)
+	})
+}
+
+func TestValidatePropertyJSONSchema(t *testing.T) {
+	handler, _ := newTestHandler(t, &fakeDB{})
+	class := &models.Class{Class: "Event"}
+	validate := func(dataType, doc string) error {
+		prop := &models.Property{Name: "payload", DataType: []string{dataType}, JSONSchemaValidation: &doc}
+		if dataType == "text" {
+			prop.Tokenization = models.PropertyTokenizationField
+		}
+		return handler.validateProperty(class, map[string]bool{}, false,
+			func(string) (*models.Class, error) { return nil, nil }, prop)
+	}
+
+	assert.Nil(t, validate("text", `{"type": "object"}`))
+	assert.Nil(t, validate("blob", `{"$schema": "https://json-schema.org/draft-04/schema", "type": "array"}`))
+	assert.Nil(t, validate("text", `{"$schema": "http://json-schema.org/draft-04/schema#"}`))
+	assert.Nil(t, validate("text", `{"definitions": {"kind": {"type": "string"}},
+		"properties": {"kind": {"$ref": "#/definitions/kind"}, "$ref": {"type": "string"}}}`))
+
+	for name, tc := range map[string]struct{ dataType, doc, err string }{
+		"not text or blob": {"int", `{"type": "object"}`, "only allowed for data types text and blob"},
+		"not JSON":         {"text", `{"type": `, "not a JSON object"},
+		"not an object":    {"text", `["object"]`, "not a JSON object"},
+		"invalid type":     {"text", `{"type": 5}`, "invalid JSON schema"},
+		"unknown version":  {"text", `{"$schema": "http://json-schema.org/draft-99/schema#"}`, "unsupported JSON schema version"},
+		"later version":    {"text", `{"$schema": "https://json-schema.org/draft/2020-12/schema"}`, "only draft-04 is supported"},
+		"remote ref":       {"text", `{"properties": {"kind": {"$ref": "http://169.254.169.254/schema"}}}`, "only references within the document"},
+		"dangling ref":     {"text", `{"properties": {"kind": {"$ref": "#/definitions/missing"}}}`, "invalid $ref"},
+		"recursive ref":    {"text", `{"definitions": {"node": {"properties": {"child": {"$ref": "#/definitions/node"}}}}, "$ref": "#/definitions/node"}`, "recursive $ref"},
+		"id":               {"text", `{"id": "http://example.com/schema", "type": "object"}`, "id \"http://example.com/schema\" is not supported"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			err := validate(tc.dataType, tc.doc)
+			require.NotNil(t, err)
+			assert.ErrorContains(t, err, tc.err)
+		})
+	}
+}
@@ -0,0 +1,63 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"sync"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// ClassValidator adds custom validation to AddClass and UpdateClass, e.g. a
+// module rejecting classes without certain properties. Validators are called
+// after the built-in validation with the class as it will be stored. They
+// must be idempotent and must not modify the class.
+type ClassValidator interface {
+	ValidateClass(ctx context.Context, class *models.Class) error
+}
+
+// RegisterClassValidator adds v to the validators of AddClass and
+// UpdateClass. Validators are called in the order they are registered.
+func (h *Handler) RegisterClassValidator(v ClassValidator) {
+	h.classValidators.Lock()
+	defer h.classValidators.Unlock()
+	h.classValidators.validators = append(h.classValidators.validators, v)
+}
+
+// classValidators is shared by all copies of a Handler
+type classValidators struct {
+	sync.RWMutex
+	validators []ClassValidator
+}
+
+func newClassValidators() *classValidators {
+	return &classValidators{}
+}
+
+// runClassValidators calls all registered validators for class and returns
+// their errors joined
+func (h *Handler) runClassValidators(ctx context.Context, class *models.Class) error {
+	h.classValidators.RLock()
+	validators := slices.Clone(h.classValidators.validators)
+	h.classValidators.RUnlock()
+
+	var errs []error
+	for _, v := range validators {
+		if err := v.ValidateClass(ctx, class); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
@@ -0,0 +1,96 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+type recordingValidator struct {
+	name  string
+	err   error
+	calls *[]string
+}
+
+func (v recordingValidator) ValidateClass(ctx context.Context, class *models.Class) error {
+	*v.calls = append(*v.calls, v.name+":"+class.Class)
+	return v.err
+}
+
+func TestHandler_ClassValidators(t *testing.T) {
+	ctx := context.Background()
+	newClass := func() *models.Class {
+		return &models.Class{Class: "C", Vectorizer: "none", Properties: []*models.Property{
+			{Name: "name", DataType: []string{"text"}},
+		}}
+	}
+
+	t.Run("add class calls validators in order", func(t *testing.T) {
+		handler, fakeSchemaManager := newTestHandler(t, &fakeDB{})
+		fakeSchemaManager.On("AddClass", mock.Anything, mock.Anything).Return(nil)
+		var calls []string
+		handler.RegisterClassValidator(recordingValidator{name: "v1", calls: &calls})
+		handler.RegisterClassValidator(recordingValidator{name: "v2", calls: &calls})
+
+		_, err := handler.AddClass(ctx, nil, newClass())
+		require.Nil(t, err)
+		assert.Equal(t, []string{"v1:C", "v2:C"}, calls)
+		fakeSchemaManager.AssertCalled(t, "AddClass", mock.Anything, mock.Anything)
+	})
+
+	t.Run("add class collects the errors of all validators", func(t *testing.T) {
+		handler, fakeSchemaManager := newTestHandler(t, &fakeDB{})
+		var calls []string
+		err1, err2 := errors.New("missing property owner"), errors.New("missing property region")
+		handler.RegisterClassValidator(recordingValidator{name: "v1", err: err1, calls: &calls})
+		handler.RegisterClassValidator(recordingValidator{name: "v2", calls: &calls})
+		handler.RegisterClassValidator(recordingValidator{name: "v3", err: err2, calls: &calls})
+
+		_, err := handler.AddClass(ctx, nil, newClass())
+		assert.ErrorIs(t, err, err1)
+		assert.ErrorIs(t, err, err2)
+		assert.Equal(t, []string{"v1:C", "v2:C", "v3:C"}, calls)
+		fakeSchemaManager.AssertNotCalled(t, "AddClass", mock.Anything, mock.Anything)
+	})
+
+	t.Run("validators run after the built-in validation", func(t *testing.T) {
+		handler, _ := newTestHandler(t, &fakeDB{})
+		var calls []string
+		handler.RegisterClassValidator(recordingValidator{name: "v1", calls: &calls})
+
+		cls := newClass()
+		cls.Properties[0].DataType = []string{"unknown"}
+		_, err := handler.AddClass(ctx, nil, cls)
+		require.NotNil(t, err)
+		assert.Empty(t, calls)
+	})
+
+	t.Run("update class", func(t *testing.T) {
+		handler, fakeSchemaManager := newTestHandler(t, &fakeDB{})
+		fakeSchemaManager.On("ReadOnlyClass", "C", mock.Anything).Return(nil)
+		var calls []string
+		errInvalid := errors.New("invalid")
+		handler.RegisterClassValidator(recordingValidator{name: "v1", err: errInvalid, calls: &calls})
+
+		err := handler.UpdateClass(ctx, nil, "C", newClass())
+		assert.ErrorIs(t, err, errInvalid)
+		assert.Equal(t, []string{"v1:C"}, calls)
+		fakeSchemaManager.AssertNotCalled(t, "UpdateClass", mock.Anything, mock.Anything)
+	})
+}
@@ -0,0 +1,135 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	clusterTypes "github.com/weaviate/weaviate/cluster/types"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
+)
+
+// CompactionReport counts the schema entries removed by CompactSchema
+type CompactionReport struct {
+	// ModuleConfigs are the moduleConfig entries of classes and properties
+	// for modules which are not loaded
+	ModuleConfigs int
+	// DataTypes are the reference targets of properties which are not a
+	// class of the schema
+	DataTypes int
+	// Properties are the references without any remaining target
+	Properties int
+	// Classes are the names of the classes with removed entries
+	Classes []string
+}
+
+// CompactSchema removes the module configs of modules which are not loaded,
+// reference targets which don't exist and properties which are left without
+// a data type, i.e. the issues ValidateSchemaIntegrity reports as
+// IntegrityIssueModuleNotLoaded and IntegrityIssueDanglingReference. With
+// dryRun the schema is not changed and the report lists what would be
+// removed.
+func (h *Handler) CompactSchema(ctx context.Context, principal *models.Principal, dryRun bool) (CompactionReport, error) {
+	report := CompactionReport{Classes: []string{}}
+	// compaction changes classes the principal doesn't have to know about, it
+	// is a cluster administration task like RollbackToVersion
+	if err := h.Authorizer.Authorize(principal, authorization.UPDATE, authorization.Cluster()); err != nil {
+		return report, err
+	}
+
+	s := h.schemaReader.ReadOnlySchema()
+	classes := make(map[string]struct{}, len(s.Classes))
+	for _, class := range s.Classes {
+		classes[class.Class] = struct{}{}
+	}
+
+	ctx = clusterTypes.ContextWithCompaction(withActor(ctx, principal))
+	for _, class := range s.Classes {
+		compacted, changed := h.compactClass(class, classes, &report)
+		if !changed {
+			continue
+		}
+		report.Classes = append(report.Classes, class.Class)
+		if dryRun {
+			continue
+		}
+		if _, err := h.schemaManager.UpdateClass(ctx, compacted, nil); err != nil {
+			return report, fmt.Errorf("compact class %q: %w", class.Class, err)
+		}
+	}
+
+	if !dryRun && len(report.Classes) > 0 {
+		h.logEntry(ctx, "", "").WithField("action", "schema_compaction").
+			WithField("classes", report.Classes).
+			WithField("module_configs", report.ModuleConfigs).
+			WithField("data_types", report.DataTypes).
+			WithField("properties", report.Properties).
+			Info("compacted schema")
+	}
+	return report, nil
+}
+
+// compactClass returns a copy of class without the entries CompactSchema
+// removes and whether there are any. The removed entries are added to report.
+func (h *Handler) compactClass(class *models.Class, classes map[string]struct{},
+	report *CompactionReport,
+) (*models.Class, bool) {
+	changed := false
+	compacted := *class
+	if missing := h.missingModules(class.ModuleConfig); len(missing) > 0 {
+		compacted.ModuleConfig = withoutModules(class.ModuleConfig, missing)
+		report.ModuleConfigs += len(missing)
+		changed = true
+	}
+
+	compacted.Properties = make([]*models.Property, 0, len(class.Properties))
+	for _, prop := range class.Properties {
+		c := *prop
+		if missing := h.missingModules(prop.ModuleConfig); len(missing) > 0 {
+			c.ModuleConfig = withoutModules(prop.ModuleConfig, missing)
+			report.ModuleConfigs += len(missing)
+			changed = true
+		}
+		if schema.IsRefDataType(prop.DataType) {
+			c.DataType = slices.DeleteFunc(slices.Clone(prop.DataType), func(target string) bool {
+				_, ok := classes[target]
+				return !ok
+			})
+			if removed := len(prop.DataType) - len(c.DataType); removed > 0 {
+				report.DataTypes += removed
+				changed = true
+			}
+			if len(c.DataType) == 0 {
+				report.Properties++
+				continue
+			}
+		}
+		compacted.Properties = append(compacted.Properties, &c)
+	}
+	return &compacted, changed
+}
+
+// withoutModules returns a copy of moduleConfig without the given modules
+func withoutModules(moduleConfig interface{}, modules []string) interface{} {
+	cfg := moduleConfig.(map[string]interface{})
+	compacted := make(map[string]interface{}, len(cfg))
+	for name, c := range cfg {
+		if !slices.Contains(modules, name) {
+			compacted[name] = c
+		}
+	}
+	return compacted
+}
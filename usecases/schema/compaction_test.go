@@ -0,0 +1,99 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+func TestHandler_CompactSchema(t *testing.T) {
+	ctx := context.Background()
+	inconsistent := func() models.Schema {
+		return models.Schema{Classes: []*models.Class{
+			{Class: "Author"},
+			{
+				Class: "Book",
+				// the fake module config doesn't know any module
+				ModuleConfig: map[string]interface{}{"my-module1": map[string]interface{}{}},
+				Properties: []*models.Property{
+					{
+						Name:         "title",
+						DataType:     []string{"text"},
+						ModuleConfig: map[string]interface{}{"my-module2": map[string]interface{}{}},
+					},
+					{Name: "writtenBy", DataType: []string{"Author", "Publisher"}},
+					{Name: "editedBy", DataType: []string{"Editor"}},
+				},
+			},
+		}}
+	}
+	expected := CompactionReport{ModuleConfigs: 2, DataTypes: 2, Properties: 1, Classes: []string{"Book"}}
+
+	t.Run("consistent schema", func(t *testing.T) {
+		handler, fakeSchemaManager := newTestHandler(t, &fakeDB{})
+		fakeSchemaManager.On("ReadOnlySchema").Return(models.Schema{Classes: []*models.Class{
+			{Class: "Author"},
+			{Class: "Book", Properties: []*models.Property{{Name: "writtenBy", DataType: []string{"Author"}}}},
+		}})
+
+		report, err := handler.CompactSchema(ctx, nil, false)
+		require.Nil(t, err)
+		assert.Equal(t, CompactionReport{Classes: []string{}}, report)
+		fakeSchemaManager.AssertNotCalled(t, "UpdateClass", mock.Anything, mock.Anything)
+	})
+
+	t.Run("dry run", func(t *testing.T) {
+		handler, fakeSchemaManager := newTestHandler(t, &fakeDB{})
+		fakeSchemaManager.On("ReadOnlySchema").Return(inconsistent())
+
+		report, err := handler.CompactSchema(ctx, nil, true)
+		require.Nil(t, err)
+		assert.Equal(t, expected, report)
+		fakeSchemaManager.AssertNotCalled(t, "UpdateClass", mock.Anything, mock.Anything)
+	})
+
+	t.Run("compaction", func(t *testing.T) {
+		handler, fakeSchemaManager := newTestHandler(t, &fakeDB{})
+		sch := inconsistent()
+		fakeSchemaManager.On("ReadOnlySchema").Return(sch)
+		fakeSchemaManager.On("UpdateClass", &models.Class{
+			Class:        "Book",
+			ModuleConfig: map[string]interface{}{},
+			Properties: []*models.Property{
+				{Name: "title", DataType: []string{"text"}, ModuleConfig: map[string]interface{}{}},
+				{Name: "writtenBy", DataType: []string{"Author"}},
+			},
+		}, mock.Anything).Return(nil)
+
+		report, err := handler.CompactSchema(ctx, nil, false)
+		require.Nil(t, err)
+		assert.Equal(t, expected, report)
+		fakeSchemaManager.AssertExpectations(t)
+		assert.Equal(t, inconsistent(), sch, "read only schema must not be modified")
+	})
+
+	t.Run("update fails", func(t *testing.T) {
+		handler, fakeSchemaManager := newTestHandler(t, &fakeDB{})
+		fakeSchemaManager.On("ReadOnlySchema").Return(inconsistent())
+		fakeSchemaManager.On("UpdateClass", mock.Anything, mock.Anything).Return(errors.New("not the leader"))
+
+		_, err := handler.CompactSchema(ctx, nil, false)
+		require.ErrorContains(t, err, `compact class "Book": not the leader`)
+	})
+}
@@ -0,0 +1,205 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+)
+
+// A compute expression applies functions to a source property of the same
+// object, e.g. `len($.name)` or `upper(first($.category))`.
+var (
+	computeSourceRegex = regexp.MustCompile(`^\$\.([_A-Za-z][_0-9A-Za-z]*)$`)
+	computeCallRegex   = regexp.MustCompile(`^([a-z]+)\((.*)\)$`)
+)
+
+type computeFunc struct {
+	input, output schema.DataType
+	apply         func(v any) any
+}
+
+var computeFuncs = map[string]computeFunc{
+	"len": {schema.DataTypeText, schema.DataTypeInt, func(v any) any {
+		return int64(utf8.RuneCountInString(v.(string)))
+	}},
+	"upper": {schema.DataTypeText, schema.DataTypeText, func(v any) any {
+		return strings.ToUpper(v.(string))
+	}},
+	"lower": {schema.DataTypeText, schema.DataTypeText, func(v any) any {
+		return strings.ToLower(v.(string))
+	}},
+	"first": {schema.DataTypeText, schema.DataTypeText, func(v any) any {
+		for _, r := range v.(string) {
+			return string(r)
+		}
+		return ""
+	}},
+	"hash": {schema.DataTypeText, schema.DataTypeText, func(v any) any {
+		sum := sha256.Sum256([]byte(v.(string)))
+		return hex.EncodeToString(sum[:])
+	}},
+}
+
+type computeExpression struct {
+	source string
+	// funcs are applied from last to first, i.e. innermost first
+	funcs []string
+}
+
+func parseComputeExpression(expr string) (*computeExpression, error) {
+	parsed := &computeExpression{}
+	rest := strings.TrimSpace(expr)
+	for {
+		if m := computeSourceRegex.FindStringSubmatch(rest); m != nil {
+			parsed.source = schema.LowercaseFirstLetter(m[1])
+			return parsed, nil
+		}
+		m := computeCallRegex.FindStringSubmatch(rest)
+		if m == nil {
+			return nil, fmt.Errorf("invalid compute expression %q: expected $.<property> or <function>(<expression>)", expr)
+		}
+		if _, ok := computeFuncs[m[1]]; !ok {
+			return nil, fmt.Errorf("invalid compute expression %q: unknown function %q", expr, m[1])
+		}
+		parsed.funcs = append(parsed.funcs, m[1])
+		rest = strings.TrimSpace(m[2])
+	}
+}
+
+// resultType returns the data type of the computed value for a source
+// property of sourceType
+func (e *computeExpression) resultType(sourceType schema.DataType) (schema.DataType, error) {
+	if sourceType == schema.DataTypeString {
+		// deprecated, migrated to text
+		sourceType = schema.DataTypeText
+	}
+	current := sourceType
+	for i := len(e.funcs) - 1; i >= 0; i-- {
+		fn := computeFuncs[e.funcs[i]]
+		if current != fn.input {
+			return "", fmt.Errorf("function %s expects %s, got %s", e.funcs[i], fn.input, current)
+		}
+		current = fn.output
+	}
+	return current, nil
+}
+
+func (e *computeExpression) eval(v any) any {
+	for i := len(e.funcs) - 1; i >= 0; i-- {
+		v = computeFuncs[e.funcs[i]].apply(v)
+	}
+	return v
+}
+
+// ValidateComputeExpression checks that expr is a valid compute expression
+// for a source property of sourcePropertyType
+func ValidateComputeExpression(expr string, sourcePropertyType string) error {
+	parsed, err := parseComputeExpression(expr)
+	if err != nil {
+		return err
+	}
+	if _, err := parsed.resultType(schema.DataType(sourcePropertyType)); err != nil {
+		return fmt.Errorf("invalid compute expression %q: %w", expr, err)
+	}
+	return nil
+}
+
+// validateComputedProperty checks that the compute expression of prop
+// refers to an existing, not computed property of class or of pending and
+// that its result matches the data type of prop
+func (h *Handler) validateComputedProperty(class *models.Class, prop *models.Property, pending []*models.Property) error {
+	parsed, err := parseComputeExpression(prop.ComputeExpression)
+	if err != nil {
+		return err
+	}
+
+	var source *models.Property
+	for _, p := range slices.Concat(class.Properties, pending) {
+		if strings.EqualFold(p.Name, parsed.source) {
+			source = p
+			break
+		}
+	}
+	switch {
+	case source == nil:
+		return fmt.Errorf("property %q: compute expression refers to unknown property %q", prop.Name, parsed.source)
+	case strings.EqualFold(source.Name, prop.Name):
+		return fmt.Errorf("property %q: compute expression can not refer to the property itself", prop.Name)
+	case source.ComputeExpression != "":
+		return fmt.Errorf("property %q: compute expression can not refer to the computed property %q", prop.Name, source.Name)
+	case len(source.DataType) != 1:
+		return fmt.Errorf("property %q: compute expression can not refer to cross-reference %q", prop.Name, source.Name)
+	}
+
+	if err := h.validator.ValidateComputeExpression(prop.ComputeExpression, source.DataType[0]); err != nil {
+		return fmt.Errorf("property %q: %w", prop.Name, err)
+	}
+	result, _ := parsed.resultType(schema.DataType(source.DataType[0]))
+	if len(prop.DataType) != 1 || !computedTypeMatches(result, schema.DataType(prop.DataType[0])) {
+		return fmt.Errorf("property %q: compute expression returns %s, but the dataType is %v", prop.Name, result, prop.DataType)
+	}
+	return nil
+}
+
+func computedTypeMatches(result, dataType schema.DataType) bool {
+	return result == dataType || (result == schema.DataTypeText && dataType == schema.DataTypeString)
+}
+
+// ResolveComputedProperties writes the computed properties of class into the
+// properties of object. A computed property is written whenever its source
+// property is, objects must not set computed properties themselves.
+func (h *Handler) ResolveComputedProperties(class *models.Class, object *models.Object) error {
+	props, _ := object.Properties.(map[string]interface{})
+	for _, prop := range class.Properties {
+		if prop.ComputeExpression == "" {
+			continue
+		}
+		for key := range props {
+			if strings.EqualFold(key, prop.Name) {
+				return fmt.Errorf("property %q is computed and can not be set", prop.Name)
+			}
+		}
+
+		parsed, err := parseComputeExpression(prop.ComputeExpression)
+		if err != nil {
+			return fmt.Errorf("property %q: %w", prop.Name, err)
+		}
+		sourceValue, ok := computeSourceValue(props, parsed.source)
+		if !ok {
+			continue
+		}
+		value, ok := sourceValue.(string)
+		if !ok {
+			return fmt.Errorf("property %q: source property %q must be a text, got %T", prop.Name, parsed.source, sourceValue)
+		}
+		props[prop.Name] = parsed.eval(value)
+	}
+	return nil
+}
+
+func computeSourceValue(props map[string]interface{}, source string) (any, bool) {
+	for key, value := range props {
+		if value != nil && strings.EqualFold(key, source) {
+			return value, true
+		}
+	}
+	return nil, false
+}
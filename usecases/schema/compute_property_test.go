@@ -0,0 +1,160 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+func TestComputeExpression(t *testing.T) {
+	tests := []struct {
+		expr     string
+		value    string
+		expected any
+	}{
+		{expr: "len($.name)", value: "Weaviate", expected: int64(8)},
+		{expr: "len($.name)", value: "über", expected: int64(4)},
+		{expr: "upper($.name)", value: "Weaviate", expected: "WEAVIATE"},
+		{expr: "lower($.name)", value: "Weaviate", expected: "weaviate"},
+		{expr: "first($.name)", value: "über", expected: "ü"},
+		{expr: "first($.name)", value: "", expected: ""},
+		{expr: " upper( first($.name) ) ", value: "weaviate", expected: "W"},
+		{expr: "hash($.name)", value: "abc", expected: "ba7816bf8f01cfea414140de5dae2223b00361a396177a9cb410ff61f20015ad"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.expr, func(t *testing.T) {
+			require.Nil(t, ValidateComputeExpression(tt.expr, "text"))
+			parsed, err := parseComputeExpression(tt.expr)
+			require.Nil(t, err)
+			assert.Equal(t, "name", parsed.source)
+			assert.Equal(t, tt.expected, parsed.eval(tt.value))
+		})
+	}
+
+	invalid := []struct {
+		expr, sourceType, err string
+	}{
+		{expr: "$name", sourceType: "text", err: "expected $.<property>"},
+		{expr: "len($.name", sourceType: "text", err: "expected $.<property>"},
+		{expr: "reverse($.name)", sourceType: "text", err: `unknown function "reverse"`},
+		{expr: "upper(len($.name))", sourceType: "text", err: "function upper expects text, got int"},
+		{expr: "len($.count)", sourceType: "int", err: "function len expects text, got int"},
+	}
+	for _, tt := range invalid {
+		t.Run(tt.expr, func(t *testing.T) {
+			assert.ErrorContains(t, ValidateComputeExpression(tt.expr, tt.sourceType), tt.err)
+		})
+	}
+}
+
+func TestHandler_ComputedProperties(t *testing.T) {
+	ctx := context.Background()
+	class := func(props ...*models.Property) *models.Class {
+		return &models.Class{
+			Class:      "Article",
+			Vectorizer: "none",
+			Properties: append([]*models.Property{
+				{Name: "title", DataType: []string{"text"}},
+				{Name: "views", DataType: []string{"int"}},
+			}, props...),
+		}
+	}
+
+	t.Run("add class", func(t *testing.T) {
+		handler, fakeSchemaManager := newTestHandler(t, &fakeDB{})
+		fakeSchemaManager.On("AddClass", mock.Anything, mock.Anything).Return(nil)
+
+		_, err := handler.AddClass(ctx, nil, class(
+			&models.Property{Name: "titleLength", DataType: []string{"int"}, ComputeExpression: "len($.title)"},
+			&models.Property{Name: "initial", DataType: []string{"text"}, ComputeExpression: "upper(first($.title))"},
+		))
+		require.Nil(t, err)
+	})
+
+	invalid := []struct {
+		name string
+		prop *models.Property
+		err  string
+	}{
+		{
+			name: "unknown source",
+			prop: &models.Property{Name: "c", DataType: []string{"int"}, ComputeExpression: "len($.body)"},
+			err:  `refers to unknown property "body"`,
+		},
+		{
+			name: "source type",
+			prop: &models.Property{Name: "c", DataType: []string{"int"}, ComputeExpression: "len($.views)"},
+			err:  "function len expects text, got int",
+		},
+		{
+			name: "result type",
+			prop: &models.Property{Name: "c", DataType: []string{"text"}, ComputeExpression: "len($.title)"},
+			err:  "compute expression returns int, but the dataType is [text]",
+		},
+		{
+			name: "itself",
+			prop: &models.Property{Name: "c", DataType: []string{"text"}, ComputeExpression: "upper($.c)"},
+			err:  "can not refer to the property itself",
+		},
+	}
+	for _, tt := range invalid {
+		t.Run(tt.name, func(t *testing.T) {
+			handler, _ := newTestHandler(t, &fakeDB{})
+
+			_, err := handler.AddClass(ctx, nil, class(tt.prop))
+			var verr *ValidationError
+			require.ErrorAs(t, err, &verr)
+			require.Len(t, verr.Violations, 1)
+			assert.Equal(t, "properties.c.computeExpression", verr.Violations[0].Field)
+			assert.ErrorContains(t, err, tt.err)
+		})
+	}
+
+	t.Run("computed source", func(t *testing.T) {
+		handler, _ := newTestHandler(t, &fakeDB{})
+
+		_, err := handler.AddClass(ctx, nil, class(
+			&models.Property{Name: "initial", DataType: []string{"text"}, ComputeExpression: "first($.title)"},
+			&models.Property{Name: "c", DataType: []string{"text"}, ComputeExpression: "upper($.initial)"},
+		))
+		assert.ErrorContains(t, err, `can not refer to the computed property "initial"`)
+	})
+
+	t.Run("resolve", func(t *testing.T) {
+		handler, _ := newTestHandler(t, &fakeDB{})
+		cls := class(
+			&models.Property{Name: "titleLength", DataType: []string{"int"}, ComputeExpression: "len($.title)"},
+		)
+
+		obj := &models.Object{Properties: map[string]interface{}{"title": "Weaviate"}}
+		require.Nil(t, handler.ResolveComputedProperties(cls, obj))
+		assert.Equal(t, map[string]interface{}{"title": "Weaviate", "titleLength": int64(8)}, obj.Properties)
+
+		// without the source nothing is computed
+		obj = &models.Object{Properties: map[string]interface{}{"views": 3}}
+		require.Nil(t, handler.ResolveComputedProperties(cls, obj))
+		assert.Equal(t, map[string]interface{}{"views": 3}, obj.Properties)
+
+		obj = &models.Object{}
+		require.Nil(t, handler.ResolveComputedProperties(cls, obj))
+		assert.Nil(t, obj.Properties)
+
+		obj = &models.Object{Properties: map[string]interface{}{"title": "Weaviate", "titleLength": 3}}
+		assert.ErrorContains(t, handler.ResolveComputedProperties(cls, obj), `property "titleLength" is computed and can not be set`)
+	})
+}
@@ -0,0 +1,64 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"fmt"
+	"sync"
+
+	clusterSchema "github.com/weaviate/weaviate/cluster/schema"
+	"github.com/weaviate/weaviate/usecases/replica"
+)
+
+// defaultConsistency holds the consistency level of requests which don't set
+// one. It is shared by all copies of a Handler.
+type defaultConsistency struct {
+	sync.RWMutex
+	level replica.ConsistencyLevel
+}
+
+func newDefaultConsistency(level string) *defaultConsistency {
+	if level == "" {
+		level = string(replica.One)
+	}
+	return &defaultConsistency{level: replica.ConsistencyLevel(level)}
+}
+
+// DefaultConsistencyLevel returns the consistency level used by requests
+// which don't set one
+func (h *Handler) DefaultConsistencyLevel() replica.ConsistencyLevel {
+	h.defaultConsistency.RLock()
+	defer h.defaultConsistency.RUnlock()
+	return h.defaultConsistency.level
+}
+
+// SetDefaultConsistencyLevel overrides the configured default consistency
+// level until the next restart. The override is local to this node, it is
+// not replicated to the rest of the cluster.
+func (h *Handler) SetDefaultConsistencyLevel(level replica.ConsistencyLevel) error {
+	switch level {
+	case replica.One, replica.Quorum, replica.All:
+	default:
+		return fmt.Errorf("unknown consistency level %q: %w", level, clusterSchema.ErrBadRequest)
+	}
+
+	h.defaultConsistency.Lock()
+	defer h.defaultConsistency.Unlock()
+	if h.defaultConsistency.level != level {
+		h.logger.WithField("action", "set_default_consistency_level").
+			WithField("previous", h.defaultConsistency.level).
+			WithField("level", level).
+			Info("default consistency level overridden")
+	}
+	h.defaultConsistency.level = level
+	return nil
+}
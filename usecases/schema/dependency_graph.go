@@ -0,0 +1,162 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"slices"
+	"sort"
+
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
+)
+
+// DependencyGraph is the graph of the cross-references between classes, see
+// GetSchemaDependencyGraph
+type DependencyGraph struct {
+	// Nodes are the names of all classes, ordered by name
+	Nodes []string `json:"nodes"`
+	// Edges are ordered by source class, property and target class
+	Edges []DependencyEdge `json:"edges"`
+	// Cycles are the groups of classes which reference each other, directly
+	// or through other classes of the group, ordered by name. Classes
+	// referencing themselves are a group of one.
+	Cycles [][]string `json:"cycles"`
+}
+
+// DependencyEdge is a cross-reference property of class From which may
+// reference class To
+type DependencyEdge struct {
+	From     string `json:"from"`
+	To       string `json:"to"`
+	Property string `json:"property"`
+}
+
+// GetSchemaDependencyGraph returns the graph of the cross-references between
+// the classes of the schema. A property referencing several classes has an
+// edge to each of them.
+func (h *Handler) GetSchemaDependencyGraph(principal *models.Principal) (*DependencyGraph, error) {
+	if err := h.Authorizer.Authorize(principal, authorization.READ, authorization.CollectionsMetadata()...); err != nil {
+		return nil, err
+	}
+	return dependencyGraph(h.schemaReader.ReadOnlySchema().Classes), nil
+}
+
+func dependencyGraph(classes []*models.Class) *DependencyGraph {
+	g := &DependencyGraph{Nodes: make([]string, 0, len(classes)), Edges: []DependencyEdge{}}
+	known := make(map[string]bool, len(classes))
+	for _, class := range classes {
+		g.Nodes = append(g.Nodes, class.Class)
+		known[class.Class] = true
+	}
+	sort.Strings(g.Nodes)
+
+	for _, class := range classes {
+		for _, prop := range class.Properties {
+			if len(prop.DataType) == 0 || !schema.IsRefDataType(prop.DataType) {
+				continue
+			}
+			for _, target := range prop.DataType {
+				if known[target] {
+					g.Edges = append(g.Edges, DependencyEdge{From: class.Class, To: target, Property: prop.Name})
+				}
+			}
+		}
+	}
+	sort.Slice(g.Edges, func(i, j int) bool {
+		a, b := g.Edges[i], g.Edges[j]
+		if a.From != b.From {
+			return a.From < b.From
+		}
+		if a.Property != b.Property {
+			return a.Property < b.Property
+		}
+		return a.To < b.To
+	})
+
+	g.Cycles = g.findCycles()
+	return g
+}
+
+// findCycles returns the strongly connected components of g which contain a
+// cycle, found with Tarjan's algorithm
+func (g *DependencyGraph) findCycles() [][]string {
+	targets := make(map[string][]string, len(g.Nodes))
+	selfRefs := map[string]bool{}
+	for _, e := range g.Edges {
+		targets[e.From] = append(targets[e.From], e.To)
+		if e.From == e.To {
+			selfRefs[e.From] = true
+		}
+	}
+
+	var (
+		next    int
+		index   = make(map[string]int, len(g.Nodes))
+		lowlink = make(map[string]int, len(g.Nodes))
+		onStack = map[string]bool{}
+		stack   []string
+		cycles  = [][]string{}
+	)
+	var visit func(node string)
+	visit = func(node string) {
+		index[node], lowlink[node] = next, next
+		next++
+		stack = append(stack, node)
+		onStack[node] = true
+
+		for _, target := range targets[node] {
+			if _, ok := index[target]; !ok {
+				visit(target)
+				lowlink[node] = min(lowlink[node], lowlink[target])
+			} else if onStack[target] {
+				lowlink[node] = min(lowlink[node], index[target])
+			}
+		}
+
+		if lowlink[node] != index[node] {
+			return
+		}
+		var component []string
+		for {
+			last := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			onStack[last] = false
+			component = append(component, last)
+			if last == node {
+				break
+			}
+		}
+		if len(component) > 1 || selfRefs[node] {
+			sort.Strings(component)
+			cycles = append(cycles, component)
+		}
+	}
+	for _, node := range g.Nodes {
+		if _, ok := index[node]; !ok {
+			visit(node)
+		}
+	}
+
+	sort.Slice(cycles, func(i, j int) bool { return cycles[i][0] < cycles[j][0] })
+	return cycles
+}
+
+// inCycle returns whether e is part of one of the cycles of g
+func (g *DependencyGraph) inCycle(e DependencyEdge) bool {
+	for _, cycle := range g.Cycles {
+		if slices.Contains(cycle, e.From) && slices.Contains(cycle, e.To) {
+			return true
+		}
+	}
+	return false
+}
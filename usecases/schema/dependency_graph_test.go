@@ -0,0 +1,102 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+func TestDependencyGraph(t *testing.T) {
+	class := func(name string, refs ...string) *models.Class {
+		c := &models.Class{Class: name, Properties: []*models.Property{{Name: "title", DataType: []string{"text"}}}}
+		for i := 0; i+1 < len(refs); i += 2 {
+			c.Properties = append(c.Properties, &models.Property{Name: refs[i], DataType: []string{refs[i+1]}})
+		}
+		return c
+	}
+
+	t.Run("no dependencies", func(t *testing.T) {
+		g := dependencyGraph([]*models.Class{class("B"), class("A")})
+		assert.Equal(t, []string{"A", "B"}, g.Nodes)
+		assert.Empty(t, g.Edges)
+		assert.Empty(t, g.Cycles)
+
+		js, err := json.Marshal(g)
+		require.Nil(t, err)
+		assert.JSONEq(t, `{"nodes":["A","B"],"edges":[],"cycles":[]}`, string(js))
+		assert.Equal(t, "digraph schema {\n  \"A\";\n  \"B\";\n}\n", g.DOT())
+		assert.Equal(t, "flowchart LR\n  A\n  B\n", g.Mermaid())
+	})
+
+	t.Run("chain", func(t *testing.T) {
+		g := dependencyGraph([]*models.Class{
+			class("Article", "author", "Author"),
+			class("Author", "publisher", "Publisher"),
+			class("Publisher"),
+		})
+		assert.Equal(t, []DependencyEdge{
+			{From: "Article", To: "Author", Property: "author"},
+			{From: "Author", To: "Publisher", Property: "publisher"},
+		}, g.Edges)
+		assert.Empty(t, g.Cycles)
+
+		assert.Equal(t, `digraph schema {
+  "Article";
+  "Author";
+  "Publisher";
+  "Article" -> "Author" [label="author"];
+  "Author" -> "Publisher" [label="publisher"];
+}
+`, g.DOT())
+		assert.Equal(t, `flowchart LR
+  Article
+  Author
+  Publisher
+  Article -->|author| Author
+  Author -->|publisher| Publisher
+`, g.Mermaid())
+	})
+
+	t.Run("cycle", func(t *testing.T) {
+		g := dependencyGraph([]*models.Class{
+			class("A", "toB", "B"),
+			class("B", "toC", "C"),
+			class("C", "toA", "A"),
+			class("D", "toA", "A", "self", "D"),
+		})
+		assert.Equal(t, [][]string{{"A", "B", "C"}, {"D"}}, g.Cycles)
+
+		dot := g.DOT()
+		assert.Contains(t, dot, `"C" -> "A" [label="toA", color=red];`)
+		assert.Contains(t, dot, `"D" -> "A" [label="toA"];`)
+		assert.Contains(t, dot, `"D" -> "D" [label="self", color=red];`)
+		// edges: A->B, B->C, C->A, D->D (self), D->A (toA)
+		assert.Contains(t, g.Mermaid(), "  linkStyle 0,1,2,3 stroke:red\n")
+	})
+
+	t.Run("multiple targets and unknown classes", func(t *testing.T) {
+		g := dependencyGraph([]*models.Class{
+			{Class: "Note", Properties: []*models.Property{{Name: "about", DataType: []string{"Person", "Place", "Gone"}}}},
+			class("Person"),
+			class("Place"),
+		})
+		assert.Equal(t, []DependencyEdge{
+			{From: "Note", To: "Person", Property: "about"},
+			{From: "Note", To: "Place", Property: "about"},
+		}, g.Edges)
+	})
+}
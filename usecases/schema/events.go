@@ -0,0 +1,217 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"sync/atomic"
+
+	"github.com/sirupsen/logrus"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// EventListener is notified about the schema changes made through a
+// Handler, after they have been applied successfully. Listeners must not
+// modify the classes and properties passed to them.
+type EventListener interface {
+	OnClassAdded(class *models.Class)
+	OnClassUpdated(class *models.Class)
+	OnClassDeleted(name string)
+	OnPropertyAdded(class string, prop *models.Property)
+	OnTenantsAdded(class string, tenants []string)
+	OnTenantsUpdated(class string, tenants []string)
+	OnTenantsDeleted(class string, tenants []string)
+}
+
+// NoopEventListener implements EventListener without doing anything. It can
+// be embedded by listeners which are only interested in some of the events.
+type NoopEventListener struct{}
+
+func (NoopEventListener) OnClassAdded(*models.Class)               {}
+func (NoopEventListener) OnClassUpdated(*models.Class)             {}
+func (NoopEventListener) OnClassDeleted(string)                    {}
+func (NoopEventListener) OnPropertyAdded(string, *models.Property) {}
+func (NoopEventListener) OnTenantsAdded(string, []string)          {}
+func (NoopEventListener) OnTenantsUpdated(string, []string)        {}
+func (NoopEventListener) OnTenantsDeleted(string, []string)        {}
+
+// ListenerID identifies a registered EventListener, see UnregisterListener
+type ListenerID uint64
+
+// RegisterListener adds l to the listeners notified about schema changes.
+// Listeners are called synchronously one after another, a write returns
+// once all of them are done or its context is cancelled. Wrap slow
+// listeners with NewAsyncListener to not block writes. The returned ID
+// unregisters l again.
+func (h *Handler) RegisterListener(l EventListener) ListenerID {
+	h.listeners.Lock()
+	defer h.listeners.Unlock()
+	h.listeners.lastID++
+	h.listeners.listeners = append(h.listeners.listeners, registeredListener{id: h.listeners.lastID, listener: l})
+	return h.listeners.lastID
+}
+
+// UnregisterListener removes the listener with id from the listeners
+// notified about schema changes. It is a no-op if there is none.
+func (h *Handler) UnregisterListener(id ListenerID) {
+	h.listeners.Lock()
+	defer h.listeners.Unlock()
+	h.listeners.listeners = slices.DeleteFunc(h.listeners.listeners, func(r registeredListener) bool {
+		return r.id == id
+	})
+}
+
+// registeredListener identifies listeners by ID, as listeners of types which
+// aren't comparable can't be compared to each other
+type registeredListener struct {
+	id       ListenerID
+	listener EventListener
+}
+
+// eventListeners is shared by all copies of a Handler
+type eventListeners struct {
+	sync.RWMutex
+	listeners []registeredListener
+	lastID    ListenerID
+}
+
+func newEventListeners() *eventListeners {
+	return &eventListeners{}
+}
+
+// notify calls event for all registered listeners. Within a transaction the
+// events are buffered and sent once the transaction is committed.
+func (h *Handler) notify(ctx context.Context, event func(EventListener)) {
+	if h.pendingEvents != nil {
+		*h.pendingEvents = append(*h.pendingEvents, event)
+		return
+	}
+
+	h.listeners.RLock()
+	listeners := slices.Clone(h.listeners.listeners)
+	h.listeners.RUnlock()
+
+	for _, l := range listeners {
+		done := enterrors.GoWrapperWithErrorCh(func() { event(l.listener) }, h.logger)
+		select {
+		case err := <-done:
+			if err != nil {
+				h.logEntry(ctx, "", "").WithField("action", "schema_event").WithError(err).Error("schema event listener failed")
+			}
+		case <-ctx.Done():
+			h.logEntry(ctx, "", "").WithField("action", "schema_event").WithError(ctx.Err()).
+				Warn("stop notifying schema event listeners")
+			return
+		}
+	}
+}
+
+// AsyncListener passes events on to a listener in the background. Events
+// which arrive while its queue is full are dropped.
+type AsyncListener struct {
+	listener EventListener
+	logger   logrus.FieldLogger
+	queue    chan func(EventListener)
+	dropped  atomic.Uint64
+
+	closeOnce sync.Once
+	mu        sync.RWMutex
+	closed    bool
+	done      chan struct{}
+}
+
+// NewAsyncListener starts passing events on to l, with room for queueSize
+// events which have not been handled yet. Close must be called once it is no
+// longer needed.
+func NewAsyncListener(l EventListener, queueSize int, logger logrus.FieldLogger) *AsyncListener {
+	if queueSize < 1 {
+		queueSize = 1
+	}
+	a := &AsyncListener{
+		listener: l,
+		logger:   logger,
+		queue:    make(chan func(EventListener), queueSize),
+		done:     make(chan struct{}),
+	}
+	enterrors.GoWrapper(a.run, logger)
+	return a
+}
+
+func (a *AsyncListener) run() {
+	defer close(a.done)
+	for event := range a.queue {
+		// a panicking listener must not stop the events which follow
+		if err := enterrors.GoWrapperWithBlock(func() { event(a.listener) }, a.logger); err != nil {
+			a.logger.WithField("action", "schema_event").WithError(err).Error("schema event listener failed")
+		}
+	}
+}
+
+func (a *AsyncListener) enqueue(event func(EventListener)) {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+	if a.closed {
+		return
+	}
+	select {
+	case a.queue <- event:
+	default:
+		a.dropped.Add(1)
+	}
+}
+
+// Dropped returns the number of events dropped because the queue was full
+func (a *AsyncListener) Dropped() uint64 {
+	return a.dropped.Load()
+}
+
+// Close stops accepting events and waits until the queued ones are handled
+func (a *AsyncListener) Close() {
+	a.closeOnce.Do(func() {
+		a.mu.Lock()
+		a.closed = true
+		close(a.queue)
+		a.mu.Unlock()
+	})
+	<-a.done
+}
+
+func (a *AsyncListener) OnClassAdded(class *models.Class) {
+	a.enqueue(func(l EventListener) { l.OnClassAdded(class) })
+}
+
+func (a *AsyncListener) OnClassUpdated(class *models.Class) {
+	a.enqueue(func(l EventListener) { l.OnClassUpdated(class) })
+}
+
+func (a *AsyncListener) OnClassDeleted(name string) {
+	a.enqueue(func(l EventListener) { l.OnClassDeleted(name) })
+}
+
+func (a *AsyncListener) OnPropertyAdded(class string, prop *models.Property) {
+	a.enqueue(func(l EventListener) { l.OnPropertyAdded(class, prop) })
+}
+
+func (a *AsyncListener) OnTenantsAdded(class string, tenants []string) {
+	a.enqueue(func(l EventListener) { l.OnTenantsAdded(class, tenants) })
+}
+
+func (a *AsyncListener) OnTenantsUpdated(class string, tenants []string) {
+	a.enqueue(func(l EventListener) { l.OnTenantsUpdated(class, tenants) })
+}
+
+func (a *AsyncListener) OnTenantsDeleted(class string, tenants []string) {
+	a.enqueue(func(l EventListener) { l.OnTenantsDeleted(class, tenants) })
+}
@@ -0,0 +1,231 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+type recordingListener struct {
+	NoopEventListener
+	sync.Mutex
+	deletedClasses []string
+	deletedTenants map[string][]string
+	onDelete       func()
+}
+
+func (r *recordingListener) OnClassDeleted(name string) {
+	if r.onDelete != nil {
+		r.onDelete()
+	}
+	r.Lock()
+	defer r.Unlock()
+	r.deletedClasses = append(r.deletedClasses, name)
+}
+
+func (r *recordingListener) OnTenantsDeleted(class string, tenants []string) {
+	r.Lock()
+	defer r.Unlock()
+	if r.deletedTenants == nil {
+		r.deletedTenants = map[string][]string{}
+	}
+	r.deletedTenants[class] = append(r.deletedTenants[class], tenants...)
+}
+
+func (r *recordingListener) classes() []string {
+	r.Lock()
+	defer r.Unlock()
+	return append([]string(nil), r.deletedClasses...)
+}
+
+func TestHandler_EventListeners(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("listeners are called once per successful write", func(t *testing.T) {
+		handler, fakeSchemaManager := newTestHandler(t, &fakeDB{})
+		fakeSchemaManager.On("DeleteClass", "C1").Return(nil)
+		fakeSchemaManager.On("DeleteClass", "C2").Return(errors.New("any error"))
+		fakeSchemaManager.On("DeleteTenants", "C1", mock.Anything).Return(nil)
+		fakeSchemaManager.On("ReadOnlyClass", "C1").Return(&models.Class{Class: "C1"})
+
+		l1, l2 := &recordingListener{}, &recordingListener{}
+		id1 := handler.RegisterListener(l1)
+		handler.RegisterListener(l2)
+
+		_, err := handler.DeleteClass(ctx, nil, "c1")
+		require.NoError(t, err)
+		_, err = handler.DeleteClass(ctx, nil, "C2")
+		require.Error(t, err)
+		require.NoError(t, handler.DeleteTenants(ctx, nil, "C1", []string{"T1", "T2"}))
+
+		for _, l := range []*recordingListener{l1, l2} {
+			assert.Equal(t, []string{"C1"}, l.classes())
+			assert.Equal(t, map[string][]string{"C1": {"T1", "T2"}}, l.deletedTenants)
+		}
+
+		handler.UnregisterListener(id1)
+		_, err = handler.DeleteClass(ctx, nil, "C1")
+		require.NoError(t, err)
+		assert.Equal(t, []string{"C1"}, l1.classes())
+		assert.Equal(t, []string{"C1", "C1"}, l2.classes())
+	})
+
+	t.Run("listeners of types which aren't comparable", func(t *testing.T) {
+		handler, _ := newTestHandler(t, &fakeDB{})
+		type listener struct {
+			NoopEventListener
+			names []string
+		}
+
+		id1 := handler.RegisterListener(listener{names: []string{"a"}})
+		id2 := handler.RegisterListener(listener{names: []string{"b"}})
+		assert.NotEqual(t, id1, id2)
+		assert.NotPanics(t, func() { handler.UnregisterListener(id1) })
+		require.Len(t, handler.listeners.listeners, 1)
+		assert.Equal(t, id2, handler.listeners.listeners[0].id)
+	})
+
+	t.Run("a panicking listener does not affect the others", func(t *testing.T) {
+		handler, fakeSchemaManager := newTestHandler(t, &fakeDB{})
+		fakeSchemaManager.On("DeleteClass", "C1").Return(nil)
+
+		panicking := &recordingListener{onDelete: func() { panic("listener failed") }}
+		l := &recordingListener{}
+		handler.RegisterListener(panicking)
+		handler.RegisterListener(l)
+
+		_, err := handler.DeleteClass(ctx, nil, "C1")
+		require.NoError(t, err)
+		assert.Empty(t, panicking.classes())
+		assert.Equal(t, []string{"C1"}, l.classes())
+	})
+
+	t.Run("slow listeners are abandoned once the context is done", func(t *testing.T) {
+		handler, fakeSchemaManager := newTestHandler(t, &fakeDB{})
+		fakeSchemaManager.On("DeleteClass", "C1").Return(nil)
+
+		unblock := make(chan struct{})
+		defer close(unblock)
+		blocking := &recordingListener{onDelete: func() { <-unblock }}
+		l := &recordingListener{}
+		handler.RegisterListener(blocking)
+		handler.RegisterListener(l)
+
+		cctx, cancel := context.WithTimeout(ctx, 50*time.Millisecond)
+		defer cancel()
+		_, err := handler.DeleteClass(cctx, nil, "C1")
+		require.NoError(t, err)
+		assert.Empty(t, l.classes())
+	})
+
+	t.Run("transactions notify once committed", func(t *testing.T) {
+		handler, fakeSchemaManager := newTestHandler(t, &fakeDB{})
+		l := &recordingListener{}
+		handler.RegisterListener(l)
+
+		committed := &fakeTxn{}
+		committed.On("DeleteClass", "C1").Return(nil)
+		committed.On("Commit").Return(nil)
+		fakeSchemaManager.On("Begin").Return(committed, nil).Once()
+		err := handler.WithTransaction(ctx, nil, func(th TxnHandler) error {
+			_, err := th.DeleteClass(ctx, nil, "C1")
+			assert.Empty(t, l.classes())
+			return err
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"C1"}, l.classes())
+
+		rolledBack := &fakeTxn{}
+		rolledBack.On("DeleteClass", "C2").Return(nil)
+		rolledBack.On("Rollback").Return(nil)
+		fakeSchemaManager.On("Begin").Return(rolledBack, nil).Once()
+		err = handler.WithTransaction(ctx, nil, func(th TxnHandler) error {
+			if _, err := th.DeleteClass(ctx, nil, "C2"); err != nil {
+				return err
+			}
+			return errors.New("any error")
+		})
+		require.Error(t, err)
+		assert.Equal(t, []string{"C1"}, l.classes())
+	})
+}
+
+func TestAsyncListener(t *testing.T) {
+	logger, _ := test.NewNullLogger()
+
+	t.Run("events are dropped while the queue is full", func(t *testing.T) {
+		started := make(chan struct{}, 3)
+		unblock := make(chan struct{})
+		l := &recordingListener{onDelete: func() {
+			started <- struct{}{}
+			<-unblock
+		}}
+		async := NewAsyncListener(l, 1, logger)
+
+		async.OnClassDeleted("C1")
+		<-started
+		// C1 is being handled, C2 is queued and C3 doesn't fit
+		async.OnClassDeleted("C2")
+		async.OnClassDeleted("C3")
+		assert.Equal(t, uint64(1), async.Dropped())
+
+		close(unblock)
+		async.Close()
+		assert.Equal(t, []string{"C1", "C2"}, l.classes())
+
+		// events after Close are ignored
+		async.OnClassDeleted("C4")
+		assert.Equal(t, []string{"C1", "C2"}, l.classes())
+	})
+
+	t.Run("a panicking listener keeps receiving events", func(t *testing.T) {
+		calls := 0
+		l := &recordingListener{onDelete: func() {
+			calls++
+			if calls == 1 {
+				panic("listener failed")
+			}
+		}}
+		async := NewAsyncListener(l, 2, logger)
+		async.OnClassDeleted("C1")
+		async.OnClassDeleted("C2")
+		async.Close()
+		assert.Equal(t, []string{"C2"}, l.classes())
+	})
+
+	t.Run("writes don't wait for the listener", func(t *testing.T) {
+		handler, fakeSchemaManager := newTestHandler(t, &fakeDB{})
+		fakeSchemaManager.On("DeleteClass", "C1").Return(nil)
+
+		unblock := make(chan struct{})
+		l := &recordingListener{onDelete: func() { <-unblock }}
+		async := NewAsyncListener(l, 1, logger)
+		handler.RegisterListener(async)
+
+		_, err := handler.DeleteClass(context.Background(), nil, "C1")
+		require.NoError(t, err)
+		close(unblock)
+		async.Close()
+		assert.Equal(t, []string{"C1"}, l.classes())
+		assert.Equal(t, uint64(0), async.Dropped())
+	})
+}
@@ -15,12 +15,17 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"math/rand"
 	"sync"
+	"sync/atomic"
+	"time"
 
 	"github.com/sirupsen/logrus"
 	"github.com/weaviate/weaviate/cluster/proto/api"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/schema"
+	schemaConfig "github.com/weaviate/weaviate/entities/schema/config"
 )
 
 type executor struct {
@@ -29,9 +34,30 @@ type executor struct {
 
 	callbacksLock sync.RWMutex
 	callbacks     []func(updatedSchema schema.Schema)
+	// listeners are notified about the class changes applied on this node,
+	// see RegisterEventListener
+	listeners []EventListener
 
 	logger          logrus.FieldLogger
 	restoreClassDir func(string) error
+
+	// shardUpdateDelay returns how long to wait before updating the vector
+	// index configs of the i-th local shard, see UpdateVectorIndexConfig
+	shardUpdateDelay func(i int) time.Duration
+	// vectorIndexConfigVersion numbers the vector index config updates, so
+	// that shards don't go back to older configs
+	vectorIndexConfigVersion atomic.Uint64
+}
+
+const (
+	// shardUpdateStagger and shardUpdateJitter spread the vector index config
+	// updates of the local shards over time
+	shardUpdateStagger = 100 * time.Millisecond
+	shardUpdateJitter  = 50 * time.Millisecond
+)
+
+func staggeredShardUpdateDelay(i int) time.Duration {
+	return time.Duration(i)*shardUpdateStagger + time.Duration(rand.Int63n(int64(shardUpdateJitter)))
 }
 
 // NewManager creates a new manager
@@ -39,10 +65,11 @@ func NewExecutor(migrator Migrator, sr SchemaReader,
 	logger logrus.FieldLogger, classBackupDir func(string) error,
 ) *executor {
 	return &executor{
-		migrator:        migrator,
-		logger:          logger,
-		schemaReader:    sr,
-		restoreClassDir: classBackupDir,
+		migrator:         migrator,
+		logger:           logger,
+		schemaReader:     sr,
+		restoreClassDir:  classBackupDir,
+		shardUpdateDelay: staggeredShardUpdateDelay,
 	}
 }
 
@@ -76,6 +103,14 @@ func (e *executor) AddClass(pl api.AddClassRequest) error {
 	if err := e.migrator.AddClass(ctx, pl.Class, pl.State); err != nil {
 		return fmt.Errorf("apply add class: %w", err)
 	}
+	if pl.Class != nil && pl.Class.CompactionConfig != nil {
+		if err := e.migrator.SetClassCompactionConfig(ctx, pl.Class.Class, *pl.Class.CompactionConfig); err != nil {
+			return fmt.Errorf("apply add class: compaction config: %w", err)
+		}
+	}
+	if pl.Class != nil {
+		e.notify(func(l EventListener) { l.OnClassAdded(pl.Class) })
+	}
 	return nil
 }
 
@@ -89,6 +124,19 @@ func (e *executor) UpdateClass(req api.UpdateClassRequest) error {
 	className := req.Class.Class
 	ctx := context.Background()
 
+	if req.MultiTenancyFrom != nil {
+		// the index is recreated with the updated settings
+		var to models.MultiTenancyConfig
+		if req.Class.MultiTenancyConfig != nil {
+			to = *req.Class.MultiTenancyConfig
+		}
+		if err := e.migrator.MigrateMultiTenancy(ctx, className, *req.MultiTenancyFrom, to); err != nil {
+			return fmt.Errorf("migrate multi-tenancy: %w", err)
+		}
+		e.notify(func(l EventListener) { l.OnClassUpdated(req.Class) })
+		return nil
+	}
+
 	if hasTargetVectors(req.Class) {
 		if err := e.migrator.UpdateVectorIndexConfigs(ctx, className, asVectorIndexConfigs(req.Class)); err != nil {
 			return fmt.Errorf("vector index configs update: %w", err)
@@ -109,6 +157,62 @@ func (e *executor) UpdateClass(req api.UpdateClassRequest) error {
 		return fmt.Errorf("update replication config: %w", err)
 	}
 
+	// a nil config restores the global settings
+	var compaction models.ClassCompactionConfig
+	if req.Class.CompactionConfig != nil {
+		compaction = *req.Class.CompactionConfig
+	}
+	if err := e.migrator.SetClassCompactionConfig(ctx, className, compaction); err != nil {
+		return fmt.Errorf("update compaction config: %w", err)
+	}
+
+	e.notify(func(l EventListener) { l.OnClassUpdated(req.Class) })
+	return nil
+}
+
+// UpdateVectorIndexConfig applies the updated vector index configs to the
+// local shards of the class. Rather than reloading all shards at once, which
+// is what UpdateClass does, the shards are updated one after another in the
+// background. Shards created in the meantime use the updated configs already.
+// Each shard is updated to the configs of the index at the time, updates which
+// arrive after a later one are skipped, so that shards never go back to
+// older configs.
+func (e *executor) UpdateVectorIndexConfig(className string, req api.UpdateVectorIndexConfigRequest) error {
+	ctx := context.Background()
+	cls := e.schemaReader.ReadOnlyClass(className)
+	if cls == nil {
+		return fmt.Errorf("class %q not found", className)
+	}
+
+	// the schema holds the parsed configs already
+	all := vectorIndexConfigsByName(cls)
+	updated := make(map[string]schemaConfig.VectorIndexConfig, len(req.VectorIndexConfigs))
+	for name := range req.VectorIndexConfigs {
+		updated[name] = all[name]
+	}
+	if err := e.migrator.SetVectorIndexConfigs(ctx, className, updated); err != nil {
+		return fmt.Errorf("vector index configs update: %w", err)
+	}
+	e.notify(func(l EventListener) { l.OnClassUpdated(cls) })
+
+	state := e.schemaReader.CopyShardingState(className)
+	if state == nil {
+		return nil
+	}
+	version := e.vectorIndexConfigVersion.Add(1)
+	for i, shard := range state.AllLocalPhysicalShards() {
+		delay := e.shardUpdateDelay(i)
+		enterrors.GoWrapper(func() {
+			time.Sleep(delay)
+			if err := e.migrator.UpdateShardVectorIndexConfigs(ctx, className, shard, version); err != nil {
+				e.logger.WithFields(logrus.Fields{
+					"action": "update_vector_index_config",
+					"class":  className,
+					"shard":  shard,
+				}).WithError(err).Error("vector index configs update")
+			}
+		}, e.logger)
+	}
 	return nil
 }
 
@@ -134,9 +238,18 @@ func (e *executor) DeleteClass(cls string, hasFrozen bool) error {
 		"class":  cls,
 	}).Debug("deleting class")
 
+	e.notify(func(l EventListener) { l.OnClassDeleted(cls) })
 	return nil
 }
 
+func (e *executor) UnloadClass(cls string) error {
+	return e.migrator.UnloadClass(context.Background(), cls)
+}
+
+func (e *executor) DropUnloadedClass(cls string, hasFrozen bool) error {
+	return e.migrator.DropUnloadedClass(context.Background(), cls, hasFrozen)
+}
+
 func (e *executor) AddProperty(className string, req api.AddPropertyRequest) error {
 	ctx := context.Background()
 	if err := e.migrator.AddProperty(ctx, className, req.Properties...); err != nil {
@@ -147,6 +260,9 @@ func (e *executor) AddProperty(className string, req api.AddPropertyRequest) err
 		"action": "add_property",
 		"class":  className,
 	}).Debug("adding property")
+	for _, prop := range req.Properties {
+		e.notify(func(l EventListener) { l.OnPropertyAdded(className, prop) })
+	}
 	return nil
 }
 
@@ -247,6 +363,11 @@ func (e *executor) UpdateShardStatus(req *api.UpdateShardStatusRequest) error {
 	return e.migrator.UpdateShardStatus(ctx, req.Class, req.Shard, req.Status, req.SchemaVersion)
 }
 
+func (e *executor) MoveShard(req *api.MoveShardRequest) error {
+	ctx := context.Background()
+	return e.migrator.MoveShard(ctx, req.Class, req.Shard, req.FromNode, req.ToNode)
+}
+
 func (e *executor) GetShardsStatus(class, tenant string) (models.ShardStatusList, error) {
 	ctx := context.Background()
 	shardsStatus, err := e.migrator.GetShardsStatus(ctx, class, tenant)
@@ -254,18 +375,78 @@ func (e *executor) GetShardsStatus(class, tenant string) (models.ShardStatusList
 		return nil, err
 	}
 
+	compactionStats, err := e.migrator.GetShardsCompactionStats(ctx, class, tenant)
+	if err != nil {
+		return nil, err
+	}
+
 	resp := models.ShardStatusList{}
 
 	for name, status := range shardsStatus {
 		resp = append(resp, &models.ShardStatusGetResponse{
-			Name:   name,
-			Status: status,
+			Name:            name,
+			Status:          status,
+			CompactionStats: compactionStats[name],
 		})
 	}
 
 	return resp, nil
 }
 
+func (e *executor) ShardObjectCount(class, shard string) (int64, error) {
+	return e.migrator.ShardObjectCount(context.Background(), class, shard)
+}
+
+func (e *executor) TenantLastActivity(class, tenant string) (time.Time, error) {
+	return e.migrator.TenantLastActivity(class, tenant), nil
+}
+
+func (e *executor) TenantQueriesInFlight(class, tenant string) (int64, error) {
+	return e.migrator.TenantQueriesInFlight(class, tenant), nil
+}
+
+func (e *executor) ReindexInvertedIndex(ctx context.Context, class string) error {
+	return e.migrator.ReindexInvertedIndex(ctx, class)
+}
+
+func (e *executor) CopyTenantObjects(ctx context.Context, sourceClass, targetClass, tenant string,
+	progress func(copied int64),
+) error {
+	return e.migrator.CopyTenantObjects(ctx, sourceClass, targetClass, tenant, progress)
+}
+
+func (e *executor) TenantDataPurged(ctx context.Context, class, tenant string, nodes []string) (bool, error) {
+	return e.migrator.TenantDataPurged(ctx, class, tenant, nodes)
+}
+
+func (e *executor) ReplicaObjectCounts(ctx context.Context, class string) (map[string]map[string]int64, error) {
+	return e.migrator.ReplicaObjectCounts(ctx, class)
+}
+
+func (e *executor) BackfillProperty(ctx context.Context, class, property string, defaultValue interface{}) (string, error) {
+	return e.migrator.BackfillProperty(ctx, class, property, defaultValue)
+}
+
+func (e *executor) BackfillStatus(jobID string) (BackfillStatus, bool) {
+	return e.migrator.BackfillStatus(jobID)
+}
+
+func (e *executor) BackfillVectors(ctx context.Context, class string, opts BackfillVectorOptions) (string, error) {
+	return e.migrator.BackfillVectors(ctx, class, opts)
+}
+
+func (e *executor) VectorBackfillStatus(jobID string) (VectorBackfillStatus, bool) {
+	return e.migrator.VectorBackfillStatus(jobID)
+}
+
+func (e *executor) PrewarmTenantIndex(ctx context.Context, class, tenant string, nodes []string) error {
+	return e.migrator.PrewarmTenantIndex(ctx, class, tenant, nodes)
+}
+
+func (e *executor) ComputePropertyStats(ctx context.Context, class, property string) (*PropertyStats, error) {
+	return e.migrator.ComputePropertyStats(ctx, class, property)
+}
+
 func (e *executor) TriggerSchemaUpdateCallbacks() {
 	e.callbacksLock.RLock()
 	defer e.callbacksLock.RUnlock()
@@ -287,3 +468,22 @@ func (e *executor) RegisterSchemaUpdateCallback(callback func(updatedSchema sche
 
 	e.callbacks = append(e.callbacks, callback)
 }
+
+// RegisterEventListener registers l to be notified about every class change
+// once it is applied on this node, whichever node served the request. The
+// listeners are called while the raft log is applied, so they must not block.
+func (e *executor) RegisterEventListener(l EventListener) {
+	e.callbacksLock.Lock()
+	defer e.callbacksLock.Unlock()
+
+	e.listeners = append(e.listeners, l)
+}
+
+func (e *executor) notify(event func(EventListener)) {
+	e.callbacksLock.RLock()
+	defer e.callbacksLock.RUnlock()
+
+	for _, l := range e.listeners {
+		event(l)
+	}
+}
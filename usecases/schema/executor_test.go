@@ -15,14 +15,19 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/sirupsen/logrus/hooks/test"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 	"github.com/weaviate/weaviate/cluster/proto/api"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/schema"
+	schemaConfig "github.com/weaviate/weaviate/entities/schema/config"
 	"github.com/weaviate/weaviate/entities/vectorindex/flat"
+	"github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+	"github.com/weaviate/weaviate/usecases/sharding"
 )
 
 var (
@@ -65,6 +70,15 @@ func TestExecutor(t *testing.T) {
 		x := newMockExecutor(migrator, store)
 		assert.Nil(t, x.AddClass(api.AddClassRequest{}))
 	})
+	t.Run("AddClassWithCompactionConfig", func(t *testing.T) {
+		migrator := &fakeMigrator{}
+		migrator.On("AddClass", Anything, Anything, Anything).Return(nil)
+		compaction := models.ClassCompactionConfig{CompactionIntervalSeconds: 60}
+		migrator.On("SetClassCompactionConfig", Anything, "A", compaction).Return(nil)
+		x := newMockExecutor(migrator, store)
+		assert.Nil(t, x.AddClass(api.AddClassRequest{Class: &models.Class{Class: "A", CompactionConfig: &compaction}}))
+		migrator.AssertExpectations(t)
+	})
 	t.Run("AddClassWithError", func(t *testing.T) {
 		migrator := &fakeMigrator{}
 		migrator.On("AddClass", Anything, Anything, Anything).Return(ErrAny)
@@ -90,10 +104,24 @@ func TestExecutor(t *testing.T) {
 		migrator.On("UpdateVectorIndexConfig", Anything, "A", Anything).Return(nil)
 		migrator.On("UpdateInvertedIndexConfig", Anything, "A", Anything).Return(nil)
 		migrator.On("UpdateReplicationConfig", context.Background(), "A", false).Return(nil)
+		migrator.On("SetClassCompactionConfig", Anything, "A", models.ClassCompactionConfig{}).Return(nil)
 
 		x := newMockExecutor(migrator, store)
 		assert.Nil(t, x.UpdateClass(api.UpdateClassRequest{Class: cls}))
 	})
+	t.Run("UpdateCompactionConfig", func(t *testing.T) {
+		migrator := &fakeMigrator{}
+		migrator.On("UpdateVectorIndexConfig", Anything, "A", Anything).Return(nil)
+		migrator.On("UpdateInvertedIndexConfig", Anything, "A", Anything).Return(nil)
+		compaction := models.ClassCompactionConfig{MaxSegmentSizeMB: 10, MinMergeSegments: 4}
+		migrator.On("SetClassCompactionConfig", Anything, "A", compaction).Return(ErrAny)
+
+		updated := *cls
+		updated.CompactionConfig = &compaction
+		x := newMockExecutor(migrator, store)
+		assert.ErrorIs(t, x.UpdateClass(api.UpdateClassRequest{Class: &updated}), ErrAny)
+		migrator.AssertExpectations(t)
+	})
 
 	t.Run("UpdateVectorIndexConfig", func(t *testing.T) {
 		migrator := &fakeMigrator{}
@@ -195,9 +223,13 @@ func TestExecutor(t *testing.T) {
 		migrator := &fakeMigrator{}
 		status := map[string]string{"A": "B"}
 		migrator.On("GetShardsStatus", Anything, "A", "").Return(status, nil)
+		stats := map[string]*models.CompactionStats{"A": {SegmentCount: 3}}
+		migrator.On("GetShardsCompactionStats", Anything, "A", "").Return(stats, nil)
 		x := newMockExecutor(migrator, store)
-		_, err := x.GetShardsStatus("A", "")
+		shards, err := x.GetShardsStatus("A", "")
 		assert.Nil(t, err)
+		require.Len(t, shards, 1)
+		assert.Equal(t, stats["A"], shards[0].CompactionStats)
 	})
 	t.Run("GetShardsStatusError", func(t *testing.T) {
 		migrator := &fakeMigrator{}
@@ -215,3 +247,96 @@ func TestExecutor(t *testing.T) {
 		assert.Nil(t, x.UpdateShardStatus(req))
 	})
 }
+
+func TestExecutorUpdateVectorIndexConfig(t *testing.T) {
+	cfg := hnsw.NewDefaultUserConfig()
+	cfg.EF = 128
+	cls := &models.Class{
+		Class: "A",
+		VectorConfig: map[string]models.VectorConfig{
+			"title":   {VectorIndexType: "hnsw", VectorIndexConfig: cfg},
+			"content": {VectorIndexType: "hnsw", VectorIndexConfig: hnsw.NewDefaultUserConfig()},
+		},
+	}
+	state := &sharding.State{Physical: map[string]sharding.Physical{
+		"S1": {Name: "S1", BelongsToNodes: []string{"node-1"}},
+		"S2": {Name: "S2", BelongsToNodes: []string{"node-2"}},
+		"S3": {Name: "S3", BelongsToNodes: []string{"node-1", "node-2"}},
+	}}
+	state.SetLocalName("node-1")
+	store := &fakeSchemaManager{}
+	store.On("ReadOnlyClass", "A", mock.Anything).Return(cls)
+	store.On("CopyShardingState", "A").Return(state)
+	req := api.UpdateVectorIndexConfigRequest{VectorIndexConfigs: map[string]any{"title": map[string]any{"ef": 128}}}
+	updated := map[string]schemaConfig.VectorIndexConfig{"title": cfg}
+
+	t.Run("updates the local shards one after another", func(t *testing.T) {
+		migrator := &fakeMigrator{}
+		migrator.On("SetVectorIndexConfigs", Anything, "A", updated).Return(nil).Once()
+		done := make(chan string, 2)
+		migrator.On("UpdateShardVectorIndexConfigs", Anything, "A", Anything, uint64(1)).Return(ErrAny).
+			Run(func(args mock.Arguments) { done <- args.String(2) })
+		x := newMockExecutor(migrator, store)
+		var delays []int
+		x.shardUpdateDelay = func(i int) time.Duration {
+			delays = append(delays, i)
+			return 0
+		}
+
+		assert.Nil(t, x.UpdateVectorIndexConfig("A", req))
+		assert.ElementsMatch(t, []string{"S1", "S3"}, []string{<-done, <-done})
+		assert.Equal(t, []int{0, 1}, delays)
+		migrator.AssertExpectations(t)
+	})
+
+	t.Run("later updates have higher versions", func(t *testing.T) {
+		migrator := &fakeMigrator{}
+		migrator.On("SetVectorIndexConfigs", Anything, "A", updated).Return(nil)
+		versions := make(chan uint64, 4)
+		migrator.On("UpdateShardVectorIndexConfigs", Anything, "A", Anything, Anything).Return(nil).
+			Run(func(args mock.Arguments) { versions <- args.Get(3).(uint64) })
+		x := newMockExecutor(migrator, store)
+		x.shardUpdateDelay = func(int) time.Duration { return 0 }
+
+		assert.Nil(t, x.UpdateVectorIndexConfig("A", req))
+		assert.Nil(t, x.UpdateVectorIndexConfig("A", req))
+		assert.ElementsMatch(t, []uint64{1, 1, 2, 2}, []uint64{<-versions, <-versions, <-versions, <-versions})
+	})
+
+	t.Run("legacy vector index", func(t *testing.T) {
+		legacy := &models.Class{Class: "B", VectorIndexType: "hnsw", VectorIndexConfig: cfg}
+		store := &fakeSchemaManager{}
+		store.On("ReadOnlyClass", "B", mock.Anything).Return(legacy)
+		store.On("CopyShardingState", "B").Return(state)
+		migrator := &fakeMigrator{}
+		migrator.On("SetVectorIndexConfigs", Anything, "B",
+			map[string]schemaConfig.VectorIndexConfig{"": cfg}).Return(nil).Once()
+		done := make(chan string, 2)
+		migrator.On("UpdateShardVectorIndexConfigs", Anything, "B", Anything, uint64(1)).Return(nil).
+			Run(func(args mock.Arguments) { done <- args.String(2) })
+		x := newMockExecutor(migrator, store)
+		x.shardUpdateDelay = func(int) time.Duration { return 0 }
+
+		legacyReq := api.UpdateVectorIndexConfigRequest{VectorIndexConfigs: map[string]any{"": map[string]any{"ef": 128}}}
+		assert.Nil(t, x.UpdateVectorIndexConfig("B", legacyReq))
+		assert.ElementsMatch(t, []string{"S1", "S3"}, []string{<-done, <-done})
+		migrator.AssertExpectations(t)
+	})
+
+	t.Run("fails if the configs cannot be set", func(t *testing.T) {
+		migrator := &fakeMigrator{}
+		migrator.On("SetVectorIndexConfigs", Anything, "A", updated).Return(ErrAny)
+		x := newMockExecutor(migrator, store)
+
+		assert.ErrorIs(t, x.UpdateVectorIndexConfig("A", req), ErrAny)
+		migrator.AssertNotCalled(t, "UpdateShardVectorIndexConfigs", Anything, Anything, Anything, Anything)
+	})
+
+	t.Run("staggered delay", func(t *testing.T) {
+		for i := 0; i < 3; i++ {
+			delay := staggeredShardUpdateDelay(i)
+			assert.GreaterOrEqual(t, delay, time.Duration(i)*shardUpdateStagger)
+			assert.Less(t, delay, time.Duration(i)*shardUpdateStagger+shardUpdateJitter)
+		}
+	})
+}
@@ -15,11 +15,13 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"time"
 
 	"github.com/stretchr/testify/mock"
 	command "github.com/weaviate/weaviate/cluster/proto/api"
 	clusterSchema "github.com/weaviate/weaviate/cluster/schema"
 	"github.com/weaviate/weaviate/entities/models"
+	schemaConfig "github.com/weaviate/weaviate/entities/schema/config"
 	"github.com/weaviate/weaviate/entities/versioned"
 	"github.com/weaviate/weaviate/usecases/fakes"
 	"github.com/weaviate/weaviate/usecases/sharding"
@@ -28,6 +30,8 @@ import (
 type fakeSchemaManager struct {
 	mock.Mock
 	countClassEqual bool
+	// storageCandidates are returned by StorageCandidates if set
+	storageCandidates []string
 }
 
 func (f *fakeSchemaManager) AddClass(_ context.Context, cls *models.Class, ss *sharding.State) (uint64, error) {
@@ -45,6 +49,13 @@ func (f *fakeSchemaManager) UpdateClass(_ context.Context, cls *models.Class, ss
 	return 0, args.Error(0)
 }
 
+func (f *fakeSchemaManager) UpdateVectorIndexConfig(_ context.Context, class string,
+	configs map[string]schemaConfig.VectorIndexConfig,
+) (uint64, error) {
+	args := f.Called(class, configs)
+	return 0, args.Error(0)
+}
+
 func (f *fakeSchemaManager) DeleteClass(_ context.Context, name string) (uint64, error) {
 	args := f.Called(name)
 	return 0, args.Error(0)
@@ -60,6 +71,46 @@ func (f *fakeSchemaManager) UpdateShardStatus(c_ context.Context, class, shard,
 	return 0, args.Error(0)
 }
 
+func (f *fakeSchemaManager) SoftDeleteClass(_ context.Context, name string, retainUntil time.Time) (uint64, error) {
+	args := f.Called(name, retainUntil)
+	return 0, args.Error(0)
+}
+
+func (f *fakeSchemaManager) RestoreDeletedClass(_ context.Context, name string) (uint64, error) {
+	args := f.Called(name)
+	return 0, args.Error(0)
+}
+
+func (f *fakeSchemaManager) ReserveClassObjects(_ context.Context, class string, count int64) (uint64, error) {
+	args := f.Called(class, count)
+	return 0, args.Error(0)
+}
+
+func (f *fakeSchemaManager) SetClassObjectCount(_ context.Context, class string, count int64) (uint64, error) {
+	args := f.Called(class, count)
+	return 0, args.Error(0)
+}
+
+func (f *fakeSchemaManager) RecordBatchDelete(_ context.Context, req *command.RecordBatchDeleteRequest) (uint64, error) {
+	args := f.Called(req)
+	return 0, args.Error(0)
+}
+
+func (f *fakeSchemaManager) PurgeDeletedClass(_ context.Context, name string) (uint64, error) {
+	args := f.Called(name)
+	return 0, args.Error(0)
+}
+
+func (f *fakeSchemaManager) PurgeTenant(_ context.Context, class, tenant string, purgedAt time.Time) (uint64, error) {
+	args := f.Called(class, tenant)
+	return 0, args.Error(0)
+}
+
+func (f *fakeSchemaManager) MoveShard(_ context.Context, class, shard, fromNode, toNode string) (uint64, error) {
+	args := f.Called(class, shard, fromNode, toNode)
+	return 0, args.Error(0)
+}
+
 func (f *fakeSchemaManager) AddTenants(_ context.Context, class string, req *command.AddTenantsRequest) (uint64, error) {
 	args := f.Called(class, req)
 	return 0, args.Error(0)
@@ -75,6 +126,15 @@ func (f *fakeSchemaManager) DeleteTenants(_ context.Context, class string, req *
 	return 0, args.Error(0)
 }
 
+func (f *fakeSchemaManager) Begin() (Txn, error) {
+	args := f.Called()
+	txn := args.Get(0)
+	if txn == nil {
+		return nil, args.Error(1)
+	}
+	return txn.(Txn), args.Error(1)
+}
+
 func (f *fakeSchemaManager) Join(ctx context.Context, nodeID, raftAddr string, voter bool) error {
 	args := f.Called(ctx, nodeID, raftAddr, voter)
 	return args.Error(0)
@@ -85,6 +145,11 @@ func (f *fakeSchemaManager) Remove(ctx context.Context, nodeID string) error {
 	return args.Error(0)
 }
 
+func (f *fakeSchemaManager) LeaderWithID() (string, string) {
+	args := f.Called()
+	return args.String(0), args.String(1)
+}
+
 func (f *fakeSchemaManager) Stats() map[string]any {
 	return map[string]any{}
 }
@@ -93,6 +158,11 @@ func (f *fakeSchemaManager) StoreSchemaV1() error {
 	return nil
 }
 
+func (f *fakeSchemaManager) Snapshot() error {
+	args := f.Called()
+	return args.Error(0)
+}
+
 func (f *fakeSchemaManager) ClassEqual(name string) string {
 	if f.countClassEqual {
 		args := f.Called(name)
@@ -117,6 +187,9 @@ func (f *fakeSchemaManager) ClassInfo(class string) (ci clusterSchema.ClassInfo)
 }
 
 func (f *fakeSchemaManager) StorageCandidates() []string {
+	if f.storageCandidates != nil {
+		return f.storageCandidates
+	}
 	return []string{"node-1"}
 }
 
@@ -130,6 +203,11 @@ func (f *fakeSchemaManager) QuerySchema() (models.Schema, error) {
 	return args.Get(0).(models.Schema), args.Error(1)
 }
 
+func (f *fakeSchemaManager) LinearizableRead(ctx context.Context) error {
+	args := f.Called(ctx)
+	return args.Error(0)
+}
+
 func (f *fakeSchemaManager) QueryReadOnlyClasses(classes ...string) (map[string]versioned.Class, error) {
 	args := f.Called(classes)
 
@@ -146,6 +224,11 @@ func (f *fakeSchemaManager) QueryTenants(class string, tenants []string) ([]*mod
 	return args.Get(0).([]*models.TenantResponse), 0, args.Error(2)
 }
 
+func (f *fakeSchemaManager) QueryTenantsPage(class string, tenants []string, after string, limit int) ([]*models.TenantResponse, int, uint64, error) {
+	args := f.Called(class, tenants, after, limit)
+	return args.Get(0).([]*models.TenantResponse), args.Int(1), 0, args.Error(2)
+}
+
 func (f *fakeSchemaManager) QueryShardOwner(class, shard string) (string, uint64, error) {
 	args := f.Called(class, shard)
 	return args.Get(0).(string), 0, args.Error(0)
@@ -162,7 +245,7 @@ func (f *fakeSchemaManager) QueryTenantsShards(class string, tenants ...string)
 
 func (f *fakeSchemaManager) QueryShardingState(class string) (*sharding.State, uint64, error) {
 	args := f.Called(class)
-	return args.Get(0).(*sharding.State), 0, args.Error(0)
+	return args.Get(0).(*sharding.State), 0, args.Error(1)
 }
 
 func (f *fakeSchemaManager) ReadOnlyClass(class string) *models.Class {
@@ -184,10 +267,25 @@ func (f *fakeSchemaManager) ReadOnlyClassWithVersion(ctx context.Context, class
 }
 
 func (f *fakeSchemaManager) ReadOnlySchema() models.Schema {
+	// the schema is read to find child classes on most writes, tests which
+	// don't set it up get an empty schema
+	if !f.expects("ReadOnlySchema") {
+		return models.Schema{}
+	}
 	args := f.Called()
 	return args.Get(0).(models.Schema)
 }
 
+// expects reports whether a return value is set up for method
+func (f *fakeSchemaManager) expects(method string) bool {
+	for _, call := range f.ExpectedCalls {
+		if call.Method == method {
+			return true
+		}
+	}
+	return false
+}
+
 func (f *fakeSchemaManager) CopyShardingState(class string) *sharding.State {
 	args := f.Called(class)
 	return args.Get(0).(*sharding.State)
@@ -243,8 +341,171 @@ func (f *fakeSchemaManager) GetShardsStatus(class, tenant string) (models.ShardS
 	return args.Get(0).(models.ShardStatusList), args.Error(1)
 }
 
+func (f *fakeSchemaManager) ShardObjectCount(class, shard string) (int64, error) {
+	args := f.Called(class, shard)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (f *fakeSchemaManager) TenantLastActivity(class, tenant string) (time.Time, error) {
+	args := f.Called(class, tenant)
+	return args.Get(0).(time.Time), args.Error(1)
+}
+
+func (f *fakeSchemaManager) TenantQueriesInFlight(class, tenant string) (int64, error) {
+	args := f.Called(class, tenant)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (f *fakeSchemaManager) TenantDataPurged(ctx context.Context, class, tenant string, nodes []string) (bool, error) {
+	args := f.Called(class, tenant, nodes)
+	return args.Bool(0), args.Error(1)
+}
+
+func (f *fakeSchemaManager) ReplicaObjectCounts(ctx context.Context, class string) (map[string]map[string]int64, error) {
+	args := f.Called(ctx, class)
+	return args.Get(0).(map[string]map[string]int64), args.Error(1)
+}
+
+func (f *fakeSchemaManager) ReindexInvertedIndex(ctx context.Context, class string) error {
+	args := f.Called(ctx, class)
+	return args.Error(0)
+}
+
+func (f *fakeSchemaManager) CopyTenantObjects(ctx context.Context, sourceClass, targetClass, tenant string,
+	progress func(copied int64),
+) error {
+	args := f.Called(sourceClass, targetClass, tenant)
+	progress(args.Get(0).(int64))
+	return args.Error(1)
+}
+
+func (f *fakeSchemaManager) BackfillProperty(ctx context.Context, class, property string, defaultValue interface{}) (string, error) {
+	args := f.Called(class, property, defaultValue)
+	return args.String(0), args.Error(1)
+}
+
+func (f *fakeSchemaManager) BackfillStatus(jobID string) (BackfillStatus, bool) {
+	args := f.Called(jobID)
+	return args.Get(0).(BackfillStatus), args.Bool(1)
+}
+
+func (f *fakeSchemaManager) BackfillVectors(ctx context.Context, class string, opts BackfillVectorOptions) (string, error) {
+	args := f.Called(class, opts)
+	return args.String(0), args.Error(1)
+}
+
+func (f *fakeSchemaManager) VectorBackfillStatus(jobID string) (VectorBackfillStatus, bool) {
+	args := f.Called(jobID)
+	return args.Get(0).(VectorBackfillStatus), args.Bool(1)
+}
+
+func (f *fakeSchemaManager) PrewarmTenantIndex(ctx context.Context, class, tenant string, nodes []string) error {
+	args := f.Called(class, tenant, nodes)
+	return args.Error(0)
+}
+
+func (f *fakeSchemaManager) ComputePropertyStats(ctx context.Context, class, property string) (*PropertyStats, error) {
+	args := f.Called(class, property)
+	return args.Get(0).(*PropertyStats), args.Error(1)
+}
+
+func (f *fakeSchemaManager) InvalidateShardObjectCounts(class string, shards ...string) {
+	f.Called(class, shards)
+}
+
+func (f *fakeSchemaManager) SchemaVersion() uint64 {
+	args := f.Called()
+	return args.Get(0).(uint64)
+}
+
+func (f *fakeSchemaManager) ClassObjectCount(class string) (int64, error) {
+	args := f.Called(class)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (f *fakeSchemaManager) ReadOnlyDeletedClasses() []clusterSchema.DeletedClass {
+	args := f.Called()
+	return args.Get(0).([]clusterSchema.DeletedClass)
+}
+
+func (f *fakeSchemaManager) SchemaChangelog(since uint64, limit int) []clusterSchema.SchemaChangeEntry {
+	args := f.Called(since, limit)
+	return args.Get(0).([]clusterSchema.SchemaChangeEntry)
+}
+
+func (f *fakeSchemaManager) BatchDeleteHistory(q clusterSchema.BatchDeleteHistoryQuery) ([]clusterSchema.BatchDeleteHistoryEntry, bool) {
+	args := f.Called(q)
+	return args.Get(0).([]clusterSchema.BatchDeleteHistoryEntry), args.Bool(1)
+}
+
 func (f *fakeSchemaManager) WaitForUpdate(ctx context.Context, schemaVersion uint64) error {
-	return nil
+	return ctx.Err()
+}
+
+type fakeTxn struct {
+	mock.Mock
+}
+
+func (f *fakeTxn) AddClass(_ context.Context, cls *models.Class, ss *sharding.State) (uint64, error) {
+	args := f.Called(cls, ss)
+	return 0, args.Error(0)
+}
+
+func (f *fakeTxn) UpdateClass(_ context.Context, cls *models.Class, ss *sharding.State) (uint64, error) {
+	args := f.Called(cls, ss)
+	return 0, args.Error(0)
+}
+
+func (f *fakeTxn) UpdateVectorIndexConfig(_ context.Context, class string,
+	configs map[string]schemaConfig.VectorIndexConfig,
+) (uint64, error) {
+	args := f.Called(class, configs)
+	return 0, args.Error(0)
+}
+
+func (f *fakeTxn) DeleteClass(_ context.Context, name string) (uint64, error) {
+	args := f.Called(name)
+	return 0, args.Error(0)
+}
+
+func (f *fakeTxn) AddProperty(_ context.Context, class string, p ...*models.Property) (uint64, error) {
+	args := f.Called(class, p)
+	return 0, args.Error(0)
+}
+
+func (f *fakeTxn) UpdateShardStatus(_ context.Context, class, shard, status string) (uint64, error) {
+	args := f.Called(class, shard, status)
+	return 0, args.Error(0)
+}
+
+func (f *fakeTxn) MoveShard(_ context.Context, class, shard, fromNode, toNode string) (uint64, error) {
+	args := f.Called(class, shard, fromNode, toNode)
+	return 0, args.Error(0)
+}
+
+func (f *fakeTxn) AddTenants(_ context.Context, class string, req *command.AddTenantsRequest) (uint64, error) {
+	args := f.Called(class, req)
+	return 0, args.Error(0)
+}
+
+func (f *fakeTxn) UpdateTenants(_ context.Context, class string, req *command.UpdateTenantsRequest) (uint64, error) {
+	args := f.Called(class, req)
+	return 0, args.Error(0)
+}
+
+func (f *fakeTxn) DeleteTenants(_ context.Context, class string, req *command.DeleteTenantsRequest) (uint64, error) {
+	args := f.Called(class, req)
+	return 0, args.Error(0)
+}
+
+func (f *fakeTxn) Commit() error {
+	args := f.Called()
+	return args.Error(0)
+}
+
+func (f *fakeTxn) Rollback() error {
+	args := f.Called()
+	return args.Error(0)
 }
 
 type fakeStore struct {
@@ -0,0 +1,58 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// DOT renders g in the DOT language of Graphviz. Edges of cycles are red.
+func (g *DependencyGraph) DOT() string {
+	var b strings.Builder
+	b.WriteString("digraph schema {\n")
+	for _, node := range g.Nodes {
+		fmt.Fprintf(&b, "  %s;\n", strconv.Quote(node))
+	}
+	for _, e := range g.Edges {
+		attrs := "label=" + strconv.Quote(e.Property)
+		if g.inCycle(e) {
+			attrs += ", color=red"
+		}
+		fmt.Fprintf(&b, "  %s -> %s [%s];\n", strconv.Quote(e.From), strconv.Quote(e.To), attrs)
+	}
+	b.WriteString("}\n")
+	return b.String()
+}
+
+// Mermaid renders g as Mermaid flowchart. Edges of cycles are red.
+func (g *DependencyGraph) Mermaid() string {
+	var b strings.Builder
+	b.WriteString("flowchart LR\n")
+	for _, node := range g.Nodes {
+		// class names are valid Mermaid ids, they start with a capital letter
+		// and only contain letters, digits and underscores
+		fmt.Fprintf(&b, "  %s\n", node)
+	}
+	var cycleEdges []string
+	for i, e := range g.Edges {
+		fmt.Fprintf(&b, "  %s -->|%s| %s\n", e.From, e.Property, e.To)
+		if g.inCycle(e) {
+			cycleEdges = append(cycleEdges, strconv.Itoa(i))
+		}
+	}
+	if len(cycleEdges) > 0 {
+		fmt.Fprintf(&b, "  linkStyle %s stroke:red\n", strings.Join(cycleEdges, ","))
+	}
+	return b.String()
+}
@@ -0,0 +1,215 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
+	"golang.org/x/text/cases"
+	"golang.org/x/text/language"
+)
+
+// ExportGraphQLSDL returns the classes of the schema as GraphQL SDL. Every
+// class becomes a type with a field per property and the Query type has a
+// Get<Class> and an Aggregate<Class> field per class. The types of
+// geoCoordinates, phoneNumber, reference and nested properties are named
+// like the ones of the GraphQL API. Only the types are exported, not the
+// arguments of the GraphQL API.
+func (h *Handler) ExportGraphQLSDL(principal *models.Principal) (string, error) {
+	if err := h.Authorizer.Authorize(principal, authorization.READ, authorization.CollectionsMetadata()...); err != nil {
+		return "", err
+	}
+	return graphQLSDL(h.schemaReader.ReadOnlySchema().Classes), nil
+}
+
+func graphQLSDL(classes []*models.Class) string {
+	known := make(map[string]bool, len(classes))
+	for _, class := range classes {
+		known[class.Class] = true
+	}
+
+	w := &sdlWriter{known: known}
+	for _, class := range classes {
+		w.objectType(class.Description, class.Class, w.propertyFields(class))
+	}
+
+	queries := make([]sdlField, 0, 2*len(classes))
+	for _, class := range classes {
+		queries = append(queries,
+			sdlField{name: "Get" + class.Class, typ: "[" + class.Class + "]"},
+			sdlField{name: "Aggregate" + class.Class, typ: "[Aggregate" + class.Class + "]"})
+		w.objectType("", "Aggregate"+class.Class, []sdlField{{name: "meta", typ: "AggregateMeta"}})
+	}
+	if len(classes) > 0 {
+		w.objectType("", "AggregateMeta", []sdlField{{name: "count", typ: "Int"}})
+	}
+	w.objectType("", "Query", queries)
+	return w.String()
+}
+
+type sdlField struct {
+	description string
+	name        string
+	typ         string
+}
+
+// sdlWriter writes type definitions separated by blank lines. The types of
+// fields are written before the type containing them.
+type sdlWriter struct {
+	strings.Builder
+	known map[string]bool
+}
+
+func (w *sdlWriter) separate() {
+	if w.Len() > 0 {
+		w.WriteString("\n")
+	}
+}
+
+func (w *sdlWriter) objectType(description, name string, fields []sdlField) {
+	if len(fields) == 0 {
+		// types without fields are invalid, the _additional field of the
+		// GraphQL API is always there
+		fields = []sdlField{{name: "_additional", typ: "String"}}
+	}
+	w.separate()
+	writeSDLDescription(&w.Builder, "", description)
+	fmt.Fprintf(w, "type %s {\n", name)
+	for _, f := range fields {
+		writeSDLDescription(&w.Builder, "  ", f.description)
+		fmt.Fprintf(w, "  %s: %s\n", f.name, f.typ)
+	}
+	w.WriteString("}\n")
+}
+
+func (w *sdlWriter) propertyFields(class *models.Class) []sdlField {
+	fields := make([]sdlField, 0, len(class.Properties))
+	for _, prop := range class.Properties {
+		typ := w.propertyType(class.Class, prop)
+		if typ == "" {
+			continue
+		}
+		fields = append(fields, sdlField{description: prop.Description, name: prop.Name, typ: typ})
+	}
+	return fields
+}
+
+// propertyType returns the GraphQL type of prop and writes the types it
+// needs. It is empty for references to unknown classes only.
+func (w *sdlWriter) propertyType(className string, prop *models.Property) string {
+	if dt, ok := schema.AsPrimitive(prop.DataType); ok {
+		switch dt {
+		case schema.DataTypeGeoCoordinates:
+			name := fmt.Sprintf("%s%sGeoCoordinatesObj", className, prop.Name)
+			w.objectType("", name, []sdlField{{name: "latitude", typ: "Float"}, {name: "longitude", typ: "Float"}})
+			return name
+		case schema.DataTypePhoneNumber:
+			name := fmt.Sprintf("%s%sPhoneNumberObj", className, prop.Name)
+			w.objectType("", name, []sdlField{
+				{name: "input", typ: "String"},
+				{name: "internationalFormatted", typ: "String"},
+				{name: "nationalFormatted", typ: "String"},
+				{name: "national", typ: "Int"},
+				{name: "valid", typ: "Boolean"},
+				{name: "countryCode", typ: "Int"},
+				{name: "defaultCountry", typ: "String"},
+			})
+			return name
+		default:
+			return graphQLScalar(dt)
+		}
+	}
+	if _, ok := schema.AsNested(prop.DataType); ok {
+		return w.nestedType(className, prop.Name, prop.DataType, prop.NestedProperties)
+	}
+
+	var targets []string
+	for _, target := range prop.DataType {
+		if w.known[target] {
+			targets = append(targets, target)
+		}
+	}
+	if len(targets) == 0 {
+		return ""
+	}
+	name := fmt.Sprintf("%s%sObj", className, cases.Title(language.Und, cases.NoLower).String(prop.Name))
+	w.separate()
+	fmt.Fprintf(w, "union %s = %s\n", name, strings.Join(targets, " | "))
+	return "[" + name + "]"
+}
+
+func (w *sdlWriter) nestedType(className, prefix string, dataType []string, props []*models.NestedProperty) string {
+	fields := make([]sdlField, 0, len(props))
+	for _, prop := range props {
+		var typ string
+		if _, ok := schema.AsNested(prop.DataType); ok {
+			typ = w.nestedType(className, prefix+"_"+prop.Name, prop.DataType, prop.NestedProperties)
+		} else if dt, ok := schema.AsPrimitive(prop.DataType); ok {
+			typ = graphQLScalar(dt)
+		} else {
+			continue
+		}
+		fields = append(fields, sdlField{description: prop.Description, name: prop.Name, typ: typ})
+	}
+
+	name := fmt.Sprintf("%s_%s_object", className, prefix)
+	w.objectType("", name, fields)
+	if dataType[0] == schema.DataTypeObjectArray.String() {
+		return "[" + name + "]"
+	}
+	return name
+}
+
+// graphQLScalar returns the scalar type the GraphQL API uses for dt, dates,
+// uuids and blobs are strings
+func graphQLScalar(dt schema.DataType) string {
+	if base, ok := schema.IsArrayType(dt); ok {
+		return "[" + graphQLScalar(base) + "]"
+	}
+	switch dt {
+	case schema.DataTypeInt:
+		return "Int"
+	case schema.DataTypeNumber:
+		return "Float"
+	case schema.DataTypeBoolean:
+		return "Boolean"
+	default:
+		return "String"
+	}
+}
+
+// writeSDLDescription writes description as GraphQL string, escaping quotes,
+// backslashes and control characters
+func writeSDLDescription(b *strings.Builder, indent, description string) {
+	if description == "" {
+		return
+	}
+	b.WriteString(indent + `"`)
+	for _, r := range description {
+		switch {
+		case r == '"' || r == '\\':
+			b.WriteRune('\\')
+			b.WriteRune(r)
+		case r == '\n':
+			b.WriteString(`\n`)
+		case r < 0x20:
+			fmt.Fprintf(b, `\u%04x`, r)
+		default:
+			b.WriteRune(r)
+		}
+	}
+	b.WriteString("\"\n")
+}
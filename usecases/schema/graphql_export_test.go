@@ -0,0 +1,144 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tailor-inc/graphql/language/ast"
+	"github.com/tailor-inc/graphql/language/parser"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+func TestHandler_ExportGraphQLSDL(t *testing.T) {
+	classes := []*models.Class{
+		{
+			Class:       "Article",
+			Description: `An "article" with a \ in its description`,
+			Properties: []*models.Property{
+				{Name: "title", DataType: []string{"text"}, Description: "The title\nof the article"},
+				{Name: "tags", DataType: []string{"text[]"}},
+				{Name: "wordCount", DataType: []string{"int"}},
+				{Name: "score", DataType: []string{"number[]"}},
+				{Name: "published", DataType: []string{"boolean"}},
+				{Name: "date", DataType: []string{"date"}},
+				{Name: "location", DataType: []string{"geoCoordinates"}},
+				{Name: "phone", DataType: []string{"phoneNumber"}},
+				{Name: "writtenBy", DataType: []string{"Author", "Publisher"}},
+				{Name: "missing", DataType: []string{"Unknown"}},
+				{
+					Name: "meta", DataType: []string{"object"},
+					NestedProperties: []*models.NestedProperty{
+						{Name: "source", DataType: []string{"text"}},
+						{
+							Name: "revisions", DataType: []string{"object[]"},
+							NestedProperties: []*models.NestedProperty{{Name: "number", DataType: []string{"int"}}},
+						},
+					},
+				},
+			},
+		},
+		{Class: "Author", Properties: []*models.Property{{Name: "name", DataType: []string{"text"}}}},
+		{Class: "Publisher"},
+	}
+
+	handler, fakeSchemaManager := newTestHandler(t, &fakeDB{})
+	fakeSchemaManager.On("ReadOnlySchema").Return(models.Schema{Classes: classes})
+
+	sdl, err := handler.ExportGraphQLSDL(nil)
+	require.Nil(t, err)
+
+	doc, err := parser.Parse(parser.ParseParams{Source: sdl})
+	require.Nil(t, err, sdl)
+
+	objects := map[string]*ast.ObjectDefinition{}
+	unions := map[string]*ast.UnionDefinition{}
+	for _, def := range doc.Definitions {
+		switch d := def.(type) {
+		case *ast.ObjectDefinition:
+			objects[d.Name.Value] = d
+		case *ast.UnionDefinition:
+			unions[d.Name.Value] = d
+		}
+	}
+	fieldTypes := func(name string) map[string]string {
+		require.Contains(t, objects, name)
+		types := map[string]string{}
+		for _, f := range objects[name].Fields {
+			types[f.Name.Value] = typeString(f.Type)
+		}
+		return types
+	}
+
+	assert.Equal(t, map[string]string{
+		"title":     "String",
+		"tags":      "[String]",
+		"wordCount": "Int",
+		"score":     "[Float]",
+		"published": "Boolean",
+		"date":      "String",
+		"location":  "ArticlelocationGeoCoordinatesObj",
+		"phone":     "ArticlephonePhoneNumberObj",
+		"writtenBy": "[ArticleWrittenByObj]",
+		"meta":      "Article_meta_object",
+	}, fieldTypes("Article"))
+	assert.Equal(t, `An "article" with a \ in its description`, objects["Article"].Description.Value)
+	assert.Equal(t, "The title\nof the article", objects["Article"].Fields[0].Description.Value)
+
+	assert.Equal(t, map[string]string{"latitude": "Float", "longitude": "Float"},
+		fieldTypes("ArticlelocationGeoCoordinatesObj"))
+	assert.Contains(t, fieldTypes("ArticlephonePhoneNumberObj"), "internationalFormatted")
+	assert.Equal(t, map[string]string{"source": "String", "revisions": "[Article_meta_revisions_object]"},
+		fieldTypes("Article_meta_object"))
+	assert.Equal(t, map[string]string{"number": "Int"}, fieldTypes("Article_meta_revisions_object"))
+
+	require.Contains(t, unions, "ArticleWrittenByObj")
+	var members []string
+	for _, m := range unions["ArticleWrittenByObj"].Types {
+		members = append(members, m.Name.Value)
+	}
+	assert.Equal(t, []string{"Author", "Publisher"}, members)
+
+	// classes without properties still need a field to be valid
+	assert.Equal(t, map[string]string{"_additional": "String"}, fieldTypes("Publisher"))
+
+	assert.Equal(t, map[string]string{
+		"GetArticle": "[Article]", "AggregateArticle": "[AggregateArticle]",
+		"GetAuthor": "[Author]", "AggregateAuthor": "[AggregateAuthor]",
+		"GetPublisher": "[Publisher]", "AggregatePublisher": "[AggregatePublisher]",
+	}, fieldTypes("Query"))
+	assert.Equal(t, map[string]string{"meta": "AggregateMeta"}, fieldTypes("AggregateArticle"))
+}
+
+func TestHandler_ExportGraphQLSDL_EmptySchema(t *testing.T) {
+	handler, _ := newTestHandler(t, &fakeDB{})
+
+	sdl, err := handler.ExportGraphQLSDL(nil)
+	require.Nil(t, err)
+	_, err = parser.Parse(parser.ParseParams{Source: sdl})
+	require.Nil(t, err, sdl)
+}
+
+func typeString(typ ast.Type) string {
+	switch t := typ.(type) {
+	case *ast.List:
+		return "[" + typeString(t.Type) + "]"
+	case *ast.NonNull:
+		return typeString(t.Type) + "!"
+	case *ast.Named:
+		return t.Name.Value
+	default:
+		return ""
+	}
+}
@@ -15,18 +15,23 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 	command "github.com/weaviate/weaviate/cluster/proto/api"
 	clusterSchema "github.com/weaviate/weaviate/cluster/schema"
+	clusterTypes "github.com/weaviate/weaviate/cluster/types"
+	"github.com/weaviate/weaviate/entities/jobs"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/modulecapabilities"
 	"github.com/weaviate/weaviate/entities/schema"
 	schemaConfig "github.com/weaviate/weaviate/entities/schema/config"
 	"github.com/weaviate/weaviate/entities/versioned"
 	"github.com/weaviate/weaviate/usecases/auth/authorization"
+	authzerrors "github.com/weaviate/weaviate/usecases/auth/authorization/errors"
 	"github.com/weaviate/weaviate/usecases/config"
+	"github.com/weaviate/weaviate/usecases/replica"
 	"github.com/weaviate/weaviate/usecases/sharding"
 )
 
@@ -42,28 +47,45 @@ type SchemaManager interface {
 	AddClass(ctx context.Context, cls *models.Class, ss *sharding.State) (uint64, error)
 	RestoreClass(ctx context.Context, cls *models.Class, ss *sharding.State) (uint64, error)
 	UpdateClass(ctx context.Context, cls *models.Class, ss *sharding.State) (uint64, error)
+	UpdateVectorIndexConfig(ctx context.Context, class string, configs map[string]schemaConfig.VectorIndexConfig) (uint64, error)
 	DeleteClass(ctx context.Context, name string) (uint64, error)
+	SoftDeleteClass(ctx context.Context, name string, retainUntil time.Time) (uint64, error)
+	RestoreDeletedClass(ctx context.Context, name string) (uint64, error)
+	PurgeDeletedClass(ctx context.Context, name string) (uint64, error)
+	ReserveClassObjects(ctx context.Context, class string, count int64) (uint64, error)
+	SetClassObjectCount(ctx context.Context, class string, count int64) (uint64, error)
+	RecordBatchDelete(ctx context.Context, req *command.RecordBatchDeleteRequest) (uint64, error)
 	AddProperty(ctx context.Context, class string, p ...*models.Property) (uint64, error)
 	UpdateShardStatus(ctx context.Context, class, shard, status string) (uint64, error)
+	MoveShard(ctx context.Context, class, shard, fromNode, toNode string) (uint64, error)
 	AddTenants(ctx context.Context, class string, req *command.AddTenantsRequest) (uint64, error)
 	UpdateTenants(ctx context.Context, class string, req *command.UpdateTenantsRequest) (uint64, error)
 	DeleteTenants(ctx context.Context, class string, req *command.DeleteTenantsRequest) (uint64, error)
+	PurgeTenant(ctx context.Context, class, tenant string, purgedAt time.Time) (uint64, error)
+	SchemaTransaction
 
 	// Cluster related operations
 	Join(_ context.Context, nodeID, raftAddr string, voter bool) error
 	Remove(_ context.Context, nodeID string) error
 	Stats() map[string]any
+	LeaderWithID() (string, string)
 	StorageCandidates() []string
 	StoreSchemaV1() error
+	Snapshot() error
 
 	// Strongly consistent schema read. These endpoints will emit a query to the leader to ensure that the data is read
 	// from an up to date schema.
 	QueryReadOnlyClasses(names ...string) (map[string]versioned.Class, error)
 	QuerySchema() (models.Schema, error)
 	QueryTenants(class string, tenants []string) ([]*models.TenantResponse, uint64, error)
+	QueryTenantsPage(class string, tenants []string, after string, limit int) ([]*models.TenantResponse, int, uint64, error)
 	QueryShardOwner(class, shard string) (string, uint64, error)
 	QueryTenantsShards(class string, tenants ...string) (map[string]string, uint64, error)
 	QueryShardingState(class string) (*sharding.State, uint64, error)
+
+	// LinearizableRead blocks until the local schema has applied every change
+	// committed on the leader before the call.
+	LinearizableRead(ctx context.Context) error
 }
 
 // SchemaReader allows reading the local schema with or without using a schema version.
@@ -85,6 +107,15 @@ type SchemaReader interface {
 	ShardOwner(class, shard string) (string, error)
 	Read(class string, reader func(*models.Class, *sharding.State) error) error
 	GetShardsStatus(class, tenant string) (models.ShardStatusList, error)
+	ShardObjectCount(class, shard string) (int64, error)
+	TenantLastActivity(class, tenant string) (time.Time, error)
+	TenantQueriesInFlight(class, tenant string) (int64, error)
+	InvalidateShardObjectCounts(class string, shards ...string)
+	SchemaVersion() uint64
+	SchemaChangelog(since uint64, limit int) []clusterSchema.SchemaChangeEntry
+	BatchDeleteHistory(q clusterSchema.BatchDeleteHistoryQuery) ([]clusterSchema.BatchDeleteHistoryEntry, bool)
+	ReadOnlyDeletedClasses() []clusterSchema.DeletedClass
+	ClassObjectCount(class string) (int64, error)
 
 	// These schema reads function (...WithVersion) return the metadata once the local schema has caught up to the
 	// version parameter. If version is 0 is behaves exactly the same as eventual consistent reads.
@@ -99,10 +130,28 @@ type SchemaReader interface {
 	CopyShardingStateWithVersion(ctx context.Context, class string, version uint64) (*sharding.State, error)
 }
 
+// dataMigrator runs the operations on the stored objects of classes. Unlike
+// the SchemaReader they read or change data and not just schema metadata,
+// so the handler authorizes them before calling them. It is implemented by
+// the executor on top of the Migrator.
+type dataMigrator interface {
+	BackfillVectors(ctx context.Context, class string, opts BackfillVectorOptions) (string, error)
+	VectorBackfillStatus(jobID string) (VectorBackfillStatus, bool)
+	ReindexInvertedIndex(ctx context.Context, class string) error
+	CopyTenantObjects(ctx context.Context, sourceClass, targetClass, tenant string, progress func(copied int64)) error
+	TenantDataPurged(ctx context.Context, class, tenant string, nodes []string) (bool, error)
+	ReplicaObjectCounts(ctx context.Context, class string) (map[string]map[string]int64, error)
+	BackfillProperty(ctx context.Context, class, property string, defaultValue interface{}) (string, error)
+	BackfillStatus(jobID string) (BackfillStatus, bool)
+	PrewarmTenantIndex(ctx context.Context, class, tenant string, nodes []string) error
+	ComputePropertyStats(ctx context.Context, class, property string) (*PropertyStats, error)
+}
+
 type validator interface {
 	ValidateVectorIndexConfigUpdate(old, updated schemaConfig.VectorIndexConfig) error
 	ValidateInvertedIndexConfigUpdate(old, updated *models.InvertedIndexConfig) error
 	ValidateVectorIndexConfigsUpdate(old, updated map[string]schemaConfig.VectorIndexConfig) error
+	ValidateComputeExpression(expr string, sourcePropertyType string) error
 }
 
 // The handler manages API requests for manipulating class schemas.
@@ -116,7 +165,8 @@ type Handler struct {
 
 	cloud modulecapabilities.OffloadCloud
 
-	validator validator
+	validator    validator
+	dataMigrator dataMigrator
 
 	logger                  logrus.FieldLogger
 	Authorizer              authorization.Authorizer
@@ -128,6 +178,38 @@ type Handler struct {
 	invertedConfigValidator InvertedConfigValidator
 	scaleOut                scaleOut
 	parser                  Parser
+	metrics                 *SchemaHandlerMetrics
+	invertedIndexMigrations *jobs.Tracker[InvertedIndexMigration]
+	shardMoves              *jobs.Tracker[MoveStatus]
+	rebalances              *jobs.Tracker[RebalanceStatus]
+	tenantMigrations        *jobs.Tracker[TenantMigrationStatus]
+	tenantActivator         *tenantActivator
+	defaultConsistency      *defaultConsistency
+	listeners               *eventListeners
+	classValidators         *classValidators
+	schemaEvents            *SchemaEventBroadcaster
+	propertyAccess          *propertyAccess
+	propertyStats           *propertyStatsCache
+	// pendingEvents buffers the events of a transaction until it is
+	// committed, it is nil outside of transactions
+	pendingEvents *[]func(EventListener)
+
+	// replicationChecker reports the applied index of the other nodes, it is
+	// nil until WithReplicationChecker is called
+	replicationChecker replicationChecker
+	// backupScheduler is told about changed backup configs of classes, it is
+	// nil until WithBackupScheduler is called
+	backupScheduler backupScheduler
+	// kmsClient verifies the encryption keys of classes, it is nil until
+	// WithKMSClient is called
+	kmsClient kmsClient
+
+	// AutoActivateTenants turns inactive tenants of every class HOT when
+	// they are accessed, see Manager.TenantsShards
+	AutoActivateTenants bool
+	// SoftDelete makes DeleteClass keep the deleted classes for
+	// Schema.SoftDeleteRetentionDays, see RestoreDeletedClass
+	SoftDelete bool
 }
 
 // NewHandler creates a new handler
@@ -135,6 +217,7 @@ func NewHandler(
 	schemaReader SchemaReader,
 	schemaManager SchemaManager,
 	validator validator,
+	dataMigrator dataMigrator,
 	logger logrus.FieldLogger, authorizer authorization.Authorizer, config config.Config,
 	configParser VectorConfigParser, vectorizerValidator VectorizerValidator,
 	invertedConfigValidator InvertedConfigValidator,
@@ -148,6 +231,7 @@ func NewHandler(
 		schemaManager:           schemaManager,
 		parser:                  Parser{clusterState: clusterState, configParser: configParser, validator: validator},
 		validator:               validator,
+		dataMigrator:            dataMigrator,
 		logger:                  logger,
 		Authorizer:              authorizer,
 		configParser:            configParser,
@@ -157,6 +241,19 @@ func NewHandler(
 		clusterState:            clusterState,
 		scaleOut:                scaleoutManager,
 		cloud:                   cloud,
+		invertedIndexMigrations: jobs.NewTracker[InvertedIndexMigration](),
+		shardMoves:              jobs.NewTracker[MoveStatus](),
+		rebalances:              jobs.NewTracker[RebalanceStatus](),
+		tenantMigrations:        jobs.NewTracker[TenantMigrationStatus](),
+		tenantActivator:         newTenantActivator(config.Schema.AutoActivateTenantsTimeout),
+		AutoActivateTenants:     config.Schema.AutoActivateTenants,
+		SoftDelete:              config.Schema.SoftDelete,
+		defaultConsistency:      newDefaultConsistency(config.Schema.DefaultConsistencyLevel),
+		listeners:               newEventListeners(),
+		classValidators:         newClassValidators(),
+		schemaEvents:            NewSchemaEventBroadcaster(SchemaSubscriberBufferSize),
+		propertyAccess:          newPropertyAccess(),
+		propertyStats:           newPropertyStatsCache(config.Schema.PropertyStatsCacheTTL),
 	}
 
 	handler.scaleOut.SetSchemaReader(schemaReader)
@@ -164,14 +261,59 @@ func NewHandler(
 	return handler, nil
 }
 
-// GetSchema retrieves a locally cached copy of the schema
-func (h *Handler) GetSchema(principal *models.Principal) (schema.Schema, error) {
+// GetSchema retrieves a locally cached copy of the schema. With consistency
+// QUORUM or ALL the local copy is caught up with the leader first, so that it
+// contains every change committed before the call. ONE returns the local copy
+// as is. An empty consistency uses DefaultConsistencyLevel.
+func (h *Handler) GetSchema(principal *models.Principal, consistency replica.ConsistencyLevel) (schema.Schema, error) {
 	err := h.Authorizer.Authorize(principal, authorization.READ, authorization.CollectionsMetadata()...)
 	if err != nil {
 		return schema.Schema{}, err
 	}
 
-	return h.getSchema(), nil
+	if consistency == "" {
+		consistency = h.DefaultConsistencyLevel()
+	}
+	switch consistency {
+	case replica.One:
+	case replica.Quorum, replica.All:
+		if err := h.schemaManager.LinearizableRead(context.Background()); err != nil {
+			return schema.Schema{}, fmt.Errorf("could not read schema with consistency %s: %w", consistency, err)
+		}
+	default:
+		return schema.Schema{}, fmt.Errorf("unknown consistency level %q: %w", consistency, clusterSchema.ErrBadRequest)
+	}
+
+	s := h.getSchema()
+	s.Objects.MaxPropertiesPerClass = int64(h.config.Schema.MaxPropertiesPerClass)
+	return s, nil
+}
+
+// GetSchemaFiltered retrieves a locally cached copy of the schema that only
+// contains the classes the principal is authorized to read. Unlike GetSchema,
+// an unauthorized class is omitted from the result instead of failing the
+// whole request.
+func (h *Handler) GetSchemaFiltered(principal *models.Principal) (schema.Schema, error) {
+	s := h.schemaReader.ReadOnlySchema()
+
+	classes := make([]*models.Class, 0, len(s.Classes))
+	for _, class := range s.Classes {
+		// clone so the authorizer never shares memory with the schema copy
+		name := strings.Clone(class.Class)
+		err := h.Authorizer.Authorize(principal, authorization.READ, authorization.CollectionsMetadata(name)...)
+		if err != nil {
+			if errors.As(err, &authzerrors.Forbidden{}) {
+				continue
+			}
+			return schema.Schema{}, err
+		}
+		classes = append(classes, h.redactEncryptionKey(principal, class))
+	}
+	s.Classes = classes
+
+	return schema.Schema{
+		Objects: &s,
+	}, nil
 }
 
 // GetSchema retrieves a locally cached copy of the schema
@@ -181,18 +323,36 @@ func (h *Handler) GetConsistentSchema(principal *models.Principal, consistency b
 	}
 
 	if !consistency {
-		return h.getSchema(), nil
+		s := h.getSchema()
+		s.Objects.MaxPropertiesPerClass = int64(h.config.Schema.MaxPropertiesPerClass)
+		h.redactEncryptionKeys(principal, s.Objects)
+		return s, nil
 	}
 
 	if consistentSchema, err := h.schemaManager.QuerySchema(); err != nil {
 		return schema.Schema{}, fmt.Errorf("could not read schema with strong consistency: %w", err)
 	} else {
+		consistentSchema.MaxPropertiesPerClass = int64(h.config.Schema.MaxPropertiesPerClass)
+		h.redactEncryptionKeys(principal, &consistentSchema)
 		return schema.Schema{
 			Objects: &consistentSchema,
 		}, nil
 	}
 }
 
+// redactEncryptionKeys replaces the classes of s by copies without the key
+// ids principal may not see, see redactEncryptionKey
+func (h *Handler) redactEncryptionKeys(principal *models.Principal, s *models.Schema) {
+	if len(s.Classes) == 0 {
+		return
+	}
+	classes := make([]*models.Class, len(s.Classes))
+	for i, class := range s.Classes {
+		classes[i] = h.redactEncryptionKey(principal, class)
+	}
+	s.Classes = classes
+}
+
 // GetSchemaSkipAuth can never be used as a response to a user request as it
 // could leak the schema to an unauthorized user, is intended to be used for
 // non-user triggered processes, such as regular updates / maintenance / etc
@@ -205,6 +365,46 @@ func (h *Handler) getSchema() schema.Schema {
 	}
 }
 
+// WaitForSchemaConsistency blocks until the local schema has applied
+// targetVersion or ctx is done. The version is the one returned by schema
+// changes such as AddClass, DeleteClass and AddClassProperty.
+func (h *Handler) WaitForSchemaConsistency(ctx context.Context, targetVersion uint64) error {
+	if err := h.schemaReader.WaitForUpdate(ctx, targetVersion); err != nil {
+		return fmt.Errorf("wait for schema version %d: %w", targetVersion, err)
+	}
+	return nil
+}
+
+// SchemaChangeEntry is a single applied schema change, see GetSchemaChangelog
+type SchemaChangeEntry = clusterSchema.SchemaChangeEntry
+
+// GetSchemaChangelog returns up to limit schema changes applied after schema
+// version since, oldest first. Only the most recent changes are retained, a
+// limit of 0 returns all retained changes. The encryption keys of the
+// previous classes are redacted, see redactEncryptionKey.
+func (h *Handler) GetSchemaChangelog(principal *models.Principal, since uint64, limit int) ([]SchemaChangeEntry, error) {
+	if err := h.Authorizer.Authorize(principal, authorization.READ, authorization.CollectionsMetadata()...); err != nil {
+		return nil, err
+	}
+	if limit < 0 {
+		return nil, fmt.Errorf("limit must not be negative, got %d: %w", limit, clusterSchema.ErrBadRequest)
+	}
+	entries := h.schemaReader.SchemaChangelog(since, limit)
+	for i := range entries {
+		entries[i].Previous = h.redactEncryptionKey(principal, entries[i].Previous)
+	}
+	return entries, nil
+}
+
+// withActor attaches the principal to ctx so that it is recorded as the actor
+// of the resulting schema change
+func withActor(ctx context.Context, principal *models.Principal) context.Context {
+	if principal == nil {
+		return ctx
+	}
+	return clusterTypes.ContextWithActor(ctx, principal.Username)
+}
+
 func (h *Handler) Nodes() []string {
 	return h.clusterState.AllNames()
 }
@@ -221,7 +421,7 @@ func (h *Handler) UpdateShardStatus(ctx context.Context,
 		return 0, err
 	}
 
-	return h.schemaManager.UpdateShardStatus(ctx, class, shard, status)
+	return h.schemaManager.UpdateShardStatus(withActor(ctx, principal), class, shard, status)
 }
 
 func (h *Handler) ShardsStatus(ctx context.Context,
@@ -235,15 +435,37 @@ func (h *Handler) ShardsStatus(ctx context.Context,
 	return h.schemaReader.GetShardsStatus(class, shard)
 }
 
+// GetShardObjectCount returns the number of objects in a local shard. Counts are
+// cached until the shard is written to or its schema changes.
+func (h *Handler) GetShardObjectCount(ctx context.Context,
+	principal *models.Principal, class, shard string,
+) (int64, error) {
+	class = schema.UppercaseClassName(class)
+	err := h.Authorizer.Authorize(principal, authorization.READ, authorization.ShardsMetadata(class, shard)...)
+	if err != nil {
+		return 0, err
+	}
+
+	count, err := h.schemaReader.ShardObjectCount(class, shard)
+	if errors.Is(err, clusterSchema.ErrClassNotFound) || errors.Is(err, clusterSchema.ErrShardNotFound) {
+		return 0, fmt.Errorf("%w: %w", ErrNotFound, err)
+	}
+	return count, err
+}
+
 // JoinNode adds the given node to the cluster.
 // Node needs to reachable via memberlist/gossip.
 // If nodePort is an empty string, nodePort will be the default raft port.
 // If the node is not reachable using memberlist, an error is returned
 // If joining the node fails, an error is returned.
-func (h *Handler) JoinNode(ctx context.Context, node string, nodePort string, voter bool) error {
+// With opts.AutoRebalance shard replicas are moved to the node in the
+// background afterwards, see RebalanceStatus for the returned job ID.
+func (h *Handler) JoinNode(ctx context.Context, node string, nodePort string, voter bool,
+	opts JoinOptions,
+) (rebalanceJobID string, err error) {
 	nodeAddr, ok := h.clusterState.NodeHostname(node)
 	if !ok {
-		return fmt.Errorf("could not resolve addr for node id %v", node)
+		return "", fmt.Errorf("could not resolve addr for node id %v", node)
 	}
 	nodeAddr = strings.Split(nodeAddr, ":")[0]
 
@@ -252,9 +474,12 @@ func (h *Handler) JoinNode(ctx context.Context, node string, nodePort string, vo
 	}
 
 	if err := h.schemaManager.Join(ctx, node, nodeAddr+":"+nodePort, voter); err != nil {
-		return fmt.Errorf("node failed to join cluster: %w", err)
+		return "", fmt.Errorf("node failed to join cluster: %w", err)
 	}
-	return nil
+	if opts.AutoRebalance {
+		return h.rebalance(node), nil
+	}
+	return "", nil
 }
 
 // RemoveNode removes the given node from the cluster.
@@ -265,6 +490,20 @@ func (h *Handler) RemoveNode(ctx context.Context, node string) error {
 	return nil
 }
 
+// TriggerSnapshot takes a raft snapshot on this node, compacting its raft log.
+// Depending on the size of the schema this takes from a few milliseconds up to
+// a few seconds, during which schema changes are not applied on this node.
+func (h *Handler) TriggerSnapshot(ctx context.Context, principal *models.Principal) error {
+	if err := h.Authorizer.Authorize(principal, authorization.UPDATE, authorization.Cluster()); err != nil {
+		return err
+	}
+
+	if err := h.schemaManager.Snapshot(); err != nil {
+		return fmt.Errorf("trigger snapshot: %w", err)
+	}
+	return nil
+}
+
 // Statistics is used to return a map of various internal stats. This should only be used for informative purposes or debugging.
 func (h *Handler) Statistics() map[string]any {
 	return h.schemaManager.Stats()
@@ -18,6 +18,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
+	clusterSchema "github.com/weaviate/weaviate/cluster/schema"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/schema"
 	"github.com/weaviate/weaviate/usecases/config"
@@ -30,6 +31,7 @@ var schemaTests = []struct {
 	{name: "AddObjectClass", fn: testAddObjectClass},
 	{name: "AddObjectClassWithExplicitVectorizer", fn: testAddObjectClassExplicitVectorizer},
 	{name: "AddObjectClassWithImplicitVectorizer", fn: testAddObjectClassImplicitVectorizer},
+	{name: "AddObjectClassReturnsDefaults", fn: testAddObjectClassReturnsDefaults},
 	{name: "AddObjectClassWithWrongVectorizer", fn: testAddObjectClassWrongVectorizer},
 	{name: "AddObjectClassWithWrongIndexType", fn: testAddObjectClassWrongIndexType},
 	{name: "RemoveObjectClass", fn: testRemoveObjectClass},
@@ -55,7 +57,7 @@ func testAddObjectClass(t *testing.T, handler *Handler, fakeSchemaManager *fakeS
 		VectorIndexConfig: map[string]interface{}{},
 	}
 	fakeSchemaManager.On("AddClass", class, mock.Anything).Return(nil)
-	_, _, err := handler.AddClass(context.Background(), nil, class)
+	_, err := handler.AddClass(context.Background(), nil, class)
 	assert.Nil(t, err)
 }
 
@@ -73,7 +75,7 @@ func testAddObjectClassExplicitVectorizer(t *testing.T, handler *Handler, fakeSc
 		}},
 	}
 	fakeSchemaManager.On("AddClass", class, mock.Anything).Return(nil)
-	_, _, err := handler.AddClass(context.Background(), nil, class)
+	_, err := handler.AddClass(context.Background(), nil, class)
 	assert.Nil(t, err)
 }
 
@@ -91,10 +93,39 @@ func testAddObjectClassImplicitVectorizer(t *testing.T, handler *Handler, fakeSc
 
 	fakeSchemaManager.On("AddClass", mock.Anything, mock.Anything).Return(nil)
 
-	_, _, err := handler.AddClass(context.Background(), nil, class)
+	_, err := handler.AddClass(context.Background(), nil, class)
 	assert.Nil(t, err)
 }
 
+func testAddObjectClassReturnsDefaults(t *testing.T, handler *Handler, fakeSchemaManager *fakeSchemaManager) {
+	t.Parallel()
+	handler.config.Replication.MinimumFactor = 1
+
+	class := &models.Class{
+		Class:      "car",
+		Properties: []*models.Property{{DataType: schema.DataTypeText.PropString(), Name: "Dummy"}},
+	}
+	var stored *models.Class
+	fakeSchemaManager.On("AddClass", mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		stored = args.Get(0).(*models.Class)
+	})
+
+	res, err := handler.AddClass(context.Background(), nil, class)
+	require.Nil(t, err)
+	created := res.Class
+	assert.Equal(t, stored, created)
+	assert.Equal(t, "Car", created.Class)
+	assert.Equal(t, config.VectorizerModuleNone, created.Vectorizer)
+	assert.Equal(t, "hnsw", created.VectorIndexType)
+	require.NotNil(t, created.ReplicationConfig)
+	assert.Equal(t, int64(1), created.ReplicationConfig.Factor)
+	require.NotNil(t, created.InvertedIndexConfig)
+	require.NotNil(t, created.MultiTenancyConfig)
+	require.Len(t, created.Properties, 1)
+	assert.Equal(t, "dummy", created.Properties[0].Name)
+	assert.Equal(t, models.PropertyTokenizationWord, created.Properties[0].Tokenization)
+}
+
 func testAddObjectClassWrongVectorizer(t *testing.T, handler *Handler, fakeSchemaManager *fakeSchemaManager) {
 	t.Parallel()
 
@@ -108,7 +139,7 @@ func testAddObjectClassWrongVectorizer(t *testing.T, handler *Handler, fakeSchem
 		}},
 	}
 
-	_, _, err := handler.AddClass(context.Background(), nil, class)
+	_, err := handler.AddClass(context.Background(), nil, class)
 	assert.Error(t, err)
 }
 
@@ -125,7 +156,7 @@ func testAddObjectClassWrongIndexType(t *testing.T, handler *Handler, fakeSchema
 		}},
 	}
 
-	_, _, err := handler.AddClass(context.Background(), nil, class)
+	_, err := handler.AddClass(context.Background(), nil, class)
 	require.NotNil(t, err)
 	assert.Equal(t, "unrecognized or unsupported vectorIndexType \"vector-index-2-million\"", err.Error())
 }
@@ -144,12 +175,12 @@ func testRemoveObjectClass(t *testing.T, handler *Handler, fakeSchemaManager *fa
 	}
 
 	fakeSchemaManager.On("AddClass", class, mock.Anything).Return(nil)
-	_, _, err := handler.AddClass(context.Background(), nil, class)
+	_, err := handler.AddClass(context.Background(), nil, class)
 	require.Nil(t, err)
 
 	// Now delete the class
 	fakeSchemaManager.On("DeleteClass", "Car").Return(nil)
-	err = handler.DeleteClass(context.Background(), nil, "Car")
+	_, err = handler.DeleteClass(context.Background(), nil, "Car")
 	assert.Nil(t, err)
 }
 
@@ -168,7 +199,7 @@ func testCantAddSameClassTwice(t *testing.T, handler *Handler, fakeSchemaManager
 		},
 	}
 	fakeSchemaManager.On("AddClass", class, mock.Anything).Return(nil)
-	_, _, err := handler.AddClass(context.Background(), nil, class)
+	_, err := handler.AddClass(context.Background(), nil, class)
 	assert.Nil(t, err)
 
 	// Reset schema to simulate the class has been added
@@ -186,7 +217,7 @@ func testCantAddSameClassTwice(t *testing.T, handler *Handler, fakeSchemaManager
 	fakeSchemaManager.On("AddClass", class, mock.Anything).Return(ErrNotFound)
 
 	// Add it again
-	_, _, err = handler.AddClass(context.Background(), nil, class)
+	_, err = handler.AddClass(context.Background(), nil, class)
 	assert.NotNil(t, err)
 }
 
@@ -203,7 +234,7 @@ func testCantAddSameClassTwiceDifferentKinds(t *testing.T, handler *Handler, fak
 		},
 	}
 	fakeSchemaManager.On("AddClass", class, mock.Anything).Return(nil)
-	_, _, err := handler.AddClass(ctx, nil, class)
+	_, err := handler.AddClass(ctx, nil, class)
 	assert.Nil(t, err)
 
 	class.ModuleConfig = map[string]interface{}{
@@ -214,7 +245,7 @@ func testCantAddSameClassTwiceDifferentKinds(t *testing.T, handler *Handler, fak
 
 	// Add it again, but with a different kind.
 	fakeSchemaManager.On("AddClass", class, mock.Anything).Return(nil)
-	_, _, err = handler.AddClass(context.Background(), nil, class)
+	_, err = handler.AddClass(context.Background(), nil, class)
 	assert.NotNil(t, err)
 }
 
@@ -308,7 +339,7 @@ func testAddPropertyDuringCreation(t *testing.T, handler *Handler, fakeSchemaMan
 		Properties: properties,
 	}
 	fakeSchemaManager.On("AddClass", class, mock.Anything).Return(nil)
-	_, _, err := handler.AddClass(context.Background(), nil, class)
+	_, err := handler.AddClass(context.Background(), nil, class)
 	assert.Nil(t, err)
 }
 
@@ -319,7 +350,7 @@ func testAddInvalidPropertyDuringCreation(t *testing.T, handler *Handler, fakeSc
 		{Name: "color", DataType: []string{"blurp"}},
 	}
 
-	_, _, err := handler.AddClass(context.Background(), nil, &models.Class{
+	_, err := handler.AddClass(context.Background(), nil, &models.Class{
 		Class:      "Car",
 		Properties: properties,
 	})
@@ -333,7 +364,7 @@ func testAddInvalidPropertyWithEmptyDataTypeDuringCreation(t *testing.T, handler
 		{Name: "color", DataType: []string{""}},
 	}
 
-	_, _, err := handler.AddClass(context.Background(), nil, &models.Class{
+	_, err := handler.AddClass(context.Background(), nil, &models.Class{
 		Class:      "Car",
 		Properties: properties,
 	})
@@ -358,7 +389,7 @@ func testDropProperty(t *testing.T, handler *Handler, fakeSchemaManager *fakeSch
 		Properties: properties,
 	}
 	fakeSchemaManager.On("AddClass", class, mock.Anything).Return(nil)
-	_, _, err := handler.AddClass(context.Background(), nil, class)
+	_, err := handler.AddClass(context.Background(), nil, class)
 	assert.Nil(t, err)
 
 	// Now drop the property
@@ -379,3 +410,63 @@ func TestSchema(t *testing.T) {
 		}
 	})
 }
+
+func TestHandler_WaitForSchemaConsistency(t *testing.T) {
+	handler, _ := newTestHandler(t, &fakeDB{})
+
+	t.Run("version applied", func(t *testing.T) {
+		require.Nil(t, handler.WaitForSchemaConsistency(context.Background(), 3))
+	})
+
+	t.Run("context done", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		err := handler.WaitForSchemaConsistency(ctx, 3)
+		require.ErrorIs(t, err, context.Canceled)
+		assert.ErrorContains(t, err, "wait for schema version 3")
+	})
+}
+
+func TestHandler_ReadAtToken(t *testing.T) {
+	handler, _ := newTestHandler(t, &fakeDB{})
+
+	token, err := ParseReadYourWritesToken(AddClassResult{Version: 7}.Token().String())
+	require.Nil(t, err)
+	assert.Equal(t, ReadYourWritesToken(7), token)
+	require.Nil(t, handler.ReadAtToken(context.Background(), token))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	err = handler.ReadAtToken(ctx, token)
+	require.ErrorIs(t, err, context.Canceled)
+	assert.ErrorContains(t, err, "wait for consistency token 7")
+
+	_, err = ParseReadYourWritesToken("seven")
+	assert.ErrorContains(t, err, "invalid consistency token")
+}
+
+func TestHandler_GetSchemaChangelog(t *testing.T) {
+	handler, fakeSchemaManager := newTestHandler(t, &fakeDB{})
+
+	entries := []SchemaChangeEntry{{Version: 4, Operation: "ADD_CLASS", Class: "Car", Actor: "john"}}
+	fakeSchemaManager.On("SchemaChangelog", uint64(3), 10).Return(entries)
+	got, err := handler.GetSchemaChangelog(nil, 3, 10)
+	require.Nil(t, err)
+	assert.Equal(t, entries, got)
+
+	_, err = handler.GetSchemaChangelog(nil, 3, -1)
+	assert.ErrorIs(t, err, clusterSchema.ErrBadRequest)
+}
+
+func TestHandler_GetShardObjectCount(t *testing.T) {
+	handler, fakeSchemaManager := newTestHandler(t, &fakeDB{})
+	fakeSchemaManager.On("ShardObjectCount", "Car", "S1").Return(int64(3), nil)
+	fakeSchemaManager.On("ShardObjectCount", "Car", "S2").Return(int64(0), clusterSchema.ErrShardNotFound)
+
+	count, err := handler.GetShardObjectCount(context.Background(), nil, "car", "S1")
+	require.Nil(t, err)
+	assert.Equal(t, int64(3), count)
+
+	_, err = handler.GetShardObjectCount(context.Background(), nil, "Car", "S2")
+	assert.ErrorIs(t, err, ErrNotFound)
+}
@@ -15,6 +15,7 @@ import (
 	"context"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/sirupsen/logrus/hooks/test"
 	"github.com/stretchr/testify/mock"
@@ -45,7 +46,7 @@ func newTestHandler(t *testing.T, db clusterSchema.Indexer) (*Handler, *fakeSche
 		DefaultVectorDistanceMetric: "cosine",
 	}
 	handler, err := NewHandler(
-		schemaManager, schemaManager, &fakeValidator{}, logger, mocks.NewMockAuthorizer(),
+		schemaManager, schemaManager, &fakeValidator{}, schemaManager, logger, mocks.NewMockAuthorizer(),
 		cfg, dummyParseVectorConfig, vectorizerValidator, dummyValidateInvertedConfig,
 		&fakeModuleConfig{}, fakes.NewFakeClusterState(), &fakeScaleOutManager{}, nil)
 	require.Nil(t, err)
@@ -62,7 +63,7 @@ func newTestHandlerWithCustomAuthorizer(t *testing.T, db clusterSchema.Indexer,
 		},
 	}
 	handler, err := NewHandler(
-		metaHandler, metaHandler, &fakeValidator{}, logger, authorizer,
+		metaHandler, metaHandler, &fakeValidator{}, metaHandler, logger, authorizer,
 		cfg, dummyParseVectorConfig, vectorizerValidator, dummyValidateInvertedConfig,
 		&fakeModuleConfig{}, fakes.NewFakeClusterState(), &fakeScaleOutManager{}, nil)
 	require.Nil(t, err)
@@ -93,6 +94,10 @@ func (f *fakeDB) UpdateClass(cmd command.UpdateClassRequest) error {
 	return nil
 }
 
+func (f *fakeDB) UpdateVectorIndexConfig(class string, req command.UpdateVectorIndexConfigRequest) error {
+	return nil
+}
+
 func (f *fakeDB) UpdateIndex(cmd command.UpdateClassRequest) error {
 	return nil
 }
@@ -105,6 +110,14 @@ func (f *fakeDB) DeleteClass(class string, hasFrozen bool) error {
 	return nil
 }
 
+func (f *fakeDB) UnloadClass(class string) error {
+	return nil
+}
+
+func (f *fakeDB) DropUnloadedClass(class string, hasFrozen bool) error {
+	return nil
+}
+
 func (f *fakeDB) AddProperty(prop string, cmd command.AddPropertyRequest) error {
 	return nil
 }
@@ -129,16 +142,37 @@ func (f *fakeDB) UpdateShardStatus(cmd *command.UpdateShardStatusRequest) error
 	return nil
 }
 
+func (f *fakeDB) MoveShard(cmd *command.MoveShardRequest) error {
+	return nil
+}
+
 func (f *fakeDB) GetShardsStatus(class, tenant string) (models.ShardStatusList, error) {
 	args := f.Called(class, tenant)
 	return args.Get(0).(models.ShardStatusList), nil
 }
 
+func (f *fakeDB) ShardObjectCount(class, shard string) (int64, error) {
+	args := f.Called(class, shard)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (f *fakeDB) TenantLastActivity(class, tenant string) (time.Time, error) {
+	args := f.Called(class, tenant)
+	return args.Get(0).(time.Time), args.Error(1)
+}
+
+func (f *fakeDB) TenantQueriesInFlight(class, tenant string) (int64, error) {
+	args := f.Called(class, tenant)
+	return args.Get(0).(int64), args.Error(1)
+}
+
 func (f *fakeDB) TriggerSchemaUpdateCallbacks() {
 	f.Called()
 }
 
-type fakeScaleOutManager struct{}
+type fakeScaleOutManager struct {
+	mock.Mock
+}
 
 func (f *fakeScaleOutManager) Scale(ctx context.Context,
 	className string, updated shardingConfig.Config, _, _ int64,
@@ -149,6 +183,11 @@ func (f *fakeScaleOutManager) Scale(ctx context.Context,
 func (f *fakeScaleOutManager) SetSchemaReader(sr scaler.SchemaReader) {
 }
 
+func (f *fakeScaleOutManager) CopyShard(ctx context.Context, className, shard, fromNode, toNode string) error {
+	args := f.Called(className, shard, fromNode, toNode)
+	return args.Error(0)
+}
+
 type fakeValidator struct{}
 
 func (f fakeValidator) ValidateVectorIndexConfigUpdate(
@@ -168,6 +207,10 @@ func (fakeValidator) ValidateVectorIndexConfigsUpdate(old, updated map[string]sc
 	return nil
 }
 
+func (fakeValidator) ValidateComputeExpression(expr string, sourcePropertyType string) error {
+	return ValidateComputeExpression(expr, sourcePropertyType)
+}
+
 type fakeModuleConfig struct{}
 
 func (f *fakeModuleConfig) SetClassDefaults(class *models.Class) {
@@ -267,6 +310,16 @@ func (f *fakeMigrator) DropClass(ctx context.Context, className string, hasFroze
 	return args.Error(0)
 }
 
+func (f *fakeMigrator) UnloadClass(ctx context.Context, className string) error {
+	args := f.Called(ctx, className)
+	return args.Error(0)
+}
+
+func (f *fakeMigrator) DropUnloadedClass(ctx context.Context, className string, hasFrozen bool) error {
+	args := f.Called(ctx, className)
+	return args.Error(0)
+}
+
 func (f *fakeMigrator) AddProperty(ctx context.Context, className string, prop ...*models.Property) error {
 	args := f.Called(ctx, className, prop)
 	return args.Error(0)
@@ -296,11 +349,93 @@ func (f *fakeMigrator) GetShardsStatus(ctx context.Context, className, tenant st
 	return args.Get(0).(map[string]string), args.Error(1)
 }
 
+func (f *fakeMigrator) GetShardsCompactionStats(ctx context.Context, className, tenant string) (map[string]*models.CompactionStats, error) {
+	args := f.Called(ctx, className, tenant)
+	return args.Get(0).(map[string]*models.CompactionStats), args.Error(1)
+}
+
+func (f *fakeMigrator) ShardObjectCount(ctx context.Context, className, shardName string) (int64, error) {
+	args := f.Called(ctx, className, shardName)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (f *fakeMigrator) TenantLastActivity(className, tenant string) time.Time {
+	args := f.Called(className, tenant)
+	return args.Get(0).(time.Time)
+}
+
+func (f *fakeMigrator) TenantQueriesInFlight(className, tenant string) int64 {
+	args := f.Called(className, tenant)
+	return args.Get(0).(int64)
+}
+
+func (f *fakeMigrator) TenantDataPurged(ctx context.Context, className, tenant string, nodes []string) (bool, error) {
+	args := f.Called(className, tenant, nodes)
+	return args.Bool(0), args.Error(1)
+}
+
+func (f *fakeMigrator) ReplicaObjectCounts(ctx context.Context, className string) (map[string]map[string]int64, error) {
+	args := f.Called(ctx, className)
+	return args.Get(0).(map[string]map[string]int64), args.Error(1)
+}
+
+func (f *fakeMigrator) MigrateMultiTenancy(ctx context.Context, className string, from, to models.MultiTenancyConfig) error {
+	args := f.Called(ctx, className, from, to)
+	return args.Error(0)
+}
+
+func (f *fakeMigrator) ReindexInvertedIndex(ctx context.Context, className string) error {
+	args := f.Called(ctx, className)
+	return args.Error(0)
+}
+
+func (f *fakeMigrator) CopyTenantObjects(ctx context.Context, sourceClassName, targetClassName, tenant string,
+	progress func(copied int64),
+) error {
+	args := f.Called(ctx, sourceClassName, targetClassName, tenant)
+	return args.Error(0)
+}
+
+func (f *fakeMigrator) BackfillProperty(ctx context.Context, className, propertyName string, defaultValue interface{}) (string, error) {
+	args := f.Called(ctx, className, propertyName, defaultValue)
+	return args.String(0), args.Error(1)
+}
+
+func (f *fakeMigrator) BackfillStatus(jobID string) (BackfillStatus, bool) {
+	args := f.Called(jobID)
+	return args.Get(0).(BackfillStatus), args.Bool(1)
+}
+
+func (f *fakeMigrator) BackfillVectors(ctx context.Context, class string, opts BackfillVectorOptions) (string, error) {
+	args := f.Called(ctx, class, opts)
+	return args.String(0), args.Error(1)
+}
+
+func (f *fakeMigrator) VectorBackfillStatus(jobID string) (VectorBackfillStatus, bool) {
+	args := f.Called(jobID)
+	return args.Get(0).(VectorBackfillStatus), args.Bool(1)
+}
+
+func (f *fakeMigrator) PrewarmTenantIndex(ctx context.Context, className, tenant string, nodes []string) error {
+	args := f.Called(ctx, className, tenant, nodes)
+	return args.Error(0)
+}
+
+func (f *fakeMigrator) ComputePropertyStats(ctx context.Context, className, propertyName string) (*PropertyStats, error) {
+	args := f.Called(ctx, className, propertyName)
+	return args.Get(0).(*PropertyStats), args.Error(1)
+}
+
 func (f *fakeMigrator) UpdateShardStatus(ctx context.Context, className, shardName, targetStatus string, schemaVersion uint64) error {
 	args := f.Called(ctx, className, shardName, targetStatus, schemaVersion)
 	return args.Error(0)
 }
 
+func (f *fakeMigrator) MoveShard(ctx context.Context, className, shardName, fromNode, toNode string) error {
+	args := f.Called(ctx, className, shardName, fromNode, toNode)
+	return args.Error(0)
+}
+
 func (f *fakeMigrator) UpdateVectorIndexConfig(ctx context.Context, className string, updated schemaConfig.VectorIndexConfig) error {
 	args := f.Called(ctx, className, updated)
 	return args.Error(0)
@@ -317,6 +452,18 @@ func (*fakeMigrator) UpdateVectorIndexConfigs(ctx context.Context, className str
 	return nil
 }
 
+func (f *fakeMigrator) SetVectorIndexConfigs(ctx context.Context, className string,
+	updated map[string]schemaConfig.VectorIndexConfig,
+) error {
+	args := f.Called(ctx, className, updated)
+	return args.Error(0)
+}
+
+func (f *fakeMigrator) UpdateShardVectorIndexConfigs(ctx context.Context, className, shardName string, version uint64) error {
+	args := f.Called(ctx, className, shardName, version)
+	return args.Error(0)
+}
+
 func (*fakeMigrator) ValidateInvertedIndexConfigUpdate(old, updated *models.InvertedIndexConfig) error {
 	return nil
 }
@@ -330,6 +477,11 @@ func (f *fakeMigrator) UpdateReplicationConfig(ctx context.Context, className st
 	return nil
 }
 
+func (f *fakeMigrator) SetClassCompactionConfig(ctx context.Context, className string, cfg models.ClassCompactionConfig) error {
+	args := f.Called(ctx, className, cfg)
+	return args.Error(0)
+}
+
 func (f *fakeMigrator) WaitForStartup(ctx context.Context) error {
 	args := f.Called(ctx)
 	return args.Error(0)
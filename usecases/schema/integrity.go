@@ -0,0 +1,125 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"sort"
+
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
+)
+
+// IntegrityIssueType describes what is wrong with a schema element
+type IntegrityIssueType string
+
+const (
+	// IntegrityIssueDanglingReference is a cross-reference property pointing
+	// to a class which doesn't exist (anymore)
+	IntegrityIssueDanglingReference IntegrityIssueType = "DANGLING_REFERENCE"
+	// IntegrityIssueModuleNotLoaded is a moduleConfig entry of a module which
+	// is not enabled on this node
+	IntegrityIssueModuleNotLoaded IntegrityIssueType = "MODULE_NOT_LOADED"
+	// IntegrityIssueReplicationFactor is a replication factor larger than the
+	// number of nodes in the cluster
+	IntegrityIssueReplicationFactor IntegrityIssueType = "REPLICATION_FACTOR_EXCEEDS_NODES"
+)
+
+// IntegrityIssue is a single inconsistency found by ValidateSchemaIntegrity.
+// Property, ReferencedClass and Module are only set if they apply to the
+// IssueType.
+type IntegrityIssue struct {
+	Class           string
+	Property        string
+	ReferencedClass string
+	Module          string
+	IssueType       IntegrityIssueType
+}
+
+// ValidateSchemaIntegrity checks the whole schema for references to classes
+// which don't exist, module configs of modules which are not loaded and
+// replication factors which can't be satisfied by the current cluster.
+// Classes are not modified, the issues are only reported.
+func (h *Handler) ValidateSchemaIntegrity(principal *models.Principal) ([]IntegrityIssue, error) {
+	err := h.Authorizer.Authorize(principal, authorization.READ, authorization.CollectionsMetadata()...)
+	if err != nil {
+		return nil, err
+	}
+
+	s := h.schemaReader.ReadOnlySchema()
+	classes := make(map[string]struct{}, len(s.Classes))
+	for _, class := range s.Classes {
+		classes[class.Class] = struct{}{}
+	}
+	nodeCount := h.clusterState.NodeCount()
+
+	issues := []IntegrityIssue{}
+	for _, class := range s.Classes {
+		for _, module := range h.missingModules(class.ModuleConfig) {
+			issues = append(issues, IntegrityIssue{
+				Class:     class.Class,
+				Module:    module,
+				IssueType: IntegrityIssueModuleNotLoaded,
+			})
+		}
+
+		for _, prop := range class.Properties {
+			if schema.IsRefDataType(prop.DataType) {
+				for _, target := range prop.DataType {
+					if _, ok := classes[target]; !ok {
+						issues = append(issues, IntegrityIssue{
+							Class:           class.Class,
+							Property:        prop.Name,
+							ReferencedClass: target,
+							IssueType:       IntegrityIssueDanglingReference,
+						})
+					}
+				}
+			}
+			for _, module := range h.missingModules(prop.ModuleConfig) {
+				issues = append(issues, IntegrityIssue{
+					Class:     class.Class,
+					Property:  prop.Name,
+					Module:    module,
+					IssueType: IntegrityIssueModuleNotLoaded,
+				})
+			}
+		}
+
+		if class.ReplicationConfig != nil && int(class.ReplicationConfig.Factor) > nodeCount {
+			issues = append(issues, IntegrityIssue{
+				Class:     class.Class,
+				IssueType: IntegrityIssueReplicationFactor,
+			})
+		}
+	}
+
+	return issues, nil
+}
+
+// missingModules returns the sorted names of all modules configured in
+// moduleConfig which are not loaded
+func (h *Handler) missingModules(moduleConfig interface{}) []string {
+	cfg, ok := moduleConfig.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	var missing []string
+	for name := range cfg {
+		if h.moduleConfig.GetByName(name) == nil {
+			missing = append(missing, name)
+		}
+	}
+	sort.Strings(missing)
+	return missing
+}
@@ -0,0 +1,71 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+func TestHandler_ValidateSchemaIntegrity(t *testing.T) {
+	t.Run("consistent schema", func(t *testing.T) {
+		handler, fakeSchemaManager := newTestHandler(t, &fakeDB{})
+		fakeSchemaManager.On("ReadOnlySchema").Return(models.Schema{Classes: []*models.Class{
+			{Class: "Author"},
+			{
+				Class: "Book",
+				Properties: []*models.Property{
+					{Name: "title", DataType: []string{"text"}},
+					{Name: "writtenBy", DataType: []string{"Author"}},
+				},
+				ReplicationConfig: &models.ReplicationConfig{Factor: 1},
+			},
+		}})
+
+		issues, err := handler.ValidateSchemaIntegrity(nil)
+		require.Nil(t, err)
+		assert.Empty(t, issues)
+	})
+
+	t.Run("inconsistent schema", func(t *testing.T) {
+		handler, fakeSchemaManager := newTestHandler(t, &fakeDB{})
+		fakeSchemaManager.On("ReadOnlySchema").Return(models.Schema{Classes: []*models.Class{
+			{Class: "Author"},
+			{
+				Class: "Book",
+				// the fake module config doesn't know any module
+				ModuleConfig: map[string]interface{}{"my-module1": map[string]interface{}{}},
+				Properties: []*models.Property{
+					{
+						Name:         "title",
+						DataType:     []string{"text"},
+						ModuleConfig: map[string]interface{}{"my-module2": map[string]interface{}{}},
+					},
+					{Name: "writtenBy", DataType: []string{"Author", "Publisher"}},
+				},
+				ReplicationConfig: &models.ReplicationConfig{Factor: 3},
+			},
+		}})
+
+		issues, err := handler.ValidateSchemaIntegrity(nil)
+		require.Nil(t, err)
+		assert.Equal(t, []IntegrityIssue{
+			{Class: "Book", Module: "my-module1", IssueType: IntegrityIssueModuleNotLoaded},
+			{Class: "Book", Property: "title", Module: "my-module2", IssueType: IntegrityIssueModuleNotLoaded},
+			{Class: "Book", Property: "writtenBy", ReferencedClass: "Publisher", IssueType: IntegrityIssueDanglingReference},
+			{Class: "Book", IssueType: IntegrityIssueReplicationFactor},
+		}, issues)
+	})
+}
@@ -0,0 +1,133 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"time"
+
+	"github.com/google/uuid"
+	clusterSchema "github.com/weaviate/weaviate/cluster/schema"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
+)
+
+type InvertedIndexMigrationStatus string
+
+const (
+	InvertedIndexMigrationRunning  InvertedIndexMigrationStatus = "RUNNING"
+	InvertedIndexMigrationFinished InvertedIndexMigrationStatus = "FINISHED"
+	InvertedIndexMigrationFailed   InvertedIndexMigrationStatus = "FAILED"
+)
+
+// InvertedIndexMigration describes a reindexing job started by
+// MigrateInvertedIndex
+type InvertedIndexMigration struct {
+	ID         string
+	Class      string
+	Status     InvertedIndexMigrationStatus
+	Error      string
+	StartedAt  time.Time
+	FinishedAt time.Time
+}
+
+func finishInvertedIndexMigration(err error) func(job *InvertedIndexMigration) {
+	return func(job *InvertedIndexMigration) {
+		job.FinishedAt = time.Now().UTC()
+		job.Status = InvertedIndexMigrationFinished
+		if err != nil {
+			job.Status = InvertedIndexMigrationFailed
+			job.Error = err.Error()
+		}
+	}
+}
+
+// MigrateInvertedIndex replaces the inverted index config of class with
+// updated and rebuilds the inverted index of the class in the background.
+// The returned job ID can be passed to GetInvertedIndexMigration to poll
+// the progress. Only the shards on this node are reindexed.
+func (h *Handler) MigrateInvertedIndex(ctx context.Context, principal *models.Principal,
+	class string, updated *models.InvertedIndexConfig,
+) (string, error) {
+	err := h.Authorizer.Authorize(principal, authorization.UPDATE, authorization.CollectionsMetadata(class)...)
+	if err != nil {
+		return "", err
+	}
+	if updated == nil {
+		return "", fmt.Errorf("%w: inverted index config is required", clusterSchema.ErrBadRequest)
+	}
+
+	class = schema.UppercaseClassName(class)
+	initial := h.schemaReader.ReadOnlyClass(class)
+	if initial == nil {
+		return "", fmt.Errorf("class %q: %w", class, ErrNotFound)
+	}
+
+	if !reflect.DeepEqual(initial.InvertedIndexConfig, updated) {
+		if err := h.validator.ValidateInvertedIndexConfigUpdate(initial.InvertedIndexConfig, updated); err != nil {
+			return "", fmt.Errorf("%w: %w", clusterSchema.ErrBadRequest, err)
+		}
+		if err := h.invertedConfigValidator(updated); err != nil {
+			return "", fmt.Errorf("%w: %w", clusterSchema.ErrBadRequest, err)
+		}
+
+		cls := *initial
+		cls.InvertedIndexConfig = updated
+		version, err := h.schemaManager.UpdateClass(withActor(ctx, principal), &cls, nil)
+		if err != nil {
+			return "", err
+		}
+		// the local index must use the new config before it is rebuilt
+		if err := h.WaitForSchemaConsistency(ctx, version); err != nil {
+			return "", err
+		}
+	}
+
+	job := InvertedIndexMigration{
+		ID:        uuid.NewString(),
+		Class:     class,
+		Status:    InvertedIndexMigrationRunning,
+		StartedAt: time.Now().UTC(),
+	}
+	h.invertedIndexMigrations.Add(job.ID, job)
+	enterrors.GoWrapper(func() {
+		err := h.dataMigrator.ReindexInvertedIndex(context.Background(), class)
+		if err != nil {
+			h.logEntry(ctx, class, "").WithField("action", "migrate_inverted_index").
+				WithField("job", job.ID).
+				WithError(err).Error("reindexing failed")
+		}
+		h.invertedIndexMigrations.Finish(job.ID, finishInvertedIndexMigration(err))
+	}, h.logger)
+
+	return job.ID, nil
+}
+
+// GetInvertedIndexMigration returns the reindexing job jobID of class
+func (h *Handler) GetInvertedIndexMigration(ctx context.Context, principal *models.Principal,
+	class, jobID string,
+) (InvertedIndexMigration, error) {
+	err := h.Authorizer.Authorize(principal, authorization.READ, authorization.CollectionsMetadata(class)...)
+	if err != nil {
+		return InvertedIndexMigration{}, err
+	}
+
+	job, ok := h.invertedIndexMigrations.Get(jobID)
+	if !ok || job.Class != schema.UppercaseClassName(class) {
+		return InvertedIndexMigration{}, fmt.Errorf("inverted index migration %q of class %q: %w", jobID, class, ErrNotFound)
+	}
+	return job, nil
+}
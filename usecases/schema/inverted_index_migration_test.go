@@ -0,0 +1,90 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	clusterSchema "github.com/weaviate/weaviate/cluster/schema"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+func TestHandler_MigrateInvertedIndex(t *testing.T) {
+	ctx := context.Background()
+	initial := &models.Class{
+		Class: "C",
+		InvertedIndexConfig: &models.InvertedIndexConfig{
+			Bm25: &models.BM25Config{K1: 1.2, B: 0.75},
+		},
+	}
+	updated := &models.InvertedIndexConfig{Bm25: &models.BM25Config{K1: 1.5, B: 0.5}}
+
+	waitForJob := func(t *testing.T, handler *Handler, id string) InvertedIndexMigration {
+		var job InvertedIndexMigration
+		require.Eventually(t, func() bool {
+			var err error
+			job, err = handler.GetInvertedIndexMigration(ctx, nil, "C", id)
+			require.Nil(t, err)
+			return job.Status != InvertedIndexMigrationRunning
+		}, 5*time.Second, 10*time.Millisecond)
+		return job
+	}
+
+	t.Run("config changed", func(t *testing.T) {
+		handler, fakeSchemaManager := newTestHandler(t, &fakeDB{})
+		fakeSchemaManager.On("ReadOnlyClass", "C").Return(initial)
+		fakeSchemaManager.On("UpdateClass", mock.MatchedBy(func(c *models.Class) bool {
+			return c.InvertedIndexConfig == updated
+		}), mock.Anything).Return(nil)
+		fakeSchemaManager.On("ReindexInvertedIndex", mock.Anything, "C").Return(nil)
+
+		id, err := handler.MigrateInvertedIndex(ctx, nil, "C", updated)
+		require.Nil(t, err)
+		job := waitForJob(t, handler, id)
+		assert.Equal(t, InvertedIndexMigrationFinished, job.Status)
+		assert.Equal(t, "C", job.Class)
+		fakeSchemaManager.AssertExpectations(t)
+		// the class of the schema must not be modified in place
+		assert.Equal(t, float32(1.2), initial.InvertedIndexConfig.Bm25.K1)
+	})
+
+	t.Run("config unchanged only reindexes", func(t *testing.T) {
+		handler, fakeSchemaManager := newTestHandler(t, &fakeDB{})
+		fakeSchemaManager.On("ReadOnlyClass", "C").Return(initial)
+		fakeSchemaManager.On("ReindexInvertedIndex", mock.Anything, "C").Return(errors.New("disk full"))
+
+		id, err := handler.MigrateInvertedIndex(ctx, nil, "C", initial.InvertedIndexConfig)
+		require.Nil(t, err)
+		job := waitForJob(t, handler, id)
+		assert.Equal(t, InvertedIndexMigrationFailed, job.Status)
+		assert.Equal(t, "disk full", job.Error)
+		fakeSchemaManager.AssertNotCalled(t, "UpdateClass", mock.Anything, mock.Anything)
+	})
+
+	t.Run("invalid requests", func(t *testing.T) {
+		handler, fakeSchemaManager := newTestHandler(t, &fakeDB{})
+		fakeSchemaManager.On("ReadOnlyClass", "Unknown").Return(nil)
+
+		_, err := handler.MigrateInvertedIndex(ctx, nil, "C", nil)
+		assert.ErrorIs(t, err, clusterSchema.ErrBadRequest)
+		_, err = handler.MigrateInvertedIndex(ctx, nil, "Unknown", updated)
+		assert.ErrorIs(t, err, ErrNotFound)
+		_, err = handler.GetInvertedIndexMigration(ctx, nil, "C", "unknown-job")
+		assert.ErrorIs(t, err, ErrNotFound)
+	})
+}
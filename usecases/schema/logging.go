@@ -0,0 +1,66 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"context"
+	"errors"
+	"runtime"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/trace"
+
+	clusterSchema "github.com/weaviate/weaviate/cluster/schema"
+	clusterTypes "github.com/weaviate/weaviate/cluster/types"
+)
+
+// logEntry returns an entry of the handler's logger with the class and tenant
+// a method works on, the local node, the calling method and, if ctx carries
+// them, the trace id and the user. Empty class and tenant are left out.
+func (h *Handler) logEntry(ctx context.Context, class, tenant string) *logrus.Entry {
+	fields := logrus.Fields{"node": h.clusterState.LocalName()}
+	if class != "" {
+		fields["class"] = class
+	}
+	if tenant != "" {
+		fields["tenant"] = tenant
+	}
+	if spanCtx := trace.SpanContextFromContext(ctx); spanCtx.HasTraceID() {
+		fields["traceID"] = spanCtx.TraceID().String()
+	}
+	if actor := clusterTypes.ActorFromContext(ctx); actor != "" {
+		fields["user"] = actor
+	}
+	if pc, _, _, ok := runtime.Caller(1); ok {
+		if fn := runtime.FuncForPC(pc); fn != nil {
+			// drop the package path, e.g. "(*Handler).AddClass" remains
+			name := fn.Name()
+			name = name[strings.LastIndex(name, "/")+1:]
+			fields["caller"] = name[strings.Index(name, ".")+1:]
+		}
+	}
+	return h.logger.WithFields(fields)
+}
+
+// writeErrorLevel is the level a failed schema write is logged at. Writes
+// rejected because of the request, e.g. an existing class, are returned to
+// the user and only logged at debug level.
+func writeErrorLevel(err error) logrus.Level {
+	switch {
+	case errors.Is(err, clusterSchema.ErrBadRequest), errors.Is(err, clusterSchema.ErrSchema),
+		errors.Is(err, clusterSchema.ErrClassExists), errors.Is(err, clusterSchema.ErrClassNotFound):
+		return logrus.DebugLevel
+	default:
+		return logrus.ErrorLevel
+	}
+}
@@ -0,0 +1,102 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/trace"
+
+	clusterSchema "github.com/weaviate/weaviate/cluster/schema"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+func TestHandler_LogEntry(t *testing.T) {
+	traceID := trace.TraceID{1, 2, 3}
+	ctx := trace.ContextWithSpanContext(context.Background(),
+		trace.NewSpanContext(trace.SpanContextConfig{TraceID: traceID, SpanID: trace.SpanID{1}}))
+	principal := &models.Principal{Username: "alice"}
+	errWrite := errors.New("leader changed")
+
+	captured := func(t *testing.T, run func(*Handler, *fakeSchemaManager)) *logrus.Entry {
+		handler, fakeSchemaManager := newTestHandler(t, &fakeDB{})
+		logger, hook := test.NewNullLogger()
+		handler.logger = logger
+		run(handler, fakeSchemaManager)
+		require.Len(t, hook.AllEntries(), 1)
+		entry := hook.LastEntry()
+		assert.Equal(t, logrus.ErrorLevel, entry.Level)
+		assert.Equal(t, errWrite, entry.Data[logrus.ErrorKey])
+		assert.Equal(t, traceID.String(), entry.Data["traceID"])
+		assert.Equal(t, "alice", entry.Data["user"])
+		assert.Contains(t, entry.Data, "node")
+		return entry
+	}
+
+	t.Run("AddClass", func(t *testing.T) {
+		entry := captured(t, func(handler *Handler, fakeSchemaManager *fakeSchemaManager) {
+			fakeSchemaManager.On("AddClass", mock.Anything, mock.Anything).Return(errWrite)
+			_, err := handler.AddClass(ctx, principal, &models.Class{
+				Class: "NewClass", Vectorizer: "none",
+			})
+			assert.ErrorIs(t, err, errWrite)
+		})
+		assert.Equal(t, "NewClass", entry.Data["class"])
+		assert.Equal(t, "(*Handler).AddClass", entry.Data["caller"])
+		assert.NotContains(t, entry.Data, "tenant")
+	})
+
+	t.Run("DeleteClass", func(t *testing.T) {
+		entry := captured(t, func(handler *Handler, fakeSchemaManager *fakeSchemaManager) {
+			fakeSchemaManager.On("DeleteClass", "OldClass").Return(errWrite)
+			_, err := handler.DeleteClass(ctx, principal, "oldClass")
+			assert.ErrorIs(t, err, errWrite)
+		})
+		assert.Equal(t, "OldClass", entry.Data["class"])
+		assert.Equal(t, "(*Handler).DeleteClass", entry.Data["caller"])
+	})
+
+	t.Run("AddClassProperty", func(t *testing.T) {
+		entry := captured(t, func(handler *Handler, fakeSchemaManager *fakeSchemaManager) {
+			class := &models.Class{Class: "Existing", Vectorizer: "none"}
+			fakeSchemaManager.On("ReadOnlyClass", mock.Anything).Return(class)
+			fakeSchemaManager.On("AddProperty", mock.Anything, mock.Anything).Return(errWrite)
+			_, _, err := handler.AddClassProperty(ctx, principal, class, class.Class, false,
+				&models.Property{Name: "title", DataType: []string{"text"}})
+			assert.ErrorIs(t, err, errWrite)
+		})
+		assert.Equal(t, "Existing", entry.Data["class"])
+		// AddClassProperty wraps AddClassPropertyWithBackfill
+		assert.Equal(t, "(*Handler).AddClassPropertyWithBackfill", entry.Data["caller"])
+	})
+	t.Run("rejected write", func(t *testing.T) {
+		handler, fakeSchemaManager := newTestHandler(t, &fakeDB{})
+		logger, hook := test.NewNullLogger()
+		logger.SetLevel(logrus.DebugLevel)
+		handler.logger = logger
+		errExists := fmt.Errorf("%w: add class: %w", clusterSchema.ErrSchema, clusterSchema.ErrClassExists)
+		fakeSchemaManager.On("AddClass", mock.Anything, mock.Anything).Return(errExists)
+
+		_, err := handler.AddClass(ctx, principal, &models.Class{Class: "NewClass", Vectorizer: "none"})
+		assert.ErrorIs(t, err, clusterSchema.ErrClassExists)
+		require.Len(t, hook.AllEntries(), 1)
+		assert.Equal(t, logrus.DebugLevel, hook.LastEntry().Level, "the request is at fault, not the node")
+	})
+}
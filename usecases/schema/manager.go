@@ -15,11 +15,9 @@ import (
 	"context"
 	"fmt"
 	"slices"
-	"strings"
 	"sync"
 
 	"github.com/sirupsen/logrus"
-	"github.com/weaviate/weaviate/cluster/proto/api"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/modulecapabilities"
 	"github.com/weaviate/weaviate/entities/schema"
@@ -191,10 +189,12 @@ type scaleOut interface {
 	SetSchemaReader(sr scaler.SchemaReader)
 	Scale(ctx context.Context, className string,
 		updated shardingConfig.Config, prevReplFactor, newReplFactor int64) (*sharding.State, error)
+	CopyShard(ctx context.Context, className, shard, fromNode, toNode string) error
 }
 
 // NewManager creates a new manager
 func NewManager(validator validator,
+	dataMigrator dataMigrator,
 	schemaManager SchemaManager,
 	schemaReader SchemaReader,
 	repo SchemaStore,
@@ -209,6 +209,7 @@ func NewManager(validator validator,
 		schemaReader,
 		schemaManager,
 		validator,
+		dataMigrator,
 		logger, authorizer,
 		config, configParser, vectorizerValidator, invertedConfigValidator,
 		moduleConfig, clusterState, scaleoutManager, cloud)
@@ -396,41 +397,38 @@ func (m *Manager) OptimisticTenantStatus(ctx context.Context, class string, tena
 func (m *Manager) activateTenantIfInactive(ctx context.Context, class string,
 	status map[string]string,
 ) (map[string]string, error) {
-	req := &api.UpdateTenantsRequest{
-		Tenants:      make([]*api.Tenant, 0, len(status)),
-		ClusterNodes: m.schemaManager.StorageCandidates(),
-	}
-
+	inactive := make([]string, 0, len(status))
 	for tenant, s := range status {
 		if s != models.TenantActivityStatusHOT {
-			req.Tenants = append(req.Tenants,
-				&api.Tenant{Name: tenant, Status: models.TenantActivityStatusHOT})
+			inactive = append(inactive, tenant)
 		}
 	}
 
-	if len(req.Tenants) == 0 {
+	if len(inactive) == 0 {
 		// nothing to do, all tenants are already HOT
 		return status, nil
 	}
 
-	_, err := m.schemaManager.UpdateTenants(ctx, class, req)
-	if err != nil {
-		names := make([]string, len(req.Tenants))
-		for i, t := range req.Tenants {
-			names[i] = t.Name
-		}
-
-		return nil, fmt.Errorf("implicit activation of tenants %s: %w", strings.Join(names, ", "), err)
+	slices.Sort(inactive)
+	if err := m.activateTenants(ctx, class, inactive); err != nil {
+		return nil, err
 	}
 
-	for _, t := range req.Tenants {
-		status[t.Name] = models.TenantActivityStatusHOT
+	for _, tenant := range inactive {
+		status[tenant] = models.TenantActivityStatusHOT
 	}
 
 	return status, nil
 }
 
+// AllowImplicitTenantActivation returns whether inactive tenants of class are
+// activated when accessed, either because the class enables it or because
+// AutoActivateTenants is set
 func (m *Manager) AllowImplicitTenantActivation(class string) bool {
+	if m.AutoActivateTenants {
+		return true
+	}
+
 	allow := false
 	m.schemaReader.Read(class, func(c *models.Class, _ *sharding.State) error {
 		allow = schema.AutoTenantActivationEnabled(c)
@@ -0,0 +1,119 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/weaviate/weaviate/usecases/monitoring"
+)
+
+// operation label values of SchemaHandlerMetrics.OperationDuration
+const (
+	opAddClass             = "add_class"
+	opUpdateClass          = "update_class"
+	opDeleteClass          = "delete_class"
+	opAddProperty          = "add_property"
+	opDeleteProperty       = "delete_property"
+	opAddTenants           = "add_tenants"
+	opUpdateTenants        = "update_tenants"
+	opDeleteTenants        = "delete_tenants"
+	schemaHandlerSubsystem = "schema_handler"
+)
+
+// SchemaHandlerMetrics count the schema writes received by the Handler and
+// observe how long they take, including validation and replication.
+type SchemaHandlerMetrics struct {
+	AddClassTotal       prometheus.Counter
+	UpdateClassTotal    prometheus.Counter
+	DeleteClassTotal    prometheus.Counter
+	AddPropertyTotal    prometheus.Counter
+	DeletePropertyTotal prometheus.Counter
+	AddTenantsTotal     prometheus.Counter
+	UpdateTenantsTotal  prometheus.Counter
+	DeleteTenantsTotal  prometheus.Counter
+
+	// OperationDuration is labeled by operation, e.g. add_class
+	OperationDuration *prometheus.HistogramVec
+}
+
+func NewSchemaHandlerMetrics(reg prometheus.Registerer) *SchemaHandlerMetrics {
+	r := promauto.With(reg)
+	counter := func(name, help string) prometheus.Counter {
+		return r.NewCounter(prometheus.CounterOpts{
+			Subsystem: schemaHandlerSubsystem,
+			Name:      name,
+			Help:      help,
+		})
+	}
+
+	return &SchemaHandlerMetrics{
+		AddClassTotal:       counter("add_class_total", "Number of requests to add a class."),
+		UpdateClassTotal:    counter("update_class_total", "Number of requests to update a class."),
+		DeleteClassTotal:    counter("delete_class_total", "Number of requests to delete a class."),
+		AddPropertyTotal:    counter("add_property_total", "Number of requests to add properties to a class."),
+		DeletePropertyTotal: counter("delete_property_total", "Number of requests to delete a property of a class."),
+		AddTenantsTotal:     counter("add_tenants_total", "Number of requests to add tenants."),
+		UpdateTenantsTotal:  counter("update_tenants_total", "Number of requests to update tenants."),
+		DeleteTenantsTotal:  counter("delete_tenants_total", "Number of requests to delete tenants."),
+		OperationDuration: r.NewHistogramVec(prometheus.HistogramOpts{
+			Subsystem: schemaHandlerSubsystem,
+			Name:      "operation_duration_seconds",
+			Help:      "Time (in seconds) spent handling a schema write.",
+			Buckets:   monitoring.LatencyBuckets,
+		}, []string{"operation"}),
+	}
+}
+
+// track counts operation and returns a function observing its duration,
+// meant to be deferred. It is a no-op if no metrics are configured.
+func (m *SchemaHandlerMetrics) track(operation string) func() {
+	if m == nil {
+		return func() {}
+	}
+
+	var counter prometheus.Counter
+	switch operation {
+	case opAddClass:
+		counter = m.AddClassTotal
+	case opUpdateClass:
+		counter = m.UpdateClassTotal
+	case opDeleteClass:
+		counter = m.DeleteClassTotal
+	case opAddProperty:
+		counter = m.AddPropertyTotal
+	case opDeleteProperty:
+		counter = m.DeletePropertyTotal
+	case opAddTenants:
+		counter = m.AddTenantsTotal
+	case opUpdateTenants:
+		counter = m.UpdateTenantsTotal
+	case opDeleteTenants:
+		counter = m.DeleteTenantsTotal
+	}
+	if counter != nil {
+		counter.Inc()
+	}
+
+	start := time.Now()
+	return func() {
+		m.OperationDuration.WithLabelValues(operation).Observe(time.Since(start).Seconds())
+	}
+}
+
+// WithMetrics makes the handler record SchemaHandlerMetrics for every schema
+// write it handles
+func (h *Handler) WithMetrics(m *SchemaHandlerMetrics) {
+	h.metrics = m
+}
@@ -0,0 +1,52 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+func TestSchemaHandlerMetrics(t *testing.T) {
+	ctx := context.Background()
+	handler, fakeSchemaManager := newTestHandler(t, &fakeDB{})
+	metrics := NewSchemaHandlerMetrics(prometheus.NewPedanticRegistry())
+	handler.WithMetrics(metrics)
+
+	fakeSchemaManager.On("AddClass", mock.Anything, mock.Anything).Return(nil)
+	fakeSchemaManager.On("DeleteClass", "NewClass").Return(nil)
+
+	_, err := handler.AddClass(ctx, nil, &models.Class{Class: "NewClass", Vectorizer: "none"})
+	require.Nil(t, err)
+	// failed writes are counted as well
+	_, err = handler.AddClass(ctx, nil, &models.Class{})
+	require.NotNil(t, err)
+	_, err = handler.DeleteClass(ctx, nil, "NewClass")
+	require.Nil(t, err)
+
+	assert.Equal(t, float64(2), testutil.ToFloat64(metrics.AddClassTotal))
+	assert.Equal(t, float64(1), testutil.ToFloat64(metrics.DeleteClassTotal))
+	assert.Equal(t, float64(0), testutil.ToFloat64(metrics.AddTenantsTotal))
+	assert.Equal(t, 2, testutil.CollectAndCount(metrics.OperationDuration))
+}
+
+func TestSchemaHandlerMetricsNotConfigured(t *testing.T) {
+	var metrics *SchemaHandlerMetrics
+	assert.NotPanics(t, func() { metrics.track(opAddClass)() })
+}
@@ -16,6 +16,7 @@ package schema
 
 import (
 	"context"
+	"time"
 
 	"github.com/weaviate/weaviate/entities/models"
 	schemaConfig "github.com/weaviate/weaviate/entities/schema/config"
@@ -36,6 +37,8 @@ type UpdateTenantPayload struct {
 type Migrator interface {
 	AddClass(ctx context.Context, class *models.Class, shardingState *sharding.State) error
 	DropClass(ctx context.Context, className string, hasFrozen bool) error
+	UnloadClass(ctx context.Context, className string) error
+	DropUnloadedClass(ctx context.Context, className string, hasFrozen bool) error
 	// UpdateClass(ctx context.Context, className string,newClassName *string) error
 	GetShardsQueueSize(ctx context.Context, className, tenant string) (map[string]int64, error)
 
@@ -50,17 +53,54 @@ type Migrator interface {
 	DeleteTenants(ctx context.Context, class string, tenants []string) error
 
 	GetShardsStatus(ctx context.Context, className, tenant string) (map[string]string, error)
+	// GetShardsCompactionStats returns the compaction stats of the shards of
+	// className which are loaded on this node
+	GetShardsCompactionStats(ctx context.Context, className, tenant string) (map[string]*models.CompactionStats, error)
+	ShardObjectCount(ctx context.Context, className, shardName string) (int64, error)
+	TenantLastActivity(className, tenant string) time.Time
+	TenantQueriesInFlight(className, tenant string) int64
 	UpdateShardStatus(ctx context.Context, className, shardName, targetStatus string, schemaVersion uint64) error
+	// MoveShard opens shardName if this node is toNode and closes it if this
+	// node is fromNode
+	MoveShard(ctx context.Context, className, shardName, fromNode, toNode string) error
 
 	UpdateVectorIndexConfig(ctx context.Context, className string, updated schemaConfig.VectorIndexConfig) error
 	ValidateVectorIndexConfigsUpdate(old, updated map[string]schemaConfig.VectorIndexConfig) error
 	UpdateVectorIndexConfigs(ctx context.Context, className string,
 		updated map[string]schemaConfig.VectorIndexConfig) error
+	// SetVectorIndexConfigs sets the vector index configs new shards are
+	// created with, UpdateShardVectorIndexConfigs updates an existing shard to
+	// them unless it has been updated to the given or a later version already
+	SetVectorIndexConfigs(ctx context.Context, className string,
+		updated map[string]schemaConfig.VectorIndexConfig) error
+	UpdateShardVectorIndexConfigs(ctx context.Context, className, shardName string, version uint64) error
 	ValidateInvertedIndexConfigUpdate(old, updated *models.InvertedIndexConfig) error
 	UpdateInvertedIndexConfig(ctx context.Context, className string,
 		updated *models.InvertedIndexConfig) error
+	ReindexInvertedIndex(ctx context.Context, className string) error
+	CopyTenantObjects(ctx context.Context, sourceClassName, targetClassName, tenant string,
+		progress func(copied int64)) error
+	// TenantDataPurged returns whether the shard of tenant of className is
+	// unloaded and its files are removed on each of nodes
+	TenantDataPurged(ctx context.Context, className, tenant string, nodes []string) (bool, error)
+	// ReplicaObjectCounts returns the number of objects per shard and node
+	// of every replica of className
+	ReplicaObjectCounts(ctx context.Context, className string) (map[string]map[string]int64, error)
+	BackfillProperty(ctx context.Context, className, propertyName string, defaultValue interface{}) (string, error)
+	BackfillStatus(jobID string) (BackfillStatus, bool)
+	BackfillVectors(ctx context.Context, className string, opts BackfillVectorOptions) (string, error)
+	VectorBackfillStatus(jobID string) (VectorBackfillStatus, bool)
+	PrewarmTenantIndex(ctx context.Context, className, tenant string, nodes []string) error
+	ComputePropertyStats(ctx context.Context, className, propertyName string) (*PropertyStats, error)
 	UpdateReplicationConfig(ctx context.Context, className string,
 		updated *models.ReplicationConfig) error
+	// SetClassCompactionConfig changes the compaction settings of the shards
+	// of className, unset settings fall back to the global ones
+	SetClassCompactionConfig(ctx context.Context, className string, cfg models.ClassCompactionConfig) error
+	// MigrateMultiTenancy recreates the index of an empty class whose
+	// multi-tenancy has been enabled or disabled, without removing any data.
+	// It doesn't move objects between shards and tenants.
+	MigrateMultiTenancy(ctx context.Context, className string, from, to models.MultiTenancyConfig) error
 	WaitForStartup(context.Context) error
 	Shutdown(context.Context) error
 }
@@ -0,0 +1,180 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	clusterSchema "github.com/weaviate/weaviate/cluster/schema"
+	clusterTypes "github.com/weaviate/weaviate/cluster/types"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
+	"github.com/weaviate/weaviate/usecases/sharding"
+	shardingcfg "github.com/weaviate/weaviate/usecases/sharding/config"
+)
+
+// ErrUnsupportedMultiTenancyTransition is returned by
+// UpdateMultiTenancyConfig if multi-tenancy can't be enabled or disabled for
+// the class in its current state
+var ErrUnsupportedMultiTenancyTransition = errors.New("unsupported multi-tenancy transition")
+
+// multiTenancyFreezeTimeout bounds how long UpdateMultiTenancyConfig waits for
+// the nodes to block writes to the class
+var multiTenancyFreezeTimeout = 30 * time.Second
+
+// UpdateMultiTenancyConfig replaces the multi-tenancy config of class.
+// Multi-tenancy may only be disabled for classes without tenants and only be
+// enabled for classes without objects. Before enabling it, writes to the
+// class are blocked until the transition is committed or rejected and the
+// objects are counted on every replica.
+//
+// Objects are never moved between shards and tenants: enabling doesn't put
+// existing objects into a "default" tenant and disabling doesn't collapse
+// tenant shards into class-level shards. With the two rules above there is
+// nothing to move, and moving objects while the change is applied would
+// block the schema log for as long as the copy takes. Classes holding data
+// are rejected with ErrUnsupportedMultiTenancyTransition instead.
+func (h *Handler) UpdateMultiTenancyConfig(ctx context.Context, principal *models.Principal,
+	class string, config models.MultiTenancyConfig,
+) error {
+	className := schema.UppercaseClassName(class)
+	err := h.Authorizer.Authorize(principal, authorization.UPDATE, authorization.CollectionsMetadata(className)...)
+	if err != nil {
+		return err
+	}
+
+	initial := h.schemaReader.ReadOnlyClass(className)
+	if initial == nil {
+		return fmt.Errorf("class %q: %w", className, ErrNotFound)
+	}
+	updated := *initial
+	updated.MultiTenancyConfig = &config
+	if err := validateMT(&updated); err != nil {
+		return fmt.Errorf("%w: %w", clusterSchema.ErrBadRequest, err)
+	}
+
+	var from models.MultiTenancyConfig
+	if initial.MultiTenancyConfig != nil {
+		from = *initial.MultiTenancyConfig
+	}
+	if from.Enabled == config.Enabled {
+		if _, err := h.schemaManager.UpdateClass(withActor(ctx, principal), &updated, nil); err != nil {
+			return err
+		}
+		h.notify(ctx, func(l EventListener) { l.OnClassUpdated(&updated) })
+		return nil
+	}
+
+	if config.Enabled && (initial.ReadOnly == nil || !*initial.ReadOnly) {
+		err = h.commitFrozenMultiTenancyTransition(ctx, principal, initial, &updated, from)
+	} else {
+		err = h.commitMultiTenancyTransition(ctx, principal, &updated, from)
+	}
+	if err != nil {
+		return err
+	}
+	h.notify(ctx, func(l EventListener) { l.OnClassUpdated(&updated) })
+	return nil
+}
+
+// commitFrozenMultiTenancyTransition blocks writes to the class on every node
+// before committing the transition, which unblocks them. If the transition
+// isn't committed, writes are unblocked again.
+func (h *Handler) commitFrozenMultiTenancyTransition(ctx context.Context, principal *models.Principal,
+	initial, updated *models.Class, from models.MultiTenancyConfig,
+) error {
+	frozen, readOnly := *initial, true
+	frozen.ReadOnly = &readOnly
+	version, err := h.schemaManager.UpdateClass(withActor(ctx, principal), &frozen, nil)
+	if err != nil {
+		return fmt.Errorf("block writes to class %q: %w", initial.Class, err)
+	}
+
+	err = h.waitForAllNodes(ctx, version, multiTenancyFreezeTimeout)
+	if err != nil {
+		err = fmt.Errorf("block writes to class %q: %w", initial.Class, err)
+	} else {
+		err = h.commitMultiTenancyTransition(ctx, principal, updated, from)
+	}
+	if err != nil {
+		if _, uerr := h.schemaManager.UpdateClass(withActor(ctx, principal), initial, nil); uerr != nil {
+			h.logger.WithField("action", "update_multi_tenancy_config").
+				WithField("class", initial.Class).WithError(uerr).
+				Error("cannot unblock writes to class")
+		}
+	}
+	return err
+}
+
+// commitMultiTenancyTransition validates the transition of updated from the
+// multi-tenancy config from and commits it with a new sharding state
+func (h *Handler) commitMultiTenancyTransition(ctx context.Context, principal *models.Principal,
+	updated *models.Class, from models.MultiTenancyConfig,
+) error {
+	className := updated.Class
+	ss, _, err := h.schemaManager.QueryShardingState(className)
+	if err != nil {
+		return fmt.Errorf("query sharding state for %q: %w", className, err)
+	}
+	enable := updated.MultiTenancyConfig.Enabled
+	if err := h.validateMultiTenancyTransition(ctx, className, ss, enable); err != nil {
+		return err
+	}
+
+	// the sharding config of multi-tenant classes is empty, so it is set
+	// to the defaults when multi-tenancy is disabled
+	updated.ShardingConfig = nil
+	if err := h.parser.parseShardingConfig(updated); err != nil {
+		return fmt.Errorf("parse sharding config: %w", err)
+	}
+	shardState, err := sharding.InitState(className,
+		updated.ShardingConfig.(shardingcfg.Config),
+		h.clusterState.LocalName(), h.schemaManager.StorageCandidates(), updated.ReplicationConfig.Factor,
+		enable)
+	if err != nil {
+		return fmt.Errorf("init sharding state: %w", err)
+	}
+
+	ctx = clusterTypes.ContextWithMultiTenancyMigration(withActor(ctx, principal), from)
+	_, err = h.schemaManager.UpdateClass(ctx, updated, shardState)
+	return err
+}
+
+// validateMultiTenancyTransition checks that class has no tenants before
+// disabling multi-tenancy or no objects on any replica before enabling it
+func (h *Handler) validateMultiTenancyTransition(ctx context.Context, className string, ss *sharding.State, enable bool) error {
+	if !enable {
+		if n := len(ss.Physical); n > 0 {
+			return fmt.Errorf("%w: class %q has %d tenants, delete them before disabling multi-tenancy",
+				ErrUnsupportedMultiTenancyTransition, className, n)
+		}
+		return nil
+	}
+
+	counts, err := h.dataMigrator.ReplicaObjectCounts(ctx, className)
+	if err != nil {
+		return fmt.Errorf("count objects of class %q: %w", className, err)
+	}
+	for shard, nodes := range counts {
+		for node, count := range nodes {
+			if count > 0 {
+				return fmt.Errorf("%w: class %q has objects in shard %q on node %q, multi-tenancy can only be enabled for empty classes",
+					ErrUnsupportedMultiTenancyTransition, className, shard, node)
+			}
+		}
+	}
+	return nil
+}
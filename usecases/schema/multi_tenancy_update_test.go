@@ -0,0 +1,153 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/sharding"
+)
+
+func TestHandler_UpdateMultiTenancyConfig(t *testing.T) {
+	ctx := context.Background()
+	newClass := func(mt bool) *models.Class {
+		return &models.Class{
+			Class:              "C",
+			MultiTenancyConfig: &models.MultiTenancyConfig{Enabled: mt},
+			ReplicationConfig:  &models.ReplicationConfig{Factor: 1},
+		}
+	}
+	shardState := func(shards ...string) *sharding.State {
+		ss := &sharding.State{Physical: map[string]sharding.Physical{}}
+		for _, s := range shards {
+			ss.Physical[s] = sharding.Physical{Name: s, BelongsToNodes: []string{"node1"}}
+		}
+		return ss
+	}
+
+	frozen := func(readOnly bool) interface{} {
+		return mock.MatchedBy(func(c *models.Class) bool {
+			return (c.ReadOnly != nil && *c.ReadOnly) == readOnly && !c.MultiTenancyConfig.Enabled
+		})
+	}
+
+	t.Run("enable multi-tenancy of an empty class", func(t *testing.T) {
+		handler, fakeSchemaManager := newTestHandler(t, &fakeDB{})
+		fakeSchemaManager.On("ReadOnlyClass", "C").Return(newClass(false))
+		fakeSchemaManager.On("UpdateClass", frozen(true), (*sharding.State)(nil)).Return(nil).Once()
+		fakeSchemaManager.On("QueryShardingState", "C").Return(shardState("S1"), nil)
+		fakeSchemaManager.On("ReplicaObjectCounts", mock.Anything, "C").
+			Return(map[string]map[string]int64{"S1": {"node1": 0}}, nil)
+		fakeSchemaManager.On("UpdateClass", mock.MatchedBy(func(c *models.Class) bool {
+			return c.MultiTenancyConfig.Enabled && c.MultiTenancyConfig.AutoTenantCreation &&
+				(c.ReadOnly == nil || !*c.ReadOnly)
+		}), mock.MatchedBy(func(ss *sharding.State) bool {
+			return ss != nil && ss.PartitioningEnabled && len(ss.Physical) == 0
+		})).Return(nil).Once()
+
+		err := handler.UpdateMultiTenancyConfig(ctx, nil, "c",
+			models.MultiTenancyConfig{Enabled: true, AutoTenantCreation: true})
+		require.Nil(t, err)
+		fakeSchemaManager.AssertExpectations(t)
+		fakeSchemaManager.AssertNumberOfCalls(t, "UpdateClass", 2)
+	})
+
+	t.Run("enable multi-tenancy of a class with objects", func(t *testing.T) {
+		handler, fakeSchemaManager := newTestHandler(t, &fakeDB{})
+		fakeSchemaManager.On("ReadOnlyClass", "C").Return(newClass(false))
+		fakeSchemaManager.On("UpdateClass", frozen(true), (*sharding.State)(nil)).Return(nil).Once()
+		fakeSchemaManager.On("QueryShardingState", "C").Return(shardState("S1"), nil)
+		fakeSchemaManager.On("ReplicaObjectCounts", mock.Anything, "C").
+			Return(map[string]map[string]int64{"S1": {"node1": 0, "node2": 3}}, nil)
+		fakeSchemaManager.On("UpdateClass", frozen(false), (*sharding.State)(nil)).Return(nil).Once()
+
+		err := handler.UpdateMultiTenancyConfig(ctx, nil, "C", models.MultiTenancyConfig{Enabled: true})
+		assert.ErrorIs(t, err, ErrUnsupportedMultiTenancyTransition)
+		assert.ErrorContains(t, err, "node2")
+		fakeSchemaManager.AssertExpectations(t)
+	})
+
+	t.Run("enable multi-tenancy of a read-only class", func(t *testing.T) {
+		handler, fakeSchemaManager := newTestHandler(t, &fakeDB{})
+		class := newClass(false)
+		vTrue := true
+		class.ReadOnly = &vTrue
+		fakeSchemaManager.On("ReadOnlyClass", "C").Return(class)
+		fakeSchemaManager.On("QueryShardingState", "C").Return(shardState("S1"), nil)
+		fakeSchemaManager.On("ReplicaObjectCounts", mock.Anything, "C").
+			Return(map[string]map[string]int64{"S1": {"node1": 0}}, nil)
+		fakeSchemaManager.On("UpdateClass", mock.MatchedBy(func(c *models.Class) bool {
+			return c.MultiTenancyConfig.Enabled && c.ReadOnly != nil && *c.ReadOnly
+		}), mock.Anything).Return(nil).Once()
+
+		require.Nil(t, handler.UpdateMultiTenancyConfig(ctx, nil, "C", models.MultiTenancyConfig{Enabled: true}))
+		fakeSchemaManager.AssertExpectations(t)
+		fakeSchemaManager.AssertNumberOfCalls(t, "UpdateClass", 1)
+	})
+
+	t.Run("disable multi-tenancy of a class without tenants", func(t *testing.T) {
+		handler, fakeSchemaManager := newTestHandler(t, &fakeDB{})
+		fakeSchemaManager.On("ReadOnlyClass", "C").Return(newClass(true))
+		fakeSchemaManager.On("QueryShardingState", "C").Return(shardState(), nil)
+		fakeSchemaManager.On("UpdateClass", mock.Anything, mock.MatchedBy(func(ss *sharding.State) bool {
+			return ss != nil && !ss.PartitioningEnabled && len(ss.Physical) == 1
+		})).Return(nil)
+
+		require.Nil(t, handler.UpdateMultiTenancyConfig(ctx, nil, "C", models.MultiTenancyConfig{}))
+		fakeSchemaManager.AssertExpectations(t)
+	})
+
+	t.Run("disable multi-tenancy of a class with tenants", func(t *testing.T) {
+		handler, fakeSchemaManager := newTestHandler(t, &fakeDB{})
+		fakeSchemaManager.On("ReadOnlyClass", "C").Return(newClass(true))
+		fakeSchemaManager.On("QueryShardingState", "C").Return(shardState("t1", "t2"), nil)
+
+		err := handler.UpdateMultiTenancyConfig(ctx, nil, "C", models.MultiTenancyConfig{})
+		assert.ErrorIs(t, err, ErrUnsupportedMultiTenancyTransition)
+		assert.ErrorContains(t, err, "2 tenants")
+	})
+
+	t.Run("update flags only", func(t *testing.T) {
+		handler, fakeSchemaManager := newTestHandler(t, &fakeDB{})
+		fakeSchemaManager.On("ReadOnlyClass", "C").Return(newClass(true))
+		fakeSchemaManager.On("UpdateClass", mock.MatchedBy(func(c *models.Class) bool {
+			return c.MultiTenancyConfig.AutoTenantActivation
+		}), (*sharding.State)(nil)).Return(nil)
+
+		err := handler.UpdateMultiTenancyConfig(ctx, nil, "C",
+			models.MultiTenancyConfig{Enabled: true, AutoTenantActivation: true})
+		require.Nil(t, err)
+		fakeSchemaManager.AssertExpectations(t)
+		fakeSchemaManager.AssertNotCalled(t, "QueryShardingState", mock.Anything)
+	})
+
+	t.Run("invalid flags", func(t *testing.T) {
+		handler, fakeSchemaManager := newTestHandler(t, &fakeDB{})
+		fakeSchemaManager.On("ReadOnlyClass", "C").Return(newClass(false))
+
+		err := handler.UpdateMultiTenancyConfig(ctx, nil, "C", models.MultiTenancyConfig{AutoTenantCreation: true})
+		assert.ErrorContains(t, err, "autoTenantCreation")
+	})
+
+	t.Run("class not found", func(t *testing.T) {
+		handler, fakeSchemaManager := newTestHandler(t, &fakeDB{})
+		fakeSchemaManager.On("ReadOnlyClass", "C").Return(nil)
+
+		err := handler.UpdateMultiTenancyConfig(ctx, nil, "C", models.MultiTenancyConfig{Enabled: true})
+		assert.ErrorIs(t, err, ErrNotFound)
+	})
+}
@@ -0,0 +1,252 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math"
+	"slices"
+	"sort"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/schema/crossref"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
+)
+
+// PropertyValidationError is a property of an object which doesn't pass
+// ValidateObjectAgainstClass
+type PropertyValidationError struct {
+	Property string
+	Message  string
+}
+
+func (e PropertyValidationError) Error() string {
+	return fmt.Sprintf("property %q: %s", e.Property, e.Message)
+}
+
+// ValidateObjectAgainstClass checks the properties of an object before it is
+// written: every required property of class is present, the values match the
+// data types of their properties and references are beacons to valid UUIDs.
+// Properties which are not part of class are errors if the class sets
+// StrictPropertyValidation, otherwise they are left to auto schema. The
+// properties are expected as decoded from JSON. All errors are returned at
+// once, the error is only set if the validation couldn't run.
+func (h *Handler) ValidateObjectAgainstClass(principal *models.Principal, class string,
+	properties map[string]interface{},
+) ([]PropertyValidationError, error) {
+	className := schema.UppercaseClassName(class)
+	err := h.Authorizer.Authorize(principal, authorization.READ, authorization.CollectionsMetadata(className)...)
+	if err != nil {
+		return nil, err
+	}
+
+	c := h.schemaReader.ReadOnlyClass(className)
+	if c == nil {
+		return nil, fmt.Errorf("class %q: %w", className, ErrNotFound)
+	}
+
+	var errs []PropertyValidationError
+	present := map[string]bool{}
+	keys := make([]string, 0, len(properties))
+	for key := range properties {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		name := schema.ResolvePropertyAlias(c, schema.LowercaseFirstLetter(key))
+		prop, err := schema.GetPropertyByName(c, name)
+		if err != nil {
+			if c.StrictPropertyValidation != nil && *c.StrictPropertyValidation {
+				errs = append(errs, PropertyValidationError{
+					Property: key,
+					Message:  fmt.Sprintf("no such property in class %q", className),
+				})
+			}
+			continue
+		}
+		present[prop.Name] = true
+		if msg := validatePropertyValue(prop, properties[key]); msg != "" {
+			errs = append(errs, PropertyValidationError{Property: key, Message: msg})
+		}
+	}
+
+	for _, prop := range c.Properties {
+		if prop.Required != nil && *prop.Required && !present[prop.Name] {
+			errs = append(errs, PropertyValidationError{Property: prop.Name, Message: "required property is missing"})
+		}
+	}
+	return errs, nil
+}
+
+// validatePropertyValue returns why value isn't valid for prop, or an empty
+// string if it is
+func validatePropertyValue(prop *models.Property, value interface{}) string {
+	if value == nil {
+		if prop.Required != nil && *prop.Required {
+			return "required property is null"
+		}
+		return ""
+	}
+
+	dataType, ok := schema.AsPrimitive(prop.DataType)
+	if !ok {
+		if dataType, ok = schema.AsNested(prop.DataType); !ok {
+			return validateReferences(prop, value)
+		}
+	}
+	if itemType, ok := schema.IsArrayType(dataType); ok {
+		items, ok := value.([]interface{})
+		if !ok {
+			return fmt.Sprintf("expected an array of %s, got %s", itemType, jsonType(value))
+		}
+		for i, item := range items {
+			if msg := validateScalar(itemType, item); msg != "" {
+				return fmt.Sprintf("item %d: %s", i, msg)
+			}
+		}
+		return ""
+	}
+	return validateScalar(dataType, value)
+}
+
+func validateScalar(dataType schema.DataType, value interface{}) string {
+	valid := false
+	switch dataType {
+	case schema.DataTypeText, schema.DataTypeString:
+		_, valid = value.(string)
+	case schema.DataTypeInt:
+		valid = isWholeNumber(value)
+	case schema.DataTypeNumber:
+		valid = isNumber(value)
+	case schema.DataTypeBoolean:
+		_, valid = value.(bool)
+	case schema.DataTypeDate:
+		if s, ok := value.(string); ok {
+			if _, err := time.Parse(time.RFC3339, s); err != nil {
+				return fmt.Sprintf("expected an RFC3339 date, got %q", s)
+			}
+			return ""
+		}
+	case schema.DataTypeUUID:
+		if s, ok := value.(string); ok {
+			if !strfmt.IsUUID(s) {
+				return fmt.Sprintf("expected a uuid, got %q", s)
+			}
+			return ""
+		}
+	case schema.DataTypeBlob:
+		if s, ok := value.(string); ok {
+			if _, err := base64.StdEncoding.DecodeString(s); err != nil {
+				return "expected a base64 encoded blob"
+			}
+			return ""
+		}
+	case schema.DataTypeGeoCoordinates:
+		if m, ok := value.(map[string]interface{}); ok {
+			if !isNumber(m["latitude"]) || !isNumber(m["longitude"]) {
+				return "expected geo coordinates with a numeric latitude and longitude"
+			}
+			return ""
+		}
+	case schema.DataTypePhoneNumber:
+		if m, ok := value.(map[string]interface{}); ok {
+			if _, ok := m["input"].(string); !ok {
+				return "expected a phone number with an input"
+			}
+			return ""
+		}
+	case schema.DataTypeObject:
+		_, valid = value.(map[string]interface{})
+	default:
+		// the data type can't be validated here
+		return ""
+	}
+	if !valid {
+		return fmt.Sprintf("expected %s, got %s", dataType, jsonType(value))
+	}
+	return ""
+}
+
+// validateReferences checks that value is a list of references to the
+// classes of prop
+func validateReferences(prop *models.Property, value interface{}) string {
+	refs, ok := value.([]interface{})
+	if !ok {
+		return fmt.Sprintf("expected an array of references, got %s", jsonType(value))
+	}
+	for i, r := range refs {
+		ref, ok := r.(map[string]interface{})
+		if !ok {
+			return fmt.Sprintf("reference %d: expected an object with a beacon, got %s", i, jsonType(r))
+		}
+		beacon, ok := ref["beacon"].(string)
+		if !ok {
+			return fmt.Sprintf("reference %d: beacon is not a string", i)
+		}
+		parsed, err := crossref.Parse(beacon)
+		if err != nil {
+			return fmt.Sprintf("reference %d: %s", i, err)
+		}
+		if parsed.Class != "" && !slices.Contains(prop.DataType, parsed.Class) {
+			return fmt.Sprintf("reference %d: class %q is not one of %v", i, parsed.Class, prop.DataType)
+		}
+	}
+	return ""
+}
+
+func isNumber(value interface{}) bool {
+	switch value.(type) {
+	case float64, float32, int, int64, int32, json.Number:
+		return true
+	default:
+		return false
+	}
+}
+
+func isWholeNumber(value interface{}) bool {
+	switch v := value.(type) {
+	case int, int64, int32:
+		return true
+	case float64:
+		return v == math.Trunc(v)
+	case json.Number:
+		_, err := v.Int64()
+		return err == nil
+	default:
+		return false
+	}
+}
+
+// jsonType names the JSON type of a decoded value for error messages
+func jsonType(value interface{}) string {
+	switch value.(type) {
+	case string:
+		return "a string"
+	case bool:
+		return "a boolean"
+	case map[string]interface{}:
+		return "an object"
+	case []interface{}:
+		return "an array"
+	default:
+		if isNumber(value) {
+			return "a number"
+		}
+		return fmt.Sprintf("%T", value)
+	}
+}
@@ -0,0 +1,125 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+func TestHandler_ValidateObjectAgainstClass(t *testing.T) {
+	required, strict := true, true
+	newClass := func() *models.Class {
+		return &models.Class{
+			Class: "Article",
+			Properties: []*models.Property{
+				{Name: "title", DataType: []string{"text"}, Required: &required, Aliases: []string{"headline"}},
+				{Name: "wordCount", DataType: []string{"int"}},
+				{Name: "score", DataType: []string{"number"}},
+				{Name: "published", DataType: []string{"boolean"}},
+				{Name: "date", DataType: []string{"date"}},
+				{Name: "tags", DataType: []string{"text[]"}},
+				{Name: "externalId", DataType: []string{"uuid"}},
+				{Name: "location", DataType: []string{"geoCoordinates"}},
+				{Name: "author", DataType: []string{"Author"}},
+			},
+		}
+	}
+	validate := func(t *testing.T, class *models.Class, props map[string]interface{}) []PropertyValidationError {
+		handler, fakeSchemaManager := newTestHandler(t, &fakeDB{})
+		fakeSchemaManager.On("ReadOnlyClass", "Article").Return(class)
+		errs, err := handler.ValidateObjectAgainstClass(nil, "Article", props)
+		require.Nil(t, err)
+		return errs
+	}
+	properties := func(errs []PropertyValidationError) []string {
+		names := make([]string, len(errs))
+		for i, e := range errs {
+			names[i] = e.Property
+		}
+		return names
+	}
+
+	t.Run("valid", func(t *testing.T) {
+		errs := validate(t, newClass(), map[string]interface{}{
+			"headline":   "a",
+			"wordCount":  float64(3),
+			"score":      0.5,
+			"published":  true,
+			"date":       "2024-01-02T03:04:05Z",
+			"tags":       []interface{}{"a", "b"},
+			"externalId": "8fa8e4e8-6a83-4a2b-8e34-6ff4d2f4d6a5",
+			"location":   map[string]interface{}{"latitude": 1.0, "longitude": 2.0},
+			"author": []interface{}{
+				map[string]interface{}{"beacon": "weaviate://localhost/Author/8fa8e4e8-6a83-4a2b-8e34-6ff4d2f4d6a5"},
+			},
+			"unknown": "left to auto schema",
+		})
+		assert.Empty(t, errs)
+	})
+
+	t.Run("required properties", func(t *testing.T) {
+		errs := validate(t, newClass(), map[string]interface{}{"wordCount": float64(3)})
+		assert.Equal(t, []PropertyValidationError{{Property: "title", Message: "required property is missing"}}, errs)
+
+		errs = validate(t, newClass(), map[string]interface{}{"title": nil})
+		assert.Equal(t, []string{"title"}, properties(errs))
+	})
+
+	t.Run("data types", func(t *testing.T) {
+		errs := validate(t, newClass(), map[string]interface{}{
+			"title":      1.0,
+			"wordCount":  1.5,
+			"score":      "high",
+			"published":  "yes",
+			"date":       "yesterday",
+			"tags":       []interface{}{"a", false},
+			"externalId": "not-a-uuid",
+			"location":   map[string]interface{}{"latitude": "north"},
+		})
+		// all errors are reported at once
+		assert.Equal(t, []string{
+			"date", "externalId", "location", "published", "score", "tags", "title", "wordCount",
+		}, properties(errs))
+	})
+
+	t.Run("references", func(t *testing.T) {
+		for name, ref := range map[string]interface{}{
+			"not an array":  map[string]interface{}{"beacon": "weaviate://localhost/8fa8e4e8-6a83-4a2b-8e34-6ff4d2f4d6a5"},
+			"no beacon":     []interface{}{map[string]interface{}{"href": "x"}},
+			"invalid uuid":  []interface{}{map[string]interface{}{"beacon": "weaviate://localhost/Author/123"}},
+			"another class": []interface{}{map[string]interface{}{"beacon": "weaviate://localhost/Article/8fa8e4e8-6a83-4a2b-8e34-6ff4d2f4d6a5"}},
+		} {
+			t.Run(name, func(t *testing.T) {
+				errs := validate(t, newClass(), map[string]interface{}{"title": "a", "author": ref})
+				assert.Equal(t, []string{"author"}, properties(errs))
+			})
+		}
+	})
+
+	t.Run("strict property validation", func(t *testing.T) {
+		class := newClass()
+		class.StrictPropertyValidation = &strict
+		errs := validate(t, class, map[string]interface{}{"title": "a", "unknown": 1})
+		assert.Equal(t, []string{"unknown"}, properties(errs))
+	})
+
+	t.Run("unknown class", func(t *testing.T) {
+		handler, fakeSchemaManager := newTestHandler(t, &fakeDB{})
+		fakeSchemaManager.On("ReadOnlyClass", "Missing").Return(nil)
+		_, err := handler.ValidateObjectAgainstClass(nil, "Missing", nil)
+		assert.ErrorIs(t, err, ErrNotFound)
+	})
+}
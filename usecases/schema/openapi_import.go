@@ -0,0 +1,372 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
+	"gopkg.in/yaml.v2"
+)
+
+// ErrInvalidOpenAPISpec is returned if an OpenAPI document can't be parsed
+var ErrInvalidOpenAPISpec = errors.New("invalid openapi spec")
+
+const openAPISchemaRefPrefix = "#/components/schemas/"
+
+// OpenAPIImportOptions control how OpenAPI schemas are mapped to classes
+type OpenAPIImportOptions struct {
+	// DefaultVectorizer is set as vectorizer of all imported classes. If empty
+	// the configured default vectorizer is used.
+	DefaultVectorizer string
+	// SkipUnknownTypes skips properties which can't be mapped to a weaviate
+	// data type. Otherwise the whole class is skipped.
+	SkipUnknownTypes bool
+}
+
+// openAPIDocument contains the parts of an OpenAPI 3.0 document relevant for
+// the import. YAML is a superset of JSON, so both formats are supported.
+type openAPIDocument struct {
+	OpenAPI    string `yaml:"openapi"`
+	Components struct {
+		Schemas map[string]*openAPISchema `yaml:"schemas"`
+	} `yaml:"components"`
+}
+
+type openAPISchema struct {
+	Ref         string                    `yaml:"$ref"`
+	Type        string                    `yaml:"type"`
+	Format      string                    `yaml:"format"`
+	Description string                    `yaml:"description"`
+	Properties  map[string]*openAPISchema `yaml:"properties"`
+	Items       *openAPISchema            `yaml:"items"`
+	AllOf       []*openAPISchema          `yaml:"allOf"`
+	OneOf       []*openAPISchema          `yaml:"oneOf"`
+	AnyOf       []*openAPISchema          `yaml:"anyOf"`
+}
+
+func (s *openAPISchema) isObject() bool {
+	return s.Ref == "" && (s.Type == "object" || (s.Type == "" && s.Properties != nil))
+}
+
+// ImportFromOpenAPI maps the object schemas in components.schemas of an
+// OpenAPI 3.0 document to classes, ordered by class name. string, integer, number
+// and boolean properties, and arrays of them, are mapped to the equivalent
+// weaviate data types, references to other object schemas to
+// cross-references.
+//
+// Constructs which can't be mapped, such as inline objects or allOf, are
+// reported as warnings. The only fatal error is an unparsable document, it
+// is returned as the only warning and wraps ErrInvalidOpenAPISpec.
+func ImportFromOpenAPI(spec []byte, opts OpenAPIImportOptions) ([]models.Class, []error) {
+	var doc openAPIDocument
+	if err := yaml.Unmarshal(spec, &doc); err != nil {
+		return nil, []error{fmt.Errorf("%w: %w", ErrInvalidOpenAPISpec, err)}
+	}
+	if !strings.HasPrefix(doc.OpenAPI, "3.") {
+		return nil, []error{fmt.Errorf("%w: unsupported openapi version %q", ErrInvalidOpenAPISpec, doc.OpenAPI)}
+	}
+
+	schemas := doc.Components.Schemas
+	names := make([]string, 0, len(schemas))
+	for name, s := range schemas {
+		if s != nil && s.isObject() {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	sort.SliceStable(names, func(i, j int) bool {
+		return schema.UppercaseClassName(names[i]) < schema.UppercaseClassName(names[j])
+	})
+
+	var (
+		warnings []error
+		classes  = make([]models.Class, 0, len(names))
+		skipped  = map[string]bool{}
+	)
+	for i, name := range names {
+		if i > 0 && schema.UppercaseClassName(names[i-1]) == schema.UppercaseClassName(name) {
+			warnings = append(warnings, fmt.Errorf("skip schema %q: same class name as schema %q", name, names[i-1]))
+			continue
+		}
+		class, errs := openAPIClass(schemas, name, opts)
+		if len(errs) > 0 && !opts.SkipUnknownTypes {
+			warnings = append(warnings, fmt.Errorf("skip schema %q: %w", name, errors.Join(errs...)))
+			skipped[class.Class] = true
+			continue
+		}
+		for _, err := range errs {
+			warnings = append(warnings, fmt.Errorf("schema %q: %w", name, err))
+		}
+		classes = append(classes, class)
+	}
+
+	// references to skipped classes would fail on import, drop them like
+	// any other unknown type until no class refers to a skipped one
+	for len(skipped) > 0 {
+		removed := map[string]bool{}
+		kept := classes[:0]
+		for _, class := range classes {
+			var props []*models.Property
+			for _, prop := range class.Properties {
+				if !skipped[prop.DataType[0]] {
+					props = append(props, prop)
+					continue
+				}
+				err := fmt.Errorf("property %q: reference to skipped schema %q", prop.Name, prop.DataType[0])
+				if !opts.SkipUnknownTypes {
+					warnings = append(warnings, fmt.Errorf("skip class %q: %w", class.Class, err))
+					removed[class.Class] = true
+					break
+				}
+				warnings = append(warnings, fmt.Errorf("class %q: %w", class.Class, err))
+			}
+			if !removed[class.Class] {
+				class.Properties = props
+				kept = append(kept, class)
+			}
+		}
+		classes, skipped = kept, removed
+	}
+
+	return classes, warnings
+}
+
+func openAPIClass(schemas map[string]*openAPISchema, name string, opts OpenAPIImportOptions) (models.Class, []error) {
+	s := schemas[name]
+	class := models.Class{
+		Class:       schema.UppercaseClassName(name),
+		Description: s.Description,
+		Vectorizer:  opts.DefaultVectorizer,
+	}
+
+	propNames := make([]string, 0, len(s.Properties))
+	for propName := range s.Properties {
+		propNames = append(propNames, propName)
+	}
+	sort.Strings(propNames)
+
+	var errs []error
+	for _, propName := range propNames {
+		prop := s.Properties[propName]
+		if prop == nil {
+			continue
+		}
+		dataType, err := openAPIDataType(schemas, prop, 0)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("property %q: %w", propName, err))
+			continue
+		}
+		class.Properties = append(class.Properties, &models.Property{
+			Name:        propName,
+			Description: prop.Description,
+			DataType:    dataType,
+		})
+	}
+	return class, errs
+}
+
+// openAPIDataType maps a property schema to a weaviate data type. depth
+// guards against cyclic references between non-object schemas.
+func openAPIDataType(schemas map[string]*openAPISchema, s *openAPISchema, depth int) ([]string, error) {
+	if depth > len(schemas) {
+		return nil, fmt.Errorf("cyclic reference %q", s.Ref)
+	}
+
+	if s.Ref != "" {
+		name, ok := strings.CutPrefix(s.Ref, openAPISchemaRefPrefix)
+		if !ok {
+			return nil, fmt.Errorf("unsupported reference %q", s.Ref)
+		}
+		target := schemas[name]
+		if target == nil {
+			return nil, fmt.Errorf("unresolved reference %q", s.Ref)
+		}
+		if target.isObject() {
+			return []string{schema.UppercaseClassName(name)}, nil
+		}
+		return openAPIDataType(schemas, target, depth+1)
+	}
+
+	if len(s.AllOf) > 0 || len(s.OneOf) > 0 || len(s.AnyOf) > 0 {
+		return nil, fmt.Errorf("unsupported allOf, oneOf or anyOf")
+	}
+
+	switch s.Type {
+	case "string":
+		switch s.Format {
+		case "date", "date-time":
+			return schema.DataTypeDate.PropString(), nil
+		case "uuid":
+			return schema.DataTypeUUID.PropString(), nil
+		case "byte":
+			return schema.DataTypeBlob.PropString(), nil
+		default:
+			return schema.DataTypeText.PropString(), nil
+		}
+	case "integer":
+		return schema.DataTypeInt.PropString(), nil
+	case "number":
+		return schema.DataTypeNumber.PropString(), nil
+	case "boolean":
+		return schema.DataTypeBoolean.PropString(), nil
+	case "array":
+		if s.Items == nil {
+			return nil, fmt.Errorf("array without items")
+		}
+		dataType, err := openAPIDataType(schemas, s.Items, depth+1)
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := schema.AsPrimitive(dataType); !ok {
+			// a reference can point to multiple objects already
+			return dataType, nil
+		}
+		switch dt := schema.DataType(dataType[0]); dt {
+		case schema.DataTypeText, schema.DataTypeInt, schema.DataTypeNumber,
+			schema.DataTypeBoolean, schema.DataTypeDate, schema.DataTypeUUID:
+			return []string{dataType[0] + "[]"}, nil
+		default:
+			return nil, fmt.Errorf("unsupported array of %s", dt)
+		}
+	case "object":
+		return nil, fmt.Errorf("unsupported inline object, use a reference instead")
+	case "":
+		return nil, fmt.Errorf("missing type")
+	default:
+		return nil, fmt.Errorf("unknown type %q", s.Type)
+	}
+}
+
+// AddClassesFromOpenAPI imports the object schemas of an OpenAPI document,
+// see ImportFromOpenAPI. Classes are added in dependency order, so that
+// referenced classes exist first. References which form a cycle are added as
+// properties once all classes exist.
+//
+// It returns the added classes and the import warnings. On error the classes
+// added so far are not removed.
+func (h *Handler) AddClassesFromOpenAPI(ctx context.Context, principal *models.Principal,
+	spec []byte, opts OpenAPIImportOptions,
+) ([]*models.Class, []error, error) {
+	classes, warnings := ImportFromOpenAPI(spec, opts)
+	for _, w := range warnings {
+		if errors.Is(w, ErrInvalidOpenAPISpec) {
+			return nil, nil, w
+		}
+	}
+
+	// authorize all classes up front to not leave a partial import behind
+	for _, class := range classes {
+		if err := h.Authorizer.Authorize(principal, authorization.CREATE, authorization.CollectionsMetadata(class.Class)...); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	ordered, deferred := orderByReferences(classes)
+	added := make([]*models.Class, 0, len(ordered))
+	for _, class := range ordered {
+		res, err := h.AddClass(ctx, principal, class)
+		if err != nil {
+			return added, warnings, fmt.Errorf("add class %q: %w", class.Class, err)
+		}
+		// the next class may reference this one
+		if err := h.ReadAtToken(ctx, res.Token()); err != nil {
+			return added, warnings, err
+		}
+		added = append(added, res.Class)
+	}
+
+	for _, class := range ordered {
+		props := deferred[class.Class]
+		if len(props) == 0 {
+			continue
+		}
+		_, _, err := h.AddClassProperty(ctx, principal, h.schemaReader.ReadOnlyClass(class.Class),
+			class.Class, false, props...)
+		if err != nil {
+			return added, warnings, fmt.Errorf("add references of class %q: %w", class.Class, err)
+		}
+	}
+
+	return added, warnings, nil
+}
+
+// orderByReferences orders classes such that classes are preceded by the
+// classes they reference. Cycles are broken by removing the offending
+// reference properties, they are returned per class to be added later.
+// References to classes outside of classes are ignored.
+func orderByReferences(classes []models.Class) ([]*models.Class, map[string][]*models.Property) {
+	remaining := make([]*models.Class, len(classes))
+	imported := make(map[string]bool, len(classes))
+	for i := range classes {
+		remaining[i] = &classes[i]
+		imported[classes[i].Class] = true
+	}
+
+	pending := func(class *models.Class, prop *models.Property, added map[string]bool) bool {
+		for _, dt := range prop.DataType {
+			if imported[dt] && !added[dt] && dt != class.Class {
+				return true
+			}
+		}
+		return false
+	}
+
+	var (
+		ordered  = make([]*models.Class, 0, len(classes))
+		deferred = map[string][]*models.Property{}
+		added    = make(map[string]bool, len(classes))
+	)
+	for len(remaining) > 0 {
+		next := -1
+		for i, class := range remaining {
+			ready := true
+			for _, prop := range class.Properties {
+				if pending(class, prop, added) {
+					ready = false
+					break
+				}
+			}
+			if ready {
+				next = i
+				break
+			}
+		}
+
+		if next == -1 {
+			// all remaining classes are part of a cycle, break it at the first one
+			next = 0
+			class := remaining[0]
+			var props []*models.Property
+			for _, prop := range class.Properties {
+				if pending(class, prop, added) {
+					deferred[class.Class] = append(deferred[class.Class], prop)
+				} else {
+					props = append(props, prop)
+				}
+			}
+			class.Properties = props
+		}
+
+		class := remaining[next]
+		ordered = append(ordered, class)
+		added[class.Class] = true
+		remaining = append(remaining[:next], remaining[next+1:]...)
+	}
+	return ordered, deferred
+}
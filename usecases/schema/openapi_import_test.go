@@ -0,0 +1,191 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+const petStoreSpec = `
+openapi: 3.0.3
+info:
+  title: Pet store
+  version: 1.0.0
+components:
+  schemas:
+    Pet:
+      type: object
+      description: A pet for sale
+      properties:
+        name:
+          type: string
+          description: The name of the pet
+        bornAt:
+          type: string
+          format: date-time
+        age:
+          type: integer
+        weight:
+          type: number
+        vaccinated:
+          type: boolean
+        tags:
+          type: array
+          items:
+            type: string
+        status:
+          $ref: '#/components/schemas/Status'
+        owner:
+          $ref: '#/components/schemas/owner'
+        friends:
+          type: array
+          items:
+            $ref: '#/components/schemas/Pet'
+    owner:
+      type: object
+      properties:
+        pets:
+          type: array
+          items:
+            $ref: '#/components/schemas/Pet'
+    Status:
+      type: string
+      enum: [available, sold]
+`
+
+func TestImportFromOpenAPI(t *testing.T) {
+	t.Run("supported types", func(t *testing.T) {
+		classes, warnings := ImportFromOpenAPI([]byte(petStoreSpec), OpenAPIImportOptions{DefaultVectorizer: "model1"})
+		assert.Empty(t, warnings)
+		assert.Equal(t, []models.Class{
+			{
+				Class: "Owner",
+				Properties: []*models.Property{
+					{Name: "pets", DataType: []string{"Pet"}},
+				},
+				Vectorizer: "model1",
+			},
+			{
+				Class:       "Pet",
+				Description: "A pet for sale",
+				Properties: []*models.Property{
+					{Name: "age", DataType: []string{"int"}},
+					{Name: "bornAt", DataType: []string{"date"}},
+					{Name: "friends", DataType: []string{"Pet"}},
+					{Name: "name", DataType: []string{"text"}, Description: "The name of the pet"},
+					{Name: "owner", DataType: []string{"Owner"}},
+					{Name: "status", DataType: []string{"text"}},
+					{Name: "tags", DataType: []string{"text[]"}},
+					{Name: "vaccinated", DataType: []string{"boolean"}},
+					{Name: "weight", DataType: []string{"number"}},
+				},
+				Vectorizer: "model1",
+			},
+		}, classes)
+	})
+
+	spec := []byte(`{
+		"openapi": "3.0.0",
+		"components": {"schemas": {
+			"Author": {"type": "object", "properties": {
+				"name": {"type": "string"},
+				"address": {"type": "object", "properties": {"street": {"type": "string"}}}
+			}},
+			"Book": {"type": "object", "properties": {
+				"title": {"type": "string"},
+				"writtenBy": {"$ref": "#/components/schemas/Author"}
+			}}
+		}}
+	}`)
+
+	t.Run("unsupported construct skips class", func(t *testing.T) {
+		classes, warnings := ImportFromOpenAPI(spec, OpenAPIImportOptions{})
+		// Book references the skipped Author, so it is skipped as well
+		assert.Empty(t, classes)
+		require.Len(t, warnings, 2)
+		assert.ErrorContains(t, warnings[0], `skip schema "Author": property "address": unsupported inline object`)
+		assert.ErrorContains(t, warnings[1], `skip class "Book": property "writtenBy": reference to skipped schema "Author"`)
+	})
+
+	t.Run("unsupported construct skips property", func(t *testing.T) {
+		classes, warnings := ImportFromOpenAPI(spec, OpenAPIImportOptions{SkipUnknownTypes: true})
+		require.Len(t, warnings, 1)
+		assert.ErrorContains(t, warnings[0], `schema "Author": property "address": unsupported inline object`)
+		require.Len(t, classes, 2)
+		assert.Equal(t, []*models.Property{{Name: "name", DataType: []string{"text"}}}, classes[0].Properties)
+		assert.Len(t, classes[1].Properties, 2)
+	})
+
+	t.Run("invalid spec", func(t *testing.T) {
+		for _, spec := range []string{`{`, `{"swagger": "2.0"}`} {
+			classes, warnings := ImportFromOpenAPI([]byte(spec), OpenAPIImportOptions{})
+			assert.Nil(t, classes)
+			require.Len(t, warnings, 1)
+			assert.ErrorIs(t, warnings[0], ErrInvalidOpenAPISpec)
+		}
+	})
+}
+
+func TestHandler_AddClassesFromOpenAPI(t *testing.T) {
+	handler, fakeSchemaManager := newTestHandler(t, &fakeDB{})
+
+	var added []string
+	fakeSchemaManager.On("AddClass", mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		added = append(added, args.Get(0).(*models.Class).Class)
+	})
+	for _, name := range []string{"Owner", "Pet"} {
+		fakeSchemaManager.On("ReadOnlyClass", name).Return(&models.Class{Class: name})
+	}
+	// the cycle between Owner and Pet is broken at Owner
+	fakeSchemaManager.On("AddProperty", "Owner", mock.MatchedBy(func(props []*models.Property) bool {
+		return len(props) == 1 && props[0].Name == "pets"
+	})).Return(nil)
+
+	classes, warnings, err := handler.AddClassesFromOpenAPI(context.Background(), nil,
+		[]byte(petStoreSpec), OpenAPIImportOptions{})
+	require.Nil(t, err)
+	assert.Empty(t, warnings)
+	assert.Len(t, classes, 2)
+	assert.Equal(t, []string{"Owner", "Pet"}, added)
+	fakeSchemaManager.AssertExpectations(t)
+
+	_, _, err = handler.AddClassesFromOpenAPI(context.Background(), nil, []byte(`{`), OpenAPIImportOptions{})
+	assert.ErrorIs(t, err, ErrInvalidOpenAPISpec)
+}
+
+func TestOrderByReferences(t *testing.T) {
+	ref := func(name string, classes ...string) *models.Property {
+		return &models.Property{Name: name, DataType: classes}
+	}
+	classes := []models.Class{
+		{Class: "A", Properties: []*models.Property{ref("b", "B"), ref("text", "text")}},
+		{Class: "B", Properties: []*models.Property{ref("c", "C"), ref("external", "Existing")}},
+		{Class: "C", Properties: []*models.Property{ref("self", "C")}},
+		{Class: "D", Properties: []*models.Property{ref("e", "E")}},
+		{Class: "E", Properties: []*models.Property{ref("d", "D")}},
+	}
+
+	ordered, deferred := orderByReferences(classes)
+	var names []string
+	for _, class := range ordered {
+		names = append(names, class.Class)
+	}
+	assert.Equal(t, []string{"C", "B", "A", "D", "E"}, names)
+	assert.Equal(t, map[string][]*models.Property{"D": {ref("e", "E")}}, deferred)
+	assert.Empty(t, ordered[3].Properties)
+}
@@ -15,6 +15,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"reflect"
+	"slices"
 	"strings"
 
 	"github.com/pkg/errors"
@@ -255,12 +256,17 @@ func (p *Parser) ParseClassUpdate(class, update *models.Class) (*models.Class, e
 		return nil, fmt.Errorf("validate sharding config: %w", err)
 	}
 
-	if !reflect.DeepEqual(class.Properties, update.Properties) {
+	if !propertiesEqualIgnoringMutable(class.Properties, update.Properties) {
 		return nil, errors.Errorf(
 			"properties cannot be updated through updating the class. Use the add " +
 				"property feature (e.g. \"POST /v1/schema/{className}/properties\") " +
 				"to add additional properties")
 	}
+	for _, prop := range update.Properties {
+		if err := validatePropertyAliases(update.Properties, prop); err != nil {
+			return nil, err
+		}
+	}
 
 	if err := p.validator.ValidateInvertedIndexConfigUpdate(
 		class.InvertedIndexConfig,
@@ -271,6 +277,121 @@ func (p *Parser) ParseClassUpdate(class, update *models.Class) (*models.Class, e
 	return update, nil
 }
 
+// propertiesEqualIgnoringMutable compares the properties of a class with
+// the ones of its update. Deprecation, group, aliases and the required flag
+// are the only property settings which may be changed through a class update.
+// An unset deprecation flag, group, aliases or required flag in the update
+// keep the current ones, so updated is modified in place.
+func propertiesEqualIgnoringMutable(initial, updated []*models.Property) bool {
+	if len(initial) != len(updated) {
+		return false
+	}
+	for i := range initial {
+		if initial[i] == nil || updated[i] == nil {
+			if initial[i] != updated[i] {
+				return false
+			}
+			continue
+		}
+		if updated[i].Deprecated == nil {
+			updated[i].Deprecated = initial[i].Deprecated
+			if updated[i].DeprecationMessage == "" {
+				updated[i].DeprecationMessage = initial[i].DeprecationMessage
+			}
+		}
+		if updated[i].Group == nil {
+			updated[i].Group = initial[i].Group
+		}
+		if updated[i].Aliases == nil {
+			updated[i].Aliases = initial[i].Aliases
+		}
+		if updated[i].Required == nil {
+			updated[i].Required = initial[i].Required
+		}
+
+		a, b := *initial[i], *updated[i]
+		a.Deprecated, a.DeprecationMessage, a.Group, a.Aliases, a.Required = nil, "", nil, nil, nil
+		b.Deprecated, b.DeprecationMessage, b.Group, b.Aliases, b.Required = nil, "", nil, nil, nil
+		if !reflect.DeepEqual(a, b) {
+			return false
+		}
+	}
+	return true
+}
+
+// ParseClassCompaction returns a copy of class without the module configs,
+// reference targets and properties which are missing in update. All other
+// settings are taken from class. An update which adds any of them is rejected,
+// except for reference targets of duplicates of a property of the same name,
+// which they are merged from, see RepairDuplicateProperties.
+func (p *Parser) ParseClassCompaction(class, update *models.Class) (*models.Class, error) {
+	compacted := *class
+	moduleConfig, err := compactModuleConfig(class.ModuleConfig, update.ModuleConfig)
+	if err != nil {
+		return nil, fmt.Errorf("class %q: %w", class.Class, err)
+	}
+	compacted.ModuleConfig = moduleConfig
+
+	updated := make(map[string]*models.Property, len(update.Properties))
+	for _, prop := range update.Properties {
+		updated[prop.Name] = prop
+	}
+	dataTypes := make(map[string][]string, len(class.Properties))
+	for _, prop := range class.Properties {
+		dataTypes[strings.ToLower(prop.Name)] = append(dataTypes[strings.ToLower(prop.Name)], prop.DataType...)
+	}
+	compacted.Properties = make([]*models.Property, 0, len(updated))
+	for _, prop := range class.Properties {
+		u, ok := updated[prop.Name]
+		if !ok {
+			continue
+		}
+		delete(updated, prop.Name)
+
+		c := *prop
+		c.DataType = make([]string, 0, len(u.DataType))
+		for _, dt := range u.DataType {
+			if !slices.Contains(dataTypes[strings.ToLower(prop.Name)], dt) {
+				return nil, fmt.Errorf("property %q: data type %q can't be added by a compaction", prop.Name, dt)
+			}
+			c.DataType = append(c.DataType, dt)
+		}
+		if len(c.DataType) == 0 {
+			return nil, fmt.Errorf("property %q: without data types the property must be removed", prop.Name)
+		}
+		if c.ModuleConfig, err = compactModuleConfig(prop.ModuleConfig, u.ModuleConfig); err != nil {
+			return nil, fmt.Errorf("property %q: %w", prop.Name, err)
+		}
+		compacted.Properties = append(compacted.Properties, &c)
+	}
+	for name := range updated {
+		return nil, fmt.Errorf("property %q can't be added by a compaction", name)
+	}
+	return &compacted, nil
+}
+
+// compactModuleConfig returns the entries of initial whose module is still
+// configured in updated
+func compactModuleConfig(initial, updated any) (any, error) {
+	if updated == nil {
+		return nil, nil
+	}
+	updatedCfg, ok := updated.(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("module config is not a map, got %v", updated)
+	}
+	initialCfg, _ := initial.(map[string]any)
+	compacted := make(map[string]any, len(updatedCfg))
+	for module := range updatedCfg {
+		cfg, ok := initialCfg[module]
+		if !ok {
+			return nil, fmt.Errorf("module config %q can't be added by a compaction", module)
+		}
+		compacted[module] = cfg
+	}
+	return compacted, nil
+}
+
 func hasTargetVectors(class *models.Class) bool {
 	return len(class.VectorConfig) > 0
 }
@@ -442,6 +563,19 @@ func asVectorIndexConfigs(c *models.Class) map[string]schemaConfig.VectorIndexCo
 	return cfgs
 }
 
+// vectorIndexConfigsByName returns the vector index configs of the named
+// vectors of c or, if it has none, the config of its legacy vector index
+// keyed by ""
+func vectorIndexConfigsByName(c *models.Class) map[string]schemaConfig.VectorIndexConfig {
+	if len(c.VectorConfig) > 0 {
+		return asVectorIndexConfigs(c)
+	}
+	if cfg := asVectorIndexConfig(c); cfg != nil {
+		return map[string]schemaConfig.VectorIndexConfig{"": cfg}
+	}
+	return nil
+}
+
 func asVectorIndexConfig(c *models.Class) schemaConfig.VectorIndexConfig {
 	validCfg, ok := c.VectorIndexConfig.(schemaConfig.VectorIndexConfig)
 	if !ok {
@@ -12,6 +12,7 @@
 package schema
 
 import (
+	"slices"
 	"strings"
 	"testing"
 
@@ -138,6 +139,182 @@ func TestParser(t *testing.T) {
 	}
 }
 
+func TestParser_PropertyDeprecation(t *testing.T) {
+	cs := fakes.NewFakeClusterState()
+	p := NewParser(cs, dummyParseVectorConfig, fakeValidator{}, fakeModulesProvider{})
+
+	sc := config.Config{DesiredCount: 1, VirtualPerPhysical: 128, ActualCount: 1, DesiredVirtualCount: 128, Key: "_id", Strategy: "hash", Function: "murmur3"}
+	vTrue, vFalse := true, false
+	class := func(props ...*models.Property) *models.Class {
+		return &models.Class{
+			Class: "Test", VectorIndexType: hnswT, VectorIndexConfig: enthnsw.NewDefaultUserConfig(),
+			ShardingConfig: sc, Properties: props,
+		}
+	}
+	update := func(props ...*models.Property) *models.Class {
+		return &models.Class{
+			Class: "Test", VectorIndexType: hnswT, VectorIndexConfig: enthnsw.NewDefaultUserConfig(),
+			Properties: props,
+		}
+	}
+
+	t.Run("deprecate property", func(t *testing.T) {
+		got, err := p.ParseClassUpdate(
+			class(&models.Property{Name: "text", DataType: []string{"text"}}),
+			update(&models.Property{Name: "text", DataType: []string{"text"}, Deprecated: &vTrue, DeprecationMessage: "use title"}))
+		require.NoError(t, err)
+		require.True(t, *got.Properties[0].Deprecated)
+		require.Equal(t, "use title", got.Properties[0].DeprecationMessage)
+	})
+
+	t.Run("clear deprecation", func(t *testing.T) {
+		got, err := p.ParseClassUpdate(
+			class(&models.Property{Name: "text", DataType: []string{"text"}, Deprecated: &vTrue}),
+			update(&models.Property{Name: "text", DataType: []string{"text"}, Deprecated: &vFalse}))
+		require.NoError(t, err)
+		require.False(t, *got.Properties[0].Deprecated)
+	})
+
+	t.Run("unset deprecation keeps current one", func(t *testing.T) {
+		got, err := p.ParseClassUpdate(
+			class(&models.Property{Name: "text", DataType: []string{"text"}, Deprecated: &vTrue, DeprecationMessage: "use title"}),
+			update(&models.Property{Name: "text", DataType: []string{"text"}}))
+		require.NoError(t, err)
+		require.True(t, *got.Properties[0].Deprecated)
+		require.Equal(t, "use title", got.Properties[0].DeprecationMessage)
+	})
+
+	t.Run("change group", func(t *testing.T) {
+		got, err := p.ParseClassUpdate(
+			class(&models.Property{Name: "text", DataType: []string{"text"}, Group: &models.PropertyGroup{Name: "a"}}),
+			update(&models.Property{Name: "text", DataType: []string{"text"}, Group: &models.PropertyGroup{Name: "b"}}))
+		require.NoError(t, err)
+		require.Equal(t, "b", got.Properties[0].Group.Name)
+	})
+
+	t.Run("unset group keeps current one", func(t *testing.T) {
+		got, err := p.ParseClassUpdate(
+			class(&models.Property{Name: "text", DataType: []string{"text"}, Group: &models.PropertyGroup{Name: "a"}}),
+			update(&models.Property{Name: "text", DataType: []string{"text"}}))
+		require.NoError(t, err)
+		require.Equal(t, "a", got.Properties[0].Group.Name)
+	})
+
+	t.Run("unset required flag keeps current one", func(t *testing.T) {
+		got, err := p.ParseClassUpdate(
+			class(&models.Property{Name: "text", DataType: []string{"text"}, Required: &vTrue}),
+			update(&models.Property{Name: "text", DataType: []string{"text"}}))
+		require.NoError(t, err)
+		require.True(t, *got.Properties[0].Required)
+
+		got, err = p.ParseClassUpdate(
+			class(&models.Property{Name: "text", DataType: []string{"text"}, Required: &vTrue}),
+			update(&models.Property{Name: "text", DataType: []string{"text"}, Required: &vFalse}))
+		require.NoError(t, err)
+		require.False(t, *got.Properties[0].Required)
+	})
+
+	t.Run("other property changes are still rejected", func(t *testing.T) {
+		_, err := p.ParseClassUpdate(
+			class(&models.Property{Name: "text", DataType: []string{"text"}}),
+			update(&models.Property{Name: "text", DataType: []string{"text"}, Deprecated: &vTrue, Description: "NEW"}))
+		require.ErrorContains(t, err, "properties cannot be updated")
+	})
+}
+
+func TestParser_ClassCompaction(t *testing.T) {
+	cs := fakes.NewFakeClusterState()
+	p := NewParser(cs, dummyParseVectorConfig, fakeValidator{}, fakeModulesProvider{})
+
+	class := &models.Class{
+		Class:        "Book",
+		Description:  "books",
+		ModuleConfig: map[string]any{"loaded": map[string]any{"a": 1}, "removed": map[string]any{}},
+		Properties: []*models.Property{
+			{Name: "title", DataType: []string{"text"}},
+			{Name: "writtenBy", DataType: []string{"Author", "Publisher"}},
+			{Name: "editedBy", DataType: []string{"Editor"}},
+		},
+	}
+
+	t.Run("removals", func(t *testing.T) {
+		got, err := p.ParseClassCompaction(class, &models.Class{
+			Class:        "Book",
+			Description:  "ignored",
+			ModuleConfig: map[string]any{"loaded": map[string]any{}},
+			Properties: []*models.Property{
+				{Name: "title", DataType: []string{"text"}},
+				{Name: "writtenBy", DataType: []string{"Author"}},
+			},
+		})
+		require.NoError(t, err)
+		require.Equal(t, "books", got.Description)
+		require.Equal(t, map[string]any{"loaded": map[string]any{"a": 1}}, got.ModuleConfig)
+		require.Equal(t, []*models.Property{
+			{Name: "title", DataType: []string{"text"}},
+			{Name: "writtenBy", DataType: []string{"Author"}},
+		}, got.Properties)
+		require.Len(t, class.Properties, 3, "class must not be modified")
+	})
+
+	t.Run("merged duplicates", func(t *testing.T) {
+		duplicated := *class
+		duplicated.Properties = append(slices.Clone(class.Properties),
+			&models.Property{Name: "editedBy", DataType: []string{"Author"}})
+		got, err := p.ParseClassCompaction(&duplicated, &models.Class{
+			Class:        "Book",
+			ModuleConfig: class.ModuleConfig,
+			Properties: []*models.Property{
+				{Name: "title", DataType: []string{"text"}},
+				{Name: "writtenBy", DataType: []string{"Author", "Publisher"}},
+				{Name: "editedBy", DataType: []string{"Editor", "Author"}},
+			},
+		})
+		require.NoError(t, err)
+		require.Len(t, got.Properties, 3)
+		require.Equal(t, []string{"Editor", "Author"}, got.Properties[2].DataType)
+	})
+
+	tests := []struct {
+		name   string
+		update *models.Class
+		err    string
+	}{
+		{
+			name:   "added module config",
+			update: &models.Class{Class: "Book", ModuleConfig: map[string]any{"new": map[string]any{}}},
+			err:    `module config "new" can't be added`,
+		},
+		{
+			name: "added data type",
+			update: &models.Class{Class: "Book", Properties: []*models.Property{
+				{Name: "editedBy", DataType: []string{"Editor", "Author"}},
+			}},
+			err: `data type "Author" can't be added`,
+		},
+		{
+			name: "added property",
+			update: &models.Class{Class: "Book", Properties: []*models.Property{
+				{Name: "summary", DataType: []string{"text"}},
+			}},
+			err: `property "summary" can't be added`,
+		},
+		{
+			name: "property without data type",
+			update: &models.Class{Class: "Book", Properties: []*models.Property{
+				{Name: "editedBy", DataType: []string{}},
+			}},
+			err: "without data types the property must be removed",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := p.ParseClassCompaction(class, tt.update)
+			require.ErrorContains(t, err, tt.err)
+		})
+	}
+}
+
 type fakeModulesProvider struct{}
 
 func (m fakeModulesProvider) IsReranker(name string) bool {
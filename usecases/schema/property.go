@@ -13,13 +13,16 @@ package schema
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strings"
 
 	clusterSchema "github.com/weaviate/weaviate/cluster/schema"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/schema"
 	"github.com/weaviate/weaviate/usecases/auth/authorization"
+	"github.com/weaviate/weaviate/usecases/sharding"
 )
 
 // AddClassProperty it is upsert operation. it adds properties to a class and updates
@@ -27,12 +30,67 @@ import (
 func (h *Handler) AddClassProperty(ctx context.Context, principal *models.Principal,
 	class *models.Class, className string, merge bool, newProps ...*models.Property,
 ) (*models.Class, uint64, error) {
+	class, version, _, err := h.AddClassPropertyWithBackfill(ctx, principal, class, className, merge, newProps...)
+	if errors.As(err, &ErrBackfillNotStarted{}) {
+		// the properties are added, the error is logged
+		return class, version, nil
+	}
+	return class, version, err
+}
+
+// AddProperty adds prop to className and returns it as stored, with the
+// defaults applied to the fields left unset, e.g. the tokenization, the
+// indexes and the module config, and deprecated settings migrated.
+func (h *Handler) AddProperty(ctx context.Context, principal *models.Principal,
+	className string, prop *models.Property,
+) (*models.Property, error) {
+	prop, _, err := h.AddPropertyWithBackfill(ctx, principal, className, prop)
+	if errors.As(err, &ErrBackfillNotStarted{}) {
+		// the property is added, the error is logged
+		return prop, nil
+	}
+	return prop, err
+}
+
+// AddPropertyWithBackfill is AddProperty, which also returns the ID of the job
+// setting the DefaultValue of prop on the existing objects, if any. If the job
+// can't be started, the property is returned with an ErrBackfillNotStarted.
+func (h *Handler) AddPropertyWithBackfill(ctx context.Context, principal *models.Principal,
+	className string, prop *models.Property,
+) (*models.Property, string, error) {
+	if prop == nil {
+		return nil, "", fmt.Errorf("property is nil")
+	}
+	className = schema.UppercaseClassName(className)
+	class, _, jobs, err := h.AddClassPropertyWithBackfill(ctx, principal,
+		h.schemaReader.ReadOnlyClass(className), className, false, prop)
+	if err != nil && !errors.As(err, &ErrBackfillNotStarted{}) {
+		return nil, "", err
+	}
+	stored, getErr := schema.GetPropertyByName(class, prop.Name)
+	if getErr != nil {
+		return nil, "", getErr
+	}
+	return stored, jobs[stored.Name], err
+}
+
+// AddClassPropertyWithBackfill is AddClassProperty, which also returns the
+// IDs of the jobs setting the DefaultValue of the added properties on the
+// existing objects by property name, see BackfillStatus. The objects of
+// classes inheriting the properties keep them unset. If a job can't be
+// started, the class is returned with an ErrBackfillNotStarted, the
+// properties are added nonetheless.
+func (h *Handler) AddClassPropertyWithBackfill(ctx context.Context, principal *models.Principal,
+	class *models.Class, className string, merge bool, newProps ...*models.Property,
+) (*models.Class, uint64, map[string]string, error) {
+	defer h.metrics.track(opAddProperty)()
+
 	if err := h.Authorizer.Authorize(principal, authorization.UPDATE, authorization.CollectionsMetadata(className)...); err != nil {
-		return nil, 0, err
+		return nil, 0, nil, err
 	}
 
 	if err := h.Authorizer.Authorize(principal, authorization.READ, authorization.CollectionsMetadata(className)...); err != nil {
-		return nil, 0, err
+		return nil, 0, nil, err
 	}
 	classGetterWithAuth := func(name string) (*models.Class, error) {
 		if err := h.Authorizer.Authorize(principal, authorization.READ, authorization.CollectionsMetadata(name)...); err != nil {
@@ -42,60 +100,264 @@ func (h *Handler) AddClassProperty(ctx context.Context, principal *models.Princi
 	}
 
 	if class == nil {
-		return nil, 0, fmt.Errorf("class is nil: %w", ErrNotFound)
+		return nil, 0, nil, fmt.Errorf("class is nil: %w", ErrNotFound)
 	}
 
 	if len(newProps) == 0 {
-		return nil, 0, nil
+		return nil, 0, nil, nil
 	}
 
 	// validate new props
 	for _, prop := range newProps {
 		if prop.Name == "" {
-			return nil, 0, fmt.Errorf("property must contain name")
+			return nil, 0, nil, fmt.Errorf("property must contain name")
 		}
 		prop.Name = schema.LowercaseFirstLetter(prop.Name)
 		if prop.DataType == nil {
-			return nil, 0, fmt.Errorf("property must contain dataType")
+			return nil, 0, nil, fmt.Errorf("property must contain dataType")
 		}
+		// properties are only inherited by propagation from parent classes
+		existing, err := schema.GetPropertyByName(class, prop.Name)
+		prop.Inherited = err == nil && existing.Inherited
 	}
 
 	if err := h.setNewPropDefaults(class, newProps...); err != nil {
-		return nil, 0, err
+		return nil, 0, nil, err
 	}
 
 	existingNames := make(map[string]bool, len(class.Properties))
-	if !merge {
-		for _, prop := range class.Properties {
+	for _, prop := range class.Properties {
+		if !merge {
 			existingNames[strings.ToLower(prop.Name)] = true
 		}
+		// merged properties can't be aliases of other properties either
+		for _, alias := range prop.Aliases {
+			existingNames[strings.ToLower(alias)] = true
+		}
 	}
 
 	if err := h.validateProperty(class, existingNames, false, classGetterWithAuth, newProps...); err != nil {
-		return nil, 0, err
+		return nil, 0, nil, err
 	}
 
 	// TODO-RAFT use UpdateProperty() for adding/merging property when index idempotence exists
 	// revisit when index idempotence exists and/or allowing merging properties on index.
 	props := schema.DedupProperties(class.Properties, newProps)
 	if len(props) == 0 {
-		return class, 0, nil
+		return class, 0, nil, nil
+	}
+
+	if err := validateDeprecatedPropsMerge(class.Properties, newProps, props); err != nil {
+		return nil, 0, nil, err
+	}
+
+	if err := h.validateMaxProperties(class.Class, props); err != nil {
+		return nil, 0, nil, err
+	}
+	descendants, err := h.validatePropertyInheritance(principal, class.Class, props)
+	if err != nil {
+		return nil, 0, nil, err
 	}
 
 	migratePropertySettings(props...)
 
+	// only properties new to the class are backfilled, not merged ones
+	var added []*models.Property
+	for _, prop := range props {
+		if _, err := schema.GetPropertyByName(class, prop.Name); err != nil {
+			added = append(added, prop)
+		}
+	}
+
 	class.Properties = clusterSchema.MergeProps(class.Properties, props)
-	version, err := h.schemaManager.AddProperty(ctx, class.Class, props...)
+	version, err := h.addInheritedProperties(ctx, principal, class.Class, descendants, props)
 	if err != nil {
-		return nil, 0, err
+		h.logEntry(withActor(ctx, principal), class.Class, "").WithError(err).Log(writeErrorLevel(err), "add property")
+		return nil, 0, nil, err
 	}
-	return class, version, err
+	jobs, err := h.backfillProperties(ctx, class.Class, version, added)
+	if err != nil {
+		h.logEntry(ctx, class.Class, "").WithError(err).Error("backfill property")
+	}
+	return class, version, jobs, err
+}
+
+// ErrMaxPropertiesExceeded is returned if a class would have more properties
+// than Schema.MaxPropertiesPerClass allows
+type ErrMaxPropertiesExceeded struct {
+	Class   string
+	Current int // number of properties the class would have
+	Max     int
+}
+
+func (e ErrMaxPropertiesExceeded) Error() string {
+	return fmt.Sprintf("class %s would have %d properties, at most %d are allowed", e.Class, e.Current, e.Max)
+}
+
+// validateMaxProperties checks that adding props to the current state of
+// className stays within the limit. It only gives a descriptive error early,
+// the limit is enforced when the properties are applied to the schema since
+// concurrent requests can all pass this check.
+func (h *Handler) validateMaxProperties(className string, props []*models.Property) error {
+	limit := h.config.Schema.MaxPropertiesPerClass
+	if limit <= 0 {
+		return nil
+	}
+
+	current := 0
+	err := h.schemaReader.Read(className, func(c *models.Class, _ *sharding.State) error {
+		existing := make(map[string]struct{}, len(c.Properties))
+		for _, prop := range c.Properties {
+			existing[strings.ToLower(prop.Name)] = struct{}{}
+		}
+		current = len(existing)
+		for _, prop := range props {
+			if _, ok := existing[strings.ToLower(prop.Name)]; !ok {
+				current++
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		// unknown classes are rejected when the properties are added
+		return nil
+	}
+	if current > limit {
+		return ErrMaxPropertiesExceeded{Class: className, Current: current, Max: limit}
+	}
+	return nil
+}
+
+// bulkAddPropertyConcurrency limits the classes BulkAddProperty updates at
+// the same time
+const bulkAddPropertyConcurrency = 8
+
+// ClassPropertyAddition is a single property to be added by BulkAddProperty
+type ClassPropertyAddition struct {
+	Class    string
+	Property *models.Property
+}
+
+// ClassPropertyError is the failure to add properties to a single class
+type ClassPropertyError struct {
+	Class string
+	Err   error
+}
+
+func (e ClassPropertyError) Error() string {
+	return fmt.Sprintf("class %s: %v", e.Class, e.Err)
+}
+
+func (e ClassPropertyError) Unwrap() error {
+	return e.Err
+}
+
+// ClassPropertyErrors is returned by BulkAddProperty if some of the classes
+// could not be updated. All other classes were updated.
+type ClassPropertyErrors []ClassPropertyError
+
+func (e ClassPropertyErrors) Error() string {
+	msgs := make([]string, len(e))
+	for i, err := range e {
+		msgs[i] = err.Error()
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// BulkAddProperty adds properties to many classes at once. The principal needs
+// to be allowed to update every class, otherwise nothing is changed. All
+// properties of a class are added in a single schema change, the changes of
+// different classes are submitted concurrently, up to
+// bulkAddPropertyConcurrency at a time, rather than waiting for each one to be
+// committed. Failures of single classes are returned as
+// ClassPropertyErrors and don't affect the other classes.
+func (h *Handler) BulkAddProperty(ctx context.Context, principal *models.Principal,
+	additions []ClassPropertyAddition,
+) error {
+	var classes []string
+	propsByClass := map[string][]*models.Property{}
+	for _, addition := range additions {
+		name := schema.UppercaseClassName(addition.Class)
+		if _, ok := propsByClass[name]; !ok {
+			classes = append(classes, name)
+		}
+		propsByClass[name] = append(propsByClass[name], addition.Property)
+	}
+
+	for _, name := range classes {
+		if err := h.Authorizer.Authorize(principal, authorization.UPDATE, authorization.CollectionsMetadata(name)...); err != nil {
+			return err
+		}
+	}
+
+	errs := make([]error, len(classes))
+	eg := enterrors.NewErrorGroupWrapper(h.logger)
+	eg.SetLimit(bulkAddPropertyConcurrency)
+	for i, name := range classes {
+		i, name := i, name
+		eg.Go(func() error {
+			for _, prop := range propsByClass[name] {
+				if prop == nil {
+					errs[i] = fmt.Errorf("property is nil")
+					return nil
+				}
+			}
+			// the failure of a class doesn't stop the others, it is collected
+			// in errs instead
+			_, _, errs[i] = h.AddClassProperty(ctx, principal, h.schemaReader.ReadOnlyClass(name),
+				name, false, propsByClass[name]...)
+			return nil
+		}, name)
+	}
+	eg.Wait()
+
+	var failed ClassPropertyErrors
+	for i, err := range errs {
+		if err != nil {
+			failed = append(failed, ClassPropertyError{Class: classes[i], Err: err})
+		}
+	}
+	if len(failed) > 0 {
+		return failed
+	}
+	return nil
+}
+
+// validateDeprecatedPropsMerge refuses extending existing deprecated properties
+// (e.g. adding nested properties through auto schema), unless the incoming
+// property explicitly clears the deprecated flag.
+func validateDeprecatedPropsMerge(existing, incoming, merged []*models.Property) error {
+	existingByName := make(map[string]*models.Property, len(existing))
+	for _, prop := range existing {
+		existingByName[strings.ToLower(prop.Name)] = prop
+	}
+	incomingByName := make(map[string]*models.Property, len(incoming))
+	for _, prop := range incoming {
+		incomingByName[strings.ToLower(prop.Name)] = prop
+	}
+
+	for _, prop := range merged {
+		name := strings.ToLower(prop.Name)
+		old, ok := existingByName[name]
+		if !ok || old.Deprecated == nil || !*old.Deprecated {
+			continue
+		}
+		in := incomingByName[name]
+		if in == nil || in.Deprecated == nil || *in.Deprecated {
+			return fmt.Errorf("property %q is deprecated and cannot be extended, "+
+				"clear the deprecated flag to modify it", old.Name)
+		}
+		prop.Deprecated = in.Deprecated
+	}
+	return nil
 }
 
 // DeleteClassProperty from existing Schema
 func (h *Handler) DeleteClassProperty(ctx context.Context, principal *models.Principal,
 	class string, property string,
 ) error {
+	defer h.metrics.track(opDeleteProperty)()
+
 	err := h.Authorizer.Authorize(principal, authorization.UPDATE, authorization.CollectionsMetadata(class)...)
 	if err != nil {
 		return err
@@ -106,6 +368,39 @@ func (h *Handler) DeleteClassProperty(ctx context.Context, principal *models.Pri
 	// return h.deleteClassProperty(ctx, class, property, kind.Action)
 }
 
+// GetPropertyByName returns a copy of the property of class with the given
+// name, compared case-insensitively. The class is read in place rather than
+// copied. ErrNotFound is returned if there is no such class or property.
+func (h *Handler) GetPropertyByName(principal *models.Principal, class, property string) (*models.Property, error) {
+	class = schema.UppercaseClassName(class)
+	err := h.Authorizer.Authorize(principal, authorization.READ, authorization.CollectionsMetadata(class)...)
+	if err != nil {
+		return nil, err
+	}
+
+	var found *models.Property
+	err = h.schemaReader.Read(class, func(c *models.Class, _ *sharding.State) error {
+		for _, prop := range c.Properties {
+			if strings.EqualFold(prop.Name, property) {
+				cp := *prop
+				found = &cp
+				return nil
+			}
+		}
+		return nil
+	})
+	if errors.Is(err, clusterSchema.ErrClassNotFound) {
+		return nil, fmt.Errorf("class %q: %w", class, ErrNotFound)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if found == nil {
+		return nil, fmt.Errorf("property %q of class %q: %w", property, class, ErrNotFound)
+	}
+	return found, nil
+}
+
 func (h *Handler) setNewPropDefaults(class *models.Class, props ...*models.Property) error {
 	setPropertyDefaults(props...)
 	h.moduleConfig.SetSinglePropertyDefaults(class, props...)
@@ -0,0 +1,158 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+	"sync/atomic"
+
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
+)
+
+// IndexFilterable is the index of IndexRecommendation for filterable indexes
+const IndexFilterable = "filterable"
+
+// IndexRecommendation recommends to enable or disable an index of Property
+type IndexRecommendation struct {
+	Property    string
+	Index       string
+	Enable      bool
+	AccessCount int64
+	Reason      string
+}
+
+// propertyAccess counts how often filters and sorts used the properties of
+// each class. The counts are kept in memory only and shared by all copies
+// of a Handler. As an EventListener it drops the counts of deleted classes.
+type propertyAccess struct {
+	NoopEventListener
+
+	sync.RWMutex
+	counts map[string]map[string]*atomic.Int64
+}
+
+func newPropertyAccess() *propertyAccess {
+	return &propertyAccess{counts: map[string]map[string]*atomic.Int64{}}
+}
+
+func (p *propertyAccess) counter(class, property string) *atomic.Int64 {
+	p.RLock()
+	c := p.counts[class][property]
+	p.RUnlock()
+	if c != nil {
+		return c
+	}
+
+	p.Lock()
+	defer p.Unlock()
+	if p.counts[class] == nil {
+		p.counts[class] = map[string]*atomic.Int64{}
+	}
+	if c = p.counts[class][property]; c == nil {
+		c = &atomic.Int64{}
+		p.counts[class][property] = c
+	}
+	return c
+}
+
+func (p *propertyAccess) stats(class string) map[string]int64 {
+	p.RLock()
+	defer p.RUnlock()
+	stats := make(map[string]int64, len(p.counts[class]))
+	for property, c := range p.counts[class] {
+		stats[property] = c.Load()
+	}
+	return stats
+}
+
+func (p *propertyAccess) OnClassDeleted(class string) {
+	p.Lock()
+	defer p.Unlock()
+	delete(p.counts, class)
+}
+
+// PropertyAccessListener drops the access stats of deleted classes. It must
+// be registered with the executor applying the raft log, so that the stats
+// of classes deleted through any node are dropped.
+func (h *Handler) PropertyAccessListener() EventListener {
+	return h.propertyAccess
+}
+
+// RecordPropertyAccess counts a filter or sort on property of class
+func (h *Handler) RecordPropertyAccess(class, property string) {
+	h.propertyAccess.counter(class, property).Add(1)
+}
+
+// GetPropertyAccessStats returns how often filters and sorts used the
+// properties of class on this node since it was started. Properties which
+// weren't used are left out.
+func (h *Handler) GetPropertyAccessStats(principal *models.Principal, class string) (map[string]int64, error) {
+	class = schema.UppercaseClassName(class)
+	err := h.Authorizer.Authorize(principal, authorization.READ, authorization.CollectionsMetadata(class)...)
+	if err != nil {
+		return nil, err
+	}
+	if h.schemaReader.ReadOnlyClass(class) == nil {
+		return nil, fmt.Errorf("class %q: %w", class, ErrNotFound)
+	}
+	return h.propertyAccess.stats(class), nil
+}
+
+// GenerateIndexingRecommendations compares the access stats of class with
+// the filterable indexes of its properties, ordered by property name. Used
+// properties without a filterable index should get one. Unused properties
+// with a filterable index may drop it, as long as any property of the class
+// was used at all. Searchable indexes are used by keyword searches, which
+// aren't tracked, so no recommendations are made for them.
+func (h *Handler) GenerateIndexingRecommendations(principal *models.Principal, class string) ([]IndexRecommendation, error) {
+	class = schema.UppercaseClassName(class)
+	err := h.Authorizer.Authorize(principal, authorization.READ, authorization.CollectionsMetadata(class)...)
+	if err != nil {
+		return nil, err
+	}
+	c := h.schemaReader.ReadOnlyClass(class)
+	if c == nil {
+		return nil, fmt.Errorf("class %q: %w", class, ErrNotFound)
+	}
+	stats := h.propertyAccess.stats(class)
+
+	var recommendations []IndexRecommendation
+	for _, prop := range c.Properties {
+		if _, ok := schema.AsPrimitive(prop.DataType); !ok || schema.IsBlobDataType(prop.DataType) {
+			// nested properties can't be filtered on and references
+			// require a filterable index
+			continue
+		}
+		count := stats[prop.Name]
+		filterable := prop.IndexFilterable == nil || *prop.IndexFilterable
+		switch {
+		case count > 0 && !filterable:
+			recommendations = append(recommendations, IndexRecommendation{
+				Property: prop.Name, Index: IndexFilterable, Enable: true, AccessCount: count,
+				Reason: fmt.Sprintf("used by %d filters or sorts without a filterable index", count),
+			})
+		case count == 0 && filterable && len(stats) > 0:
+			recommendations = append(recommendations, IndexRecommendation{
+				Property: prop.Name, Index: IndexFilterable, Enable: false,
+				Reason: "not used by any filter or sort since the node was started",
+			})
+		}
+	}
+	sort.Slice(recommendations, func(i, j int) bool {
+		return recommendations[i].Property < recommendations[j].Property
+	})
+	return recommendations, nil
+}
@@ -0,0 +1,100 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+func TestHandler_PropertyAccess(t *testing.T) {
+	vFalse := false
+	class := &models.Class{Class: "Article", Properties: []*models.Property{
+		{Name: "title", DataType: []string{"text"}},
+		{Name: "published", DataType: []string{"date"}, IndexFilterable: &vFalse},
+		{Name: "rating", DataType: []string{"int"}},
+		{Name: "author", DataType: []string{"Person"}},
+		{Name: "meta", DataType: []string{"object"}},
+	}}
+
+	t.Run("no accesses", func(t *testing.T) {
+		handler, fakeSchemaManager := newTestHandler(t, &fakeDB{})
+		fakeSchemaManager.On("ReadOnlyClass", "Article").Return(class)
+
+		stats, err := handler.GetPropertyAccessStats(nil, "Article")
+		require.Nil(t, err)
+		assert.Empty(t, stats)
+
+		recommendations, err := handler.GenerateIndexingRecommendations(nil, "Article")
+		require.Nil(t, err)
+		assert.Empty(t, recommendations)
+	})
+
+	t.Run("stats and recommendations", func(t *testing.T) {
+		handler, fakeSchemaManager := newTestHandler(t, &fakeDB{})
+		fakeSchemaManager.On("ReadOnlyClass", "Article").Return(class)
+
+		var wg sync.WaitGroup
+		for i := 0; i < 10; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				handler.RecordPropertyAccess("Article", "title")
+				handler.RecordPropertyAccess("Article", "published")
+			}()
+		}
+		wg.Wait()
+		handler.RecordPropertyAccess("Other", "title")
+
+		stats, err := handler.GetPropertyAccessStats(nil, "article")
+		require.Nil(t, err)
+		assert.Equal(t, map[string]int64{"title": 10, "published": 10}, stats)
+
+		recommendations, err := handler.GenerateIndexingRecommendations(nil, "Article")
+		require.Nil(t, err)
+		require.Len(t, recommendations, 2)
+		assert.Equal(t, IndexRecommendation{
+			Property: "published", Index: IndexFilterable, Enable: true, AccessCount: 10,
+			Reason: "used by 10 filters or sorts without a filterable index",
+		}, recommendations[0])
+		assert.Equal(t, "rating", recommendations[1].Property)
+		assert.False(t, recommendations[1].Enable)
+	})
+
+	t.Run("deleted class", func(t *testing.T) {
+		handler, fakeSchemaManager := newTestHandler(t, &fakeDB{})
+		fakeSchemaManager.On("ReadOnlyClass", "Article").Return(class)
+
+		handler.RecordPropertyAccess("Article", "title")
+		handler.RecordPropertyAccess("Other", "title")
+		handler.PropertyAccessListener().OnClassDeleted("Article")
+
+		stats, err := handler.GetPropertyAccessStats(nil, "Article")
+		require.Nil(t, err)
+		assert.Empty(t, stats)
+		assert.Equal(t, map[string]int64{"title": 1}, handler.propertyAccess.stats("Other"))
+	})
+
+	t.Run("class not found", func(t *testing.T) {
+		handler, fakeSchemaManager := newTestHandler(t, &fakeDB{})
+		fakeSchemaManager.On("ReadOnlyClass", "Article").Return(nil)
+
+		_, err := handler.GetPropertyAccessStats(nil, "Article")
+		assert.ErrorIs(t, err, ErrNotFound)
+		_, err = handler.GenerateIndexingRecommendations(nil, "Article")
+		assert.ErrorIs(t, err, ErrNotFound)
+	})
+}
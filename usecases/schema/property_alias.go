@@ -0,0 +1,150 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+
+	clusterSchema "github.com/weaviate/weaviate/cluster/schema"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
+)
+
+// AddPropertyAlias makes canonicalProp of class accessible under alias as
+// well. The alias may not collide with the name or an alias of any property.
+func (h *Handler) AddPropertyAlias(ctx context.Context, principal *models.Principal,
+	class, canonicalProp, alias string,
+) error {
+	className := schema.UppercaseClassName(class)
+	err := h.Authorizer.Authorize(principal, authorization.UPDATE, authorization.CollectionsMetadata(className)...)
+	if err != nil {
+		return err
+	}
+
+	initial := h.schemaReader.ReadOnlyClass(className)
+	if initial == nil {
+		return fmt.Errorf("class %q: %w", className, ErrNotFound)
+	}
+	updated := *initial
+	updated.Properties = make([]*models.Property, len(initial.Properties))
+	var prop *models.Property
+	for i, p := range initial.Properties {
+		updated.Properties[i] = p
+		if p.Name == schema.LowercaseFirstLetter(canonicalProp) {
+			copied := *p
+			copied.Aliases = append(slices.Clone(p.Aliases), schema.LowercaseFirstLetter(alias))
+			updated.Properties[i], prop = &copied, &copied
+		}
+	}
+	if prop == nil {
+		return fmt.Errorf("property %q of class %q: %w", canonicalProp, className, ErrNotFound)
+	}
+	if err := validatePropertyAliases(updated.Properties, prop); err != nil {
+		return fmt.Errorf("%w: %w", clusterSchema.ErrBadRequest, err)
+	}
+
+	if _, err := h.schemaManager.UpdateClass(withActor(ctx, principal), &updated, nil); err != nil {
+		return err
+	}
+	h.notify(ctx, func(l EventListener) { l.OnClassUpdated(&updated) })
+	return nil
+}
+
+// validatePropertyAliases checks that the aliases of prop are valid property
+// names which collide neither with the names nor the aliases of other props.
+// Props may contain prop and other versions of it.
+func validatePropertyAliases(props []*models.Property, prop *models.Property) error {
+	taken := map[string]string{strings.ToLower(prop.Name): prop.Name}
+	for _, p := range props {
+		if strings.EqualFold(p.Name, prop.Name) {
+			continue
+		}
+		taken[strings.ToLower(p.Name)] = p.Name
+		for _, alias := range p.Aliases {
+			taken[strings.ToLower(alias)] = p.Name
+		}
+	}
+
+	for i, alias := range prop.Aliases {
+		if _, err := schema.ValidatePropertyName(alias); err != nil {
+			return fmt.Errorf("property %q: alias: %w", prop.Name, err)
+		}
+		if err := schema.ValidateReservedPropertyName(alias); err != nil {
+			return fmt.Errorf("property %q: alias: %w", prop.Name, err)
+		}
+		if owner, ok := taken[strings.ToLower(alias)]; ok {
+			return fmt.Errorf("property %q: alias %q collides with property %q", prop.Name, alias, owner)
+		}
+		if slices.ContainsFunc(prop.Aliases[:i], func(a string) bool { return strings.EqualFold(a, alias) }) {
+			return fmt.Errorf("property %q: alias %q provided multiple times", prop.Name, alias)
+		}
+	}
+	return nil
+}
+
+// ResolvePropertyAliases moves the values object sets under an alias to the
+// property of the alias. Setting both fails unless the values are equal, so
+// that an object as returned by ExpandPropertyAliases can be written back.
+func (h *Handler) ResolvePropertyAliases(object *models.Object) error {
+	props, _ := object.Properties.(map[string]interface{})
+	if len(props) == 0 {
+		return nil
+	}
+	class := h.schemaReader.ReadOnlyClass(schema.UppercaseClassName(object.Class))
+	if class == nil {
+		return nil
+	}
+
+	for _, prop := range class.Properties {
+		for _, alias := range prop.Aliases {
+			for key, value := range props {
+				if schema.LowercaseFirstLetter(key) != schema.LowercaseFirstLetter(alias) {
+					continue
+				}
+				if current, ok := props[prop.Name]; ok && !reflect.DeepEqual(current, value) {
+					return fmt.Errorf("property %q is set under its name and its alias %q", prop.Name, alias)
+				}
+				delete(props, key)
+				props[prop.Name] = value
+			}
+		}
+	}
+	return nil
+}
+
+// ExpandPropertyAliases copies the values of the properties of object to
+// each of their aliases
+func (h *Handler) ExpandPropertyAliases(object *models.Object) {
+	props, _ := object.Properties.(map[string]interface{})
+	if len(props) == 0 {
+		return
+	}
+	class := h.schemaReader.ReadOnlyClass(object.Class)
+	if class == nil {
+		return
+	}
+
+	for _, prop := range class.Properties {
+		value, ok := props[prop.Name]
+		if !ok {
+			continue
+		}
+		for _, alias := range prop.Aliases {
+			props[alias] = value
+		}
+	}
+}
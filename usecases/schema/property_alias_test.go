@@ -0,0 +1,110 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+func TestHandler_PropertyAliases(t *testing.T) {
+	ctx := context.Background()
+	newClass := func() *models.Class {
+		return &models.Class{
+			Class: "Article",
+			Properties: []*models.Property{
+				{Name: "title", DataType: []string{"text"}, Aliases: []string{"headline"}},
+				{Name: "body", DataType: []string{"text"}},
+			},
+		}
+	}
+
+	t.Run("add alias", func(t *testing.T) {
+		handler, fakeSchemaManager := newTestHandler(t, &fakeDB{})
+		class := newClass()
+		fakeSchemaManager.On("ReadOnlyClass", "Article").Return(class)
+		fakeSchemaManager.On("UpdateClass", mock.MatchedBy(func(c *models.Class) bool {
+			return assert.Equal(t, []string{"text"}, c.Properties[1].Aliases)
+		}), mock.Anything).Return(nil)
+
+		require.Nil(t, handler.AddPropertyAlias(ctx, nil, "article", "body", "Text"))
+		fakeSchemaManager.AssertExpectations(t)
+		// the class of the schema isn't modified
+		assert.Nil(t, class.Properties[1].Aliases)
+	})
+
+	t.Run("invalid aliases", func(t *testing.T) {
+		for _, tt := range []struct {
+			prop, alias, err string
+		}{
+			{prop: "body", alias: "title", err: `collides with property "title"`},
+			{prop: "body", alias: "Headline", err: `collides with property "title"`},
+			{prop: "body", alias: "body", err: `collides with property "body"`},
+			{prop: "title", alias: "headline", err: "provided multiple times"},
+			{prop: "body", alias: "_id", err: "alias"},
+			{prop: "body", alias: "no-dashes", err: "alias"},
+		} {
+			t.Run(tt.alias, func(t *testing.T) {
+				handler, fakeSchemaManager := newTestHandler(t, &fakeDB{})
+				fakeSchemaManager.On("ReadOnlyClass", "Article").Return(newClass())
+
+				err := handler.AddPropertyAlias(ctx, nil, "Article", tt.prop, tt.alias)
+				assert.ErrorContains(t, err, tt.err)
+				fakeSchemaManager.AssertNotCalled(t, "UpdateClass", mock.Anything, mock.Anything)
+			})
+		}
+
+		handler, fakeSchemaManager := newTestHandler(t, &fakeDB{})
+		fakeSchemaManager.On("ReadOnlyClass", "Article").Return(newClass())
+		assert.ErrorIs(t, handler.AddPropertyAlias(ctx, nil, "Article", "missing", "alias"), ErrNotFound)
+	})
+
+	t.Run("write objects using an alias", func(t *testing.T) {
+		handler, fakeSchemaManager := newTestHandler(t, &fakeDB{})
+		fakeSchemaManager.On("ReadOnlyClass", "Article").Return(newClass())
+
+		obj := &models.Object{Class: "Article", Properties: map[string]interface{}{"headline": "a", "body": "b"}}
+		require.Nil(t, handler.ResolvePropertyAliases(obj))
+		assert.Equal(t, map[string]interface{}{"title": "a", "body": "b"}, obj.Properties)
+
+		obj = &models.Object{Class: "Article", Properties: map[string]interface{}{"headline": "a", "title": "a"}}
+		require.Nil(t, handler.ResolvePropertyAliases(obj))
+		assert.Equal(t, map[string]interface{}{"title": "a"}, obj.Properties)
+
+		obj = &models.Object{Class: "Article", Properties: map[string]interface{}{"headline": "a", "title": "b"}}
+		assert.ErrorContains(t, handler.ResolvePropertyAliases(obj), "under its name and its alias")
+	})
+
+	t.Run("read objects using an alias", func(t *testing.T) {
+		handler, fakeSchemaManager := newTestHandler(t, &fakeDB{})
+		fakeSchemaManager.On("ReadOnlyClass", "Article").Return(newClass())
+
+		obj := &models.Object{Class: "Article", Properties: map[string]interface{}{"title": "a", "body": "b"}}
+		handler.ExpandPropertyAliases(obj)
+		assert.Equal(t, map[string]interface{}{"title": "a", "headline": "a", "body": "b"}, obj.Properties)
+	})
+
+	t.Run("add class with colliding aliases", func(t *testing.T) {
+		handler, _ := newTestHandler(t, &fakeDB{})
+		class := newClass()
+		class.Vectorizer = "none"
+		class.Properties[1].Aliases = []string{"headline"}
+
+		_, err := handler.AddClass(ctx, nil, class)
+		assert.ErrorContains(t, err, `property "title": alias "headline" collides with property "body"`)
+	})
+}
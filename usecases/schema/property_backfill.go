@@ -0,0 +1,141 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/weaviate/weaviate/cluster/types"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
+)
+
+// BackfillStatus describes a job started by AddClassPropertyWithBackfill
+type BackfillStatus = types.BackfillStatus
+
+// ErrBackfillNotStarted is returned by AddClassPropertyWithBackfill if the
+// properties were added, but the backfill of Property couldn't be started
+type ErrBackfillNotStarted struct {
+	Property string
+	Err      error
+}
+
+func (e ErrBackfillNotStarted) Error() string {
+	return fmt.Sprintf("property %q was added, but its backfill was not started: %v", e.Property, e.Err)
+}
+
+func (e ErrBackfillNotStarted) Unwrap() error {
+	return e.Err
+}
+
+// validatePropertyDefaultValue checks that the defaultValue of property
+// matches its data type
+func validatePropertyDefaultValue(property *models.Property, propertyDataType schema.PropertyDataType) error {
+	if property.DefaultValue == nil {
+		return nil
+	}
+	if !propertyDataType.IsPrimitive() {
+		return fmt.Errorf("property '%s': defaultValue is only allowed for data types int, number and boolean", property.Name)
+	}
+	if _, err := propertyDefaultValue(propertyDataType.AsPrimitive(), property.DefaultValue); err != nil {
+		return fmt.Errorf("property '%s': %w", property.Name, err)
+	}
+	return nil
+}
+
+// propertyDefaultValue converts value to the type objects hold for dataType,
+// float64 for int and number and bool for boolean
+func propertyDefaultValue(dataType schema.DataType, value interface{}) (interface{}, error) {
+	switch dataType {
+	case schema.DataTypeBoolean:
+		if b, ok := value.(bool); ok {
+			return b, nil
+		}
+		return nil, fmt.Errorf("defaultValue must be a boolean, got '%v'", value)
+	case schema.DataTypeInt, schema.DataTypeNumber:
+		var number float64
+		switch typed := value.(type) {
+		case json.Number:
+			f, err := typed.Float64()
+			if err != nil {
+				return nil, fmt.Errorf("defaultValue must be a number, got '%v'", value)
+			}
+			number = f
+		case float64:
+			number = typed
+		case int64:
+			number = float64(typed)
+		case int:
+			number = float64(typed)
+		default:
+			return nil, fmt.Errorf("defaultValue must be a number, got '%v'", value)
+		}
+		if dataType == schema.DataTypeInt && number != float64(int64(number)) {
+			return nil, fmt.Errorf("defaultValue must be an integer, got '%v'", value)
+		}
+		return number, nil
+	default:
+		return nil, fmt.Errorf("defaultValue is only allowed for data types int, number and boolean")
+	}
+}
+
+// backfillProperties starts a backfill job for every property of props with
+// a DefaultValue and returns the job IDs by property name. The properties
+// must already be part of the schema of version. If a job can't be started,
+// the jobs started so far are returned with an ErrBackfillNotStarted.
+func (h *Handler) backfillProperties(ctx context.Context, class string, version uint64,
+	props []*models.Property,
+) (map[string]string, error) {
+	var jobs map[string]string
+	for _, prop := range props {
+		if prop.DefaultValue == nil {
+			continue
+		}
+		value, err := propertyDefaultValue(schema.DataType(prop.DataType[0]), prop.DefaultValue)
+		if err != nil {
+			return jobs, ErrBackfillNotStarted{Property: prop.Name, Err: err}
+		}
+		if jobs == nil {
+			// the objects can only be written once the local schema has the
+			// property
+			if err := h.WaitForSchemaConsistency(ctx, version); err != nil {
+				return nil, ErrBackfillNotStarted{Property: prop.Name, Err: err}
+			}
+			jobs = map[string]string{}
+		}
+		jobID, err := h.dataMigrator.BackfillProperty(ctx, class, prop.Name, value)
+		if err != nil {
+			return jobs, ErrBackfillNotStarted{Property: prop.Name, Err: err}
+		}
+		jobs[prop.Name] = jobID
+	}
+	return jobs, nil
+}
+
+// BackfillStatus returns the backfill job jobID. Jobs are only known to the
+// node they were started on.
+func (h *Handler) BackfillStatus(ctx context.Context, principal *models.Principal,
+	jobID string,
+) (BackfillStatus, error) {
+	job, ok := h.dataMigrator.BackfillStatus(jobID)
+	if !ok {
+		return BackfillStatus{}, fmt.Errorf("backfill job %q: %w", jobID, ErrNotFound)
+	}
+	err := h.Authorizer.Authorize(principal, authorization.READ, authorization.CollectionsMetadata(job.Class)...)
+	if err != nil {
+		return BackfillStatus{}, err
+	}
+	return job, nil
+}
@@ -0,0 +1,103 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+func TestHandler_AddClassPropertyWithBackfill(t *testing.T) {
+	ctx := context.Background()
+	newClass := func() *models.Class {
+		return &models.Class{Class: "C", Vectorizer: "none", Properties: []*models.Property{
+			{Name: "existing", DataType: []string{"int"}},
+		}}
+	}
+
+	t.Run("backfill the default value", func(t *testing.T) {
+		handler, fakeSchemaManager := newTestHandler(t, &fakeDB{})
+		fakeSchemaManager.On("AddProperty", "C", mock.Anything).Return(nil)
+		fakeSchemaManager.On("BackfillProperty", "C", "count", float64(3)).Return("job-1", nil)
+
+		_, _, jobs, err := handler.AddClassPropertyWithBackfill(ctx, nil, newClass(), "C", false,
+			&models.Property{Name: "count", DataType: []string{"int"}, DefaultValue: json.Number("3")},
+			&models.Property{Name: "flag", DataType: []string{"boolean"}})
+		require.Nil(t, err)
+		assert.Equal(t, map[string]string{"count": "job-1"}, jobs)
+		fakeSchemaManager.AssertNumberOfCalls(t, "BackfillProperty", 1)
+	})
+
+	t.Run("backfill not started", func(t *testing.T) {
+		handler, fakeSchemaManager := newTestHandler(t, &fakeDB{})
+		fakeSchemaManager.On("AddProperty", "C", mock.Anything).Return(nil)
+		fakeSchemaManager.On("BackfillProperty", "C", "count", float64(3)).Return("", errors.New("no index"))
+
+		class, _, _, err := handler.AddClassPropertyWithBackfill(ctx, nil, newClass(), "C", false,
+			&models.Property{Name: "count", DataType: []string{"int"}, DefaultValue: json.Number("3")})
+		var backfillErr ErrBackfillNotStarted
+		require.ErrorAs(t, err, &backfillErr)
+		assert.Equal(t, "count", backfillErr.Property)
+		require.NotNil(t, class)
+		assert.Len(t, class.Properties, 2)
+	})
+
+	t.Run("merged properties are not backfilled", func(t *testing.T) {
+		handler, fakeSchemaManager := newTestHandler(t, &fakeDB{})
+		fakeSchemaManager.On("AddProperty", "C", mock.Anything).Return(nil)
+
+		_, _, jobs, err := handler.AddClassPropertyWithBackfill(ctx, nil, newClass(), "C", true,
+			&models.Property{Name: "existing", DataType: []string{"int"}, DefaultValue: 1.0})
+		require.Nil(t, err)
+		assert.Empty(t, jobs)
+		fakeSchemaManager.AssertNotCalled(t, "BackfillProperty", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("invalid default values", func(t *testing.T) {
+		handler, fakeSchemaManager := newTestHandler(t, &fakeDB{})
+		for name, prop := range map[string]*models.Property{
+			"fraction for int":    {Name: "p", DataType: []string{"int"}, DefaultValue: 1.5},
+			"string for number":   {Name: "p", DataType: []string{"number"}, DefaultValue: "1"},
+			"number for boolean":  {Name: "p", DataType: []string{"boolean"}, DefaultValue: json.Number("1")},
+			"unsupported type":    {Name: "p", DataType: []string{"text"}, DefaultValue: "text"},
+			"unsupported array":   {Name: "p", DataType: []string{"int[]"}, DefaultValue: 1.0},
+			"invalid json number": {Name: "p", DataType: []string{"number"}, DefaultValue: json.Number("x")},
+		} {
+			t.Run(name, func(t *testing.T) {
+				_, _, _, err := handler.AddClassPropertyWithBackfill(ctx, nil, newClass(), "C", false, prop)
+				assert.ErrorContains(t, err, "defaultValue")
+			})
+		}
+		fakeSchemaManager.AssertNotCalled(t, "AddProperty", mock.Anything, mock.Anything)
+	})
+}
+
+func TestHandler_BackfillStatus(t *testing.T) {
+	handler, fakeSchemaManager := newTestHandler(t, &fakeDB{})
+	job := BackfillStatus{ID: "job-1", Class: "C", Property: "count", Status: "RUNNING", Updated: 10}
+	fakeSchemaManager.On("BackfillStatus", "job-1").Return(job, true)
+	fakeSchemaManager.On("BackfillStatus", "unknown").Return(BackfillStatus{}, false)
+
+	status, err := handler.BackfillStatus(context.Background(), nil, "job-1")
+	require.Nil(t, err)
+	assert.Equal(t, job, status)
+
+	_, err = handler.BackfillStatus(context.Background(), nil, "unknown")
+	assert.ErrorIs(t, err, ErrNotFound)
+}
@@ -0,0 +1,130 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	clusterTypes "github.com/weaviate/weaviate/cluster/types"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
+)
+
+// RemovedDuplicateProperty is a property which was removed by
+// RepairDuplicateProperties because an earlier one has the same name
+type RemovedDuplicateProperty struct {
+	Name     string
+	DataType []string
+}
+
+// RepairReport lists the duplicate properties removed from a class by
+// RepairDuplicateProperties
+type RepairReport struct {
+	Class   string
+	Removed []RemovedDuplicateProperty
+}
+
+// RepairDuplicateProperties removes the properties of class which share
+// their name with an earlier one, as concurrent property additions may leave
+// them behind. The first property of a name is kept. Reference targets of its
+// duplicates are merged into it, other data types of duplicates are dropped
+// and only appear in the report. The class isn't changed if it has no
+// duplicates.
+func (h *Handler) RepairDuplicateProperties(ctx context.Context, principal *models.Principal,
+	class string,
+) (RepairReport, error) {
+	className := schema.UppercaseClassName(class)
+	report := RepairReport{Class: className, Removed: []RemovedDuplicateProperty{}}
+	err := h.Authorizer.Authorize(principal, authorization.UPDATE, authorization.CollectionsMetadata(className)...)
+	if err != nil {
+		return report, err
+	}
+
+	initial := h.schemaReader.ReadOnlyClass(className)
+	if initial == nil {
+		return report, fmt.Errorf("class %q: %w", className, ErrNotFound)
+	}
+	repaired, removed := deduplicateProperties(initial)
+	if len(removed) == 0 {
+		return report, nil
+	}
+	report.Removed = removed
+
+	// dropping properties is only accepted from compactions, which may as
+	// well merge the data types of properties of the same name
+	ctx = clusterTypes.ContextWithCompaction(withActor(ctx, principal))
+	if _, err := h.schemaManager.UpdateClass(ctx, repaired, nil); err != nil {
+		return report, fmt.Errorf("repair class %q: %w", className, err)
+	}
+	h.notify(ctx, func(l EventListener) { l.OnClassUpdated(repaired) })
+
+	h.logEntry(ctx, className, "").WithField("action", "repair_duplicate_properties").
+		WithField("removed", len(removed)).
+		Info("removed duplicate properties")
+	return report, nil
+}
+
+// WarnDuplicateProperties logs a warning for every class with duplicate
+// properties, see RepairDuplicateProperties. It is meant to run once the
+// schema is loaded at startup.
+func (h *Handler) WarnDuplicateProperties() {
+	for _, class := range h.schemaReader.ReadOnlySchema().Classes {
+		if _, removed := deduplicateProperties(class); len(removed) > 0 {
+			names := make([]string, len(removed))
+			for i, r := range removed {
+				names[i] = r.Name
+			}
+			h.logEntry(context.Background(), class.Class, "").WithField("action", "startup").
+				WithField("properties", names).
+				Warn("class has duplicate properties, repair them with RepairDuplicateProperties")
+		}
+	}
+}
+
+// deduplicateProperties returns a copy of class with only the first property
+// of each name and the properties left out
+func deduplicateProperties(class *models.Class) (*models.Class, []RemovedDuplicateProperty) {
+	var removed []RemovedDuplicateProperty
+	kept := map[string]*models.Property{}
+	deduplicated := *class
+	deduplicated.Properties = make([]*models.Property, 0, len(class.Properties))
+	for _, prop := range class.Properties {
+		first, ok := kept[strings.ToLower(prop.Name)]
+		if !ok {
+			c := *prop
+			kept[strings.ToLower(prop.Name)] = &c
+			deduplicated.Properties = append(deduplicated.Properties, &c)
+			continue
+		}
+
+		removed = append(removed, RemovedDuplicateProperty{Name: prop.Name, DataType: slices.Clone(prop.DataType)})
+		if !schema.IsRefDataType(first.DataType) || !schema.IsRefDataType(prop.DataType) {
+			continue
+		}
+		for _, dt := range prop.DataType {
+			if !dataTypeAlreadyContained(first.DataType, dt) {
+				first.DataType = append(slices.Clone(first.DataType), dt)
+			}
+		}
+	}
+	return &deduplicated, removed
+}
+
+// dataTypeAlreadyContained reports whether dataType is one of the data types
+// of a property
+func dataTypeAlreadyContained(dataTypes []string, dataType string) bool {
+	return slices.Contains(dataTypes, dataType)
+}
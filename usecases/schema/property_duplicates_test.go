@@ -0,0 +1,79 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+func TestHandler_RepairDuplicateProperties(t *testing.T) {
+	ctx := context.Background()
+	newClass := func() *models.Class {
+		return &models.Class{
+			Class: "Article",
+			Properties: []*models.Property{
+				{Name: "title", DataType: []string{"text"}},
+				{Name: "author", DataType: []string{"Author"}},
+				{Name: "Title", DataType: []string{"int"}},
+				{Name: "author", DataType: []string{"Editor", "Author"}},
+			},
+		}
+	}
+
+	t.Run("repair", func(t *testing.T) {
+		handler, fakeSchemaManager := newTestHandler(t, &fakeDB{})
+		class := newClass()
+		fakeSchemaManager.On("ReadOnlyClass", "Article").Return(class)
+		fakeSchemaManager.On("UpdateClass", mock.MatchedBy(func(c *models.Class) bool {
+			return assert.Equal(t, []*models.Property{
+				{Name: "title", DataType: []string{"text"}},
+				{Name: "author", DataType: []string{"Author", "Editor"}},
+			}, c.Properties)
+		}), mock.Anything).Return(nil)
+
+		report, err := handler.RepairDuplicateProperties(ctx, nil, "article")
+		require.Nil(t, err)
+		assert.Equal(t, RepairReport{Class: "Article", Removed: []RemovedDuplicateProperty{
+			{Name: "Title", DataType: []string{"int"}},
+			{Name: "author", DataType: []string{"Editor", "Author"}},
+		}}, report)
+		fakeSchemaManager.AssertExpectations(t)
+		// the class of the schema isn't modified
+		assert.Len(t, class.Properties, 4)
+		assert.Equal(t, []string{"Author"}, class.Properties[1].DataType)
+	})
+
+	t.Run("no duplicates", func(t *testing.T) {
+		handler, fakeSchemaManager := newTestHandler(t, &fakeDB{})
+		class := newClass()
+		class.Properties = class.Properties[:2]
+		fakeSchemaManager.On("ReadOnlyClass", "Article").Return(class)
+
+		report, err := handler.RepairDuplicateProperties(ctx, nil, "Article")
+		require.Nil(t, err)
+		assert.Empty(t, report.Removed)
+		fakeSchemaManager.AssertNotCalled(t, "UpdateClass", mock.Anything, mock.Anything)
+	})
+
+	t.Run("unknown class", func(t *testing.T) {
+		handler, fakeSchemaManager := newTestHandler(t, &fakeDB{})
+		fakeSchemaManager.On("ReadOnlyClass", "Missing").Return(nil)
+		_, err := handler.RepairDuplicateProperties(ctx, nil, "Missing")
+		assert.ErrorIs(t, err, ErrNotFound)
+	})
+}
@@ -0,0 +1,148 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	clusterSchema "github.com/weaviate/weaviate/cluster/schema"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
+	"github.com/weaviate/weaviate/usecases/sharding"
+)
+
+var propertyGroupNameRegex = regexp.MustCompile(`^[A-Za-z0-9_-]{1,64}$`)
+
+// validatePropertyGroup validates the group of a property, properties don't
+// need to have one
+func validatePropertyGroup(group *models.PropertyGroup) error {
+	if group == nil {
+		return nil
+	}
+	if !propertyGroupNameRegex.MatchString(group.Name) {
+		return fmt.Errorf("invalid group name %q: must match %s", group.Name, propertyGroupNameRegex)
+	}
+	return nil
+}
+
+// GetPropertiesByGroup returns the properties of class which belong to the
+// named group
+func (h *Handler) GetPropertiesByGroup(principal *models.Principal, class, group string) ([]*models.Property, error) {
+	err := h.Authorizer.Authorize(principal, authorization.READ, authorization.CollectionsMetadata(class)...)
+	if err != nil {
+		return nil, err
+	}
+
+	props := []*models.Property{}
+	err = h.schemaReader.Read(schema.UppercaseClassName(class), func(c *models.Class, _ *sharding.State) error {
+		for _, prop := range c.Properties {
+			if prop.Group != nil && prop.Group.Name == group {
+				props = append(props, prop)
+			}
+		}
+		return nil
+	})
+	if errors.Is(err, clusterSchema.ErrClassNotFound) {
+		return nil, fmt.Errorf("class %q: %w", class, ErrNotFound)
+	} else if err != nil {
+		return nil, fmt.Errorf("class %q: %w", class, err)
+	}
+	return props, nil
+}
+
+// GetPropertyGroups returns the groups of the properties of class ordered by
+// name. If properties of a group disagree on its description the one of the
+// first property is returned.
+func (h *Handler) GetPropertyGroups(principal *models.Principal, class string) ([]*models.PropertyGroup, error) {
+	err := h.Authorizer.Authorize(principal, authorization.READ, authorization.CollectionsMetadata(class)...)
+	if err != nil {
+		return nil, err
+	}
+
+	groups := []*models.PropertyGroup{}
+	err = h.schemaReader.Read(schema.UppercaseClassName(class), func(c *models.Class, _ *sharding.State) error {
+		seen := map[string]struct{}{}
+		for _, prop := range c.Properties {
+			if prop.Group == nil {
+				continue
+			}
+			if _, ok := seen[prop.Group.Name]; !ok {
+				seen[prop.Group.Name] = struct{}{}
+				groups = append(groups, &models.PropertyGroup{Name: prop.Group.Name, Description: prop.Group.Description})
+			}
+		}
+		return nil
+	})
+	if errors.Is(err, clusterSchema.ErrClassNotFound) {
+		return nil, fmt.Errorf("class %q: %w", class, ErrNotFound)
+	} else if err != nil {
+		return nil, fmt.Errorf("class %q: %w", class, err)
+	}
+	sort.Slice(groups, func(i, j int) bool { return groups[i].Name < groups[j].Name })
+	return groups, nil
+}
+
+// SetPropertyGroup assigns group to the named properties of class, replacing
+// the group they had before. The group of the other properties is kept.
+func (h *Handler) SetPropertyGroup(ctx context.Context, principal *models.Principal,
+	className string, group *models.PropertyGroup, properties []string,
+) error {
+	err := h.Authorizer.Authorize(principal, authorization.UPDATE, authorization.CollectionsMetadata(className)...)
+	if err != nil {
+		return err
+	}
+	if group == nil {
+		return fmt.Errorf("%w: missing group", clusterSchema.ErrBadRequest)
+	}
+	if err := validatePropertyGroup(group); err != nil {
+		return fmt.Errorf("%w: %w", clusterSchema.ErrBadRequest, err)
+	}
+	if len(properties) == 0 {
+		return fmt.Errorf("%w: group %q must be assigned to at least one property", clusterSchema.ErrBadRequest, group.Name)
+	}
+
+	className = schema.UppercaseClassName(className)
+	initial := h.schemaReader.ReadOnlyClass(className)
+	if initial == nil {
+		return fmt.Errorf("class %q: %w", className, ErrNotFound)
+	}
+
+	assign := make(map[string]bool, len(properties))
+	for _, name := range properties {
+		assign[strings.ToLower(name)] = false
+	}
+	updated := *initial
+	updated.Properties = make([]*models.Property, len(initial.Properties))
+	for i, prop := range initial.Properties {
+		updated.Properties[i] = prop
+		if _, ok := assign[strings.ToLower(prop.Name)]; ok {
+			c := *prop
+			c.Group = &models.PropertyGroup{Name: group.Name, Description: group.Description}
+			updated.Properties[i] = &c
+			assign[strings.ToLower(prop.Name)] = true
+		}
+	}
+	for name, found := range assign {
+		if !found {
+			return fmt.Errorf("%w: class %q has no property %q", clusterSchema.ErrBadRequest, className, name)
+		}
+	}
+
+	_, err = h.schemaManager.UpdateClass(withActor(ctx, principal), &updated, nil)
+	return err
+}
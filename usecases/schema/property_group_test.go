@@ -0,0 +1,146 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	clusterSchema "github.com/weaviate/weaviate/cluster/schema"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/sharding"
+)
+
+func TestHandler_PropertyGroups(t *testing.T) {
+	ctx := context.Background()
+	address := &models.PropertyGroup{Name: "address", Description: "postal address"}
+	class := func() *models.Class {
+		return &models.Class{Class: "C", Properties: []*models.Property{
+			{Name: "name", DataType: []string{"text"}},
+			{Name: "street", DataType: []string{"text"}, Group: address},
+			{Name: "city", DataType: []string{"text"}, Group: address},
+			{Name: "revenue", DataType: []string{"number"}, Group: &models.PropertyGroup{Name: "finance"}},
+		}}
+	}
+	read := func(fakeSchemaManager *fakeSchemaManager, class *models.Class) {
+		call := fakeSchemaManager.On("Read", "C", mock.Anything)
+		if class == nil {
+			call.Return(clusterSchema.ErrClassNotFound)
+			return
+		}
+		call.Run(func(args mock.Arguments) {
+			args.Get(1).(func(*models.Class, *sharding.State) error)(class, nil)
+		}).Return(nil)
+	}
+
+	t.Run("properties by group", func(t *testing.T) {
+		handler, fakeSchemaManager := newTestHandler(t, &fakeDB{})
+		read(fakeSchemaManager, class())
+
+		props, err := handler.GetPropertiesByGroup(nil, "C", "address")
+		require.Nil(t, err)
+		require.Len(t, props, 2)
+		assert.Equal(t, "street", props[0].Name)
+		assert.Equal(t, "city", props[1].Name)
+	})
+
+	t.Run("properties of unknown group", func(t *testing.T) {
+		handler, fakeSchemaManager := newTestHandler(t, &fakeDB{})
+		read(fakeSchemaManager, class())
+
+		props, err := handler.GetPropertiesByGroup(nil, "C", "other")
+		require.Nil(t, err)
+		assert.Empty(t, props)
+	})
+
+	t.Run("groups", func(t *testing.T) {
+		handler, fakeSchemaManager := newTestHandler(t, &fakeDB{})
+		read(fakeSchemaManager, class())
+
+		groups, err := handler.GetPropertyGroups(nil, "C")
+		require.Nil(t, err)
+		assert.Equal(t, []*models.PropertyGroup{address, {Name: "finance"}}, groups)
+	})
+
+	t.Run("unknown class", func(t *testing.T) {
+		handler, fakeSchemaManager := newTestHandler(t, &fakeDB{})
+		read(fakeSchemaManager, nil)
+
+		_, err := handler.GetPropertyGroups(nil, "C")
+		assert.ErrorIs(t, err, ErrNotFound)
+		_, err = handler.GetPropertiesByGroup(nil, "C", "address")
+		assert.ErrorIs(t, err, ErrNotFound)
+	})
+
+	t.Run("assign group", func(t *testing.T) {
+		handler, fakeSchemaManager := newTestHandler(t, &fakeDB{})
+		initial := class()
+		fakeSchemaManager.On("ReadOnlyClass", "C").Return(initial)
+		contact := &models.PropertyGroup{Name: "contact"}
+		fakeSchemaManager.On("UpdateClass", mock.MatchedBy(func(c *models.Class) bool {
+			return c.Properties[0].Group.Name == "contact" && c.Properties[1].Group.Name == "contact" &&
+				c.Properties[2].Group == address && c.Properties[3].Group.Name == "finance"
+		}), mock.Anything).Return(nil)
+
+		require.Nil(t, handler.SetPropertyGroup(ctx, nil, "C", contact, []string{"name", "Street"}))
+		fakeSchemaManager.AssertExpectations(t)
+		assert.Nil(t, initial.Properties[0].Group, "read only class must not be modified")
+		assert.Equal(t, address, initial.Properties[1].Group)
+	})
+
+	tests := []struct {
+		name       string
+		group      *models.PropertyGroup
+		properties []string
+		err        string
+	}{
+		{name: "missing group", properties: []string{"name"}, err: "missing group"},
+		{name: "empty name", group: &models.PropertyGroup{}, properties: []string{"name"}, err: "invalid group name"},
+		{name: "invalid name", group: &models.PropertyGroup{Name: "a b"}, properties: []string{"name"}, err: "invalid group name"},
+		{name: "too long", group: &models.PropertyGroup{Name: strings.Repeat("a", 65)}, properties: []string{"name"}, err: "invalid group name"},
+		{name: "no properties", group: address, err: "at least one property"},
+		{name: "unknown property", group: address, properties: []string{"zip"}, err: `no property "zip"`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			handler, fakeSchemaManager := newTestHandler(t, &fakeDB{})
+			fakeSchemaManager.On("ReadOnlyClass", "C").Return(class())
+
+			err := handler.SetPropertyGroup(ctx, nil, "C", tt.group, tt.properties)
+			assert.ErrorIs(t, err, clusterSchema.ErrBadRequest)
+			assert.ErrorContains(t, err, tt.err)
+			fakeSchemaManager.AssertNotCalled(t, "UpdateClass", mock.Anything, mock.Anything)
+		})
+	}
+
+	t.Run("assign group to unknown class", func(t *testing.T) {
+		handler, fakeSchemaManager := newTestHandler(t, &fakeDB{})
+		fakeSchemaManager.On("ReadOnlyClass", "C").Return(nil)
+
+		err := handler.SetPropertyGroup(ctx, nil, "C", address, []string{"name"})
+		assert.ErrorIs(t, err, ErrNotFound)
+	})
+}
+
+func TestValidatePropertyGroup(t *testing.T) {
+	for _, name := range []string{"a", "Address_2", "finance-eu", strings.Repeat("a", 64)} {
+		assert.Nil(t, validatePropertyGroup(&models.PropertyGroup{Name: name}), name)
+	}
+	for _, name := range []string{"", "a.b", "ä", strings.Repeat("a", 65)} {
+		assert.NotNil(t, validatePropertyGroup(&models.PropertyGroup{Name: name}), name)
+	}
+	assert.Nil(t, validatePropertyGroup(nil))
+}
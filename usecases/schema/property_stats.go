@@ -0,0 +1,129 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/weaviate/weaviate/cluster/types"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/usecases/config"
+	uco "github.com/weaviate/weaviate/usecases/objects"
+	"golang.org/x/sync/singleflight"
+)
+
+// PropertyStats describes the values of a property, see GetPropertyStats
+type PropertyStats = types.PropertyStats
+
+// propertyStatsTimeout bounds the scan of the index of a property, which is
+// not bound to the requests waiting for it
+const propertyStatsTimeout = 10 * time.Minute
+
+// propertyStatsCache keeps the stats of properties until they are older than
+// ttl. Concurrent requests for the same property share a single scan. It is
+// shared by all copies of a Handler.
+type propertyStatsCache struct {
+	sync.Mutex
+	group singleflight.Group
+	ttl   time.Duration
+	stats map[string]PropertyStats
+}
+
+func newPropertyStatsCache(ttl time.Duration) *propertyStatsCache {
+	if ttl <= 0 {
+		ttl = time.Duration(config.DefaultPropertyStatsCacheTTL) * time.Second
+	}
+	return &propertyStatsCache{ttl: ttl, stats: map[string]PropertyStats{}}
+}
+
+func (c *propertyStatsCache) get(key string) (PropertyStats, bool) {
+	c.Lock()
+	defer c.Unlock()
+	stats, ok := c.stats[key]
+	if ok && time.Since(stats.ComputedAt) > c.ttl {
+		delete(c.stats, key)
+		return PropertyStats{}, false
+	}
+	return stats, ok
+}
+
+func (c *propertyStatsCache) put(key string, stats PropertyStats) {
+	c.Lock()
+	defer c.Unlock()
+	c.stats[key] = stats
+}
+
+// GetPropertyStats returns the number of objects without a value, the number
+// of distinct values and the average value length of property in the loaded
+// shards of class on this node, e.g. to decide whether a filterable index is
+// worth keeping. The stats are computed by scanning the filterable index of
+// the property, which therefore must have one. They are cached for
+// Schema.PropertyStatsCacheTTL, see ComputedAt for their age. Like the
+// activation of tenants the scan is not bound to ctx, so that a request
+// giving up does not fail the other requests waiting for it.
+func (h *Handler) GetPropertyStats(ctx context.Context, principal *models.Principal,
+	class, property string,
+) (*PropertyStats, error) {
+	prop, err := h.GetPropertyByName(principal, class, property)
+	if err != nil {
+		return nil, err
+	}
+	class = schema.UppercaseClassName(class)
+	if err := validatePropertyStatsIndex(prop); err != nil {
+		return nil, err
+	}
+
+	key := class + "/" + prop.Name
+	if stats, ok := h.propertyStats.get(key); ok {
+		return &stats, nil
+	}
+	ch := h.propertyStats.group.DoChan(key, func() (interface{}, error) {
+		ctx, cancel := context.WithTimeout(context.Background(), propertyStatsTimeout)
+		defer cancel()
+
+		stats, err := h.dataMigrator.ComputePropertyStats(ctx, class, prop.Name)
+		if err != nil {
+			return nil, fmt.Errorf("compute stats of property %q: %w", prop.Name, err)
+		}
+		h.propertyStats.put(key, *stats)
+		return *stats, nil
+	})
+	select {
+	case res := <-ch:
+		if res.Err != nil {
+			return nil, res.Err
+		}
+		stats := res.Val.(PropertyStats)
+		return &stats, nil
+	case <-ctx.Done():
+		return nil, fmt.Errorf("compute stats of property %q: %w", prop.Name, ctx.Err())
+	}
+}
+
+// validatePropertyStatsIndex checks that prop has a filterable index with
+// its values as keys
+func validatePropertyStatsIndex(prop *models.Property) error {
+	if prop.IndexFilterable != nil && !*prop.IndexFilterable {
+		return uco.NewErrInvalidUserInput("property %q has no filterable index", prop.Name)
+	}
+	switch dt, ok := schema.AsPrimitive(prop.DataType); {
+	case !ok:
+		return uco.NewErrInvalidUserInput("stats of property %q: only primitive data types are supported", prop.Name)
+	case dt == schema.DataTypeGeoCoordinates || dt == schema.DataTypeBlob || dt == schema.DataTypePhoneNumber:
+		return uco.NewErrInvalidUserInput("stats of property %q: data type %s is not supported", prop.Name, dt)
+	}
+	return nil
+}
@@ -0,0 +1,113 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+	uco "github.com/weaviate/weaviate/usecases/objects"
+	"github.com/weaviate/weaviate/usecases/sharding"
+)
+
+func TestHandler_GetPropertyStats(t *testing.T) {
+	ctx := context.Background()
+	vFalse := false
+	class := &models.Class{Class: "C", Properties: []*models.Property{
+		{Name: "color", DataType: []string{"text"}},
+		{Name: "note", DataType: []string{"text"}, IndexFilterable: &vFalse},
+		{Name: "location", DataType: []string{"geoCoordinates"}},
+	}}
+	newHandler := func(t *testing.T) (*Handler, *fakeSchemaManager) {
+		handler, fakeSchemaManager := newTestHandler(t, &fakeDB{})
+		fakeSchemaManager.On("Read", "C", mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+			args.Get(1).(func(*models.Class, *sharding.State) error)(class, nil)
+		})
+		return handler, fakeSchemaManager
+	}
+
+	t.Run("stats are cached", func(t *testing.T) {
+		handler, fakeSchemaManager := newHandler(t)
+		computed := &PropertyStats{TotalCount: 60, NullCount: 10, DistinctValueCount: 2, AvgValueLength: 4.8, ComputedAt: time.Now()}
+		fakeSchemaManager.On("ComputePropertyStats", "C", "color").Return(computed, nil).Once()
+
+		stats, err := handler.GetPropertyStats(ctx, nil, "c", "Color")
+		require.Nil(t, err)
+		assert.Equal(t, computed, stats)
+		// the cached stats are a copy
+		stats.NullCount = 0
+
+		stats, err = handler.GetPropertyStats(ctx, nil, "C", "color")
+		require.Nil(t, err)
+		assert.Equal(t, computed, stats)
+		fakeSchemaManager.AssertNumberOfCalls(t, "ComputePropertyStats", 1)
+	})
+
+	t.Run("expired stats are computed again", func(t *testing.T) {
+		handler, fakeSchemaManager := newHandler(t)
+		old := &PropertyStats{TotalCount: 1, ComputedAt: time.Now().Add(-5*time.Minute - time.Second)}
+		fakeSchemaManager.On("ComputePropertyStats", "C", "color").Return(old, nil)
+
+		for i := 0; i < 2; i++ {
+			_, err := handler.GetPropertyStats(ctx, nil, "C", "color")
+			require.Nil(t, err)
+		}
+		fakeSchemaManager.AssertNumberOfCalls(t, "ComputePropertyStats", 2)
+	})
+
+	t.Run("errors are not cached", func(t *testing.T) {
+		handler, fakeSchemaManager := newHandler(t)
+		fakeSchemaManager.On("ComputePropertyStats", "C", "color").Return((*PropertyStats)(nil), errors.New("disk failure")).Once()
+		fakeSchemaManager.On("ComputePropertyStats", "C", "color").Return(&PropertyStats{ComputedAt: time.Now()}, nil).Once()
+
+		_, err := handler.GetPropertyStats(ctx, nil, "C", "color")
+		assert.ErrorContains(t, err, "disk failure")
+		_, err = handler.GetPropertyStats(ctx, nil, "C", "color")
+		assert.Nil(t, err)
+	})
+
+	t.Run("the scan outlives a canceled request", func(t *testing.T) {
+		handler, fakeSchemaManager := newHandler(t)
+		computed := &PropertyStats{TotalCount: 1, ComputedAt: time.Now()}
+		scanning := make(chan time.Time)
+		fakeSchemaManager.On("ComputePropertyStats", "C", "color").Return(computed, nil).WaitUntil(scanning).Once()
+
+		canceled, cancel := context.WithCancel(ctx)
+		cancel()
+		_, err := handler.GetPropertyStats(canceled, nil, "C", "color")
+		assert.ErrorIs(t, err, context.Canceled)
+
+		close(scanning)
+		stats, err := handler.GetPropertyStats(ctx, nil, "C", "color")
+		require.Nil(t, err)
+		assert.Equal(t, computed, stats)
+		fakeSchemaManager.AssertNumberOfCalls(t, "ComputePropertyStats", 1)
+	})
+
+	t.Run("unsupported properties", func(t *testing.T) {
+		handler, fakeSchemaManager := newHandler(t)
+
+		_, err := handler.GetPropertyStats(ctx, nil, "C", "missing")
+		assert.ErrorIs(t, err, ErrNotFound)
+		for _, prop := range []string{"note", "location"} {
+			_, err = handler.GetPropertyStats(ctx, nil, "C", prop)
+			assert.ErrorAs(t, err, &uco.ErrInvalidUserInput{}, prop)
+		}
+		fakeSchemaManager.AssertNotCalled(t, "ComputePropertyStats", mock.Anything, mock.Anything)
+	})
+}
@@ -20,8 +20,10 @@ import (
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	clusterSchema "github.com/weaviate/weaviate/cluster/schema"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/usecases/sharding"
 )
 
 func TestHandler_AddProperty(t *testing.T) {
@@ -35,7 +37,7 @@ func TestHandler_AddProperty(t *testing.T) {
 			Vectorizer: "none",
 		}
 		fakeSchemaManager.On("AddClass", mock.Anything, mock.Anything).Return(nil)
-		_, _, err := handler.AddClass(ctx, nil, &class)
+		_, err := handler.AddClass(ctx, nil, &class)
 		require.NoError(t, err)
 		dataTypes := []schema.DataType{
 			schema.DataTypeInt,
@@ -91,7 +93,7 @@ func TestHandler_AddProperty(t *testing.T) {
 			Vectorizer: "none",
 		}
 		fakeSchemaManager.On("AddClass", mock.Anything, mock.Anything).Return(nil)
-		_, _, err := handler.AddClass(ctx, nil, &class)
+		_, err := handler.AddClass(ctx, nil, &class)
 		require.NoError(t, err)
 
 		existingNames := []string{
@@ -119,6 +121,167 @@ func TestHandler_AddProperty(t *testing.T) {
 	})
 }
 
+func TestHandler_AddProperty_Deprecated(t *testing.T) {
+	ctx := context.Background()
+	vTrue, vFalse := true, false
+
+	newClass := func() *models.Class {
+		return &models.Class{
+			Class:      "NewClass",
+			Vectorizer: "none",
+			Properties: []*models.Property{{
+				Name:             "obj",
+				DataType:         schema.DataTypeObject.PropString(),
+				NestedProperties: []*models.NestedProperty{{Name: "first", DataType: schema.DataTypeInt.PropString()}},
+				Deprecated:       &vTrue,
+			}},
+		}
+	}
+
+	t.Run("merge into deprecated property is refused", func(t *testing.T) {
+		handler, fakeSchemaManager := newTestHandler(t, &fakeDB{})
+		class := newClass()
+
+		prop := &models.Property{
+			Name:             "obj",
+			DataType:         schema.DataTypeObject.PropString(),
+			NestedProperties: []*models.NestedProperty{{Name: "second", DataType: schema.DataTypeInt.PropString()}},
+		}
+		_, _, err := handler.AddClassProperty(ctx, nil, class, class.Class, true, prop)
+		require.ErrorContains(t, err, `property "obj" is deprecated`)
+		fakeSchemaManager.AssertNotCalled(t, "AddProperty", mock.Anything, mock.Anything)
+	})
+
+	t.Run("merge into deprecated property clearing the flag", func(t *testing.T) {
+		handler, fakeSchemaManager := newTestHandler(t, &fakeDB{})
+		class := newClass()
+
+		prop := &models.Property{
+			Name:             "obj",
+			DataType:         schema.DataTypeObject.PropString(),
+			NestedProperties: []*models.NestedProperty{{Name: "second", DataType: schema.DataTypeInt.PropString()}},
+			Deprecated:       &vFalse,
+		}
+		fakeSchemaManager.On("AddProperty", class.Class, mock.Anything).Return(nil)
+		updated, _, err := handler.AddClassProperty(ctx, nil, class, class.Class, true, prop)
+		require.NoError(t, err)
+		require.Len(t, updated.Properties, 1)
+		require.False(t, *updated.Properties[0].Deprecated)
+		require.Len(t, updated.Properties[0].NestedProperties, 2)
+	})
+}
+
+func TestHandler_BulkAddProperty(t *testing.T) {
+	ctx := context.Background()
+	handler, fakeSchemaManager := newTestHandler(t, &fakeDB{})
+
+	newClass := func(name string) *models.Class {
+		return &models.Class{
+			Class:      name,
+			Vectorizer: "none",
+			Properties: []*models.Property{{Name: "title", DataType: schema.DataTypeText.PropString()}},
+		}
+	}
+	newProp := func(name string) *models.Property {
+		return &models.Property{Name: name, DataType: schema.DataTypeDate.PropString()}
+	}
+
+	fakeSchemaManager.On("ReadOnlyClass", "Books").Return(newClass("Books"))
+	fakeSchemaManager.On("ReadOnlyClass", "Authors").Return(newClass("Authors"))
+	fakeSchemaManager.On("ReadOnlyClass", "Missing").Return(nil)
+	fakeSchemaManager.On("AddProperty", "Books", mock.MatchedBy(func(props []*models.Property) bool {
+		return len(props) == 2 && props[0].Name == "updated_at" && props[1].Name == "created_at"
+	})).Return(nil)
+	fakeSchemaManager.On("AddProperty", "Authors", mock.MatchedBy(func(props []*models.Property) bool {
+		return len(props) == 1 && props[0].Name == "updated_at"
+	})).Return(nil)
+
+	err := handler.BulkAddProperty(ctx, nil, []ClassPropertyAddition{
+		{Class: "Books", Property: newProp("updated_at")},
+		{Class: "authors", Property: newProp("updated_at")},
+		{Class: "Missing", Property: newProp("updated_at")},
+		{Class: "Books", Property: newProp("created_at")},
+	})
+
+	var classErrs ClassPropertyErrors
+	require.ErrorAs(t, err, &classErrs)
+	require.Len(t, classErrs, 1)
+	assert.Equal(t, "Missing", classErrs[0].Class)
+	assert.ErrorIs(t, classErrs[0], ErrNotFound)
+	fakeSchemaManager.AssertNumberOfCalls(t, "AddProperty", 2)
+}
+
+func TestHandler_MaxPropertiesPerClass(t *testing.T) {
+	ctx := context.Background()
+	prop := func(name string) *models.Property {
+		return &models.Property{Name: name, DataType: schema.DataTypeText.PropString()}
+	}
+	newHandler := func(t *testing.T) (*Handler, *fakeSchemaManager) {
+		handler, fakeSchemaManager := newTestHandler(t, &fakeDB{})
+		handler.config.Schema.MaxPropertiesPerClass = 2
+		return handler, fakeSchemaManager
+	}
+
+	t.Run("add class", func(t *testing.T) {
+		handler, fakeSchemaManager := newHandler(t)
+		fakeSchemaManager.On("AddClass", mock.Anything, mock.Anything).Return(nil)
+
+		_, err := handler.AddClass(ctx, nil, &models.Class{
+			Class: "C1", Vectorizer: "none", Properties: []*models.Property{prop("a"), prop("b")},
+		})
+		require.NoError(t, err)
+
+		_, err = handler.AddClass(ctx, nil, &models.Class{
+			Class: "C2", Vectorizer: "none", Properties: []*models.Property{prop("a"), prop("b"), prop("c")},
+		})
+		var limitErr ErrMaxPropertiesExceeded
+		require.ErrorAs(t, err, &limitErr)
+		assert.Equal(t, ErrMaxPropertiesExceeded{Class: "C2", Current: 3, Max: 2}, limitErr)
+	})
+
+	t.Run("add property", func(t *testing.T) {
+		handler, fakeSchemaManager := newHandler(t)
+		// the stored class is newer than the one passed in
+		stored := &models.Class{Class: "C", Properties: []*models.Property{prop("a"), prop("b")}}
+		fakeSchemaManager.On("Read", "C", mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+			args.Get(1).(func(*models.Class, *sharding.State) error)(stored, nil)
+		})
+		fakeSchemaManager.On("AddProperty", "C", mock.Anything).Return(nil)
+
+		class := &models.Class{Class: "C", Vectorizer: "none", Properties: []*models.Property{prop("a")}}
+		_, _, err := handler.AddClassProperty(ctx, nil, class, "C", false, prop("c"))
+		var limitErr ErrMaxPropertiesExceeded
+		require.ErrorAs(t, err, &limitErr)
+		assert.Equal(t, ErrMaxPropertiesExceeded{Class: "C", Current: 3, Max: 2}, limitErr)
+		fakeSchemaManager.AssertNotCalled(t, "AddProperty", "C", mock.Anything)
+
+		// merging into existing properties does not count
+		class = &models.Class{Class: "C", Vectorizer: "none", Properties: []*models.Property{prop("a")}}
+		_, _, err = handler.AddClassProperty(ctx, nil, class, "C", true, prop("b"))
+		require.NoError(t, err)
+	})
+
+	t.Run("unlimited", func(t *testing.T) {
+		handler, fakeSchemaManager := newHandler(t)
+		handler.config.Schema.MaxPropertiesPerClass = 0
+		fakeSchemaManager.On("AddClass", mock.Anything, mock.Anything).Return(nil)
+
+		_, err := handler.AddClass(ctx, nil, &models.Class{
+			Class: "C", Vectorizer: "none", Properties: []*models.Property{prop("a"), prop("b"), prop("c")},
+		})
+		require.NoError(t, err)
+	})
+
+	t.Run("exposed in schema", func(t *testing.T) {
+		handler, fakeSchemaManager := newHandler(t)
+		fakeSchemaManager.On("ReadOnlySchema").Return(models.Schema{})
+
+		s, err := handler.GetSchema(nil, "")
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), s.Objects.MaxPropertiesPerClass)
+	})
+}
+
 // TestHandler_AddProperty_Object verifies that we can add properties on class with the Object and ObjectArray type.
 // This test is different than TestHandler_AddProperty because Object and ObjectArray require nested properties to be validated.
 func TestHandler_AddProperty_Object(t *testing.T) {
@@ -132,7 +295,7 @@ func TestHandler_AddProperty_Object(t *testing.T) {
 			Vectorizer: "none",
 		}
 		fakeSchemaManager.On("AddClass", mock.Anything, mock.Anything).Return(nil)
-		_, _, err := handler.AddClass(ctx, nil, &class)
+		_, err := handler.AddClass(ctx, nil, &class)
 		require.NoError(t, err)
 		dataTypes := []schema.DataType{
 			schema.DataTypeObject,
@@ -405,9 +568,9 @@ func TestHandler_AddProperty_Reference_Tokenization(t *testing.T) {
 	fakeSchemaManager.On("ReadOnlyClass", mock.Anything, mock.Anything).Return(&refClass)
 	fakeSchemaManager.On("AddClass", mock.Anything, mock.Anything).Return(nil).Twice()
 	fakeSchemaManager.On("ReadOnlyClass", mock.Anything, mock.Anything).Return(&class)
-	_, _, err := handler.AddClass(ctx, nil, &class)
+	_, err := handler.AddClass(ctx, nil, &class)
 	require.NoError(t, err)
-	_, _, err = handler.AddClass(ctx, nil, &refClass)
+	_, err = handler.AddClass(ctx, nil, &refClass)
 	require.NoError(t, err)
 
 	dataType := []string{refClass.Class}
@@ -715,6 +878,18 @@ func Test_Validation_PropertyIndexing(t *testing.T) {
 			})
 		}
 	})
+
+	t.Run("references must stay filterable", func(t *testing.T) {
+		for _, filterable := range []*bool{nil, &vTrue} {
+			require.NoError(t, validateReferenceIndexing(&models.Property{
+				Name: "ref", DataType: []string{"Target"}, IndexFilterable: filterable,
+			}))
+		}
+		err := validateReferenceIndexing(&models.Property{
+			Name: "ref", DataType: []string{"Target"}, IndexFilterable: &vFalse,
+		})
+		assert.ErrorContains(t, err, "`indexFilterable` can not be disabled for reference data types")
+	})
 }
 
 type fakePropertyDataType struct {
@@ -770,3 +945,112 @@ func (pdt *fakePropertyDataType) Classes() []schema.ClassName {
 func (pdt *fakePropertyDataType) ContainsClass(name schema.ClassName) bool {
 	return false
 }
+
+func TestHandler_GetPropertyByName(t *testing.T) {
+	class := &models.Class{
+		Class:      "C1",
+		Properties: []*models.Property{{Name: "name", DataType: schema.DataTypeText.PropString()}},
+	}
+	handler, fakeSchemaManager := newTestHandler(t, &fakeDB{})
+	fakeSchemaManager.On("Read", "C1", mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		args.Get(1).(func(*models.Class, *sharding.State) error)(class, nil)
+	})
+	fakeSchemaManager.On("Read", "C2", mock.Anything).Return(clusterSchema.ErrClassNotFound)
+
+	prop, err := handler.GetPropertyByName(nil, "c1", "Name")
+	require.Nil(t, err)
+	assert.Equal(t, "name", prop.Name)
+	// the returned property is a copy
+	prop.Description = "changed"
+	assert.Empty(t, class.Properties[0].Description)
+
+	_, err = handler.GetPropertyByName(nil, "C1", "missing")
+	assert.ErrorIs(t, err, ErrNotFound)
+	_, err = handler.GetPropertyByName(nil, "C2", "name")
+	assert.ErrorIs(t, err, ErrNotFound)
+}
+
+// propertyDefaultsModuleConfig sets the module config of properties without
+// one, like vectorizer modules do
+type propertyDefaultsModuleConfig struct {
+	fakeModuleConfig
+}
+
+func (f *propertyDefaultsModuleConfig) SetSinglePropertyDefaults(class *models.Class,
+	props ...*models.Property,
+) {
+	for _, prop := range props {
+		if prop.ModuleConfig == nil {
+			prop.ModuleConfig = map[string]interface{}{
+				class.Vectorizer: map[string]interface{}{"skip": false, "vectorizePropertyName": false},
+			}
+		}
+	}
+}
+
+func TestHandler_AddProperty_ReturnsDefaults(t *testing.T) {
+	ctx := context.Background()
+	newHandler := func(t *testing.T) *Handler {
+		handler, fakeSchemaManager := newTestHandler(t, &fakeDB{})
+		handler.moduleConfig = &propertyDefaultsModuleConfig{}
+		fakeSchemaManager.On("ReadOnlyClass", "C").Return(&models.Class{Class: "C", Vectorizer: "text2vec-fake"})
+		fakeSchemaManager.On("AddProperty", "C", mock.Anything).Return(nil)
+		return handler
+	}
+
+	t.Run("omitted fields", func(t *testing.T) {
+		prop, err := newHandler(t).AddProperty(ctx, nil, "C", &models.Property{
+			Name:     "Title",
+			DataType: schema.DataTypeText.PropString(),
+		})
+		require.Nil(t, err)
+		assert.Equal(t, "title", prop.Name)
+		assert.Equal(t, schema.DataTypeText.PropString(), prop.DataType)
+		assert.Equal(t, models.PropertyTokenizationWord, prop.Tokenization)
+		assert.Equal(t, true, *prop.IndexFilterable)
+		assert.Equal(t, true, *prop.IndexSearchable)
+		assert.Equal(t, map[string]interface{}{
+			"text2vec-fake": map[string]interface{}{"skip": false, "vectorizePropertyName": false},
+		}, prop.ModuleConfig)
+	})
+
+	t.Run("deprecated data type is migrated", func(t *testing.T) {
+		prop, err := newHandler(t).AddProperty(ctx, nil, "C", &models.Property{
+			Name:     "title",
+			DataType: schema.DataTypeString.PropString(),
+		})
+		require.Nil(t, err)
+		assert.Equal(t, schema.DataTypeText.PropString(), prop.DataType)
+		assert.Equal(t, models.PropertyTokenizationWhitespace, prop.Tokenization)
+	})
+
+	t.Run("explicit fields are kept", func(t *testing.T) {
+		vFalse := false
+		moduleConfig := map[string]interface{}{"text2vec-fake": map[string]interface{}{"skip": true}}
+		prop, err := newHandler(t).AddProperty(ctx, nil, "C", &models.Property{
+			Name:            "title",
+			DataType:        schema.DataTypeText.PropString(),
+			Tokenization:    models.PropertyTokenizationField,
+			IndexSearchable: &vFalse,
+			ModuleConfig:    moduleConfig,
+		})
+		require.Nil(t, err)
+		assert.Equal(t, models.PropertyTokenizationField, prop.Tokenization)
+		assert.Equal(t, false, *prop.IndexSearchable)
+		assert.Equal(t, moduleConfig, prop.ModuleConfig)
+	})
+
+	t.Run("lowercase class name", func(t *testing.T) {
+		prop, err := newHandler(t).AddProperty(ctx, nil, "c", &models.Property{
+			Name:     "title",
+			DataType: schema.DataTypeText.PropString(),
+		})
+		require.Nil(t, err)
+		assert.Equal(t, models.PropertyTokenizationWord, prop.Tokenization)
+	})
+
+	t.Run("data type has no default", func(t *testing.T) {
+		_, err := newHandler(t).AddProperty(ctx, nil, "C", &models.Property{Name: "title"})
+		assert.ErrorContains(t, err, "property must contain dataType")
+	})
+}
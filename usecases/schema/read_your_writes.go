@@ -0,0 +1,41 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/weaviate/weaviate/entities/versioned"
+)
+
+// ReadYourWritesToken is returned by schema changes, see
+// [github.com/weaviate/weaviate/entities/versioned.ReadYourWritesToken]
+type ReadYourWritesToken = versioned.ReadYourWritesToken
+
+// AddClassResult is returned by Handler.AddClass
+type AddClassResult = versioned.AddClassResult
+
+// ReadAtToken blocks until this node has applied the schema change of token
+// or ctx is done. Reads made afterwards see the change, even if this node is
+// not the leader which applied it first.
+func (h *Handler) ReadAtToken(ctx context.Context, token ReadYourWritesToken) error {
+	if err := h.schemaReader.WaitForUpdate(ctx, uint64(token)); err != nil {
+		return fmt.Errorf("wait for consistency token %s: %w", token, err)
+	}
+	return nil
+}
+
+// ParseReadYourWritesToken parses a token formatted by its String method
+func ParseReadYourWritesToken(s string) (ReadYourWritesToken, error) {
+	return versioned.ParseReadYourWritesToken(s)
+}
@@ -0,0 +1,232 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
+	"github.com/weaviate/weaviate/usecases/sharding"
+)
+
+// JoinOptions are the optional settings of JoinNode
+type JoinOptions struct {
+	// AutoRebalance moves shard replicas from the other nodes to the joined
+	// node until all nodes hold about the same number of replicas
+	AutoRebalance bool
+}
+
+type RebalanceState string
+
+const (
+	RebalanceRunning  RebalanceState = "RUNNING"
+	RebalanceFinished RebalanceState = "FINISHED"
+	RebalanceFailed   RebalanceState = "FAILED"
+)
+
+// RebalanceStatus describes a rebalancing started by JoinNode
+type RebalanceStatus struct {
+	ID   string
+	Node string
+	// MoveIDs are the IDs of the shard moves of the rebalancing, they can be
+	// passed to ShardMoveStatus for the details of each move
+	MoveIDs    []string
+	Total      int
+	Finished   int
+	Failed     int
+	Status     RebalanceState
+	Error      string
+	StartedAt  time.Time
+	FinishedAt time.Time
+}
+
+func finishRebalance(job *RebalanceStatus) {
+	job.FinishedAt = time.Now().UTC()
+	job.Status = RebalanceFinished
+	if job.Failed > 0 {
+		job.Status = RebalanceFailed
+		job.Error = fmt.Sprintf("%d of %d shard moves failed", job.Failed, job.Total)
+	}
+}
+
+// rebalanceMove moves the replica of shard on fromNode to the joined node
+type rebalanceMove struct {
+	class, shard, fromNode string
+}
+
+// planRebalance returns the moves which give node about as many shard
+// replicas as each of the other nodes. The replicas are taken from the most
+// loaded nodes first. Only active shards are moved and a shard is never given
+// two replicas on node.
+func planRebalance(states map[string]*sharding.State, nodes []string, node string) []rebalanceMove {
+	load := make(map[string]int, len(nodes))
+	for _, n := range nodes {
+		load[n] = 0
+	}
+	// candidates are the shards each node could hand over, in a stable order
+	candidates := map[string][]rebalanceMove{}
+	total := 0
+
+	classes := make([]string, 0, len(states))
+	for class := range states {
+		classes = append(classes, class)
+	}
+	sort.Strings(classes)
+	for _, class := range classes {
+		shards := make([]string, 0, len(states[class].Physical))
+		for shard := range states[class].Physical {
+			shards = append(shards, shard)
+		}
+		sort.Strings(shards)
+		for _, shard := range shards {
+			physical := states[class].Physical[shard]
+			movable := physical.ActivityStatus() == models.TenantActivityStatusHOT &&
+				!slices.Contains(physical.BelongsToNodes, node)
+			for _, owner := range physical.BelongsToNodes {
+				if _, ok := load[owner]; !ok {
+					continue
+				}
+				load[owner]++
+				total++
+				if movable {
+					candidates[owner] = append(candidates[owner], rebalanceMove{class, shard, owner})
+				}
+			}
+		}
+	}
+	if len(nodes) == 0 {
+		return nil
+	}
+
+	want := total / len(nodes)
+	moved := map[[2]string]bool{}
+	var moves []rebalanceMove
+	for load[node] < want {
+		// the node with the most replicas that can still hand one over, ties
+		// are broken by name for a deterministic plan
+		from := ""
+		for _, n := range nodes {
+			if n == node || len(candidates[n]) == 0 {
+				continue
+			}
+			if from == "" || load[n] > load[from] || (load[n] == load[from] && n < from) {
+				from = n
+			}
+		}
+		if from == "" || load[from]-1 < load[node]+1 {
+			break
+		}
+
+		move := candidates[from][0]
+		candidates[from] = candidates[from][1:]
+		key := [2]string{move.class, move.shard}
+		if moved[key] {
+			continue
+		}
+		moved[key] = true
+		moves = append(moves, move)
+		load[from]--
+		load[node]++
+	}
+	return moves
+}
+
+// rebalance plans the moves to node and starts them in the background, at
+// most Schema.MaxConcurrentMoves at a time. It returns the job ID of the
+// rebalancing.
+//
+// The nodes are taken from the raft configuration, as the memberlist may not
+// know the joined node yet. The joined node is added if this node hasn't
+// applied the configuration change yet.
+func (h *Handler) rebalance(node string) string {
+	schema := h.schemaReader.ReadOnlySchema()
+	states := make(map[string]*sharding.State, len(schema.Classes))
+	for _, class := range schema.Classes {
+		if state := h.schemaReader.CopyShardingState(class.Class); state != nil {
+			states[class.Class] = state
+		}
+	}
+	nodes := h.schemaManager.StorageCandidates()
+	if !slices.Contains(nodes, node) {
+		nodes = append(slices.Clone(nodes), node)
+	}
+	moves := planRebalance(states, nodes, node)
+
+	job := RebalanceStatus{
+		ID:        uuid.NewString(),
+		Node:      node,
+		Total:     len(moves),
+		Status:    RebalanceRunning,
+		StartedAt: time.Now().UTC(),
+	}
+	h.rebalances.Add(job.ID, job)
+	concurrency := h.config.Schema.MaxConcurrentMoves
+	if concurrency < 1 {
+		concurrency = 1
+	}
+	enterrors.GoWrapper(func() {
+		eg := enterrors.NewErrorGroupWrapper(h.logger)
+		eg.SetLimit(concurrency)
+		for _, move := range moves {
+			eg.Go(func() error {
+				moveJob := newMoveStatus(move.class, move.shard, move.fromNode, node)
+				h.shardMoves.Add(moveJob.ID, moveJob)
+				h.rebalances.Update(job.ID, func(job *RebalanceStatus) {
+					job.MoveIDs = append(job.MoveIDs, moveJob.ID)
+				})
+				err := h.moveShard(context.Background(), nil, moveJob)
+				if err != nil {
+					h.logEntry(context.Background(), move.class, "").WithField("action", "rebalance").
+						WithField("shard", move.shard).
+						WithField("job", job.ID).
+						WithError(err).Error("moving shard failed")
+				}
+				h.shardMoves.Finish(moveJob.ID, finishShardMove(err))
+				h.rebalances.Update(job.ID, func(job *RebalanceStatus) {
+					job.Finished++
+					if err != nil {
+						job.Failed++
+					}
+				})
+				// a failed move must not stop the others
+				return nil
+			})
+		}
+		eg.Wait()
+		h.rebalances.Finish(job.ID, finishRebalance)
+	}, h.logger)
+
+	return job.ID
+}
+
+// RebalanceStatus returns the rebalancing jobID started by JoinNode
+func (h *Handler) RebalanceStatus(ctx context.Context, principal *models.Principal,
+	jobID string,
+) (RebalanceStatus, error) {
+	if err := h.Authorizer.Authorize(principal, authorization.READ, authorization.Cluster()); err != nil {
+		return RebalanceStatus{}, err
+	}
+
+	job, ok := h.rebalances.Get(jobID)
+	if !ok {
+		return RebalanceStatus{}, fmt.Errorf("rebalance %q: %w", jobID, ErrNotFound)
+	}
+	job.MoveIDs = slices.Clone(job.MoveIDs)
+	return job, nil
+}
@@ -0,0 +1,200 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/fakes"
+	"github.com/weaviate/weaviate/usecases/sharding"
+)
+
+func shardsOn(shards map[string][]string) *sharding.State {
+	state := &sharding.State{Physical: map[string]sharding.Physical{}}
+	for name, nodes := range shards {
+		state.Physical[name] = sharding.Physical{Name: name, BelongsToNodes: nodes}
+	}
+	return state
+}
+
+func TestPlanRebalance(t *testing.T) {
+	nodes := []string{"node-1", "node-2", "node-3"}
+
+	t.Run("moves replicas from the most loaded nodes", func(t *testing.T) {
+		states := map[string]*sharding.State{
+			"A": shardsOn(map[string][]string{
+				"S1": {"node-1"}, "S2": {"node-1"}, "S3": {"node-1"}, "S4": {"node-1"},
+				"S5": {"node-2"}, "S6": {"node-2"},
+			}),
+		}
+		moves := planRebalance(states, nodes, "node-3")
+		assert.Equal(t, []rebalanceMove{
+			{"A", "S1", "node-1"},
+			{"A", "S2", "node-1"},
+		}, moves)
+	})
+
+	t.Run("a shard gets at most one replica on the node", func(t *testing.T) {
+		states := map[string]*sharding.State{
+			"A": shardsOn(map[string][]string{"S1": {"node-1", "node-2"}, "S2": {"node-1", "node-2"}}),
+		}
+		moves := planRebalance(states, nodes, "node-3")
+		assert.Equal(t, []rebalanceMove{{"A", "S1", "node-1"}}, moves)
+	})
+
+	t.Run("inactive tenants aren't moved", func(t *testing.T) {
+		states := map[string]*sharding.State{"A": {Physical: map[string]sharding.Physical{
+			"T1": {BelongsToNodes: []string{"node-1"}, Status: models.TenantActivityStatusCOLD},
+			"T2": {BelongsToNodes: []string{"node-1"}, Status: models.TenantActivityStatusCOLD},
+			"T3": {BelongsToNodes: []string{"node-1"}, Status: models.TenantActivityStatusCOLD},
+		}}}
+		assert.Empty(t, planRebalance(states, nodes, "node-3"))
+	})
+
+	t.Run("balanced cluster", func(t *testing.T) {
+		states := map[string]*sharding.State{
+			"A": shardsOn(map[string][]string{"S1": {"node-1"}, "S2": {"node-2"}, "S3": {"node-3"}}),
+		}
+		assert.Empty(t, planRebalance(states, nodes, "node-3"))
+	})
+}
+
+// joinableClusterState resolves the hostname of every node
+type joinableClusterState struct {
+	*fakes.FakeClusterState
+}
+
+func (joinableClusterState) NodeHostname(node string) (string, bool) {
+	return node + ":7946", true
+}
+
+// concurrencyTracker records the highest number of concurrent CopyShard calls
+type concurrencyTracker struct {
+	sync.Mutex
+	running, max int
+}
+
+func (c *concurrencyTracker) enter() {
+	c.Lock()
+	defer c.Unlock()
+	c.running++
+	if c.running > c.max {
+		c.max = c.running
+	}
+}
+
+func (c *concurrencyTracker) leave() {
+	c.Lock()
+	defer c.Unlock()
+	c.running--
+}
+
+func TestHandler_JoinNodeRebalance(t *testing.T) {
+	ctx := context.Background()
+
+	newHandler := func(t *testing.T) (*Handler, *fakeSchemaManager, *fakeScaleOutManager) {
+		handler, fakeSchemaManager := newTestHandler(t, &fakeDB{})
+		// neither the memberlist nor the raft configuration of this node
+		// may know the joined node yet
+		handler.clusterState = joinableClusterState{fakes.NewFakeClusterState("node-1", "node-2")}
+		fakeSchemaManager.storageCandidates = []string{"node-1", "node-2"}
+		scaleOut := &fakeScaleOutManager{}
+		handler.scaleOut = scaleOut
+		handler.config.Schema.MaxConcurrentMoves = 2
+		fakeSchemaManager.On("Join", mock.Anything, "node-3", "node-3:8300", true).Return(nil)
+		fakeSchemaManager.On("ReadOnlySchema").Return(models.Schema{Classes: []*models.Class{{Class: "A"}}})
+		fakeSchemaManager.On("CopyShardingState", "A").Return(shardsOn(map[string][]string{
+			"S1": {"node-1"}, "S2": {"node-1"}, "S3": {"node-1"}, "S4": {"node-1"}, "S5": {"node-1"},
+			"S6": {"node-2"}, "S7": {"node-2"}, "S8": {"node-2"}, "S9": {"node-2"},
+		}))
+		fakeSchemaManager.On("UpdateShardStatus", "A", mock.Anything, mock.Anything).Return(nil)
+		return handler, fakeSchemaManager, scaleOut
+	}
+	waitForJob := func(t *testing.T, handler *Handler, id string) RebalanceStatus {
+		var job RebalanceStatus
+		require.Eventually(t, func() bool {
+			var err error
+			job, err = handler.RebalanceStatus(ctx, nil, id)
+			require.Nil(t, err)
+			return job.Status != RebalanceRunning
+		}, 5*time.Second, 10*time.Millisecond)
+		return job
+	}
+
+	t.Run("without rebalancing", func(t *testing.T) {
+		handler, fakeSchemaManager, scaleOut := newHandler(t)
+		id, err := handler.JoinNode(ctx, "node-3", "", true, JoinOptions{})
+		require.Nil(t, err)
+		assert.Empty(t, id)
+		fakeSchemaManager.AssertNotCalled(t, "ReadOnlySchema")
+		scaleOut.AssertNotCalled(t, "CopyShard", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("moves shards respecting the concurrency limit", func(t *testing.T) {
+		handler, fakeSchemaManager, scaleOut := newHandler(t)
+		var tracker concurrencyTracker
+		scaleOut.On("CopyShard", "A", mock.Anything, mock.Anything, "node-3").Return(nil).Run(func(mock.Arguments) {
+			tracker.enter()
+			defer tracker.leave()
+			time.Sleep(20 * time.Millisecond)
+		})
+		fakeSchemaManager.On("MoveShard", "A", mock.Anything, mock.Anything, "node-3").Return(nil)
+
+		id, err := handler.JoinNode(ctx, "node-3", "", true, JoinOptions{AutoRebalance: true})
+		require.Nil(t, err)
+		require.NotEmpty(t, id)
+
+		job := waitForJob(t, handler, id)
+		assert.Equal(t, RebalanceFinished, job.Status)
+		assert.Equal(t, "node-3", job.Node)
+		assert.Equal(t, 3, job.Total)
+		assert.Equal(t, 3, job.Finished)
+		assert.Len(t, job.MoveIDs, 3)
+		assert.LessOrEqual(t, tracker.max, 2)
+		for _, moveID := range job.MoveIDs {
+			move, err := handler.ShardMoveStatus(ctx, nil, moveID)
+			require.Nil(t, err)
+			assert.Equal(t, ShardMoveFinished, move.Status)
+		}
+		fakeSchemaManager.AssertNumberOfCalls(t, "MoveShard", 3)
+	})
+
+	t.Run("a failed move doesn't stop the others", func(t *testing.T) {
+		handler, fakeSchemaManager, scaleOut := newHandler(t)
+		scaleOut.On("CopyShard", "A", "S1", "node-1", "node-3").Return(errors.New("disk full"))
+		scaleOut.On("CopyShard", "A", mock.Anything, mock.Anything, "node-3").Return(nil)
+		fakeSchemaManager.On("MoveShard", "A", mock.Anything, mock.Anything, "node-3").Return(nil)
+
+		id, err := handler.JoinNode(ctx, "node-3", "", true, JoinOptions{AutoRebalance: true})
+		require.Nil(t, err)
+		job := waitForJob(t, handler, id)
+		assert.Equal(t, RebalanceFailed, job.Status)
+		assert.Equal(t, 3, job.Finished)
+		assert.Equal(t, 1, job.Failed)
+		assert.Equal(t, "1 of 3 shard moves failed", job.Error)
+		fakeSchemaManager.AssertNumberOfCalls(t, "MoveShard", 2)
+	})
+
+	t.Run("unknown job", func(t *testing.T) {
+		handler, _, _ := newHandler(t)
+		_, err := handler.RebalanceStatus(ctx, nil, "unknown")
+		assert.ErrorIs(t, err, ErrNotFound)
+	})
+}
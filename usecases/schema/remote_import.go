@@ -0,0 +1,147 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
+)
+
+const (
+	// defaultRemoteTimeout limits the request to the remote instance if
+	// RemoteImportOptions.RemoteTimeout is not set
+	defaultRemoteTimeout = 30 * time.Second
+	// maxRemoteClassSize limits the response of the remote instance which
+	// is read into memory
+	maxRemoteClassSize = 10 << 20
+	// maxRemoteErrorBodySize limits how much of an error response of the
+	// remote instance is included in the returned error
+	maxRemoteErrorBodySize = 512
+)
+
+// RemoteImportOptions control the request ImportClassFromRemote sends to the
+// remote instance
+type RemoteImportOptions struct {
+	// SkipTLSVerify accepts any certificate of the remote instance
+	SkipTLSVerify bool
+	// RemoteTimeout limits the request to the remote instance, 30s if unset
+	RemoteTimeout time.Duration
+}
+
+// ImportClassFromRemote adds targetClass with the definition of sourceClass
+// of the Weaviate instance at remoteURL. Only the definition is copied, no
+// objects. The API key is sent as bearer token if set. Sharding counts are
+// derived again for this cluster, references of the class to itself point
+// to the imported class and the ACL of the remote class is dropped.
+func (h *Handler) ImportClassFromRemote(ctx context.Context, principal *models.Principal,
+	remoteURL, apiKey, sourceClass, targetClass string, opts RemoteImportOptions,
+) (*models.Class, error) {
+	targetClass = schema.UppercaseClassName(targetClass)
+	err := h.Authorizer.Authorize(principal, authorization.CREATE, authorization.CollectionsMetadata(targetClass)...)
+	if err != nil {
+		return nil, err
+	}
+
+	remote, err := fetchRemoteClass(ctx, remoteURL, apiKey, schema.UppercaseClassName(sourceClass), opts)
+	if err != nil {
+		return nil, err
+	}
+	class, err := cloneClass(remote, targetClass, &ClassCloneOverrides{RewriteSelfReferences: true})
+	if err != nil {
+		return nil, fmt.Errorf("copy remote class %q: %w", remote.Class, err)
+	}
+	class.ACL = nil
+
+	res, err := h.AddClass(ctx, principal, class)
+	if err != nil {
+		return nil, err
+	}
+	return res.Class, nil
+}
+
+// fetchRemoteClass gets class from the REST schema endpoint of the instance
+// at remoteURL
+func fetchRemoteClass(ctx context.Context, remoteURL, apiKey, class string,
+	opts RemoteImportOptions,
+) (*models.Class, error) {
+	base, err := url.Parse(remoteURL)
+	if err != nil || base.Scheme == "" || base.Host == "" {
+		return nil, fmt.Errorf("invalid remote url %q", remoteURL)
+	}
+	endpoint := base.JoinPath("v1", "schema", class)
+
+	timeout := opts.RemoteTimeout
+	if timeout <= 0 {
+		timeout = defaultRemoteTimeout
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint.String(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("create remote request: %w", err)
+	}
+	req.Header.Set("Accept", "application/json")
+	if apiKey != "" {
+		req.Header.Set("Authorization", "Bearer "+apiKey)
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	if opts.SkipTLSVerify {
+		transport.TLSClientConfig = &tls.Config{InsecureSkipVerify: true}
+	}
+	client := &http.Client{Transport: transport}
+	defer client.CloseIdleConnections()
+
+	res, err := client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("get remote class %q: %w", class, err)
+	}
+	defer res.Body.Close()
+
+	body, err := io.ReadAll(io.LimitReader(res.Body, maxRemoteClassSize+1))
+	if err != nil {
+		return nil, fmt.Errorf("read remote class %q: %w", class, err)
+	}
+	switch res.StatusCode {
+	case http.StatusOK:
+		if len(body) > maxRemoteClassSize {
+			return nil, fmt.Errorf("remote class %q: response exceeds %d bytes", class, maxRemoteClassSize)
+		}
+	case http.StatusNotFound:
+		return nil, fmt.Errorf("remote class %q: %w", class, ErrNotFound)
+	default:
+		msg := strings.TrimSpace(string(body))
+		if len(msg) > maxRemoteErrorBodySize {
+			msg = msg[:maxRemoteErrorBodySize] + "..."
+		}
+		return nil, fmt.Errorf("get remote class %q: unexpected status %d: %s",
+			class, res.StatusCode, msg)
+	}
+
+	remote := &models.Class{}
+	if err := json.Unmarshal(body, remote); err != nil {
+		return nil, fmt.Errorf("decode remote class %q: %w", class, err)
+	}
+	return remote, nil
+}
@@ -0,0 +1,125 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+	shardingConfig "github.com/weaviate/weaviate/usecases/sharding/config"
+)
+
+func TestHandler_ImportClassFromRemote(t *testing.T) {
+	ctx := context.Background()
+	remote := &models.Class{
+		Class: "Article",
+		Properties: []*models.Property{
+			{Name: "title", DataType: []string{"text"}},
+			{Name: "related", DataType: []string{"Article"}},
+		},
+		Vectorizer:        "none",
+		ReplicationConfig: &models.ReplicationConfig{Factor: 1},
+		ShardingConfig: map[string]interface{}{
+			"desiredCount": float64(1), "actualCount": float64(3), "actualVirtualCount": float64(384),
+		},
+		ACL: []*models.ACLEntry{{Principal: "remote-user", Actions: []string{ACLActionRead}}},
+	}
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Header.Get("Authorization") != "Bearer key":
+			w.WriteHeader(http.StatusUnauthorized)
+		case r.URL.Path == "/v1/schema/Article":
+			json.NewEncoder(w).Encode(remote)
+		case r.URL.Path == "/v1/schema/Slow":
+			time.Sleep(100 * time.Millisecond)
+		case r.URL.Path == "/v1/schema/Huge":
+			w.Write(bytes.Repeat([]byte(" "), maxRemoteClassSize+1))
+		case r.URL.Path == "/v1/schema/Failing":
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write(bytes.Repeat([]byte("x"), 2*maxRemoteErrorBodySize))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}
+	newHandler := func(t *testing.T) (*Handler, **models.Class) {
+		h, fakeSchemaManager := newTestHandler(t, &fakeDB{})
+		added := new(*models.Class)
+		fakeSchemaManager.On("ReadOnlyClass", mock.Anything).Return(nil).Maybe()
+		fakeSchemaManager.On("AddClass", mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+			*added = args.Get(0).(*models.Class)
+		}).Maybe()
+		return h, added
+	}
+
+	srv := httptest.NewServer(http.HandlerFunc(handler))
+	defer srv.Close()
+	tlsSrv := httptest.NewTLSServer(http.HandlerFunc(handler))
+	defer tlsSrv.Close()
+
+	t.Run("import", func(t *testing.T) {
+		h, added := newHandler(t)
+		class, err := h.ImportClassFromRemote(ctx, nil, srv.URL, "key", "article", "copiedArticle", RemoteImportOptions{})
+		require.Nil(t, err)
+		require.NotNil(t, *added)
+		assert.Equal(t, "CopiedArticle", class.Class)
+		assert.Equal(t, []string{"CopiedArticle"}, class.Properties[1].DataType)
+		assert.Nil(t, class.ACL)
+		// the sharding counts are derived for this cluster
+		assert.Equal(t, 1, class.ShardingConfig.(shardingConfig.Config).ActualCount)
+	})
+
+	t.Run("TLS certificates are verified", func(t *testing.T) {
+		h, added := newHandler(t)
+		_, err := h.ImportClassFromRemote(ctx, nil, tlsSrv.URL, "key", "Article", "Copy", RemoteImportOptions{})
+		assert.ErrorContains(t, err, "certificate")
+		assert.Nil(t, *added)
+
+		_, err = h.ImportClassFromRemote(ctx, nil, tlsSrv.URL, "key", "Article", "Copy",
+			RemoteImportOptions{SkipTLSVerify: true})
+		require.Nil(t, err)
+		assert.Equal(t, "Copy", (*added).Class)
+	})
+
+	t.Run("remote errors", func(t *testing.T) {
+		h, added := newHandler(t)
+		_, err := h.ImportClassFromRemote(ctx, nil, srv.URL, "key", "Missing", "Copy", RemoteImportOptions{})
+		assert.ErrorIs(t, err, ErrNotFound)
+
+		_, err = h.ImportClassFromRemote(ctx, nil, srv.URL, "wrong", "Article", "Copy", RemoteImportOptions{})
+		assert.ErrorContains(t, err, "unexpected status 401")
+
+		_, err = h.ImportClassFromRemote(ctx, nil, srv.URL, "key", "Slow", "Copy",
+			RemoteImportOptions{RemoteTimeout: 10 * time.Millisecond})
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+
+		_, err = h.ImportClassFromRemote(ctx, nil, srv.URL, "key", "Huge", "Copy", RemoteImportOptions{})
+		assert.ErrorContains(t, err, "response exceeds")
+
+		// the body of error responses is truncated
+		_, err = h.ImportClassFromRemote(ctx, nil, srv.URL, "key", "Failing", "Copy", RemoteImportOptions{})
+		assert.ErrorContains(t, err, "unexpected status 500")
+		assert.Less(t, len(err.Error()), maxRemoteErrorBodySize+100)
+
+		_, err = h.ImportClassFromRemote(ctx, nil, "not a url", "key", "Article", "Copy", RemoteImportOptions{})
+		assert.ErrorContains(t, err, "invalid remote url")
+		assert.Nil(t, *added)
+	})
+}
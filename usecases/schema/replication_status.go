@@ -0,0 +1,157 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
+)
+
+// ErrNoReplicationConfigured is returned by GetClassReplicationStatus for
+// classes with a replication factor of 1
+var ErrNoReplicationConfigured = errors.New("no replication configured")
+
+// replicationChecker reports the schema log index applied by a node, as last
+// gossiped by it
+type replicationChecker interface {
+	AppliedIndex(node string) (uint64, bool)
+}
+
+// ShardReplicationStatus tells which replicas of a shard have applied the
+// latest schema change of its class
+type ShardReplicationStatus struct {
+	Shard          string
+	Replicas       []string
+	SyncedReplicas []string
+	// LaggedReplicas haven't applied the latest change yet, or their applied
+	// index is unknown
+	LaggedReplicas []string
+	IsHealthy      bool
+}
+
+// appliedIndexPollInterval is how often waitForAllNodes checks the applied
+// indexes of the nodes
+var appliedIndexPollInterval = 100 * time.Millisecond
+
+// WithReplicationChecker makes GetClassReplicationStatus check the replicas
+// against the applied indexes reported by c
+func (h *Handler) WithReplicationChecker(c replicationChecker) {
+	h.replicationChecker = c
+}
+
+// GetClassReplicationStatus returns the replication status of every shard of
+// class, ordered by shard name. A replica is synced if its node has applied
+// the version of the class.
+func (h *Handler) GetClassReplicationStatus(ctx context.Context, principal *models.Principal,
+	class string,
+) ([]ShardReplicationStatus, error) {
+	class = schema.UppercaseClassName(class)
+	if err := h.Authorizer.Authorize(principal, authorization.READ, authorization.CollectionsMetadata(class)...); err != nil {
+		return nil, err
+	}
+	return h.classReplicationStatus(ctx, class)
+}
+
+// classReplicationStatus is GetClassReplicationStatus without authorization
+func (h *Handler) classReplicationStatus(ctx context.Context, class string) ([]ShardReplicationStatus, error) {
+	info := h.schemaReader.ClassInfo(class)
+	if !info.Exists {
+		return nil, fmt.Errorf("class %q: %w", class, ErrNotFound)
+	}
+	if info.ReplicationFactor <= 1 {
+		return nil, fmt.Errorf("class %q: %w", class, ErrNoReplicationConfigured)
+	}
+	if h.replicationChecker == nil {
+		return nil, fmt.Errorf("replication status of class %q: applied indexes not available", class)
+	}
+
+	state := h.schemaReader.CopyShardingState(class)
+	if state == nil {
+		return nil, fmt.Errorf("class %q: %w", class, ErrNotFound)
+	}
+	shards := make([]string, 0, len(state.Physical))
+	for shard := range state.Physical {
+		shards = append(shards, shard)
+	}
+	sort.Strings(shards)
+
+	version := info.Version()
+	statuses := make([]ShardReplicationStatus, 0, len(shards))
+	for _, shard := range shards {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		replicas, err := h.schemaReader.ShardReplicas(class, shard)
+		if err != nil {
+			return nil, fmt.Errorf("replicas of shard %q: %w", shard, err)
+		}
+
+		status := ShardReplicationStatus{
+			Shard:          shard,
+			Replicas:       replicas,
+			SyncedReplicas: []string{},
+			LaggedReplicas: []string{},
+		}
+		for _, node := range replicas {
+			if idx, ok := h.replicationChecker.AppliedIndex(node); ok && idx >= version {
+				status.SyncedReplicas = append(status.SyncedReplicas, node)
+			} else {
+				status.LaggedReplicas = append(status.LaggedReplicas, node)
+			}
+		}
+		status.IsHealthy = len(replicas) > 0 && len(status.LaggedReplicas) == 0
+		statuses = append(statuses, status)
+	}
+	return statuses, nil
+}
+
+// waitForAllNodes blocks until every node of the cluster has applied the
+// schema log up to version. Without a replication checker only this node is
+// waited for.
+func (h *Handler) waitForAllNodes(ctx context.Context, version uint64, timeout time.Duration) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	if err := h.schemaReader.WaitForUpdate(ctx, version); err != nil {
+		return fmt.Errorf("wait for schema version %d: %w", version, err)
+	}
+	if h.replicationChecker == nil {
+		return nil
+	}
+
+	ticker := time.NewTicker(appliedIndexPollInterval)
+	defer ticker.Stop()
+	for {
+		var lagging []string
+		for _, node := range h.clusterState.AllNames() {
+			if idx, ok := h.replicationChecker.AppliedIndex(node); !ok || idx < version {
+				lagging = append(lagging, node)
+			}
+		}
+		if len(lagging) == 0 {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("wait for nodes %v to apply schema version %d: %w", lagging, version, ctx.Err())
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,81 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	clusterSchema "github.com/weaviate/weaviate/cluster/schema"
+)
+
+type fakeReplicationChecker map[string]uint64
+
+func (f fakeReplicationChecker) AppliedIndex(node string) (uint64, bool) {
+	idx, ok := f[node]
+	return idx, ok
+}
+
+func TestHandler_GetClassReplicationStatus(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("synced and lagged replicas", func(t *testing.T) {
+		handler, fakeSchemaManager := newTestHandler(t, &fakeDB{})
+		handler.WithReplicationChecker(fakeReplicationChecker{"node-1": 7, "node-2": 5})
+		fakeSchemaManager.On("ClassInfo", "C").Return(clusterSchema.ClassInfo{
+			Exists: true, ReplicationFactor: 2, ClassVersion: 6, ShardVersion: 4,
+		})
+		fakeSchemaManager.On("CopyShardingState", "C").Return(shardsOn(map[string][]string{
+			"S2": {"node-1", "node-2"}, "S1": {"node-1", "node-3"}, "S3": {"node-1"},
+		}))
+		fakeSchemaManager.On("ShardReplicas", "C", "S1").Return([]string{"node-1", "node-3"}, nil)
+		fakeSchemaManager.On("ShardReplicas", "C", "S2").Return([]string{"node-1", "node-2"}, nil)
+		fakeSchemaManager.On("ShardReplicas", "C", "S3").Return([]string{"node-1"}, nil)
+
+		statuses, err := handler.GetClassReplicationStatus(ctx, nil, "c")
+		require.Nil(t, err)
+		assert.Equal(t, []ShardReplicationStatus{
+			{
+				Shard: "S1", Replicas: []string{"node-1", "node-3"},
+				SyncedReplicas: []string{"node-1"}, LaggedReplicas: []string{"node-3"},
+			},
+			{
+				Shard: "S2", Replicas: []string{"node-1", "node-2"},
+				SyncedReplicas: []string{"node-1"}, LaggedReplicas: []string{"node-2"},
+			},
+			{
+				Shard: "S3", Replicas: []string{"node-1"},
+				SyncedReplicas: []string{"node-1"}, LaggedReplicas: []string{}, IsHealthy: true,
+			},
+		}, statuses)
+	})
+
+	t.Run("no replication configured", func(t *testing.T) {
+		handler, fakeSchemaManager := newTestHandler(t, &fakeDB{})
+		handler.WithReplicationChecker(fakeReplicationChecker{})
+		fakeSchemaManager.On("ClassInfo", "C").Return(clusterSchema.ClassInfo{Exists: true, ReplicationFactor: 1})
+
+		_, err := handler.GetClassReplicationStatus(ctx, nil, "C")
+		assert.ErrorIs(t, err, ErrNoReplicationConfigured)
+	})
+
+	t.Run("unknown class", func(t *testing.T) {
+		handler, fakeSchemaManager := newTestHandler(t, &fakeDB{})
+		handler.WithReplicationChecker(fakeReplicationChecker{})
+		fakeSchemaManager.On("ClassInfo", "C").Return(clusterSchema.ClassInfo{})
+
+		_, err := handler.GetClassReplicationStatus(ctx, nil, "C")
+		assert.ErrorIs(t, err, ErrNotFound)
+	})
+}
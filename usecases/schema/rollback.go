@@ -0,0 +1,180 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	clusterSchema "github.com/weaviate/weaviate/cluster/schema"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
+)
+
+// MaxRollbackDepth is the maximum number of schema versions RollbackToVersion
+// can undo at once, the changelog only keeps the previous classes of as many
+// changes
+const MaxRollbackDepth = clusterSchema.MaxChangelogPrevious
+
+// ErrRollbackBlocked is returned by RollbackToVersion if a schema change
+// cannot be undone
+var ErrRollbackBlocked = errors.New("rollback blocked")
+
+// RollbackToVersion undoes the schema changes applied after targetVersion,
+// newest first. An added class is deleted and a class update is undone by
+// re-applying the configuration the class had before the update.
+//
+// All changes are checked before the first one is undone. ErrRollbackBlocked
+// is returned, leaving the schema untouched, if any of them cannot be undone:
+//   - added properties, as deleting properties is not supported
+//   - added classes that hold objects, as deleting them would lose data
+//   - added classes that are referenced by a class which is kept
+//   - any other change, e.g. deleted classes or tenant changes
+//
+// The changes are undone in a single transaction, so either all of them or
+// none are. Undoing a change is a schema change itself, the schema version
+// therefore keeps increasing instead of being reset to targetVersion.
+func (h *Handler) RollbackToVersion(ctx context.Context, principal *models.Principal, targetVersion uint64) error {
+	if err := h.Authorizer.Authorize(principal, authorization.UPDATE, authorization.Cluster()); err != nil {
+		return err
+	}
+
+	current := h.schemaReader.SchemaVersion()
+	if targetVersion > current {
+		return fmt.Errorf("%w: target version %d is newer than the current version %d",
+			clusterSchema.ErrBadRequest, targetVersion, current)
+	}
+	if current-targetVersion > MaxRollbackDepth {
+		return fmt.Errorf("%w: cannot roll back more than %d versions, current version is %d",
+			clusterSchema.ErrBadRequest, MaxRollbackDepth, current)
+	}
+	entries := h.schemaReader.SchemaChangelog(targetVersion, 0)
+	if len(entries) == 0 {
+		return nil
+	}
+	if entries[0].Version != targetVersion+1 {
+		return fmt.Errorf("%w: the changelog no longer holds the changes after version %d",
+			ErrRollbackBlocked, targetVersion)
+	}
+
+	if err := h.checkRollback(ctx, entries); err != nil {
+		return err
+	}
+	return h.WithTransaction(ctx, principal, func(th TxnHandler) error {
+		for i := len(entries) - 1; i >= 0; i-- {
+			if err := th.rollbackChange(ctx, principal, entries[i]); err != nil {
+				return fmt.Errorf("roll back version %d: %w", entries[i].Version, err)
+			}
+		}
+		return nil
+	})
+}
+
+// checkRollback returns ErrRollbackBlocked if any of entries cannot be undone
+func (h *Handler) checkRollback(ctx context.Context, entries []SchemaChangeEntry) error {
+	deleted := map[string]bool{}
+	for _, e := range entries {
+		if e.Operation == "ADD_CLASS" {
+			deleted[e.Class] = true
+		}
+	}
+
+	for i := len(entries) - 1; i >= 0; i-- {
+		e := entries[i]
+		switch e.Operation {
+		case "ADD_CLASS":
+			if other := h.referencingClass(e.Class, deleted); other != "" {
+				return fmt.Errorf("%w: version %d: class %q is referenced by class %q",
+					ErrRollbackBlocked, e.Version, e.Class, other)
+			}
+			if err := h.checkClassEmpty(ctx, e); err != nil {
+				return err
+			}
+		case "UPDATE_CLASS", "UPDATE_VECTOR_INDEX_CONFIG":
+			if e.Previous == nil {
+				return fmt.Errorf("%w: version %d: previous configuration of class %q is unknown",
+					ErrRollbackBlocked, e.Version, e.Class)
+			}
+		case "ADD_PROPERTY":
+			return fmt.Errorf("%w: version %d: properties %v of class %q cannot be deleted",
+				ErrRollbackBlocked, e.Version, e.Properties, e.Class)
+		default:
+			return fmt.Errorf("%w: version %d: %s of class %q cannot be undone",
+				ErrRollbackBlocked, e.Version, e.Operation, e.Class)
+		}
+	}
+	return nil
+}
+
+// checkClassEmpty returns ErrRollbackBlocked if the class added by e still
+// holds objects on any replica
+func (h *Handler) checkClassEmpty(ctx context.Context, e SchemaChangeEntry) error {
+	if h.schemaReader.ReadOnlyClass(e.Class) == nil {
+		return nil
+	}
+	counts, err := h.dataMigrator.ReplicaObjectCounts(ctx, e.Class)
+	if err != nil {
+		return fmt.Errorf("%w: version %d: count objects of class %q: %w",
+			ErrRollbackBlocked, e.Version, e.Class, err)
+	}
+	for shard, nodes := range counts {
+		for node, count := range nodes {
+			if count > 0 {
+				return fmt.Errorf("%w: version %d: class %q has %d objects in shard %q on node %q",
+					ErrRollbackBlocked, e.Version, e.Class, count, shard, node)
+			}
+		}
+	}
+	return nil
+}
+
+// referencingClass returns a class other than class and the ones in ignored
+// that has a reference property to class, empty if there is none
+func (h *Handler) referencingClass(class string, ignored map[string]bool) string {
+	for _, c := range h.schemaReader.ReadOnlySchema().Classes {
+		if c.Class == class || ignored[c.Class] {
+			continue
+		}
+		for _, p := range c.Properties {
+			for _, dt := range p.DataType {
+				if dt == class {
+					return c.Class
+				}
+			}
+		}
+	}
+	return ""
+}
+
+func (h *Handler) rollbackChange(ctx context.Context, principal *models.Principal, e SchemaChangeEntry) error {
+	switch e.Operation {
+	case "ADD_CLASS":
+		_, err := h.schemaManager.DeleteClass(withActor(ctx, principal), e.Class)
+		return err
+	case "UPDATE_CLASS", "UPDATE_VECTOR_INDEX_CONFIG":
+		// updateClass sets defaults on the class it is passed, which must not
+		// change the entry of the changelog
+		raw, err := json.Marshal(e.Previous)
+		if err != nil {
+			return fmt.Errorf("marshal previous class: %w", err)
+		}
+		previous := &models.Class{}
+		if err := json.Unmarshal(raw, previous); err != nil {
+			return fmt.Errorf("unmarshal previous class: %w", err)
+		}
+		return h.updateClass(ctx, principal, e.Class, previous)
+	default:
+		return fmt.Errorf("%w: %s cannot be undone", ErrRollbackBlocked, e.Operation)
+	}
+}
@@ -0,0 +1,154 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	clusterSchema "github.com/weaviate/weaviate/cluster/schema"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+func TestHandler_RollbackToVersion(t *testing.T) {
+	ctx := context.Background()
+	current := &models.Class{
+		Class: "C1", Vectorizer: "none", Description: "new",
+		ReplicationConfig: &models.ReplicationConfig{Factor: 1},
+	}
+	previous := &models.Class{
+		Class: "C1", Vectorizer: "none", Description: "old",
+		ReplicationConfig: &models.ReplicationConfig{Factor: 1},
+	}
+	entries := []SchemaChangeEntry{
+		{Version: 4, Operation: "UPDATE_CLASS", Class: "C1", Previous: previous},
+		{Version: 5, Operation: "ADD_CLASS", Class: "C2"},
+	}
+
+	newHandler := func(t *testing.T, version uint64, entries []SchemaChangeEntry, classes ...*models.Class) (*Handler, *fakeSchemaManager) {
+		handler, fakeSchemaManager := newTestHandler(t, &fakeDB{})
+		fakeSchemaManager.On("SchemaVersion").Return(version)
+		fakeSchemaManager.On("SchemaChangelog", uint64(3), 0).Return(entries)
+		fakeSchemaManager.On("ReadOnlySchema").Return(models.Schema{Classes: classes})
+		for _, c := range classes {
+			fakeSchemaManager.On("ReadOnlyClass", c.Class).Return(c).Maybe()
+			fakeSchemaManager.On("ReplicaObjectCounts", mock.Anything, c.Class).
+				Return(map[string]map[string]int64{"shard": {"node1": 0}}, nil).Maybe()
+		}
+		return handler, fakeSchemaManager
+	}
+
+	t.Run("undoes changes newest first", func(t *testing.T) {
+		// C2 references itself and is deleted alongside C3
+		c2 := &models.Class{Class: "C2", Properties: []*models.Property{{Name: "self", DataType: []string{"C2"}}}}
+		c3 := &models.Class{Class: "C3", Properties: []*models.Property{{Name: "ref", DataType: []string{"C2"}}}}
+		handler, fakeSchemaManager := newHandler(t, 6, append(entries,
+			SchemaChangeEntry{Version: 6, Operation: "ADD_CLASS", Class: "C3"}), current, c2, c3)
+		txn := &fakeTxn{}
+		fakeSchemaManager.On("Begin").Return(txn, nil)
+
+		var calls []string
+		record := func(call string) func(mock.Arguments) {
+			return func(mock.Arguments) { calls = append(calls, call) }
+		}
+		txn.On("DeleteClass", "C3").Return(nil).Run(record("delete C3")).Once()
+		txn.On("DeleteClass", "C2").Return(nil).Run(record("delete C2")).Once()
+		txn.On("UpdateClass", mock.MatchedBy(func(c *models.Class) bool {
+			return c.Class == "C1" && c.Description == "old"
+		}), mock.Anything).Return(nil).Run(record("update C1")).Once()
+		txn.On("Commit").Return(nil).Run(record("commit")).Once()
+
+		require.Nil(t, handler.RollbackToVersion(ctx, nil, 3))
+		assert.Equal(t, []string{"delete C3", "delete C2", "update C1", "commit"}, calls)
+		txn.AssertExpectations(t)
+		// the defaults set by the update don't change the changelog
+		assert.Nil(t, previous.VectorIndexConfig)
+		assert.Empty(t, previous.VectorIndexType)
+	})
+
+	t.Run("failed change rolls back all of them", func(t *testing.T) {
+		handler, fakeSchemaManager := newHandler(t, 5, entries, current, &models.Class{Class: "C2"})
+		errAny := errors.New("any error")
+		txn := &fakeTxn{}
+		fakeSchemaManager.On("Begin").Return(txn, nil)
+		txn.On("DeleteClass", "C2").Return(nil)
+		txn.On("UpdateClass", mock.Anything, mock.Anything).Return(errAny)
+		txn.On("Rollback").Return(nil)
+
+		assert.ErrorIs(t, handler.RollbackToVersion(ctx, nil, 3), errAny)
+		txn.AssertNotCalled(t, "Commit")
+		txn.AssertCalled(t, "Rollback")
+	})
+
+	t.Run("added class with objects", func(t *testing.T) {
+		handler, fakeSchemaManager := newTestHandler(t, &fakeDB{})
+		fakeSchemaManager.On("SchemaVersion").Return(uint64(5))
+		fakeSchemaManager.On("SchemaChangelog", uint64(3), 0).Return(entries)
+		fakeSchemaManager.On("ReadOnlySchema").Return(models.Schema{Classes: []*models.Class{current, {Class: "C2"}}})
+		fakeSchemaManager.On("ReadOnlyClass", "C2").Return(&models.Class{Class: "C2"})
+		fakeSchemaManager.On("ReplicaObjectCounts", mock.Anything, "C2").
+			Return(map[string]map[string]int64{"shard": {"node1": 0, "node2": 3}}, nil)
+
+		err := handler.RollbackToVersion(ctx, nil, 3)
+		assert.ErrorIs(t, err, ErrRollbackBlocked)
+		assert.ErrorContains(t, err, `class "C2" has 3 objects in shard "shard" on node "node2"`)
+		fakeSchemaManager.AssertNotCalled(t, "Begin")
+	})
+
+	t.Run("blocked changes", func(t *testing.T) {
+		for name, tc := range map[string]struct {
+			entries []SchemaChangeEntry
+			classes []*models.Class
+		}{
+			"added property": {
+				entries: append(entries, SchemaChangeEntry{Version: 6, Operation: "ADD_PROPERTY", Class: "C1", Properties: []string{"p"}}),
+			},
+			"referenced class": {
+				entries: entries,
+				classes: []*models.Class{{Class: "C1", Properties: []*models.Property{{Name: "ref", DataType: []string{"C2"}}}}},
+			},
+			"unknown previous config": {
+				entries: []SchemaChangeEntry{{Version: 4, Operation: "UPDATE_CLASS", Class: "C1"}},
+			},
+			"deleted class": {
+				entries: []SchemaChangeEntry{{Version: 4, Operation: "DELETE_CLASS", Class: "C1"}},
+			},
+			"truncated changelog": {
+				entries: []SchemaChangeEntry{{Version: 5, Operation: "ADD_CLASS", Class: "C2"}},
+			},
+		} {
+			t.Run(name, func(t *testing.T) {
+				version := tc.entries[len(tc.entries)-1].Version
+				handler, fakeSchemaManager := newHandler(t, version, tc.entries, tc.classes...)
+
+				err := handler.RollbackToVersion(ctx, nil, 3)
+				assert.ErrorIs(t, err, ErrRollbackBlocked)
+				fakeSchemaManager.AssertNotCalled(t, "Begin")
+			})
+		}
+	})
+
+	t.Run("invalid target version", func(t *testing.T) {
+		handler, _ := newHandler(t, 200, nil)
+		assert.ErrorIs(t, handler.RollbackToVersion(ctx, nil, 201), clusterSchema.ErrBadRequest)
+		assert.ErrorIs(t, handler.RollbackToVersion(ctx, nil, 99), clusterSchema.ErrBadRequest)
+	})
+
+	t.Run("nothing to undo", func(t *testing.T) {
+		handler, _ := newHandler(t, 3, []SchemaChangeEntry{})
+		assert.Nil(t, handler.RollbackToVersion(ctx, nil, 3))
+	})
+}
@@ -0,0 +1,168 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	clusterSchema "github.com/weaviate/weaviate/cluster/schema"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/storagestate"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
+)
+
+type ShardMoveState string
+
+// shardMoveFreezeTimeout bounds how long MoveShard waits for the nodes to
+// block writes to the shard
+var shardMoveFreezeTimeout = 30 * time.Second
+
+const (
+	ShardMoveRunning  ShardMoveState = "RUNNING"
+	ShardMoveFinished ShardMoveState = "FINISHED"
+	ShardMoveFailed   ShardMoveState = "FAILED"
+)
+
+// MoveStatus describes a shard move started by MoveShard
+type MoveStatus struct {
+	ID         string
+	Class      string
+	Shard      string
+	FromNode   string
+	ToNode     string
+	Status     ShardMoveState
+	Error      string
+	StartedAt  time.Time
+	FinishedAt time.Time
+}
+
+func finishShardMove(err error) func(job *MoveStatus) {
+	return func(job *MoveStatus) {
+		job.FinishedAt = time.Now().UTC()
+		job.Status = ShardMoveFinished
+		if err != nil {
+			job.Status = ShardMoveFailed
+			job.Error = err.Error()
+		}
+	}
+}
+
+func newMoveStatus(class, shard, fromNode, toNode string) MoveStatus {
+	return MoveStatus{
+		ID:        uuid.NewString(),
+		Class:     class,
+		Shard:     shard,
+		FromNode:  fromNode,
+		ToNode:    toNode,
+		Status:    ShardMoveRunning,
+		StartedAt: time.Now().UTC(),
+	}
+}
+
+// MoveShard moves the replica of shard held by fromNode to toNode in the
+// background. Writes to the shard are blocked on every node, the shard is
+// copied to toNode and the ownership is handed over to it, which opens the
+// shard on toNode and closes it on fromNode. Writes are unblocked once the
+// move is committed or has failed. The files of the shard are kept on
+// fromNode.
+//
+// The returned job ID can be passed to ShardMoveStatus on this node to poll
+// the progress, the status of the job isn't shared with the other nodes.
+func (h *Handler) MoveShard(ctx context.Context, principal *models.Principal,
+	class, shard, fromNode, toNode string,
+) (string, error) {
+	err := h.Authorizer.Authorize(principal, authorization.UPDATE, authorization.ShardsMetadata(class, shard)...)
+	if err != nil {
+		return "", err
+	}
+
+	class = schema.UppercaseClassName(class)
+	if h.schemaReader.ReadOnlyClass(class) == nil {
+		return "", fmt.Errorf("class %q: %w", class, ErrNotFound)
+	}
+	if fromNode == toNode {
+		return "", fmt.Errorf("%w: shard %q cannot be moved to the node it is on", clusterSchema.ErrBadRequest, shard)
+	}
+	owners, err := h.schemaReader.ShardReplicas(class, shard)
+	if err != nil {
+		return "", fmt.Errorf("shard %q of class %q: %w", shard, class, err)
+	}
+	if !slices.Contains(owners, fromNode) {
+		return "", fmt.Errorf("%w: shard %q is not owned by node %q", clusterSchema.ErrBadRequest, shard, fromNode)
+	}
+	if slices.Contains(owners, toNode) {
+		return "", fmt.Errorf("%w: shard %q is already owned by node %q", clusterSchema.ErrBadRequest, shard, toNode)
+	}
+	if !slices.Contains(h.clusterState.AllNames(), toNode) {
+		return "", fmt.Errorf("%w: node %q does not exist", clusterSchema.ErrBadRequest, toNode)
+	}
+
+	job := newMoveStatus(class, shard, fromNode, toNode)
+	h.shardMoves.Add(job.ID, job)
+	enterrors.GoWrapper(func() {
+		err := h.moveShard(context.Background(), principal, job)
+		if err != nil {
+			h.logEntry(ctx, class, "").WithField("action", "move_shard").
+				WithField("shard", shard).
+				WithField("job", job.ID).
+				WithError(err).Error("moving shard failed")
+		}
+		h.shardMoves.Finish(job.ID, finishShardMove(err))
+	}, h.logger)
+
+	return job.ID, nil
+}
+
+func (h *Handler) moveShard(ctx context.Context, principal *models.Principal, job MoveStatus) error {
+	version, err := h.schemaManager.UpdateShardStatus(withActor(ctx, principal), job.Class, job.Shard,
+		storagestate.StatusReadOnly.String())
+	if err != nil {
+		return fmt.Errorf("block writes to shard %q: %w", job.Shard, err)
+	}
+	err = h.waitForAllNodes(ctx, version, shardMoveFreezeTimeout)
+	if err != nil {
+		err = fmt.Errorf("block writes to shard %q: %w", job.Shard, err)
+	} else if err = h.scaleOut.CopyShard(ctx, job.Class, job.Shard, job.FromNode, job.ToNode); err == nil {
+		_, err = h.schemaManager.MoveShard(withActor(ctx, principal), job.Class, job.Shard, job.FromNode, job.ToNode)
+	}
+
+	_, uerr := h.schemaManager.UpdateShardStatus(withActor(ctx, principal), job.Class, job.Shard,
+		storagestate.StatusReady.String())
+	if uerr != nil {
+		h.logEntry(ctx, job.Class, "").WithField("action", "move_shard").
+			WithField("shard", job.Shard).
+			WithField("job", job.ID).
+			WithError(uerr).Error("cannot unblock writes to shard")
+	}
+	return err
+}
+
+// ShardMoveStatus returns the shard move jobID
+func (h *Handler) ShardMoveStatus(ctx context.Context, principal *models.Principal,
+	jobID string,
+) (MoveStatus, error) {
+	job, ok := h.shardMoves.Get(jobID)
+	if !ok {
+		return MoveStatus{}, fmt.Errorf("shard move %q: %w", jobID, ErrNotFound)
+	}
+	err := h.Authorizer.Authorize(principal, authorization.UPDATE, authorization.ShardsMetadata(job.Class, job.Shard)...)
+	if err != nil {
+		return MoveStatus{}, err
+	}
+	return job, nil
+}
@@ -0,0 +1,110 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	clusterSchema "github.com/weaviate/weaviate/cluster/schema"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/fakes"
+)
+
+func TestHandler_MoveShard(t *testing.T) {
+	ctx := context.Background()
+	class := &models.Class{Class: "C"}
+
+	newHandler := func(t *testing.T) (*Handler, *fakeSchemaManager, *fakeScaleOutManager) {
+		handler, fakeSchemaManager := newTestHandler(t, &fakeDB{})
+		handler.clusterState = fakes.NewFakeClusterState("node-1", "node-2", "node-3")
+		scaleOut := &fakeScaleOutManager{}
+		handler.scaleOut = scaleOut
+		fakeSchemaManager.On("ReadOnlyClass", "C").Return(class)
+		fakeSchemaManager.On("ShardReplicas", "C", "S1").Return([]string{"node-1", "node-2"}, nil)
+		return handler, fakeSchemaManager, scaleOut
+	}
+	waitForJob := func(t *testing.T, handler *Handler, id string) MoveStatus {
+		var job MoveStatus
+		require.Eventually(t, func() bool {
+			var err error
+			job, err = handler.ShardMoveStatus(ctx, nil, id)
+			require.Nil(t, err)
+			return job.Status != ShardMoveRunning
+		}, 5*time.Second, 10*time.Millisecond)
+		return job
+	}
+
+	t.Run("success", func(t *testing.T) {
+		handler, fakeSchemaManager, scaleOut := newHandler(t)
+		fakeSchemaManager.On("UpdateShardStatus", "C", "S1", "READONLY").Return(nil)
+		scaleOut.On("CopyShard", "C", "S1", "node-1", "node-3").Return(nil)
+		fakeSchemaManager.On("MoveShard", "C", "S1", "node-1", "node-3").Return(nil)
+		fakeSchemaManager.On("UpdateShardStatus", "C", "S1", "READY").Return(nil)
+
+		id, err := handler.MoveShard(ctx, nil, "C", "S1", "node-1", "node-3")
+		require.Nil(t, err)
+		job := waitForJob(t, handler, id)
+		assert.Equal(t, ShardMoveFinished, job.Status)
+		assert.Equal(t, "S1", job.Shard)
+		fakeSchemaManager.AssertExpectations(t)
+	})
+
+	t.Run("copy fails", func(t *testing.T) {
+		handler, fakeSchemaManager, scaleOut := newHandler(t)
+		fakeSchemaManager.On("UpdateShardStatus", "C", "S1", "READONLY").Return(nil)
+		scaleOut.On("CopyShard", "C", "S1", "node-1", "node-3").Return(errors.New("disk full"))
+		fakeSchemaManager.On("UpdateShardStatus", "C", "S1", "READY").Return(nil)
+
+		id, err := handler.MoveShard(ctx, nil, "C", "S1", "node-1", "node-3")
+		require.Nil(t, err)
+		job := waitForJob(t, handler, id)
+		assert.Equal(t, ShardMoveFailed, job.Status)
+		assert.Equal(t, "disk full", job.Error)
+		fakeSchemaManager.AssertNotCalled(t, "MoveShard", "C", "S1", "node-1", "node-3")
+		fakeSchemaManager.AssertExpectations(t)
+	})
+
+	t.Run("writes cannot be blocked", func(t *testing.T) {
+		handler, fakeSchemaManager, scaleOut := newHandler(t)
+		fakeSchemaManager.On("UpdateShardStatus", "C", "S1", "READONLY").Return(errors.New("no leader"))
+
+		id, err := handler.MoveShard(ctx, nil, "C", "S1", "node-1", "node-3")
+		require.Nil(t, err)
+		job := waitForJob(t, handler, id)
+		assert.Equal(t, ShardMoveFailed, job.Status)
+		scaleOut.AssertNotCalled(t, "CopyShard", "C", "S1", "node-1", "node-3")
+	})
+
+	t.Run("invalid requests", func(t *testing.T) {
+		handler, fakeSchemaManager, _ := newHandler(t)
+		fakeSchemaManager.On("ReadOnlyClass", "Unknown").Return(nil)
+
+		_, err := handler.MoveShard(ctx, nil, "Unknown", "S1", "node-1", "node-3")
+		assert.ErrorIs(t, err, ErrNotFound)
+		for _, nodes := range [][2]string{
+			{"node-3", "node-1"}, // not an owner
+			{"node-1", "node-2"}, // already an owner
+			{"node-1", "node-1"},
+			{"node-1", "node-4"}, // unknown node
+		} {
+			_, err = handler.MoveShard(ctx, nil, "C", "S1", nodes[0], nodes[1])
+			assert.ErrorIs(t, err, clusterSchema.ErrBadRequest, nodes)
+		}
+		_, err = handler.ShardMoveStatus(ctx, nil, "unknown-job")
+		assert.ErrorIs(t, err, ErrNotFound)
+	})
+}
@@ -0,0 +1,248 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	clusterSchema "github.com/weaviate/weaviate/cluster/schema"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/vectorindex"
+	"github.com/weaviate/weaviate/entities/vectorindex/dynamic"
+	"github.com/weaviate/weaviate/entities/vectorindex/flat"
+	"github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
+)
+
+// The heuristics assume average sizes of the values of each data type. They
+// are meant for capacity planning, the actual footprint depends on the data.
+const (
+	// defaultEstimateDimensions is used if the vector index config has no
+	// vectorDimensions
+	defaultEstimateDimensions = 1536
+	estimateObjectOverhead    = 100 // id, timestamps, class name and headers
+	estimateArrayLength       = 5
+	estimateTextBytes         = 300
+	estimateTextTokens        = 50
+	estimateBlobBytes         = 32 << 10
+	estimateNodeIDBytes       = 8
+)
+
+// ClassSizeEstimate is the estimated storage footprint of a class for all of
+// its replicas
+type ClassSizeEstimate struct {
+	VectorIndexBytes   int64
+	InvertedIndexBytes int64
+	ObjectStoreBytes   int64
+	TotalBytes         int64
+}
+
+// EstimateClassSize estimates the storage footprint of class with
+// objectCount objects, without adding it to the schema. The dimensions of a
+// vector can be set with vectorDimensions in its vector index config,
+// otherwise defaultEstimateDimensions are assumed.
+func (h *Handler) EstimateClassSize(principal *models.Principal, class *models.Class, objectCount int64) (*ClassSizeEstimate, error) {
+	if class == nil {
+		return nil, fmt.Errorf("%w: class is required", clusterSchema.ErrBadRequest)
+	}
+	if err := h.Authorizer.Authorize(principal, authorization.READ, authorization.CollectionsMetadata(class.Class)...); err != nil {
+		return nil, err
+	}
+	if objectCount < 0 {
+		return nil, fmt.Errorf("%w: object count must not be negative, got %d", clusterSchema.ErrBadRequest, objectCount)
+	}
+
+	est := &ClassSizeEstimate{}
+	perObject := int64(estimateObjectOverhead)
+	for _, prop := range class.Properties {
+		objectBytes, invertedBytes := estimatePropertySize(prop)
+		perObject += objectBytes
+		est.InvertedIndexBytes += invertedBytes * objectCount
+	}
+
+	vectors := map[string]models.VectorConfig{"": {
+		VectorIndexType:   class.VectorIndexType,
+		VectorIndexConfig: class.VectorIndexConfig,
+	}}
+	if hasTargetVectors(class) {
+		vectors = class.VectorConfig
+	}
+	for name, vector := range vectors {
+		dims := estimateDimensions(vector.VectorIndexConfig)
+		indexBytes, err := h.estimateVectorIndexSize(vector, dims, objectCount)
+		if err != nil {
+			if name != "" {
+				return nil, fmt.Errorf("target vector %q: %w", name, err)
+			}
+			return nil, err
+		}
+		est.VectorIndexBytes += indexBytes
+		// the object store keeps the uncompressed vectors
+		perObject += dims * 4
+	}
+	est.ObjectStoreBytes = perObject * objectCount
+
+	if class.ReplicationConfig != nil && class.ReplicationConfig.Factor > 1 {
+		est.VectorIndexBytes *= class.ReplicationConfig.Factor
+		est.InvertedIndexBytes *= class.ReplicationConfig.Factor
+		est.ObjectStoreBytes *= class.ReplicationConfig.Factor
+	}
+	est.TotalBytes = est.VectorIndexBytes + est.InvertedIndexBytes + est.ObjectStoreBytes
+	return est, nil
+}
+
+func (h *Handler) estimateVectorIndexSize(vector models.VectorConfig, dims, objectCount int64) (int64, error) {
+	indexType := vector.VectorIndexType
+	if indexType == "" {
+		indexType = vectorindex.DefaultVectorIndexType
+	}
+	cfg, err := h.configParser(vector.VectorIndexConfig, indexType)
+	if err != nil {
+		return 0, fmt.Errorf("parse vector index config: %w", err)
+	}
+
+	switch cfg := cfg.(type) {
+	case hnsw.UserConfig:
+		return estimateHNSWSize(cfg, dims) * objectCount, nil
+	case flat.UserConfig:
+		return estimateFlatSize(cfg, dims) * objectCount, nil
+	case dynamic.UserConfig:
+		// dynamic indexes are upgraded to hnsw above the threshold
+		if uint64(objectCount) > cfg.Threshold {
+			return estimateHNSWSize(cfg.HnswUC, dims) * objectCount, nil
+		}
+		return estimateFlatSize(cfg.FlatUC, dims) * objectCount, nil
+	default:
+		return 0, fmt.Errorf("can not estimate the size of vector index type %q", indexType)
+	}
+}
+
+// estimateHNSWSize returns the bytes per object of the graph, with up to
+// twice maxConnections links on the lowest layer, and of the vector the
+// index keeps for distance calculations
+func estimateHNSWSize(cfg hnsw.UserConfig, dims int64) int64 {
+	links := int64(2*cfg.MaxConnections) * estimateNodeIDBytes
+	switch {
+	case cfg.BQ.Enabled:
+		return links + (dims+7)/8
+	case cfg.PQ.Enabled:
+		segments := int64(cfg.PQ.Segments)
+		if segments <= 0 {
+			segments = dims
+		}
+		return links + segments
+	case cfg.SQ.Enabled:
+		return links + dims
+	default:
+		return links + dims*4
+	}
+}
+
+// estimateFlatSize returns the bytes per object of the vectors of a flat
+// index, which keeps the uncompressed vector next to the compressed one
+func estimateFlatSize(cfg flat.UserConfig, dims int64) int64 {
+	size := dims * 4
+	if cfg.BQ.Enabled {
+		size += (dims + 7) / 8
+	}
+	return size
+}
+
+func estimateDimensions(vectorIndexConfig interface{}) int64 {
+	cfg, ok := vectorIndexConfig.(map[string]interface{})
+	if !ok {
+		return defaultEstimateDimensions
+	}
+	switch dims := cfg["vectorDimensions"].(type) {
+	case float64:
+		if dims > 0 {
+			return int64(dims)
+		}
+	case json.Number:
+		if v, err := dims.Int64(); err == nil && v > 0 {
+			return v
+		}
+	case int:
+		if dims > 0 {
+			return int64(dims)
+		}
+	}
+	return defaultEstimateDimensions
+}
+
+// estimatePropertySize returns the bytes per object of prop in the object
+// store and in the inverted index
+func estimatePropertySize(prop *models.Property) (objectBytes, invertedBytes int64) {
+	if schema.IsRefDataType(prop.DataType) {
+		// a beacon per reference, indexed by its count
+		return 64, estimateNodeIDBytes
+	}
+	if len(prop.DataType) != 1 {
+		return 0, 0
+	}
+
+	dataType := schema.DataType(prop.DataType[0])
+	count := int64(1)
+	if schema.IsArrayDataType(prop.DataType) {
+		dataType = schema.DataType(strings.TrimSuffix(string(dataType), "[]"))
+		count = estimateArrayLength
+	}
+	filterable := prop.IndexFilterable == nil || *prop.IndexFilterable
+	searchable := prop.IndexSearchable == nil || *prop.IndexSearchable
+	rangeable := prop.IndexRangeFilters != nil && *prop.IndexRangeFilters
+
+	var valueBytes, indexBytes int64
+	switch dataType {
+	case schema.DataTypeText, schema.DataTypeString:
+		valueBytes = estimateTextBytes
+		if filterable {
+			// roaring bitmap entry per token
+			indexBytes += estimateTextTokens * 4
+		}
+		if searchable {
+			// doc id, frequency and property length per token for BM25
+			indexBytes += estimateTextTokens * 16
+		}
+	case schema.DataTypeInt, schema.DataTypeNumber, schema.DataTypeDate:
+		valueBytes = 8
+		if filterable {
+			indexBytes += 8
+		}
+		if rangeable {
+			indexBytes += 16
+		}
+	case schema.DataTypeBoolean:
+		valueBytes = 1
+		if filterable {
+			indexBytes += 1
+		}
+	case schema.DataTypeUUID:
+		valueBytes = 16
+		if filterable {
+			indexBytes += 24
+		}
+	case schema.DataTypeGeoCoordinates:
+		// indexed in a dedicated geo index
+		valueBytes, indexBytes = 16, 64
+	case schema.DataTypePhoneNumber:
+		valueBytes = 32
+	case schema.DataTypeBlob:
+		valueBytes = estimateBlobBytes
+	case schema.DataTypeObject:
+		// nested properties are stored, but not indexed
+		valueBytes = estimateTextBytes
+	}
+	return valueBytes * count, indexBytes * count
+}
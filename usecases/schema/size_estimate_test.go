@@ -0,0 +1,117 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	clusterSchema "github.com/weaviate/weaviate/cluster/schema"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/vectorindex"
+)
+
+func TestHandler_EstimateClassSize(t *testing.T) {
+	handler, _ := newTestHandler(t, &fakeDB{})
+	handler.configParser = vectorindex.ParseAndValidateConfig
+
+	vectorOnly := func(indexType string, cfg map[string]interface{}) *models.Class {
+		cfg["vectorDimensions"] = float64(8)
+		return &models.Class{Class: "C", VectorIndexType: indexType, VectorIndexConfig: cfg}
+	}
+	// 100 bytes of object overhead and the uncompressed vector of 8 dimensions
+	const objectStore = 100 + 8*4
+
+	tests := []struct {
+		name        string
+		class       *models.Class
+		vectorIndex int64
+	}{
+		{
+			name:  "hnsw",
+			class: vectorOnly("hnsw", map[string]interface{}{"maxConnections": float64(16)}),
+			// 2*16 links of 8 bytes and the vector
+			vectorIndex: 2*16*8 + 8*4,
+		},
+		{
+			name:        "hnsw with bq",
+			class:       vectorOnly("hnsw", map[string]interface{}{"maxConnections": float64(16), "bq": map[string]interface{}{"enabled": true}}),
+			vectorIndex: 2*16*8 + 1,
+		},
+		{
+			name:        "hnsw with pq",
+			class:       vectorOnly("hnsw", map[string]interface{}{"maxConnections": float64(16), "pq": map[string]interface{}{"enabled": true, "segments": float64(4)}}),
+			vectorIndex: 2*16*8 + 4,
+		},
+		{
+			name:        "flat",
+			class:       vectorOnly("flat", map[string]interface{}{}),
+			vectorIndex: 8 * 4,
+		},
+		{
+			name:        "flat with bq",
+			class:       vectorOnly("flat", map[string]interface{}{"bq": map[string]interface{}{"enabled": true}}),
+			vectorIndex: 8*4 + 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			est, err := handler.EstimateClassSize(nil, tt.class, 10)
+			require.Nil(t, err)
+			assert.Equal(t, &ClassSizeEstimate{
+				VectorIndexBytes: tt.vectorIndex * 10,
+				ObjectStoreBytes: objectStore * 10,
+				TotalBytes:       (tt.vectorIndex + objectStore) * 10,
+			}, est)
+		})
+	}
+
+	t.Run("properties, named vectors and replication", func(t *testing.T) {
+		class := &models.Class{
+			Class: "C",
+			Properties: []*models.Property{
+				{Name: "title", DataType: []string{"text"}},
+				{Name: "count", DataType: []string{"int"}, IndexFilterable: new(bool)},
+			},
+			VectorConfig: map[string]models.VectorConfig{
+				"a": {VectorIndexType: "flat", VectorIndexConfig: map[string]interface{}{"vectorDimensions": float64(8)}},
+				"b": {VectorIndexType: "flat", VectorIndexConfig: map[string]interface{}{}},
+			},
+			ReplicationConfig: &models.ReplicationConfig{Factor: 2},
+		}
+		est, err := handler.EstimateClassSize(nil, class, 10)
+		require.Nil(t, err)
+
+		vectorIndex := int64(8*4 + defaultEstimateDimensions*4)
+		// the text is filterable and searchable, the int is not indexed
+		inverted := int64(50*4 + 50*16)
+		objectStore := int64(100+300+8) + vectorIndex
+		assert.Equal(t, &ClassSizeEstimate{
+			VectorIndexBytes:   vectorIndex * 10 * 2,
+			InvertedIndexBytes: inverted * 10 * 2,
+			ObjectStoreBytes:   objectStore * 10 * 2,
+			TotalBytes:         (vectorIndex + inverted + objectStore) * 10 * 2,
+		}, est)
+	})
+
+	t.Run("invalid input", func(t *testing.T) {
+		_, err := handler.EstimateClassSize(nil, &models.Class{Class: "C"}, -1)
+		assert.ErrorIs(t, err, clusterSchema.ErrBadRequest)
+
+		_, err = handler.EstimateClassSize(nil, nil, 1)
+		assert.ErrorIs(t, err, clusterSchema.ErrBadRequest)
+
+		_, err = handler.EstimateClassSize(nil, vectorOnly("unknown", map[string]interface{}{}), 1)
+		assert.ErrorContains(t, err, "parse vector index config")
+	})
+}
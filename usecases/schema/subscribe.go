@@ -0,0 +1,154 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"sync"
+	"time"
+
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
+)
+
+const (
+	SchemaEventClassAdded   = "class_added"
+	SchemaEventClassUpdated = "class_updated"
+	SchemaEventClassDeleted = "class_deleted"
+)
+
+// SchemaSubscriberBufferSize is the number of events buffered for each
+// subscriber. Subscribers which fall further behind are dropped.
+const SchemaSubscriberBufferSize = 100
+
+// SchemaEvent is sent to subscribers for every class which is added, updated
+// or deleted. Adding properties to a class counts as an update.
+type SchemaEvent struct {
+	Type      string    `json:"type"`
+	Class     string    `json:"class"`
+	Timestamp time.Time `json:"timestamp"`
+}
+
+// SchemaSubscription receives the events of a SchemaEventBroadcaster until
+// it is unsubscribed or dropped
+type SchemaSubscription struct {
+	events chan SchemaEvent
+	// dropped is set before events is closed
+	dropped bool
+}
+
+// Events is closed once the subscription ends
+func (s *SchemaSubscription) Events() <-chan SchemaEvent {
+	return s.events
+}
+
+// Dropped reports whether the subscription ended because its buffer was
+// full. It is only meaningful once Events is closed.
+func (s *SchemaSubscription) Dropped() bool {
+	return s.dropped
+}
+
+// SchemaEventBroadcaster is an EventListener which fans the class events out
+// to all subscribers. It is fed with the changes applied on the local node,
+// see SchemaEventListener. It never blocks schema writes: a subscriber whose
+// buffer is full is dropped instead.
+type SchemaEventBroadcaster struct {
+	NoopEventListener
+
+	sync.Mutex
+	bufferSize  int
+	subscribers map[*SchemaSubscription]struct{}
+	now         func() time.Time
+}
+
+func NewSchemaEventBroadcaster(bufferSize int) *SchemaEventBroadcaster {
+	if bufferSize <= 0 {
+		bufferSize = SchemaSubscriberBufferSize
+	}
+	return &SchemaEventBroadcaster{
+		bufferSize:  bufferSize,
+		subscribers: map[*SchemaSubscription]struct{}{},
+		now:         time.Now,
+	}
+}
+
+func (b *SchemaEventBroadcaster) Subscribe() *SchemaSubscription {
+	b.Lock()
+	defer b.Unlock()
+
+	s := &SchemaSubscription{events: make(chan SchemaEvent, b.bufferSize)}
+	b.subscribers[s] = struct{}{}
+	return s
+}
+
+// Unsubscribe ends s, it is a no-op if s has ended already
+func (b *SchemaEventBroadcaster) Unsubscribe(s *SchemaSubscription) {
+	b.Lock()
+	defer b.Unlock()
+
+	if _, ok := b.subscribers[s]; ok {
+		delete(b.subscribers, s)
+		close(s.events)
+	}
+}
+
+func (b *SchemaEventBroadcaster) broadcast(eventType, class string) {
+	b.Lock()
+	defer b.Unlock()
+
+	event := SchemaEvent{Type: eventType, Class: class, Timestamp: b.now()}
+	for s := range b.subscribers {
+		select {
+		case s.events <- event:
+		default:
+			delete(b.subscribers, s)
+			s.dropped = true
+			close(s.events)
+		}
+	}
+}
+
+func (b *SchemaEventBroadcaster) OnClassAdded(class *models.Class) {
+	b.broadcast(SchemaEventClassAdded, class.Class)
+}
+
+func (b *SchemaEventBroadcaster) OnClassUpdated(class *models.Class) {
+	b.broadcast(SchemaEventClassUpdated, class.Class)
+}
+
+func (b *SchemaEventBroadcaster) OnClassDeleted(name string) {
+	b.broadcast(SchemaEventClassDeleted, name)
+}
+
+func (b *SchemaEventBroadcaster) OnPropertyAdded(class string, _ *models.Property) {
+	b.broadcast(SchemaEventClassUpdated, class)
+}
+
+// SubscribeSchemaEvents subscribes to the events of all classes, which
+// requires READ on the metadata of all collections. The subscription must be
+// ended with UnsubscribeSchemaEvents.
+func (h *Handler) SubscribeSchemaEvents(principal *models.Principal) (*SchemaSubscription, error) {
+	if err := h.Authorizer.Authorize(principal, authorization.READ, authorization.CollectionsMetadata()...); err != nil {
+		return nil, err
+	}
+	return h.schemaEvents.Subscribe(), nil
+}
+
+func (h *Handler) UnsubscribeSchemaEvents(s *SchemaSubscription) {
+	h.schemaEvents.Unsubscribe(s)
+}
+
+// SchemaEventListener feeds the subscriptions. It must be registered with the
+// executor applying the raft log, so that subscribers receive the changes
+// served by any node.
+func (h *Handler) SchemaEventListener() EventListener {
+	return h.schemaEvents
+}
@@ -0,0 +1,125 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/cluster/proto/api"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+func TestSchemaEventBroadcaster(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	newBroadcaster := func(size int) *SchemaEventBroadcaster {
+		b := NewSchemaEventBroadcaster(size)
+		b.now = func() time.Time { return now }
+		return b
+	}
+	received := func(s *SchemaSubscription) []SchemaEvent {
+		var events []SchemaEvent
+		for {
+			select {
+			case e, ok := <-s.Events():
+				if !ok {
+					return events
+				}
+				events = append(events, e)
+			default:
+				return events
+			}
+		}
+	}
+
+	t.Run("fan out", func(t *testing.T) {
+		b := newBroadcaster(10)
+		s1, s2 := b.Subscribe(), b.Subscribe()
+
+		b.OnClassAdded(&models.Class{Class: "A"})
+		b.OnPropertyAdded("A", &models.Property{Name: "p"})
+		b.OnClassUpdated(&models.Class{Class: "A"})
+		b.OnClassDeleted("A")
+
+		expected := []SchemaEvent{
+			{Type: SchemaEventClassAdded, Class: "A", Timestamp: now},
+			{Type: SchemaEventClassUpdated, Class: "A", Timestamp: now},
+			{Type: SchemaEventClassUpdated, Class: "A", Timestamp: now},
+			{Type: SchemaEventClassDeleted, Class: "A", Timestamp: now},
+		}
+		assert.Equal(t, expected, received(s1))
+		assert.Equal(t, expected, received(s2))
+	})
+
+	t.Run("full buffer drops the subscriber", func(t *testing.T) {
+		b := newBroadcaster(2)
+		slow, fast := b.Subscribe(), b.Subscribe()
+
+		b.OnClassDeleted("A")
+		b.OnClassDeleted("B")
+		assert.Len(t, received(fast), 2)
+		b.OnClassDeleted("C")
+
+		assert.Len(t, received(slow), 2)
+		_, ok := <-slow.Events()
+		assert.False(t, ok, "events are closed")
+		assert.True(t, slow.Dropped())
+		assert.Len(t, received(fast), 1)
+		assert.False(t, fast.Dropped())
+	})
+
+	t.Run("unsubscribe", func(t *testing.T) {
+		b := newBroadcaster(2)
+		s := b.Subscribe()
+		b.Unsubscribe(s)
+		b.Unsubscribe(s)
+
+		b.OnClassDeleted("A")
+		_, ok := <-s.Events()
+		assert.False(t, ok)
+		assert.False(t, s.Dropped())
+	})
+}
+
+func TestHandler_SubscribeSchemaEvents(t *testing.T) {
+	handler, _ := newTestHandler(t, &fakeDB{})
+	sub, err := handler.SubscribeSchemaEvents(nil)
+	require.Nil(t, err)
+	defer handler.UnsubscribeSchemaEvents(sub)
+
+	// the executor feeds the listener with the changes applied on this node
+	migrator := &fakeMigrator{}
+	migrator.On("AddClass", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	migrator.On("AddProperty", mock.Anything, "A", mock.Anything).Return(nil)
+	migrator.On("DropClass", mock.Anything, "A").Return(nil)
+	x := newMockExecutor(migrator, &fakeSchemaManager{})
+	x.RegisterEventListener(handler.SchemaEventListener())
+
+	require.Nil(t, x.AddClass(api.AddClassRequest{Class: &models.Class{Class: "A"}}))
+	require.Nil(t, x.AddProperty("A", api.AddPropertyRequest{Properties: []*models.Property{{Name: "p"}}}))
+	require.Nil(t, x.DeleteClass("A", false))
+
+	var types []string
+	for i := 0; i < 3; i++ {
+		select {
+		case e := <-sub.Events():
+			assert.Equal(t, "A", e.Class)
+			types = append(types, e.Type)
+		case <-time.After(time.Second):
+			t.Fatal("no event received")
+		}
+	}
+	assert.Equal(t, []string{SchemaEventClassAdded, SchemaEventClassUpdated, SchemaEventClassDeleted}, types)
+}
@@ -17,8 +17,8 @@ import (
 	"regexp"
 	"slices"
 	"strings"
+	"time"
 
-	"github.com/sirupsen/logrus"
 	"github.com/weaviate/weaviate/cluster/proto/api"
 	clusterSchema "github.com/weaviate/weaviate/cluster/schema"
 	"github.com/weaviate/weaviate/entities/models"
@@ -42,6 +42,8 @@ func (h *Handler) AddTenants(ctx context.Context,
 	class string,
 	tenants []*models.Tenant,
 ) (uint64, error) {
+	defer h.metrics.track(opAddTenants)()
+
 	if err := h.Authorizer.Authorize(principal, authorization.CREATE, authorization.ShardsMetadata(class)...); err != nil {
 		return 0, err
 	}
@@ -55,6 +57,10 @@ func (h *Handler) AddTenants(ctx context.Context,
 		return 0, err
 	}
 
+	if err = h.validateMaxTenants(class, validated); err != nil {
+		return 0, err
+	}
+
 	request := api.AddTenantsRequest{
 		ClusterNodes: h.schemaManager.StorageCandidates(),
 		Tenants:      make([]*api.Tenant, 0, len(validated)),
@@ -66,7 +72,66 @@ func (h *Handler) AddTenants(ctx context.Context,
 		})
 	}
 
-	return h.schemaManager.AddTenants(ctx, class, &request)
+	version, err := h.schemaManager.AddTenants(withActor(ctx, principal), class, &request)
+	if err != nil {
+		return 0, err
+	}
+	names := make([]string, len(request.Tenants))
+	for i, tenant := range request.Tenants {
+		names[i] = tenant.Name
+	}
+	h.notify(ctx, func(l EventListener) { l.OnTenantsAdded(class, names) })
+	return version, nil
+}
+
+// ErrMaxTenantsExceeded is returned if a class would have more tenants than
+// its maxTenants setting or Schema.MaxTenantsPerClass allow
+type ErrMaxTenantsExceeded struct {
+	Class   string
+	Current int // number of tenants the class would have
+	Max     int
+}
+
+func (e ErrMaxTenantsExceeded) Error() string {
+	return fmt.Sprintf("class %s would have %d tenants, at most %d are allowed", e.Class, e.Current, e.Max)
+}
+
+// validateMaxTenants checks that adding tenants to class stays within its
+// limit. The maxTenants setting of the class takes precedence over
+// Schema.MaxTenantsPerClass. Tenants which already exist are not counted twice.
+// Like validateMaxProperties it only gives a descriptive error early, the
+// limit is enforced when the tenants are applied to the schema.
+func (h *Handler) validateMaxTenants(class string, tenants []*models.Tenant) error {
+	info := h.schemaReader.ClassInfo(class)
+	if !info.Exists {
+		// unknown classes are rejected when the tenants are added
+		return nil
+	}
+	limit := h.config.Schema.MaxTenantsPerClass
+	if info.MultiTenancy.MaxTenants > 0 {
+		limit = int(info.MultiTenancy.MaxTenants)
+	}
+	if limit <= 0 || info.Tenants+len(tenants) <= limit {
+		return nil
+	}
+
+	current := 0
+	err := h.schemaReader.Read(class, func(_ *models.Class, ss *sharding.State) error {
+		current = len(ss.Physical)
+		for _, tenant := range tenants {
+			if _, ok := ss.Physical[tenant.Name]; !ok {
+				current++
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("read tenants of class %q: %w", class, err)
+	}
+	if current > limit {
+		return ErrMaxTenantsExceeded{Class: class, Current: current, Max: limit}
+	}
+	return nil
 }
 
 func validateTenants(tenants []*models.Tenant, allowOverHundred bool) (validated []*models.Tenant, err error) {
@@ -150,6 +215,8 @@ func (h *Handler) validateActivityStatuses(ctx context.Context, tenants []*model
 func (h *Handler) UpdateTenants(ctx context.Context, principal *models.Principal,
 	class string, tenants []*models.Tenant,
 ) ([]*models.Tenant, error) {
+	defer h.metrics.track(opUpdateTenants)()
+
 	shardNames := make([]string, len(tenants))
 	for idx := range tenants {
 		shardNames[idx] = tenants[idx].Name
@@ -162,10 +229,7 @@ func (h *Handler) UpdateTenants(ctx context.Context, principal *models.Principal
 		return nil, err
 	}
 
-	h.logger.WithFields(logrus.Fields{
-		"class":   class,
-		"tenants": tenants,
-	}).Debug("update tenants status")
+	h.logEntry(ctx, class, "").WithField("tenants", tenants).Debug("update tenants status")
 
 	validated, err := validateTenants(tenants, false)
 	if err != nil {
@@ -185,9 +249,10 @@ func (h *Handler) UpdateTenants(ctx context.Context, principal *models.Principal
 		req.Tenants[i] = &api.Tenant{Name: tenant.Name, Status: tenant.ActivityStatus}
 	}
 
-	if _, err = h.schemaManager.UpdateTenants(ctx, class, &req); err != nil {
+	if _, err = h.schemaManager.UpdateTenants(withActor(ctx, principal), class, &req); err != nil {
 		return nil, err
 	}
+	h.notify(ctx, func(l EventListener) { l.OnTenantsUpdated(class, tNames) })
 
 	// we get the new state to return correct status
 	// specially in FREEZING and UNFREEZING
@@ -202,6 +267,8 @@ func (h *Handler) UpdateTenants(ctx context.Context, principal *models.Principal
 //
 // Class must exist and has partitioning enabled
 func (h *Handler) DeleteTenants(ctx context.Context, principal *models.Principal, class string, tenants []string) error {
+	defer h.metrics.track(opDeleteTenants)()
+
 	if err := h.Authorizer.Authorize(principal, authorization.DELETE, authorization.ShardsMetadata(class, tenants...)...); err != nil {
 		return err
 	}
@@ -219,8 +286,24 @@ func (h *Handler) DeleteTenants(ctx context.Context, principal *models.Principal
 		Tenants: tenants,
 	}
 
-	_, err := h.schemaManager.DeleteTenants(ctx, class, &req)
-	return err
+	// the objects of the tenants can only be counted before they are deleted
+	var objectCounts map[string]map[string]int64
+	if c := h.schemaReader.ReadOnlyClass(class); c != nil && c.MaxObjects > 0 {
+		counts, err := h.dataMigrator.ReplicaObjectCounts(ctx, class)
+		if err != nil {
+			return fmt.Errorf("count objects of class %q: %w", class, err)
+		}
+		objectCounts = counts
+	}
+
+	if _, err := h.schemaManager.DeleteTenants(withActor(ctx, principal), class, &req); err != nil {
+		return err
+	}
+	h.notify(ctx, func(l EventListener) { l.OnTenantsDeleted(class, tenants) })
+	if objectCounts != nil {
+		h.releaseTenantObjects(ctx, class, tenants, objectCounts)
+	}
+	return nil
 }
 
 // GetTenants is used to get tenants of a class.
@@ -247,6 +330,22 @@ func (h *Handler) GetConsistentTenants(ctx context.Context, principal *models.Pr
 	return h.getTenantsByNames(class, tenants)
 }
 
+// GetConsistentTenantsPage returns a page of the tenants of class ordered by
+// name, read from the leader like GetConsistentTenants with consistency. If
+// tenants is not empty, only those of them which exist are returned. The page
+// starts after the tenant named after and has at most limit tenants if limit
+// is positive. The number of tenants of all pages is returned as well.
+func (h *Handler) GetConsistentTenantsPage(ctx context.Context, principal *models.Principal,
+	class string, tenants []string, after string, limit int,
+) ([]*models.TenantResponse, int, error) {
+	if err := h.Authorizer.Authorize(principal, authorization.READ, authorization.ShardsMetadata(class)...); err != nil {
+		return nil, 0, err
+	}
+
+	page, total, _, err := h.schemaManager.QueryTenantsPage(class, tenants, after, limit)
+	return page, total, err
+}
+
 func (h *Handler) getTenants(class string) ([]*models.Tenant, error) {
 	info, err := h.multiTenancy(class)
 	if err != nil || info.Tenants == 0 {
@@ -310,6 +409,60 @@ func (h *Handler) ConsistentTenantExists(ctx context.Context, principal *models.
 	return ErrNotFound
 }
 
+// TenantStatus is the lifecycle state of a single tenant
+type TenantStatus struct {
+	Name  string
+	Shard string
+	// Status is the activity status, e.g. HOT, COLD or FROZEN
+	Status string
+	// ObjectCount is the number of objects of an active tenant on this node,
+	// it is zero otherwise as the shard is not loaded
+	ObjectCount int64
+	// LastActivityTime is when the tenant was last read or written on this
+	// node, it is zero if no activity has been observed yet
+	LastActivityTime time.Time
+}
+
+// GetTenantStatus returns the lifecycle state of a tenant.
+//
+// Class must exist and has partitioning enabled
+func (h *Handler) GetTenantStatus(ctx context.Context, principal *models.Principal, class, tenant string) (*TenantStatus, error) {
+	if err := h.Authorizer.Authorize(principal, authorization.READ, authorization.ShardsMetadata(class, tenant)...); err != nil {
+		return nil, err
+	}
+	if _, err := h.multiTenancy(class); err != nil {
+		return nil, err
+	}
+
+	var physical sharding.Physical
+	err := h.schemaReader.Read(class, func(_ *models.Class, ss *sharding.State) error {
+		var ok bool
+		if physical, ok = ss.Physical[tenant]; !ok {
+			return fmt.Errorf("tenant %q: %w", tenant, ErrNotFound)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	ts := &TenantStatus{
+		Name: tenant,
+		// the shard of a tenant is named after it
+		Shard:  tenant,
+		Status: schema.ActivityStatus(physical.Status),
+	}
+	if IsLocalActiveTenant(&physical, h.clusterState.LocalName()) {
+		if ts.ObjectCount, err = h.schemaReader.ShardObjectCount(class, tenant); err != nil {
+			return nil, fmt.Errorf("object count of tenant %q: %w", tenant, err)
+		}
+	}
+	if ts.LastActivityTime, err = h.schemaReader.TenantLastActivity(class, tenant); err != nil {
+		return nil, fmt.Errorf("last activity of tenant %q: %w", tenant, err)
+	}
+	return ts, nil
+}
+
 // IsLocalActiveTenant determines whether a given physical partition
 // represents a tenant that is expected to be active
 func IsLocalActiveTenant(phys *sharding.Physical, localNode string) bool {
@@ -0,0 +1,135 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/weaviate/weaviate/cluster/proto/api"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// defaultTenantActivationTimeout applies if the config does not set
+// Schema.AutoActivateTenantsTimeout
+const defaultTenantActivationTimeout = 30 * time.Second
+
+// tenantActivator turns inactive tenants HOT on behalf of the requests
+// accessing them. The tenants of a request are activated with a single
+// schema update, concurrent requests for a tenant which is already being
+// activated wait for that update instead of starting another one.
+type tenantActivator struct {
+	timeout time.Duration
+
+	sync.Mutex
+	// pending are the running activations by class and tenant
+	pending map[string]*tenantActivation
+}
+
+// tenantActivation is a single schema update turning tenants HOT, err is set
+// before done is closed
+type tenantActivation struct {
+	tenants []string
+	done    chan struct{}
+	err     error
+}
+
+func newTenantActivator(timeout time.Duration) *tenantActivator {
+	if timeout <= 0 {
+		timeout = defaultTenantActivationTimeout
+	}
+	return &tenantActivator{timeout: timeout, pending: map[string]*tenantActivation{}}
+}
+
+// activateTenants turns tenants of class HOT and waits until the local schema
+// reflects the change. The activation itself is not bound to ctx, so that a
+// request giving up does not fail the other requests waiting for the same
+// tenant, but it is aborted after the configured timeout.
+func (h *Handler) activateTenants(ctx context.Context, class string, tenants []string) error {
+	ctx, cancel := context.WithTimeout(ctx, h.tenantActivator.timeout)
+	defer cancel()
+
+	a := h.tenantActivator
+	started := &tenantActivation{done: make(chan struct{})}
+	var waits []*tenantActivation
+	a.Lock()
+	for _, tenant := range tenants {
+		running, ok := a.pending[class+"/"+tenant]
+		if !ok {
+			a.pending[class+"/"+tenant] = started
+			started.tenants = append(started.tenants, tenant)
+			continue
+		}
+		if running != started && !slices.Contains(waits, running) {
+			waits = append(waits, running)
+		}
+	}
+	a.Unlock()
+
+	if len(started.tenants) > 0 {
+		waits = append(waits, started)
+		enterrors.GoWrapper(func() {
+			defer func() {
+				a.Lock()
+				for _, tenant := range started.tenants {
+					delete(a.pending, class+"/"+tenant)
+				}
+				a.Unlock()
+				close(started.done)
+			}()
+			started.err = h.activateTenantsNow(class, started.tenants)
+		}, h.logger)
+	}
+
+	var errs []error
+	for _, activation := range waits {
+		select {
+		case <-activation.done:
+			if activation.err != nil {
+				errs = append(errs, activation.err)
+			}
+		case <-ctx.Done():
+			return fmt.Errorf("implicit activation of tenants %s: %w",
+				strings.Join(tenants, ", "), ctx.Err())
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// activateTenantsNow turns tenants of class HOT in a single schema update
+func (h *Handler) activateTenantsNow(class string, tenants []string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), h.tenantActivator.timeout)
+	defer cancel()
+
+	req := &api.UpdateTenantsRequest{
+		Tenants:      make([]*api.Tenant, len(tenants)),
+		ClusterNodes: h.schemaManager.StorageCandidates(),
+	}
+	for i, tenant := range tenants {
+		req.Tenants[i] = &api.Tenant{Name: tenant, Status: models.TenantActivityStatusHOT}
+	}
+	names := strings.Join(tenants, ", ")
+	version, err := h.schemaManager.UpdateTenants(ctx, class, req)
+	if err != nil {
+		return fmt.Errorf("implicit activation of tenants %s: %w", names, err)
+	}
+	if err := h.schemaReader.WaitForUpdate(ctx, version); err != nil {
+		return fmt.Errorf("implicit activation of tenants %s: wait for schema version %d: %w", names, version, err)
+	}
+	return nil
+}
@@ -0,0 +1,132 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/cluster/proto/api"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+func activationOf(tenants ...string) interface{} {
+	return mock.MatchedBy(func(req *api.UpdateTenantsRequest) bool {
+		if len(req.Tenants) != len(tenants) {
+			return false
+		}
+		for i, tenant := range req.Tenants {
+			if tenant.Name != tenants[i] || tenant.Status != models.TenantActivityStatusHOT {
+				return false
+			}
+		}
+		return true
+	})
+}
+
+func TestActivateTenants(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("activates every tenant in one update", func(t *testing.T) {
+		handler, fakeSchemaManager := newTestHandler(t, &fakeDB{})
+		fakeSchemaManager.On("UpdateTenants", "C1", activationOf("T1", "T2")).Return(nil).Once()
+
+		require.Nil(t, handler.activateTenants(ctx, "C1", []string{"T1", "T2"}))
+		fakeSchemaManager.AssertExpectations(t)
+	})
+
+	t.Run("only tenants which aren't being activated are added", func(t *testing.T) {
+		handler, fakeSchemaManager := newTestHandler(t, &fakeDB{})
+		started, release := make(chan struct{}), make(chan struct{})
+		fakeSchemaManager.On("UpdateTenants", "C1", activationOf("T1")).Return(nil).
+			Run(func(mock.Arguments) {
+				close(started)
+				<-release
+			}).Once()
+		fakeSchemaManager.On("UpdateTenants", "C1", activationOf("T2")).Return(nil).Once()
+
+		errs := make(chan error, 1)
+		go func() { errs <- handler.activateTenants(ctx, "C1", []string{"T1"}) }()
+		<-started
+		done := make(chan error, 1)
+		go func() { done <- handler.activateTenants(ctx, "C1", []string{"T1", "T2"}) }()
+		time.Sleep(50 * time.Millisecond)
+		close(release)
+
+		assert.Nil(t, <-errs)
+		assert.Nil(t, <-done)
+		fakeSchemaManager.AssertExpectations(t)
+	})
+
+	t.Run("concurrent requests share one activation", func(t *testing.T) {
+		handler, fakeSchemaManager := newTestHandler(t, &fakeDB{})
+		started, release := make(chan struct{}), make(chan struct{})
+		fakeSchemaManager.On("UpdateTenants", "C1", activationOf("T1")).Return(nil).
+			Run(func(mock.Arguments) {
+				close(started)
+				<-release
+			}).Once()
+
+		var wg sync.WaitGroup
+		errs := make(chan error, 10)
+		for i := 0; i < cap(errs); i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				errs <- handler.activateTenants(ctx, "C1", []string{"T1"})
+			}()
+		}
+		<-started
+		// give the remaining requests time to join the running activation
+		time.Sleep(50 * time.Millisecond)
+		close(release)
+		wg.Wait()
+		close(errs)
+
+		for err := range errs {
+			assert.Nil(t, err)
+		}
+		fakeSchemaManager.AssertNumberOfCalls(t, "UpdateTenants", 1)
+	})
+
+	t.Run("times out", func(t *testing.T) {
+		handler, fakeSchemaManager := newTestHandler(t, &fakeDB{})
+		handler.tenantActivator = newTenantActivator(20 * time.Millisecond)
+		release := make(chan struct{})
+		defer close(release)
+		fakeSchemaManager.On("UpdateTenants", "C1", activationOf("T1")).Return(nil).
+			Run(func(mock.Arguments) { <-release })
+
+		err := handler.activateTenants(ctx, "C1", []string{"T1"})
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+	})
+}
+
+func TestManagerTenantsShardsAutoActivation(t *testing.T) {
+	ctx := context.Background()
+	handler, fakeSchemaManager := newTestHandler(t, &fakeDB{})
+	handler.AutoActivateTenants = true
+	m := &Manager{Handler: *handler, SchemaReader: fakeSchemaManager}
+
+	fakeSchemaManager.On("QueryTenantsShards", "C1", []string{"T1"}).Return(nil, "T1")
+	fakeSchemaManager.On("UpdateTenants", "C1", activationOf("T1")).Return(nil).Once()
+
+	status, err := m.TenantsShards(ctx, "C1", "T1")
+	require.Nil(t, err)
+	assert.Equal(t, map[string]string{"T1": models.TenantActivityStatusHOT}, status)
+	fakeSchemaManager.AssertExpectations(t)
+}
@@ -0,0 +1,256 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/weaviate/weaviate/cluster/proto/api"
+	clusterSchema "github.com/weaviate/weaviate/cluster/schema"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/storagestate"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
+)
+
+type TenantMigrationState string
+
+// tenantMigrationFreezeTimeout bounds how long MigrateTenant waits for the
+// nodes to block writes to the source tenant
+var tenantMigrationFreezeTimeout = 30 * time.Second
+
+const (
+	TenantMigrationRunning  TenantMigrationState = "RUNNING"
+	TenantMigrationFinished TenantMigrationState = "FINISHED"
+	TenantMigrationFailed   TenantMigrationState = "FAILED"
+)
+
+// TenantMigrationStatus describes a tenant migration started by
+// MigrateTenant
+type TenantMigrationStatus struct {
+	ID           string
+	SourceClass  string
+	TargetClass  string
+	Tenant       string
+	DeleteSource bool
+	Status       TenantMigrationState
+	// Copied is the number of objects copied so far
+	Copied     int64
+	Error      string
+	StartedAt  time.Time
+	FinishedAt time.Time
+}
+
+func finishTenantMigration(err error) func(job *TenantMigrationStatus) {
+	return func(job *TenantMigrationStatus) {
+		job.FinishedAt = time.Now().UTC()
+		job.Status = TenantMigrationFinished
+		if err != nil {
+			job.Status = TenantMigrationFailed
+			job.Error = err.Error()
+		}
+	}
+}
+
+// MigrateTenant copies the objects of tenant of sourceClass to the same
+// tenant of targetClass in the background, the target tenant is created if
+// it doesn't exist. Every property of sourceClass must exist in targetClass
+// with the same data type. If deleteSource is set, the source tenant is
+// deleted once all objects are copied. The returned job ID can be passed to
+// TenantMigrationStatus to poll the progress.
+//
+// The objects are read from the replica of the tenant on this node, so the
+// migration has to be started on a node holding the tenant. If deleteSource
+// is set, writes to the source tenant are blocked on every node before the
+// objects are copied, and the source tenant is only deleted if each of its
+// replicas holds as many objects as were copied. Otherwise objects written
+// to the source tenant during the migration may not be copied.
+func (h *Handler) MigrateTenant(ctx context.Context, principal *models.Principal,
+	sourceClass, targetClass, tenant string, deleteSource bool,
+) (string, error) {
+	sourceClass = schema.UppercaseClassName(sourceClass)
+	targetClass = schema.UppercaseClassName(targetClass)
+	err := h.Authorizer.Authorize(principal, authorization.DELETE, authorization.ShardsMetadata(sourceClass, tenant)...)
+	if err != nil {
+		return "", err
+	}
+	err = h.Authorizer.Authorize(principal, authorization.CREATE, authorization.ShardsMetadata(targetClass, tenant)...)
+	if err != nil {
+		return "", err
+	}
+
+	if sourceClass == targetClass {
+		return "", fmt.Errorf("%w: tenant %q cannot be migrated to the class it is in", clusterSchema.ErrBadRequest, tenant)
+	}
+	source := h.schemaReader.ReadOnlyClass(sourceClass)
+	if source == nil {
+		return "", fmt.Errorf("class %q: %w", sourceClass, ErrNotFound)
+	}
+	target := h.schemaReader.ReadOnlyClass(targetClass)
+	if target == nil {
+		return "", fmt.Errorf("class %q: %w", targetClass, ErrNotFound)
+	}
+	if !schema.MultiTenancyEnabled(source) || !schema.MultiTenancyEnabled(target) {
+		return "", fmt.Errorf("%w: classes %q and %q must both have multi-tenancy enabled",
+			clusterSchema.ErrBadRequest, sourceClass, targetClass)
+	}
+	if err := validateMigrationTarget(source, target); err != nil {
+		return "", err
+	}
+
+	physical, ok := h.schemaReader.CopyShardingState(sourceClass).Physical[tenant]
+	if !ok {
+		return "", fmt.Errorf("tenant %q of class %q: %w", tenant, sourceClass, ErrNotFound)
+	}
+	if status := physical.ActivityStatus(); status != models.TenantActivityStatusHOT {
+		return "", fmt.Errorf("%w: tenant %q of class %q is %s, only %s tenants can be migrated",
+			clusterSchema.ErrBadRequest, tenant, sourceClass, status, models.TenantActivityStatusHOT)
+	}
+	if !slices.Contains(physical.BelongsToNodes, h.clusterState.LocalName()) {
+		return "", fmt.Errorf("%w: tenant %q of class %q is not held by this node",
+			clusterSchema.ErrBadRequest, tenant, sourceClass)
+	}
+
+	if targetPhysical, ok := h.schemaReader.CopyShardingState(targetClass).Physical[tenant]; ok {
+		if status := targetPhysical.ActivityStatus(); status != models.TenantActivityStatusHOT {
+			return "", fmt.Errorf("%w: tenant %q of class %q is %s, only %s tenants can be migrated to",
+				clusterSchema.ErrBadRequest, tenant, targetClass, status, models.TenantActivityStatusHOT)
+		}
+	} else {
+		version, err := h.schemaManager.AddTenants(withActor(ctx, principal), targetClass, &api.AddTenantsRequest{
+			ClusterNodes: h.schemaManager.StorageCandidates(),
+			Tenants:      []*api.Tenant{{Name: tenant, Status: models.TenantActivityStatusHOT}},
+		})
+		if err != nil {
+			return "", fmt.Errorf("add tenant %q to class %q: %w", tenant, targetClass, err)
+		}
+		// the objects can only be copied once the local schema has the tenant
+		if err := h.WaitForSchemaConsistency(ctx, version); err != nil {
+			return "", err
+		}
+	}
+
+	job := TenantMigrationStatus{
+		ID:           uuid.NewString(),
+		SourceClass:  sourceClass,
+		TargetClass:  targetClass,
+		Tenant:       tenant,
+		DeleteSource: deleteSource,
+		Status:       TenantMigrationRunning,
+		StartedAt:    time.Now().UTC(),
+	}
+	h.tenantMigrations.Add(job.ID, job)
+	enterrors.GoWrapper(func() {
+		err := h.migrateTenant(context.Background(), principal, job)
+		if err != nil {
+			h.logEntry(ctx, sourceClass, tenant).WithField("action", "migrate_tenant").
+				WithField("target_class", targetClass).
+				WithField("job", job.ID).
+				WithError(err).Error("migrating tenant failed")
+		}
+		h.tenantMigrations.Finish(job.ID, finishTenantMigration(err))
+	}, h.logger)
+
+	return job.ID, nil
+}
+
+// validateMigrationTarget checks that every property of source exists in
+// target with the same data type
+func validateMigrationTarget(source, target *models.Class) error {
+	for _, prop := range source.Properties {
+		targetProp, err := schema.GetPropertyByName(target, prop.Name)
+		if err != nil {
+			return fmt.Errorf("%w: class %q has no property %q of class %q",
+				clusterSchema.ErrBadRequest, target.Class, prop.Name, source.Class)
+		}
+		if !slices.Equal(prop.DataType, targetProp.DataType) {
+			return fmt.Errorf("%w: property %q has data type %v in class %q but %v in class %q",
+				clusterSchema.ErrBadRequest, prop.Name, prop.DataType, source.Class, targetProp.DataType, target.Class)
+		}
+	}
+	return nil
+}
+
+func (h *Handler) migrateTenant(ctx context.Context, principal *models.Principal, job TenantMigrationStatus) error {
+	if !job.DeleteSource {
+		return h.copyTenantObjects(ctx, job)
+	}
+
+	version, err := h.schemaManager.UpdateShardStatus(withActor(ctx, principal), job.SourceClass, job.Tenant,
+		storagestate.StatusReadOnly.String())
+	if err != nil {
+		return fmt.Errorf("block writes to tenant %q: %w", job.Tenant, err)
+	}
+	err = h.waitForAllNodes(ctx, version, tenantMigrationFreezeTimeout)
+	if err != nil {
+		err = fmt.Errorf("block writes to tenant %q: %w", job.Tenant, err)
+	} else if err = h.copyTenantObjects(ctx, job); err == nil {
+		err = h.deleteMigratedTenant(ctx, principal, job)
+	}
+	if err != nil {
+		_, uerr := h.schemaManager.UpdateShardStatus(withActor(ctx, principal), job.SourceClass, job.Tenant,
+			storagestate.StatusReady.String())
+		if uerr != nil {
+			h.logEntry(ctx, job.SourceClass, job.Tenant).WithField("action", "migrate_tenant").
+				WithField("job", job.ID).WithError(uerr).
+				Error("cannot unblock writes to tenant")
+		}
+	}
+	return err
+}
+
+func (h *Handler) copyTenantObjects(ctx context.Context, job TenantMigrationStatus) error {
+	return h.dataMigrator.CopyTenantObjects(ctx, job.SourceClass, job.TargetClass, job.Tenant, func(copied int64) {
+		h.tenantMigrations.Update(job.ID, func(job *TenantMigrationStatus) { job.Copied = copied })
+	})
+}
+
+// deleteMigratedTenant deletes the source tenant of job once every replica
+// of it holds as many objects as were copied from the local one
+func (h *Handler) deleteMigratedTenant(ctx context.Context, principal *models.Principal, job TenantMigrationStatus) error {
+	progress, _ := h.tenantMigrations.Get(job.ID)
+	counts, err := h.dataMigrator.ReplicaObjectCounts(ctx, job.SourceClass)
+	if err != nil {
+		return fmt.Errorf("count objects of tenant %q: %w", job.Tenant, err)
+	}
+	physical := h.schemaReader.CopyShardingState(job.SourceClass).Physical[job.Tenant]
+	for _, node := range physical.BelongsToNodes {
+		count, ok := counts[job.Tenant][node]
+		if !ok || count != progress.Copied {
+			return fmt.Errorf("tenant %q has %d objects on node %q but %d were copied, the source tenant is kept",
+				job.Tenant, count, node, progress.Copied)
+		}
+	}
+	_, err = h.schemaManager.DeleteTenants(withActor(ctx, principal), job.SourceClass,
+		&api.DeleteTenantsRequest{Tenants: []string{job.Tenant}})
+	return err
+}
+
+// TenantMigrationStatus returns the tenant migration jobID
+func (h *Handler) TenantMigrationStatus(ctx context.Context, principal *models.Principal,
+	jobID string,
+) (TenantMigrationStatus, error) {
+	job, ok := h.tenantMigrations.Get(jobID)
+	if !ok {
+		return TenantMigrationStatus{}, fmt.Errorf("tenant migration %q: %w", jobID, ErrNotFound)
+	}
+	err := h.Authorizer.Authorize(principal, authorization.READ, authorization.ShardsMetadata(job.SourceClass, job.Tenant)...)
+	if err != nil {
+		return TenantMigrationStatus{}, err
+	}
+	return job, nil
+}
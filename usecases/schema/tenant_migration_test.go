@@ -0,0 +1,167 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/cluster/proto/api"
+	clusterSchema "github.com/weaviate/weaviate/cluster/schema"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/fakes"
+	"github.com/weaviate/weaviate/usecases/sharding"
+)
+
+func TestHandler_MigrateTenant(t *testing.T) {
+	ctx := context.Background()
+	mt := &models.MultiTenancyConfig{Enabled: true}
+	source := &models.Class{Class: "Source", MultiTenancyConfig: mt, Properties: []*models.Property{
+		{Name: "title", DataType: []string{"text"}},
+	}}
+	target := &models.Class{Class: "Target", MultiTenancyConfig: mt, Properties: []*models.Property{
+		{Name: "title", DataType: []string{"text"}},
+		{Name: "year", DataType: []string{"int"}},
+	}}
+	sourceState := &sharding.State{Physical: map[string]sharding.Physical{
+		"hot":    {Name: "hot", BelongsToNodes: []string{"node1"}, Status: models.TenantActivityStatusHOT},
+		"cold":   {Name: "cold", BelongsToNodes: []string{"node1"}, Status: models.TenantActivityStatusCOLD},
+		"remote": {Name: "remote", BelongsToNodes: []string{"node2"}, Status: models.TenantActivityStatusHOT},
+	}}
+
+	newHandler := func(t *testing.T, targetState *sharding.State) (*Handler, *fakeSchemaManager) {
+		handler, fakeSchemaManager := newTestHandler(t, &fakeDB{})
+		handler.clusterState = fakes.NewFakeClusterState("node1", "node2")
+		fakeSchemaManager.On("ReadOnlyClass", "Source").Return(source)
+		fakeSchemaManager.On("ReadOnlyClass", "Target").Return(target)
+		fakeSchemaManager.On("CopyShardingState", "Source").Return(sourceState)
+		fakeSchemaManager.On("CopyShardingState", "Target").Return(targetState)
+		return handler, fakeSchemaManager
+	}
+	waitForJob := func(t *testing.T, handler *Handler, id string) TenantMigrationStatus {
+		var job TenantMigrationStatus
+		require.Eventually(t, func() bool {
+			var err error
+			job, err = handler.TenantMigrationStatus(ctx, nil, id)
+			require.Nil(t, err)
+			return job.Status != TenantMigrationRunning
+		}, 5*time.Second, 10*time.Millisecond)
+		return job
+	}
+
+	t.Run("success", func(t *testing.T) {
+		handler, fakeSchemaManager := newHandler(t, &sharding.State{Physical: map[string]sharding.Physical{}})
+		fakeSchemaManager.On("AddTenants", "Target", &api.AddTenantsRequest{
+			ClusterNodes: []string{"node-1"},
+			Tenants:      []*api.Tenant{{Name: "hot", Status: models.TenantActivityStatusHOT}},
+		}).Return(nil)
+		fakeSchemaManager.On("UpdateShardStatus", "Source", "hot", "READONLY").Return(nil)
+		fakeSchemaManager.On("CopyTenantObjects", "Source", "Target", "hot").Return(int64(42), nil)
+		fakeSchemaManager.On("ReplicaObjectCounts", mock.Anything, "Source").
+			Return(map[string]map[string]int64{"hot": {"node1": 42}}, nil)
+		fakeSchemaManager.On("DeleteTenants", "Source", &api.DeleteTenantsRequest{Tenants: []string{"hot"}}).Return(nil)
+
+		id, err := handler.MigrateTenant(ctx, nil, "source", "Target", "hot", true)
+		require.Nil(t, err)
+		job := waitForJob(t, handler, id)
+		assert.Equal(t, TenantMigrationFinished, job.Status)
+		assert.Equal(t, int64(42), job.Copied)
+		assert.Equal(t, "Source", job.SourceClass)
+		fakeSchemaManager.AssertExpectations(t)
+	})
+
+	t.Run("keeps the source tenant", func(t *testing.T) {
+		handler, fakeSchemaManager := newHandler(t, &sharding.State{Physical: map[string]sharding.Physical{
+			"hot": {Name: "hot", Status: models.TenantActivityStatusHOT},
+		}})
+		fakeSchemaManager.On("CopyTenantObjects", "Source", "Target", "hot").Return(int64(1), nil)
+
+		id, err := handler.MigrateTenant(ctx, nil, "Source", "Target", "hot", false)
+		require.Nil(t, err)
+		job := waitForJob(t, handler, id)
+		assert.Equal(t, TenantMigrationFinished, job.Status)
+		fakeSchemaManager.AssertNotCalled(t, "AddTenants", mock.Anything, mock.Anything)
+		fakeSchemaManager.AssertNotCalled(t, "UpdateShardStatus", mock.Anything, mock.Anything, mock.Anything)
+		fakeSchemaManager.AssertNotCalled(t, "DeleteTenants", mock.Anything, mock.Anything)
+	})
+
+	t.Run("replica differs from the copied objects", func(t *testing.T) {
+		handler, fakeSchemaManager := newHandler(t, &sharding.State{Physical: map[string]sharding.Physical{
+			"hot": {Name: "hot", Status: models.TenantActivityStatusHOT},
+		}})
+		fakeSchemaManager.On("UpdateShardStatus", "Source", "hot", "READONLY").Return(nil)
+		fakeSchemaManager.On("CopyTenantObjects", "Source", "Target", "hot").Return(int64(42), nil)
+		fakeSchemaManager.On("ReplicaObjectCounts", mock.Anything, "Source").
+			Return(map[string]map[string]int64{"hot": {"node1": 43}}, nil)
+		fakeSchemaManager.On("UpdateShardStatus", "Source", "hot", "READY").Return(nil)
+
+		id, err := handler.MigrateTenant(ctx, nil, "Source", "Target", "hot", true)
+		require.Nil(t, err)
+		job := waitForJob(t, handler, id)
+		assert.Equal(t, TenantMigrationFailed, job.Status)
+		assert.Contains(t, job.Error, "source tenant is kept")
+		fakeSchemaManager.AssertNotCalled(t, "DeleteTenants", mock.Anything, mock.Anything)
+		fakeSchemaManager.AssertExpectations(t)
+	})
+
+	t.Run("copy fails", func(t *testing.T) {
+		handler, fakeSchemaManager := newHandler(t, &sharding.State{Physical: map[string]sharding.Physical{
+			"hot": {Name: "hot", Status: models.TenantActivityStatusHOT},
+		}})
+		fakeSchemaManager.On("UpdateShardStatus", "Source", "hot", "READONLY").Return(nil)
+		fakeSchemaManager.On("CopyTenantObjects", "Source", "Target", "hot").Return(int64(10), errors.New("disk full"))
+		fakeSchemaManager.On("UpdateShardStatus", "Source", "hot", "READY").Return(nil)
+
+		id, err := handler.MigrateTenant(ctx, nil, "Source", "Target", "hot", true)
+		require.Nil(t, err)
+		job := waitForJob(t, handler, id)
+		assert.Equal(t, TenantMigrationFailed, job.Status)
+		assert.Equal(t, "disk full", job.Error)
+		fakeSchemaManager.AssertNotCalled(t, "DeleteTenants", mock.Anything, mock.Anything)
+		fakeSchemaManager.AssertExpectations(t)
+	})
+
+	t.Run("invalid requests", func(t *testing.T) {
+		handler, fakeSchemaManager := newHandler(t, &sharding.State{Physical: map[string]sharding.Physical{
+			"cold": {Name: "cold", Status: models.TenantActivityStatusCOLD},
+		}})
+		fakeSchemaManager.On("ReadOnlyClass", "Unknown").Return(nil)
+		fakeSchemaManager.On("ReadOnlyClass", "Other").Return(&models.Class{
+			Class: "Other", MultiTenancyConfig: mt,
+			Properties: []*models.Property{{Name: "title", DataType: []string{"int"}}},
+		})
+
+		_, err := handler.MigrateTenant(ctx, nil, "Unknown", "Target", "hot", false)
+		assert.ErrorIs(t, err, ErrNotFound)
+		_, err = handler.MigrateTenant(ctx, nil, "Source", "Unknown", "hot", false)
+		assert.ErrorIs(t, err, ErrNotFound)
+		_, err = handler.MigrateTenant(ctx, nil, "Source", "Target", "missing", false)
+		assert.ErrorIs(t, err, ErrNotFound)
+		for _, tt := range []struct{ source, target, tenant string }{
+			{"Source", "Source", "hot"},    // same class
+			{"Target", "Source", "hot"},    // missing property
+			{"Source", "Other", "hot"},     // other data type
+			{"Source", "Target", "cold"},   // inactive source tenant
+			{"Source", "Target", "remote"}, // not on this node
+		} {
+			_, err = handler.MigrateTenant(ctx, nil, tt.source, tt.target, tt.tenant, false)
+			assert.ErrorIs(t, err, clusterSchema.ErrBadRequest, tt)
+		}
+		_, err = handler.TenantMigrationStatus(ctx, nil, "unknown-job")
+		assert.ErrorIs(t, err, ErrNotFound)
+	})
+}
@@ -0,0 +1,59 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"context"
+	"fmt"
+
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
+	"github.com/weaviate/weaviate/usecases/sharding"
+)
+
+// PrewarmTenant loads the index of tenant of class into the memory of every
+// node holding a replica of it and blocks until they are loaded, so that the
+// first query to a tenant which was just activated doesn't have to wait for
+// it. The tenant must be HOT, enterrors.ErrTenantNotActive is returned
+// otherwise.
+//
+// Class must exist and has partitioning enabled
+func (h *Handler) PrewarmTenant(ctx context.Context, principal *models.Principal, class, tenant string) error {
+	class = schema.UppercaseClassName(class)
+	if err := h.Authorizer.Authorize(principal, authorization.READ, authorization.ShardsMetadata(class, tenant)...); err != nil {
+		return err
+	}
+	if _, err := h.multiTenancy(class); err != nil {
+		return err
+	}
+
+	var physical sharding.Physical
+	err := h.schemaReader.Read(class, func(_ *models.Class, ss *sharding.State) error {
+		var ok bool
+		if physical, ok = ss.Physical[tenant]; !ok {
+			return fmt.Errorf("tenant %q: %w", tenant, ErrNotFound)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	if physical.Status != models.TenantActivityStatusHOT {
+		return fmt.Errorf("%w: tenant %q is %s", enterrors.ErrTenantNotActive, tenant, physical.Status)
+	}
+	if err := h.dataMigrator.PrewarmTenantIndex(ctx, class, tenant, physical.BelongsToNodes); err != nil {
+		return fmt.Errorf("prewarm tenant %q: %w", tenant, err)
+	}
+	return nil
+}
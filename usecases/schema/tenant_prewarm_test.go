@@ -0,0 +1,71 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	clusterSchema "github.com/weaviate/weaviate/cluster/schema"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/sharding"
+)
+
+func TestHandler_PrewarmTenant(t *testing.T) {
+	var (
+		ctx   = context.Background()
+		class = "MT"
+		ss    = &sharding.State{Physical: map[string]sharding.Physical{
+			"hot":  {Name: "hot", Status: models.TenantActivityStatusHOT, BelongsToNodes: []string{"node1", "node2"}},
+			"cold": {Name: "cold", Status: models.TenantActivityStatusCOLD, BelongsToNodes: []string{"node1"}},
+		}}
+	)
+	newHandler := func(t *testing.T) (*Handler, *fakeSchemaManager) {
+		handler, fakeSchemaManager := newTestHandler(t, &fakeDB{})
+		fakeSchemaManager.On("ClassInfo", class).Return(clusterSchema.ClassInfo{
+			Exists:       true,
+			MultiTenancy: models.MultiTenancyConfig{Enabled: true},
+		})
+		fakeSchemaManager.On("Read", class, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+			args.Get(1).(func(*models.Class, *sharding.State) error)(&models.Class{Class: class}, ss)
+		})
+		return handler, fakeSchemaManager
+	}
+
+	t.Run("active tenant", func(t *testing.T) {
+		handler, fakeSchemaManager := newHandler(t)
+		fakeSchemaManager.On("PrewarmTenantIndex", class, "hot", mock.Anything).Return(nil)
+
+		// the class name is uppercased and every replica is loaded
+		require.Nil(t, handler.PrewarmTenant(ctx, nil, "mT", "hot"))
+		fakeSchemaManager.AssertCalled(t, "PrewarmTenantIndex", class, "hot", []string{"node1", "node2"})
+	})
+
+	t.Run("loading fails", func(t *testing.T) {
+		handler, fakeSchemaManager := newHandler(t)
+		fakeSchemaManager.On("PrewarmTenantIndex", class, "hot", mock.Anything).Return(errors.New("memory pressure"))
+
+		assert.ErrorContains(t, handler.PrewarmTenant(ctx, nil, class, "hot"), "memory pressure")
+	})
+
+	t.Run("inactive tenant", func(t *testing.T) {
+		handler, fakeSchemaManager := newHandler(t)
+
+		assert.ErrorIs(t, handler.PrewarmTenant(ctx, nil, class, "cold"), enterrors.ErrTenantNotActive)
+		fakeSchemaManager.AssertNotCalled(t, "PrewarmTenantIndex", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
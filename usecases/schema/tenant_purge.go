@@ -0,0 +1,93 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
+)
+
+// ErrPurgeTimeout is returned by PurgeTenant if the data of the tenant is
+// not removed within Schema.PurgeTenantTimeout
+var ErrPurgeTimeout = errors.New("purge timeout")
+
+// purgePollInterval is how often PurgeTenant checks whether the data of the
+// tenant is removed
+var purgePollInterval = 100 * time.Millisecond
+
+// PurgeTenant deletes tenant of class and blocks until its data is removed
+// from the storage of every node holding it. Once it is, the purge is recorded in the
+// schema changelog as PURGE_TENANT. ErrPurgeTimeout is returned if the data
+// is not removed within Schema.PurgeTenantTimeout, the tenant stays deleted.
+func (h *Handler) PurgeTenant(ctx context.Context, principal *models.Principal, class, tenant string) error {
+	class = schema.UppercaseClassName(class)
+	if err := h.Authorizer.Authorize(principal, authorization.DELETE, authorization.ShardsMetadata(class, tenant)...); err != nil {
+		return err
+	}
+	if err := h.Authorizer.Authorize(principal, authorization.DELETE, authorization.CollectionsData(class)...); err != nil {
+		return err
+	}
+
+	// the replicas are only known while the tenant exists
+	var nodes []string
+	if ss := h.schemaReader.CopyShardingState(class); ss != nil {
+		nodes = ss.Physical[tenant].BelongsToNodes
+	}
+
+	if err := h.DeleteTenants(ctx, principal, class, []string{tenant}); err != nil {
+		return err
+	}
+
+	timeout := h.config.Schema.PurgeTenantTimeout
+	if timeout <= 0 {
+		timeout = time.Duration(60) * time.Second
+	}
+	if err := h.waitForTenantPurge(ctx, class, tenant, nodes, timeout); err != nil {
+		return err
+	}
+
+	if _, err := h.schemaManager.PurgeTenant(withActor(ctx, principal), class, tenant, time.Now()); err != nil {
+		return fmt.Errorf("record purge of tenant %q: %w", tenant, err)
+	}
+	return nil
+}
+
+func (h *Handler) waitForTenantPurge(ctx context.Context, class, tenant string, nodes []string, timeout time.Duration) error {
+	deadline := time.NewTimer(timeout)
+	defer deadline.Stop()
+	ticker := time.NewTicker(purgePollInterval)
+	defer ticker.Stop()
+
+	for {
+		purged, err := h.dataMigrator.TenantDataPurged(ctx, class, tenant, nodes)
+		if err != nil {
+			return fmt.Errorf("check data of tenant %q: %w", tenant, err)
+		}
+		if purged {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-deadline.C:
+			return fmt.Errorf("%w: data of tenant %q of class %q not removed after %s", ErrPurgeTimeout, tenant, class, timeout)
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,85 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/sharding"
+)
+
+func TestHandler_PurgeTenant(t *testing.T) {
+	ctx := context.Background()
+	purgePollInterval = time.Millisecond
+	nodes := []string{"node-1", "node-2"}
+	state := &sharding.State{Physical: map[string]sharding.Physical{
+		"T1": {Name: "T1", BelongsToNodes: nodes},
+	}}
+
+	t.Run("waits for the data to be removed", func(t *testing.T) {
+		handler, fakeSchemaManager := newTestHandler(t, &fakeDB{})
+		fakeSchemaManager.On("DeleteTenants", "C1", mock.Anything).Return(nil)
+		fakeSchemaManager.On("ReadOnlyClass", "C1").Return(&models.Class{Class: "C1"})
+		fakeSchemaManager.On("CopyShardingState", "C1").Return(state)
+		fakeSchemaManager.On("TenantDataPurged", "C1", "T1", nodes).Return(false, nil).Twice()
+		fakeSchemaManager.On("TenantDataPurged", "C1", "T1", nodes).Return(true, nil).Once()
+		fakeSchemaManager.On("PurgeTenant", "C1", "T1").Return(nil)
+
+		require.Nil(t, handler.PurgeTenant(ctx, nil, "C1", "T1"))
+		fakeSchemaManager.AssertNumberOfCalls(t, "TenantDataPurged", 3)
+		fakeSchemaManager.AssertCalled(t, "PurgeTenant", "C1", "T1")
+	})
+
+	t.Run("timeout", func(t *testing.T) {
+		handler, fakeSchemaManager := newTestHandler(t, &fakeDB{})
+		handler.config.Schema.PurgeTenantTimeout = 20 * time.Millisecond
+		fakeSchemaManager.On("DeleteTenants", "C1", mock.Anything).Return(nil)
+		fakeSchemaManager.On("ReadOnlyClass", "C1").Return(&models.Class{Class: "C1"})
+		fakeSchemaManager.On("CopyShardingState", "C1").Return(state)
+		fakeSchemaManager.On("TenantDataPurged", "C1", "T1", nodes).Return(false, nil)
+
+		err := handler.PurgeTenant(ctx, nil, "C1", "T1")
+		assert.ErrorIs(t, err, ErrPurgeTimeout)
+		fakeSchemaManager.AssertNotCalled(t, "PurgeTenant", mock.Anything, mock.Anything)
+	})
+
+	t.Run("deleting the tenant fails", func(t *testing.T) {
+		handler, fakeSchemaManager := newTestHandler(t, &fakeDB{})
+		fakeSchemaManager.On("DeleteTenants", "C1", mock.Anything).Return(errors.New("any error"))
+		fakeSchemaManager.On("ReadOnlyClass", "C1").Return(&models.Class{Class: "C1"})
+		fakeSchemaManager.On("CopyShardingState", "C1").Return(state)
+
+		require.NotNil(t, handler.PurgeTenant(ctx, nil, "C1", "T1"))
+		fakeSchemaManager.AssertNotCalled(t, "TenantDataPurged", mock.Anything, mock.Anything, mock.Anything)
+		fakeSchemaManager.AssertNotCalled(t, "PurgeTenant", mock.Anything, mock.Anything)
+	})
+
+	t.Run("checking the data fails", func(t *testing.T) {
+		handler, fakeSchemaManager := newTestHandler(t, &fakeDB{})
+		fakeSchemaManager.On("DeleteTenants", "C1", mock.Anything).Return(nil)
+		fakeSchemaManager.On("ReadOnlyClass", "C1").Return(&models.Class{Class: "C1"})
+		fakeSchemaManager.On("CopyShardingState", "C1").Return(state)
+		fakeSchemaManager.On("TenantDataPurged", "C1", "T1", nodes).Return(false, errors.New("disk error"))
+
+		err := handler.PurgeTenant(ctx, nil, "C1", "T1")
+		require.NotNil(t, err)
+		assert.NotErrorIs(t, err, ErrPurgeTimeout)
+		fakeSchemaManager.AssertNotCalled(t, "PurgeTenant", mock.Anything, mock.Anything)
+	})
+}
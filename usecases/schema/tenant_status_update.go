@@ -0,0 +1,151 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/weaviate/weaviate/cluster/proto/api"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
+	"github.com/weaviate/weaviate/usecases/sharding"
+)
+
+var (
+	// ErrInvalidTenantTransition is returned by UpdateTenantsStatus for
+	// tenants which can't change to the requested status right now
+	ErrInvalidTenantTransition = errors.New("invalid tenant status transition")
+	// ErrTenantQueriesInFlight is returned by UpdateTenantsStatus for active
+	// tenants which are still serving requests on this node. It is a best
+	// effort guard, see UpdateTenantsStatus.
+	ErrTenantQueriesInFlight = errors.New("tenant has requests in flight")
+)
+
+// TenantStatusUpdate is the status a tenant should change to. Status is an
+// activity status, e.g. ACTIVE or INACTIVE, or its old name, e.g. HOT.
+type TenantStatusUpdate struct {
+	Name   string
+	Status string
+}
+
+// UpdateTenantsStatus changes the activity status of the given tenants of
+// class in a single schema change, either all tenants change or none does.
+// Tenants already in the requested status are left as they are.
+//
+// Each tenant must exist and must not be moving from or to the cloud at the
+// moment. Tenants are only deactivated or offloaded if they aren't serving
+// any requests on this node. This is a best effort check against obvious
+// mistakes rather than a drain: the requests on other replicas aren't
+// counted, and requests starting after the check still reach the shard until
+// it is unloaded. The error joins the errors of all tenants which can't
+// change, each starting with the name of the tenant.
+//
+// Class must exist and has partitioning enabled
+func (h *Handler) UpdateTenantsStatus(ctx context.Context, principal *models.Principal,
+	class string, updates []TenantStatusUpdate,
+) error {
+	defer h.metrics.track(opUpdateTenants)()
+
+	class = schema.UppercaseClassName(class)
+	if err := h.Authorizer.Authorize(principal, authorization.UPDATE, authorization.ShardsMetadata(class)...); err != nil {
+		return err
+	}
+	if _, err := h.multiTenancy(class); err != nil {
+		return err
+	}
+
+	tenants := make([]*models.Tenant, len(updates))
+	for i, u := range updates {
+		tenants[i] = &models.Tenant{Name: u.Name, ActivityStatus: u.Status}
+	}
+	if _, err := validateTenants(tenants, false); err != nil {
+		return err
+	}
+
+	physicals := make(map[string]sharding.Physical, len(tenants))
+	err := h.schemaReader.Read(class, func(_ *models.Class, ss *sharding.State) error {
+		for _, tenant := range tenants {
+			if physical, ok := ss.Physical[tenant.Name]; ok {
+				physicals[tenant.Name] = physical
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	req := api.UpdateTenantsRequest{ClusterNodes: h.schemaManager.StorageCandidates()}
+	for _, tenant := range tenants {
+		physical, ok := physicals[tenant.Name]
+		if !ok {
+			errs = append(errs, fmt.Errorf("tenant %q: %w", tenant.Name, ErrNotFound))
+			continue
+		}
+		if err := h.validateTenantTransition(ctx, class, tenant, physical.Status); err != nil {
+			errs = append(errs, fmt.Errorf("tenant %q: %w", tenant.Name, err))
+			continue
+		}
+		if tenant.ActivityStatus != physical.Status {
+			req.Tenants = append(req.Tenants, &api.Tenant{Name: tenant.Name, Status: tenant.ActivityStatus})
+		}
+	}
+	if len(errs) > 0 {
+		return errors.Join(errs...)
+	}
+	if len(req.Tenants) == 0 {
+		return nil
+	}
+
+	h.logEntry(ctx, class, "").WithField("tenants", req.Tenants).Debug("update tenants status")
+	if _, err := h.schemaManager.UpdateTenants(withActor(ctx, principal), class, &req); err != nil {
+		return err
+	}
+
+	names := make([]string, len(req.Tenants))
+	for i, tenant := range req.Tenants {
+		names[i] = tenant.Name
+	}
+	h.notify(ctx, func(l EventListener) { l.OnTenantsUpdated(class, names) })
+	return nil
+}
+
+// validateTenantTransition validates that tenant can change from status to
+// its activity status, which is converted to its old name
+func (h *Handler) validateTenantTransition(ctx context.Context, class string, tenant *models.Tenant, status string) error {
+	if err := h.validateActivityStatuses(ctx, []*models.Tenant{tenant}, false, true); err != nil {
+		return err
+	}
+
+	switch {
+	case tenant.ActivityStatus == status:
+		return nil
+	case status == models.TenantActivityStatusFREEZING || status == models.TenantActivityStatusUNFREEZING:
+		return fmt.Errorf("%w: tenant is %s, wait for it to finish", ErrInvalidTenantTransition, status)
+	case status == models.TenantActivityStatusHOT:
+		// deactivating waits for running requests only for a while, the
+		// tenant has to be drained first. Only the requests on this node are
+		// counted.
+		inFlight, err := h.schemaReader.TenantQueriesInFlight(class, tenant.Name)
+		if err != nil {
+			return fmt.Errorf("requests in flight: %w", err)
+		}
+		if inFlight > 0 {
+			return fmt.Errorf("%w: %d requests, can't change to %s", ErrTenantQueriesInFlight, inFlight, tenant.ActivityStatus)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,114 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/cluster/proto/api"
+	clusterSchema "github.com/weaviate/weaviate/cluster/schema"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/sharding"
+)
+
+func TestHandler_UpdateTenantsStatus(t *testing.T) {
+	var (
+		ctx   = context.Background()
+		class = "MT"
+		ss    = &sharding.State{Physical: map[string]sharding.Physical{
+			"hot":      {Name: "hot", Status: models.TenantActivityStatusHOT},
+			"busy":     {Name: "busy", Status: models.TenantActivityStatusHOT},
+			"cold":     {Name: "cold", Status: models.TenantActivityStatusCOLD},
+			"freezing": {Name: "freezing", Status: models.TenantActivityStatusFREEZING},
+		}}
+	)
+	newHandler := func(t *testing.T) (*Handler, *fakeSchemaManager) {
+		handler, fakeSchemaManager := newTestHandler(t, &fakeDB{})
+		fakeSchemaManager.On("ClassInfo", class).Return(clusterSchema.ClassInfo{
+			Exists:       true,
+			MultiTenancy: models.MultiTenancyConfig{Enabled: true},
+		})
+		fakeSchemaManager.On("Read", class, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+			args.Get(1).(func(*models.Class, *sharding.State) error)(&models.Class{Class: class}, ss)
+		})
+		fakeSchemaManager.On("TenantQueriesInFlight", class, "hot").Return(int64(0), nil)
+		fakeSchemaManager.On("TenantQueriesInFlight", class, "busy").Return(int64(3), nil)
+		return handler, fakeSchemaManager
+	}
+
+	t.Run("changes all tenants in one request", func(t *testing.T) {
+		handler, fakeSchemaManager := newHandler(t)
+		fakeSchemaManager.On("UpdateTenants", class, mock.Anything).Return(nil)
+
+		// the class name is uppercased
+		err := handler.UpdateTenantsStatus(ctx, nil, "mT", []TenantStatusUpdate{
+			{Name: "hot", Status: models.TenantActivityStatusINACTIVE},
+			{Name: "cold", Status: models.TenantActivityStatusACTIVE},
+		})
+		require.Nil(t, err)
+		fakeSchemaManager.AssertCalled(t, "UpdateTenants", class, &api.UpdateTenantsRequest{
+			Tenants: []*api.Tenant{
+				{Name: "hot", Status: models.TenantActivityStatusCOLD},
+				{Name: "cold", Status: models.TenantActivityStatusHOT},
+			},
+			ClusterNodes: []string{"node-1"},
+		})
+	})
+
+	t.Run("unchanged tenants are skipped", func(t *testing.T) {
+		handler, fakeSchemaManager := newHandler(t)
+
+		err := handler.UpdateTenantsStatus(ctx, nil, class, []TenantStatusUpdate{
+			{Name: "cold", Status: models.TenantActivityStatusINACTIVE},
+			{Name: "busy", Status: models.TenantActivityStatusHOT},
+		})
+		require.Nil(t, err)
+		fakeSchemaManager.AssertNotCalled(t, "UpdateTenants", mock.Anything, mock.Anything)
+	})
+
+	t.Run("errors of all tenants", func(t *testing.T) {
+		handler, fakeSchemaManager := newHandler(t)
+
+		err := handler.UpdateTenantsStatus(ctx, nil, class, []TenantStatusUpdate{
+			{Name: "hot", Status: models.TenantActivityStatusINACTIVE},
+			{Name: "busy", Status: models.TenantActivityStatusINACTIVE},
+			{Name: "freezing", Status: models.TenantActivityStatusACTIVE},
+			{Name: "unknown", Status: models.TenantActivityStatusACTIVE},
+			{Name: "cold", Status: "WARM"},
+		})
+		assert.ErrorIs(t, err, ErrTenantQueriesInFlight)
+		assert.ErrorIs(t, err, ErrInvalidTenantTransition)
+		assert.ErrorIs(t, err, ErrNotFound)
+		assert.ErrorContains(t, err, `tenant "busy"`)
+		assert.ErrorContains(t, err, `tenant "freezing"`)
+		assert.ErrorContains(t, err, `tenant "unknown"`)
+		assert.ErrorContains(t, err, `invalid activity status 'WARM'`)
+		assert.NotContains(t, err.Error(), `tenant "hot"`)
+		// no tenant changes if one can't
+		fakeSchemaManager.AssertNotCalled(t, "UpdateTenants", mock.Anything, mock.Anything)
+	})
+
+	t.Run("duplicate tenants", func(t *testing.T) {
+		handler, fakeSchemaManager := newHandler(t)
+
+		err := handler.UpdateTenantsStatus(ctx, nil, class, []TenantStatusUpdate{
+			{Name: "hot", Status: models.TenantActivityStatusINACTIVE},
+			{Name: "hot", Status: models.TenantActivityStatusACTIVE},
+		})
+		assert.ErrorContains(t, err, "existed multiple times")
+		fakeSchemaManager.AssertNotCalled(t, "UpdateTenants", mock.Anything, mock.Anything)
+	})
+}
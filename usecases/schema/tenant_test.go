@@ -14,12 +14,15 @@ package schema
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
+	clusterSchema "github.com/weaviate/weaviate/cluster/schema"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/usecases/sharding"
 )
 
 func TestAddTenants(t *testing.T) {
@@ -72,6 +75,7 @@ func TestAddTenants(t *testing.T) {
 			errMsgs: nil,
 			mockCalls: func(fakeSchemaManager *fakeSchemaManager) {
 				// MT validation is done leader side now
+				fakeSchemaManager.On("ClassInfo", mock.Anything).Return(clusterSchema.ClassInfo{})
 				fakeSchemaManager.On("AddTenants", mock.Anything, mock.Anything).Return(nil)
 			},
 		},
@@ -82,6 +86,7 @@ func TestAddTenants(t *testing.T) {
 			errMsgs: nil,
 			mockCalls: func(fakeSchemaManager *fakeSchemaManager) {
 				// MT validation is done leader side now
+				fakeSchemaManager.On("ClassInfo", mock.Anything).Return(clusterSchema.ClassInfo{})
 				fakeSchemaManager.On("AddTenants", mock.Anything, mock.Anything).Return(nil)
 			},
 		},
@@ -91,6 +96,7 @@ func TestAddTenants(t *testing.T) {
 			tenants: tenants,
 			errMsgs: nil,
 			mockCalls: func(fakeSchemaManager *fakeSchemaManager) {
+				fakeSchemaManager.On("ClassInfo", mock.Anything).Return(clusterSchema.ClassInfo{})
 				fakeSchemaManager.On("AddTenants", mock.Anything, mock.Anything).Return(nil)
 			},
 		},
@@ -130,6 +136,7 @@ func TestAddTenants(t *testing.T) {
 			},
 			errMsgs: []string{},
 			mockCalls: func(fakeSchemaManager *fakeSchemaManager) {
+				fakeSchemaManager.On("ClassInfo", mock.Anything).Return(clusterSchema.ClassInfo{})
 				fakeSchemaManager.On("AddTenants", mock.Anything, mock.Anything).Return(nil)
 			},
 		},
@@ -159,6 +166,82 @@ func TestAddTenants(t *testing.T) {
 	}
 }
 
+func TestAddTenants_MaxTenants(t *testing.T) {
+	ctx := context.Background()
+	tenants := []*models.Tenant{{Name: "T3"}, {Name: "T4"}}
+	existing := &sharding.State{Physical: map[string]sharding.Physical{"T1": {}, "T2": {}}}
+
+	tests := []struct {
+		name        string
+		globalLimit int
+		classLimit  int64
+		tenants     []*models.Tenant
+		expectedErr error
+	}{
+		{
+			name:        "global limit exceeded",
+			globalLimit: 3,
+			tenants:     tenants,
+			expectedErr: ErrMaxTenantsExceeded{Class: "C", Current: 4, Max: 3},
+		},
+		{
+			name:        "exactly at global limit",
+			globalLimit: 4,
+			tenants:     tenants,
+		},
+		{
+			name:        "class limit takes precedence",
+			globalLimit: 10,
+			classLimit:  3,
+			tenants:     tenants,
+			expectedErr: ErrMaxTenantsExceeded{Class: "C", Current: 4, Max: 3},
+		},
+		{
+			name:        "class limit above global limit",
+			globalLimit: 3,
+			classLimit:  4,
+			tenants:     tenants,
+		},
+		{
+			name:        "existing tenants are not counted twice",
+			globalLimit: 3,
+			tenants:     []*models.Tenant{{Name: "T2"}, {Name: "T3"}},
+		},
+		{
+			name:    "unlimited",
+			tenants: tenants,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			handler, fakeSchemaManager := newTestHandler(t, &fakeDB{})
+			handler.config.Schema.MaxTenantsPerClass = tt.globalLimit
+
+			fakeSchemaManager.On("ClassInfo", "C").Return(clusterSchema.ClassInfo{
+				Exists:       true,
+				MultiTenancy: models.MultiTenancyConfig{Enabled: true, MaxTenants: tt.classLimit},
+				Tenants:      len(existing.Physical),
+			})
+			fakeSchemaManager.On("Read", "C", mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+				args.Get(1).(func(*models.Class, *sharding.State) error)(nil, existing)
+			}).Maybe()
+			if tt.expectedErr == nil {
+				fakeSchemaManager.On("AddTenants", mock.Anything, mock.Anything).Return(nil)
+			}
+
+			_, err := handler.AddTenants(ctx, nil, "C", tt.tenants)
+			if tt.expectedErr != nil {
+				var limitErr ErrMaxTenantsExceeded
+				require.ErrorAs(t, err, &limitErr)
+				assert.Equal(t, tt.expectedErr, limitErr)
+			} else {
+				require.NoError(t, err)
+			}
+			fakeSchemaManager.AssertExpectations(t)
+		})
+	}
+}
+
 func TestUpdateTenants(t *testing.T) {
 	var (
 		ctx     = context.Background()
@@ -384,6 +467,7 @@ func TestDeleteTenants(t *testing.T) {
 			errMsgs:         nil,
 			expectedTenants: tenants,
 			mockCalls: func(fakeSchemaManager *fakeSchemaManager) {
+				fakeSchemaManager.On("ReadOnlyClass", mock.Anything).Return(nil)
 				fakeSchemaManager.On("DeleteTenants", mock.Anything, mock.Anything).Return(nil)
 			},
 		},
@@ -394,6 +478,7 @@ func TestDeleteTenants(t *testing.T) {
 			errMsgs:         nil,
 			expectedTenants: tenants,
 			mockCalls: func(fakeSchemaManager *fakeSchemaManager) {
+				fakeSchemaManager.On("ReadOnlyClass", mock.Anything).Return(nil)
 				fakeSchemaManager.On("DeleteTenants", mock.Anything, mock.Anything).Return(nil)
 			},
 		},
@@ -404,6 +489,7 @@ func TestDeleteTenants(t *testing.T) {
 			errMsgs:         nil,
 			expectedTenants: tenants,
 			mockCalls: func(fakeSchemaManager *fakeSchemaManager) {
+				fakeSchemaManager.On("ReadOnlyClass", mock.Anything).Return(nil)
 				fakeSchemaManager.On("DeleteTenants", mock.Anything, mock.Anything).Return(nil)
 			},
 		},
@@ -426,9 +512,27 @@ func TestDeleteTenants(t *testing.T) {
 			errMsgs:         []string{},
 			expectedTenants: tenants[2:],
 			mockCalls: func(fakeSchemaManager *fakeSchemaManager) {
+				fakeSchemaManager.On("ReadOnlyClass", mock.Anything).Return(nil)
 				fakeSchemaManager.On("DeleteTenants", mock.Anything, mock.Anything).Return(nil)
 			},
 		},
+		{
+			name:            "ReleasesObjectsOfLimitedClass",
+			class:           mtEnabledClass.Class,
+			tenants:         tenants[:2],
+			errMsgs:         []string{},
+			expectedTenants: tenants[2:],
+			mockCalls: func(fakeSchemaManager *fakeSchemaManager) {
+				fakeSchemaManager.On("ReadOnlyClass", mtEnabledClass.Class).Return(&models.Class{Class: mtEnabledClass.Class, MaxObjects: 10})
+				fakeSchemaManager.On("ReplicaObjectCounts", mock.Anything, mtEnabledClass.Class).Return(map[string]map[string]int64{
+					tenants[0].Name: {"node-1": 2, "node-2": 3},
+					tenants[1].Name: {"node-1": 1},
+					tenants[2].Name: {"node-1": 4},
+				}, nil)
+				fakeSchemaManager.On("DeleteTenants", mock.Anything, mock.Anything).Return(nil)
+				fakeSchemaManager.On("ReserveClassObjects", mtEnabledClass.Class, int64(-4)).Return(nil).Once()
+			},
+		},
 	}
 
 	for _, test := range tests {
@@ -443,6 +547,7 @@ func TestDeleteTenants(t *testing.T) {
 			}
 
 			err := handler.DeleteTenants(ctx, nil, test.class, tenantNames)
+			fakeSchemaManager.AssertExpectations(t)
 			if len(test.errMsgs) == 0 {
 				require.NoError(t, err)
 			} else {
@@ -453,3 +558,68 @@ func TestDeleteTenants(t *testing.T) {
 		})
 	}
 }
+
+func TestGetTenantStatus(t *testing.T) {
+	var (
+		ctx          = context.Background()
+		class        = "MT"
+		lastActivity = time.UnixMilli(1700000000000)
+		ss           = &sharding.State{Physical: map[string]sharding.Physical{
+			"hot":    {Name: "hot", Status: models.TenantActivityStatusHOT, BelongsToNodes: []string{"node1"}},
+			"remote": {Name: "remote", Status: models.TenantActivityStatusHOT, BelongsToNodes: []string{"node2"}},
+			"cold":   {Name: "cold", Status: models.TenantActivityStatusCOLD, BelongsToNodes: []string{"node1"}},
+		}}
+	)
+	newHandler := func(t *testing.T) (*Handler, *fakeSchemaManager) {
+		handler, fakeSchemaManager := newTestHandler(t, &fakeDB{})
+		fakeSchemaManager.On("ClassInfo", class).Return(clusterSchema.ClassInfo{
+			Exists:       true,
+			MultiTenancy: models.MultiTenancyConfig{Enabled: true},
+		})
+		fakeSchemaManager.On("Read", class, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+			args.Get(1).(func(*models.Class, *sharding.State) error)(&models.Class{Class: class}, ss)
+		})
+		return handler, fakeSchemaManager
+	}
+
+	t.Run("active local tenant", func(t *testing.T) {
+		handler, fakeSchemaManager := newHandler(t)
+		fakeSchemaManager.On("ShardObjectCount", class, "hot").Return(int64(42), nil)
+		fakeSchemaManager.On("TenantLastActivity", class, "hot").Return(lastActivity, nil)
+
+		status, err := handler.GetTenantStatus(ctx, nil, class, "hot")
+		require.Nil(t, err)
+		assert.Equal(t, &TenantStatus{
+			Name:             "hot",
+			Shard:            "hot",
+			Status:           models.TenantActivityStatusHOT,
+			ObjectCount:      42,
+			LastActivityTime: lastActivity,
+		}, status)
+	})
+
+	t.Run("inactive and remote tenants are not counted", func(t *testing.T) {
+		handler, fakeSchemaManager := newHandler(t)
+		fakeSchemaManager.On("TenantLastActivity", class, mock.Anything).Return(time.Time{}, nil)
+
+		status, err := handler.GetTenantStatus(ctx, nil, class, "cold")
+		require.Nil(t, err)
+		assert.Equal(t, models.TenantActivityStatusCOLD, status.Status)
+		assert.Zero(t, status.ObjectCount)
+
+		status, err = handler.GetTenantStatus(ctx, nil, class, "remote")
+		require.Nil(t, err)
+		assert.Equal(t, models.TenantActivityStatusHOT, status.Status)
+		assert.Zero(t, status.ObjectCount)
+		fakeSchemaManager.AssertNotCalled(t, "ShardObjectCount", mock.Anything, mock.Anything)
+	})
+
+	t.Run("unknown tenant", func(t *testing.T) {
+		handler, fakeSchemaManager := newHandler(t)
+		fakeSchemaManager.ExpectedCalls = fakeSchemaManager.ExpectedCalls[:1]
+		fakeSchemaManager.On("Read", class, mock.Anything).Return(ErrNotFound)
+
+		_, err := handler.GetTenantStatus(ctx, nil, class, "unknown")
+		assert.ErrorIs(t, err, ErrNotFound)
+	})
+}
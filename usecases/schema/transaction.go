@@ -0,0 +1,194 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	command "github.com/weaviate/weaviate/cluster/proto/api"
+	clusterSchema "github.com/weaviate/weaviate/cluster/schema"
+	clusterTypes "github.com/weaviate/weaviate/cluster/types"
+	"github.com/weaviate/weaviate/entities/models"
+	schemaConfig "github.com/weaviate/weaviate/entities/schema/config"
+	"github.com/weaviate/weaviate/usecases/sharding"
+)
+
+// Txn buffers schema changes and applies them all at once on Commit.
+// For details see [github.com/weaviate/weaviate/cluster/types.Txn].
+type Txn = clusterTypes.Txn
+
+// SchemaTransaction starts transactions to apply several schema changes
+// atomically
+type SchemaTransaction interface {
+	Begin() (Txn, error)
+}
+
+// txnSchemaReader is implemented by transactions which can read the schema
+// with their buffered changes applied
+type txnSchemaReader interface {
+	SchemaReader() clusterSchema.SchemaReader
+}
+
+// txnVersion is implemented by transactions which report the schema version
+// of their commit
+type txnVersion interface {
+	Version() uint64
+}
+
+// TxnHandler has the API of Handler, but the schema changes made through it
+// are part of the transaction of Handler.WithTransaction
+type TxnHandler struct {
+	*Handler
+}
+
+// WithTransaction calls fn with a TxnHandler and applies the schema changes
+// made through it together once fn returns. If fn returns an error the
+// changes are rolled back, if the commit fails none of them is applied.
+//
+// Within fn reads see the schema as it was when the transaction began with
+// the changes made through the TxnHandler applied, e.g. a property can be
+// added to a class created in the same transaction. Changes which don't apply
+// fail right away.
+func (h *Handler) WithTransaction(ctx context.Context, principal *models.Principal, fn func(TxnHandler) error) error {
+	_, err := h.withTransaction(ctx, principal, fn)
+	return err
+}
+
+// withTransaction is WithTransaction returning the schema version of the
+// commit
+func (h *Handler) withTransaction(ctx context.Context, principal *models.Principal, fn func(TxnHandler) error) (uint64, error) {
+	txn, err := h.schemaManager.Begin()
+	if err != nil {
+		return 0, fmt.Errorf("begin transaction: %w", err)
+	}
+
+	th := *h
+	th.schemaManager = txnSchemaManager{SchemaManager: h.schemaManager, txn: txn}
+	if r, ok := txn.(txnSchemaReader); ok {
+		th.schemaReader = r.SchemaReader()
+	}
+	var events []func(EventListener)
+	th.pendingEvents = &events
+	err = fn(TxnHandler{Handler: &th})
+	if err == nil {
+		err = ctx.Err()
+	}
+	if err != nil {
+		if rerr := txn.Rollback(); rerr != nil {
+			h.logEntry(ctx, "", "").WithField("action", "schema_transaction").WithError(rerr).Warn("roll back transaction")
+		}
+		return 0, err
+	}
+
+	if err := txn.Commit(); err != nil {
+		return 0, fmt.Errorf("commit transaction: %w", err)
+	}
+	var version uint64
+	if v, ok := txn.(txnVersion); ok {
+		version = v.Version()
+	}
+	for _, event := range events {
+		h.notify(ctx, event)
+	}
+	if principal != nil {
+		h.logEntry(ctx, "", "").WithField("action", "schema_transaction").WithField("user", principal.Username).
+			Debug("committed transaction")
+	}
+	return version, nil
+}
+
+// txnSchemaManager sends the schema changes of a TxnHandler to its
+// transaction and everything else to the SchemaManager it was started from
+type txnSchemaManager struct {
+	SchemaManager
+	txn Txn
+}
+
+func (m txnSchemaManager) Begin() (Txn, error) {
+	return nil, fmt.Errorf("%w: nested transactions are not supported", clusterSchema.ErrBadRequest)
+}
+
+func (m txnSchemaManager) AddClass(ctx context.Context, cls *models.Class, ss *sharding.State) (uint64, error) {
+	return m.txn.AddClass(ctx, cls, ss)
+}
+
+func (m txnSchemaManager) RestoreClass(ctx context.Context, cls *models.Class, ss *sharding.State) (uint64, error) {
+	return 0, fmt.Errorf("%w: classes can't be restored in a transaction", clusterSchema.ErrBadRequest)
+}
+
+func (m txnSchemaManager) PurgeTenant(ctx context.Context, class, tenant string, purgedAt time.Time) (uint64, error) {
+	return 0, fmt.Errorf("%w: tenants can't be purged in a transaction", clusterSchema.ErrBadRequest)
+}
+
+func (m txnSchemaManager) SoftDeleteClass(ctx context.Context, name string, retainUntil time.Time) (uint64, error) {
+	return 0, fmt.Errorf("%w: classes can't be soft deleted in a transaction", clusterSchema.ErrBadRequest)
+}
+
+func (m txnSchemaManager) RestoreDeletedClass(ctx context.Context, name string) (uint64, error) {
+	return 0, fmt.Errorf("%w: classes can't be restored in a transaction", clusterSchema.ErrBadRequest)
+}
+
+func (m txnSchemaManager) PurgeDeletedClass(ctx context.Context, name string) (uint64, error) {
+	return 0, fmt.Errorf("%w: classes can't be purged in a transaction", clusterSchema.ErrBadRequest)
+}
+
+func (m txnSchemaManager) ReserveClassObjects(ctx context.Context, class string, count int64) (uint64, error) {
+	return 0, fmt.Errorf("%w: objects can't be reserved in a transaction", clusterSchema.ErrBadRequest)
+}
+
+func (m txnSchemaManager) SetClassObjectCount(ctx context.Context, class string, count int64) (uint64, error) {
+	return 0, fmt.Errorf("%w: object counts can't be set in a transaction", clusterSchema.ErrBadRequest)
+}
+
+func (m txnSchemaManager) RecordBatchDelete(ctx context.Context, req *command.RecordBatchDeleteRequest) (uint64, error) {
+	return 0, fmt.Errorf("%w: batch deletes can't be recorded in a transaction", clusterSchema.ErrBadRequest)
+}
+
+func (m txnSchemaManager) UpdateClass(ctx context.Context, cls *models.Class, ss *sharding.State) (uint64, error) {
+	return m.txn.UpdateClass(ctx, cls, ss)
+}
+
+func (m txnSchemaManager) UpdateVectorIndexConfig(ctx context.Context, class string,
+	configs map[string]schemaConfig.VectorIndexConfig,
+) (uint64, error) {
+	return m.txn.UpdateVectorIndexConfig(ctx, class, configs)
+}
+
+func (m txnSchemaManager) DeleteClass(ctx context.Context, name string) (uint64, error) {
+	return m.txn.DeleteClass(ctx, name)
+}
+
+func (m txnSchemaManager) AddProperty(ctx context.Context, class string, p ...*models.Property) (uint64, error) {
+	return m.txn.AddProperty(ctx, class, p...)
+}
+
+func (m txnSchemaManager) UpdateShardStatus(ctx context.Context, class, shard, status string) (uint64, error) {
+	return m.txn.UpdateShardStatus(ctx, class, shard, status)
+}
+
+func (m txnSchemaManager) MoveShard(ctx context.Context, class, shard, fromNode, toNode string) (uint64, error) {
+	return m.txn.MoveShard(ctx, class, shard, fromNode, toNode)
+}
+
+func (m txnSchemaManager) AddTenants(ctx context.Context, class string, req *command.AddTenantsRequest) (uint64, error) {
+	return m.txn.AddTenants(ctx, class, req)
+}
+
+func (m txnSchemaManager) UpdateTenants(ctx context.Context, class string, req *command.UpdateTenantsRequest) (uint64, error) {
+	return m.txn.UpdateTenants(ctx, class, req)
+}
+
+func (m txnSchemaManager) DeleteTenants(ctx context.Context, class string, req *command.DeleteTenantsRequest) (uint64, error) {
+	return m.txn.DeleteTenants(ctx, class, req)
+}
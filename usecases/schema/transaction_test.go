@@ -0,0 +1,121 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	clusterSchema "github.com/weaviate/weaviate/cluster/schema"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+func Test_WithTransaction(t *testing.T) {
+	ctx := context.Background()
+	principal := &models.Principal{Username: "admin"}
+	errAny := errors.New("any error")
+
+	t.Run("commits the changes", func(t *testing.T) {
+		handler, fakeSchemaManager := newTestHandler(t, &fakeDB{})
+		txn := &fakeTxn{}
+		fakeSchemaManager.On("Begin").Return(txn, nil)
+		txn.On("DeleteClass", "C1").Return(nil)
+		txn.On("DeleteClass", "C2").Return(nil)
+		txn.On("Commit").Return(nil)
+
+		err := handler.WithTransaction(ctx, principal, func(th TxnHandler) error {
+			if _, err := th.DeleteClass(ctx, principal, "c1"); err != nil {
+				return err
+			}
+			_, err := th.DeleteClass(ctx, principal, "C2")
+			return err
+		})
+		require.Nil(t, err)
+		txn.AssertExpectations(t)
+		fakeSchemaManager.AssertExpectations(t)
+		fakeSchemaManager.AssertNotCalled(t, "DeleteClass", "C1")
+	})
+
+	t.Run("rolls back on error", func(t *testing.T) {
+		handler, fakeSchemaManager := newTestHandler(t, &fakeDB{})
+		txn := &fakeTxn{}
+		fakeSchemaManager.On("Begin").Return(txn, nil)
+		txn.On("DeleteClass", "C1").Return(nil)
+		txn.On("Rollback").Return(nil)
+
+		err := handler.WithTransaction(ctx, principal, func(th TxnHandler) error {
+			if _, err := th.DeleteClass(ctx, principal, "C1"); err != nil {
+				return err
+			}
+			return errAny
+		})
+		assert.ErrorIs(t, err, errAny)
+		txn.AssertExpectations(t)
+		txn.AssertNotCalled(t, "Commit")
+	})
+
+	t.Run("rolls back if the context is done", func(t *testing.T) {
+		handler, fakeSchemaManager := newTestHandler(t, &fakeDB{})
+		txn := &fakeTxn{}
+		fakeSchemaManager.On("Begin").Return(txn, nil)
+		txn.On("Rollback").Return(nil)
+
+		cctx, cancel := context.WithCancel(ctx)
+		cancel()
+		err := handler.WithTransaction(cctx, principal, func(th TxnHandler) error { return nil })
+		assert.ErrorIs(t, err, context.Canceled)
+		txn.AssertExpectations(t)
+	})
+
+	t.Run("commit fails", func(t *testing.T) {
+		handler, fakeSchemaManager := newTestHandler(t, &fakeDB{})
+		txn := &fakeTxn{}
+		fakeSchemaManager.On("Begin").Return(txn, nil)
+		txn.On("DeleteClass", "C1").Return(nil)
+		txn.On("Commit").Return(errAny)
+
+		err := handler.WithTransaction(ctx, principal, func(th TxnHandler) error {
+			_, err := th.DeleteClass(ctx, principal, "C1")
+			return err
+		})
+		assert.ErrorIs(t, err, errAny)
+	})
+
+	t.Run("begin fails", func(t *testing.T) {
+		handler, fakeSchemaManager := newTestHandler(t, &fakeDB{})
+		fakeSchemaManager.On("Begin").Return(nil, errAny)
+
+		called := false
+		err := handler.WithTransaction(ctx, principal, func(th TxnHandler) error {
+			called = true
+			return nil
+		})
+		assert.ErrorIs(t, err, errAny)
+		assert.False(t, called)
+	})
+
+	t.Run("no nested transactions", func(t *testing.T) {
+		handler, fakeSchemaManager := newTestHandler(t, &fakeDB{})
+		txn := &fakeTxn{}
+		fakeSchemaManager.On("Begin").Return(txn, nil)
+		txn.On("Rollback").Return(nil)
+
+		err := handler.WithTransaction(ctx, principal, func(th TxnHandler) error {
+			return th.WithTransaction(ctx, principal, func(TxnHandler) error { return nil })
+		})
+		assert.ErrorIs(t, err, clusterSchema.ErrBadRequest)
+		txn.AssertExpectations(t)
+	})
+}
@@ -0,0 +1,228 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
+)
+
+// ErrInvalidUpdateMask is returned by UpdateClassWithMask for mask paths
+// which don't name an updatable field of a class
+var ErrInvalidUpdateMask = errors.New("invalid update mask")
+
+// updatableClassFields are the fields of a class which may be named by the
+// first segment of an update mask path. Fields mapped to true hold an
+// object, so the path may continue into it.
+var updatableClassFields = map[string]bool{
+	"description":         false,
+	"enforceDeprecation":  false,
+	"invertedIndexConfig": true,
+	"moduleConfig":        true,
+	"multiTenancyConfig":  true,
+	"replicationConfig":   true,
+	"vectorConfig":        true,
+	"vectorIndexConfig":   true,
+}
+
+// UpdateClassWithMask updates only the fields of class named by paths, the
+// rest of the class is kept as it is stored. Paths are dot separated JSON
+// field names of the class, e.g. "vectorIndexConfig.ef". A named field which
+// isn't set in update is cleared. Objects are merged field by field, other
+// values replaced, except for moduleConfig which is applied as JSON merge
+// patch (RFC 7396), so null removes a module setting.
+func (h *Handler) UpdateClassWithMask(ctx context.Context, principal *models.Principal,
+	className string, update *models.Class, paths []string,
+) (*models.Class, error) {
+	defer h.metrics.track(opUpdateClass)()
+
+	className = schema.UppercaseClassName(className)
+	err := h.Authorizer.Authorize(principal, authorization.UPDATE, authorization.CollectionsMetadata(className)...)
+	if err != nil {
+		return nil, err
+	}
+	if len(paths) == 0 {
+		return nil, fmt.Errorf("%w: no paths given", ErrInvalidUpdateMask)
+	}
+
+	initial := h.schemaReader.ReadOnlyClass(className)
+	if initial == nil {
+		return nil, fmt.Errorf("class %q: %w", className, ErrNotFound)
+	}
+	merged, err := mergeClassFields(initial, update, paths)
+	if err != nil {
+		return nil, err
+	}
+	if err := h.updateClass(ctx, principal, className, merged); err != nil {
+		return nil, err
+	}
+	return merged, nil
+}
+
+// mergeClassFields returns a copy of initial with the fields named by paths
+// taken from update
+func mergeClassFields(initial, update *models.Class, paths []string) (*models.Class, error) {
+	target, err := classFields(initial)
+	if err != nil {
+		return nil, err
+	}
+	if update == nil {
+		update = &models.Class{}
+	}
+	source, err := classFields(update)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, path := range paths {
+		segments := strings.Split(path, ".")
+		nested, ok := updatableClassFields[segments[0]]
+		if !ok {
+			return nil, fmt.Errorf("%w: %q is not an updatable field", ErrInvalidUpdateMask, path)
+		}
+		if len(segments) > 1 && !nested {
+			return nil, fmt.Errorf("%w: %q has no fields", ErrInvalidUpdateMask, segments[0])
+		}
+		for _, s := range segments {
+			if s == "" {
+				return nil, fmt.Errorf("%w: %q has an empty segment", ErrInvalidUpdateMask, path)
+			}
+		}
+
+		value, present := lookupField(source, segments)
+		if !present {
+			if err := deleteField(target, path, segments); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		current, _ := lookupField(target, segments)
+		if segments[0] == "moduleConfig" {
+			value = mergePatch(current, value)
+		} else {
+			value = mergeFields(current, value)
+		}
+		if err := setField(target, path, segments, value); err != nil {
+			return nil, err
+		}
+	}
+
+	raw, err := json.Marshal(target)
+	if err != nil {
+		return nil, fmt.Errorf("marshal merged class: %w", err)
+	}
+	merged := &models.Class{}
+	if err := json.Unmarshal(raw, merged); err != nil {
+		return nil, fmt.Errorf("unmarshal merged class: %w", err)
+	}
+	return merged, nil
+}
+
+// classFields returns the JSON representation of class as map
+func classFields(class *models.Class) (map[string]any, error) {
+	raw, err := json.Marshal(class)
+	if err != nil {
+		return nil, fmt.Errorf("marshal class: %w", err)
+	}
+	fields := map[string]any{}
+	if err := json.Unmarshal(raw, &fields); err != nil {
+		return nil, fmt.Errorf("unmarshal class: %w", err)
+	}
+	return fields, nil
+}
+
+func lookupField(fields map[string]any, segments []string) (any, bool) {
+	var current any = fields
+	for _, s := range segments {
+		m, ok := current.(map[string]any)
+		if !ok {
+			return nil, false
+		}
+		if current, ok = m[s]; !ok {
+			return nil, false
+		}
+	}
+	return current, true
+}
+
+func setField(fields map[string]any, path string, segments []string, value any) error {
+	m := fields
+	for _, s := range segments[:len(segments)-1] {
+		next, ok := m[s]
+		if !ok || next == nil {
+			next = map[string]any{}
+			m[s] = next
+		}
+		if m, ok = next.(map[string]any); !ok {
+			return fmt.Errorf("%w: %q is not an object", ErrInvalidUpdateMask, path)
+		}
+	}
+	m[segments[len(segments)-1]] = value
+	return nil
+}
+
+func deleteField(fields map[string]any, path string, segments []string) error {
+	parent, ok := lookupField(fields, segments[:len(segments)-1])
+	if !ok || parent == nil {
+		return nil
+	}
+	m, ok := parent.(map[string]any)
+	if !ok {
+		return fmt.Errorf("%w: %q is not an object", ErrInvalidUpdateMask, path)
+	}
+	delete(m, segments[len(segments)-1])
+	return nil
+}
+
+// mergeFields merges the objects of update into the ones of current field by
+// field, other values of update replace the current ones
+func mergeFields(current, update any) any {
+	cm, ok := current.(map[string]any)
+	if !ok {
+		return update
+	}
+	um, ok := update.(map[string]any)
+	if !ok {
+		return update
+	}
+	for k, v := range um {
+		cm[k] = mergeFields(cm[k], v)
+	}
+	return cm
+}
+
+// mergePatch applies patch to current as JSON merge patch, see RFC 7396
+func mergePatch(current, patch any) any {
+	pm, ok := patch.(map[string]any)
+	if !ok {
+		return patch
+	}
+	cm, ok := current.(map[string]any)
+	if !ok {
+		cm = map[string]any{}
+	}
+	for k, v := range pm {
+		if v == nil {
+			delete(cm, k)
+			continue
+		}
+		cm[k] = mergePatch(cm[k], v)
+	}
+	return cm
+}
@@ -0,0 +1,136 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+func TestMergeClassFields(t *testing.T) {
+	initial := func() *models.Class {
+		return &models.Class{
+			Class:           "C",
+			Description:     "events",
+			VectorIndexType: "hnsw",
+			VectorIndexConfig: map[string]any{
+				"ef": float64(64), "efConstruction": float64(128),
+				"pq": map[string]any{"enabled": false, "segments": float64(8)},
+			},
+			InvertedIndexConfig: &models.InvertedIndexConfig{
+				Bm25: &models.BM25Config{B: 0.75, K1: 1.2},
+			},
+			ModuleConfig: map[string]any{
+				"text2vec-contextionary": map[string]any{"vectorizeClassName": true},
+				"generative-openai":      map[string]any{"model": "gpt-4"},
+			},
+			Properties: []*models.Property{{Name: "name", DataType: []string{"text"}}},
+		}
+	}
+
+	t.Run("nested field", func(t *testing.T) {
+		update := &models.Class{VectorIndexConfig: map[string]any{"ef": 100, "efConstruction": 999}}
+		merged, err := mergeClassFields(initial(), update, []string{"vectorIndexConfig.ef"})
+		require.Nil(t, err)
+		assert.Equal(t, map[string]any{
+			"ef": float64(100), "efConstruction": float64(128),
+			"pq": map[string]any{"enabled": false, "segments": float64(8)},
+		}, merged.VectorIndexConfig)
+		assert.Equal(t, "events", merged.Description)
+		assert.Equal(t, "hnsw", merged.VectorIndexType)
+		assert.Len(t, merged.Properties, 1)
+	})
+
+	t.Run("objects are merged field by field", func(t *testing.T) {
+		update := &models.Class{VectorIndexConfig: map[string]any{"pq": map[string]any{"enabled": true}}}
+		merged, err := mergeClassFields(initial(), update, []string{"vectorIndexConfig"})
+		require.Nil(t, err)
+		assert.Equal(t, map[string]any{"enabled": true, "segments": float64(8)},
+			merged.VectorIndexConfig.(map[string]any)["pq"])
+		assert.Equal(t, float64(64), merged.VectorIndexConfig.(map[string]any)["ef"])
+	})
+
+	t.Run("named fields missing in the update are cleared", func(t *testing.T) {
+		merged, err := mergeClassFields(initial(), &models.Class{}, []string{"description", "invertedIndexConfig.bm25"})
+		require.Nil(t, err)
+		assert.Empty(t, merged.Description)
+		assert.Nil(t, merged.InvertedIndexConfig.Bm25)
+	})
+
+	t.Run("module config is merge patched", func(t *testing.T) {
+		update := &models.Class{ModuleConfig: map[string]any{
+			"text2vec-contextionary": map[string]any{"vectorizePropertyName": true},
+			"generative-openai":      nil,
+		}}
+		merged, err := mergeClassFields(initial(), update, []string{"moduleConfig"})
+		require.Nil(t, err)
+		assert.Equal(t, map[string]any{
+			"text2vec-contextionary": map[string]any{"vectorizeClassName": true, "vectorizePropertyName": true},
+		}, merged.ModuleConfig)
+	})
+
+	for name, path := range map[string]string{
+		"unknown field":      "properties",
+		"not updatable":      "vectorIndexType",
+		"scalar with fields": "description.text",
+		"empty segment":      "vectorIndexConfig..ef",
+		"into a scalar":      "vectorIndexConfig.ef.value",
+	} {
+		t.Run(name, func(t *testing.T) {
+			update := &models.Class{VectorIndexConfig: map[string]any{"ef": map[string]any{"value": 1}}}
+			_, err := mergeClassFields(initial(), update, []string{path})
+			assert.ErrorIs(t, err, ErrInvalidUpdateMask)
+		})
+	}
+
+	t.Run("the stored class is not modified", func(t *testing.T) {
+		class := initial()
+		update := &models.Class{VectorIndexConfig: map[string]any{"ef": 100}}
+		_, err := mergeClassFields(class, update, []string{"vectorIndexConfig.ef"})
+		require.Nil(t, err)
+		assert.Equal(t, initial(), class)
+	})
+}
+
+func TestHandler_UpdateClassWithMask(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("unknown class", func(t *testing.T) {
+		handler, fakeSchemaManager := newTestHandler(t, &fakeDB{})
+		fakeSchemaManager.On("ReadOnlyClass", "C").Return(nil)
+
+		_, err := handler.UpdateClassWithMask(ctx, nil, "c", &models.Class{}, []string{"description"})
+		assert.ErrorIs(t, err, ErrNotFound)
+	})
+
+	t.Run("empty mask", func(t *testing.T) {
+		handler, fakeSchemaManager := newTestHandler(t, &fakeDB{})
+
+		_, err := handler.UpdateClassWithMask(ctx, nil, "C", &models.Class{}, nil)
+		assert.ErrorIs(t, err, ErrInvalidUpdateMask)
+		fakeSchemaManager.AssertNotCalled(t, "UpdateClass", mock.Anything, mock.Anything)
+	})
+
+	t.Run("invalid path", func(t *testing.T) {
+		handler, fakeSchemaManager := newTestHandler(t, &fakeDB{})
+		fakeSchemaManager.On("ReadOnlyClass", "C").Return(&models.Class{Class: "C"})
+
+		_, err := handler.UpdateClassWithMask(ctx, nil, "C", &models.Class{}, []string{"shardingConfig"})
+		assert.ErrorIs(t, err, ErrInvalidUpdateMask)
+		fakeSchemaManager.AssertNotCalled(t, "UpdateClass", mock.Anything, mock.Anything)
+	})
+}
@@ -0,0 +1,71 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"fmt"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// FieldViolation is the validation failure of a single field of a class
+type FieldViolation struct {
+	// Field is the path of the field within the class, e.g. "vectorizer" or
+	// "properties.title.dataType"
+	Field string
+	Err   error
+}
+
+// ValidationError lists all validation failures of a class or of the
+// properties added to it. It unwraps to the errors of the violations, its
+// message is the one of the first violation, i.e. the message validation
+// returns if it stops at the first failure.
+type ValidationError struct {
+	Violations []FieldViolation
+}
+
+func (e *ValidationError) Error() string {
+	return e.Violations[0].Err.Error()
+}
+
+func (e *ValidationError) Unwrap() []error {
+	errs := make([]error, len(e.Violations))
+	for i, v := range e.Violations {
+		errs[i] = v.Err
+	}
+	return errs
+}
+
+// add records err as a violation of field, a nil err is ignored. It returns
+// whether err was recorded.
+func (e *ValidationError) add(field string, err error) bool {
+	if err == nil {
+		return false
+	}
+	e.Violations = append(e.Violations, FieldViolation{Field: field, Err: err})
+	return true
+}
+
+// errOrNil returns e if it has violations
+func (e *ValidationError) errOrNil() error {
+	if len(e.Violations) == 0 {
+		return nil
+	}
+	return e
+}
+
+func propertyField(prop *models.Property, field string) string {
+	if field == "" {
+		return fmt.Sprintf("properties.%s", prop.Name)
+	}
+	return fmt.Sprintf("properties.%s.%s", prop.Name, field)
+}
@@ -0,0 +1,118 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/weaviate/weaviate/cluster/types"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
+	uco "github.com/weaviate/weaviate/usecases/objects"
+)
+
+const (
+	// DefaultBackfillVectorBatchSize is the number of objects BackfillVectors
+	// vectorizes at once if BackfillVectorOptions.BatchSize is not set
+	DefaultBackfillVectorBatchSize = 100
+	// DefaultBackfillVectorWorkers is the number of batches BackfillVectors
+	// vectorizes at the same time if BackfillVectorOptions.ConcurrentWorkers is
+	// not set
+	DefaultBackfillVectorWorkers = 1
+)
+
+type (
+	// BackfillVectorOptions configure a job started by BackfillVectors
+	BackfillVectorOptions = types.BackfillVectorOptions
+	// VectorBackfillStatus describes a job started by BackfillVectors
+	VectorBackfillStatus = types.VectorBackfillStatus
+)
+
+// BackfillVectors vectorizes the existing objects of class again with the
+// vectorizers of the class in the background, e.g. after the vectorizer was
+// changed and the stored vectors are stale. The returned job ID can be passed
+// to BackfillVectorStatus to poll the progress. The shards are backfilled on
+// every node holding them, vectors without a vectorizer are never touched.
+func (h *Handler) BackfillVectors(ctx context.Context, principal *models.Principal,
+	class string, opts BackfillVectorOptions,
+) (string, error) {
+	class = schema.UppercaseClassName(class)
+	err := h.Authorizer.Authorize(principal, authorization.UPDATE, authorization.CollectionsData(class)...)
+	if err != nil {
+		return "", err
+	}
+
+	cls := h.schemaReader.ReadOnlyClass(class)
+	if cls == nil {
+		return "", fmt.Errorf("class %q: %w", class, ErrNotFound)
+	}
+	if err := validateBackfillVectorOptions(cls, &opts); err != nil {
+		return "", err
+	}
+
+	jobID, err := h.dataMigrator.BackfillVectors(ctx, class, opts)
+	if err != nil {
+		return "", fmt.Errorf("start vector backfill: %w", err)
+	}
+	return jobID, nil
+}
+
+// validateBackfillVectorOptions checks opts against class and sets the
+// defaults of unset options
+func validateBackfillVectorOptions(class *models.Class, opts *BackfillVectorOptions) error {
+	switch {
+	case opts.BatchSize < 0:
+		return uco.NewErrInvalidUserInput("batch size must not be negative, got %d", opts.BatchSize)
+	case opts.BatchSize == 0:
+		opts.BatchSize = DefaultBackfillVectorBatchSize
+	}
+	switch {
+	case opts.ConcurrentWorkers < 0:
+		return uco.NewErrInvalidUserInput("concurrent workers must not be negative, got %d", opts.ConcurrentWorkers)
+	case opts.ConcurrentWorkers == 0:
+		opts.ConcurrentWorkers = DefaultBackfillVectorWorkers
+	}
+
+	targets := schema.VectorizedTargets(class)
+	if opts.TargetVector == "" {
+		if len(targets) == 0 {
+			return uco.NewErrInvalidUserInput("class %q has no vectorizer", class.Class)
+		}
+		return nil
+	}
+	if _, ok := class.VectorConfig[opts.TargetVector]; !ok {
+		return uco.NewErrInvalidUserInput("class %q has no target vector %q", class.Class, opts.TargetVector)
+	}
+	if !slices.Contains(targets, opts.TargetVector) {
+		return uco.NewErrInvalidUserInput("target vector %q of class %q has no vectorizer", opts.TargetVector, class.Class)
+	}
+	return nil
+}
+
+// BackfillVectorStatus returns the vector backfill job jobID. Jobs are only
+// known to the node they were started on.
+func (h *Handler) BackfillVectorStatus(ctx context.Context, principal *models.Principal,
+	jobID string,
+) (VectorBackfillStatus, error) {
+	job, ok := h.dataMigrator.VectorBackfillStatus(jobID)
+	if !ok {
+		return VectorBackfillStatus{}, fmt.Errorf("vector backfill job %q: %w", jobID, ErrNotFound)
+	}
+	err := h.Authorizer.Authorize(principal, authorization.READ, authorization.CollectionsData(job.Class)...)
+	if err != nil {
+		return VectorBackfillStatus{}, err
+	}
+	return job, nil
+}
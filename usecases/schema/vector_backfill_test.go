@@ -0,0 +1,105 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+	uco "github.com/weaviate/weaviate/usecases/objects"
+)
+
+func TestHandler_BackfillVectors(t *testing.T) {
+	ctx := context.Background()
+	vectorizer := func(name string) interface{} {
+		return map[string]interface{}{name: map[string]interface{}{}}
+	}
+	named := &models.Class{Class: "Named", VectorConfig: map[string]models.VectorConfig{
+		"title": {Vectorizer: vectorizer("text2vec-cohere")},
+		"image": {Vectorizer: vectorizer("none")},
+	}}
+
+	t.Run("defaults", func(t *testing.T) {
+		handler, fakeSchemaManager := newTestHandler(t, &fakeDB{})
+		fakeSchemaManager.On("ReadOnlyClass", "C").Return(&models.Class{Class: "C", Vectorizer: "text2vec-cohere"})
+		fakeSchemaManager.On("BackfillVectors", "C", BackfillVectorOptions{
+			BatchSize:         DefaultBackfillVectorBatchSize,
+			ConcurrentWorkers: DefaultBackfillVectorWorkers,
+			OnlyNilVectors:    true,
+		}).Return("job-1", nil)
+
+		jobID, err := handler.BackfillVectors(ctx, nil, "c", BackfillVectorOptions{OnlyNilVectors: true})
+		require.Nil(t, err)
+		assert.Equal(t, "job-1", jobID)
+	})
+
+	t.Run("target vector", func(t *testing.T) {
+		handler, fakeSchemaManager := newTestHandler(t, &fakeDB{})
+		fakeSchemaManager.On("ReadOnlyClass", "Named").Return(named)
+		opts := BackfillVectorOptions{BatchSize: 50, ConcurrentWorkers: 4, TargetVector: "title"}
+		fakeSchemaManager.On("BackfillVectors", "Named", opts).Return("job-2", nil)
+
+		jobID, err := handler.BackfillVectors(ctx, nil, "Named", opts)
+		require.Nil(t, err)
+		assert.Equal(t, "job-2", jobID)
+	})
+
+	t.Run("invalid options", func(t *testing.T) {
+		handler, fakeSchemaManager := newTestHandler(t, &fakeDB{})
+		fakeSchemaManager.On("ReadOnlyClass", "Named").Return(named)
+		fakeSchemaManager.On("ReadOnlyClass", "None").Return(&models.Class{Class: "None", Vectorizer: "none"})
+
+		for name, tc := range map[string]struct {
+			class string
+			opts  BackfillVectorOptions
+		}{
+			"negative batch size":       {"Named", BackfillVectorOptions{BatchSize: -1}},
+			"negative workers":          {"Named", BackfillVectorOptions{ConcurrentWorkers: -1}},
+			"unknown target vector":     {"Named", BackfillVectorOptions{TargetVector: "body"}},
+			"target without vectorizer": {"Named", BackfillVectorOptions{TargetVector: "image"}},
+			"class without vectorizer":  {"None", BackfillVectorOptions{}},
+		} {
+			t.Run(name, func(t *testing.T) {
+				_, err := handler.BackfillVectors(ctx, nil, tc.class, tc.opts)
+				assert.True(t, errors.As(err, &uco.ErrInvalidUserInput{}), err)
+			})
+		}
+		fakeSchemaManager.AssertNotCalled(t, "BackfillVectors", mock.Anything, mock.Anything)
+	})
+
+	t.Run("unknown class", func(t *testing.T) {
+		handler, fakeSchemaManager := newTestHandler(t, &fakeDB{})
+		fakeSchemaManager.On("ReadOnlyClass", "Gone").Return(nil)
+
+		_, err := handler.BackfillVectors(ctx, nil, "Gone", BackfillVectorOptions{})
+		assert.ErrorIs(t, err, ErrNotFound)
+	})
+}
+
+func TestHandler_BackfillVectorStatus(t *testing.T) {
+	handler, fakeSchemaManager := newTestHandler(t, &fakeDB{})
+	job := VectorBackfillStatus{ID: "job-1", Class: "C", Status: "RUNNING", Scanned: 20, Updated: 10}
+	fakeSchemaManager.On("VectorBackfillStatus", "job-1").Return(job, true)
+	fakeSchemaManager.On("VectorBackfillStatus", "unknown").Return(VectorBackfillStatus{}, false)
+
+	status, err := handler.BackfillVectorStatus(context.Background(), nil, "job-1")
+	require.Nil(t, err)
+	assert.Equal(t, job, status)
+
+	_, err = handler.BackfillVectorStatus(context.Background(), nil, "unknown")
+	assert.ErrorIs(t, err, ErrNotFound)
+}
@@ -95,6 +95,9 @@ type RemoteIndexClient interface {
 	DeleteObjectBatch(ctx context.Context, hostName, indexName, shardName string,
 		uuids []strfmt.UUID, deletionTime time.Time, dryRun bool, schemaVersion uint64) objects.BatchSimpleObjects
 	GetShardQueueSize(ctx context.Context, hostName, indexName, shardName string) (int64, error)
+	GetShardObjectCount(ctx context.Context, hostName, indexName, shardName string) (int64, error)
+	GetShardDataPurged(ctx context.Context, hostName, indexName, shardName string) (bool, error)
+	PrewarmShard(ctx context.Context, hostName, indexName, shardName string) error
 	GetShardStatus(ctx context.Context, hostName, indexName, shardName string) (string, error)
 	UpdateShardStatus(ctx context.Context, hostName, indexName, shardName, targetStatus string, schemaVersion uint64) error
 
@@ -380,6 +383,39 @@ func (ri *RemoteIndex) GetShardQueueSize(ctx context.Context, shardName string)
 	return ri.client.GetShardQueueSize(ctx, host, ri.class, shardName)
 }
 
+// GetShardObjectCount returns the number of objects in the replica of
+// shardName held by node
+func (ri *RemoteIndex) GetShardObjectCount(ctx context.Context, node, shardName string) (int64, error) {
+	host, ok := ri.nodeResolver.NodeHostname(node)
+	if !ok {
+		return 0, fmt.Errorf("resolve node name %q to host", node)
+	}
+
+	return ri.client.GetShardObjectCount(ctx, host, ri.class, shardName)
+}
+
+// GetShardDataPurged returns whether the replica of shardName held by node
+// is unloaded and its files are removed
+func (ri *RemoteIndex) GetShardDataPurged(ctx context.Context, node, shardName string) (bool, error) {
+	host, ok := ri.nodeResolver.NodeHostname(node)
+	if !ok {
+		return false, fmt.Errorf("resolve node name %q to host", node)
+	}
+
+	return ri.client.GetShardDataPurged(ctx, host, ri.class, shardName)
+}
+
+// PrewarmShard loads the replica of shardName held by node into memory and
+// blocks until it is loaded
+func (ri *RemoteIndex) PrewarmShard(ctx context.Context, node, shardName string) error {
+	host, ok := ri.nodeResolver.NodeHostname(node)
+	if !ok {
+		return fmt.Errorf("resolve node name %q to host", node)
+	}
+
+	return ri.client.PrewarmShard(ctx, host, ri.class, shardName)
+}
+
 func (ri *RemoteIndex) GetShardStatus(ctx context.Context, shardName string) (string, error) {
 	owner, err := ri.stateGetter.ShardOwner(ri.class, shardName)
 	if err != nil {
@@ -75,6 +75,9 @@ type RemoteIndexIncomingRepo interface {
 	IncomingDeleteObjectBatch(ctx context.Context, shardName string,
 		uuids []strfmt.UUID, deletionTime time.Time, dryRun bool, schemaVersion uint64) objects.BatchSimpleObjects
 	IncomingGetShardQueueSize(ctx context.Context, shardName string) (int64, error)
+	IncomingGetShardObjectCount(ctx context.Context, shardName string) (int64, error)
+	IncomingGetShardDataPurged(ctx context.Context, shardName string) (bool, error)
+	IncomingPrewarmShard(ctx context.Context, shardName string) error
 	IncomingGetShardStatus(ctx context.Context, shardName string) (string, error)
 	IncomingUpdateShardStatus(ctx context.Context, shardName, targetStatus string, schemaVersion uint64) error
 	IncomingOverwriteObjects(ctx context.Context, shard string,
@@ -255,6 +258,43 @@ func (rii *RemoteIndexIncoming) GetShardQueueSize(ctx context.Context,
 	return index.IncomingGetShardQueueSize(ctx, shardName)
 }
 
+func (rii *RemoteIndexIncoming) GetShardObjectCount(ctx context.Context,
+	indexName, shardName string,
+) (int64, error) {
+	index := rii.repo.GetIndexForIncomingSharding(schema.ClassName(indexName))
+	if index == nil {
+		return 0, enterrors.NewErrUnprocessable(errors.Errorf("local index %q not found", indexName))
+	}
+
+	return index.IncomingGetShardObjectCount(ctx, shardName)
+}
+
+// GetShardDataPurged returns whether the local shard is removed, which it
+// also is if the index doesn't exist on this node
+func (rii *RemoteIndexIncoming) GetShardDataPurged(ctx context.Context,
+	indexName, shardName string,
+) (bool, error) {
+	index := rii.repo.GetIndexForIncomingSharding(schema.ClassName(indexName))
+	if index == nil {
+		return true, nil
+	}
+
+	return index.IncomingGetShardDataPurged(ctx, shardName)
+}
+
+// PrewarmShard loads the local shard into memory and blocks until it is
+// loaded
+func (rii *RemoteIndexIncoming) PrewarmShard(ctx context.Context,
+	indexName, shardName string,
+) error {
+	index := rii.repo.GetIndexForIncomingSharding(schema.ClassName(indexName))
+	if index == nil {
+		return enterrors.NewErrUnprocessable(errors.Errorf("local index %q not found", indexName))
+	}
+
+	return index.IncomingPrewarmShard(ctx, shardName)
+}
+
 func (rii *RemoteIndexIncoming) GetShardStatus(ctx context.Context,
 	indexName, shardName string,
 ) (string, error) {
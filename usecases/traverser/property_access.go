@@ -0,0 +1,61 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package traverser
+
+import (
+	"github.com/weaviate/weaviate/entities/dto"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/schema"
+)
+
+// propertyAccessRecorder counts the properties used by filters and sorts,
+// see schema.Handler.RecordPropertyAccess
+type propertyAccessRecorder interface {
+	RecordPropertyAccess(class, property string)
+}
+
+// WithPropertyAccessRecorder makes GetClass record the properties used by
+// the filters and sorts of successful queries with r
+func (t *Traverser) WithPropertyAccessRecorder(r propertyAccessRecorder) {
+	t.propertyAccess = r
+}
+
+func (t *Traverser) recordPropertyAccess(params dto.GetParams) {
+	if t.propertyAccess == nil {
+		return
+	}
+	if params.Filters != nil {
+		t.recordClauseAccess(params.Filters.Root)
+	}
+	for _, s := range params.Sort {
+		if len(s.Path) > 0 && !filters.IsInternalProperty(schema.PropertyName(s.Path[0])) {
+			t.propertyAccess.RecordPropertyAccess(params.ClassName, s.Path[0])
+		}
+	}
+}
+
+// recordClauseAccess records the properties of all operands of clause. The
+// properties of referenced classes along a path are recorded for the
+// respective class.
+func (t *Traverser) recordClauseAccess(clause *filters.Clause) {
+	if clause == nil {
+		return
+	}
+	for i := range clause.Operands {
+		t.recordClauseAccess(&clause.Operands[i])
+	}
+	for path := clause.On; path != nil; path = path.Child {
+		if path.Class != "" && path.Property != "" && !filters.IsInternalProperty(path.Property) {
+			t.propertyAccess.RecordPropertyAccess(string(path.Class), string(path.Property))
+		}
+	}
+}
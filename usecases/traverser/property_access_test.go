@@ -0,0 +1,57 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package traverser
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/weaviate/weaviate/entities/dto"
+	"github.com/weaviate/weaviate/entities/filters"
+)
+
+type fakePropertyAccessRecorder []string
+
+func (r *fakePropertyAccessRecorder) RecordPropertyAccess(class, property string) {
+	*r = append(*r, class+"."+property)
+}
+
+func TestTraverser_recordPropertyAccess(t *testing.T) {
+	params := dto.GetParams{
+		ClassName: "Article",
+		Filters: &filters.LocalFilter{Root: &filters.Clause{
+			Operator: filters.OperatorAnd,
+			Operands: []filters.Clause{
+				{Operator: filters.OperatorEqual, On: &filters.Path{Class: "Article", Property: "title"}},
+				{Operator: filters.OperatorEqual, On: &filters.Path{Class: "Article", Property: "id"}},
+				{Operator: filters.OperatorEqual, On: &filters.Path{
+					Class: "Article", Property: "author",
+					Child: &filters.Path{Class: "Person", Property: "name"},
+				}},
+			},
+		}},
+		Sort: []filters.Sort{{Path: []string{"published"}}, {Path: []string{"_creationTimeUnix"}}},
+	}
+
+	t.Run("without recorder", func(t *testing.T) {
+		(&Traverser{}).recordPropertyAccess(params)
+	})
+
+	t.Run("filters and sorts are recorded", func(t *testing.T) {
+		recorder := &fakePropertyAccessRecorder{}
+		tr := &Traverser{}
+		tr.WithPropertyAccessRecorder(recorder)
+		tr.recordPropertyAccess(params)
+		assert.Equal(t, []string{"Article.title", "Article.author", "Person.name", "Article.published"},
+			[]string(*recorder))
+	})
+}
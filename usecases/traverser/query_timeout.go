@@ -0,0 +1,46 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package traverser
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// withQueryTimeout bounds ctx by the queryTimeout of class or, for classes
+// without one, by the default query timeout of the config. Queries of other
+// classes are only bounded by ctx itself.
+func (t *Traverser) withQueryTimeout(ctx context.Context, className string) (context.Context, context.CancelFunc) {
+	var timeout time.Duration
+	if t.config != nil {
+		timeout = t.config.Config.Schema.DefaultQueryTimeout
+	}
+	if class := t.schemaGetter.ReadOnlyClass(className); class != nil && class.QueryTimeout != nil {
+		timeout = *class.QueryTimeout
+	}
+	if timeout <= 0 {
+		return ctx, func() {}
+	}
+	return context.WithTimeout(ctx, timeout)
+}
+
+// queryTimeoutErr makes err of a query whose ctx exceeded its deadline a
+// context.DeadlineExceeded error, the searches don't wrap the error of ctx
+// everywhere
+func queryTimeoutErr(ctx context.Context, err error) error {
+	if err == nil || errors.Is(err, context.DeadlineExceeded) || !errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		return err
+	}
+	return fmt.Errorf("%w: %w", context.DeadlineExceeded, err)
+}
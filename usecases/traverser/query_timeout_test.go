@@ -0,0 +1,87 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package traverser
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/aggregation"
+	"github.com/weaviate/weaviate/entities/dto"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/usecases/auth/authorization/mocks"
+	"github.com/weaviate/weaviate/usecases/config"
+)
+
+// blockingExplorer blocks a search until its ctx is done, like a query which
+// takes too long
+type blockingExplorer struct {
+	fakeExplorer
+}
+
+func (f *blockingExplorer) GetClass(ctx context.Context, p dto.GetParams) ([]interface{}, error) {
+	<-ctx.Done()
+	// the searches don't always wrap the error of ctx
+	return nil, errors.New("search aborted")
+}
+
+func TestTraverser_QueryTimeout(t *testing.T) {
+	timeout := 10 * time.Millisecond
+	newTraverser := func(defaultTimeout time.Duration, class *models.Class, vectorSearcher VectorSearcher) *Traverser {
+		logger, _ := test.NewNullLogger()
+		cfg := &config.WeaviateConfig{}
+		cfg.Config.Schema.DefaultQueryTimeout = defaultTimeout
+		schemaGetter := &fakeSchemaGetter{schema.Schema{Objects: &models.Schema{Classes: []*models.Class{class}}}}
+		return NewTraverser(cfg, &fakeLocks{}, logger, mocks.NewMockAuthorizer(),
+			vectorSearcher, &blockingExplorer{}, schemaGetter, nil, nil, -1)
+	}
+
+	t.Run("get exceeding the class timeout", func(t *testing.T) {
+		traverser := newTraverser(time.Hour, &models.Class{Class: "MyClass", QueryTimeout: &timeout}, &fakeVectorSearcher{})
+		_, err := traverser.GetClass(context.Background(), nil, dto.GetParams{ClassName: "MyClass"})
+		require.NotNil(t, err)
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+		assert.ErrorContains(t, err, "search aborted")
+	})
+
+	t.Run("get exceeding the default timeout", func(t *testing.T) {
+		traverser := newTraverser(timeout, &models.Class{Class: "MyClass"}, &fakeVectorSearcher{})
+		_, err := traverser.GetClass(context.Background(), nil, dto.GetParams{ClassName: "MyClass"})
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+	})
+
+	t.Run("get without timeout", func(t *testing.T) {
+		traverser := newTraverser(0, &models.Class{Class: "MyClass"}, &fakeVectorSearcher{})
+		ctx, cancel := context.WithCancel(context.Background())
+		time.AfterFunc(timeout, cancel)
+		_, err := traverser.GetClass(ctx, nil, dto.GetParams{ClassName: "MyClass"})
+		require.NotNil(t, err)
+		assert.NotErrorIs(t, err, context.DeadlineExceeded, "only the cancellation of the caller ends the query")
+	})
+
+	t.Run("aggregate exceeding the class timeout", func(t *testing.T) {
+		vectorSearcher := &fakeVectorSearcher{}
+		vectorSearcher.On("Aggregate", mock.Anything).
+			WaitUntil(time.After(100*time.Millisecond)).
+			Return((*aggregation.Result)(nil), errors.New("search aborted"))
+		traverser := newTraverser(0, &models.Class{Class: "MyClass", QueryTimeout: &timeout}, vectorSearcher)
+		_, err := traverser.Aggregate(context.Background(), nil, &aggregation.Params{ClassName: "MyClass"})
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+	})
+}
@@ -45,6 +45,8 @@ type Traverser struct {
 	targetVectorParamHelper *TargetVectorParamHelper
 	metrics                 *Metrics
 	ratelimiter             *ratelimiter.Limiter
+	// propertyAccess is nil until WithPropertyAccessRecorder is called
+	propertyAccess propertyAccessRecorder
 }
 
 type VectorSearcher interface {
@@ -29,6 +29,9 @@ func (t *Traverser) Aggregate(ctx context.Context, principal *models.Principal,
 	t.metrics.QueriesAggregateInc(params.ClassName.String())
 	defer t.metrics.QueriesAggregateDec(params.ClassName.String())
 
+	ctx, cancel := t.withQueryTimeout(ctx, params.ClassName.String())
+	defer cancel()
+
 	unlock, err := t.locks.LockConnector()
 	if err != nil {
 		return nil, enterrors.NewErrLockConnector(err)
@@ -112,7 +115,7 @@ func (t *Traverser) Aggregate(ctx context.Context, principal *models.Principal,
 
 	res, err := t.vectorSearcher.Aggregate(ctx, *params, mp)
 	if err != nil || res == nil {
-		return nil, err
+		return nil, queryTimeoutErr(ctx, err)
 	}
 
 	return inspector.WithTypes(res, *params)
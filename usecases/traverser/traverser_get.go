@@ -41,6 +41,9 @@ func (t *Traverser) GetClass(ctx context.Context, principal *models.Principal,
 
 	defer t.ratelimiter.Dec()
 
+	ctx, cancel := t.withQueryTimeout(ctx, params.ClassName)
+	defer cancel()
+
 	t.metrics.QueriesGetInc(params.ClassName)
 	defer t.metrics.QueriesGetDec(params.ClassName)
 	defer t.metrics.QueriesObserveDuration(params.ClassName, before.UnixMilli())
@@ -70,7 +73,12 @@ func (t *Traverser) GetClass(ctx context.Context, principal *models.Principal,
 		}
 	}
 
-	return t.explorer.GetClass(ctx, params)
+	res, err := t.explorer.GetClass(ctx, params)
+	if err != nil {
+		return nil, queryTimeoutErr(ctx, err)
+	}
+	t.recordPropertyAccess(params)
+	return res, nil
 }
 
 // probeForRefDepthLimit checks to ensure reference nesting depth doesn't exceed the limit